@@ -145,6 +145,8 @@ type Routes struct {
 
 	IPFiltering *mux.Router // 'api/v4/ip_filtering'
 
+	GuestInvites *mux.Router // 'api/v4/guest_invites'
+
 	Reports *mux.Router // 'api/v4/reports'
 
 	Limits *mux.Router // 'api/v4/limits'
@@ -281,6 +283,8 @@ func Init(srv *app.Server) (*API, error) {
 
 	api.BaseRoutes.IPFiltering = api.BaseRoutes.APIRoot.PathPrefix("/ip_filtering").Subrouter()
 
+	api.BaseRoutes.GuestInvites = api.BaseRoutes.APIRoot.PathPrefix("/guest_invites").Subrouter()
+
 	api.BaseRoutes.Reports = api.BaseRoutes.APIRoot.PathPrefix("/reports").Subrouter()
 
 	api.BaseRoutes.Limits = api.BaseRoutes.APIRoot.PathPrefix("/limits").Subrouter()
@@ -332,6 +336,12 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitHostedCustomer()
 	api.InitDrafts()
 	api.InitIPFiltering()
+	api.InitGuestAccount()
+	api.InitUserDataExport()
+	api.InitSavedSearch()
+	api.InitTranslation()
+	api.InitCatchUp()
+	api.InitReadReceipt()
 	api.InitChannelBookmarks()
 	api.InitReports()
 	api.InitLimits()
@@ -12,6 +12,7 @@ import (
 
 func (api *API) InitBleve() {
 	api.BaseRoutes.Bleve.Handle("/purge_indexes", api.APISessionRequired(purgeBleveIndexes)).Methods(http.MethodPost)
+	api.BaseRoutes.Bleve.Handle("/rollback_indexes", api.APISessionRequired(rollbackBleveIndexes)).Methods(http.MethodPost)
 }
 
 func purgeBleveIndexes(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -37,3 +38,27 @@ func purgeBleveIndexes(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	ReturnStatusOK(w)
 }
+
+func rollbackBleveIndexes(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("rollbackBleveIndexes", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionPurgeBleveIndexes) {
+		c.SetPermissionError(model.PermissionPurgeBleveIndexes)
+		return
+	}
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("rollbackBleveIndexes", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if err := c.App.RollbackBleveIndexes(c.AppContext); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
@@ -44,3 +44,28 @@ func TestBlevePurgeIndexes(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestBleveRollbackIndexes(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		resp, err := th.Client.RollbackBleveIndexes(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as system admin without previous indexes", func(t *testing.T) {
+		resp, err := th.SystemAdminClient.RollbackBleveIndexes(context.Background())
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+
+		resp, err := th.SystemAdminClient.RollbackBleveIndexes(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
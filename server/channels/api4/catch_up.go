@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitCatchUp() {
+	api.BaseRoutes.Channel.Handle("/catch_up", api.APISessionRequired(getChannelCatchUp)).Methods(http.MethodGet)
+	api.BaseRoutes.Post.Handle("/thread/catch_up", api.APISessionRequired(getThreadCatchUp)).Methods(http.MethodGet)
+}
+
+// catchUpParams returns the time since which to catch up, zero for the default, and the number
+// of posts to return, with zero for the default too.
+func catchUpParams(c *Context, r *http.Request) (int64, int) {
+	var since int64
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		var err error
+		since, err = strconv.ParseInt(sinceStr, 10, 64)
+		if err != nil || since < 0 {
+			c.SetInvalidURLParam("since")
+			return 0, 0
+		}
+	}
+
+	limit := model.CatchUpDefaultLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 0 || limit > model.CatchUpMaxLimit {
+			c.SetInvalidURLParam("limit")
+			return 0, 0
+		}
+		if limit == 0 {
+			limit = model.CatchUpDefaultLimit
+		}
+	}
+
+	return since, limit
+}
+
+func writeCatchUp(c *Context, w http.ResponseWriter, catchUp *model.CatchUp) {
+	for _, ranking := range catchUp.Posts {
+		post, appErr := c.App.SanitizePostMetadataForUser(c.AppContext, ranking.Post, c.AppContext.Session().UserId)
+		if appErr != nil {
+			c.Err = appErr
+			return
+		}
+		ranking.Post = post
+	}
+
+	if err := json.NewEncoder(w).Encode(catchUp); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelCatchUp(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	since, limit := catchUpParams(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannelContent) {
+		c.SetPermissionError(model.PermissionReadChannelContent)
+		return
+	}
+
+	catchUp, appErr := c.App.GetChannelCatchUp(c.AppContext, c.AppContext.Session().UserId, c.Params.ChannelId, since, limit)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	writeCatchUp(c, w, catchUp)
+}
+
+func getThreadCatchUp(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	since, limit := catchUpParams(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	post, appErr := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	rootPost := post
+	if post.RootId != "" {
+		rootPost, appErr = c.App.GetSinglePost(c.AppContext, post.RootId, false)
+		if appErr != nil {
+			c.Err = appErr
+			return
+		}
+	}
+
+	catchUp, appErr := c.App.GetThreadCatchUp(c.AppContext, c.AppContext.Session().UserId, rootPost, since, limit)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	writeCatchUp(c, w, catchUp)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCatchUp(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createPost := func(user *model.User, post *model.Post) *model.Post {
+		post.UserId = user.Id
+		post.ChannelId = th.BasicChannel.Id
+		rpost, appErr := th.App.CreatePost(th.Context, post, th.BasicChannel, false, false)
+		require.Nil(t, appErr)
+		return rpost
+	}
+
+	since := createPost(th.BasicUser2, &model.Post{Message: "already read"}).CreateAt
+
+	plain := createPost(th.BasicUser2, &model.Post{Message: "plain"})
+	mention := createPost(th.BasicUser2, &model.Post{Message: "hi @" + th.BasicUser.Username})
+	reply := createPost(th.BasicUser2, &model.Post{Message: "reply", RootId: plain.Id})
+	pinned := createPost(th.BasicUser2, &model.Post{Message: "pinned", IsPinned: true})
+	reacted := createPost(th.BasicUser2, &model.Post{Message: "reacted"})
+	for _, user := range []*model.User{th.BasicUser, th.SystemAdminUser} {
+		_, appErr := th.App.SaveReactionForPost(th.Context, &model.Reaction{UserId: user.Id, PostId: reacted.Id, EmojiName: "smile"})
+		require.Nil(t, appErr)
+	}
+	createPost(th.BasicUser, &model.Post{Message: "own"})
+
+	postIDs := func(catchUp *model.CatchUp) []string {
+		ids := []string{}
+		for _, ranking := range catchUp.Posts {
+			ids = append(ids, ranking.Post.Id)
+		}
+		return ids
+	}
+
+	t.Run("channel", func(t *testing.T) {
+		catchUp, _, err := th.Client.GetChannelCatchUp(context.Background(), th.BasicChannel.Id, since, 0)
+		require.NoError(t, err)
+		assert.Equal(t, since, catchUp.Since)
+		assert.Equal(t, 6, catchUp.TotalPosts)
+
+		// The reply to the plain post and the reactions rank the same, so they are ordered
+		// chronologically.
+		assert.Equal(t, []string{mention.Id, pinned.Id, plain.Id, reacted.Id}, postIDs(catchUp))
+		assert.Equal(t, []string{model.CatchUpReasonMention}, catchUp.Posts[0].Reasons)
+		assert.Equal(t, []string{model.CatchUpReasonReplies}, catchUp.Posts[2].Reasons)
+		assert.Equal(t, []string{model.CatchUpReasonReactions}, catchUp.Posts[3].Reasons)
+		assert.Equal(t, model.CatchUpSkipped{NoSignal: 1, OwnPosts: 1}, catchUp.Skipped)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		catchUp, _, err := th.Client.GetChannelCatchUp(context.Background(), th.BasicChannel.Id, since, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{mention.Id}, postIDs(catchUp))
+		assert.Equal(t, 3, catchUp.Skipped.OverLimit)
+
+		_, resp, err := th.Client.GetChannelCatchUp(context.Background(), th.BasicChannel.Id, since, model.CatchUpMaxLimit+1)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("since the channel was last viewed", func(t *testing.T) {
+		_, appErr := th.App.MarkChannelsAsViewed(th.Context, []string{th.BasicChannel.Id}, th.BasicUser.Id, "", false, false)
+		require.Nil(t, appErr)
+
+		catchUp, _, err := th.Client.GetChannelCatchUp(context.Background(), th.BasicChannel.Id, 0, 0)
+		require.NoError(t, err)
+		assert.Zero(t, catchUp.TotalPosts)
+		assert.Empty(t, catchUp.Posts)
+	})
+
+	t.Run("thread", func(t *testing.T) {
+		catchUp, _, err := th.Client.GetThreadCatchUp(context.Background(), reply.Id, since, 0)
+		require.NoError(t, err)
+		assert.Equal(t, plain.Id, catchUp.RootId)
+		assert.Equal(t, 2, catchUp.TotalPosts)
+		assert.Equal(t, []string{plain.Id}, postIDs(catchUp))
+		assert.Equal(t, 1, catchUp.Skipped.NoSignal)
+	})
+
+	t.Run("channel the user isn't a member of", func(t *testing.T) {
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+
+		_, resp, err := th.Client.GetChannelCatchUp(context.Background(), private.Id, 0, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		post := th.CreatePostWithClient(th.SystemAdminClient, private)
+		_, resp, err = th.Client.GetThreadCatchUp(context.Background(), post.Id, 0, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
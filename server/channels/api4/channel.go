@@ -1473,6 +1473,11 @@ func getChannelMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := c.App.SanitizeChannelMembersReadReceipts(members, c.AppContext.Session().UserId); err != nil {
+		c.Err = err
+		return
+	}
+
 	if err := json.NewEncoder(w).Encode(members); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -1524,6 +1529,11 @@ func getChannelMembersByIds(c *Context, w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if appErr := c.App.SanitizeChannelMembersReadReceipts(members, c.AppContext.Session().UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	if err := json.NewEncoder(w).Encode(members); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -1547,7 +1557,13 @@ func getChannelMember(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := json.NewEncoder(w).Encode(member); err != nil {
+	members := model.ChannelMembers{*member}
+	if err := c.App.SanitizeChannelMembersReadReceipts(members, c.AppContext.Session().UserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(members[0]); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
@@ -1614,6 +1630,7 @@ func viewChannel(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
+	c.App.UpdateSessionIPAddressIfNeeded(c.AppContext, c.AppContext.Session())
 	c.ExtendSessionExpiryIfNeeded(w, r)
 
 	// Returning {"status": "OK", ...} for backwards compatibility
@@ -152,6 +152,12 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	// modifications to the slice.
 	cfg.PluginSettings.SignaturePublicKeyFiles = appCfg.PluginSettings.SignaturePublicKeyFiles
 
+	// Plugin capabilities are only granted through the plugin capabilities approval API
+	cfg.PluginSettings.GrantedCapabilities = appCfg.PluginSettings.GrantedCapabilities
+
+	// The permissions registered by plugins are tracked by the server
+	cfg.PluginSettings.RegisteredPermissions = appCfg.PluginSettings.RegisteredPermissions
+
 	// Do not allow marketplace URL to be toggled through the API if EnableUploads are disabled.
 	if cfg.PluginSettings.EnableUploads != nil && !*appCfg.PluginSettings.EnableUploads {
 		*cfg.PluginSettings.MarketplaceURL = *appCfg.PluginSettings.MarketplaceURL
@@ -183,6 +189,11 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr := c.App.CheckIPFilterLockout(cfg, utils.GetTrustedIPAddress(r, cfg.ServiceSettings.TrustedProxyIPHeader), c.AppContext.Session()); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	oldCfg, newCfg, appErr := c.App.SaveConfig(cfg, true)
 	if appErr != nil {
 		c.Err = appErr
@@ -306,6 +317,18 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Plugin capabilities are only granted through the plugin capabilities approval API
+	if cfg.PluginSettings.GrantedCapabilities != nil && !reflect.DeepEqual(cfg.PluginSettings.GrantedCapabilities, appCfg.PluginSettings.GrantedCapabilities) {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "PluginSettings.GrantedCapabilities"}, "", http.StatusForbidden)
+		return
+	}
+
+	// The permissions registered by plugins are tracked by the server
+	if cfg.PluginSettings.RegisteredPermissions != nil && !reflect.DeepEqual(cfg.PluginSettings.RegisteredPermissions, appCfg.PluginSettings.RegisteredPermissions) {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "PluginSettings.RegisteredPermissions"}, "", http.StatusForbidden)
+		return
+	}
+
 	// Do not allow marketplace URL to be toggled if plugin uploads are disabled.
 	if cfg.PluginSettings.MarketplaceURL != nil && cfg.PluginSettings.EnableUploads != nil {
 		// Breaking it down to 2 conditions to make it simple.
@@ -341,6 +364,11 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr = c.App.CheckIPFilterLockout(updatedCfg, utils.GetTrustedIPAddress(r, updatedCfg.ServiceSettings.TrustedProxyIPHeader), c.AppContext.Session()); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	oldCfg, newCfg, appErr := c.App.SaveConfig(updatedCfg, true)
 	if appErr != nil {
 		c.Err = appErr
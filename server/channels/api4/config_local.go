@@ -56,6 +56,12 @@ func localUpdateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	// Do not allow certificates to be changed through the API
 	cfg.PluginSettings.SignaturePublicKeyFiles = appCfg.PluginSettings.SignaturePublicKeyFiles
 
+	// Plugin capabilities are only granted through the plugin capabilities approval API
+	cfg.PluginSettings.GrantedCapabilities = appCfg.PluginSettings.GrantedCapabilities
+
+	// The permissions registered by plugins are tracked by the server
+	cfg.PluginSettings.RegisteredPermissions = appCfg.PluginSettings.RegisteredPermissions
+
 	c.App.HandleMessageExportConfig(cfg, appCfg)
 
 	appErr := cfg.IsValid()
@@ -104,6 +110,18 @@ func localPatchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return true
 	}
 
+	// Plugin capabilities are only granted through the plugin capabilities approval API
+	if cfg.PluginSettings.GrantedCapabilities != nil && !reflect.DeepEqual(cfg.PluginSettings.GrantedCapabilities, appCfg.PluginSettings.GrantedCapabilities) {
+		c.Err = model.NewAppError("localPatchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "PluginSettings.GrantedCapabilities"}, "", http.StatusForbidden)
+		return
+	}
+
+	// The permissions registered by plugins are tracked by the server
+	if cfg.PluginSettings.RegisteredPermissions != nil && !reflect.DeepEqual(cfg.PluginSettings.RegisteredPermissions, appCfg.PluginSettings.RegisteredPermissions) {
+		c.Err = model.NewAppError("localPatchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "PluginSettings.RegisteredPermissions"}, "", http.StatusForbidden)
+		return
+	}
+
 	if cfg.MessageExportSettings.EnableExport != nil {
 		c.App.HandleMessageExportConfig(cfg, appCfg)
 	}
@@ -217,6 +217,24 @@ func TestUpdateConfig(t *testing.T) {
 			assert.Equal(t, oldPublicKeys, cfg.PluginSettings.SignaturePublicKeyFiles)
 			assert.Equal(t, oldPublicKeys, th.App.Config().PluginSettings.SignaturePublicKeyFiles)
 		})
+
+		t.Run("Should not be able to modify PluginSettings.GrantedCapabilities", func(t *testing.T) {
+			cfg.PluginSettings.GrantedCapabilities = map[string][]string{"pluginid": {model.PluginCapabilityConfigWrite}}
+
+			cfg, _, err = client.UpdateConfig(context.Background(), cfg)
+			require.NoError(t, err)
+			assert.Empty(t, cfg.PluginSettings.GrantedCapabilities)
+			assert.Empty(t, th.App.Config().PluginSettings.GrantedCapabilities)
+		})
+
+		t.Run("Should not be able to modify PluginSettings.RegisteredPermissions", func(t *testing.T) {
+			cfg.PluginSettings.RegisteredPermissions = map[string][]string{"pluginid": {"plugin_pluginid_permission"}}
+
+			cfg, _, err = client.UpdateConfig(context.Background(), cfg)
+			require.NoError(t, err)
+			assert.Empty(t, cfg.PluginSettings.RegisteredPermissions)
+			assert.Empty(t, th.App.Config().PluginSettings.RegisteredPermissions)
+		})
 	})
 
 	t.Run("Should not be able to modify PluginSettings.MarketplaceURL if EnableUploads is disabled", func(t *testing.T) {
@@ -755,6 +773,28 @@ func TestPatchConfig(t *testing.T) {
 			assert.Equal(t, model.FakeSetting, *updatedConfig.SqlSettings.DataSource)
 		})
 
+		t.Run("not allowing to grant plugin capabilities", func(t *testing.T) {
+			config := model.Config{PluginSettings: model.PluginSettings{
+				GrantedCapabilities: map[string][]string{"pluginid": {model.PluginCapabilityConfigWrite}},
+			}}
+
+			_, resp, err := client.PatchConfig(context.Background(), &config)
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+			assert.Empty(t, th.App.Config().PluginSettings.GrantedCapabilities)
+		})
+
+		t.Run("not allowing to change the permissions registered by plugins", func(t *testing.T) {
+			config := model.Config{PluginSettings: model.PluginSettings{
+				RegisteredPermissions: map[string][]string{"pluginid": {"plugin_pluginid_permission"}},
+			}}
+
+			_, resp, err := client.PatchConfig(context.Background(), &config)
+			require.Error(t, err)
+			CheckForbiddenStatus(t, resp)
+			assert.Empty(t, th.App.Config().PluginSettings.RegisteredPermissions)
+		})
+
 		t.Run("not allowing to toggle enable uploads for plugin via api", func(t *testing.T) {
 			config := model.Config{PluginSettings: model.PluginSettings{
 				EnableUploads: model.NewPointer(true),
@@ -839,6 +879,61 @@ func TestPatchConfig(t *testing.T) {
 	})
 }
 
+func TestPatchConfigIPFiltering(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("should not save settings that block the requester", func(t *testing.T) {
+		config := model.Config{IPFilteringSettings: model.IPFilteringSettings{
+			Enable:          model.NewPointer(true),
+			AllowedIPRanges: model.NewPointer("10.0.0.0/8"),
+		}}
+
+		_, response, err := th.SystemAdminClient.PatchConfig(context.Background(), &config)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, response)
+		CheckErrorID(t, err, "app.ip_filtering.lockout.app_error")
+	})
+
+	t.Run("should save settings allowing the requester", func(t *testing.T) {
+		config := model.Config{IPFilteringSettings: model.IPFilteringSettings{
+			Enable:          model.NewPointer(true),
+			AllowedIPRanges: model.NewPointer("127.0.0.0/8 ::1"),
+		}}
+
+		updatedConfig, _, err := th.SystemAdminClient.PatchConfig(context.Background(), &config)
+		require.NoError(t, err)
+		require.True(t, *updatedConfig.IPFilteringSettings.Enable)
+
+		_, _, err = th.Client.GetMe(context.Background(), "")
+		require.NoError(t, err)
+	})
+
+	t.Run("should block requests from denied ranges until disabled in local mode", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.IPFilteringSettings.DeniedIPRanges = "127.0.0.0/8 ::1"
+		})
+
+		_, response, err := th.Client.GetMe(context.Background(), "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, response)
+		CheckErrorID(t, err, "app.ip_filtering.blocked.app_error")
+
+		_, response, err = th.SystemAdminClient.GetConfig(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, response)
+
+		config := model.Config{IPFilteringSettings: model.IPFilteringSettings{
+			Enable: model.NewPointer(false),
+		}}
+		_, _, err = th.LocalClient.PatchConfig(context.Background(), &config)
+		require.NoError(t, err)
+
+		_, _, err = th.Client.GetMe(context.Background(), "")
+		require.NoError(t, err)
+	})
+}
+
 func TestMigrateConfig(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
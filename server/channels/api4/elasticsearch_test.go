@@ -8,10 +8,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine/opensearchtest"
 )
 
 func TestElasticsearchTest(t *testing.T) {
@@ -25,9 +27,13 @@ func TestElasticsearchTest(t *testing.T) {
 	})
 
 	t.Run("as system admin", func(t *testing.T) {
+		server := opensearchtest.NewServer()
+		defer server.Close()
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ElasticsearchSettings.ConnectionURL = server.URL })
+
 		resp, err := th.SystemAdminClient.TestElasticsearch(context.Background())
-		require.Error(t, err)
-		CheckNotImplementedStatus(t, resp)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
 	})
 
 	t.Run("invalid config", func(t *testing.T) {
@@ -66,9 +72,20 @@ func TestElasticsearchPurgeIndexes(t *testing.T) {
 	})
 
 	t.Run("as system admin", func(t *testing.T) {
+		server := opensearchtest.NewServer()
+		defer server.Close()
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ElasticsearchSettings.ConnectionURL = server.URL
+			*cfg.ElasticsearchSettings.EnableIndexing = true
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ElasticsearchSettings.EnableIndexing = false })
+		require.Eventually(t, func() bool {
+			return th.App.SearchEngine().ElasticsearchEngine.IsActive()
+		}, 5*time.Second, 100*time.Millisecond)
+
 		resp, err := th.SystemAdminClient.PurgeElasticsearchIndexes(context.Background())
-		require.Error(t, err)
-		CheckNotImplementedStatus(t, resp)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
 	})
 
 	t.Run("as restricted system admin", func(t *testing.T) {
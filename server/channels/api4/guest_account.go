@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitGuestAccount() {
+	api.BaseRoutes.User.Handle("/guest_account", api.APISessionRequired(getGuestAccount)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/guest_account/extend", api.APISessionRequired(extendGuestAccount)).Methods(http.MethodPost)
+
+	api.BaseRoutes.GuestInvites.Handle("", api.APISessionRequired(getPendingGuestInvites)).Methods(http.MethodGet)
+	api.BaseRoutes.GuestInvites.Handle("/{invite_id:[A-Za-z0-9]+}/approve", api.APISessionRequired(approvePendingGuestInvite)).Methods(http.MethodPost)
+	api.BaseRoutes.GuestInvites.Handle("/{invite_id:[A-Za-z0-9]+}", api.APISessionRequired(deletePendingGuestInvite)).Methods(http.MethodDelete)
+}
+
+func getGuestAccount(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	account, appErr := c.App.GetGuestAccount(c.Params.UserId)
+	if appErr != nil {
+		// Don't reveal whether the user is a guest to those who can't see their account.
+		if c.Params.UserId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+			c.SetPermissionError(model.PermissionManageSystem)
+			return
+		}
+		c.Err = appErr
+		return
+	}
+
+	sessionUserID := c.AppContext.Session().UserId
+	if account.UserId != sessionUserID && account.SponsorId != sessionUserID && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(account); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func extendGuestAccount(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var extension model.GuestAccountExtension
+	if err := json.NewDecoder(r.Body).Decode(&extension); err != nil {
+		c.SetInvalidParamWithErr("guest_account_extension", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("extendGuestAccount", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "expires_at", extension.ExpiresAt)
+
+	isAdmin := c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem)
+	account, appErr := c.App.GetGuestAccount(c.Params.UserId)
+	if appErr != nil {
+		if !isAdmin {
+			c.SetPermissionError(model.PermissionManageSystem)
+			return
+		}
+		c.Err = appErr
+		return
+	}
+
+	if account.SponsorId != c.AppContext.Session().UserId && !isAdmin {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+	auditRec.AddEventPriorState(account)
+
+	account, appErr = c.App.ExtendGuestAccount(c.AppContext, c.Params.UserId, extension.ExpiresAt, isAdmin)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(account)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(account); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPendingGuestInvites(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	invites, appErr := c.App.GetPendingGuestInvites(c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(invites); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func approvePendingGuestInvite(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireInviteId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("approvePendingGuestInvite", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "invite_id", c.Params.InviteId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	invitesWithError, appErr := c.App.ApprovePendingGuestInvite(c.AppContext, c.Params.InviteId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(invitesWithError); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deletePendingGuestInvite(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireInviteId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deletePendingGuestInvite", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "invite_id", c.Params.InviteId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if appErr := c.App.DeletePendingGuestInvite(c.Params.InviteId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestGuestAccount(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.Enable = true })
+	th.App.Srv().SetLicense(model.NewTestLicense("guest_accounts"))
+
+	guest, guestClient := th.CreateGuestAndClient()
+	expiresAt := model.GetMillis() + 24*60*60*1000
+	_, err := th.App.Srv().Store().GuestAccount().Save(&model.GuestAccount{
+		UserId:    guest.Id,
+		SponsorId: th.BasicUser.Id,
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+
+	t.Run("the guest, their sponsor and admins can get the account", func(t *testing.T) {
+		account, _, err := guestClient.GetGuestAccount(context.Background(), model.Me)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, account.SponsorId)
+
+		_, _, err = th.Client.GetGuestAccount(context.Background(), guest.Id)
+		require.NoError(t, err)
+
+		_, _, err = th.SystemAdminClient.GetGuestAccount(context.Background(), guest.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("other members can't get the account", func(t *testing.T) {
+		client := th.CreateClient()
+		th.LoginBasic2WithClient(client)
+
+		_, resp, err := client.GetGuestAccount(context.Background(), guest.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetGuestAccount(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("only the sponsor and admins can extend the account", func(t *testing.T) {
+		_, resp, err := guestClient.ExtendGuestAccount(context.Background(), guest.Id, expiresAt+1000)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		account, _, err := th.Client.ExtendGuestAccount(context.Background(), guest.Id, expiresAt+1000)
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt+1000, account.ExpiresAt)
+
+		account, _, err = th.SystemAdminClient.ExtendGuestAccount(context.Background(), guest.Id, expiresAt+2000)
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt+2000, account.ExpiresAt)
+	})
+
+	t.Run("only admins can extend past the configured number of days", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.ExpiryDays = 30 })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.GuestAccountsSettings.ExpiryDays = 0 })
+		farExpiresAt := model.GetMillis() + 60*24*60*60*1000
+
+		_, resp, err := th.Client.ExtendGuestAccount(context.Background(), guest.Id, farExpiresAt)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		account, _, err := th.SystemAdminClient.ExtendGuestAccount(context.Background(), guest.Id, farExpiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, farExpiresAt, account.ExpiresAt)
+	})
+}
+
+func TestPendingGuestInvites(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.GuestAccountsSettings.Enable = true
+		*cfg.GuestAccountsSettings.RequireInviteApproval = true
+		*cfg.ServiceSettings.EnableEmailInvitations = true
+	})
+	th.App.Srv().SetLicense(model.NewTestLicense("guest_accounts"))
+	th.AddPermissionToRole(model.PermissionInviteGuest.Id, model.TeamUserRoleId)
+	defer th.RemovePermissionFromRole(model.PermissionInviteGuest.Id, model.TeamUserRoleId)
+
+	_, err := th.Client.InviteGuestsToTeam(context.Background(), th.BasicTeam.Id, []string{th.GenerateTestEmail()}, []string{th.BasicChannel.Id}, "")
+	require.NoError(t, err)
+
+	t.Run("members can't manage pending invites", func(t *testing.T) {
+		_, resp, err := th.Client.GetPendingGuestInvites(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	invites, _, err := th.SystemAdminClient.GetPendingGuestInvites(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	assert.Equal(t, th.BasicUser.Id, invites[0].SenderId)
+	assert.Equal(t, th.BasicUser.Id, invites[0].SponsorId, "the sender sponsors the guests by default")
+
+	t.Run("reject an invite", func(t *testing.T) {
+		_, err := th.SystemAdminClient.DeletePendingGuestInvite(context.Background(), invites[0].Id)
+		require.NoError(t, err)
+
+		resp, err := th.SystemAdminClient.DeletePendingGuestInvite(context.Background(), invites[0].Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("approve an invite", func(t *testing.T) {
+		_, err := th.Client.InviteGuestsToTeam(context.Background(), th.BasicTeam.Id, []string{th.GenerateTestEmail()}, []string{th.BasicChannel.Id}, "")
+		require.NoError(t, err)
+
+		invites, _, err := th.SystemAdminClient.GetPendingGuestInvites(context.Background(), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+
+		res, _, err := th.SystemAdminClient.ApprovePendingGuestInvite(context.Background(), invites[0].Id)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Nil(t, res[0].Error)
+
+		invites, _, err = th.SystemAdminClient.GetPendingGuestInvites(context.Background(), 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, invites)
+	})
+}
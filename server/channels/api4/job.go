@@ -168,7 +168,8 @@ func getJobs(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 		validJobTypes = append(validJobTypes, jobType)
 	} else {
-		for _, jType := range model.AllJobTypes {
+		jobTypes := append(model.AllJobTypes[:], c.App.GetPluginJobTypes()...)
+		for _, jType := range jobTypes {
 			hasPermission, permissionRequired := c.App.SessionHasPermissionToReadJob(*c.AppContext.Session(), jType)
 			if permissionRequired == nil {
 				c.Logger.Warn("The job types of a job you are trying to retrieve does not contain permissions", mlog.String("jobType", jType))
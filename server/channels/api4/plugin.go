@@ -32,8 +32,12 @@ func (api *API) InitPlugin() {
 	api.BaseRoutes.Plugins.Handle("/marketplace", api.APISessionRequired(installMarketplacePlugin)).Methods(http.MethodPost)
 
 	api.BaseRoutes.Plugins.Handle("/statuses", api.APISessionRequired(getPluginStatuses)).Methods(http.MethodGet)
+	api.BaseRoutes.Plugins.Handle("/permissions", api.APISessionRequired(getPluginPermissions)).Methods(http.MethodGet)
 	api.BaseRoutes.Plugin.Handle("/enable", api.APISessionRequired(enablePlugin)).Methods(http.MethodPost)
 	api.BaseRoutes.Plugin.Handle("/disable", api.APISessionRequired(disablePlugin)).Methods(http.MethodPost)
+	api.BaseRoutes.Plugin.Handle("/capabilities/approve", api.APISessionRequired(approvePluginCapabilities)).Methods(http.MethodPost)
+	api.BaseRoutes.Plugin.Handle("/migrations", api.APISessionRequired(getPluginMigrations)).Methods(http.MethodGet)
+	api.BaseRoutes.Plugin.Handle("/migrations/rollback", api.APISessionRequired(rollbackPluginMigrations)).Methods(http.MethodPost)
 
 	api.BaseRoutes.Plugins.Handle("/webapp", api.APIHandler(getWebappPlugins)).Methods(http.MethodGet)
 
@@ -93,7 +97,13 @@ func uploadPlugin(c *Context, w http.ResponseWriter, r *http.Request) {
 		force = true
 	}
 
-	installPlugin(c, w, file, force)
+	approveCapabilities := false
+	if len(m.Value["approve_capabilities"]) > 0 && m.Value["approve_capabilities"][0] == "true" {
+		approveCapabilities = true
+	}
+	audit.AddEventParameter(auditRec, "approve_capabilities", approveCapabilities)
+
+	installPlugin(c, w, file, force, approveCapabilities)
 	auditRec.Success()
 }
 
@@ -114,8 +124,10 @@ func installPluginFromURL(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+	approveCapabilities, _ := strconv.ParseBool(r.URL.Query().Get("approve_capabilities"))
 	downloadURL := r.URL.Query().Get("plugin_download_url")
 	audit.AddEventParameter(auditRec, "url", downloadURL)
+	audit.AddEventParameter(auditRec, "approve_capabilities", approveCapabilities)
 
 	pluginFileBytes, err := c.App.DownloadFromURL(downloadURL)
 	if err != nil {
@@ -123,7 +135,7 @@ func installPluginFromURL(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	installPlugin(c, w, bytes.NewReader(pluginFileBytes), force)
+	installPlugin(c, w, bytes.NewReader(pluginFileBytes), force, approveCapabilities)
 	auditRec.Success()
 }
 
@@ -152,6 +164,7 @@ func installMarketplacePlugin(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 	audit.AddEventParameter(auditRec, "plugin_id", pluginRequest.Id)
+	audit.AddEventParameter(auditRec, "approve_capabilities", pluginRequest.ApproveCapabilities)
 
 	// Always install the latest compatible version
 	// https://mattermost.atlassian.net/browse/MM-41981
@@ -163,6 +176,14 @@ func installMarketplacePlugin(c *Context, w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if pluginRequest.ApproveCapabilities && len(manifest.Capabilities) > 0 {
+		if _, appErr = c.App.ApprovePluginCapabilities(manifest.Id); appErr != nil {
+			c.Err = appErr
+			return
+		}
+		auditRec.AddMeta("capabilities", manifest.Capabilities)
+	}
+
 	auditRec.Success()
 	auditRec.AddMeta("plugin_name", manifest.Name)
 	auditRec.AddMeta("plugin_desc", manifest.Description)
@@ -217,6 +238,28 @@ func getPluginStatuses(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getPluginPermissions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !*c.App.Config().PluginSettings.Enable {
+		c.Err = model.NewAppError("getPluginPermissions", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementPermissions)
+		return
+	}
+
+	permissions, appErr := c.App.GetPluginPermissions()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(permissions); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func removePlugin(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequirePluginId()
 	if c.Err != nil {
@@ -361,16 +404,19 @@ func disablePlugin(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+
 	auditRec := c.MakeAuditRecord("disablePlugin", audit.Fail)
 	defer c.LogAuditRec(auditRec)
 	audit.AddEventParameter(auditRec, "plugin_id", c.Params.PluginId)
+	audit.AddEventParameter(auditRec, "force", force)
 
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWritePlugins) {
 		c.SetPermissionError(model.PermissionSysconsoleWritePlugins)
 		return
 	}
 
-	if err := c.App.DisablePlugin(c.Params.PluginId); err != nil {
+	if err := c.App.DisablePlugin(c.Params.PluginId, force); err != nil {
 		c.Err = err
 		return
 	}
@@ -379,6 +425,112 @@ func disablePlugin(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func approvePluginCapabilities(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePluginId()
+	if c.Err != nil {
+		return
+	}
+
+	if !*c.App.Config().PluginSettings.Enable {
+		c.Err = model.NewAppError("approvePluginCapabilities", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("approvePluginCapabilities", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "plugin_id", c.Params.PluginId)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWritePlugins) {
+		c.SetPermissionError(model.PermissionSysconsoleWritePlugins)
+		return
+	}
+
+	manifest, appErr := c.App.ApprovePluginCapabilities(c.Params.PluginId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("capabilities", manifest.Capabilities)
+
+	if err := json.NewEncoder(w).Encode(manifest); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getPluginMigrations(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePluginId()
+	if c.Err != nil {
+		return
+	}
+
+	if !*c.App.Config().PluginSettings.Enable {
+		c.Err = model.NewAppError("getPluginMigrations", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadPlugins) {
+		c.SetPermissionError(model.PermissionSysconsoleReadPlugins)
+		return
+	}
+
+	migrations, appErr := c.App.GetPluginMigrations(c.Params.PluginId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(migrations); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func rollbackPluginMigrations(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePluginId()
+	if c.Err != nil {
+		return
+	}
+
+	if !*c.App.Config().PluginSettings.Enable {
+		c.Err = model.NewAppError("rollbackPluginMigrations", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	var rollbackRequest model.PluginMigrationRollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&rollbackRequest); err != nil || rollbackRequest.ToVersion < 0 {
+		c.SetInvalidParamWithErr("to_version", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rollbackPluginMigrations", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "plugin_id", c.Params.PluginId)
+	audit.AddEventParameter(auditRec, "to_version", rollbackRequest.ToVersion)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWritePlugins) {
+		c.SetPermissionError(model.PermissionSysconsoleWritePlugins)
+		return
+	}
+
+	rolledBack, appErr := c.App.RollbackPluginMigrations(c.Params.PluginId, rollbackRequest.ToVersion)
+	versions := make([]int64, 0, len(rolledBack))
+	for _, migration := range rolledBack {
+		versions = append(versions, migration.Version)
+	}
+	auditRec.AddMeta("rolled_back_versions", versions)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(rolledBack); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func parseMarketplacePluginFilter(u *url.URL) (*model.MarketplacePluginFilter, error) {
 	page, err := parseInt(u, "page", 0)
 	if err != nil {
@@ -409,12 +561,18 @@ func parseMarketplacePluginFilter(u *url.URL) (*model.MarketplacePluginFilter, e
 	}, nil
 }
 
-func installPlugin(c *Context, w http.ResponseWriter, plugin io.ReadSeeker, force bool) {
+func installPlugin(c *Context, w http.ResponseWriter, plugin io.ReadSeeker, force, approveCapabilities bool) {
 	manifest, appErr := c.App.InstallPlugin(plugin, force)
 	if appErr != nil {
 		c.Err = appErr
 		return
 	}
+	if approveCapabilities && len(manifest.Capabilities) > 0 {
+		if _, appErr = c.App.ApprovePluginCapabilities(manifest.Id); appErr != nil {
+			c.Err = appErr
+			return
+		}
+	}
 	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(manifest); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
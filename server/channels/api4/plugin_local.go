@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 func (api *API) InitPluginLocal() {
@@ -17,10 +18,15 @@ func (api *API) InitPluginLocal() {
 	api.BaseRoutes.Plugin.Handle("", api.APILocal(removePlugin)).Methods(http.MethodDelete)
 	api.BaseRoutes.Plugin.Handle("/enable", api.APILocal(enablePlugin)).Methods(http.MethodPost)
 	api.BaseRoutes.Plugin.Handle("/disable", api.APILocal(disablePlugin)).Methods(http.MethodPost)
+	api.BaseRoutes.Plugin.Handle("/migrations", api.APILocal(getPluginMigrations)).Methods(http.MethodGet)
+	api.BaseRoutes.Plugin.Handle("/migrations/rollback", api.APILocal(rollbackPluginMigrations)).Methods(http.MethodPost)
 	api.BaseRoutes.Plugins.Handle("/marketplace", api.APILocal(installMarketplacePlugin)).Methods(http.MethodPost)
 	api.BaseRoutes.Plugins.Handle("/marketplace", api.APILocal(getMarketplacePlugins)).Methods(http.MethodGet)
 	api.BaseRoutes.Plugins.Handle("/reattach", api.APILocal(reattachPlugin)).Methods(http.MethodPost)
 	api.BaseRoutes.Plugin.Handle("/detach", api.APILocal(detachPlugin)).Methods(http.MethodPost)
+	api.BaseRoutes.Plugins.Handle("/watch", api.APILocal(watchPlugin)).Methods(http.MethodPost)
+	api.BaseRoutes.Plugin.Handle("/watch", api.APILocal(unwatchPlugin)).Methods(http.MethodDelete)
+	api.BaseRoutes.Plugin.Handle("/watch/logs", api.APILocal(getPluginWatchLogs)).Methods(http.MethodGet)
 }
 
 // reattachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
@@ -60,3 +66,92 @@ func detachPlugin(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// watchPlugin deploys a plugin from a directory on the server's machine, and redeploys it whenever
+// it is rebuilt.
+//
+// This API is only exposed over a local socket.
+func watchPlugin(c *Context, w http.ResponseWriter, r *http.Request) {
+	var watchRequest model.PluginWatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&watchRequest); err != nil {
+		c.Err = model.NewAppError("watchPlugin", "api4.plugin.watchPlugin.invalid_request", nil, "", http.StatusBadRequest).Wrap(err)
+		return
+	}
+
+	if err := watchRequest.IsValid(); err != nil {
+		c.Err = err
+		return
+	}
+
+	manifest, appErr := c.App.WatchPlugin(watchRequest.Path)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(manifest); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// unwatchPlugin stops redeploying a watched plugin.
+//
+// This API is only exposed over a local socket.
+func unwatchPlugin(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePluginId()
+	if c.Err != nil {
+		return
+	}
+
+	if appErr := c.App.UnwatchPlugin(c.Params.PluginId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
+
+// getPluginWatchLogs streams the logs of a watched plugin as newline-delimited JSON until the
+// client disconnects or the plugin is no longer watched.
+//
+// This API is only exposed over a local socket.
+func getPluginWatchLogs(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePluginId()
+	if c.Err != nil {
+		return
+	}
+
+	subscription, appErr := c.App.SubscribePluginWatchLogs(c.Params.PluginId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	defer subscription.Close()
+
+	flusher, _ := w.(http.Flusher)
+	flush := func() {
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case entry, ok := <-subscription.Logs:
+			if !ok {
+				return
+			}
+			if err := encoder.Encode(entry); err != nil {
+				return
+			}
+			flush()
+		}
+	}
+}
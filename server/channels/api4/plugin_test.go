@@ -2192,3 +2192,50 @@ func TestPluginWebSocketRemoteAddress(t *testing.T) {
 	_, remoteAddr := hooks.MessageWillBePosted(nil, nil)
 	require.NotEmpty(t, remoteAddr)
 }
+
+func TestApprovePluginCapabilities(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.PluginSettings.Enable = true
+		*cfg.PluginSettings.EnableUploads = true
+	})
+
+	path, _ := fileutils.FindDir("tests")
+	tarData, err := os.ReadFile(filepath.Join(path, "testplugin.tar.gz"))
+	require.NoError(t, err)
+
+	manifest, _, err := th.SystemAdminClient.UploadPluginForced(context.Background(), bytes.NewReader(tarData))
+	require.NoError(t, err)
+	defer os.RemoveAll("plugins/testplugin")
+
+	t.Run("no permission", func(t *testing.T) {
+		_, resp, err := th.Client.ApprovePluginCapabilities(context.Background(), manifest.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unknown plugin", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.ApprovePluginCapabilities(context.Background(), "unknown")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("approve installed plugin", func(t *testing.T) {
+		approved, _, err := th.SystemAdminClient.ApprovePluginCapabilities(context.Background(), manifest.Id)
+		require.NoError(t, err)
+		assert.Equal(t, manifest.Id, approved.Id)
+
+		_, ok := th.App.Config().PluginSettings.GrantedCapabilities[manifest.Id]
+		assert.True(t, ok)
+	})
+
+	t.Run("removing the plugin revokes the grant", func(t *testing.T) {
+		_, err := th.SystemAdminClient.RemovePlugin(context.Background(), manifest.Id)
+		require.NoError(t, err)
+
+		_, ok := th.App.Config().PluginSettings.GrantedCapabilities[manifest.Id]
+		assert.False(t, ok)
+	})
+}
@@ -38,6 +38,8 @@ func (api *API) InitPost() {
 	api.BaseRoutes.Post.Handle("/patch", api.APISessionRequired(patchPost)).Methods(http.MethodPut)
 	api.BaseRoutes.PostForUser.Handle("/set_unread", api.APISessionRequired(setPostUnread)).Methods(http.MethodPost)
 	api.BaseRoutes.PostForUser.Handle("/reminder", api.APISessionRequired(setPostReminder)).Methods(http.MethodPost)
+	api.BaseRoutes.PostForUser.Handle("/reminder", api.APISessionRequired(deletePostReminder)).Methods(http.MethodDelete)
+	api.BaseRoutes.PostsForUser.Handle("/reminders", api.APISessionRequired(getPostRemindersForUser)).Methods(http.MethodGet)
 
 	api.BaseRoutes.Post.Handle("/pin", api.APISessionRequired(pinPost)).Methods(http.MethodPost)
 	api.BaseRoutes.Post.Handle("/unpin", api.APISessionRequired(unpinPost)).Methods(http.MethodPost)
@@ -164,6 +166,7 @@ func createPost(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
+	c.App.UpdateSessionIPAddressIfNeeded(c.AppContext, c.AppContext.Session())
 	c.ExtendSessionExpiryIfNeeded(w, r)
 
 	w.WriteHeader(http.StatusCreated)
@@ -835,9 +838,24 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		includeDeletedChannels = *params.IncludeDeletedChannels
 	}
 
+	var options model.PostSearchOptions
+	if params.SortBy != nil {
+		options.SortBy = *params.SortBy
+	}
+	if params.IncludeSnippets != nil {
+		options.IncludeSnippets = *params.IncludeSnippets
+	}
+	if params.IncludeFacets != nil {
+		options.IncludeFacets = *params.IncludeFacets
+	}
+	if options.IsValid() != nil {
+		c.SetInvalidParam("sort_by")
+		return
+	}
+
 	startTime := time.Now()
 
-	results, err := c.App.SearchPostsForUser(c.AppContext, terms, c.AppContext.Session().UserId, teamId, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage)
+	results, err := c.App.SearchPostsForUserWithOptions(c.AppContext, terms, c.AppContext.Session().UserId, teamId, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, options)
 
 	elapsedTime := float64(time.Since(startTime)) / float64(time.Second)
 	metrics := c.App.Metrics()
@@ -858,7 +876,8 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		return
 	}
 
-	results = model.MakePostSearchResults(clientPostList, results.Matches)
+	// The snippets and facets of the results are kept.
+	results.PostList = clientPostList
 
 	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
 	if err := results.EncodeJSON(w); err != nil {
@@ -1055,7 +1074,7 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	appErr := c.App.SetPostReminder(c.AppContext, c.Params.PostId, c.Params.UserId, reminder.TargetTime)
+	appErr := c.App.SetPostReminder(c.AppContext, c.Params.PostId, c.Params.UserId, &reminder)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1064,6 +1083,47 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func deletePostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId().RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if appErr := c.App.DeletePostReminder(c.Params.PostId, c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
+
+func getPostRemindersForUser(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	reminders, appErr := c.App.GetPostRemindersForUser(c.AppContext, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(reminders); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func saveIsPinnedPost(c *Context, w http.ResponseWriter, isPinned bool) {
 	c.RequirePostId()
 	if c.Err != nil {
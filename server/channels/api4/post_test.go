@@ -4156,6 +4156,116 @@ func TestPostReminder(t *testing.T) {
 	require.Truef(t, caught, "User should have received %s event", model.WebsocketEventEphemeralMessage)
 }
 
+func TestManagePostReminders(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+
+	resp, err := client.SetPostReminder(context.Background(), &model.PostReminder{
+		In:     "2h",
+		PostId: th.BasicPost.Id,
+		UserId: th.BasicUser.Id,
+	})
+	require.NoError(t, err)
+	CheckOKStatus(t, resp)
+
+	reminders, _, err := client.GetPostReminders(context.Background(), th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, th.BasicPost.Id, reminders[0].PostId)
+	assert.InDelta(t, time.Now().Add(2*time.Hour).Unix(), reminders[0].TargetTime, 60)
+
+	t.Run("invalid time", func(t *testing.T) {
+		resp, err := client.SetPostReminder(context.Background(), &model.PostReminder{
+			At:     "someday",
+			PostId: th.BasicPost.Id,
+			UserId: th.BasicUser.Id,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resp, err = client.SetPostReminder(context.Background(), &model.PostReminder{
+			PostId: th.BasicPost.Id,
+			UserId: th.BasicUser.Id,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("reminders of another user", func(t *testing.T) {
+		_, resp, err := client.GetPostReminders(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.DeletePostReminder(context.Background(), th.BasicUser2.Id, th.BasicPost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		resp, err := client.DeletePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+
+		reminders, _, err := client.GetPostReminders(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Empty(t, reminders)
+
+		resp, err = client.DeletePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("deleted when the post is deleted", func(t *testing.T) {
+		post := th.CreatePost()
+		_, err := client.SetPostReminder(context.Background(), &model.PostReminder{In: "1h", PostId: post.Id, UserId: th.BasicUser.Id})
+		require.NoError(t, err)
+
+		_, err = client.DeletePost(context.Background(), post.Id)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			reminders, err := th.App.Srv().Store().Post().GetPostRemindersForUser(th.BasicUser.Id)
+			return err == nil && len(reminders) == 0
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("deleted when the user leaves a private channel", func(t *testing.T) {
+		private := th.CreatePrivateChannel()
+		post := th.CreatePostWithClient(client, private)
+		_, err := client.SetPostReminder(context.Background(), &model.PostReminder{In: "1h", PostId: post.Id, UserId: th.BasicUser.Id})
+		require.NoError(t, err)
+
+		_, err = client.RemoveUserFromChannel(context.Background(), private.Id, th.BasicUser.Id)
+		require.NoError(t, err)
+
+		// Reminders are only listed for channels the user is a member of, so rejoin to check
+		// that the reminder was deleted rather than hidden.
+		th.AddUserToChannel(th.BasicUser, private)
+
+		reminders, err := th.App.Srv().Store().Post().GetPostRemindersForUser(th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Empty(t, reminders)
+	})
+
+	t.Run("hidden while the user can't read the post", func(t *testing.T) {
+		private := th.CreatePrivateChannel()
+		post := th.CreatePostWithClient(client, private)
+		_, err := client.SetPostReminder(context.Background(), &model.PostReminder{In: "1h", PostId: post.Id, UserId: th.BasicUser.Id})
+		require.NoError(t, err)
+
+		// Removing the membership directly skips the leave-channel clean-up.
+		require.NoError(t, th.App.Srv().Store().Channel().RemoveMember(th.Context, private.Id, th.BasicUser.Id))
+
+		reminders, _, err := client.GetPostReminders(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		for _, reminder := range reminders {
+			assert.NotEqual(t, post.Id, reminder.PostId)
+		}
+	})
+}
+
 func TestPostGetInfo(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
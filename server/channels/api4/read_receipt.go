@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitReadReceipt() {
+	api.BaseRoutes.Post.Handle("/read_receipts", api.APISessionRequired(getPostReadReceipts)).Methods(http.MethodGet)
+}
+
+func getPostReadReceipts(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	post, appErr := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	receipts, appErr := c.App.GetPostReadReceipts(c.AppContext, post)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(receipts); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
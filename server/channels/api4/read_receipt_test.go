@@ -0,0 +1,162 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestGetPostReadReceipts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dm := th.CreateDmChannel(th.BasicUser2)
+	post := th.CreatePostWithClient(th.Client, dm)
+
+	view := func(user *model.User, channelID string) {
+		_, appErr := th.App.MarkChannelsAsViewed(th.Context, []string{channelID}, user.Id, "", false, false)
+		require.Nil(t, appErr)
+	}
+	share := func(user *model.User, value string) {
+		appErr := th.App.UpdatePreferences(th.Context, user.Id, model.Preferences{{
+			UserId:   user.Id,
+			Category: model.PreferenceCategoryDisplaySettings,
+			Name:     model.PreferenceNameShareReadReceipts,
+			Value:    value,
+		}})
+		require.Nil(t, appErr)
+	}
+	seenBy := func(receipts *model.PostReadReceipts) []string {
+		ids := []string{}
+		for _, receipt := range receipts.SeenBy {
+			ids = append(ids, receipt.UserId)
+		}
+		return ids
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.GetPostReadReceipts(context.Background(), post.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.EnableReadReceipts = model.NewPointer(true) })
+
+	t.Run("not seen yet", func(t *testing.T) {
+		share(th.BasicUser2, "true")
+
+		receipts, _, err := th.Client.GetPostReadReceipts(context.Background(), post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, post.Id, receipts.PostId)
+		assert.Empty(t, receipts.SeenBy)
+	})
+
+	t.Run("seen by a user who shares read receipts", func(t *testing.T) {
+		wsClient, err := th.CreateWebSocketClient()
+		require.NoError(t, err)
+		defer wsClient.Close()
+		wsClient.Listen()
+
+		view(th.BasicUser2, dm.Id)
+
+		receipts, _, err := th.Client.GetPostReadReceipts(context.Background(), post.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{th.BasicUser2.Id}, seenBy(receipts))
+		assert.GreaterOrEqual(t, receipts.SeenBy[0].LastViewedAt, post.CreateAt)
+
+		member, _, err := th.Client.GetChannelMember(context.Background(), dm.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, member.LastViewedAt, post.CreateAt)
+
+		var caught bool
+		func() {
+			for {
+				select {
+				case ev := <-wsClient.EventChannel:
+					if ev.EventType() != model.WebsocketEventReadReceipt {
+						continue
+					}
+					caught = true
+
+					var receipt model.ReadReceipt
+					require.NoError(t, json.Unmarshal([]byte(ev.GetData()["read_receipt"].(string)), &receipt))
+					assert.Equal(t, th.BasicUser2.Id, receipt.UserId)
+					assert.Equal(t, dm.Id, receipt.ChannelId)
+					assert.Equal(t, post.CreateAt, receipt.LastViewedAt)
+					return
+				case <-time.After(5 * time.Second):
+					return
+				}
+			}
+		}()
+		require.Truef(t, caught, "User should have received %s event", model.WebsocketEventReadReceipt)
+	})
+
+	t.Run("seen by a user who hides read receipts", func(t *testing.T) {
+		share(th.BasicUser2, "false")
+		defer share(th.BasicUser2, "true")
+
+		receipts, _, err := th.Client.GetPostReadReceipts(context.Background(), post.Id)
+		require.NoError(t, err)
+		assert.Empty(t, receipts.SeenBy)
+
+		member, _, err := th.Client.GetChannelMember(context.Background(), dm.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.Zero(t, member.LastViewedAt)
+
+		members, _, err := th.Client.GetChannelMembers(context.Background(), dm.Id, 0, 10, "")
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+		for _, member := range members {
+			if member.UserId == th.BasicUser2.Id {
+				assert.Zero(t, member.LastViewedAt)
+			}
+		}
+
+		member, _, err = th.SystemAdminClient.GetChannelMember(context.Background(), dm.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.Zero(t, member.LastViewedAt)
+
+		// Users still see when they last viewed the channel themselves.
+		th.LoginBasic2()
+		defer th.LoginBasic()
+		member, _, err = th.Client.GetChannelMember(context.Background(), dm.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, member.LastViewedAt, post.CreateAt)
+	})
+
+	t.Run("channel over the member cap", func(t *testing.T) {
+		channelPost := th.CreatePost()
+
+		_, resp, err := th.Client.GetPostReadReceipts(context.Background(), channelPost.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.ReadReceiptsMaxChannelMembers = model.NewPointer(10) })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.ReadReceiptsMaxChannelMembers = model.NewPointer(0) })
+
+		view(th.BasicUser2, th.BasicChannel.Id)
+
+		receipts, _, err := th.Client.GetPostReadReceipts(context.Background(), channelPost.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{th.BasicUser2.Id}, seenBy(receipts))
+	})
+
+	t.Run("post the user can't read", func(t *testing.T) {
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+		privatePost := th.CreatePostWithClient(th.SystemAdminClient, private)
+
+		_, resp, err := th.Client.GetPostReadReceipts(context.Background(), privatePost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
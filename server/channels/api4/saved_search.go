@@ -0,0 +1,202 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitSavedSearch() {
+	api.BaseRoutes.User.Handle("/saved_searches", api.APISessionRequired(createSavedSearch)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/saved_searches", api.APISessionRequired(getSavedSearches)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/saved_searches/{saved_search_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchSavedSearch)).Methods(http.MethodPut)
+	api.BaseRoutes.User.Handle("/saved_searches/{saved_search_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteSavedSearch)).Methods(http.MethodDelete)
+	api.BaseRoutes.User.Handle("/saved_searches/{saved_search_id:[A-Za-z0-9]+}/run", api.APISessionRequired(runSavedSearch)).Methods(http.MethodPost)
+}
+
+func createSavedSearch(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var search model.SavedSearch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&search); jsonErr != nil {
+		c.SetInvalidParamWithErr("saved_search", jsonErr)
+		return
+	}
+	search.UserId = c.Params.UserId
+
+	auditRec := c.MakeAuditRecord("createSavedSearch", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "saved_search", &search)
+
+	// Users can only save their own searches.
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if search.TeamId != "" && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), search.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	saved, appErr := c.App.CreateSavedSearch(c.AppContext, &search)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(saved)
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(saved); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getSavedSearches(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	searches, appErr := c.App.GetSavedSearchesForUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(searches); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// getSavedSearchOfUser returns the saved search of the request, checking it belongs to the user
+// of the session.
+func getSavedSearchOfUser(c *Context) *model.SavedSearch {
+	c.RequireUserId().RequireSavedSearchId()
+	if c.Err != nil {
+		return nil
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return nil
+	}
+
+	search, appErr := c.App.GetSavedSearch(c.Params.SavedSearchId)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	if search.UserId != c.Params.UserId {
+		c.Err = model.NewAppError("getSavedSearchOfUser", "app.saved_search.get.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return search
+}
+
+func patchSavedSearch(c *Context, w http.ResponseWriter, r *http.Request) {
+	var patch model.SavedSearchPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("saved_search", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchSavedSearch", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "saved_search_id", c.Params.SavedSearchId)
+
+	search := getSavedSearchOfUser(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(search)
+
+	updated, appErr := c.App.PatchSavedSearch(search, &patch)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(updated)
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteSavedSearch(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("deleteSavedSearch", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "saved_search_id", c.Params.SavedSearchId)
+
+	search := getSavedSearchOfUser(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(search)
+
+	if appErr := c.App.DeleteSavedSearch(search.Id); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func runSavedSearch(c *Context, w http.ResponseWriter, r *http.Request) {
+	search := getSavedSearchOfUser(c)
+	if c.Err != nil {
+		return
+	}
+
+	startTime := time.Now()
+
+	results, appErr := c.App.RunSavedSearch(c.AppContext, search, c.Params.Page, c.Params.PerPage)
+
+	elapsedTime := float64(time.Since(startTime)) / float64(time.Second)
+	if metrics := c.App.Metrics(); metrics != nil {
+		metrics.IncrementPostsSearchCounter()
+		metrics.ObservePostsSearchDuration(elapsedTime)
+	}
+
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	clientPostList := c.App.PreparePostListForClient(c.AppContext, results.PostList)
+	clientPostList, appErr = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	results = model.MakePostSearchResults(clientPostList, results.Matches)
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := results.EncodeJSON(w); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSavedSearches(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	post := th.CreatePost()
+
+	search, resp, err := th.Client.CreateSavedSearch(context.Background(), &model.SavedSearch{
+		UserId: th.BasicUser.Id,
+		TeamId: th.BasicTeam.Id,
+		Name:   "Basic post",
+		Terms:  post.Message,
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.NotEmpty(t, search.Id)
+	require.NotEmpty(t, search.Params)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, resp, err := th.Client.CreateSavedSearch(context.Background(), &model.SavedSearch{UserId: th.BasicUser.Id, Terms: "term"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("users can only manage their own searches", func(t *testing.T) {
+		_, resp, err := th.Client.CreateSavedSearch(context.Background(), &model.SavedSearch{UserId: th.BasicUser2.Id, Name: "name", Terms: "term"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetSavedSearches(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err = th.Client.RunSavedSearch(context.Background(), th.BasicUser2.Id, search.Id, 0, 10)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		resp, err = th.Client.DeleteSavedSearch(context.Background(), th.BasicUser2.Id, search.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("team the user isn't a member of", func(t *testing.T) {
+		team := th.CreateTeamWithClient(th.SystemAdminClient)
+		_, resp, err := th.Client.CreateSavedSearch(context.Background(), &model.SavedSearch{UserId: th.BasicUser.Id, TeamId: team.Id, Name: "name", Terms: "term"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		searches, _, err := th.Client.GetSavedSearches(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, searches, 1)
+		assert.Equal(t, search.Id, searches[0].Id)
+	})
+
+	t.Run("run", func(t *testing.T) {
+		results, _, err := th.Client.RunSavedSearch(context.Background(), th.BasicUser.Id, search.Id, 0, 10)
+		require.NoError(t, err)
+		require.Contains(t, results.PostList.Posts, post.Id)
+	})
+
+	t.Run("patch", func(t *testing.T) {
+		patched, _, err := th.Client.PatchSavedSearch(context.Background(), th.BasicUser.Id, search.Id, &model.SavedSearchPatch{
+			Name:  model.NewPointer("Renamed"),
+			Alert: model.NewPointer(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", patched.Name)
+		assert.Equal(t, search.Terms, patched.Terms)
+		assert.True(t, patched.Alert)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := th.Client.DeleteSavedSearch(context.Background(), th.BasicUser.Id, search.Id)
+		require.NoError(t, err)
+
+		resp, err := th.Client.DeleteSavedSearch(context.Background(), th.BasicUser.Id, search.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -45,6 +45,7 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.System.Handle("/timezones", api.APISessionRequired(getSupportedTimezones)).Methods(http.MethodGet)
 
 	api.BaseRoutes.APIRoot.Handle("/audits", api.APISessionRequired(getAudits)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/audits/events", api.APISessionRequired(searchAuditEvents)).Methods(http.MethodGet)
 	api.BaseRoutes.APIRoot.Handle("/email/test", api.APISessionRequired(testEmail)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/site_url/test", api.APISessionRequired(testSiteURL)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/file/s3_test", api.APISessionRequired(testS3)).Methods(http.MethodPost)
@@ -317,6 +318,61 @@ func getAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func searchAuditEvents(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("searchAuditEvents", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := model.AuditEventSearchOpts{
+		UserId:    query.Get("user_id"),
+		EventName: query.Get("event_name"),
+		Page:      c.Params.Page,
+		PerPage:   c.Params.PerPage,
+	}
+
+	if sinceString := query.Get("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil {
+			c.SetInvalidURLParam("since")
+			return
+		}
+		opts.Since = since
+	}
+
+	if untilString := query.Get("until"); untilString != "" {
+		until, err := strconv.ParseInt(untilString, 10, 64)
+		if err != nil {
+			c.SetInvalidURLParam("until")
+			return
+		}
+		opts.Until = until
+	}
+
+	audit.AddEventParameter(auditRec, "user_id", opts.UserId)
+	audit.AddEventParameter(auditRec, "event_name", opts.EventName)
+	audit.AddEventParameter(auditRec, "since", opts.Since)
+	audit.AddEventParameter(auditRec, "until", opts.Until)
+	audit.AddEventParameter(auditRec, "page", opts.Page)
+	audit.AddEventParameter(auditRec, "per_page", opts.PerPage)
+
+	events, appErr := c.App.SearchAuditEvents(c.AppContext, opts)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(events); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func databaseRecycle(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionRecycleDatabaseConnections) {
 		c.SetPermissionError(model.PermissionRecycleDatabaseConnections)
@@ -137,6 +137,46 @@ func TestGetAudits(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestSearchAuditEvents(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.DatabaseEnabled = true })
+
+	since := model.GetMillis()
+	_, _, err := client.PatchUser(context.Background(), th.BasicUser.Id, &model.UserPatch{Nickname: model.NewPointer("audited")})
+	require.NoError(t, err)
+
+	opts := model.AuditEventSearchOpts{UserId: th.BasicUser.Id, EventName: "patchUser", Since: since}
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		events, _, err := th.SystemAdminClient.GetAuditEvents(context.Background(), opts)
+		require.NoError(c, err)
+		require.Len(c, events, 1)
+		assert.Equal(c, "success", events[0].Status)
+		assert.Equal(c, th.BasicUser.Id, events[0].UserId)
+		assert.Contains(c, string(events[0].Record), "patchUser")
+	}, 5*time.Second, 100*time.Millisecond)
+
+	t.Run("time range excludes the event", func(t *testing.T) {
+		events, _, err := th.SystemAdminClient.GetAuditEvents(context.Background(), model.AuditEventSearchOpts{UserId: th.BasicUser.Id, Until: since - 1})
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		resp, err := th.SystemAdminClient.DoAPIGet(context.Background(), "/audits/events?since=yesterday", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, model.BuildResponse(resp))
+	})
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := client.GetAuditEvents(context.Background(), opts)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestEmailTest(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -1537,6 +1537,9 @@ func inviteGuestsToChannels(c *Context, w http.ResponseWriter, r *http.Request)
 	for i, email := range guestsInvite.Emails {
 		guestsInvite.Emails[i] = strings.ToLower(email)
 	}
+	if guestsInvite.SponsorId == "" {
+		guestsInvite.SponsorId = c.AppContext.Session().UserId
+	}
 	if appErr := guestsInvite.IsValid(); appErr != nil {
 		c.Err = appErr
 		return
@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitTranslation() {
+	api.BaseRoutes.Post.Handle("/translate", api.APISessionRequired(translatePost)).Methods(http.MethodPost)
+	api.BaseRoutes.Post.Handle("/thread/translate", api.APISessionRequired(translatePostThread)).Methods(http.MethodPost)
+
+	api.BaseRoutes.Channel.Handle("/translation", api.APISessionRequired(getChannelTranslationSettings)).Methods(http.MethodGet)
+	api.BaseRoutes.Channel.Handle("/translation", api.APISessionRequired(updateChannelTranslationSettings)).Methods(http.MethodPut)
+}
+
+// translationLocale returns the locale of the request, defaulting to the locale of the user.
+func translationLocale(c *Context, r *http.Request) string {
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		user, err := c.App.GetUser(c.AppContext.Session().UserId)
+		if err != nil {
+			c.Err = err
+			return ""
+		}
+		locale = user.Locale
+	}
+
+	if locale == "" || !model.IsValidLocale(locale) {
+		c.SetInvalidURLParam("locale")
+		return ""
+	}
+
+	return locale
+}
+
+func translatePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	locale := translationLocale(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	post, appErr := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	translation, appErr := c.App.TranslatePost(c.AppContext, post, locale)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(translation); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func translatePostThread(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	locale := translationLocale(c, r)
+	if c.Err != nil {
+		return
+	}
+
+	post, appErr := c.App.GetPostIfAuthorized(c.AppContext, c.Params.PostId, c.AppContext.Session(), false)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	translations, appErr := c.App.TranslatePostThread(c.AppContext, post.Id, locale)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(translations); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getChannelTranslationSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	settings, appErr := c.App.GetChannelTranslationSettings(c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateChannelTranslationSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	var settings model.ChannelTranslationSettings
+	if jsonErr := json.NewDecoder(r.Body).Decode(&settings); jsonErr != nil {
+		c.SetInvalidParamWithErr("translation_settings", jsonErr)
+		return
+	}
+	settings.ChannelId = c.Params.ChannelId
+
+	auditRec := c.MakeAuditRecord("updateChannelTranslationSettings", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "translation_settings", &settings)
+
+	channel, appErr := c.App.GetChannel(c.AppContext, c.Params.ChannelId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	switch channel.Type {
+	case model.ChannelTypeOpen:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePublicChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePublicChannelProperties)
+			return
+		}
+	case model.ChannelTypePrivate, model.ChannelTypeGroup:
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionManagePrivateChannelProperties) {
+			c.SetPermissionError(model.PermissionManagePrivateChannelProperties)
+			return
+		}
+	default:
+		// Direct messages aren't linked to any specific permission, so just check for membership.
+		if _, appErr = c.App.GetChannelMember(c.AppContext, c.Params.ChannelId, c.AppContext.Session().UserId); appErr != nil {
+			c.SetPermissionError(model.PermissionReadChannel)
+			return
+		}
+	}
+
+	if !*c.App.Config().TranslationSettings.Enable {
+		c.Err = model.NewAppError("updateChannelTranslationSettings", "app.translation.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	updated, appErr := c.App.UpdateChannelTranslationSettings(&settings)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updated)
+
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
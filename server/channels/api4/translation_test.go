@@ -0,0 +1,185 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/translation/translationtest"
+)
+
+func TestTranslatePost(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server := translationtest.NewServer()
+	defer server.Close()
+
+	post := th.CreatePost()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.TranslatePost(context.Background(), post.Id, "de")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.TranslationSettings.Enable = model.NewPointer(true)
+		cfg.TranslationSettings.LibreTranslateURL = model.NewPointer(server.URL)
+	})
+
+	translation, _, err := th.Client.TranslatePost(context.Background(), post.Id, "de")
+	require.NoError(t, err)
+	assert.Equal(t, post.Id, translation.PostId)
+	assert.Equal(t, "de", translation.Locale)
+	assert.Equal(t, translationtest.Translate(post.Message, "de"), translation.Message)
+	assert.Equal(t, translationtest.SourceLanguage, translation.SourceLanguage)
+	assert.Equal(t, model.TranslationProviderLibreTranslate, translation.Provider)
+	require.Len(t, server.Requests(), 1)
+
+	t.Run("cached until the post is edited", func(t *testing.T) {
+		cached, _, err := th.Client.TranslatePost(context.Background(), post.Id, "de")
+		require.NoError(t, err)
+		assert.Equal(t, translation.Message, cached.Message)
+		require.Len(t, server.Requests(), 1)
+
+		edited, _, err := th.Client.PatchPost(context.Background(), post.Id, &model.PostPatch{Message: model.NewPointer("edited")})
+		require.NoError(t, err)
+
+		translation, _, err := th.Client.TranslatePost(context.Background(), post.Id, "de")
+		require.NoError(t, err)
+		assert.Equal(t, translationtest.Translate("edited", "de"), translation.Message)
+		assert.Equal(t, edited.EditAt, translation.PostEditAt)
+		require.Len(t, server.Requests(), 2)
+	})
+
+	t.Run("the locale of the user by default", func(t *testing.T) {
+		translation, _, err := th.Client.TranslatePost(context.Background(), post.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Locale, translation.Locale)
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		_, resp, err := th.Client.TranslatePost(context.Background(), post.Id, "not a locale")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("post the user can't read", func(t *testing.T) {
+		private := th.CreatePostWithClient(th.Client, th.CreatePrivateChannel())
+
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := th.Client.TranslatePost(context.Background(), private.Id, "de")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.TranslatePostThread(context.Background(), private.Id, "de")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("thread", func(t *testing.T) {
+		root := th.CreatePost()
+		reply, _, err := th.Client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, RootId: root.Id, Message: "reply"})
+		require.NoError(t, err)
+
+		translations, _, err := th.Client.TranslatePostThread(context.Background(), reply.Id, "fr")
+		require.NoError(t, err)
+		require.Len(t, translations, 2)
+		assert.Equal(t, root.Id, translations[0].PostId)
+		assert.Equal(t, translationtest.Translate(root.Message, "fr"), translations[0].Message)
+		assert.Equal(t, reply.Id, translations[1].PostId)
+		assert.Equal(t, translationtest.Translate("reply", "fr"), translations[1].Message)
+
+		requests := server.Requests()
+		assert.Equal(t, []string{root.Message, "reply"}, requests[len(requests)-1].Texts)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.TranslationSettings.LibreTranslateURL = model.NewPointer(server.URL + "/missing")
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) { cfg.TranslationSettings.LibreTranslateURL = model.NewPointer(server.URL) })
+
+		_, resp, err := th.Client.TranslatePost(context.Background(), th.CreatePost().Id, "de")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+}
+
+func TestChannelTranslationSettings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server := translationtest.NewServer()
+	defer server.Close()
+
+	settings, _, err := th.Client.GetChannelTranslationSettings(context.Background(), th.BasicChannel.Id)
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicChannel.Id, settings.ChannelId)
+	assert.False(t, settings.AutoTranslate)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateChannelTranslationSettings(context.Background(), th.BasicChannel.Id, &model.ChannelTranslationSettings{AutoTranslate: true})
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.TranslationSettings.Enable = model.NewPointer(true)
+		cfg.TranslationSettings.LibreTranslateURL = model.NewPointer(server.URL)
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionManagePublicChannelProperties.Id, model.ChannelUserRoleId)
+
+		_, resp, err := th.Client.UpdateChannelTranslationSettings(context.Background(), th.BasicChannel.Id, &model.ChannelTranslationSettings{AutoTranslate: true})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("channel the user isn't a member of", func(t *testing.T) {
+		private := th.CreateChannelWithClient(th.SystemAdminClient, model.ChannelTypePrivate)
+
+		_, resp, err := th.Client.GetChannelTranslationSettings(context.Background(), private.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	settings, _, err = th.Client.UpdateChannelTranslationSettings(context.Background(), th.BasicChannel.Id, &model.ChannelTranslationSettings{AutoTranslate: true})
+	require.NoError(t, err)
+	assert.True(t, settings.AutoTranslate)
+
+	settings, _, err = th.Client.GetChannelTranslationSettings(context.Background(), th.BasicChannel.Id)
+	require.NoError(t, err)
+	assert.True(t, settings.AutoTranslate)
+
+	t.Run("new posts are translated into the locales of the members", func(t *testing.T) {
+		post := th.CreateMessagePostWithClient(th.Client, th.BasicChannel, "auto")
+
+		require.Eventually(t, func() bool {
+			for _, request := range server.Requests() {
+				if len(request.Texts) == 1 && request.Texts[0] == "auto" && request.Target == th.BasicUser.Locale {
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 100*time.Millisecond)
+
+		require.Eventually(t, func() bool {
+			translations, err := th.App.Srv().Store().Translation().GetPostTranslations([]string{post.Id}, th.BasicUser.Locale)
+			return err == nil && len(translations) == 1
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
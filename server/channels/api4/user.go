@@ -66,12 +66,15 @@ func (api *API) InitUser() {
 	api.BaseRoutes.Users.Handle("/login/desktop_token", api.RateLimitedHandler(api.APIHandler(loginWithDesktopToken), model.RateLimitSettings{PerSec: model.NewPointer(2), MaxBurst: model.NewPointer(1)})).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/switch", api.APIHandler(switchAccountType)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/cws", api.APIHandlerTrustRequester(loginCWS)).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/login/location/verify", api.APIHandler(verifyLoginLocation)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/logout", api.APIHandler(logout)).Methods(http.MethodPost)
 
 	api.BaseRoutes.UserByUsername.Handle("", api.APISessionRequired(getUserByUsername)).Methods(http.MethodGet)
 	api.BaseRoutes.UserByEmail.Handle("", api.APISessionRequired(getUserByEmail)).Methods(http.MethodGet)
 
 	api.BaseRoutes.User.Handle("/sessions", api.APISessionRequired(getSessions)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/sessions/devices", api.APISessionRequired(getSessionDevices)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/sessions/devices/name", api.APISessionRequired(updateSessionDeviceName)).Methods(http.MethodPut)
 	api.BaseRoutes.User.Handle("/sessions/revoke", api.APISessionRequired(revokeSession)).Methods(http.MethodPost)
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods(http.MethodPost)
@@ -224,6 +227,7 @@ func getUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.App.SanitizeProfile(user, c.IsSystemAdmin())
 	}
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
+	c.App.UpdateSessionIPAddressIfNeeded(c.AppContext, c.AppContext.Session())
 	w.Header().Set(model.HeaderEtagServer, etag)
 	if err := json.NewEncoder(w).Encode(user); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
@@ -925,6 +929,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		w.Header().Set(model.HeaderEtagServer, etag)
 	}
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
+	c.App.UpdateSessionIPAddressIfNeeded(c.AppContext, c.AppContext.Session())
 
 	js, err := json.Marshal(profiles)
 	if err != nil {
@@ -2123,6 +2128,82 @@ func getSessions(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func getSessionDevices(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	devices, appErr := c.App.GetSessionDevices(c.AppContext, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(devices); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateSessionDeviceName(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateSessionDeviceName", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	sessionId := props["session_id"]
+	if sessionId == "" {
+		c.SetInvalidParam("session_id")
+		return
+	}
+	audit.AddEventParameter(auditRec, "session_id", sessionId)
+
+	name := props["name"]
+	if !model.IsValidSessionDeviceName(name) {
+		c.SetInvalidParam("name")
+		return
+	}
+	audit.AddEventParameter(auditRec, "name", name)
+
+	session, appErr := c.App.GetSessionById(c.AppContext, sessionId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventPriorState(session)
+	auditRec.AddEventObjectType("session")
+
+	if session.UserId != c.Params.UserId {
+		c.SetInvalidURLParam("user_id")
+		return
+	}
+
+	if appErr := c.App.UpdateSessionDeviceName(c.AppContext, session, name); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(session)
+
+	ReturnStatusOK(w)
+}
+
 func revokeSession(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -2365,6 +2446,29 @@ func verifyUserEmail(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func verifyLoginLocation(c *Context, w http.ResponseWriter, r *http.Request) {
+	props := model.MapFromJSON(r.Body)
+
+	token := props["token"]
+	if len(token) != model.TokenSize {
+		c.SetInvalidParam("token")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("verifyLoginLocation", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if err := c.App.VerifyLoginLocation(c.AppContext, token); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("Login location verified")
+
+	ReturnStatusOK(w)
+}
+
 func sendVerificationEmail(c *Context, w http.ResponseWriter, r *http.Request) {
 	props := model.MapFromJSON(r.Body)
 
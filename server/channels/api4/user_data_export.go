@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitUserDataExport() {
+	api.BaseRoutes.User.Handle("/data_export", api.APISessionRequired(requestUserDataExport)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/data_export", api.APISessionRequired(getUserDataExport)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/data_export/{job_id:[A-Za-z0-9]+}/download", api.APISessionRequiredTrustRequester(downloadUserDataExport)).Methods(http.MethodGet)
+}
+
+func requestUserDataExport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("requestUserDataExport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	// Users can only export their own data.
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	export, appErr := c.App.RequestUserDataExport(c.AppContext, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(export)
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(export); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getUserDataExport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	export, appErr := c.App.GetLatestUserDataExport(c.AppContext, c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(export); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func downloadUserDataExport(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequireJobId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("downloadUserDataExport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "job_id", c.Params.JobId)
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	file, appErr := c.App.GetUserDataExportFile(c.Params.UserId, c.Params.JobId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	defer file.Close()
+
+	auditRec.Success()
+
+	name := "user_data_export_" + c.Params.JobId + ".zip"
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+name+"\"")
+	http.ServeContent(w, r, name, time.Time{}, file)
+}
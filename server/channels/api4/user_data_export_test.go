@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestUserDataExport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.Client.RequestUserDataExport(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExportSettings.EnableUserDataExport = true })
+
+	t.Run("users can only export their own data", func(t *testing.T) {
+		_, resp, err := th.Client.RequestUserDataExport(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.RequestUserDataExport(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("no export yet", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserDataExport(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	export, resp, err := th.Client.RequestUserDataExport(context.Background(), th.BasicUser.Id)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, export.UserId)
+
+	t.Run("rate limited", func(t *testing.T) {
+		_, resp, err := th.Client.RequestUserDataExport(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	})
+
+	t.Run("get the latest export", func(t *testing.T) {
+		latest, _, err := th.Client.GetUserDataExport(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, export.JobId, latest.JobId)
+		assert.NotEmpty(t, latest.Status)
+
+		_, resp, err := th.Client.GetUserDataExport(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("download an export that isn't ready", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, resp, err := th.Client.DownloadUserDataExport(context.Background(), th.BasicUser.Id, export.JobId, &buf)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -3556,6 +3556,84 @@ func TestGetSessions(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetSessionDevices(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+
+	devices, _, err := th.Client.GetSessionDevices(context.Background(), user.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, devices)
+
+	var current *model.SessionDevice
+	for _, device := range devices {
+		if device.IsCurrent {
+			current = device
+		}
+	}
+	require.NotNil(t, current, "current session should be listed")
+	assert.NotEmpty(t, current.UserAgent)
+
+	_, resp, err := th.Client.GetSessionDevices(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, _, err = th.SystemAdminClient.GetSessionDevices(context.Background(), user.Id)
+	require.NoError(t, err)
+
+	th.Client.Logout(context.Background())
+	_, resp, err = th.Client.GetSessionDevices(context.Background(), user.Id)
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
+func TestUpdateSessionDeviceName(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user := th.BasicUser
+	session, appErr := th.App.GetSession(th.Client.AuthToken)
+	require.Nil(t, appErr)
+
+	t.Run("rename own device", func(t *testing.T) {
+		_, err := th.Client.UpdateSessionDeviceName(context.Background(), user.Id, session.Id, "Work laptop")
+		require.NoError(t, err)
+
+		devices, _, err := th.Client.GetSessionDevices(context.Background(), user.Id)
+		require.NoError(t, err)
+
+		var found bool
+		for _, device := range devices {
+			if device.SessionId == session.Id {
+				found = true
+				assert.Equal(t, "Work laptop", device.Name)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		resp, err := th.Client.UpdateSessionDeviceName(context.Background(), user.Id, session.Id, "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resp, err = th.Client.UpdateSessionDeviceName(context.Background(), user.Id, session.Id, strings.Repeat("a", model.SessionDeviceNameMaxRunes+1))
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("session of another user", func(t *testing.T) {
+		resp, err := th.Client.UpdateSessionDeviceName(context.Background(), th.BasicUser2.Id, session.Id, "Work laptop")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.UpdateSessionDeviceName(context.Background(), th.BasicUser2.Id, session.Id, "Work laptop")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestRevokeSessions(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3861,6 +3939,43 @@ func TestVerifyUserEmail(t *testing.T) {
 	CheckBadRequestStatus(t, resp)
 }
 
+func TestVerifyLoginLocation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	extra, err := json.Marshal(map[string]string{
+		"UserId":    th.BasicUser.Id,
+		"IPRange":   "203.0.113.0/24",
+		"UserAgent": "Macintosh|Mac OS|Chrome",
+	})
+	require.NoError(t, err)
+
+	token := model.NewToken(app.TokenTypeLoginVerification, string(extra))
+	require.NoError(t, th.App.Srv().Store().Token().Save(token))
+
+	_, err = th.Client.VerifyLoginLocation(context.Background(), token.Token)
+	require.NoError(t, err)
+
+	fingerprint, err := th.App.Srv().Store().LoginFingerprint().Get(th.BasicUser.Id, model.LoginFingerprintTypeIPRange, "203.0.113.0/24")
+	require.NoError(t, err)
+	assert.NotZero(t, fingerprint.CreateAt)
+
+	resp, err := th.Client.VerifyLoginLocation(context.Background(), token.Token)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	resp, err = th.Client.VerifyLoginLocation(context.Background(), "")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	verifyEmailToken, err := th.App.Srv().EmailService.CreateVerifyEmailToken(th.BasicUser.Id, th.BasicUser.Email)
+	require.NoError(t, err)
+
+	resp, err = th.Client.VerifyLoginLocation(context.Background(), verifyEmailToken.Token)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestSendVerificationEmail(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -11,6 +11,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/app/platform"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
 	"github.com/mattermost/mattermost/server/v8/channels/web"
 )
 
@@ -52,6 +53,8 @@ func connectWebSocket(c *Context, w http.ResponseWriter, r *http.Request) {
 		PostedAck:     r.URL.Query().Get(postedAckParam) == "true",
 		RemoteAddress: c.AppContext.IPAddress(),
 		XForwardedFor: c.AppContext.XForwardedFor(),
+
+		TrustedRemoteAddress: utils.GetTrustedIPAddress(r, c.App.Config().ServiceSettings.TrustedProxyIPHeader),
 	}
 	// The WebSocket upgrade request coming from mobile is missing the
 	// user agent so we need to fallback on the session's metadata.
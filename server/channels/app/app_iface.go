@@ -54,6 +54,16 @@ type AppIface interface {
 	AddPublicKey(name string, key io.Reader) *model.AppError
 	// AddUserToChannel adds a user to a given channel.
 	AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
+	// ApplyPluginMigration runs a database migration of the given plugin and records it as applied.
+	// The migration SQL isn't restricted to the plugin's own tables, so every attempt is audited
+	// along with the statements it runs.
+	ApplyPluginMigration(pluginID string, migration *model.PluginMigration) *model.AppError
+	// ApprovePendingGuestInvite sends the emails of a pending guest invite on
+	// behalf of the member who sent it, and removes it from the pending invites.
+	ApprovePendingGuestInvite(rctx request.CTX, inviteID string) ([]*model.EmailInviteWithError, *model.AppError)
+	// ApprovePluginCapabilities grants the installed plugin every capability requested by its
+	// manifest, replacing any previous grant.
+	ApprovePluginCapabilities(pluginID string) (*model.Manifest, *model.AppError)
 	// Caller must close the first return value
 	ExportFileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
 	// Caller must close the first return value
@@ -71,6 +81,16 @@ type AppIface interface {
 	// If includeRemovedMembers is true, then channel members who left or were removed from the channel will
 	// be included; otherwise, they will be excluded.
 	ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, *model.AppError)
+	// CheckIPFilter returns an error if IPFilteringSettings don't allow the given
+	// session to connect from ipAddress, logging an audit record of the blocked
+	// attempt. Attempts from the same address and session are audited at most once
+	// per ipFilterBlockedAuditInterval, the next record counting those left out.
+	// Anonymous requests are checked using an empty session.
+	CheckIPFilter(rctx request.CTX, ipAddress string, session *model.Session) *model.AppError
+	// CheckIPFilterLockout returns an error if saving the given config would block
+	// the given session from connecting from ipAddress, so that admins cannot lock
+	// themselves out when changing IPFilteringSettings.
+	CheckIPFilterLockout(cfg *model.Config, ipAddress string, session *model.Session) *model.AppError
 	// CheckProviderAttributes returns the empty string if the patch can be applied without
 	// overriding attributes set by the user's login provider; otherwise, the name of the offending
 	// field is returned.
@@ -102,6 +122,9 @@ type AppIface interface {
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(c request.CTX, user *model.User) (*model.User, *model.AppError)
+	// CreatePluginJob creates a pending job of a job type registered by the given plugin. It is run
+	// by whichever server running the plugin claims it first.
+	CreatePluginJob(c request.CTX, pluginID, name string, data map[string]string) (*model.Job, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(c request.CTX, user *model.User) (*model.User, *model.AppError)
@@ -125,6 +148,8 @@ type AppIface interface {
 	DeleteGroupConstrainedMemberships(rctx request.CTX) error
 	// DeletePersistentNotification stops the persistent notifications.
 	DeletePersistentNotification(c request.CTX, post *model.Post) *model.AppError
+	// DeletePostReminder cancels the reminder of the post for the user.
+	DeletePostReminder(postID, userID string) *model.AppError
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his membership's roles from
@@ -133,8 +158,9 @@ type AppIface interface {
 	// DetachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	DetachPlugin(pluginId string) *model.AppError
 	// DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
+	// Unless forced, plugins that enabled plugins depend on can't be disabled.
 	// Notifies cluster peers through config change.
-	DisablePlugin(id string) *model.AppError
+	DisablePlugin(id string, force bool) *model.AppError
 	// DoPermissionsMigrations execute all the permissions migrations need by the current version.
 	DoPermissionsMigrations() error
 	// EnablePlugin will set the config for an installed plugin to enabled, triggering asynchronous
@@ -149,6 +175,16 @@ type AppIface interface {
 	// attributes of the attachment structure. The Slack attachment structure is
 	// documented here: https://api.slack.com/docs/attachments
 	ProcessSlackAttachments(attachments []*model.SlackAttachment) []*model.SlackAttachment
+	// ExportUserData writes the archive of a user_data_export job to the export
+	// directory, then emails the user a link to download it.
+	ExportUserData(rctx request.CTX, job *model.Job) *model.AppError
+	// ExtendGuestAccount moves the expiry date of a guest account to expiresAt,
+	// or by the configured number of expiry days if expiresAt is zero. Unless
+	// unbounded is set, as it is for system admins, expiresAt can't be further
+	// than the configured number of expiry days from now. A guest deactivated
+	// because their account had expired is reactivated, but not one deactivated
+	// for any other reason.
+	ExtendGuestAccount(rctx request.CTX, userID string, expiresAt int64, unbounded bool) (*model.GuestAccount, *model.AppError)
 	// ExtendSessionExpiryIfNeeded extends Session.ExpiresAt based on session lengths in config.
 	// A new ExpiresAt is only written if enough time has elapsed since last update.
 	// Returns true only if the session was extended.
@@ -171,6 +207,9 @@ type AppIface interface {
 	GetBot(rctx request.CTX, botUserId string, includeDeleted bool) (*model.Bot, *model.AppError)
 	// GetBots returns the requested page of bots.
 	GetBots(rctx request.CTX, options *model.BotGetOptions) (model.BotList, *model.AppError)
+	// GetChannelCatchUp returns the most important posts created in the channel since the time,
+	// which defaults to when the user last viewed the channel.
+	GetChannelCatchUp(c request.CTX, userID, channelID string, since int64, limit int) (*model.CatchUp, *model.AppError)
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
@@ -202,11 +241,27 @@ type AppIface interface {
 	GetLastAccessibleFileTime() (int64, *model.AppError)
 	// GetLastAccessiblePostTime returns CreateAt time(from cache) of the last accessible post as per the cloud limit
 	GetLastAccessiblePostTime() (int64, *model.AppError)
+	// GetLatestUserDataExport returns the most recent data export requested by the
+	// user, along with the status of the job producing it.
+	GetLatestUserDataExport(rctx request.CTX, userID string) (*model.UserDataExport, *model.AppError)
 	// GetLdapGroup retrieves a single LDAP group by the given LDAP group id.
 	GetLdapGroup(rctx request.CTX, ldapGroupID string) (*model.Group, *model.AppError)
 	// GetMarketplacePlugins returns a list of plugins from the marketplace-server,
 	// and plugins that are installed locally.
 	GetMarketplacePlugins(rctx request.CTX, filter *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.AppError)
+	// GetPluginJob returns a job of a job type registered by the given plugin.
+	GetPluginJob(c request.CTX, pluginID, jobID string) (*model.Job, *model.AppError)
+	// GetPluginJobTypes returns the job types currently registered by plugins.
+	GetPluginJobTypes() []string
+	// GetPluginKeyMetadata returns nil for non-existent keys.
+	GetPluginKeyMetadata(pluginID string, key string) (*model.PluginKVMetadata, *model.AppError)
+	// GetPluginKeys returns the values of the given keys that exist, indexed by key.
+	GetPluginKeys(pluginID string, keys []string) (map[string][]byte, *model.AppError)
+	// GetPluginMigrations returns the database migrations applied by the given plugin, ordered by
+	// version.
+	GetPluginMigrations(pluginID string) ([]*model.PluginMigration, *model.AppError)
+	// GetPluginPermissions returns the permissions registered by the active plugins.
+	GetPluginPermissions() ([]*model.Permission, *model.AppError)
 	// GetPluginStatus returns the status for a plugin installed on this server.
 	GetPluginStatus(id string) (*model.PluginStatus, *model.AppError)
 	// GetPluginStatuses returns the status for plugins installed on this server.
@@ -217,6 +272,12 @@ type AppIface interface {
 	// To get the plugins environment when the plugins are disabled, manually acquire the plugins
 	// lock instead.
 	GetPluginsEnvironment() *plugin.Environment
+	// GetPostReadReceipts returns the members of the channel of the post who have seen it, leaving
+	// out its author and the members who don't share their read receipts.
+	GetPostReadReceipts(c request.CTX, post *model.Post) (*model.PostReadReceipts, *model.AppError)
+	// GetPostRemindersForUser returns the pending reminders of the user for posts of the channels the
+	// user is still a member of.
+	GetPostRemindersForUser(rctx request.CTX, userID string) ([]*model.PostReminder, *model.AppError)
 	// GetPostsByIds response bool value indicates, if the post is inaccessible due to cloud plan's limit.
 	GetPostsByIds(postIDs []string) ([]*model.Post, int64, *model.AppError)
 	// GetPostsUsage returns the total posts count rounded down to the most
@@ -232,6 +293,9 @@ type AppIface interface {
 	GetSanitizedConfig() *model.Config
 	// GetSchemeRolesForChannel Checks if a channel or its team has an override scheme for channel roles and returns the scheme roles or default channel roles.
 	GetSchemeRolesForChannel(c request.CTX, channelID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetSessionDevices returns the device inventory for the user, built from their
+	// sessions. Sessions backing personal access tokens are not devices and are left out.
+	GetSessionDevices(c request.CTX, userID string) ([]*model.SessionDevice, *model.AppError)
 	// GetSessionLengthInMillis returns the session length, in milliseconds,
 	// based on the type of session (Mobile, SSO, Web/LDAP).
 	GetSessionLengthInMillis(session *model.Session) int64
@@ -243,8 +307,14 @@ type AppIface interface {
 	GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError)
 	// GetTeamSchemeChannelRoles Checks if a team has an override scheme and returns the scheme channel role names or default channel role names.
 	GetTeamSchemeChannelRoles(c request.CTX, teamID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetThreadCatchUp returns the most important posts created in the thread since the time, which
+	// defaults to when the user last viewed the thread, or the channel if the user doesn't follow it.
+	GetThreadCatchUp(c request.CTX, userID string, rootPost *model.Post, since int64, limit int) (*model.CatchUp, *model.AppError)
 	// GetTotalUsersStats is used for the DM list total
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
+	// GetUserDataExportFile returns a reader for the archive of the given export,
+	// as long as it belongs to the user and its download link hasn't expired.
+	GetUserDataExportFile(userID, jobID string) (filestore.ReadCloseSeeker, *model.AppError)
 	// GetUserStatusesByIds used by apiV4
 	GetUserStatusesByIds(userIDs []string) ([]*model.Status, *model.AppError)
 	// HasRemote returns whether a given channelID is present in the channel remotes or not.
@@ -303,23 +373,49 @@ type AppIface interface {
 	// PopulateWebConnConfig checks if the connection id already exists in the hub,
 	// and if so, accordingly populates the other fields of the webconn.
 	PopulateWebConnConfig(s *model.Session, cfg *platform.WebConnConfig, seqVal string) (*platform.WebConnConfig, error)
+	// ProcessGuestAccountExpiry is called periodically from the job server to
+	// deactivate the guests whose account has expired, then warn the guests whose
+	// account is about to expire, and their sponsors.
+	ProcessGuestAccountExpiry() error
 	// PromoteGuestToUser Convert user's roles and all his membership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(c request.CTX, user *model.User, requestorId string) *model.AppError
 	// ReattachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	ReattachPlugin(manifest *model.Manifest, pluginReattachConfig *model.PluginReattachConfig) *model.AppError
+	// RegisterPluginJob registers a job type owned by the given plugin with the job server. Jobs of
+	// that type are run through the plugin's RunPluginJob hook, and are also scheduled periodically
+	// when the definition has an interval. Registering a job again replaces its previous definition.
+	RegisterPluginJob(pluginID string, definition *model.PluginJobDefinition) *model.AppError
 	// Removes a listener function by the unique ID returned when AddConfigListener was called
 	RemoveConfigListener(id string)
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(c request.CTX, channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// RequestUserDataExport schedules an export of the user's own data. Users can
+	// request at most one export every ExportSettings.UserDataExportIntervalHours.
+	RequestUserDataExport(rctx request.CTX, userID string) (*model.UserDataExport, *model.AppError)
 	// ResolvePersistentNotification stops the persistent notifications, if a loggedInUserID(except the post owner) reacts, reply or ack on the post.
 	// Post-owner can only delete the original post to stop the notifications.
 	ResolvePersistentNotification(c request.CTX, post *model.Post, loggedInUserID string) *model.AppError
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RollbackBleveIndexes restores the Bleve indexes replaced by the last reindex.
+	RollbackBleveIndexes(c request.CTX) *model.AppError
+	// RollbackPluginMigrations reverts the migrations of the given plugin applied after toVersion,
+	// newest first, returning those rolled back. The plugin must not be running, since its code
+	// likely relies on the schema being rolled back.
+	RollbackPluginMigrations(pluginID string, toVersion int64) ([]*model.PluginMigration, *model.AppError)
+	// RunPluginJob runs a job claimed by this server through the RunPluginJob hook of the plugin
+	// owning its job type.
+	RunPluginJob(pluginID string, job *model.Job) error
+	// RunSavedSearch searches the posts matching the given saved search, as its user.
+	RunSavedSearch(c request.CTX, search *model.SavedSearch, page, perPage int) (*model.PostSearchResults, *model.AppError)
+	// SanitizeChannelMembersReadReceipts hides when the members last viewed their channels from
+	// anyone but themselves if read receipts are enabled, unless they share their read receipts, as
+	// the time would otherwise tell which posts they have seen.
+	SanitizeChannelMembersReadReceipts(members model.ChannelMembers, requesterID string) *model.AppError
 	// SanitizedConfig sanitizes a given configuration for a system admin without any secrets.
 	SanitizedConfig(cfg *model.Config)
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
@@ -328,6 +424,12 @@ type AppIface interface {
 	SearchAllChannels(c request.CTX, term string, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
 	SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError)
+	// SearchAuditEvents returns the audit events saved to the database that match the given options, newest first.
+	SearchAuditEvents(rctx request.CTX, opts model.AuditEventSearchOpts) ([]*model.AuditEvent, *model.AppError)
+	// SearchPostsForUserWithOptions searches the posts like SearchPostsForUser, sorting them and
+	// including snippets and facets in the results as requested by the options. Search backends
+	// that can't sort by relevance sort by time, and those that can't count facets return none.
+	SearchPostsForUserWithOptions(c request.CTX, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int, options model.PostSearchOptions) (*model.PostSearchResults, *model.AppError)
 	// SessionHasPermissionToChannels returns true only if user has access to all channels.
 	SessionHasPermissionToChannels(c request.CTX, session model.Session, channelIDs []string, permission *model.Permission) bool
 	// SessionHasPermissionToManageBot returns nil if the session has access to manage the given bot.
@@ -338,6 +440,11 @@ type AppIface interface {
 	SessionHasPermissionToTeams(c request.CTX, session model.Session, teamIDs []string, permission *model.Permission) bool
 	// SessionIsRegistered determines if a specific session has been registered
 	SessionIsRegistered(session model.Session) bool
+	// SetPluginJobProgress updates the progress of an in progress job of the given plugin.
+	SetPluginJobProgress(c request.CTX, pluginID, jobID string, progress int64) *model.AppError
+	// SetPostReminder reminds the user of the post at the target time of the reminder, which is
+	// resolved in the timezone of the user when it's set relative to now.
+	SetPostReminder(rctx request.CTX, postID, userID string, reminder *model.PostReminder) *model.AppError
 	// SetSessionExpireInHours sets the session's expiry the specified number of hours
 	// relative to either the session creation date or the current time, depending
 	// on the `ExtendSessionOnActivity` config setting.
@@ -349,6 +456,8 @@ type AppIface interface {
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userID string, activityAt int64)
+	// SubscribePluginWatchLogs streams the log entries of a watched plugin.
+	SubscribePluginWatchLogs(pluginID string) (*PluginWatchLogSubscription, *model.AppError)
 	// SyncLdap starts an LDAP sync job.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
@@ -385,6 +494,16 @@ type AppIface interface {
 	CreateZipFileAndAddFiles(fileBackend filestore.FileBackend, fileDatas []model.FileData, zipFileName, directory string) error
 	// This to be used for places we check the users password when they are already logged in
 	DoubleCheckPassword(rctx request.CTX, user *model.User, password string) *model.AppError
+	// TranslatePost translates the message of the post into the locale.
+	TranslatePost(c request.CTX, post *model.Post, locale string) (*model.PostTranslation, *model.AppError)
+	// TranslatePostThread translates the messages of the thread of the post into the locale, up to
+	// model.TranslationThreadMaxPosts of its oldest posts.
+	TranslatePostThread(c request.CTX, postID, locale string) ([]*model.PostTranslation, *model.AppError)
+	// UnregisterPluginJob stops running and scheduling the jobs of the given plugin job. Existing
+	// jobs are kept.
+	UnregisterPluginJob(pluginID, name string) *model.AppError
+	// UnwatchPlugin stops watching the given plugin. The plugin remains installed.
+	UnwatchPlugin(pluginID string) *model.AppError
 	// UpdateBotActive marks a bot as active or inactive, along with its corresponding user.
 	UpdateBotActive(rctx request.CTX, botUserId string, active bool) (*model.Bot, *model.AppError)
 	// UpdateBotOwner changes a bot's owner to the given value.
@@ -398,6 +517,11 @@ type AppIface interface {
 	UpdateDNDStatusOfUsers()
 	// UpdateProductNotices is called periodically from a scheduled worker to fetch new notices and update the cache
 	UpdateProductNotices() *model.AppError
+	// UpdateSessionDeviceName sets the user facing name of the device behind the session.
+	UpdateSessionDeviceName(c request.CTX, session *model.Session, name string) *model.AppError
+	// UpdateSessionIPAddressIfNeeded records the address the session was last used
+	// from, if it changed since the last time it was recorded.
+	UpdateSessionIPAddressIfNeeded(c request.CTX, session *model.Session)
 	// UpdateSharedChannelCursor updates the cursor for the specified channelID and remoteID.
 	// This can be used to manually set the point of last sync, either forward to skip older posts,
 	// or backward to re-sync history.
@@ -424,8 +548,14 @@ type AppIface interface {
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
 	// ValidateUserPermissionsOnChannels filters channelIds based on whether userId is authorized to manage channel members. Unauthorized channels are removed from the returned list.
 	ValidateUserPermissionsOnChannels(c request.CTX, userId string, channelIds []string) []string
+	// VerifyLoginLocation consumes a login location verification token, after which
+	// logins from that location are no longer held back.
+	VerifyLoginLocation(c request.CTX, tokenString string) *model.AppError
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
 	VerifyPlugin(plugin, signature io.ReadSeeker) *model.AppError
+	// WatchPlugin deploys the plugin found in the given local directory and keeps redeploying it
+	// whenever its manifest, server executables or webapp bundle change.
+	WatchPlugin(dir string) (*model.Manifest, *model.AppError)
 	// validateMoveOrCopy performs validation on a provided post list to determine
 	// if all permissions are in place to allow the for the posts to be moved or
 	// copied.
@@ -469,6 +599,7 @@ type AppIface interface {
 	AutocompleteChannelsForTeam(c request.CTX, teamID, userID, term string) (model.ChannelList, *model.AppError)
 	AutocompleteUsersInChannel(rctx request.CTX, teamID string, channelID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInChannel, *model.AppError)
 	AutocompleteUsersInTeam(rctx request.CTX, teamID string, term string, options *model.UserSearchOptions) (*model.UserAutocompleteInTeam, *model.AppError)
+	BatchPluginKeys(pluginID string, ops []*model.PluginKVBatchOperation) (bool, *model.AppError)
 	BuildPostReactions(ctx request.CTX, postID string) (*[]ReactionImportData, *model.AppError)
 	BuildPushNotificationMessage(c request.CTX, contentsConfig string, post *model.Post, user *model.User, channel *model.Channel, channelName string, senderName string, explicitMention bool, channelWideMention bool, replyToThreadType string) (*model.PushNotification, *model.AppError)
 	BuildSamlMetadataObject(idpMetadata []byte) (*model.SamlMetadataResponse, *model.AppError)
@@ -541,6 +672,7 @@ type AppIface interface {
 	CreateRetentionPolicy(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, *model.AppError)
 	CreateRole(role *model.Role) (*model.Role, *model.AppError)
 	CreateSamlRelayToken(extra string) (*model.Token, *model.AppError)
+	CreateSavedSearch(c request.CTX, search *model.SavedSearch) (*model.SavedSearch, *model.AppError)
 	CreateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError)
 	CreateSession(c request.CTX, session *model.Session) (*model.Session, *model.AppError)
 	CreateSidebarCategory(c request.CTX, userID, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError)
@@ -579,12 +711,14 @@ type AppIface interface {
 	DeleteIncomingWebhook(hookID string) *model.AppError
 	DeleteOAuthApp(rctx request.CTX, appID string) *model.AppError
 	DeleteOutgoingWebhook(hookID string) *model.AppError
+	DeletePendingGuestInvite(inviteID string) *model.AppError
 	DeletePluginKey(pluginID string, key string) *model.AppError
 	DeletePost(rctx request.CTX, postID, deleteByID string) (*model.Post, *model.AppError)
 	DeletePreferences(c request.CTX, userID string, preferences model.Preferences) *model.AppError
 	DeleteReactionForPost(c request.CTX, reaction *model.Reaction) *model.AppError
 	DeleteRemoteCluster(remoteClusterId string) (bool, *model.AppError)
 	DeleteRetentionPolicy(policyID string) *model.AppError
+	DeleteSavedSearch(id string) *model.AppError
 	DeleteScheme(schemeId string) (*model.Scheme, *model.AppError)
 	DeleteSharedChannelRemote(id string) (bool, error)
 	DeleteSidebarCategory(c request.CTX, userID, teamID, categoryId string) *model.AppError
@@ -664,6 +798,7 @@ type AppIface interface {
 	GetChannelMembersWithTeamDataForUserWithPagination(c request.CTX, userID string, page, perPage int) (model.ChannelMembersWithTeamData, *model.AppError)
 	GetChannelPinnedPostCount(c request.CTX, channelID string) (int64, *model.AppError)
 	GetChannelPoliciesForUser(userID string, offset, limit int) (*model.RetentionPolicyForChannelList, *model.AppError)
+	GetChannelTranslationSettings(channelID string) (*model.ChannelTranslationSettings, *model.AppError)
 	GetChannelUnread(c request.CTX, channelID, userID string) (*model.ChannelUnread, *model.AppError)
 	GetChannels(c request.CTX, channelIDs []string) ([]*model.Channel, *model.AppError)
 	GetChannelsByNames(c request.CTX, channelNames []string, teamID string) ([]*model.Channel, *model.AppError)
@@ -718,6 +853,7 @@ type AppIface interface {
 	GetGroupsByIDs(groupIDs []string) ([]*model.Group, *model.AppError)
 	GetGroupsBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError)
 	GetGroupsByUserId(userID string) ([]*model.Group, *model.AppError)
+	GetGuestAccount(userID string) (*model.GuestAccount, *model.AppError)
 	GetHubForUserId(userID string) *platform.Hub
 	GetIncomingWebhook(hookID string) (*model.IncomingWebhook, *model.AppError)
 	GetIncomingWebhooksCount(teamID string, userID string) (int64, *model.AppError)
@@ -762,6 +898,8 @@ type AppIface interface {
 	GetOutgoingWebhooksPage(page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetOutgoingWebhooksPageByUser(userID string, page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetPasswordRecoveryToken(token string) (*model.Token, *model.AppError)
+	GetPendingGuestInvite(inviteID string) (*model.PendingGuestInvite, *model.AppError)
+	GetPendingGuestInvites(page, perPage int) ([]*model.PendingGuestInvite, *model.AppError)
 	GetPermalinkPost(c request.CTX, postID string, userID string) (*model.PostList, *model.AppError)
 	GetPinnedPosts(c request.CTX, channelID string) (*model.PostList, *model.AppError)
 	GetPluginKey(pluginID string, key string) ([]byte, *model.AppError)
@@ -808,6 +946,8 @@ type AppIface interface {
 	GetSamlMetadata(c request.CTX) (string, *model.AppError)
 	GetSamlMetadataFromIdp(idpMetadataURL string) (*model.SamlMetadataResponse, *model.AppError)
 	GetSanitizeOptions(asAdmin bool) map[string]bool
+	GetSavedSearch(id string) (*model.SavedSearch, *model.AppError)
+	GetSavedSearchesForUser(userID string) ([]*model.SavedSearch, *model.AppError)
 	GetScheme(id string) (*model.Scheme, *model.AppError)
 	GetSchemeByName(name string) (*model.Scheme, *model.AppError)
 	GetSchemeRolesForTeam(teamID string) (string, string, string, *model.AppError)
@@ -957,6 +1097,7 @@ type AppIface interface {
 	ListExports() ([]string, *model.AppError)
 	ListImports() ([]string, *model.AppError)
 	ListPluginKeys(pluginID string, page, perPage int) ([]string, *model.AppError)
+	ListPluginKeysWithOptions(pluginID string, options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError)
 	ListTeamCommands(teamID string) ([]*model.Command, *model.AppError)
 	Log() *mlog.Logger
 	LoginByOAuth(c request.CTX, service string, userData io.Reader, teamID string, tokenUser *model.User) (*model.User, *model.AppError)
@@ -982,6 +1123,7 @@ type AppIface interface {
 	PatchRemoteCluster(rcId string, patch *model.RemoteClusterPatch) (*model.RemoteCluster, *model.AppError)
 	PatchRetentionPolicy(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, *model.AppError)
 	PatchRole(role *model.Role, patch *model.RolePatch) (*model.Role, *model.AppError)
+	PatchSavedSearch(search *model.SavedSearch, patch *model.SavedSearchPatch) (*model.SavedSearch, *model.AppError)
 	PatchScheme(scheme *model.Scheme, patch *model.SchemePatch) (*model.Scheme, *model.AppError)
 	PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *model.AppError)
 	PatchUser(c request.CTX, userID string, patch *model.UserPatch, asAdmin bool) (*model.User, *model.AppError)
@@ -1130,7 +1272,6 @@ type AppIface interface {
 	SetPluginKey(pluginID string, key string, value []byte) *model.AppError
 	SetPluginKeyWithExpiry(pluginID string, key string, value []byte, expireInSeconds int64) *model.AppError
 	SetPluginKeyWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError)
-	SetPostReminder(rctx request.CTX, postID, userID string, targetTime int64) *model.AppError
 	SetProfileImage(c request.CTX, userID string, imageData *multipart.FileHeader) *model.AppError
 	SetProfileImageFromFile(c request.CTX, userID string, file io.Reader) *model.AppError
 	SetProfileImageFromMultiPartFile(c request.CTX, userID string, file multipart.File) *model.AppError
@@ -1180,6 +1321,7 @@ type AppIface interface {
 	UpdateChannelMemberRoles(c request.CTX, channelID string, userID string, newRoles string) (*model.ChannelMember, *model.AppError)
 	UpdateChannelMemberSchemeRoles(c request.CTX, channelID string, userID string, isSchemeGuest bool, isSchemeUser bool, isSchemeAdmin bool) (*model.ChannelMember, *model.AppError)
 	UpdateChannelPrivacy(c request.CTX, oldChannel *model.Channel, user *model.User) (*model.Channel, *model.AppError)
+	UpdateChannelTranslationSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, *model.AppError)
 	UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command, *model.AppError)
 	UpdateConfig(f func(*model.Config))
 	UpdateDefaultProfileImage(c request.CTX, user *model.User) *model.AppError
@@ -4,6 +4,8 @@
 package app
 
 import (
+	"crypto/ecdsa"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -56,6 +58,21 @@ func (a *App) GetAuditsPage(rctx request.CTX, userID string, page int, perPage i
 	return audits, nil
 }
 
+// SearchAuditEvents returns the audit events saved to the database that match the given options, newest first.
+func (a *App) SearchAuditEvents(rctx request.CTX, opts model.AuditEventSearchOpts) ([]*model.AuditEvent, *model.AppError) {
+	events, err := a.Srv().Store().AuditEvent().Search(opts)
+	if err != nil {
+		var outErr *store.ErrOutOfBounds
+		switch {
+		case errors.As(err, &outErr):
+			return nil, model.NewAppError("SearchAuditEvents", "app.audit.get.limit.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("SearchAuditEvents", "app.audit_event.search.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	return events, nil
+}
+
 // LogAuditRec logs an audit record using default LvlAuditCLI.
 func (a *App) LogAuditRec(rctx request.CTX, rec *audit.Record, err error) {
 	a.LogAuditRecWithLevel(rctx, rec, mlog.LvlAuditCLI, err)
@@ -113,6 +130,16 @@ func (a *App) MakeAuditRecord(rctx request.CTX, event string, initialStatus stri
 func (s *Server) configureAudit(adt *audit.Audit, bAllowAdvancedLogging bool) error {
 	adt.OnQueueFull = s.onAuditTargetQueueFull
 	adt.OnError = s.onAuditError
+	adt.OnRecord = s.onAuditRecord
+	if s.auditEvents == nil {
+		s.auditEvents = newAuditEventWriter(s.saveAuditEvent, s.Log())
+	}
+
+	// The hash chain is only set up here, at startup, so changing its settings requires a restart.
+	auditSettings := s.platform.Config().ExperimentalAuditSettings
+	if *auditSettings.HashChainEnabled {
+		adt.EnableHashChain(*auditSettings.HashChainCheckpointInterval, s.signAuditCheckpoint)
+	}
 
 	var logConfigSrc config.LogConfigSrc
 	dsn := s.platform.Config().ExperimentalAuditSettings.GetAdvancedLoggingConfig()
@@ -156,3 +183,113 @@ func (s *Server) onAuditTargetQueueFull(qname string, maxQSize int) bool {
 func (s *Server) onAuditError(err error) {
 	s.Log().Error("Audit Error", mlog.Err(err))
 }
+
+// onAuditRecord saves the audit record to the database, if enabled, so that it can be searched.
+func (s *Server) onAuditRecord(level mlog.Level, rec audit.Record) {
+	if !*s.platform.Config().ExperimentalAuditSettings.DatabaseEnabled {
+		return
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		s.Log().Warn("Failed to encode audit record", mlog.String("event_name", rec.EventName), mlog.Err(err))
+		return
+	}
+
+	apiPath, _ := rec.Meta[audit.KeyAPIPath].(string)
+	event := &model.AuditEvent{
+		EventName:  rec.EventName,
+		Status:     rec.Status,
+		Level:      level.Name,
+		UserId:     rec.Actor.UserId,
+		SessionId:  rec.Actor.SessionId,
+		IpAddress:  rec.Actor.IpAddress,
+		ApiPath:    apiPath,
+		ObjectType: rec.EventData.ObjectType,
+		ChainId:    rec.ChainId,
+		Sequence:   rec.Sequence,
+		Hash:       rec.Hash,
+		Record:     data,
+	}
+
+	s.auditEvents.write(event)
+}
+
+func (s *Server) saveAuditEvent(event *model.AuditEvent) {
+	if _, err := s.Store().AuditEvent().Save(event); err != nil {
+		s.Log().Warn("Failed to save audit event", mlog.String("event_name", event.EventName), mlog.Err(err))
+	}
+}
+
+// auditEventQueueSize bounds the audit events waiting to be saved to the database.
+const auditEventQueueSize = 1000
+
+// auditEventWriter saves audit events to the database from a single goroutine,
+// dropping them when the queue is full rather than piling up goroutines.
+type auditEventWriter struct {
+	save   func(event *model.AuditEvent)
+	logger mlog.LoggerIFace
+
+	queue chan *model.AuditEvent
+	quit  chan struct{}
+	done  chan struct{}
+}
+
+func newAuditEventWriter(save func(event *model.AuditEvent), logger mlog.LoggerIFace) *auditEventWriter {
+	w := &auditEventWriter{
+		save:   save,
+		logger: logger,
+		queue:  make(chan *model.AuditEvent, auditEventQueueSize),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go w.run()
+
+	return w
+}
+
+func (w *auditEventWriter) write(event *model.AuditEvent) {
+	select {
+	case w.queue <- event:
+	default:
+		w.logger.Error("Audit event queue full, dropping event.", mlog.String("event_name", event.EventName), mlog.Int("queueSize", auditEventQueueSize))
+	}
+}
+
+func (w *auditEventWriter) run() {
+	defer close(w.done)
+
+	for {
+		select {
+		case event := <-w.queue:
+			w.save(event)
+		case <-w.quit:
+			// Save the events queued before stopping.
+			for {
+				select {
+				case event := <-w.queue:
+					w.save(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// stop saves the queued events and waits for the writer to finish.
+func (w *auditEventWriter) stop() {
+	close(w.quit)
+	<-w.done
+}
+
+// signAuditCheckpoint signs audit log checkpoints with the server's asymmetric signing key,
+// whose public key is published in the client config as AsymmetricSigningPublicKey.
+func (s *Server) signAuditCheckpoint(digest []byte) ([]byte, error) {
+	key := s.platform.AsymmetricSigningKey()
+	if key == nil {
+		return nil, errors.New("asymmetric signing key is not set")
+	}
+
+	return ecdsa.SignASN1(rand.Reader, key, digest)
+}
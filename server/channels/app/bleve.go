@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine"
+)
+
+func (a *App) initBlevePostIndexCheck() {
+	a.blevePostIndexCheck(a.Config())
+
+	a.AddConfigListener(func(oldConfig, newConfig *model.Config) {
+		oldBleveConfig := oldConfig.BleveSettings
+		newBleveConfig := newConfig.BleveSettings
+
+		// The post index is checked when indexing is turned on or the text analysis settings change.
+		if (!*oldBleveConfig.EnableIndexing && *newBleveConfig.EnableIndexing) ||
+			*oldBleveConfig.TextAnalyzer != *newBleveConfig.TextAnalyzer ||
+			*oldBleveConfig.DetectPostLanguage != *newBleveConfig.DetectPostLanguage {
+			a.blevePostIndexCheck(newConfig)
+		}
+	})
+}
+
+// blevePostIndexCheck creates a Bleve indexing job when the post index was created with
+// different text analysis settings than the configured ones. The job indexes everything again
+// into shadow indexes, which replace the live ones once it finishes.
+func (a *App) blevePostIndexCheck(cfg *model.Config) {
+	engine, ok := a.SearchEngine().BleveEngine.(*bleveengine.BleveEngine)
+	if !ok || a.Srv().Jobs == nil {
+		return
+	}
+
+	if !engine.IsPostIndexOutdated(cfg) {
+		return
+	}
+
+	a.Log().Info("blevePostIndexCheck: the Bleve post index settings have changed, creating an indexing job",
+		mlog.String("text_analyzer", *cfg.BleveSettings.TextAnalyzer),
+		mlog.Bool("detect_post_language", *cfg.BleveSettings.DetectPostLanguage),
+	)
+
+	if _, appErr := a.Srv().Jobs.CreateJobOnce(request.EmptyContext(a.Log()), model.JobTypeBlevePostIndexing, nil); appErr != nil {
+		a.Log().Error("blevePostIndexCheck: failed to create the Bleve indexing job", mlog.Err(appErr))
+	}
+}
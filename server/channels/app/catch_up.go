@@ -0,0 +1,245 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// The scores of the signals ranking the posts of a catch-up. The signals that are counted, such
+// as reactions, score per count up to catchUpMaxCountScore.
+const (
+	catchUpMentionScore         = 10
+	catchUpUrgentScore          = 8
+	catchUpImportantScore       = 5
+	catchUpPinnedScore          = 4
+	catchUpRequestedAckScore    = 3
+	catchUpReplyScore           = 2
+	catchUpReactionScore        = 1
+	catchUpAcknowledgementScore = 1
+	catchUpMaxCountScore        = 10
+)
+
+// The posts of a channel catch-up are fetched newest first in pages of catchUpPageSize, up to
+// catchUpMaxPosts.
+const (
+	catchUpPageSize = 1000
+	catchUpMaxPosts = 5000
+)
+
+// GetChannelCatchUp returns the most important posts created in the channel since the time,
+// which defaults to when the user last viewed the channel.
+func (a *App) GetChannelCatchUp(c request.CTX, userID, channelID string, since int64, limit int) (*model.CatchUp, *model.AppError) {
+	member, appErr := a.GetChannelMember(c, channelID, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if since == 0 {
+		since = member.LastViewedAt
+	}
+
+	catchUp := &model.CatchUp{ChannelId: channelID, Since: since}
+	list := model.NewPostList()
+	var beforeTime int64
+	var beforeID string
+	for {
+		posts, err := a.Srv().Store().Post().GetPostsCreatedSince(channelID, since, beforeTime, beforeID, catchUpPageSize)
+		if err != nil {
+			return nil, model.NewAppError("GetChannelCatchUp", "app.catch_up.get_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, post := range posts {
+			list.AddPost(post)
+		}
+		if len(posts) < catchUpPageSize {
+			break
+		}
+		last := posts[len(posts)-1]
+		if len(list.Posts) >= catchUpMaxPosts {
+			// The oldest posts are the least relevant to catching up, so they're the ones left out.
+			catchUp.Truncated = true
+			catchUp.TruncatedBefore = last.CreateAt
+			break
+		}
+		beforeTime, beforeID = last.CreateAt, last.Id
+	}
+
+	if appErr := a.rankCatchUpPosts(c, catchUp, list, member, limit); appErr != nil {
+		return nil, appErr
+	}
+
+	return catchUp, nil
+}
+
+// GetThreadCatchUp returns the most important posts created in the thread since the time, which
+// defaults to when the user last viewed the thread, or the channel if the user doesn't follow it.
+func (a *App) GetThreadCatchUp(c request.CTX, userID string, rootPost *model.Post, since int64, limit int) (*model.CatchUp, *model.AppError) {
+	member, appErr := a.GetChannelMember(c, rootPost.ChannelId, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if since == 0 {
+		since = member.LastViewedAt
+
+		membership, err := a.Srv().Store().Thread().GetMembershipForUser(userID, rootPost.Id)
+		var nfErr *store.ErrNotFound
+		switch {
+		case err == nil:
+			since = membership.LastViewed
+		case !errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetThreadCatchUp", "app.catch_up.get_thread_membership.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	list, appErr := a.GetPostThread(rootPost.Id, model.GetPostsOptions{SkipFetchThreads: true}, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	catchUp := &model.CatchUp{ChannelId: rootPost.ChannelId, RootId: rootPost.Id, Since: since}
+	if appErr := a.rankCatchUpPosts(c, catchUp, list, member, limit); appErr != nil {
+		return nil, appErr
+	}
+
+	return catchUp, nil
+}
+
+// rankCatchUpPosts fills the catch-up with the highest ranked posts of the list created since its
+// time, counting the ones left out.
+func (a *App) rankCatchUpPosts(c request.CTX, catchUp *model.CatchUp, list *model.PostList, member *model.ChannelMember, limit int) *model.AppError {
+	user, appErr := a.GetUser(member.UserId)
+	if appErr != nil {
+		return appErr
+	}
+
+	var candidates []*model.Post
+	var postIDs []string
+	for _, post := range list.Posts {
+		if post.CreateAt <= catchUp.Since || post.DeleteAt != 0 {
+			continue
+		}
+		catchUp.TotalPosts++
+
+		switch {
+		case post.IsSystemMessage():
+			catchUp.Skipped.SystemMessages++
+			continue
+		case post.UserId == user.Id:
+			catchUp.Skipped.OwnPosts++
+		default:
+			candidates = append(candidates, post)
+			postIDs = append(postIDs, post.Id)
+		}
+	}
+
+	if len(candidates) == 0 {
+		catchUp.Posts = []*model.CatchUpPost{}
+		return nil
+	}
+
+	reactions, err := a.Srv().Store().Reaction().BulkGetForPosts(postIDs)
+	if err != nil {
+		return model.NewAppError("rankCatchUpPosts", "app.catch_up.get_signals.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	reactionCounts := map[string]int{}
+	for _, reaction := range reactions {
+		reactionCounts[reaction.PostId]++
+	}
+
+	acknowledgements, err := a.Srv().Store().PostAcknowledgement().GetForPosts(postIDs)
+	if err != nil {
+		return model.NewAppError("rankCatchUpPosts", "app.catch_up.get_signals.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	acknowledgementCounts := map[string]int{}
+	for _, acknowledgement := range acknowledgements {
+		if acknowledgement.AcknowledgedAt != 0 {
+			acknowledgementCounts[acknowledgement.PostId]++
+		}
+	}
+
+	priorities, err := a.Srv().Store().PostPriority().GetForPosts(postIDs)
+	if err != nil {
+		return model.NewAppError("rankCatchUpPosts", "app.catch_up.get_signals.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	postPriorities := make(map[string]*model.PostPriority, len(priorities))
+	for _, priority := range priorities {
+		postPriorities[priority.PostId] = priority
+	}
+
+	// Mentions of the channel are only counted if the user is notified of them.
+	keywords := MentionKeywords{}.AddUser(user, member.NotifyProps, nil, true)
+
+	ranked := []*model.CatchUpPost{}
+	for _, post := range candidates {
+		ranking := &model.CatchUpPost{Post: post, Reasons: []string{}}
+		addSignal := func(reason string, score int) {
+			ranking.Score += score
+			ranking.Reasons = append(ranking.Reasons, reason)
+		}
+		addCountSignal := func(reason string, count, score int) {
+			if count > 0 {
+				addSignal(reason, min(count*score, catchUpMaxCountScore))
+			}
+		}
+
+		if _, ok := getExplicitMentions(post, keywords).Mentions[user.Id]; ok {
+			addSignal(model.CatchUpReasonMention, catchUpMentionScore)
+		}
+		if priority, ok := postPriorities[post.Id]; ok {
+			if priority.Priority != nil {
+				switch *priority.Priority {
+				case model.PostPriorityUrgent:
+					addSignal(model.CatchUpReasonUrgent, catchUpUrgentScore)
+				case model.PostPriorityImportant:
+					addSignal(model.CatchUpReasonImportant, catchUpImportantScore)
+				}
+			}
+			if priority.RequestedAck != nil && *priority.RequestedAck {
+				addSignal(model.CatchUpReasonRequestedAck, catchUpRequestedAckScore)
+			}
+		}
+		if post.IsPinned {
+			addSignal(model.CatchUpReasonPinned, catchUpPinnedScore)
+		}
+		if post.RootId == "" {
+			addCountSignal(model.CatchUpReasonReplies, int(post.ReplyCount), catchUpReplyScore)
+		}
+		addCountSignal(model.CatchUpReasonReactions, reactionCounts[post.Id], catchUpReactionScore)
+		addCountSignal(model.CatchUpReasonAcknowledgements, acknowledgementCounts[post.Id], catchUpAcknowledgementScore)
+
+		if ranking.Score == 0 {
+			catchUp.Skipped.NoSignal++
+			continue
+		}
+		ranked = append(ranked, ranking)
+	}
+
+	// Posts ranking the same are ordered chronologically, so the ranking is deterministic.
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		if ranked[i].Post.CreateAt != ranked[j].Post.CreateAt {
+			return ranked[i].Post.CreateAt < ranked[j].Post.CreateAt
+		}
+		return ranked[i].Post.Id < ranked[j].Post.Id
+	})
+
+	if len(ranked) > limit {
+		catchUp.Skipped.OverLimit = len(ranked) - limit
+		ranked = ranked[:limit]
+	}
+
+	for _, ranking := range ranked {
+		ranking.Post = a.PreparePostForClient(c, ranking.Post, false, false, true)
+	}
+	catchUp.Posts = ranked
+
+	return nil
+}
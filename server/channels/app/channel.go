@@ -637,6 +637,12 @@ func (a *App) GetGroupChannel(c request.CTX, userIDs []string) (*model.Channel,
 
 // UpdateChannel updates a given channel by its Id. It also publishes the CHANNEL_UPDATED event.
 func (a *App) UpdateChannel(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError) {
+	// The previous state of the channel is only needed to notify plugins of renames and conversions.
+	var oldChannel *model.Channel
+	if a.GetPluginsEnvironment() != nil {
+		oldChannel, _ = a.Srv().Store().Channel().Get(channel.Id, true)
+	}
+
 	_, err := a.Srv().Store().Channel().Update(c, channel)
 	if err != nil {
 		var appErr *model.AppError
@@ -664,6 +670,29 @@ func (a *App) UpdateChannel(c request.CTX, channel *model.Channel) (*model.Chann
 	messageWs.Add("channel", string(channelJSON))
 	a.Publish(messageWs)
 
+	if oldChannel != nil {
+		renamed := oldChannel.Name != channel.Name || oldChannel.DisplayName != channel.DisplayName
+		converted := oldChannel.Type != channel.Type
+		if renamed || converted {
+			newChannel := channel.DeepCopy()
+			a.Srv().Go(func() {
+				pluginContext := pluginContext(c)
+				if renamed {
+					a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+						hooks.ChannelHasBeenRenamed(pluginContext, newChannel, oldChannel)
+						return true
+					}, plugin.ChannelHasBeenRenamedID)
+				}
+				if converted {
+					a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+						hooks.ChannelHasBeenConverted(pluginContext, newChannel, oldChannel)
+						return true
+					}, plugin.ChannelHasBeenConvertedID)
+				}
+			})
+		}
+	}
+
 	return channel, nil
 }
 
@@ -846,6 +875,15 @@ func (a *App) RestoreChannel(c request.CTX, channel *model.Channel, userID strin
 		})
 	}
 
+	restoredChannel := channel.DeepCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.ChannelHasBeenRestored(pluginContext, restoredChannel, user)
+			return true
+		}, plugin.ChannelHasBeenRestoredID)
+	})
+
 	return channel, nil
 }
 
@@ -1171,6 +1209,7 @@ func (a *App) UpdateChannelMemberRoles(c request.CTX, channelID string, userID s
 	if member, err = a.GetChannelMember(c, channelID, userID); err != nil {
 		return nil, err
 	}
+	oldMember := *member
 
 	schemeGuestRole, schemeUserRole, schemeAdminRole, err := a.GetSchemeRolesForChannel(c, channelID)
 	if err != nil {
@@ -1221,7 +1260,7 @@ func (a *App) UpdateChannelMemberRoles(c request.CTX, channelID string, userID s
 
 	member.ExplicitRoles = strings.Join(newExplicitRoles, " ")
 
-	return a.updateChannelMember(c, member)
+	return a.updateChannelMemberRoles(c, member, &oldMember)
 }
 
 func (a *App) UpdateChannelMemberSchemeRoles(c request.CTX, channelID string, userID string, isSchemeGuest bool, isSchemeUser bool, isSchemeAdmin bool) (*model.ChannelMember, *model.AppError) {
@@ -1229,6 +1268,7 @@ func (a *App) UpdateChannelMemberSchemeRoles(c request.CTX, channelID string, us
 	if err != nil {
 		return nil, err
 	}
+	oldMember := *member
 
 	if member.SchemeGuest {
 		return nil, model.NewAppError("UpdateChannelMemberSchemeRoles", "api.channel.update_channel_member_roles.guest.app_error", nil, "", http.StatusBadRequest)
@@ -1247,7 +1287,7 @@ func (a *App) UpdateChannelMemberSchemeRoles(c request.CTX, channelID string, us
 		member.ExplicitRoles = RemoveRoles([]string{model.ChannelGuestRoleId, model.ChannelUserRoleId, model.ChannelAdminRoleId}, member.ExplicitRoles)
 	}
 
-	return a.updateChannelMember(c, member)
+	return a.updateChannelMemberRoles(c, member, &oldMember)
 }
 
 func (a *App) UpdateChannelMemberNotifyProps(c request.CTX, data map[string]string, channelID string, userID string) (*model.ChannelMember, *model.AppError) {
@@ -1406,6 +1446,28 @@ func (a *App) updateChannelMember(c request.CTX, member *model.ChannelMember) (*
 	return member, nil
 }
 
+// updateChannelMemberRoles updates the channel member and notifies plugins if its roles changed.
+func (a *App) updateChannelMemberRoles(c request.CTX, member, oldMember *model.ChannelMember) (*model.ChannelMember, *model.AppError) {
+	member, err := a.updateChannelMember(c, member)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.ExplicitRoles != oldMember.ExplicitRoles || member.SchemeGuest != oldMember.SchemeGuest ||
+		member.SchemeUser != oldMember.SchemeUser || member.SchemeAdmin != oldMember.SchemeAdmin {
+		newMember := *member
+		a.Srv().Go(func() {
+			pluginContext := pluginContext(c)
+			a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+				hooks.ChannelMemberRolesHaveChanged(pluginContext, &newMember, oldMember)
+				return true
+			}, plugin.ChannelMemberRolesHaveChangedID)
+		})
+	}
+
+	return member, nil
+}
+
 func (a *App) DeleteChannel(c request.CTX, channel *model.Channel, userID string) *model.AppError {
 	ihc := make(chan store.StoreResult[[]*model.IncomingWebhook], 1)
 	ohc := make(chan store.StoreResult[[]*model.OutgoingWebhook], 1)
@@ -1530,6 +1592,16 @@ func (a *App) DeleteChannel(c request.CTX, channel *model.Channel, userID string
 	message.Add("delete_at", deleteAt)
 	a.Publish(message)
 
+	archivedChannel := channel.DeepCopy()
+	archivedChannel.DeleteAt = deleteAt
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.ChannelHasBeenArchived(pluginContext, archivedChannel, user)
+			return true
+		}, plugin.ChannelHasBeenArchivedID)
+	})
+
 	return nil
 }
 
@@ -2570,6 +2642,12 @@ func (a *App) removeUserFromChannel(c request.CTX, userIDToRemove string, remove
 	if err := a.Srv().Store().Thread().DeleteMembershipsForChannel(userIDToRemove, channel.Id); err != nil {
 		return model.NewAppError("removeUserFromChannel", model.NoTranslation, nil, "failed to delete threadmemberships upon leaving channel", http.StatusInternalServerError).Wrap(err)
 	}
+	// Posts of public channels stay readable after leaving them.
+	if channel.Type != model.ChannelTypeOpen {
+		if err := a.Srv().Store().Post().DeleteUserPostRemindersForChannel(userIDToRemove, channel.Id); err != nil {
+			c.Logger().Warn("Failed to delete the post reminders of the user upon leaving the channel", mlog.String("channel_id", channel.Id), mlog.Err(err))
+		}
+	}
 
 	if isGuest {
 		currentMembers, err := a.GetChannelMembersForUser(c, channel.TeamId, userIDToRemove)
@@ -3042,7 +3120,7 @@ func (a *App) MarkChannelsAsViewed(c request.CTX, channelIDs []string, userID st
 		}
 	}
 
-	_, err = a.Srv().Store().Channel().UpdateLastViewedAt(channelsToView, userID)
+	lastViewedAtTimes, err := a.Srv().Store().Channel().UpdateLastViewedAt(channelsToView, userID)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
 		switch {
@@ -3053,6 +3131,10 @@ func (a *App) MarkChannelsAsViewed(c request.CTX, channelIDs []string, userID st
 		}
 	}
 
+	if *a.Config().ServiceSettings.EnableReadReceipts {
+		a.publishReadReceipts(c, userID, lastViewedAtTimes)
+	}
+
 	if *a.Config().ServiceSettings.EnableChannelViewedMessages {
 		message := model.NewWebSocketEvent(model.WebsocketEventMultipleChannelsViewed, "", "", userID, nil, "")
 		message.Add("channel_times", times)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
@@ -46,10 +47,26 @@ func (a *App) CreateChannelBookmark(c request.CTX, newBookmark *model.ChannelBoo
 	}
 	message.Add("bookmark", string(bookmarkJSON))
 	a.Publish(message)
+
+	pluginBookmark := bookmark.Clone()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.ChannelBookmarkHasBeenCreated(pluginContext, pluginBookmark)
+			return true
+		}, plugin.ChannelBookmarkHasBeenCreatedID)
+	})
+
 	return bookmark, nil
 }
 
 func (a *App) UpdateChannelBookmark(c request.CTX, updateBookmark *model.ChannelBookmarkWithFileInfo, connectionId string) (*model.UpdateChannelBookmarkResponse, *model.AppError) {
+	// The previous state of the bookmark is only needed to notify plugins of the update.
+	var oldBookmark *model.ChannelBookmarkWithFileInfo
+	if a.GetPluginsEnvironment() != nil {
+		oldBookmark, _ = a.Srv().Store().ChannelBookmark().Get(updateBookmark.Id, false)
+	}
+
 	response := &model.UpdateChannelBookmarkResponse{}
 	if updateBookmark.OwnerId == c.Session().UserId {
 		isAnotherFile := updateBookmark.FileInfo != nil && updateBookmark.FileId != "" && updateBookmark.FileId != updateBookmark.FileInfo.Id
@@ -101,6 +118,17 @@ func (a *App) UpdateChannelBookmark(c request.CTX, updateBookmark *model.Channel
 	message.Add("bookmarks", string(bookmarkJSON))
 	a.Publish(message)
 
+	if oldBookmark != nil {
+		newBookmark := response.Updated.Clone()
+		a.Srv().Go(func() {
+			pluginContext := pluginContext(c)
+			a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+				hooks.ChannelBookmarkHasBeenUpdated(pluginContext, newBookmark, oldBookmark)
+				return true
+			}, plugin.ChannelBookmarkHasBeenUpdatedID)
+		})
+	}
+
 	return response, nil
 }
 
@@ -122,6 +150,14 @@ func (a *App) DeleteChannelBookmark(bookmarkId, connectionId string) (*model.Cha
 	message.Add("bookmark", string(bookmarkJSON))
 	a.Publish(message)
 
+	pluginBookmark := bookmark.Clone()
+	a.Srv().Go(func() {
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.ChannelBookmarkHasBeenDeleted(&plugin.Context{}, pluginBookmark)
+			return true
+		}, plugin.ChannelBookmarkHasBeenDeletedID)
+	})
+
 	return bookmark, nil
 }
 
@@ -45,6 +45,16 @@ type Channels struct {
 	pluginConfigListenerID        string
 	pluginClusterLeaderListenerID string
 
+	pluginJobsLock sync.RWMutex
+	// pluginJobs maps the job types registered by plugins to the plugin owning them.
+	pluginJobs map[string]string
+
+	pluginWatchersLock sync.Mutex
+	pluginWatchers     map[string]*pluginWatcher
+
+	// pluginPermissionsLock serializes the changes made to roles for the permissions of plugins.
+	pluginPermissionsLock sync.Mutex
+
 	imageProxy *imageproxy.ImageProxy
 
 	// cached counts that are used during notice condition validation
@@ -85,6 +95,7 @@ func NewChannels(s *Server) (*Channels, error) {
 		srv:             s,
 		imageProxy:      imageproxy.MakeImageProxy(s.platform, s.httpService, s.Log()),
 		uploadLockMap:   map[string]bool{},
+		pluginJobs:      map[string]string{},
 		filestore:       s.FileBackend(),
 		exportFilestore: s.ExportFileBackend(),
 		cfgSvc:          s.Platform(),
@@ -54,6 +54,12 @@ func (s *Server) clusterPluginEventHandler(msg *model.ClusterMessage) {
 	})
 }
 
+func (s *Server) clusterInvalidateSavedSearchAlertsHandler(msg *model.ClusterMessage) {
+	if err := s.savedSearchAlertsCache.Purge(); err != nil {
+		s.Log().Warn("Failed to purge the saved search alerts cache", mlog.Err(err))
+	}
+}
+
 // registerClusterHandlers registers the cluster message handlers that are handled by the server.
 //
 // The cluster event handlers are spread across this function and NewLocalCacheLayer.
@@ -62,6 +68,7 @@ func (s *Server) registerClusterHandlers() {
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventInstallPlugin, s.clusterInstallPluginHandler)
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventRemovePlugin, s.clusterRemovePluginHandler)
 	s.platform.RegisterClusterMessageHandler(model.ClusterEventPluginEvent, s.clusterPluginEventHandler)
+	s.platform.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForSavedSearchAlerts, s.clusterInvalidateSavedSearchAlertsHandler)
 
 	s.platform.RegisterClusterHandlers()
 }
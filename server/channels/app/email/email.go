@@ -394,6 +394,64 @@ func (es *Service) SendMfaChangeEmail(email string, activated bool, locale, site
 	return nil
 }
 
+func (es *Service) SendNewLoginLocationEmail(email, locale, siteURL, ipAddress, device string) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("api.templates.new_login_location.subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.new_login_location.body.title")
+	data.Props["Info"] = T("api.templates.new_login_location.body.info",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName, "Device": device, "IPAddress": ipAddress})
+	data.Props["Warning"] = T("api.templates.new_login_location.body.warning")
+
+	body, err := es.templatesContainer.RenderToString("new_login_location_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "NewLoginLocationEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *Service) SendLoginLocationVerificationEmail(email, locale, siteURL, token, ipAddress, device string) error {
+	T := i18n.GetUserTranslations(locale)
+
+	link := fmt.Sprintf("%s/do_verify_login_location?token=%s", siteURL, token)
+
+	subject := T("api.templates.login_location_verification.subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.login_location_verification.body.title")
+	data.Props["SubTitle1"] = T("api.templates.login_location_verification.body.subTitle1",
+		map[string]any{"Device": device, "IPAddress": ipAddress})
+	data.Props["ServerURL"] = T("api.templates.verify_body.serverURL", map[string]any{"ServerURL": condenseSiteURL(siteURL)})
+	data.Props["SubTitle2"] = T("api.templates.login_location_verification.body.subTitle2")
+	data.Props["ButtonURL"] = link
+	data.Props["Button"] = T("api.templates.login_location_verification.body.button")
+	data.Props["Info"] = T("api.templates.login_location_verification.body.info")
+	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
+	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
+
+	body, err := es.templatesContainer.RenderToString("verify_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "LoginLocationVerificationEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (es *Service) SendInviteEmails(
 	team *model.Team,
 	senderName string,
@@ -489,6 +547,7 @@ func (es *Service) SendGuestInviteEmails(
 	channels []*model.Channel,
 	senderName string,
 	senderUserId string,
+	sponsorId string,
 	senderProfileImage []byte,
 	invites []string,
 	siteURL string,
@@ -540,11 +599,12 @@ func (es *Service) SendGuestInviteEmails(
 			token := model.NewToken(
 				TokenTypeGuestInvitation,
 				model.MapToJSON(map[string]string{
-					"teamId":   team.Id,
-					"channels": strings.Join(channelIDs, " "),
-					"email":    invite,
-					"guest":    "true",
-					"senderId": senderUserId,
+					"teamId":    team.Id,
+					"channels":  strings.Join(channelIDs, " "),
+					"email":     invite,
+					"guest":     "true",
+					"senderId":  senderUserId,
+					"sponsorId": sponsorId,
 				}),
 			)
 
@@ -807,6 +867,63 @@ func (es *Service) SendDeactivateAccountEmail(email string, locale, siteURL stri
 	return nil
 }
 
+func (es *Service) SendGuestExpiryWarningEmail(email, locale, siteURL, guestName string, daysToExpiry int, isSponsor bool) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("api.templates.guest_expiry_warning.subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	if isSponsor {
+		data.Props["Title"] = T("api.templates.guest_expiry_warning.sponsor.title", daysToExpiry, map[string]any{"GuestName": guestName, "Days": daysToExpiry})
+		data.Props["Info"] = T("api.templates.guest_expiry_warning.sponsor.info")
+	} else {
+		data.Props["Title"] = T("api.templates.guest_expiry_warning.guest.title", daysToExpiry, map[string]any{"Days": daysToExpiry})
+		data.Props["Info"] = T("api.templates.guest_expiry_warning.guest.info")
+	}
+	data.Props["Warning"] = T("api.templates.guest_expiry_warning.warning")
+
+	body, err := es.templatesContainer.RenderToString("deactivate_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "GuestExpiryWarningEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (es *Service) SendUserDataExportReadyEmail(email, locale, siteURL, link string, expiryHours int) error {
+	T := i18n.GetUserTranslations(locale)
+
+	subject := T("api.templates.user_data_export_ready.subject",
+		map[string]any{"SiteName": es.config().TeamSettings.SiteName})
+
+	data := es.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = T("api.templates.user_data_export_ready.title")
+	data.Props["SubTitle1"] = T("api.templates.user_data_export_ready.subTitle", expiryHours, map[string]any{"Hours": expiryHours})
+	data.Props["ButtonURL"] = link
+	data.Props["Button"] = T("api.templates.user_data_export_ready.button")
+	data.Props["Info"] = T("api.templates.user_data_export_ready.info")
+	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
+	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
+
+	body, err := es.templatesContainer.RenderToString("verify_body", data)
+	if err != nil {
+		return err
+	}
+
+	if err := es.sendMail(email, subject, body, "UserDataExportReadyEmail"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (es *Service) SendNotificationMail(to, subject, htmlBody string) error {
 	if !*es.config().EmailSettings.SendEmailNotifications {
 		return nil
@@ -107,6 +107,7 @@ func TestSendInviteEmails(t *testing.T) {
 			[]*model.Channel{th.BasicChannel},
 			"test-user",
 			th.BasicUser.Id,
+			th.BasicUser.Id,
 			nil,
 			[]string{emailTo},
 			"http://testserver",
@@ -136,6 +137,7 @@ func TestSendInviteEmails(t *testing.T) {
 			[]*model.Channel{th.BasicChannel},
 			"test-user",
 			th.BasicUser.Id,
+			th.BasicUser.Id,
 			nil,
 			[]string{emailTo},
 			"http://testserver",
@@ -151,6 +153,7 @@ func TestSendInviteEmails(t *testing.T) {
 			[]*model.Channel{th.BasicChannel},
 			"test-user",
 			th.BasicUser.Id,
+			th.BasicUser.Id,
 			nil,
 			[]string{emailTo},
 			"http://testserver",
@@ -171,6 +174,7 @@ func TestSendInviteEmails(t *testing.T) {
 			[]*model.Channel{th.BasicChannel},
 			"test-user",
 			th.BasicUser.Id,
+			th.BasicUser.Id,
 			nil,
 			[]string{emailTo},
 			"http://testserver",
@@ -254,17 +254,35 @@ func (_m *ServiceInterface) SendEmailChangeVerifyEmail(newUserEmail string, loca
 	return r0
 }
 
-// SendGuestInviteEmails provides a mock function with given fields: team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin
-func (_m *ServiceInterface) SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error {
-	ret := _m.Called(team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
+// SendGuestExpiryWarningEmail provides a mock function with given fields: _a0, locale, siteURL, guestName, daysToExpiry, isSponsor
+func (_m *ServiceInterface) SendGuestExpiryWarningEmail(_a0 string, locale string, siteURL string, guestName string, daysToExpiry int, isSponsor bool) error {
+	ret := _m.Called(_a0, locale, siteURL, guestName, daysToExpiry, isSponsor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendGuestExpiryWarningEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int, bool) error); ok {
+		r0 = rf(_a0, locale, siteURL, guestName, daysToExpiry, isSponsor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendGuestInviteEmails provides a mock function with given fields: team, channels, senderName, senderUserId, sponsorId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin
+func (_m *ServiceInterface) SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, sponsorId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error {
+	ret := _m.Called(team, channels, senderName, senderUserId, sponsorId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendGuestInviteEmails")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Team, []*model.Channel, string, string, []byte, []string, string, string, bool, bool, bool) error); ok {
-		r0 = rf(team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
+	if rf, ok := ret.Get(0).(func(*model.Team, []*model.Channel, string, string, string, []byte, []string, string, string, bool, bool, bool) error); ok {
+		r0 = rf(team, channels, senderName, senderUserId, sponsorId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -356,6 +374,24 @@ func (_m *ServiceInterface) SendLicenseUpForRenewalEmail(_a0 string, name string
 	return r0
 }
 
+// SendLoginLocationVerificationEmail provides a mock function with given fields: _a0, locale, siteURL, token, ipAddress, device
+func (_m *ServiceInterface) SendLoginLocationVerificationEmail(_a0 string, locale string, siteURL string, token string, ipAddress string, device string) error {
+	ret := _m.Called(_a0, locale, siteURL, token, ipAddress, device)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendLoginLocationVerificationEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, string) error); ok {
+		r0 = rf(_a0, locale, siteURL, token, ipAddress, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendMailWithEmbeddedFiles provides a mock function with given fields: to, subject, htmlBody, embeddedFiles, messageID, inReplyTo, references, category
 func (_m *ServiceInterface) SendMailWithEmbeddedFiles(to string, subject string, htmlBody string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error {
 	ret := _m.Called(to, subject, htmlBody, embeddedFiles, messageID, inReplyTo, references, category)
@@ -392,6 +428,24 @@ func (_m *ServiceInterface) SendMfaChangeEmail(_a0 string, activated bool, local
 	return r0
 }
 
+// SendNewLoginLocationEmail provides a mock function with given fields: _a0, locale, siteURL, ipAddress, device
+func (_m *ServiceInterface) SendNewLoginLocationEmail(_a0 string, locale string, siteURL string, ipAddress string, device string) error {
+	ret := _m.Called(_a0, locale, siteURL, ipAddress, device)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendNewLoginLocationEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string) error); ok {
+		r0 = rf(_a0, locale, siteURL, ipAddress, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendNotificationMail provides a mock function with given fields: to, subject, htmlBody
 func (_m *ServiceInterface) SendNotificationMail(to string, subject string, htmlBody string) error {
 	ret := _m.Called(to, subject, htmlBody)
@@ -510,6 +564,24 @@ func (_m *ServiceInterface) SendUserAccessTokenAddedEmail(_a0 string, locale str
 	return r0
 }
 
+// SendUserDataExportReadyEmail provides a mock function with given fields: _a0, locale, siteURL, link, expiryHours
+func (_m *ServiceInterface) SendUserDataExportReadyEmail(_a0 string, locale string, siteURL string, link string, expiryHours int) error {
+	ret := _m.Called(_a0, locale, siteURL, link, expiryHours)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendUserDataExportReadyEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int) error); ok {
+		r0 = rf(_a0, locale, siteURL, link, expiryHours)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendVerifyEmail provides a mock function with given fields: userEmail, locale, siteURL, token, redirect
 func (_m *ServiceInterface) SendVerifyEmail(userEmail string, locale string, siteURL string, token string, redirect string) error {
 	ret := _m.Called(userEmail, locale, siteURL, token, redirect)
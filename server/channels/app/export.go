@@ -455,80 +455,93 @@ func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer,
 		for _, user := range users {
 			afterId = user.Id
 
-			// Gathering here the exportable preferences to pass them on to ImportLineFromUser
-			exportedPrefs := make(map[string]*string)
-			allPrefs, err := a.GetPreferencesForUser(ctx, user.Id)
+			userLine, profilePicture, err := a.buildUserLine(ctx, user, includeArchivedChannels, includeProfilePictures)
 			if err != nil {
 				return profilePictures, err
 			}
-			for _, pref := range allPrefs {
-				// We need to manage the special cases
-				// Here we manage Tutorial steps
-				if pref.Category == model.PreferenceCategoryTutorialSteps {
-					pref.Name = ""
-					// Then the email interval
-				} else if pref.Category == model.PreferenceCategoryNotifications && pref.Name == model.PreferenceNameEmailInterval {
-					switch pref.Value {
-					case model.PreferenceEmailIntervalNoBatchingSeconds:
-						pref.Value = model.PreferenceEmailIntervalImmediately
-					case model.PreferenceEmailIntervalFifteenAsSeconds:
-						pref.Value = model.PreferenceEmailIntervalFifteen
-					case model.PreferenceEmailIntervalHourAsSeconds:
-						pref.Value = model.PreferenceEmailIntervalHour
-					case "0":
-						pref.Value = ""
-					}
-				}
-				id, ok := exportablePreferences[imports.ComparablePreference{
-					Category: pref.Category,
-					Name:     pref.Name,
-				}]
-				if ok {
-					prefPtr := pref.Value
-					if prefPtr != "" {
-						exportedPrefs[id] = &prefPtr
-					} else {
-						exportedPrefs[id] = nil
-					}
-				}
+			if profilePicture != "" {
+				profilePictures = append(profilePictures, profilePicture)
 			}
 
-			userLine := ImportLineFromUser(user, exportedPrefs)
-
-			if includeProfilePictures {
-				var pp string
-				pp, err = a.GetProfileImagePath(user)
-				if err != nil {
-					return profilePictures, err
-				}
-				if pp != "" {
-					userLine.User.ProfileImage = &pp
-					profilePictures = append(profilePictures, pp)
-				}
+			if err := a.exportWriteLine(writer, userLine); err != nil {
+				return profilePictures, err
 			}
+		}
+	}
 
-			userLine.User.NotifyProps = a.buildUserNotifyProps(user.NotifyProps)
+	return profilePictures, nil
+}
 
-			// Adding custom status
-			if cs := user.GetCustomStatus(); cs != nil {
-				userLine.User.CustomStatus = cs
+// buildUserLine returns the export line of the given user, and the path of
+// their profile picture if it is included in the export.
+func (a *App) buildUserLine(ctx request.CTX, user *model.User, includeArchivedChannels, includeProfilePicture bool) (*imports.LineImportData, string, *model.AppError) {
+	// Gathering here the exportable preferences to pass them on to ImportLineFromUser
+	exportedPrefs := make(map[string]*string)
+	allPrefs, err := a.GetPreferencesForUser(ctx, user.Id)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, pref := range allPrefs {
+		// We need to manage the special cases
+		// Here we manage Tutorial steps
+		if pref.Category == model.PreferenceCategoryTutorialSteps {
+			pref.Name = ""
+			// Then the email interval
+		} else if pref.Category == model.PreferenceCategoryNotifications && pref.Name == model.PreferenceNameEmailInterval {
+			switch pref.Value {
+			case model.PreferenceEmailIntervalNoBatchingSeconds:
+				pref.Value = model.PreferenceEmailIntervalImmediately
+			case model.PreferenceEmailIntervalFifteenAsSeconds:
+				pref.Value = model.PreferenceEmailIntervalFifteen
+			case model.PreferenceEmailIntervalHourAsSeconds:
+				pref.Value = model.PreferenceEmailIntervalHour
+			case "0":
+				pref.Value = ""
 			}
-
-			// Do the Team Memberships.
-			members, err := a.buildUserTeamAndChannelMemberships(ctx, user.Id, includeArchivedChannels)
-			if err != nil {
-				return profilePictures, err
+		}
+		id, ok := exportablePreferences[imports.ComparablePreference{
+			Category: pref.Category,
+			Name:     pref.Name,
+		}]
+		if ok {
+			prefPtr := pref.Value
+			if prefPtr != "" {
+				exportedPrefs[id] = &prefPtr
+			} else {
+				exportedPrefs[id] = nil
 			}
+		}
+	}
 
-			userLine.User.Teams = members
+	userLine := ImportLineFromUser(user, exportedPrefs)
 
-			if err := a.exportWriteLine(writer, userLine); err != nil {
-				return profilePictures, err
-			}
+	var profilePicture string
+	if includeProfilePicture {
+		profilePicture, err = a.GetProfileImagePath(user)
+		if err != nil {
+			return nil, "", err
+		}
+		if profilePicture != "" {
+			userLine.User.ProfileImage = &profilePicture
 		}
 	}
 
-	return profilePictures, nil
+	userLine.User.NotifyProps = a.buildUserNotifyProps(user.NotifyProps)
+
+	// Adding custom status
+	if cs := user.GetCustomStatus(); cs != nil {
+		userLine.User.CustomStatus = cs
+	}
+
+	// Do the Team Memberships.
+	members, err := a.buildUserTeamAndChannelMemberships(ctx, user.Id, includeArchivedChannels)
+	if err != nil {
+		return nil, "", err
+	}
+
+	userLine.User.Teams = members
+
+	return userLine, profilePicture, nil
 }
 
 func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, includeArchivedChannels bool) (*[]imports.UserTeamImportData, *model.AppError) {
@@ -640,52 +653,65 @@ func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer,
 				continue
 			}
 
-			postLine := ImportLineForPost(post)
-
-			replies, replyAttachments, err := a.buildPostReplies(ctx, post.Id, withAttachments)
+			postLine, postAttachments, err := a.buildPostLine(ctx, post, withAttachments)
 			if err != nil {
 				return nil, err
 			}
+			attachments = append(attachments, postAttachments...)
 
-			followers, err := a.buildThreadFollowers(ctx, post.Id)
-			if err != nil {
+			if err := a.exportWriteLine(writer, postLine); err != nil {
 				return nil, err
 			}
+		}
+	}
+}
 
-			if len(followers) > 0 {
-				postLine.Post.ThreadFollowers = &followers
-			}
+// buildPostLine returns the export line of the given root post with its replies, and
+// the attachments to include in the export.
+func (a *App) buildPostLine(ctx request.CTX, post *model.PostForExport, withAttachments bool) (*imports.LineImportData, []imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
+	postLine := ImportLineForPost(post)
 
-			if withAttachments && len(replyAttachments) > 0 {
-				attachments = append(attachments, replyAttachments...)
-			}
+	replies, replyAttachments, err := a.buildPostReplies(ctx, post.Id, withAttachments)
+	if err != nil {
+		return nil, nil, err
+	}
 
-			postLine.Post.Replies = &replies
-			postLine.Post.Reactions = &[]imports.ReactionImportData{}
-			if post.HasReactions {
-				postLine.Post.Reactions, err = a.BuildPostReactions(ctx, post.Id)
-				if err != nil {
-					return nil, err
-				}
-			}
+	followers, err := a.buildThreadFollowers(ctx, post.Id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-			if len(post.FileIds) > 0 {
-				postAttachments, err := a.buildPostAttachments(post.Id)
-				if err != nil {
-					return nil, err
-				}
-				postLine.Post.Attachments = &postAttachments
+	if len(followers) > 0 {
+		postLine.Post.ThreadFollowers = &followers
+	}
 
-				if withAttachments && len(postAttachments) > 0 {
-					attachments = append(attachments, postAttachments...)
-				}
-			}
+	if withAttachments && len(replyAttachments) > 0 {
+		attachments = append(attachments, replyAttachments...)
+	}
 
-			if err := a.exportWriteLine(writer, postLine); err != nil {
-				return nil, err
-			}
+	postLine.Post.Replies = &replies
+	postLine.Post.Reactions = &[]imports.ReactionImportData{}
+	if post.HasReactions {
+		postLine.Post.Reactions, err = a.BuildPostReactions(ctx, post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(post.FileIds) > 0 {
+		postAttachments, err := a.buildPostAttachments(post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		postLine.Post.Attachments = &postAttachments
+
+		if withAttachments && len(postAttachments) > 0 {
+			attachments = append(attachments, postAttachments...)
 		}
 	}
+
+	return postLine, attachments, nil
 }
 
 func (a *App) buildPostReplies(ctx request.CTX, postID string, withAttachments bool) ([]imports.ReplyImportData, []imports.AttachmentImportData, *model.AppError) {
@@ -1013,44 +1039,11 @@ func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Wr
 				continue
 			}
 
-			// Handle attachments.
-			var postAttachments []imports.AttachmentImportData
-			var err *model.AppError
-			if len(post.FileIds) > 0 {
-				postAttachments, err = a.buildPostAttachments(post.Id)
-				if err != nil {
-					return nil, err
-				}
-
-				if withAttachments && len(postAttachments) > 0 {
-					attachments = append(attachments, postAttachments...)
-				}
-			}
-
-			// Do the Replies.
-			replies, replyAttachments, err := a.buildPostReplies(ctx, post.Id, withAttachments)
+			postLine, postAttachments, err := a.buildDirectPostLine(ctx, post, withAttachments)
 			if err != nil {
 				return nil, err
 			}
-
-			if withAttachments && len(replyAttachments) > 0 {
-				attachments = append(attachments, replyAttachments...)
-			}
-
-			postLine := ImportLineForDirectPost(post)
-			postLine.DirectPost.Replies = &replies
-			if len(postAttachments) > 0 {
-				postLine.DirectPost.Attachments = &postAttachments
-			}
-
-			followers, err := a.buildThreadFollowers(ctx, post.Id)
-			if err != nil {
-				return nil, err
-			}
-
-			if len(followers) > 0 {
-				postLine.DirectPost.ThreadFollowers = &followers
-			}
+			attachments = append(attachments, postAttachments...)
 
 			if err := a.exportWriteLine(writer, postLine); err != nil {
 				return nil, err
@@ -1060,6 +1053,53 @@ func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Wr
 	return attachments, nil
 }
 
+// buildDirectPostLine returns the export line of the given direct root post with its
+// replies, and the attachments to include in the export.
+func (a *App) buildDirectPostLine(ctx request.CTX, post *model.DirectPostForExport, withAttachments bool) (*imports.LineImportData, []imports.AttachmentImportData, *model.AppError) {
+	var attachments []imports.AttachmentImportData
+
+	// Handle attachments.
+	var postAttachments []imports.AttachmentImportData
+	var err *model.AppError
+	if len(post.FileIds) > 0 {
+		postAttachments, err = a.buildPostAttachments(post.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if withAttachments && len(postAttachments) > 0 {
+			attachments = append(attachments, postAttachments...)
+		}
+	}
+
+	// Do the Replies.
+	replies, replyAttachments, err := a.buildPostReplies(ctx, post.Id, withAttachments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if withAttachments && len(replyAttachments) > 0 {
+		attachments = append(attachments, replyAttachments...)
+	}
+
+	postLine := ImportLineForDirectPost(post)
+	postLine.DirectPost.Replies = &replies
+	if len(postAttachments) > 0 {
+		postLine.DirectPost.Attachments = &postAttachments
+	}
+
+	followers, err := a.buildThreadFollowers(ctx, post.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(followers) > 0 {
+		postLine.DirectPost.ThreadFollowers = &followers
+	}
+
+	return postLine, attachments, nil
+}
+
 func (a *App) exportFile(outPath, filePath string, zipWr *zip.Writer) *model.AppError {
 	var wr io.Writer
 	var err error
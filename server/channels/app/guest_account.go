@@ -0,0 +1,346 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const guestAccountExpiryBatchSize = 100
+
+// validateGuestSponsor checks that the sponsor of a guest invite is an active
+// member who isn't a guest or a bot.
+func (a *App) validateGuestSponsor(sponsorID string) *model.AppError {
+	sponsor, err := a.GetUser(sponsorID)
+	if err != nil {
+		return model.NewAppError("validateGuestSponsor", "app.guest_account.invalid_sponsor.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if sponsor.DeleteAt != 0 || sponsor.IsGuest() || sponsor.IsBot {
+		return model.NewAppError("validateGuestSponsor", "app.guest_account.invalid_sponsor.app_error", nil, "sponsor_id="+sponsorID, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// guestInviteRequiresApproval returns true if guest invites sent by the given
+// user must be approved by a system admin before the invite emails are sent.
+func (a *App) guestInviteRequiresApproval(senderID string) bool {
+	return *a.Config().GuestAccountsSettings.RequireInviteApproval && !a.HasPermissionTo(senderID, model.PermissionManageSystem)
+}
+
+func (a *App) savePendingGuestInvite(team *model.Team, sender *model.User, guestsInvite *model.GuestsInvite, emails []string) (*model.PendingGuestInvite, *model.AppError) {
+	invite, err := a.Srv().Store().GuestAccount().SavePendingInvite(&model.PendingGuestInvite{
+		TeamId:    team.Id,
+		SenderId:  sender.Id,
+		SponsorId: guestsInvite.SponsorId,
+		Emails:    emails,
+		Channels:  guestsInvite.Channels,
+		Message:   guestsInvite.Message,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("savePendingGuestInvite", "app.guest_account.save_pending_invite.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return invite, nil
+}
+
+func (a *App) GetPendingGuestInvites(page, perPage int) ([]*model.PendingGuestInvite, *model.AppError) {
+	invites, err := a.Srv().Store().GuestAccount().GetPendingInvites(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetPendingGuestInvites", "app.guest_account.get_pending_invites.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return invites, nil
+}
+
+func (a *App) GetPendingGuestInvite(inviteID string) (*model.PendingGuestInvite, *model.AppError) {
+	invite, err := a.Srv().Store().GuestAccount().GetPendingInvite(inviteID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPendingGuestInvite", "app.guest_account.get_pending_invite.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetPendingGuestInvite", "app.guest_account.get_pending_invite.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return invite, nil
+}
+
+// ApprovePendingGuestInvite sends the emails of a pending guest invite on
+// behalf of the member who sent it, and removes it from the pending invites.
+func (a *App) ApprovePendingGuestInvite(rctx request.CTX, inviteID string) ([]*model.EmailInviteWithError, *model.AppError) {
+	invite, appErr := a.GetPendingGuestInvite(inviteID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	guestsInvite := invite.GuestsInvite()
+	guestsInvite.Channels = a.ValidateUserPermissionsOnChannels(rctx, invite.SenderId, guestsInvite.Channels)
+
+	invitesWithError, appErr := a.inviteGuestsToChannelsGracefully(rctx, invite.TeamId, guestsInvite, invite.SenderId, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.DeletePendingGuestInvite(inviteID); appErr != nil {
+		return nil, appErr
+	}
+
+	return invitesWithError, nil
+}
+
+func (a *App) DeletePendingGuestInvite(inviteID string) *model.AppError {
+	if err := a.Srv().Store().GuestAccount().DeletePendingInvite(inviteID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeletePendingGuestInvite", "app.guest_account.get_pending_invite.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeletePendingGuestInvite", "app.guest_account.delete_pending_invite.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// createGuestAccount records the sponsor of a newly created guest and, if
+// guest accounts expire, when their account expires.
+func (a *App) createGuestAccount(userID, sponsorID string) *model.AppError {
+	now := model.GetMillis()
+	_, err := a.Srv().Store().GuestAccount().Save(&model.GuestAccount{
+		UserId:    userID,
+		SponsorId: sponsorID,
+		ExpiresAt: model.GuestAccountExpiry(now, *a.Config().GuestAccountsSettings.ExpiryDays),
+		CreateAt:  now,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return appErr
+		default:
+			return model.NewAppError("createGuestAccount", "app.guest_account.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// ensureGuestAccount records a guest without a sponsor, such as a demoted
+// user, unless they already have an account.
+func (a *App) ensureGuestAccount(userID string) *model.AppError {
+	if _, err := a.Srv().Store().GuestAccount().Get(userID); err == nil {
+		return nil
+	} else if nfErr := new(store.ErrNotFound); !errors.As(err, &nfErr) {
+		return model.NewAppError("ensureGuestAccount", "app.guest_account.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.createGuestAccount(userID, "")
+}
+
+func (a *App) GetGuestAccount(userID string) (*model.GuestAccount, *model.AppError) {
+	account, err := a.Srv().Store().GuestAccount().Get(userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetGuestAccount", "app.guest_account.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetGuestAccount", "app.guest_account.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return account, nil
+}
+
+// ExtendGuestAccount moves the expiry date of a guest account to expiresAt,
+// or by the configured number of expiry days if expiresAt is zero. Unless
+// unbounded is set, as it is for system admins, expiresAt can't be further
+// than the configured number of expiry days from now. A guest deactivated
+// because their account had expired is reactivated, but not one deactivated
+// for any other reason.
+func (a *App) ExtendGuestAccount(rctx request.CTX, userID string, expiresAt int64, unbounded bool) (*model.GuestAccount, *model.AppError) {
+	account, appErr := a.GetGuestAccount(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	now := model.GetMillis()
+	expiryDays := *a.Config().GuestAccountsSettings.ExpiryDays
+	if expiresAt == 0 {
+		expiresAt = model.GuestAccountExpiry(max(now, account.ExpiresAt), expiryDays)
+	} else if expiresAt <= now {
+		return nil, model.NewAppError("ExtendGuestAccount", "app.guest_account.extend.invalid_expires_at.app_error", nil, "", http.StatusBadRequest)
+	} else if maxExpiresAt := model.GuestAccountExpiry(now, expiryDays); !unbounded && maxExpiresAt != 0 && expiresAt > maxExpiresAt {
+		return nil, model.NewAppError("ExtendGuestAccount", "app.guest_account.extend.expires_at_too_late.app_error", map[string]any{"Days": expiryDays}, "", http.StatusBadRequest)
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+	deactivatedOnExpiry := user.DeleteAt != 0 && user.DeleteAt == account.ExpiryDeactivatedAt
+
+	account.ExpiresAt = expiresAt
+	account.ExpiryNotifiedAt = 0
+	account.ExpiryDeactivatedAt = 0
+	account, err := a.Srv().Store().GuestAccount().Update(account)
+	if err != nil {
+		return nil, model.NewAppError("ExtendGuestAccount", "app.guest_account.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if deactivatedOnExpiry {
+		if _, appErr := a.UpdateActive(rctx, user, true); appErr != nil {
+			return nil, appErr
+		}
+	}
+
+	return account, nil
+}
+
+func (a *App) deleteGuestAccount(userID string) *model.AppError {
+	if err := a.Srv().Store().GuestAccount().PermanentDeleteByUser(userID); err != nil {
+		return model.NewAppError("deleteGuestAccount", "app.guest_account.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// ProcessGuestAccountExpiry is called periodically from the job server to
+// deactivate the guests whose account has expired, then warn the guests whose
+// account is about to expire, and their sponsors.
+func (a *App) ProcessGuestAccountExpiry() error {
+	rctx := request.EmptyContext(a.Log())
+	now := model.GetMillis()
+
+	// Guests that weren't invited, such as those synchronized from SAML or
+	// LDAP, get an account the first time they are seen.
+	for {
+		userIDs, err := a.Srv().Store().GuestAccount().GetUntrackedGuestIds(guestAccountExpiryBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessGuestAccountExpiry", "app.guest_account.get_untracked.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, userID := range userIDs {
+			if appErr := a.createGuestAccount(userID, ""); appErr != nil {
+				return appErr
+			}
+		}
+
+		if len(userIDs) < guestAccountExpiryBatchSize {
+			break
+		}
+	}
+
+	for {
+		accounts, err := a.Srv().Store().GuestAccount().GetExpired(now, guestAccountExpiryBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessGuestAccountExpiry", "app.guest_account.get_expired.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, account := range accounts {
+			user, appErr := a.GetUser(account.UserId)
+			if appErr != nil {
+				return appErr
+			}
+
+			deactivated, appErr := a.UpdateActive(rctx, user, false)
+			if appErr != nil {
+				return appErr
+			}
+
+			account.ExpiryDeactivatedAt = deactivated.DeleteAt
+			if _, err := a.Srv().Store().GuestAccount().Update(account); err != nil {
+				return model.NewAppError("ProcessGuestAccountExpiry", "app.guest_account.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			rctx.Logger().Info("Deactivated expired guest account", mlog.String("user_id", user.Id), mlog.String("sponsor_id", account.SponsorId))
+		}
+
+		if len(accounts) < guestAccountExpiryBatchSize {
+			break
+		}
+	}
+
+	warningDays := *a.Config().GuestAccountsSettings.ExpiryWarningDays
+	if warningDays == 0 {
+		return nil
+	}
+
+	warnBefore := now + int64(warningDays)*int64(24*time.Hour/time.Millisecond)
+	for {
+		accounts, err := a.Srv().Store().GuestAccount().GetExpiring(warnBefore, guestAccountExpiryBatchSize)
+		if err != nil {
+			return model.NewAppError("ProcessGuestAccountExpiry", "app.guest_account.get_expiring.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, account := range accounts {
+			a.sendGuestExpiryWarnings(rctx, account, now)
+
+			account.ExpiryNotifiedAt = now
+			if _, err := a.Srv().Store().GuestAccount().Update(account); err != nil {
+				return model.NewAppError("ProcessGuestAccountExpiry", "app.guest_account.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+
+		if len(accounts) < guestAccountExpiryBatchSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *App) sendGuestExpiryWarnings(rctx request.CTX, account *model.GuestAccount, now int64) {
+	if !*a.Config().EmailSettings.SendEmailNotifications {
+		return
+	}
+
+	guest, appErr := a.GetUser(account.UserId)
+	if appErr != nil {
+		rctx.Logger().Warn("Unable to get the guest to warn of their account expiry", mlog.String("user_id", account.UserId), mlog.Err(appErr))
+		return
+	}
+
+	daysToExpiry := int((account.ExpiresAt - now + int64(24*time.Hour/time.Millisecond) - 1) / int64(24*time.Hour/time.Millisecond))
+	siteURL := a.GetSiteURL()
+
+	if err := a.Srv().EmailService.SendGuestExpiryWarningEmail(guest.Email, guest.Locale, siteURL, "", daysToExpiry, false); err != nil {
+		rctx.Logger().Warn("Unable to send the guest account expiry warning", mlog.String("user_id", guest.Id), mlog.Err(err))
+	}
+
+	if account.SponsorId == "" {
+		return
+	}
+
+	sponsor, appErr := a.GetUser(account.SponsorId)
+	if appErr != nil {
+		rctx.Logger().Warn("Unable to get the sponsor to warn of a guest account expiry", mlog.String("user_id", account.UserId), mlog.String("sponsor_id", account.SponsorId), mlog.Err(appErr))
+		return
+	}
+
+	if sponsor.DeleteAt != 0 {
+		return
+	}
+
+	guestName := guest.GetDisplayName(*a.Config().TeamSettings.TeammateNameDisplay)
+	if err := a.Srv().EmailService.SendGuestExpiryWarningEmail(sponsor.Email, sponsor.Locale, siteURL, guestName, daysToExpiry, true); err != nil {
+		rctx.Logger().Warn("Unable to send the guest account expiry warning to the sponsor", mlog.String("user_id", guest.Id), mlog.String("sponsor_id", sponsor.Id), mlog.Err(err))
+	}
+}
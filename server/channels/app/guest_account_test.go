@@ -0,0 +1,242 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	emailmocks "github.com/mattermost/mattermost/server/v8/channels/app/email/mocks"
+)
+
+func TestExtendGuestAccount(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.GuestAccountsSettings.ExpiryDays = 30
+	})
+
+	guest := th.CreateGuest()
+	require.Nil(t, th.App.createGuestAccount(guest.Id, th.BasicUser.Id))
+
+	account, appErr := th.App.GetGuestAccount(guest.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicUser.Id, account.SponsorId)
+	require.NotZero(t, account.ExpiresAt)
+
+	t.Run("extend by the configured number of days", func(t *testing.T) {
+		extended, appErr := th.App.ExtendGuestAccount(th.Context, guest.Id, 0, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.GuestAccountExpiry(account.ExpiresAt, 30), extended.ExpiresAt)
+	})
+
+	t.Run("expiry date in the past", func(t *testing.T) {
+		_, appErr := th.App.ExtendGuestAccount(th.Context, guest.Id, model.GetMillis()-1000, false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("sponsors can't extend past the configured number of days", func(t *testing.T) {
+		expiresAt := model.GuestAccountExpiry(model.GetMillis(), 30) + 60*60*1000
+
+		_, appErr := th.App.ExtendGuestAccount(th.Context, guest.Id, expiresAt, false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.guest_account.extend.expires_at_too_late.app_error", appErr.Id)
+
+		extended, appErr := th.App.ExtendGuestAccount(th.Context, guest.Id, expiresAt, true)
+		require.Nil(t, appErr)
+		assert.Equal(t, expiresAt, extended.ExpiresAt)
+	})
+
+	t.Run("reactivates expired guests", func(t *testing.T) {
+		account, appErr := th.App.GetGuestAccount(guest.Id)
+		require.Nil(t, appErr)
+		account.ExpiresAt = model.GetMillis() - 1000
+		_, err := th.App.Srv().Store().GuestAccount().Update(account)
+		require.NoError(t, err)
+
+		require.NoError(t, th.App.ProcessGuestAccountExpiry())
+		deactivated, appErr := th.App.GetUser(guest.Id)
+		require.Nil(t, appErr)
+		require.NotZero(t, deactivated.DeleteAt)
+
+		expiresAt := model.GetMillis() + 60*60*1000
+		extended, appErr := th.App.ExtendGuestAccount(th.Context, guest.Id, expiresAt, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, expiresAt, extended.ExpiresAt)
+
+		reactivated, appErr := th.App.GetUser(guest.Id)
+		require.Nil(t, appErr)
+		assert.Zero(t, reactivated.DeleteAt)
+	})
+
+	t.Run("doesn't reactivate guests deactivated for another reason", func(t *testing.T) {
+		account, appErr := th.App.GetGuestAccount(guest.Id)
+		require.Nil(t, appErr)
+		account.ExpiresAt = model.GetMillis() - 1000
+		_, err := th.App.Srv().Store().GuestAccount().Update(account)
+		require.NoError(t, err)
+
+		require.NoError(t, th.App.ProcessGuestAccountExpiry())
+		deactivated, appErr := th.App.GetUser(guest.Id)
+		require.Nil(t, appErr)
+		require.NotZero(t, deactivated.DeleteAt)
+
+		// An admin reactivates the guest, then deactivates them on purpose.
+		_, appErr = th.App.UpdateActive(th.Context, deactivated, true)
+		require.Nil(t, appErr)
+		time.Sleep(time.Millisecond)
+		_, appErr = th.App.UpdateActive(th.Context, deactivated, false)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.ExtendGuestAccount(th.Context, guest.Id, 0, false)
+		require.Nil(t, appErr)
+
+		user, appErr := th.App.GetUser(guest.Id)
+		require.Nil(t, appErr)
+		assert.NotZero(t, user.DeleteAt)
+	})
+}
+
+func TestProcessGuestAccountExpiry(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.GuestAccountsSettings.ExpiryWarningDays = 7
+		*cfg.EmailSettings.SendEmailNotifications = true
+	})
+
+	expiring := th.CreateGuest()
+	_, err := th.App.Srv().Store().GuestAccount().Save(&model.GuestAccount{
+		UserId:    expiring.Id,
+		SponsorId: th.BasicUser.Id,
+		ExpiresAt: model.GetMillis() + 2*24*60*60*1000,
+	})
+	require.NoError(t, err)
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("SendGuestExpiryWarningEmail", expiring.Email, mock.AnythingOfType("string"), mock.AnythingOfType("string"), "", 2, false).Once().Return(nil)
+	emailServiceMock.On("SendGuestExpiryWarningEmail", th.BasicUser.Email, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), 2, true).Once().Return(nil)
+	emailServiceMock.On("Stop").Once().Return()
+	th.App.Srv().EmailService = &emailServiceMock
+
+	require.NoError(t, th.App.ProcessGuestAccountExpiry())
+
+	account, appErr := th.App.GetGuestAccount(expiring.Id)
+	require.Nil(t, appErr)
+	assert.NotZero(t, account.ExpiryNotifiedAt)
+
+	// Guests are warned only once.
+	require.NoError(t, th.App.ProcessGuestAccountExpiry())
+	emailServiceMock.AssertExpectations(t)
+
+	user, appErr := th.App.GetUser(expiring.Id)
+	require.Nil(t, appErr)
+	assert.Zero(t, user.DeleteAt)
+}
+
+func TestGuestAccountsWithoutSponsor(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.GuestAccountsSettings.ExpiryDays = 30
+	})
+
+	t.Run("demoted users", func(t *testing.T) {
+		user := th.CreateUser()
+		require.Nil(t, th.App.DemoteUserToGuest(th.Context, user))
+
+		account, appErr := th.App.GetGuestAccount(user.Id)
+		require.Nil(t, appErr)
+		assert.Empty(t, account.SponsorId)
+		assert.NotZero(t, account.ExpiresAt)
+	})
+
+	t.Run("guests created outside of invites", func(t *testing.T) {
+		guest := th.CreateGuest()
+		_, appErr := th.App.GetGuestAccount(guest.Id)
+		require.NotNil(t, appErr)
+
+		require.NoError(t, th.App.ProcessGuestAccountExpiry())
+
+		account, appErr := th.App.GetGuestAccount(guest.Id)
+		require.Nil(t, appErr)
+		assert.Empty(t, account.SponsorId)
+		assert.NotZero(t, account.ExpiresAt)
+	})
+}
+
+func TestGuestInviteApproval(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableEmailInvitations = true
+		*cfg.GuestAccountsSettings.RequireInviteApproval = true
+	})
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("Stop").Once().Return()
+	th.App.Srv().EmailService = &emailServiceMock
+
+	res, appErr := th.App.InviteGuestsToChannelsGracefully(th.Context, th.BasicTeam.Id, &model.GuestsInvite{
+		Emails:    []string{"guest@example.com"},
+		Channels:  []string{th.BasicChannel.Id},
+		SponsorId: th.BasicUser2.Id,
+	}, th.BasicUser.Id)
+	require.Nil(t, appErr)
+	require.Len(t, res, 1)
+	require.Nil(t, res[0].Error)
+	emailServiceMock.AssertNotCalled(t, "SendGuestInviteEmails")
+
+	invites, appErr := th.App.GetPendingGuestInvites(0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, invites, 1)
+	assert.Equal(t, th.BasicUser.Id, invites[0].SenderId)
+	assert.Equal(t, th.BasicUser2.Id, invites[0].SponsorId)
+
+	emailServiceMock.On("SendGuestInviteEmails",
+		mock.AnythingOfType("*model.Team"),
+		mock.AnythingOfType("[]*model.Channel"),
+		mock.AnythingOfType("string"),
+		th.BasicUser.Id,
+		th.BasicUser2.Id,
+		mock.AnythingOfType("[]uint8"),
+		[]string{"guest@example.com"},
+		mock.AnythingOfType("string"),
+		"",
+		true,
+		false,
+		false,
+	).Once().Return(nil)
+
+	res, appErr = th.App.ApprovePendingGuestInvite(th.Context, invites[0].Id)
+	require.Nil(t, appErr)
+	require.Len(t, res, 1)
+	emailServiceMock.AssertExpectations(t)
+
+	_, appErr = th.App.GetPendingGuestInvite(invites[0].Id)
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	t.Run("guests can't sponsor guests", func(t *testing.T) {
+		guest := th.CreateGuest()
+		_, appErr := th.App.InviteGuestsToChannelsGracefully(th.Context, th.BasicTeam.Id, &model.GuestsInvite{
+			Emails:    []string{"guest@example.com"},
+			Channels:  []string{th.BasicChannel.Id},
+			SponsorId: guest.Id,
+		}, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.guest_account.invalid_sponsor.app_error", appErr.Id)
+	})
+}
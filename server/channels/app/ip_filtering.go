@@ -5,11 +5,129 @@ package app
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"time"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
 )
 
+const (
+	ipFilterReasonInvalidAddress = "invalid_address"
+	ipFilterReasonDenied         = "denied"
+	ipFilterReasonNotAllowed     = "not_allowed"
+	ipFilterReasonRoleNotAllowed = "role_not_allowed"
+
+	// ipFilterBlockedAuditInterval is how often blocked attempts from the same address and
+	// session are audited, so that a client retrying in a loop doesn't flood the audit log.
+	ipFilterBlockedAuditInterval = time.Minute
+	ipFilterBlockedCacheSize     = 10000
+	// ipFilterBlockedCacheTTL keeps the count of unaudited attempts long enough for it to be
+	// reported by the next audit record of a client that keeps retrying.
+	ipFilterBlockedCacheTTL = time.Hour
+)
+
+// ipFilterBlockedAttempts tracks the blocked attempts of an address and session.
+type ipFilterBlockedAttempts struct {
+	LastAuditAt int64
+	Unaudited   int64
+}
+
+// ipFilter is the parsed form of the IPFilteringSettings of a given config.
+type ipFilter struct {
+	config  *model.Config
+	enabled bool
+	allowed []*net.IPNet
+	denied  []*net.IPNet
+	roles   map[string][]*net.IPNet
+}
+
+// newIPFilter parses the IPFilteringSettings of the given config. The settings
+// are validated along with the rest of the config, so ranges that fail to
+// parse are ignored.
+func newIPFilter(cfg *model.Config) *ipFilter {
+	settings := cfg.IPFilteringSettings
+	filter := &ipFilter{
+		config:  cfg,
+		enabled: *settings.Enable,
+		roles:   make(map[string][]*net.IPNet, len(settings.RoleIPRanges)),
+	}
+	if !filter.enabled {
+		return filter
+	}
+
+	filter.allowed, _ = model.ParseIPRanges(*settings.AllowedIPRanges)
+	filter.denied, _ = model.ParseIPRanges(*settings.DeniedIPRanges)
+	for role, ranges := range settings.RoleIPRanges {
+		filter.roles[role], _ = model.ParseIPRanges(ranges)
+	}
+
+	return filter
+}
+
+func ipInRanges(ip net.IP, ranges []*net.IPNet) bool {
+	for _, ipNet := range ranges {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns the reason the given address is not allowed for a user with
+// the given roles, or an empty string if it is allowed. Denied ranges always
+// apply. Users with a role that has its own ranges must connect from within
+// the ranges of every such role, in place of the allowed ranges.
+func (f *ipFilter) check(ipAddress string, roles []string) string {
+	if !f.enabled {
+		return ""
+	}
+
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return ipFilterReasonInvalidAddress
+	}
+
+	if ipInRanges(ip, f.denied) {
+		return ipFilterReasonDenied
+	}
+
+	hasRoleRanges := false
+	for _, role := range roles {
+		ranges, ok := f.roles[role]
+		if !ok {
+			continue
+		}
+		hasRoleRanges = true
+		if !ipInRanges(ip, ranges) {
+			return ipFilterReasonRoleNotAllowed
+		}
+	}
+
+	if !hasRoleRanges && len(f.allowed) > 0 && !ipInRanges(ip, f.allowed) {
+		return ipFilterReasonNotAllowed
+	}
+
+	return ""
+}
+
+// getIPFilter returns the IP filter for the current config, parsing it again
+// only when the config changes.
+func (s *Server) getIPFilter() *ipFilter {
+	cfg := s.platform.Config()
+	if filter := s.ipFilter.Load(); filter != nil && filter.config == cfg {
+		return filter
+	}
+
+	filter := newIPFilter(cfg)
+	s.ipFilter.Store(filter)
+
+	return filter
+}
+
 func (a *App) SendIPFiltersChangedEmail(c request.CTX, userID string) error {
 	cloudWorkspaceOwnerEmailAddress := ""
 	if a.License().IsCloud() {
@@ -40,3 +158,71 @@ func (a *App) SendIPFiltersChangedEmail(c request.CTX, userID string) error {
 
 	return nil
 }
+
+// shouldAuditIPFilterBlocked returns whether a blocked attempt from the given address and
+// session is due an audit record and, if so, how many attempts were blocked since the last
+// record without being audited.
+func (s *Server) shouldAuditIPFilterBlocked(ipAddress, sessionID string) (bool, int64) {
+	s.ipFilterBlockedMut.Lock()
+	defer s.ipFilterBlockedMut.Unlock()
+
+	key := ipAddress + ":" + sessionID
+	now := model.GetMillis()
+
+	var attempts ipFilterBlockedAttempts
+	if err := s.ipFilterBlockedCache.Get(key, &attempts); err == nil && now-attempts.LastAuditAt < ipFilterBlockedAuditInterval.Milliseconds() {
+		attempts.Unaudited++
+		if err := s.ipFilterBlockedCache.SetWithDefaultExpiry(key, attempts); err != nil {
+			s.Log().Warn("Failed to count a blocked attempt", mlog.String("ip_address", ipAddress), mlog.Err(err))
+		}
+		return false, 0
+	}
+
+	if err := s.ipFilterBlockedCache.SetWithDefaultExpiry(key, ipFilterBlockedAttempts{LastAuditAt: now}); err != nil {
+		s.Log().Warn("Failed to record an audited blocked attempt", mlog.String("ip_address", ipAddress), mlog.Err(err))
+	}
+	return true, attempts.Unaudited
+}
+
+// CheckIPFilter returns an error if IPFilteringSettings don't allow the given
+// session to connect from ipAddress, logging an audit record of the blocked
+// attempt. Attempts from the same address and session are audited at most once
+// per ipFilterBlockedAuditInterval, the next record counting those left out.
+// Anonymous requests are checked using an empty session.
+func (a *App) CheckIPFilter(rctx request.CTX, ipAddress string, session *model.Session) *model.AppError {
+	reason := a.Srv().getIPFilter().check(ipAddress, session.GetUserRoles())
+	if reason == "" {
+		return nil
+	}
+
+	appErr := model.NewAppError("CheckIPFilter", "app.ip_filtering.blocked.app_error", nil, "ip="+ipAddress+" reason="+reason, http.StatusForbidden)
+
+	due, unaudited := a.Srv().shouldAuditIPFilterBlocked(ipAddress, session.Id)
+	if !due {
+		return appErr
+	}
+
+	auditRec := a.MakeAuditRecord(rctx, "ipFilterBlocked", audit.Fail)
+	auditRec.Actor.UserId = session.UserId
+	auditRec.Actor.SessionId = session.Id
+	auditRec.Actor.Client = rctx.UserAgent()
+	auditRec.Actor.IpAddress = ipAddress
+	auditRec.Actor.XForwardedFor = rctx.XForwardedFor()
+	auditRec.AddMeta(audit.KeyAPIPath, rctx.Path())
+	auditRec.AddMeta("reason", reason)
+	auditRec.AddMeta("unaudited_attempts", unaudited)
+	a.LogAuditRecWithLevel(rctx, auditRec, LevelAPI, appErr)
+
+	return appErr
+}
+
+// CheckIPFilterLockout returns an error if saving the given config would block
+// the given session from connecting from ipAddress, so that admins cannot lock
+// themselves out when changing IPFilteringSettings.
+func (a *App) CheckIPFilterLockout(cfg *model.Config, ipAddress string, session *model.Session) *model.AppError {
+	if reason := newIPFilter(cfg).check(ipAddress, session.GetUserRoles()); reason != "" {
+		return model.NewAppError("CheckIPFilterLockout", "app.ip_filtering.lockout.app_error", map[string]any{"IPAddress": ipAddress}, "reason="+reason, http.StatusBadRequest)
+	}
+
+	return nil
+}
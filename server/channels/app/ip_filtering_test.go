@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestIPFilterCheck(t *testing.T) {
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	*cfg.IPFilteringSettings.Enable = true
+	*cfg.IPFilteringSettings.AllowedIPRanges = "10.0.0.0/8 2001:db8::/32"
+	*cfg.IPFilteringSettings.DeniedIPRanges = "10.0.0.0/24"
+	cfg.IPFilteringSettings.RoleIPRanges = map[string]string{
+		model.SystemAdminRoleId:   "10.8.0.0/16",
+		model.SystemManagerRoleId: "10.8.1.0/24 192.168.0.1",
+	}
+	require.Nil(t, cfg.IsValid())
+
+	filter := newIPFilter(cfg)
+	userRoles := []string{model.SystemUserRoleId}
+	adminRoles := []string{model.SystemUserRoleId, model.SystemAdminRoleId}
+	managerRoles := []string{model.SystemUserRoleId, model.SystemManagerRoleId}
+
+	for _, tc := range []struct {
+		name      string
+		ipAddress string
+		roles     []string
+		expected  string
+	}{
+		{"allowed anonymous", "10.1.0.1", nil, ""},
+		{"allowed IPv6", "2001:db8::1", userRoles, ""},
+		{"not allowed", "192.168.0.1", userRoles, ipFilterReasonNotAllowed},
+		{"denied", "10.0.0.1", userRoles, ipFilterReasonDenied},
+		{"invalid address", "@", userRoles, ipFilterReasonInvalidAddress},
+		{"role allowed", "10.8.0.1", adminRoles, ""},
+		{"role not allowed within allowed ranges", "10.1.0.1", adminRoles, ipFilterReasonRoleNotAllowed},
+		{"role ranges replace allowed ranges", "192.168.0.1", managerRoles, ""},
+		{"every role range must match", "10.8.1.1", append(adminRoles, model.SystemManagerRoleId), ""},
+		{"every role range must match, one failing", "10.8.2.1", append(adminRoles, model.SystemManagerRoleId), ipFilterReasonRoleNotAllowed},
+		{"denied applies to roles", "10.0.0.1", managerRoles, ipFilterReasonDenied},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, filter.check(tc.ipAddress, tc.roles))
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		*cfg.IPFilteringSettings.Enable = false
+		assert.Empty(t, newIPFilter(cfg).check("@", userRoles))
+	})
+}
+
+func TestCheckIPFilter(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPFilteringSettings.Enable = true
+		*cfg.IPFilteringSettings.AllowedIPRanges = "10.0.0.0/8"
+	})
+
+	session := &model.Session{UserId: th.BasicUser.Id, Roles: th.BasicUser.Roles}
+	assert.Nil(t, th.App.CheckIPFilter(th.Context, "10.0.0.1", session))
+
+	appErr := th.App.CheckIPFilter(th.Context, "192.168.0.1", session)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.ip_filtering.blocked.app_error", appErr.Id)
+
+	t.Run("repeated blocked attempts are audited once per interval", func(t *testing.T) {
+		ipAddress := "172.16.0.1"
+		sessionID := model.NewId()
+
+		due, unaudited := th.App.Srv().shouldAuditIPFilterBlocked(ipAddress, sessionID)
+		assert.True(t, due)
+		assert.Zero(t, unaudited)
+
+		for range 3 {
+			due, _ = th.App.Srv().shouldAuditIPFilterBlocked(ipAddress, sessionID)
+			assert.False(t, due)
+		}
+
+		due, _ = th.App.Srv().shouldAuditIPFilterBlocked(ipAddress, model.NewId())
+		assert.True(t, due, "other sessions are audited separately")
+
+		require.NoError(t, th.App.Srv().ipFilterBlockedCache.SetWithDefaultExpiry(ipAddress+":"+sessionID, ipFilterBlockedAttempts{
+			LastAuditAt: model.GetMillis() - ipFilterBlockedAuditInterval.Milliseconds(),
+			Unaudited:   3,
+		}))
+		due, unaudited = th.App.Srv().shouldAuditIPFilterBlocked(ipAddress, sessionID)
+		assert.True(t, due)
+		assert.Equal(t, int64(3), unaudited)
+	})
+
+	t.Run("changes to the config are applied", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.IPFilteringSettings.AllowedIPRanges = "192.168.0.0/16"
+		})
+		assert.Nil(t, th.App.CheckIPFilter(th.Context, "192.168.0.1", session))
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		cfg := th.App.Config().Clone()
+		*cfg.IPFilteringSettings.AllowedIPRanges = "172.16.0.0/12"
+
+		appErr := th.App.CheckIPFilterLockout(cfg, "192.168.0.1", session)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.ip_filtering.lockout.app_error", appErr.Id)
+		assert.Nil(t, th.App.CheckIPFilterLockout(cfg, "172.16.0.1", session))
+	})
+}
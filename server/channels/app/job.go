@@ -100,6 +100,7 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		model.JobTypePlugins,
 		model.JobTypeProductNotices,
 		model.JobTypeExpiryNotify,
+		model.JobTypeGuestExpiry,
 		model.JobTypeActiveUsers,
 		model.JobTypeImportProcess,
 		model.JobTypeImportDelete,
@@ -110,6 +111,10 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		return a.SessionHasPermissionTo(session, model.PermissionManageJobs), model.PermissionManageJobs
 	}
 
+	if model.IsPluginJobType(job.Type) {
+		return a.SessionHasPermissionTo(session, model.PermissionManageJobs), model.PermissionManageJobs
+	}
+
 	return false, nil
 }
 
@@ -134,6 +139,8 @@ func (a *App) SessionHasPermissionToManageJob(session model.Session, job *model.
 		model.JobTypePlugins,
 		model.JobTypeProductNotices,
 		model.JobTypeExpiryNotify,
+		model.JobTypeGuestExpiry,
+		model.JobTypeUserDataExport,
 		model.JobTypeActiveUsers,
 		model.JobTypeImportProcess,
 		model.JobTypeImportDelete,
@@ -142,6 +149,10 @@ func (a *App) SessionHasPermissionToManageJob(session model.Session, job *model.
 		model.JobTypeCloud,
 		model.JobTypeExtractContent:
 		permission = model.PermissionManageJobs
+	default:
+		if model.IsPluginJobType(job.Type) {
+			permission = model.PermissionManageJobs
+		}
 	}
 
 	if permission == nil {
@@ -169,6 +180,8 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		model.JobTypePlugins,
 		model.JobTypeProductNotices,
 		model.JobTypeExpiryNotify,
+		model.JobTypeGuestExpiry,
+		model.JobTypeUserDataExport,
 		model.JobTypeActiveUsers,
 		model.JobTypeImportProcess,
 		model.JobTypeImportDelete,
@@ -180,5 +193,9 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		return a.SessionHasPermissionTo(session, model.PermissionReadJobs), model.PermissionReadJobs
 	}
 
+	if model.IsPluginJobType(jobType) {
+		return a.SessionHasPermissionTo(session, model.PermissionReadJobs), model.PermissionReadJobs
+	}
+
 	return false, nil
 }
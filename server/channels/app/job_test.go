@@ -214,6 +214,43 @@ func TestSessionHasPermissionToReadJob(t *testing.T) {
 	}
 }
 
+func TestSessionHasPermissionToPluginJob(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	job := &model.Job{
+		Id:   model.NewId(),
+		Type: model.PluginJobType("sync"),
+	}
+
+	adminSession := model.Session{
+		Roles: model.SystemUserRoleId + " " + model.SystemAdminRoleId,
+	}
+	userSession := model.Session{
+		Roles: model.SystemUserRoleId,
+	}
+
+	hasPermission, permissionRequired := th.App.SessionHasPermissionToCreateJob(adminSession, job)
+	assert.True(t, hasPermission)
+	assert.Equal(t, model.PermissionManageJobs, permissionRequired)
+
+	hasPermission, permissionRequired = th.App.SessionHasPermissionToManageJob(adminSession, job)
+	assert.True(t, hasPermission)
+	assert.Equal(t, model.PermissionManageJobs, permissionRequired)
+
+	hasPermission, permissionRequired = th.App.SessionHasPermissionToReadJob(adminSession, job.Type)
+	assert.True(t, hasPermission)
+	assert.Equal(t, model.PermissionReadJobs, permissionRequired)
+
+	hasPermission, permissionRequired = th.App.SessionHasPermissionToManageJob(userSession, job)
+	assert.False(t, hasPermission)
+	assert.Equal(t, model.PermissionManageJobs, permissionRequired)
+
+	hasPermission, permissionRequired = th.App.SessionHasPermissionToReadJob(userSession, job.Type)
+	assert.False(t, hasPermission)
+	assert.Equal(t, model.PermissionReadJobs, permissionRequired)
+}
+
 func TestGetJobByType(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
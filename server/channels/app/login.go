@@ -168,6 +168,12 @@ func (a *App) DoLogin(c request.CTX, w http.ResponseWriter, r *http.Request, use
 		return nil, model.NewAppError("DoLogin", "Login rejected by plugin: "+rejectionReason, nil, "", http.StatusBadRequest)
 	}
 
+	// Requests are filtered before the user is known, so role specific ranges
+	// are only applied here.
+	if appErr := a.CheckIPFilter(c, utils.GetTrustedIPAddress(r, a.Config().ServiceSettings.TrustedProxyIPHeader), &model.Session{UserId: user.Id, Roles: user.GetRawRoles()}); appErr != nil {
+		return nil, appErr
+	}
+
 	session := &model.Session{UserId: user.Id, Roles: user.GetRawRoles(), DeviceId: deviceID, IsOAuth: false, Props: map[string]string{
 		model.UserAuthServiceIsMobile: strconv.FormatBool(isMobile),
 		model.UserAuthServiceIsSaml:   strconv.FormatBool(isSaml),
@@ -201,18 +207,43 @@ func (a *App) DoLogin(c request.CTX, w http.ResponseWriter, r *http.Request, use
 	session.AddProp(model.SessionPropPlatform, plat)
 	session.AddProp(model.SessionPropOs, os)
 	session.AddProp(model.SessionPropBrowser, fmt.Sprintf("%v/%v", bname, bversion))
+	session.AddProp(model.SessionPropUserAgent, limitStringLength(r.UserAgent(), model.SessionUserAgentMaxLength))
+	session.AddProp(model.SessionPropIpAddress, c.IPAddress())
+	session.AddProp(model.SessionPropLastIpAddress, c.IPAddress())
 	if user.IsGuest() {
 		session.AddProp(model.SessionPropIsGuest, "true")
 	} else {
 		session.AddProp(model.SessionPropIsGuest, "false")
 	}
 
-	var err *model.AppError
+	location := newLoginLocation(c.IPAddress(), plat, os, bname)
+	isNewLocation, err := a.isNewLoginLocation(user.Id, location)
+	if err != nil {
+		c.Logger().Warn("Unable to check the login location", mlog.String("user_id", user.Id), mlog.Err(err))
+	}
+
+	if isNewLocation && a.canVerifyLoginLocation() {
+		if err = a.requestLoginLocationVerification(c, user, location); err != nil {
+			return nil, err
+		}
+		return nil, model.NewAppError("DoLogin", "api.user.login.new_location_verification_required.app_error", nil, "user_id="+user.Id, http.StatusUnauthorized)
+	}
+
 	if session, err = a.CreateSession(c, session); err != nil {
 		err.StatusCode = http.StatusInternalServerError
 		return nil, err
 	}
 
+	a.Srv().Go(func() {
+		if err := a.recordLoginLocation(user.Id, location); err != nil {
+			c.Logger().Warn("Unable to record the login location", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+
+		if isNewLocation {
+			a.notifyNewLoginLocation(c, user, location)
+		}
+	})
+
 	if updateErr := a.Srv().Store().User().UpdateLastLogin(user.Id, session.CreateAt); updateErr != nil {
 		return nil, model.NewAppError("DoLogin", "app.login.doLogin.updateLastLogin.error", nil, "", http.StatusInternalServerError).Wrap(updateErr)
 	}
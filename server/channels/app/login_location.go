@@ -0,0 +1,260 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	TokenTypeLoginVerification  = "login_verification"
+	LoginVerificationExpiryTime = 1000 * 60 * 60 // 1 hour
+
+	// loginVerificationMaxPendingTokens caps the verification emails a user can be sent within
+	// LoginVerificationExpiryTime, across all the new locations they are logged in from.
+	loginVerificationMaxPendingTokens = 3
+)
+
+// loginLocation describes where a login attempt comes from, both as shown to
+// the user and as the fingerprints used to recognise it on later logins.
+type loginLocation struct {
+	IPAddress string
+	IPRange   string
+	UserAgent string
+	Device    string
+}
+
+func newLoginLocation(ipAddress, platform, os, browserName string) *loginLocation {
+	device := browserName
+	if os != "" {
+		device = fmt.Sprintf("%s (%s)", browserName, os)
+	}
+
+	return &loginLocation{
+		IPAddress: ipAddress,
+		IPRange:   model.LoginIPRange(ipAddress),
+		UserAgent: model.LoginUserAgent(platform, os, browserName),
+		Device:    device,
+	}
+}
+
+func (l *loginLocation) fingerprints(userID string) []*model.LoginFingerprint {
+	fingerprints := []*model.LoginFingerprint{
+		{UserId: userID, Type: model.LoginFingerprintTypeUserAgent, Value: l.UserAgent},
+	}
+
+	// The address can be missing or unparsable, e.g. behind a misconfigured proxy.
+	if l.IPRange != "" {
+		fingerprints = append(fingerprints, &model.LoginFingerprint{UserId: userID, Type: model.LoginFingerprintTypeIPRange, Value: l.IPRange})
+	}
+
+	return fingerprints
+}
+
+// loginLocationTokenExtra is the extra data of a login verification token. UserId comes first so
+// that a user's tokens can be found by the prefix of their encoded extra data.
+type loginLocationTokenExtra struct {
+	UserId    string
+	IPRange   string
+	UserAgent string
+}
+
+// isNewLoginLocation returns true if the user has logged in before, but never
+// from the network range or client of the given location. A user's first login
+// is never considered new, as there is nothing to compare it against.
+func (a *App) isNewLoginLocation(userID string, location *loginLocation) (bool, *model.AppError) {
+	count, err := a.Srv().Store().LoginFingerprint().CountForUser(userID)
+	if err != nil {
+		return false, model.NewAppError("isNewLoginLocation", "app.login_fingerprint.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if count == 0 {
+		return false, nil
+	}
+
+	for _, fingerprint := range location.fingerprints(userID) {
+		_, err := a.Srv().Store().LoginFingerprint().Get(fingerprint.UserId, fingerprint.Type, fingerprint.Value)
+		if err == nil {
+			continue
+		}
+
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return true, nil
+		}
+
+		return false, model.NewAppError("isNewLoginLocation", "app.login_fingerprint.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return false, nil
+}
+
+// recordLoginLocation saves the fingerprints of the location, which only refreshes when they
+// were last seen if the location is already known.
+func (a *App) recordLoginLocation(userID string, location *loginLocation) *model.AppError {
+	now := model.GetMillis()
+	for _, fingerprint := range location.fingerprints(userID) {
+		fingerprint.CreateAt = now
+		if _, err := a.Srv().Store().LoginFingerprint().Save(fingerprint); err != nil {
+			return model.NewAppError("recordLoginLocation", "app.login_fingerprint.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// canVerifyLoginLocation returns true if a login from a new location can be held
+// back until the user confirms it by email.
+func (a *App) canVerifyLoginLocation() bool {
+	return *a.Config().ServiceSettings.RequireNewLoginLocationVerification && *a.Config().EmailSettings.SendEmailNotifications
+}
+
+// requestLoginLocationVerification emails the user a link that, once followed,
+// marks the location as known so that the next login attempt succeeds. While a
+// link for the location is pending, or the user has too many pending links,
+// further attempts don't send any email, so that knowing the password isn't
+// enough to flood the user's inbox.
+func (a *App) requestLoginLocationVerification(c request.CTX, user *model.User, location *loginLocation) *model.AppError {
+	extra := loginLocationTokenExtra{
+		UserId:    user.Id,
+		IPRange:   location.IPRange,
+		UserAgent: location.UserAgent,
+	}
+
+	userPrefix, err := json.Marshal(loginLocationTokenExtra{UserId: user.Id})
+	if err != nil {
+		return model.NewAppError("requestLoginLocationVerification", "app.login_location.create_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	userPrefix = userPrefix[:bytes.IndexByte(userPrefix, ',')]
+
+	since := model.GetMillis() - LoginVerificationExpiryTime + 1
+	tokens, err := a.Srv().Store().Token().GetTokensByTypeAndExtraPrefix(TokenTypeLoginVerification, string(userPrefix), since)
+	if err != nil {
+		return model.NewAppError("requestLoginLocationVerification", "app.login_location.create_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	pending := 0
+	for _, token := range tokens {
+		var tokenExtra loginLocationTokenExtra
+		if err := json.Unmarshal([]byte(token.Extra), &tokenExtra); err != nil || tokenExtra.UserId != user.Id {
+			continue
+		}
+		if tokenExtra == extra {
+			return nil
+		}
+		pending++
+	}
+
+	if pending >= loginVerificationMaxPendingTokens {
+		c.Logger().Warn("Not sending a login location verification email, too many are pending", mlog.String("user_id", user.Id))
+		return nil
+	}
+
+	jsonData, err := json.Marshal(extra)
+	if err != nil {
+		return model.NewAppError("requestLoginLocationVerification", "app.login_location.create_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	token := model.NewToken(TokenTypeLoginVerification, string(jsonData))
+	if err := a.Srv().Store().Token().Save(token); err != nil {
+		return model.NewAppError("requestLoginLocationVerification", "app.login_location.create_token.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().EmailService.SendLoginLocationVerificationEmail(user.Email, user.Locale, a.GetSiteURL(), token.Token, location.IPAddress, location.Device); err != nil {
+		return model.NewAppError("requestLoginLocationVerification", "app.login_location.send_verification_email.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// VerifyLoginLocation consumes a login location verification token, after which
+// logins from that location are no longer held back.
+func (a *App) VerifyLoginLocation(c request.CTX, tokenString string) *model.AppError {
+	token, err := a.Srv().Store().Token().GetByToken(tokenString)
+	if err != nil {
+		return model.NewAppError("VerifyLoginLocation", "api.user.verify_login_location.bad_link.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if token.Type != TokenTypeLoginVerification {
+		return model.NewAppError("VerifyLoginLocation", "api.user.verify_login_location.bad_link.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if model.GetMillis()-token.CreateAt >= LoginVerificationExpiryTime {
+		if appErr := a.DeleteToken(token); appErr != nil {
+			c.Logger().Warn("Failed to delete token", mlog.Err(appErr))
+		}
+		return model.NewAppError("VerifyLoginLocation", "api.user.verify_login_location.link_expired.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	var extra loginLocationTokenExtra
+	if err := json.Unmarshal([]byte(token.Extra), &extra); err != nil {
+		return model.NewAppError("VerifyLoginLocation", "api.user.verify_login_location.token_parse.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if _, appErr := a.GetUser(extra.UserId); appErr != nil {
+		return appErr
+	}
+
+	location := &loginLocation{IPRange: extra.IPRange, UserAgent: extra.UserAgent}
+	if appErr := a.recordLoginLocation(extra.UserId, location); appErr != nil {
+		return appErr
+	}
+
+	if appErr := a.DeleteToken(token); appErr != nil {
+		c.Logger().Warn("Failed to delete token", mlog.Err(appErr))
+	}
+
+	return nil
+}
+
+// notifyNewLoginLocation tells the user, by email and by a direct message from
+// the system bot, that their account was accessed from a new location.
+func (a *App) notifyNewLoginLocation(c request.CTX, user *model.User, location *loginLocation) {
+	if !*a.Config().ServiceSettings.EnableNewLoginLocationAlerts {
+		return
+	}
+
+	if *a.Config().EmailSettings.SendEmailNotifications {
+		if err := a.Srv().EmailService.SendNewLoginLocationEmail(user.Email, user.Locale, a.GetSiteURL(), location.IPAddress, location.Device); err != nil {
+			c.Logger().Error("Failed to send new login location email", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+	}
+
+	systemBot, appErr := a.GetSystemBot(c)
+	if appErr != nil {
+		c.Logger().Error("Failed to get the system bot", mlog.Err(appErr))
+		return
+	}
+
+	channel, appErr := a.GetOrCreateDirectChannel(c, user.Id, systemBot.UserId)
+	if appErr != nil {
+		c.Logger().Error("Failed to get the direct channel with the system bot", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+
+	T := i18n.GetUserTranslations(user.Locale)
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    systemBot.UserId,
+		Type:      model.PostTypeDefault,
+		Message: T("app.login_location.new_login_location.message", map[string]any{
+			"Device":    location.Device,
+			"IPAddress": location.IPAddress,
+		}),
+	}
+
+	if _, appErr := a.CreatePost(c, post, channel, false, true); appErr != nil {
+		c.Logger().Error("Failed to post new login location alert", mlog.String("user_id", user.Id), mlog.Err(appErr))
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	emailmocks "github.com/mattermost/mattermost/server/v8/channels/app/email/mocks"
 )
 
 func TestCheckForClientSideCert(t *testing.T) {
@@ -69,3 +72,31 @@ func TestCWSLogin(t *testing.T) {
 		require.Nil(t, user)
 	})
 }
+
+func TestRequestLoginLocationVerification(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("SendLoginLocationVerificationEmail", th.BasicUser.Email, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	emailServiceMock.On("Stop").Once().Return()
+	th.App.Srv().EmailService = &emailServiceMock
+
+	pendingTokens := func() int {
+		tokens, err := th.App.Srv().Store().Token().GetAllTokensByType(TokenTypeLoginVerification)
+		require.NoError(t, err)
+		return len(tokens)
+	}
+
+	location := newLoginLocation("203.0.113.10", "Macintosh", "Mac OS", "Chrome")
+	require.Nil(t, th.App.requestLoginLocationVerification(th.Context, th.BasicUser, location))
+	require.Nil(t, th.App.requestLoginLocationVerification(th.Context, th.BasicUser, location))
+	assert.Equal(t, 1, pendingTokens())
+	emailServiceMock.AssertNumberOfCalls(t, "SendLoginLocationVerificationEmail", 1)
+
+	for _, ipAddress := range []string{"198.51.100.10", "192.0.2.10", "100.64.0.10"} {
+		require.Nil(t, th.App.requestLoginLocationVerification(th.Context, th.BasicUser, newLoginLocation(ipAddress, "Macintosh", "Mac OS", "Chrome")))
+	}
+	assert.Equal(t, loginVerificationMaxPendingTokens, pendingTokens())
+	emailServiceMock.AssertNumberOfCalls(t, "SendLoginLocationVerificationEmail", loginVerificationMaxPendingTokens)
+}
@@ -16,6 +16,26 @@ type SuiteIFace struct {
 	mock.Mock
 }
 
+// CheckIPFilter provides a mock function with given fields: rctx, ipAddress, session
+func (_m *SuiteIFace) CheckIPFilter(rctx request.CTX, ipAddress string, session *model.Session) *model.AppError {
+	ret := _m.Called(rctx, ipAddress, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIPFilter")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(request.CTX, string, *model.Session) *model.AppError); ok {
+		r0 = rf(rctx, ipAddress, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // GetSession provides a mock function with given fields: token
 func (_m *SuiteIFace) GetSession(token string) (*model.Session, *model.AppError) {
 	ret := _m.Called(token)
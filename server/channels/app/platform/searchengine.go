@@ -5,6 +5,7 @@ package platform
 
 import (
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine"
 )
 
 func (ps *PlatformService) StartSearchEngine() (string, string) {
@@ -61,7 +62,8 @@ func (ps *PlatformService) StartSearchEngine() (string, string) {
 				})
 			}
 		} else if oldLicense != nil && newLicense == nil {
-			if ps.SearchEngine.ElasticsearchEngine != nil {
+			// The OpenSearch engine doesn't require a license, so it keeps running.
+			if _, ok := ps.SearchEngine.ElasticsearchEngine.(*opensearchengine.OpenSearchEngine); ps.SearchEngine.ElasticsearchEngine != nil && !ok {
 				ps.Go(func() {
 					if err := ps.SearchEngine.ElasticsearchEngine.Stop(); err != nil {
 						ps.Log().Error(err.Error())
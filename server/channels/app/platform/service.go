@@ -29,6 +29,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/cache"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 )
 
@@ -445,6 +446,10 @@ func (ps *PlatformService) initEnterprise() {
 
 	if elasticsearchInterface != nil {
 		ps.SearchEngine.RegisterElasticsearchEngine(elasticsearchInterface(ps))
+	} else {
+		// Without the enterprise engine, the Elasticsearch settings configure the OpenSearch
+		// engine, which doesn't require a license.
+		ps.SearchEngine.RegisterElasticsearchEngine(opensearchengine.NewOpenSearchEngine(ps.Config()))
 	}
 
 	if licenseInterface != nil {
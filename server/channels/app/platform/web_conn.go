@@ -72,6 +72,8 @@ type WebConnConfig struct {
 	PostedAck     bool
 	RemoteAddress string
 	XForwardedFor string
+	// TrustedRemoteAddress is the address used for IP filtering, see utils.GetTrustedIPAddress.
+	TrustedRemoteAddress string
 
 	// These aren't necessary to be exported to api layer.
 	sequence         int
@@ -127,6 +129,8 @@ type WebConn struct {
 	remoteAddress string
 	// The X-Forwarded-For HTTP header value from the origina HTTP Upgrade request
 	xForwardedFor string
+	// The remote address used for IP filtering from the original HTTP Upgrade request
+	trustedRemoteAddress string
 
 	activeChannelID                 atomic.Value
 	activeTeamID                    atomic.Value
@@ -222,28 +226,29 @@ func (ps *PlatformService) NewWebConn(cfg *WebConnConfig, suite SuiteIFace, runn
 	}
 
 	wc := &WebConn{
-		Platform:           ps,
-		Suite:              suite,
-		HookRunner:         runner,
-		send:               cfg.activeQueue,
-		deadQueue:          cfg.deadQueue,
-		deadQueuePointer:   cfg.deadQueuePointer,
-		Sequence:           int64(cfg.sequence),
-		WebSocket:          cfg.WebSocket,
-		lastUserActivityAt: model.GetMillis(),
-		UserId:             cfg.Session.UserId,
-		T:                  cfg.TFunc,
-		Locale:             cfg.Locale,
-		PostedAck:          cfg.PostedAck,
-		reuseCount:         cfg.ReuseCount,
-		endWritePump:       make(chan struct{}),
-		pumpFinished:       make(chan struct{}),
-		pluginPosted:       make(chan pluginWSPostedHook, 10),
-		lastLogTimeSlow:    time.Now(),
-		lastLogTimeFull:    time.Now(),
-		originClient:       cfg.OriginClient,
-		remoteAddress:      cfg.RemoteAddress,
-		xForwardedFor:      cfg.XForwardedFor,
+		Platform:             ps,
+		Suite:                suite,
+		HookRunner:           runner,
+		send:                 cfg.activeQueue,
+		deadQueue:            cfg.deadQueue,
+		deadQueuePointer:     cfg.deadQueuePointer,
+		Sequence:             int64(cfg.sequence),
+		WebSocket:            cfg.WebSocket,
+		lastUserActivityAt:   model.GetMillis(),
+		UserId:               cfg.Session.UserId,
+		T:                    cfg.TFunc,
+		Locale:               cfg.Locale,
+		PostedAck:            cfg.PostedAck,
+		reuseCount:           cfg.ReuseCount,
+		endWritePump:         make(chan struct{}),
+		pumpFinished:         make(chan struct{}),
+		pluginPosted:         make(chan pluginWSPostedHook, 10),
+		lastLogTimeSlow:      time.Now(),
+		lastLogTimeFull:      time.Now(),
+		originClient:         cfg.OriginClient,
+		remoteAddress:        cfg.RemoteAddress,
+		xForwardedFor:        cfg.XForwardedFor,
+		trustedRemoteAddress: cfg.TrustedRemoteAddress,
 	}
 
 	wc.SetSession(&cfg.Session)
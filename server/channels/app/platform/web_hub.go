@@ -25,6 +25,7 @@ type SuiteIFace interface {
 	GetSession(token string) (*model.Session, *model.AppError)
 	RolesGrantPermission(roleNames []string, permissionId string) bool
 	UserCanSeeOtherUser(c request.CTX, userID string, otherUserId string) (bool, *model.AppError)
+	CheckIPFilter(rctx request.CTX, ipAddress string, session *model.Session) *model.AppError
 }
 
 type webConnActivityMessage struct {
@@ -9,6 +9,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 )
 
 type webSocketHandler interface {
@@ -52,6 +53,16 @@ func (wr *WebSocketRouter) ServeWebSocket(conn *WebConn, r *model.WebSocketReque
 			conn.WebSocket.Close()
 			return
 		}
+
+		// The upgrade request was filtered before the session was known, so
+		// role specific ranges are only applied here.
+		rctx := request.EmptyContext(conn.Platform.logger).
+			WithPath(model.APIURLSuffix + "/websocket").
+			WithXForwardedFor(conn.xForwardedFor)
+		if appErr := conn.Suite.CheckIPFilter(rctx, conn.trustedRemoteAddress, session); appErr != nil {
+			conn.WebSocket.Close()
+			return
+		}
 		conn.SetSession(session)
 		conn.SetSessionToken(session.Token)
 		conn.UserId = session.UserId
@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/blang/semver/v4"
 	svg "github.com/h2non/go-is-svg"
@@ -112,48 +113,68 @@ func (ch *Channels) syncPluginsActiveState() {
 			}
 		}
 
-		// Concurrently activate/deactivate each plugin appropriately.
-		var wg sync.WaitGroup
-
-		// Deactivate any plugins that have been disabled.
-		for _, plugin := range disabledPlugins {
-			wg.Add(1)
-			go func(plugin *model.BundleInfo) {
-				defer wg.Done()
+		// Enabled plugins whose dependencies aren't enabled can't run, so they are deactivated
+		// along with the disabled plugins. They fail to activate below until their dependencies are
+		// enabled, surfacing the unmet dependency in their status.
+		blocked := pluginsBlockedByDependencies(enabledPlugins)
+		deactivatedPlugins := disabledPlugins
+		for _, plugin := range enabledPlugins {
+			if blocked[plugin.Manifest.Id] {
+				deactivatedPlugins = append(deactivatedPlugins, plugin)
+			}
+		}
 
-				deactivated := pluginsEnvironment.Deactivate(plugin.Manifest.Id)
-				if deactivated && plugin.Manifest.HasClient() {
-					message := model.NewWebSocketEvent(model.WebsocketEventPluginDisabled, "", "", "", nil, "")
-					message.Add("manifest", plugin.Manifest.ClientManifest())
-					ch.srv.platform.Publish(message)
-				}
-			}(plugin)
+		// Deactivate plugins before the plugins they depend on, and activate them after. Plugins
+		// within a level don't depend on each other and are handled concurrently.
+		deactivationLevels := pluginActivationLevels(deactivatedPlugins)
+		for i := len(deactivationLevels) - 1; i >= 0; i-- {
+			var wg sync.WaitGroup
+			for _, plugin := range deactivationLevels[i] {
+				wg.Add(1)
+				go func(plugin *model.BundleInfo) {
+					defer wg.Done()
+
+					deactivated := pluginsEnvironment.Deactivate(plugin.Manifest.Id)
+					if deactivated && plugin.Manifest.HasClient() {
+						message := model.NewWebSocketEvent(model.WebsocketEventPluginDisabled, "", "", "", nil, "")
+						message.Add("manifest", plugin.Manifest.ClientManifest())
+						ch.srv.platform.Publish(message)
+					}
+				}(plugin)
+			}
+			wg.Wait()
 		}
 
-		// Activate any plugins that have been enabled
-		for _, plugin := range enabledPlugins {
-			wg.Add(1)
-			go func(plugin *model.BundleInfo) {
-				defer wg.Done()
+		for _, level := range pluginActivationLevels(enabledPlugins) {
+			var wg sync.WaitGroup
+			for _, plugin := range level {
+				wg.Add(1)
+				go func(plugin *model.BundleInfo) {
+					defer wg.Done()
 
-				pluginID := plugin.Manifest.Id
-				logger := ch.srv.Log().With(mlog.String("plugin_id", pluginID), mlog.String("bundle_path", plugin.Path))
+					pluginID := plugin.Manifest.Id
+					logger := ch.srv.Log().With(mlog.String("plugin_id", pluginID), mlog.String("bundle_path", plugin.Path))
 
-				updatedManifest, activated, err := pluginsEnvironment.Activate(pluginID)
-				if err != nil {
-					logger.Error("Unable to activate plugin", mlog.Err(err))
-					return
-				}
+					updatedManifest, activated, err := pluginsEnvironment.Activate(pluginID)
+					if err != nil {
+						logger.Error("Unable to activate plugin", mlog.Err(err))
+						return
+					}
 
-				if activated {
-					// Notify all cluster clients if ready
-					if err := ch.notifyPluginEnabled(updatedManifest); err != nil {
-						logger.Error("Failed to notify cluster on plugin enable", mlog.Err(err))
+					if activated {
+						if appErr := ch.syncPluginPermissions(updatedManifest); appErr != nil {
+							logger.Error("Failed to sync plugin permissions", mlog.Err(appErr))
+						}
+
+						// Notify all cluster clients if ready
+						if err := ch.notifyPluginEnabled(updatedManifest); err != nil {
+							logger.Error("Failed to notify cluster on plugin enable", mlog.Err(err))
+						}
 					}
-				}
-			}(plugin)
+				}(plugin)
+			}
+			wg.Wait()
 		}
-		wg.Wait()
 	} else { // If plugins are disabled, shutdown plugins.
 		pluginsEnvironment.Shutdown()
 	}
@@ -164,13 +185,33 @@ func (ch *Channels) syncPluginsActiveState() {
 }
 
 func (a *App) NewPluginAPI(c request.CTX, manifest *model.Manifest) plugin.API {
-	return NewPluginAPI(a, c, manifest)
+	api := NewPluginAPI(a, c, manifest)
+	return plugin.NewAPICapabilityLayer(api, api.checkCapability)
 }
 
 func (a *App) InitPlugins(c request.CTX, pluginDir, webappPluginDir string) {
 	a.ch.initPlugins(c, pluginDir, webappPluginDir)
 }
 
+// pluginHookBudget derives the plugin hook timeouts and circuit breaker settings from the config.
+func pluginHookBudget(cfg *model.Config) plugin.HookBudget {
+	return plugin.HookBudget{
+		DefaultTimeout:   time.Duration(*cfg.PluginSettings.HookTimeoutSeconds) * time.Second,
+		MessageTimeout:   time.Duration(*cfg.PluginSettings.MessageHookTimeoutMilliseconds) * time.Millisecond,
+		FailureThreshold: *cfg.PluginSettings.HookFailureThreshold,
+		Cooldown:         time.Duration(*cfg.PluginSettings.HookCircuitBreakerCooldownSeconds) * time.Second,
+	}
+}
+
+// pluginWasmLimits derives the resources available to WebAssembly plugins from the config. The
+// memory limit applies to each plugin as a whole, not to each of its instances.
+func pluginWasmLimits(cfg *model.Config) plugin.WasmLimits {
+	return plugin.WasmLimits{
+		MemoryLimitBytes: uint64(*cfg.PluginSettings.WasmMemoryLimitMB) * 1024 * 1024,
+		MaxCallDuration:  time.Duration(*cfg.PluginSettings.WasmMaxExecutionSeconds) * time.Second,
+	}
+}
+
 func (ch *Channels) initPlugins(c request.CTX, pluginDir, webappPluginDir string) {
 	// Acquiring lock manually, as plugins might be disabled. See GetPluginsEnvironment.
 	defer func() {
@@ -216,6 +257,10 @@ func (ch *Channels) initPlugins(c request.CTX, pluginDir, webappPluginDir string
 		ch.srv.Log().Error("Failed to start up plugins", mlog.Err(err))
 		return
 	}
+	env.SetHookBudget(pluginHookBudget(ch.cfgSvc.Config()))
+	env.SetWasmLimits(pluginWasmLimits(ch.cfgSvc.Config()))
+	// Stream the output of watched plugins, including crashes, to the developer.
+	env.SetLogObserver(ch.publishPluginWatchLog)
 	ch.pluginsLock.Lock()
 	ch.pluginsEnvironment = env
 	ch.pluginsLock.Unlock()
@@ -231,6 +276,7 @@ func (ch *Channels) initPlugins(c request.CTX, pluginDir, webappPluginDir string
 	}
 	ch.pluginClusterLeaderListenerID = ch.srv.AddClusterLeaderChangedListener(func() {
 		ch.persistTransitionallyPrepackagedPlugins()
+		ch.syncActivePluginsPermissions()
 	})
 	ch.persistTransitionallyPrepackagedPlugins()
 
@@ -244,6 +290,11 @@ func (ch *Channels) initPlugins(c request.CTX, pluginDir, webappPluginDir string
 			ch.syncPluginsActiveState()
 		}
 
+		if pluginsEnvironment := ch.GetPluginsEnvironment(); pluginsEnvironment != nil {
+			pluginsEnvironment.SetHookBudget(pluginHookBudget(new))
+			pluginsEnvironment.SetWasmLimits(pluginWasmLimits(new))
+		}
+
 		ch.RunMultiHook(func(hooks plugin.Hooks) bool {
 			if err := hooks.OnConfigurationChange(); err != nil {
 				ch.srv.Log().Error("Plugin OnConfigurationChange hook failed", mlog.Err(err))
@@ -361,6 +412,8 @@ func (ch *Channels) ShutDownPlugins() {
 
 	ch.srv.Log().Info("Shutting down plugins")
 
+	ch.stopPluginWatchers()
+
 	pluginsEnvironment.Shutdown()
 
 	ch.RemoveConfigListener(ch.pluginConfigListenerID)
@@ -445,6 +498,9 @@ func (ch *Channels) enablePlugin(id string) *model.AppError {
 		return model.NewAppError("EnablePlugin", "app.plugin.not_installed.app_error", nil, "", http.StatusNotFound)
 	}
 
+	// Give a plugin that was being bypassed for failing hooks a fresh start when an admin re-enables it.
+	pluginsEnvironment.ResetHookCircuitBreaker(id)
+
 	ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
 		cfg.PluginSettings.PluginStates[id] = &model.PluginState{Enable: true}
 	})
@@ -461,9 +517,10 @@ func (ch *Channels) enablePlugin(id string) *model.AppError {
 }
 
 // DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
+// Unless forced, plugins that enabled plugins depend on can't be disabled.
 // Notifies cluster peers through config change.
-func (a *App) DisablePlugin(id string) *model.AppError {
-	appErr := a.ch.disablePlugin(id)
+func (a *App) DisablePlugin(id string, force bool) *model.AppError {
+	appErr := a.ch.disablePlugin(id, force)
 	if appErr != nil {
 		return appErr
 	}
@@ -471,7 +528,7 @@ func (a *App) DisablePlugin(id string) *model.AppError {
 	return nil
 }
 
-func (ch *Channels) disablePlugin(id string) *model.AppError {
+func (ch *Channels) disablePlugin(id string, force bool) *model.AppError {
 	pluginsEnvironment := ch.GetPluginsEnvironment()
 	if pluginsEnvironment == nil {
 		return model.NewAppError("DisablePlugin", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -496,6 +553,12 @@ func (ch *Channels) disablePlugin(id string) *model.AppError {
 		return model.NewAppError("DisablePlugin", "app.plugin.not_installed.app_error", nil, "", http.StatusNotFound)
 	}
 
+	if !force {
+		if appErr := ch.checkPluginDependents(id, availablePlugins); appErr != nil {
+			return appErr
+		}
+	}
+
 	ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
 		cfg.PluginSettings.PluginStates[id] = &model.PluginState{Enable: false}
 	})
@@ -506,6 +569,10 @@ func (ch *Channels) disablePlugin(id string) *model.AppError {
 		return model.NewAppError("DisablePlugin", "app.plugin.config.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	// Unregister the jobs once the plugin is deactivated, which aborts those it is running,
+	// rather than waiting for them to complete.
+	ch.unregisterPluginJobs(id)
+
 	return nil
 }
 
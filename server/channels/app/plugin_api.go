@@ -98,7 +98,16 @@ func (api *PluginAPI) GetUnsanitizedConfig() *model.Config {
 	return api.app.Config().Clone()
 }
 
+// SaveConfig saves the given config, keeping the current plugin capability settings so that
+// plugins can't grant themselves capabilities, and the permissions registered by plugins, which
+// are tracked by the server.
 func (api *PluginAPI) SaveConfig(config *model.Config) *model.AppError {
+	config = config.Clone()
+	current := api.app.Config().Clone()
+	config.PluginSettings.EnforceCapabilities = current.PluginSettings.EnforceCapabilities
+	config.PluginSettings.GrantedCapabilities = current.PluginSettings.GrantedCapabilities
+	config.PluginSettings.RegisteredPermissions = current.PluginSettings.RegisteredPermissions
+
 	_, _, err := api.app.SaveConfig(config, true)
 	return err
 }
@@ -913,7 +922,7 @@ func (api *PluginAPI) EnablePlugin(id string) *model.AppError {
 }
 
 func (api *PluginAPI) DisablePlugin(id string) *model.AppError {
-	return api.app.DisablePlugin(id)
+	return api.app.DisablePlugin(id, false)
 }
 
 func (api *PluginAPI) RemovePlugin(id string) *model.AppError {
@@ -975,6 +984,22 @@ func (api *PluginAPI) KVList(page, perPage int) ([]string, *model.AppError) {
 	return api.app.ListPluginKeys(api.id, page, perPage)
 }
 
+func (api *PluginAPI) KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	return api.app.ListPluginKeysWithOptions(api.id, options)
+}
+
+func (api *PluginAPI) KVGetMulti(keys []string) (map[string][]byte, *model.AppError) {
+	return api.app.GetPluginKeys(api.id, keys)
+}
+
+func (api *PluginAPI) KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError) {
+	return api.app.GetPluginKeyMetadata(api.id, key)
+}
+
+func (api *PluginAPI) KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	return api.app.BatchPluginKeys(api.id, ops)
+}
+
 func (api *PluginAPI) PublishWebSocketEvent(event string, payload map[string]any, broadcast *model.WebsocketBroadcast) {
 	ev := model.NewWebSocketEvent(model.WebsocketEventType(fmt.Sprintf("custom_%v_%v", api.id, event)), "", "", "", nil, "")
 	ev = ev.SetBroadcast(broadcast).SetData(payload)
@@ -1003,15 +1028,19 @@ func (api *PluginAPI) UpdateUserRoles(userID string, newRoles string) (*model.Us
 
 func (api *PluginAPI) LogDebug(msg string, keyValuePairs ...any) {
 	api.logger.Debugw(msg, keyValuePairs...)
+	api.app.ch.publishPluginWatchLog(api.id, model.PluginWatchLogLevelDebug, msg, keyValuePairs...)
 }
 func (api *PluginAPI) LogInfo(msg string, keyValuePairs ...any) {
 	api.logger.Infow(msg, keyValuePairs...)
+	api.app.ch.publishPluginWatchLog(api.id, model.PluginWatchLogLevelInfo, msg, keyValuePairs...)
 }
 func (api *PluginAPI) LogError(msg string, keyValuePairs ...any) {
 	api.logger.Errorw(msg, keyValuePairs...)
+	api.app.ch.publishPluginWatchLog(api.id, model.PluginWatchLogLevelError, msg, keyValuePairs...)
 }
 func (api *PluginAPI) LogWarn(msg string, keyValuePairs ...any) {
 	api.logger.Warnw(msg, keyValuePairs...)
+	api.app.ch.publishPluginWatchLog(api.id, model.PluginWatchLogLevelWarn, msg, keyValuePairs...)
 }
 
 func (api *PluginAPI) CreateBot(bot *model.Bot) (*model.Bot, *model.AppError) {
@@ -1347,3 +1376,31 @@ func (api *PluginAPI) UninviteRemoteFromChannel(channelID string, remoteID strin
 func (api *PluginAPI) GetPluginID() string {
 	return api.id
 }
+
+func (api *PluginAPI) GetPluginMigrations() ([]*model.PluginMigration, *model.AppError) {
+	return api.app.GetPluginMigrations(api.id)
+}
+
+func (api *PluginAPI) ApplyPluginMigration(migration *model.PluginMigration) *model.AppError {
+	return api.app.ApplyPluginMigration(api.id, migration)
+}
+
+func (api *PluginAPI) RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError {
+	return api.app.RegisterPluginJob(api.id, definition)
+}
+
+func (api *PluginAPI) UnregisterPluginJob(name string) *model.AppError {
+	return api.app.UnregisterPluginJob(api.id, name)
+}
+
+func (api *PluginAPI) CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError) {
+	return api.app.CreatePluginJob(api.ctx, api.id, name, data)
+}
+
+func (api *PluginAPI) GetPluginJob(jobID string) (*model.Job, *model.AppError) {
+	return api.app.GetPluginJob(api.ctx, api.id, jobID)
+}
+
+func (api *PluginAPI) SetPluginJobProgress(jobID string, progress int64) *model.AppError {
+	return api.app.SetPluginJobProgress(api.ctx, api.id, jobID, progress)
+}
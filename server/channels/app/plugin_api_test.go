@@ -17,6 +17,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -2657,3 +2658,111 @@ func TestPluginPatchChannelMembersNotifications(t *testing.T) {
 		assert.Equal(t, "", updated.NotifyProps["test_field"])
 	})
 }
+
+func TestPluginAPICapabilities(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	manifest := &model.Manifest{
+		Id:           "pluginid",
+		Capabilities: []string{model.PluginCapabilityChannelsRead, model.PluginCapabilityUsersRead},
+	}
+	api := th.App.NewPluginAPI(th.Context, manifest)
+
+	t.Run("all calls are allowed when enforcement is disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.PluginSettings.EnforceCapabilities = false })
+
+		_, appErr := api.GetPost(th.BasicPost.Id)
+		require.Nil(t, appErr)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.PluginSettings.EnforceCapabilities = true
+		cfg.PluginSettings.GrantedCapabilities = map[string][]string{
+			"pluginid": {model.PluginCapabilityChannelsRead, model.PluginCapabilityPostsRead},
+		}
+	})
+
+	t.Run("granted and requested capability is allowed", func(t *testing.T) {
+		channel, appErr := api.GetChannel(th.BasicChannel.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicChannel.Id, channel.Id)
+	})
+
+	t.Run("requested but not granted capability is denied", func(t *testing.T) {
+		user, appErr := api.GetUser(th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Nil(t, user)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+		assert.Equal(t, "app.plugin.api.capability_denied.app_error", appErr.Id)
+	})
+
+	t.Run("granted but not requested capability is denied", func(t *testing.T) {
+		_, appErr := api.GetPost(th.BasicPost.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("methods without a capability are always allowed", func(t *testing.T) {
+		require.Nil(t, api.KVSet("key", []byte("value")))
+		assert.NotEmpty(t, api.GetServerVersion())
+	})
+
+	t.Run("unclassified methods are denied", func(t *testing.T) {
+		appErr := NewPluginAPI(th.App, th.Context, manifest).checkCapability("UnknownMethod")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("plugins can't grant themselves capabilities by saving the config", func(t *testing.T) {
+		configManifest := &model.Manifest{
+			Id:           "pluginid",
+			Capabilities: []string{model.PluginCapabilityConfigWrite},
+		}
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.PluginSettings.GrantedCapabilities = map[string][]string{
+				"pluginid": {model.PluginCapabilityConfigWrite},
+			}
+		})
+		configAPI := th.App.NewPluginAPI(th.Context, configManifest)
+
+		cfg := th.App.Config().Clone()
+		*cfg.PluginSettings.EnforceCapabilities = false
+		cfg.PluginSettings.GrantedCapabilities["pluginid"] = model.PluginCapabilities
+		cfg.PluginSettings.RegisteredPermissions = map[string][]string{"pluginid": {"plugin_pluginid_permission"}}
+		*cfg.ServiceSettings.EnableDeveloper = true
+		require.Nil(t, configAPI.SaveConfig(cfg))
+
+		assert.True(t, *th.App.Config().ServiceSettings.EnableDeveloper)
+		assert.Empty(t, th.App.Config().PluginSettings.RegisteredPermissions)
+		assert.True(t, *th.App.Config().PluginSettings.EnforceCapabilities)
+		assert.Equal(t, []string{model.PluginCapabilityConfigWrite}, th.App.Config().PluginSettings.GrantedCapabilities["pluginid"])
+	})
+
+	t.Run("migrations require their own capability", func(t *testing.T) {
+		migrationManifest := &model.Manifest{
+			Id:           "pluginid",
+			Capabilities: []string{model.PluginCapabilityPluginsManage},
+		}
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.PluginSettings.GrantedCapabilities = map[string][]string{
+				"pluginid": {model.PluginCapabilityPluginsManage},
+			}
+		})
+
+		appErr := NewPluginAPI(th.App, th.Context, migrationManifest).checkCapability("ApplyPluginMigration")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+}
+
+func TestPluginAPIMethodCapabilities(t *testing.T) {
+	apiType := reflect.TypeOf((*plugin.API)(nil)).Elem()
+	for i := 0; i < apiType.NumMethod(); i++ {
+		method := apiType.Method(i).Name
+		capability, ok := pluginAPIMethodCapabilities[method]
+		if assert.Truef(t, ok, "plugin API method %s must be classified in pluginAPIMethodCapabilities", method) && capability != "" {
+			assert.Truef(t, model.IsValidPluginCapability(capability), "plugin API method %s requires the invalid capability %q", method, capability)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+// pluginAPIMethodCapabilities maps every plugin API method to the capability a plugin must be
+// granted to call it when PluginSettings.EnforceCapabilities is enabled. Methods mapped to no
+// capability only touch the plugin's own state or public server information and are always
+// allowed, while methods missing from the map are denied.
+var pluginAPIMethodCapabilities = map[string]string{
+	"LoadPluginConfiguration":    "",
+	"GetPluginConfig":            "",
+	"SavePluginConfig":           "",
+	"GetPluginID":                "",
+	"GetBundlePath":              "",
+	"GetLicense":                 "",
+	"IsEnterpriseReady":          "",
+	"GetServerVersion":           "",
+	"GetSystemInstallDate":       "",
+	"GetDiagnosticId":            "",
+	"GetTelemetryId":             "",
+	"GetCloudLimits":             "",
+	"GetPlugins":                 "",
+	"GetPluginStatus":            "",
+	"PluginHTTP":                 "",
+	"PublishPluginClusterEvent":  "",
+	"RegisterCollectionAndTopic": "",
+	"RegisterCommand":            "",
+	"UnregisterCommand":          "",
+	"ListPluginCommands":         "",
+	"ListBuiltInCommands":        "",
+	"HasPermissionTo":            "",
+	"HasPermissionToTeam":        "",
+	"HasPermissionToChannel":     "",
+	"RolesGrantPermission":       "",
+	"GetEmojiList":               "",
+	"GetEmojiByName":             "",
+	"GetEmoji":                   "",
+	"GetEmojiImage":              "",
+	"LogDebug":                   "",
+	"LogInfo":                    "",
+	"LogError":                   "",
+	"LogWarn":                    "",
+	"KVSet":                      "",
+	"KVCompareAndSet":            "",
+	"KVCompareAndDelete":         "",
+	"KVSetWithOptions":           "",
+	"KVSetWithExpiry":            "",
+	"KVGet":                      "",
+	"KVDelete":                   "",
+	"KVDeleteAll":                "",
+	"KVList":                     "",
+	"KVListWithOptions":          "",
+	"KVGetMulti":                 "",
+	"KVGetMetadata":              "",
+	"KVBatch":                    "",
+	"GetPluginMigrations":        "",
+	"RegisterPluginJob":          "",
+	"UnregisterPluginJob":        "",
+	"CreatePluginJob":            "",
+	"GetPluginJob":               "",
+	"SetPluginJobProgress":       "",
+
+	"GetConfig":            model.PluginCapabilityConfigRead,
+	"GetUnsanitizedConfig": model.PluginCapabilityConfigRead,
+	"SaveConfig":           model.PluginCapabilityConfigWrite,
+
+	"CreateTeam":                  model.PluginCapabilityTeamsWrite,
+	"DeleteTeam":                  model.PluginCapabilityTeamsWrite,
+	"GetTeams":                    model.PluginCapabilityTeamsRead,
+	"GetTeam":                     model.PluginCapabilityTeamsRead,
+	"SearchTeams":                 model.PluginCapabilityTeamsRead,
+	"GetTeamByName":               model.PluginCapabilityTeamsRead,
+	"GetTeamsUnreadForUser":       model.PluginCapabilityTeamsRead,
+	"UpdateTeam":                  model.PluginCapabilityTeamsWrite,
+	"GetTeamsForUser":             model.PluginCapabilityTeamsRead,
+	"CreateTeamMember":            model.PluginCapabilityTeamsWrite,
+	"CreateTeamMembers":           model.PluginCapabilityTeamsWrite,
+	"CreateTeamMembersGracefully": model.PluginCapabilityTeamsWrite,
+	"DeleteTeamMember":            model.PluginCapabilityTeamsWrite,
+	"GetTeamMembers":              model.PluginCapabilityTeamsRead,
+	"GetTeamMember":               model.PluginCapabilityTeamsRead,
+	"GetTeamMembersForUser":       model.PluginCapabilityTeamsRead,
+	"UpdateTeamMemberRoles":       model.PluginCapabilityTeamsWrite,
+	"GetTeamStats":                model.PluginCapabilityTeamsRead,
+	"GetTeamIcon":                 model.PluginCapabilityTeamsRead,
+	"SetTeamIcon":                 model.PluginCapabilityTeamsWrite,
+	"RemoveTeamIcon":              model.PluginCapabilityTeamsWrite,
+
+	"CreateUser":               model.PluginCapabilityUsersWrite,
+	"DeleteUser":               model.PluginCapabilityUsersWrite,
+	"GetUsers":                 model.PluginCapabilityUsersRead,
+	"GetUsersByIds":            model.PluginCapabilityUsersRead,
+	"GetUser":                  model.PluginCapabilityUsersRead,
+	"GetUserByEmail":           model.PluginCapabilityUsersRead,
+	"GetUserByUsername":        model.PluginCapabilityUsersRead,
+	"GetUserByRemoteID":        model.PluginCapabilityUsersRead,
+	"GetUsersByUsernames":      model.PluginCapabilityUsersRead,
+	"GetUsersInTeam":           model.PluginCapabilityUsersRead,
+	"GetUsersInChannel":        model.PluginCapabilityUsersRead,
+	"SearchUsers":              model.PluginCapabilityUsersRead,
+	"GetPreferenceForUser":     model.PluginCapabilityUsersRead,
+	"GetPreferencesForUser":    model.PluginCapabilityUsersRead,
+	"UpdatePreferencesForUser": model.PluginCapabilityUsersWrite,
+	"DeletePreferencesForUser": model.PluginCapabilityUsersWrite,
+	"UpdateUser":               model.PluginCapabilityUsersWrite,
+	"UpdateUserAuth":           model.PluginCapabilityUsersWrite,
+	"UpdateUserActive":         model.PluginCapabilityUsersWrite,
+	"UpdateUserRoles":          model.PluginCapabilityUsersWrite,
+	"GetUserStatus":            model.PluginCapabilityUsersRead,
+	"GetUserStatusesByIds":     model.PluginCapabilityUsersRead,
+	"UpdateUserStatus":         model.PluginCapabilityUsersWrite,
+	"SetUserStatusTimedDND":    model.PluginCapabilityUsersWrite,
+	"UpdateUserCustomStatus":   model.PluginCapabilityUsersWrite,
+	"RemoveUserCustomStatus":   model.PluginCapabilityUsersWrite,
+	"GetUserCustomStatus":      model.PluginCapabilityUsersRead,
+	"GetLDAPUserAttributes":    model.PluginCapabilityUsersRead,
+	"GetProfileImage":          model.PluginCapabilityUsersRead,
+	"SetProfileImage":          model.PluginCapabilityUsersWrite,
+	"GetGroup":                 model.PluginCapabilityUsersRead,
+	"GetGroupByName":           model.PluginCapabilityUsersRead,
+	"GetGroupMemberUsers":      model.PluginCapabilityUsersRead,
+	"GetGroupsBySource":        model.PluginCapabilityUsersRead,
+	"GetGroupsForUser":         model.PluginCapabilityUsersRead,
+	"CreateBot":                model.PluginCapabilityUsersWrite,
+	"PatchBot":                 model.PluginCapabilityUsersWrite,
+	"GetBot":                   model.PluginCapabilityUsersRead,
+	"GetBots":                  model.PluginCapabilityUsersRead,
+	"UpdateBotActive":          model.PluginCapabilityUsersWrite,
+	"PermanentDeleteBot":       model.PluginCapabilityUsersWrite,
+	"EnsureBotUser":            model.PluginCapabilityUsersWrite,
+
+	"GetSession":            model.PluginCapabilitySessionsRead,
+	"CreateSession":         model.PluginCapabilitySessionsWrite,
+	"ExtendSessionExpiry":   model.PluginCapabilitySessionsWrite,
+	"RevokeSession":         model.PluginCapabilitySessionsWrite,
+	"CreateUserAccessToken": model.PluginCapabilitySessionsWrite,
+	"RevokeUserAccessToken": model.PluginCapabilitySessionsWrite,
+
+	"CreateChannel":                     model.PluginCapabilityChannelsWrite,
+	"DeleteChannel":                     model.PluginCapabilityChannelsWrite,
+	"GetPublicChannelsForTeam":          model.PluginCapabilityChannelsRead,
+	"GetChannel":                        model.PluginCapabilityChannelsRead,
+	"GetChannelByName":                  model.PluginCapabilityChannelsRead,
+	"GetChannelByNameForTeamName":       model.PluginCapabilityChannelsRead,
+	"GetChannelsForTeamForUser":         model.PluginCapabilityChannelsRead,
+	"GetChannelStats":                   model.PluginCapabilityChannelsRead,
+	"GetDirectChannel":                  model.PluginCapabilityChannelsWrite,
+	"GetGroupChannel":                   model.PluginCapabilityChannelsWrite,
+	"UpdateChannel":                     model.PluginCapabilityChannelsWrite,
+	"SearchChannels":                    model.PluginCapabilityChannelsRead,
+	"CreateChannelSidebarCategory":      model.PluginCapabilityChannelsWrite,
+	"GetChannelSidebarCategories":       model.PluginCapabilityChannelsRead,
+	"UpdateChannelSidebarCategories":    model.PluginCapabilityChannelsWrite,
+	"AddChannelMember":                  model.PluginCapabilityChannelsWrite,
+	"AddUserToChannel":                  model.PluginCapabilityChannelsWrite,
+	"GetChannelMember":                  model.PluginCapabilityChannelsRead,
+	"GetChannelMembers":                 model.PluginCapabilityChannelsRead,
+	"GetChannelMembersByIds":            model.PluginCapabilityChannelsRead,
+	"GetChannelMembersForUser":          model.PluginCapabilityChannelsRead,
+	"UpdateChannelMemberRoles":          model.PluginCapabilityChannelsWrite,
+	"UpdateChannelMemberNotifications":  model.PluginCapabilityChannelsWrite,
+	"PatchChannelMembersNotifications":  model.PluginCapabilityChannelsWrite,
+	"DeleteChannelMember":               model.PluginCapabilityChannelsWrite,
+	"ShareChannel":                      model.PluginCapabilityChannelsWrite,
+	"UpdateSharedChannel":               model.PluginCapabilityChannelsWrite,
+	"UnshareChannel":                    model.PluginCapabilityChannelsWrite,
+	"UpdateSharedChannelCursor":         model.PluginCapabilityChannelsWrite,
+	"SyncSharedChannel":                 model.PluginCapabilityChannelsWrite,
+	"InviteRemoteToChannel":             model.PluginCapabilityChannelsWrite,
+	"UninviteRemoteFromChannel":         model.PluginCapabilityChannelsWrite,
+	"RegisterPluginForSharedChannels":   model.PluginCapabilityChannelsWrite,
+	"UnregisterPluginForSharedChannels": model.PluginCapabilityChannelsWrite,
+
+	"SearchPostsInTeam":        model.PluginCapabilityPostsRead,
+	"SearchPostsInTeamForUser": model.PluginCapabilityPostsRead,
+	"CreatePost":               model.PluginCapabilityPostsWrite,
+	"AddReaction":              model.PluginCapabilityPostsWrite,
+	"RemoveReaction":           model.PluginCapabilityPostsWrite,
+	"GetReactions":             model.PluginCapabilityPostsRead,
+	"DeletePost":               model.PluginCapabilityPostsWrite,
+	"GetPostThread":            model.PluginCapabilityPostsRead,
+	"GetPost":                  model.PluginCapabilityPostsRead,
+	"GetPostsSince":            model.PluginCapabilityPostsRead,
+	"GetPostsAfter":            model.PluginCapabilityPostsRead,
+	"GetPostsBefore":           model.PluginCapabilityPostsRead,
+	"GetPostsForChannel":       model.PluginCapabilityPostsRead,
+	"UpdatePost":               model.PluginCapabilityPostsWrite,
+	"SendEphemeralPost":        model.PluginCapabilityPostsWrite,
+	"UpdateEphemeralPost":      model.PluginCapabilityPostsWrite,
+	"DeleteEphemeralPost":      model.PluginCapabilityPostsWrite,
+
+	"CopyFileInfos":            model.PluginCapabilityFilesWrite,
+	"GetFileInfo":              model.PluginCapabilityFilesRead,
+	"GetFileInfos":             model.PluginCapabilityFilesRead,
+	"GetFileLink":              model.PluginCapabilityFilesRead,
+	"ReadFile":                 model.PluginCapabilityFilesRead,
+	"GetFile":                  model.PluginCapabilityFilesRead,
+	"UploadFile":               model.PluginCapabilityFilesWrite,
+	"SetFileSearchableContent": model.PluginCapabilityFilesWrite,
+	"CreateUploadSession":      model.PluginCapabilityFilesWrite,
+	"UploadData":               model.PluginCapabilityFilesWrite,
+	"GetUploadSession":         model.PluginCapabilityFilesRead,
+
+	"ExecuteSlashCommand": model.PluginCapabilityCommandsWrite,
+	"CreateCommand":       model.PluginCapabilityCommandsWrite,
+	"ListCustomCommands":  model.PluginCapabilityCommandsWrite,
+	"UpdateCommand":       model.PluginCapabilityCommandsWrite,
+	"DeleteCommand":       model.PluginCapabilityCommandsWrite,
+	"ListCommands":        model.PluginCapabilityCommandsWrite,
+	"GetCommand":          model.PluginCapabilityCommandsWrite,
+
+	"SendMail":             model.PluginCapabilityMailSend,
+	"SendPushNotification": model.PluginCapabilityPushSend,
+
+	"PublishWebSocketEvent": model.PluginCapabilityWebSocketSend,
+	"PublishUserTyping":     model.PluginCapabilityWebSocketSend,
+	"OpenInteractiveDialog": model.PluginCapabilityWebSocketSend,
+
+	"CreateOAuthApp": model.PluginCapabilityOAuthWrite,
+	"GetOAuthApp":    model.PluginCapabilityOAuthWrite,
+	"UpdateOAuthApp": model.PluginCapabilityOAuthWrite,
+	"DeleteOAuthApp": model.PluginCapabilityOAuthWrite,
+
+	"EnablePlugin":        model.PluginCapabilityPluginsManage,
+	"DisablePlugin":       model.PluginCapabilityPluginsManage,
+	"RemovePlugin":        model.PluginCapabilityPluginsManage,
+	"InstallPlugin":       model.PluginCapabilityPluginsManage,
+	"RequestTrialLicense": model.PluginCapabilityPluginsManage,
+
+	"ApplyPluginMigration": model.PluginCapabilityStoreMigrate,
+}
+
+// checkCapability returns an error if capability enforcement is enabled and the plugin has not
+// been granted the capability required by the given plugin API method, or the method is not
+// classified. Denied calls are audited.
+func (api *PluginAPI) checkCapability(method string) *model.AppError {
+	capability, ok := pluginAPIMethodCapabilities[method]
+	if ok && capability == "" {
+		return nil
+	}
+
+	settings := api.app.Config().PluginSettings
+	if !*settings.EnforceCapabilities {
+		return nil
+	}
+
+	if ok && slices.Contains(api.manifest.Capabilities, capability) && slices.Contains(settings.GrantedCapabilities[api.id], capability) {
+		return nil
+	}
+
+	appErr := model.NewAppError("checkCapability", "app.plugin.api.capability_denied.app_error", map[string]any{"PluginId": api.id, "Method": method, "Capability": capability}, "", http.StatusForbidden)
+
+	rctx := api.ctx
+	if rctx == nil {
+		rctx = request.EmptyContext(api.app.Log())
+	}
+	auditRec := api.app.MakeAuditRecord(rctx, "pluginCapabilityDenied", audit.Fail)
+	auditRec.AddMeta("plugin_id", api.id)
+	auditRec.AddMeta("method", method)
+	auditRec.AddMeta("capability", capability)
+	api.app.LogAuditRecWithLevel(rctx, auditRec, LevelAPI, appErr)
+
+	return appErr
+}
+
+// ApprovePluginCapabilities grants the installed plugin every capability requested by its
+// manifest, replacing any previous grant.
+func (a *App) ApprovePluginCapabilities(pluginID string) (*model.Manifest, *model.AppError) {
+	return a.ch.approvePluginCapabilities(pluginID)
+}
+
+func (ch *Channels) approvePluginCapabilities(pluginID string) (*model.Manifest, *model.AppError) {
+	pluginsEnvironment := ch.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return nil, model.NewAppError("ApprovePluginCapabilities", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	availablePlugins, err := pluginsEnvironment.Available()
+	if err != nil {
+		return nil, model.NewAppError("ApprovePluginCapabilities", "app.plugin.config.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	pluginID = strings.ToLower(pluginID)
+
+	var manifest *model.Manifest
+	for _, p := range availablePlugins {
+		if p.Manifest != nil && p.Manifest.Id == pluginID {
+			manifest = p.Manifest
+			break
+		}
+	}
+
+	if manifest == nil {
+		return nil, model.NewAppError("ApprovePluginCapabilities", "app.plugin.not_installed.app_error", nil, "", http.StatusNotFound)
+	}
+
+	ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
+		if cfg.PluginSettings.GrantedCapabilities == nil {
+			cfg.PluginSettings.GrantedCapabilities = make(map[string][]string)
+		}
+		cfg.PluginSettings.GrantedCapabilities[pluginID] = slices.Clone(manifest.Capabilities)
+	})
+
+	if _, _, err := ch.cfgSvc.SaveConfig(ch.cfgSvc.Config(), true); err != nil {
+		return nil, model.NewAppError("ApprovePluginCapabilities", "app.plugin.config.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return manifest, nil
+}
+
+// revokePluginCapabilities removes any capabilities granted to the given plugin, so that a
+// reinstalled plugin must be approved again.
+func (ch *Channels) revokePluginCapabilities(pluginID string) {
+	if _, ok := ch.cfgSvc.Config().PluginSettings.GrantedCapabilities[pluginID]; !ok {
+		return
+	}
+
+	ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
+		delete(cfg.PluginSettings.GrantedCapabilities, pluginID)
+	})
+}
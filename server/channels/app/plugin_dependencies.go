@@ -0,0 +1,190 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// pluginActivationLevels groups the given plugins so that each plugin comes in a later level than
+// the plugins it depends on. Plugins within a level don't depend on each other and can be
+// activated concurrently. Dependencies on plugins outside the given set are ignored here, and
+// plugins caught in a dependency cycle are placed in the last level, where they fail to activate
+// with an unmet dependency.
+func pluginActivationLevels(plugins []*model.BundleInfo) [][]*model.BundleInfo {
+	byID := make(map[string]*model.BundleInfo, len(plugins))
+	for _, plugin := range plugins {
+		byID[plugin.Manifest.Id] = plugin
+	}
+
+	pending := make(map[string]int, len(plugins))
+	dependents := make(map[string][]*model.BundleInfo, len(plugins))
+	for _, plugin := range plugins {
+		for _, dependency := range plugin.Manifest.Dependencies {
+			if _, ok := byID[dependency.Id]; ok {
+				pending[plugin.Manifest.Id]++
+				dependents[dependency.Id] = append(dependents[dependency.Id], plugin)
+			}
+		}
+	}
+
+	var levels [][]*model.BundleInfo
+	var level []*model.BundleInfo
+	for _, plugin := range plugins {
+		if pending[plugin.Manifest.Id] == 0 {
+			level = append(level, plugin)
+		}
+	}
+
+	placed := 0
+	for len(level) > 0 {
+		levels = append(levels, level)
+		placed += len(level)
+
+		var next []*model.BundleInfo
+		for _, plugin := range level {
+			for _, dependent := range dependents[plugin.Manifest.Id] {
+				pending[dependent.Manifest.Id]--
+				if pending[dependent.Manifest.Id] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		level = next
+	}
+
+	if placed < len(plugins) {
+		var cyclic []*model.BundleInfo
+		for _, plugin := range plugins {
+			if pending[plugin.Manifest.Id] > 0 {
+				cyclic = append(cyclic, plugin)
+			}
+		}
+		levels = append(levels, cyclic)
+	}
+
+	return levels
+}
+
+// pluginsBlockedByDependencies returns the IDs of the enabled plugins that can't run because a
+// plugin they depend on, directly or not, isn't enabled at a matching version.
+func pluginsBlockedByDependencies(enabledPlugins []*model.BundleInfo) map[string]bool {
+	blocked := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, plugin := range enabledPlugins {
+			if blocked[plugin.Manifest.Id] {
+				continue
+			}
+
+			for _, dependency := range plugin.Manifest.Dependencies {
+				satisfied := slices.ContainsFunc(enabledPlugins, func(candidate *model.BundleInfo) bool {
+					return !blocked[candidate.Manifest.Id] && dependency.IsSatisfiedBy(candidate.Manifest)
+				})
+				if !satisfied {
+					blocked[plugin.Manifest.Id] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return blocked
+}
+
+// validatePluginDependencies checks the dependencies of a plugin being installed against the
+// installed plugins. Dependencies that aren't installed yet are allowed, since plugins may be
+// installed in any order, but installed ones must match the required versions, the plugin
+// mustn't introduce a dependency cycle, and its version must still satisfy the plugins that
+// depend on it.
+func validatePluginDependencies(manifest *model.Manifest, bundles []*model.BundleInfo) *model.AppError {
+	if err := manifest.IsValidDependencies(); err != nil {
+		return model.NewAppError("validatePluginDependencies", "app.plugin.dependencies.invalid.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	manifests := make(map[string]*model.Manifest, len(bundles)+1)
+	for _, bundle := range bundles {
+		if bundle.Manifest != nil {
+			manifests[bundle.Manifest.Id] = bundle.Manifest
+		}
+	}
+	manifests[manifest.Id] = manifest
+
+	for _, dependency := range manifest.Dependencies {
+		installed, ok := manifests[dependency.Id]
+		if ok && !dependency.IsSatisfiedBy(installed) {
+			return model.NewAppError("validatePluginDependencies", "app.plugin.dependencies.version.app_error", map[string]any{"Id": dependency.Id, "Required": dependency.Version, "Version": installed.Version}, "", http.StatusBadRequest)
+		}
+	}
+
+	for _, installed := range manifests {
+		if dependency := installed.GetDependency(manifest.Id); dependency != nil && !dependency.IsSatisfiedBy(manifest) {
+			return model.NewAppError("validatePluginDependencies", "app.plugin.dependencies.dependent_version.app_error", map[string]any{"Id": installed.Id, "Required": dependency.Version, "Version": manifest.Version}, "", http.StatusBadRequest)
+		}
+	}
+
+	// Look for a path from the plugin's dependencies back to the plugin.
+	visited := map[string]bool{}
+	var reaches func(id string) bool
+	reaches = func(id string) bool {
+		if id == manifest.Id {
+			return true
+		}
+		if visited[id] {
+			return false
+		}
+		visited[id] = true
+
+		installed, ok := manifests[id]
+		if !ok {
+			return false
+		}
+		for _, dependency := range installed.Dependencies {
+			if reaches(dependency.Id) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, dependency := range manifest.Dependencies {
+		if reaches(dependency.Id) {
+			return model.NewAppError("validatePluginDependencies", "app.plugin.dependencies.cycle.app_error", map[string]any{"Id": dependency.Id}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// checkPluginDependents refuses to disable a plugin that enabled plugins depend on.
+func (ch *Channels) checkPluginDependents(pluginID string, availablePlugins []*model.BundleInfo) *model.AppError {
+	var dependents []string
+	for _, plugin := range availablePlugins {
+		if plugin.Manifest == nil || plugin.Manifest.GetDependency(pluginID) == nil {
+			continue
+		}
+
+		enabled := false
+		if state, ok := ch.cfgSvc.Config().PluginSettings.PluginStates[plugin.Manifest.Id]; ok {
+			enabled = state.Enable
+		}
+		if hasOverride, value := ch.getPluginStateOverride(plugin.Manifest.Id); hasOverride {
+			enabled = value
+		}
+
+		if enabled {
+			dependents = append(dependents, plugin.Manifest.Id)
+		}
+	}
+
+	if len(dependents) > 0 {
+		return model.NewAppError("DisablePlugin", "app.plugin.disable.dependents.app_error", map[string]any{"Id": pluginID, "Dependents": strings.Join(dependents, ", ")}, "", http.StatusConflict)
+	}
+
+	return nil
+}
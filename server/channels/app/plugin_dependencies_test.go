@@ -0,0 +1,152 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func makeDependencyTestBundle(id, version string, dependencies ...*model.PluginDependency) *model.BundleInfo {
+	return &model.BundleInfo{Manifest: &model.Manifest{Id: id, Version: version, Dependencies: dependencies}}
+}
+
+func bundleLevelIDs(levels [][]*model.BundleInfo) [][]string {
+	ids := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		levelIDs := make([]string, 0, len(level))
+		for _, plugin := range level {
+			levelIDs = append(levelIDs, plugin.Manifest.Id)
+		}
+		ids = append(ids, levelIDs)
+	}
+	return ids
+}
+
+func TestPluginActivationLevels(t *testing.T) {
+	t.Run("plugins without dependencies share a level", func(t *testing.T) {
+		levels := pluginActivationLevels([]*model.BundleInfo{
+			makeDependencyTestBundle("a", "1.0.0"),
+			makeDependencyTestBundle("b", "1.0.0"),
+		})
+		assert.Equal(t, [][]string{{"a", "b"}}, bundleLevelIDs(levels))
+	})
+
+	t.Run("plugins come after their dependencies", func(t *testing.T) {
+		levels := pluginActivationLevels([]*model.BundleInfo{
+			makeDependencyTestBundle("calendar", "1.0.0", &model.PluginDependency{Id: "bots"}, &model.PluginDependency{Id: "oauth"}),
+			makeDependencyTestBundle("reminders", "1.0.0", &model.PluginDependency{Id: "calendar"}),
+			makeDependencyTestBundle("oauth", "1.0.0", &model.PluginDependency{Id: "bots"}),
+			makeDependencyTestBundle("bots", "1.0.0"),
+			makeDependencyTestBundle("jira", "1.0.0", &model.PluginDependency{Id: "not-installed"}),
+		})
+		assert.Equal(t, [][]string{{"bots", "jira"}, {"oauth"}, {"calendar"}, {"reminders"}}, bundleLevelIDs(levels))
+	})
+
+	t.Run("plugins in a cycle come last", func(t *testing.T) {
+		levels := pluginActivationLevels([]*model.BundleInfo{
+			makeDependencyTestBundle("a", "1.0.0", &model.PluginDependency{Id: "b"}),
+			makeDependencyTestBundle("b", "1.0.0", &model.PluginDependency{Id: "a"}),
+			makeDependencyTestBundle("c", "1.0.0"),
+		})
+		assert.Equal(t, [][]string{{"c"}, {"a", "b"}}, bundleLevelIDs(levels))
+	})
+}
+
+func TestPluginsBlockedByDependencies(t *testing.T) {
+	blocked := pluginsBlockedByDependencies([]*model.BundleInfo{
+		makeDependencyTestBundle("bots", "1.5.0"),
+		makeDependencyTestBundle("calendar", "1.0.0", &model.PluginDependency{Id: "bots", Version: ">=1.0.0"}),
+		makeDependencyTestBundle("oauth", "1.0.0", &model.PluginDependency{Id: "bots", Version: ">=2.0.0"}),
+		makeDependencyTestBundle("reminders", "1.0.0", &model.PluginDependency{Id: "oauth"}),
+		makeDependencyTestBundle("jira", "1.0.0", &model.PluginDependency{Id: "disabled"}),
+	})
+	assert.Equal(t, map[string]bool{"oauth": true, "reminders": true, "jira": true}, blocked)
+}
+
+func TestValidatePluginDependencies(t *testing.T) {
+	installed := []*model.BundleInfo{
+		makeDependencyTestBundle("bots", "1.5.0"),
+		makeDependencyTestBundle("calendar", "1.0.0", &model.PluginDependency{Id: "bots", Version: ">=1.0.0 <2.0.0"}),
+		makeDependencyTestBundle("reminders", "1.0.0", &model.PluginDependency{Id: "calendar"}),
+	}
+
+	for name, tc := range map[string]struct {
+		manifest *model.Manifest
+		errorID  string
+	}{
+		"no dependencies": {
+			manifest: &model.Manifest{Id: "jira", Version: "1.0.0"},
+		},
+		"dependency not installed yet": {
+			manifest: &model.Manifest{Id: "jira", Version: "1.0.0", Dependencies: []*model.PluginDependency{{Id: "oauth"}}},
+		},
+		"matching installed dependency": {
+			manifest: &model.Manifest{Id: "jira", Version: "1.0.0", Dependencies: []*model.PluginDependency{{Id: "bots", Version: ">=1.2.0"}}},
+		},
+		"invalid dependency": {
+			manifest: &model.Manifest{Id: "jira", Version: "1.0.0", Dependencies: []*model.PluginDependency{{Id: "bots", Version: "latest"}}},
+			errorID:  "app.plugin.dependencies.invalid.app_error",
+		},
+		"installed dependency version mismatch": {
+			manifest: &model.Manifest{Id: "jira", Version: "1.0.0", Dependencies: []*model.PluginDependency{{Id: "bots", Version: ">=2.0.0"}}},
+			errorID:  "app.plugin.dependencies.version.app_error",
+		},
+		"upgrade breaking a dependent": {
+			manifest: &model.Manifest{Id: "bots", Version: "2.0.0"},
+			errorID:  "app.plugin.dependencies.dependent_version.app_error",
+		},
+		"compatible upgrade": {
+			manifest: &model.Manifest{Id: "bots", Version: "1.6.0"},
+		},
+		"dependency cycle": {
+			manifest: &model.Manifest{Id: "bots", Version: "1.6.0", Dependencies: []*model.PluginDependency{{Id: "reminders"}}},
+			errorID:  "app.plugin.dependencies.cycle.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			appErr := validatePluginDependencies(tc.manifest, installed)
+			if tc.errorID == "" {
+				require.Nil(t, appErr)
+				return
+			}
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.errorID, appErr.Id)
+		})
+	}
+}
+
+func TestCheckPluginDependents(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	available := []*model.BundleInfo{
+		makeDependencyTestBundle("bots", "1.5.0"),
+		makeDependencyTestBundle("calendar", "1.0.0", &model.PluginDependency{Id: "bots"}),
+		makeDependencyTestBundle("oauth", "1.0.0", &model.PluginDependency{Id: "bots"}),
+	}
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.PluginSettings.PluginStates["bots"] = &model.PluginState{Enable: true}
+		cfg.PluginSettings.PluginStates["calendar"] = &model.PluginState{Enable: true}
+		cfg.PluginSettings.PluginStates["oauth"] = &model.PluginState{Enable: false}
+	})
+
+	appErr := th.App.Channels().checkPluginDependents("bots", available)
+	require.NotNil(t, appErr)
+	assert.Equal(t, "app.plugin.disable.dependents.app_error", appErr.Id)
+	assert.Equal(t, http.StatusConflict, appErr.StatusCode)
+
+	require.Nil(t, th.App.Channels().checkPluginDependents("calendar", available))
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		cfg.PluginSettings.PluginStates["calendar"] = &model.PluginState{Enable: false}
+	})
+	require.Nil(t, th.App.Channels().checkPluginDependents("bots", available))
+}
@@ -2137,3 +2137,227 @@ func TestUserHasJoinedChannel(t *testing.T) {
 		}
 	})
 }
+
+func TestHookLifecycleEvents(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	events := make(chan string, 100)
+	var mockAPI plugintest.API
+	mockAPI.On("LoadPluginConfiguration", mock.Anything).Return(nil)
+	mockAPI.On("LogDebug", mock.Anything).Run(func(args mock.Arguments) {
+		events <- args.String(0)
+	}).Return()
+
+	tearDown, _, activationErrors := SetAppEnvironmentWithPlugins(t,
+		[]string{
+			`
+		package main
+
+		import (
+			"strconv"
+
+			"github.com/mattermost/mattermost/server/public/plugin"
+			"github.com/mattermost/mattermost/server/public/model"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) ChannelHasBeenArchived(c *plugin.Context, channel *model.Channel, actor *model.User) {
+			p.API.LogDebug("ChannelHasBeenArchived " + channel.Id + " " + actor.Id)
+		}
+
+		func (p *MyPlugin) ChannelHasBeenRestored(c *plugin.Context, channel *model.Channel, actor *model.User) {
+			p.API.LogDebug("ChannelHasBeenRestored " + channel.Id + " " + actor.Id)
+		}
+
+		func (p *MyPlugin) ChannelHasBeenRenamed(c *plugin.Context, newChannel, oldChannel *model.Channel) {
+			p.API.LogDebug("ChannelHasBeenRenamed " + oldChannel.Name + " " + newChannel.Name)
+		}
+
+		func (p *MyPlugin) ChannelHasBeenConverted(c *plugin.Context, newChannel, oldChannel *model.Channel) {
+			p.API.LogDebug("ChannelHasBeenConverted " + string(oldChannel.Type) + " " + string(newChannel.Type))
+		}
+
+		func (p *MyPlugin) ChannelMemberRolesHaveChanged(c *plugin.Context, newMember, oldMember *model.ChannelMember) {
+			p.API.LogDebug("ChannelMemberRolesHaveChanged " + newMember.UserId + " " + strconv.FormatBool(oldMember.SchemeAdmin) + " " + strconv.FormatBool(newMember.SchemeAdmin))
+		}
+
+		func (p *MyPlugin) TeamMemberRolesHaveChanged(c *plugin.Context, newMember, oldMember *model.TeamMember) {
+			p.API.LogDebug("TeamMemberRolesHaveChanged " + newMember.UserId + " " + strconv.FormatBool(oldMember.SchemeAdmin) + " " + strconv.FormatBool(newMember.SchemeAdmin))
+		}
+
+		func (p *MyPlugin) PostHasBeenPinned(c *plugin.Context, post *model.Post) {
+			p.API.LogDebug("PostHasBeenPinned " + post.Id)
+		}
+
+		func (p *MyPlugin) PostHasBeenUnpinned(c *plugin.Context, post *model.Post) {
+			p.API.LogDebug("PostHasBeenUnpinned " + post.Id)
+		}
+
+		func (p *MyPlugin) PostHasBeenAcknowledged(c *plugin.Context, acknowledgement *model.PostAcknowledgement) {
+			p.API.LogDebug("PostHasBeenAcknowledged " + acknowledgement.PostId + " " + acknowledgement.UserId)
+		}
+
+		func (p *MyPlugin) ChannelBookmarkHasBeenCreated(c *plugin.Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+			p.API.LogDebug("ChannelBookmarkHasBeenCreated " + bookmark.DisplayName)
+		}
+
+		func (p *MyPlugin) ChannelBookmarkHasBeenUpdated(c *plugin.Context, newBookmark, oldBookmark *model.ChannelBookmarkWithFileInfo) {
+			p.API.LogDebug("ChannelBookmarkHasBeenUpdated " + oldBookmark.DisplayName + " " + newBookmark.DisplayName)
+		}
+
+		func (p *MyPlugin) ChannelBookmarkHasBeenDeleted(c *plugin.Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+			p.API.LogDebug("ChannelBookmarkHasBeenDeleted " + bookmark.DisplayName)
+		}
+
+		func (p *MyPlugin) TeamHasBeenCreated(c *plugin.Context, team *model.Team) {
+			p.API.LogDebug("TeamHasBeenCreated " + team.Name)
+		}
+
+		func (p *MyPlugin) TeamHasBeenUpdated(c *plugin.Context, newTeam, oldTeam *model.Team) {
+			p.API.LogDebug("TeamHasBeenUpdated " + oldTeam.DisplayName + " " + newTeam.DisplayName)
+		}
+
+		func (p *MyPlugin) UserHasBeenUpdated(c *plugin.Context, newUser, oldUser *model.User) {
+			p.API.LogDebug("UserHasBeenUpdated " + oldUser.Nickname + " " + newUser.Nickname + " " + newUser.Password)
+		}
+
+		func (p *MyPlugin) UserCustomStatusHasChanged(c *plugin.Context, userID string, customStatus *model.CustomStatus) {
+			text := "cleared"
+			if customStatus != nil {
+				text = customStatus.Text
+			}
+			p.API.LogDebug("UserCustomStatusHasChanged " + userID + " " + text)
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+	`}, th.App, func(*model.Manifest) plugin.API { return &mockAPI })
+	defer tearDown()
+	require.Len(t, activationErrors, 1)
+	require.NoError(t, activationErrors[0])
+
+	requireEvent := func(t *testing.T, expected string) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event := <-events:
+				if event == expected {
+					return
+				}
+			case <-timeout:
+				require.Failf(t, "hook was not invoked", "expected %q", expected)
+			}
+		}
+	}
+
+	t.Run("channel archived and restored", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+
+		appErr := th.App.DeleteChannel(th.Context, channel, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelHasBeenArchived "+channel.Id+" "+th.BasicUser.Id)
+
+		channel, appErr = th.App.GetChannel(th.Context, channel.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.RestoreChannel(th.Context, channel, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelHasBeenRestored "+channel.Id+" "+th.BasicUser.Id)
+	})
+
+	t.Run("channel renamed and converted", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		oldName := channel.Name
+
+		channel, appErr := th.App.RenameChannel(th.Context, channel, "renamed-channel", "Renamed channel")
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelHasBeenRenamed "+oldName+" renamed-channel")
+
+		channel.Type = model.ChannelTypePrivate
+		_, appErr = th.App.UpdateChannelPrivacy(th.Context, channel, th.BasicUser)
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelHasBeenConverted O P")
+	})
+
+	t.Run("channel and team member roles changed", func(t *testing.T) {
+		_, appErr := th.App.UpdateChannelMemberSchemeRoles(th.Context, th.BasicChannel.Id, th.BasicUser.Id, false, true, true)
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelMemberRolesHaveChanged "+th.BasicUser.Id+" false true")
+
+		_, appErr = th.App.UpdateTeamMemberSchemeRoles(th.Context, th.BasicTeam.Id, th.BasicUser.Id, false, true, true)
+		require.Nil(t, appErr)
+		requireEvent(t, "TeamMemberRolesHaveChanged "+th.BasicUser.Id+" false true")
+	})
+
+	t.Run("post pinned, unpinned and acknowledged", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+
+		_, appErr := th.App.PatchPost(th.Context, post.Id, &model.PostPatch{IsPinned: model.NewPointer(true)})
+		require.Nil(t, appErr)
+		requireEvent(t, "PostHasBeenPinned "+post.Id)
+
+		_, appErr = th.App.PatchPost(th.Context, post.Id, &model.PostPatch{IsPinned: model.NewPointer(false)})
+		require.Nil(t, appErr)
+		requireEvent(t, "PostHasBeenUnpinned "+post.Id)
+
+		_, appErr = th.App.SaveAcknowledgementForPost(th.Context, post.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		requireEvent(t, "PostHasBeenAcknowledged "+post.Id+" "+th.BasicUser2.Id)
+	})
+
+	t.Run("channel bookmark created, updated and deleted", func(t *testing.T) {
+		th.Context.Session().UserId = th.BasicUser.Id
+
+		bookmark, appErr := th.App.CreateChannelBookmark(th.Context, createBookmark("Bookmark", model.ChannelBookmarkLink, th.BasicChannel.Id, ""), "")
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelBookmarkHasBeenCreated Bookmark")
+
+		updateBookmark := bookmark.Clone()
+		updateBookmark.DisplayName = "Updated bookmark"
+		response, appErr := th.App.UpdateChannelBookmark(th.Context, updateBookmark, "")
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelBookmarkHasBeenUpdated Bookmark Updated bookmark")
+
+		_, appErr = th.App.DeleteChannelBookmark(response.Updated.Id, "")
+		require.Nil(t, appErr)
+		requireEvent(t, "ChannelBookmarkHasBeenDeleted Updated bookmark")
+	})
+
+	t.Run("team created and updated", func(t *testing.T) {
+		team, appErr := th.App.CreateTeam(th.Context, &model.Team{
+			DisplayName: "Lifecycle team",
+			Name:        "lifecycle-" + model.NewId(),
+			Email:       "success+" + model.NewId() + "@simulator.amazonses.com",
+			Type:        model.TeamOpen,
+		})
+		require.Nil(t, appErr)
+		requireEvent(t, "TeamHasBeenCreated "+team.Name)
+
+		_, appErr = th.App.PatchTeam(team.Id, &model.TeamPatch{DisplayName: model.NewPointer("Patched team")})
+		require.Nil(t, appErr)
+		requireEvent(t, "TeamHasBeenUpdated Lifecycle team Patched team")
+	})
+
+	t.Run("user updated and custom status changed", func(t *testing.T) {
+		user := th.CreateUser()
+		oldNickname := user.Nickname
+		user.Nickname = "new-nickname"
+		_, appErr := th.App.UpdateUser(th.Context, user, false)
+		require.Nil(t, appErr)
+		// The password is sanitized before the users are passed to plugins.
+		requireEvent(t, "UserHasBeenUpdated "+oldNickname+" new-nickname ")
+
+		appErr = th.App.SetCustomStatus(th.Context, user.Id, &model.CustomStatus{Text: "In a meeting"})
+		require.Nil(t, appErr)
+		requireEvent(t, "UserCustomStatusHasChanged "+user.Id+" In a meeting")
+
+		appErr = th.App.RemoveCustomStatus(th.Context, user.Id)
+		require.Nil(t, appErr)
+		requireEvent(t, "UserCustomStatusHasChanged "+user.Id+" cleared")
+	})
+}
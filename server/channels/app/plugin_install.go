@@ -470,8 +470,14 @@ func (ch *Channels) installExtractedPlugin(manifest *model.Manifest, fromPluginD
 				return nil, model.NewAppError("installExtractedPlugin", "app.plugin.skip_installation.app_error", map[string]any{"Id": manifest.Id}, "", http.StatusInternalServerError)
 			}
 		}
+	}
+
+	if appErr := validatePluginDependencies(manifest, bundles); appErr != nil {
+		return nil, appErr
+	}
 
-		// Otherwise remove the existing installation prior to installing below.
+	if existingManifest != nil {
+		// Remove the existing installation prior to installing below.
 		logger.Info("Removing existing installation of plugin before local install", mlog.String("existing_version", existingManifest.Version))
 		if err := ch.removePluginLocally(existingManifest.Id); err != nil {
 			return nil, model.NewAppError("installExtractedPlugin", "app.plugin.install_id_failed_remove.app_error", nil, "", http.StatusInternalServerError)
@@ -522,9 +528,20 @@ func (ch *Channels) installExtractedPlugin(manifest *model.Manifest, fromPluginD
 func (ch *Channels) RemovePlugin(id string) *model.AppError {
 	logger := ch.srv.Log().With(mlog.String("plugin_id", id))
 
+	// Revoke granted capabilities so that a re-installed plugin must be approved again.
+	// The config change is persisted when the plugin is disabled below.
+	ch.revokePluginCapabilities(id)
+
+	// Strip the plugin's permissions from every role. Disabling a plugin keeps them so that
+	// re-enabling it restores the administrator's grants, but removing it starts over.
+	if appErr := ch.removePluginPermissions(id); appErr != nil {
+		logger.Warn("Failed to remove plugin permissions", mlog.Err(appErr))
+	}
+
 	// Disable plugin before removal to make sure this
-	// plugin remains disabled on re-install.
-	if err := ch.disablePlugin(id); err != nil {
+	// plugin remains disabled on re-install. Plugins depending on it
+	// stay enabled, and report the unmet dependency in their status.
+	if err := ch.disablePlugin(id, true); err != nil {
 		return err
 	}
 
@@ -591,6 +608,7 @@ func (ch *Channels) removePluginLocally(id string) *model.AppError {
 	pluginsEnvironment.Deactivate(id)
 	pluginsEnvironment.RemovePlugin(id)
 	ch.unregisterPluginCommands(id)
+	ch.unregisterPluginJobs(id)
 
 	if err := os.RemoveAll(unpackedBundlePath); err != nil {
 		return model.NewAppError("removePlugin", "app.plugin.remove.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
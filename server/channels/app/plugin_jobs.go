@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/plugin_job"
+)
+
+// RegisterPluginJob registers a job type owned by the given plugin with the job server. Jobs of
+// that type are run through the plugin's RunPluginJob hook, and are also scheduled periodically
+// when the definition has an interval. Registering a job again replaces its previous definition.
+func (a *App) RegisterPluginJob(pluginID string, definition *model.PluginJobDefinition) *model.AppError {
+	if appErr := definition.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	jobType := definition.JobType()
+
+	a.ch.pluginJobsLock.Lock()
+	defer a.ch.pluginJobsLock.Unlock()
+
+	if owner, ok := a.ch.pluginJobs[jobType]; ok && owner != pluginID {
+		return model.NewAppError("RegisterPluginJob", "app.plugin.jobs.name_taken.app_error", map[string]any{"Name": definition.Name}, "owner="+owner, http.StatusConflict)
+	}
+
+	var scheduler jobs.Scheduler
+	if definition.IntervalSeconds > 0 {
+		scheduler = plugin_job.MakeScheduler(a.Srv().Jobs, a, pluginID, jobType, time.Duration(definition.IntervalSeconds)*time.Second)
+	} else {
+		// Drop the scheduler of a previous definition, if any.
+		a.Srv().Jobs.UnregisterJobType(jobType)
+	}
+	a.Srv().Jobs.RegisterJobType(jobType, plugin_job.MakeWorker(a.Srv().Jobs, a, pluginID, jobType), scheduler)
+	a.ch.pluginJobs[jobType] = pluginID
+
+	a.Log().Info("Registered plugin job", mlog.String("plugin_id", pluginID), mlog.String("job_type", jobType), mlog.Int("interval_seconds", definition.IntervalSeconds))
+
+	return nil
+}
+
+// UnregisterPluginJob stops running and scheduling the jobs of the given plugin job. Existing
+// jobs are kept.
+func (a *App) UnregisterPluginJob(pluginID, name string) *model.AppError {
+	jobType := model.PluginJobType(name)
+
+	a.ch.pluginJobsLock.Lock()
+	if owner, ok := a.ch.pluginJobs[jobType]; !ok || owner != pluginID {
+		a.ch.pluginJobsLock.Unlock()
+		return model.NewAppError("UnregisterPluginJob", "app.plugin.jobs.not_registered.app_error", map[string]any{"Name": name}, "", http.StatusNotFound)
+	}
+	delete(a.ch.pluginJobs, jobType)
+	a.ch.pluginJobsLock.Unlock()
+
+	// A running job may call back into the plugin API, so wait for it without holding the lock.
+	a.Srv().Jobs.UnregisterJobType(jobType)
+
+	return nil
+}
+
+// unregisterPluginJobs unregisters all the jobs of the given plugin. The plugin should be
+// deactivated first, since this waits for its running jobs to complete.
+func (ch *Channels) unregisterPluginJobs(pluginID string) {
+	ch.pluginJobsLock.Lock()
+	jobTypes := []string{}
+	for jobType, owner := range ch.pluginJobs {
+		if owner == pluginID {
+			jobTypes = append(jobTypes, jobType)
+			delete(ch.pluginJobs, jobType)
+		}
+	}
+	ch.pluginJobsLock.Unlock()
+
+	if ch.srv.Jobs == nil {
+		return
+	}
+	for _, jobType := range jobTypes {
+		ch.srv.Jobs.UnregisterJobType(jobType)
+	}
+}
+
+// GetPluginJobTypes returns the job types currently registered by plugins.
+func (a *App) GetPluginJobTypes() []string {
+	a.ch.pluginJobsLock.RLock()
+	defer a.ch.pluginJobsLock.RUnlock()
+
+	jobTypes := make([]string, 0, len(a.ch.pluginJobs))
+	for jobType := range a.ch.pluginJobs {
+		jobTypes = append(jobTypes, jobType)
+	}
+	sort.Strings(jobTypes)
+
+	return jobTypes
+}
+
+func (a *App) isPluginJobOwner(pluginID, jobType string) bool {
+	a.ch.pluginJobsLock.RLock()
+	defer a.ch.pluginJobsLock.RUnlock()
+
+	owner, ok := a.ch.pluginJobs[jobType]
+	return ok && owner == pluginID
+}
+
+// CreatePluginJob creates a pending job of a job type registered by the given plugin. It is run
+// by whichever server running the plugin claims it first.
+func (a *App) CreatePluginJob(c request.CTX, pluginID, name string, data map[string]string) (*model.Job, *model.AppError) {
+	jobType := model.PluginJobType(name)
+	if !a.isPluginJobOwner(pluginID, jobType) {
+		return nil, model.NewAppError("CreatePluginJob", "app.plugin.jobs.not_registered.app_error", map[string]any{"Name": name}, "", http.StatusNotFound)
+	}
+
+	return a.Srv().Jobs.CreateJob(c, jobType, data)
+}
+
+// GetPluginJob returns a job of a job type registered by the given plugin.
+func (a *App) GetPluginJob(c request.CTX, pluginID, jobID string) (*model.Job, *model.AppError) {
+	job, appErr := a.GetJob(c, jobID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !a.isPluginJobOwner(pluginID, job.Type) {
+		return nil, model.NewAppError("GetPluginJob", "app.job.get.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return job, nil
+}
+
+// SetPluginJobProgress updates the progress of an in progress job of the given plugin.
+func (a *App) SetPluginJobProgress(c request.CTX, pluginID, jobID string, progress int64) *model.AppError {
+	if progress < 0 || progress > 100 {
+		return model.NewAppError("SetPluginJobProgress", "app.plugin.jobs.invalid_progress.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	job, appErr := a.GetPluginJob(c, pluginID, jobID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if job.Status != model.JobStatusInProgress {
+		return model.NewAppError("SetPluginJobProgress", "app.plugin.jobs.not_in_progress.app_error", nil, "status="+job.Status, http.StatusBadRequest)
+	}
+
+	return a.Srv().Jobs.SetJobProgress(job, progress)
+}
+
+// RunPluginJob runs a job claimed by this server through the RunPluginJob hook of the plugin
+// owning its job type.
+func (a *App) RunPluginJob(pluginID string, job *model.Job) error {
+	hooks, err := a.ch.HooksForPlugin(pluginID)
+	if err != nil {
+		return err
+	}
+
+	return hooks.RunPluginJob(job)
+}
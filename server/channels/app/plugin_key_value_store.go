@@ -124,3 +124,116 @@ func (a *App) DeleteAllExpiredPluginKeys() *model.AppError {
 func (a *App) ListPluginKeys(pluginID string, page, perPage int) ([]string, *model.AppError) {
 	return a.Srv().Platform().ListPluginKeys(pluginID, page, perPage)
 }
+
+func (a *App) ListPluginKeysWithOptions(pluginID string, options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	if err := options.IsValid(); err != nil {
+		return nil, err
+	}
+	options.SetDefaults()
+
+	keys, err := a.Srv().Store().Plugin().ListWithOptions(pluginID, options)
+	if err != nil {
+		mlog.Error("Failed to list plugin key values", mlog.String("plugin_id", pluginID), mlog.String("prefix", options.Prefix), mlog.Err(err))
+		return nil, model.NewAppError("ListPluginKeysWithOptions", "app.plugin_store.list.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	page := &model.PluginKVListPage{Keys: keys}
+	if len(keys) == options.PerPage {
+		page.NextCursor = keys[len(keys)-1]
+	}
+
+	return page, nil
+}
+
+// GetPluginKeys returns the values of the given keys that exist, indexed by key.
+func (a *App) GetPluginKeys(pluginID string, keys []string) (map[string][]byte, *model.AppError) {
+	if len(keys) > model.PluginKVBatchMaxSize {
+		return nil, model.NewAppError("GetPluginKeys", "app.plugin_store.get_multi.too_many_keys.app_error", map[string]any{"Max": model.PluginKVBatchMaxSize}, "", http.StatusBadRequest)
+	}
+
+	values := make(map[string][]byte, len(keys))
+	kvs, err := a.Srv().Store().Plugin().GetMulti(pluginID, keys)
+	if err != nil {
+		mlog.Error("Failed to query plugin key values", mlog.String("plugin_id", pluginID), mlog.Err(err))
+		return nil, model.NewAppError("GetPluginKeys", "app.plugin_store.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	for _, kv := range kvs {
+		values[kv.Key] = kv.Value
+	}
+
+	// Lookup the remaining keys using their hashed version for keys written prior to v5.6.
+	hashedKeys := map[string]string{}
+	lookupKeys := []string{}
+	for _, key := range keys {
+		if _, ok := values[key]; !ok {
+			hashedKeys[getKeyHash(key)] = key
+			lookupKeys = append(lookupKeys, getKeyHash(key))
+		}
+	}
+	if len(lookupKeys) == 0 {
+		return values, nil
+	}
+
+	kvs, err = a.Srv().Store().Plugin().GetMulti(pluginID, lookupKeys)
+	if err != nil {
+		mlog.Error("Failed to query plugin key values using hashed keys", mlog.String("plugin_id", pluginID), mlog.Err(err))
+		return nil, model.NewAppError("GetPluginKeys", "app.plugin_store.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	for _, kv := range kvs {
+		values[hashedKeys[kv.Key]] = kv.Value
+	}
+
+	return values, nil
+}
+
+// GetPluginKeyMetadata returns nil for non-existent keys.
+func (a *App) GetPluginKeyMetadata(pluginID string, key string) (*model.PluginKVMetadata, *model.AppError) {
+	if metadata, err := a.Srv().Store().Plugin().GetMetadata(pluginID, key); err == nil {
+		return metadata, nil
+	} else if nfErr := new(store.ErrNotFound); !errors.As(err, &nfErr) {
+		mlog.Error("Failed to query plugin key metadata", mlog.String("plugin_id", pluginID), mlog.String("key", key), mlog.Err(err))
+		return nil, model.NewAppError("GetPluginKeyMetadata", "app.plugin_store.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Lookup using the hashed version of the key for keys written prior to v5.6.
+	if metadata, err := a.Srv().Store().Plugin().GetMetadata(pluginID, getKeyHash(key)); err == nil {
+		metadata.Key = key
+		return metadata, nil
+	} else if nfErr := new(store.ErrNotFound); !errors.As(err, &nfErr) {
+		mlog.Error("Failed to query plugin key metadata using hashed key", mlog.String("plugin_id", pluginID), mlog.String("key", key), mlog.Err(err))
+		return nil, model.NewAppError("GetPluginKeyMetadata", "app.plugin_store.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil, nil
+}
+
+func (a *App) BatchPluginKeys(pluginID string, ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	if err := model.IsValidPluginKVBatch(ops); err != nil {
+		return false, err
+	}
+
+	applied, err := a.Srv().Store().Plugin().Batch(pluginID, ops)
+	if err != nil {
+		mlog.Error("Failed to apply plugin key value batch", mlog.String("plugin_id", pluginID), mlog.Int("operations", len(ops)), mlog.Err(err))
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return false, appErr
+		default:
+			return false, model.NewAppError("BatchPluginKeys", "app.plugin_store.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if !applied {
+		return false, nil
+	}
+
+	// Clean up previous entries using the hashed keys, if they exist.
+	for _, op := range ops {
+		if err := a.Srv().Store().Plugin().Delete(pluginID, getKeyHash(op.Key)); err != nil {
+			mlog.Warn("Failed to clean up previously hashed plugin key value", mlog.String("plugin_id", pluginID), mlog.String("key", op.Key), mlog.Err(err))
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// GetPluginMigrations returns the database migrations applied by the given plugin, ordered by
+// version.
+func (a *App) GetPluginMigrations(pluginID string) ([]*model.PluginMigration, *model.AppError) {
+	migrations, err := a.Srv().Store().PluginMigration().GetAll(pluginID)
+	if err != nil {
+		return nil, model.NewAppError("GetPluginMigrations", "app.plugin.migrations.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return migrations, nil
+}
+
+// ApplyPluginMigration runs a database migration of the given plugin and records it as applied.
+// The migration SQL isn't restricted to the plugin's own tables, so every attempt is audited
+// along with the statements it runs.
+func (a *App) ApplyPluginMigration(pluginID string, migration *model.PluginMigration) *model.AppError {
+	migration.PluginId = pluginID
+	migration.AppliedAt = 0
+
+	rctx := request.EmptyContext(a.Log())
+	auditRec := a.MakeAuditRecord(rctx, "applyPluginMigration", audit.Fail)
+	auditRec.AddMeta("plugin_id", pluginID)
+	auditRec.AddMeta("version", migration.Version)
+	auditRec.AddMeta("name", migration.Name)
+	auditRec.AddMeta("up", migration.Up)
+	auditRec.AddMeta("down", migration.Down)
+
+	if err := a.Srv().Store().PluginMigration().Apply(migration); err != nil {
+		var appErr *model.AppError
+		var conflictErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+		case errors.As(err, &conflictErr):
+			appErr = model.NewAppError("ApplyPluginMigration", "app.plugin.migrations.already_applied.app_error", map[string]any{"Version": migration.Version}, "", http.StatusConflict).Wrap(err)
+		default:
+			appErr = model.NewAppError("ApplyPluginMigration", "app.plugin.migrations.apply.app_error", map[string]any{"Version": migration.Version}, "", http.StatusInternalServerError).Wrap(err)
+		}
+		a.LogAuditRecWithLevel(rctx, auditRec, LevelAPI, appErr)
+		return appErr
+	}
+
+	auditRec.Success()
+	a.LogAuditRecWithLevel(rctx, auditRec, LevelAPI, nil)
+
+	a.Log().Info("Applied plugin database migration", mlog.String("plugin_id", pluginID), mlog.Int("version", migration.Version), mlog.String("name", migration.Name))
+
+	return nil
+}
+
+// RollbackPluginMigrations reverts the migrations of the given plugin applied after toVersion,
+// newest first, returning those rolled back. The plugin must not be running, since its code
+// likely relies on the schema being rolled back.
+func (a *App) RollbackPluginMigrations(pluginID string, toVersion int64) ([]*model.PluginMigration, *model.AppError) {
+	if pluginsEnvironment := a.GetPluginsEnvironment(); pluginsEnvironment != nil && pluginsEnvironment.IsActive(pluginID) {
+		return nil, model.NewAppError("RollbackPluginMigrations", "app.plugin.migrations.plugin_active.app_error", nil, "", http.StatusConflict)
+	}
+
+	migrations, appErr := a.GetPluginMigrations(pluginID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	rolledBack := []*model.PluginMigration{}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if migration.Version <= toVersion {
+			break
+		}
+
+		if migration.Down == "" {
+			return rolledBack, model.NewAppError("RollbackPluginMigrations", "app.plugin.migrations.irreversible.app_error", map[string]any{"Version": migration.Version}, "", http.StatusBadRequest)
+		}
+
+		if err := a.Srv().Store().PluginMigration().Rollback(migration); err != nil {
+			return rolledBack, model.NewAppError("RollbackPluginMigrations", "app.plugin.migrations.rollback.app_error", map[string]any{"Version": migration.Version}, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		a.Log().Info("Rolled back plugin database migration", mlog.String("plugin_id", pluginID), mlog.Int("version", migration.Version), mlog.String("name", migration.Name))
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
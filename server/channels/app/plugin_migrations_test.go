@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
+)
+
+func TestApplyPluginMigration(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	pluginID := "com.mattermost.demo"
+	mockStore := th.App.Srv().Store().(*mocks.Store)
+	mockPluginMigrationStore := mocks.PluginMigrationStore{}
+	mockStore.On("PluginMigration").Return(&mockPluginMigrationStore)
+
+	mockPluginMigrationStore.On("Apply", mock.MatchedBy(func(m *model.PluginMigration) bool { return m.Version == 1 })).Return(nil).Once()
+	mockPluginMigrationStore.On("Apply", mock.MatchedBy(func(m *model.PluginMigration) bool { return m.Version == 2 })).Return(store.NewErrConflict("PluginMigration", errors.New("duplicate"), "")).Once()
+	mockPluginMigrationStore.On("Apply", mock.MatchedBy(func(m *model.PluginMigration) bool { return m.Version == 3 })).Return(errors.New("syntax error")).Once()
+
+	migration := &model.PluginMigration{PluginId: "other", Version: 1, Up: "SELECT 1"}
+	require.Nil(t, th.App.ApplyPluginMigration(pluginID, migration))
+	assert.Equal(t, pluginID, migration.PluginId)
+
+	appErr := th.App.ApplyPluginMigration(pluginID, &model.PluginMigration{Version: 2, Up: "SELECT 1"})
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusConflict, appErr.StatusCode)
+
+	appErr = th.App.ApplyPluginMigration(pluginID, &model.PluginMigration{Version: 3, Up: "SELECT 1"})
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusInternalServerError, appErr.StatusCode)
+}
+
+func TestRollbackPluginMigrations(t *testing.T) {
+	pluginID := "com.mattermost.demo"
+	migrations := []*model.PluginMigration{
+		{PluginId: pluginID, Version: 1, Name: "create_table", Down: "DROP TABLE t"},
+		{PluginId: pluginID, Version: 2, Name: "add_index"},
+		{PluginId: pluginID, Version: 3, Name: "add_column", Down: "ALTER TABLE t DROP COLUMN c"},
+	}
+
+	setup := func(t *testing.T) (*TestHelper, *mocks.PluginMigrationStore) {
+		th := SetupWithStoreMock(t)
+		t.Cleanup(th.TearDown)
+
+		mockStore := th.App.Srv().Store().(*mocks.Store)
+		mockPluginMigrationStore := mocks.PluginMigrationStore{}
+		mockPluginMigrationStore.On("GetAll", pluginID).Return(migrations, nil)
+		mockStore.On("PluginMigration").Return(&mockPluginMigrationStore)
+
+		return th, &mockPluginMigrationStore
+	}
+
+	t.Run("rolls back newer migrations, newest first", func(t *testing.T) {
+		th, mockPluginMigrationStore := setup(t)
+		mockPluginMigrationStore.On("Rollback", migrations[2]).Return(nil).Once()
+
+		rolledBack, appErr := th.App.RollbackPluginMigrations(pluginID, 2)
+		require.Nil(t, appErr)
+		assert.Equal(t, []*model.PluginMigration{migrations[2]}, rolledBack)
+		mockPluginMigrationStore.AssertExpectations(t)
+	})
+
+	t.Run("stops at irreversible migrations", func(t *testing.T) {
+		th, mockPluginMigrationStore := setup(t)
+		mockPluginMigrationStore.On("Rollback", migrations[2]).Return(nil).Once()
+
+		rolledBack, appErr := th.App.RollbackPluginMigrations(pluginID, 0)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.plugin.migrations.irreversible.app_error", appErr.Id)
+		assert.Equal(t, []*model.PluginMigration{migrations[2]}, rolledBack)
+		mockPluginMigrationStore.AssertExpectations(t)
+	})
+
+	t.Run("nothing to roll back", func(t *testing.T) {
+		th, _ := setup(t)
+
+		rolledBack, appErr := th.App.RollbackPluginMigrations(pluginID, 3)
+		require.Nil(t, appErr)
+		assert.Empty(t, rolledBack)
+	})
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const pluginPermissionsSchemesPerPage = 100
+
+// GetPluginPermissions returns the permissions registered by the active plugins.
+func (a *App) GetPluginPermissions() ([]*model.Permission, *model.AppError) {
+	pluginsEnvironment := a.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return nil, model.NewAppError("GetPluginPermissions", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	permissions := []*model.Permission{}
+	for _, plugin := range pluginsEnvironment.Active() {
+		if plugin.Manifest == nil {
+			continue
+		}
+		for _, permission := range plugin.Manifest.Permissions {
+			permissions = append(permissions, permission.ToPermission(plugin.Manifest.Id))
+		}
+	}
+
+	return permissions, nil
+}
+
+// syncPluginPermissions reconciles the roles with the permissions declared by an activated
+// plugin. Permissions declared for the first time are granted to system admins and to their
+// default roles, including the corresponding roles of every scheme. Permissions no longer declared
+// are removed from every role. Permissions of disabled plugins are left alone so that an
+// administrator's choices survive re-enabling the plugin.
+//
+// Every node activates plugins, but only the cluster leader syncs their permissions, one plugin
+// at a time, so that concurrent updates of the same role don't overwrite each other.
+func (ch *Channels) syncPluginPermissions(manifest *model.Manifest) *model.AppError {
+	if !ch.srv.IsLeader() {
+		return nil
+	}
+
+	ch.pluginPermissionsLock.Lock()
+	defer ch.pluginPermissionsLock.Unlock()
+
+	registered := ch.cfgSvc.Config().PluginSettings.RegisteredPermissions[manifest.Id]
+
+	declared := make([]string, 0, len(manifest.Permissions))
+	var added []*model.PluginPermission
+	for _, permission := range manifest.Permissions {
+		id := model.PluginPermissionId(manifest.Id, permission.Id)
+		declared = append(declared, id)
+		if !slices.Contains(registered, id) {
+			added = append(added, permission)
+		}
+	}
+
+	removed := slices.ContainsFunc(registered, func(id string) bool {
+		return !slices.Contains(declared, id)
+	})
+	if len(added) == 0 && !removed {
+		return nil
+	}
+
+	if removed {
+		if appErr := ch.removePluginPermissionsFromRoles(manifest.Id, declared); appErr != nil {
+			return appErr
+		}
+	}
+
+	if len(added) > 0 {
+		if appErr := ch.grantPluginPermissions(manifest.Id, added); appErr != nil {
+			return appErr
+		}
+	}
+
+	ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
+		if cfg.PluginSettings.RegisteredPermissions == nil {
+			cfg.PluginSettings.RegisteredPermissions = make(map[string][]string)
+		}
+		if len(declared) == 0 {
+			delete(cfg.PluginSettings.RegisteredPermissions, manifest.Id)
+			return
+		}
+		cfg.PluginSettings.RegisteredPermissions[manifest.Id] = declared
+	})
+
+	return nil
+}
+
+// syncActivePluginsPermissions syncs the permissions of every active plugin, for a node that just
+// became the cluster leader to complete any sync the previous leader didn't get to finish.
+func (ch *Channels) syncActivePluginsPermissions() {
+	pluginsEnvironment := ch.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return
+	}
+
+	for _, plugin := range pluginsEnvironment.Active() {
+		if plugin.Manifest == nil {
+			continue
+		}
+		if appErr := ch.syncPluginPermissions(plugin.Manifest); appErr != nil {
+			ch.srv.Log().Error("Failed to sync plugin permissions", mlog.String("plugin_id", plugin.Manifest.Id), mlog.Err(appErr))
+		}
+	}
+}
+
+// removePluginPermissions removes every permission of the given plugin from the roles, and forgets
+// that they were registered so that a reinstalled plugin starts over with its default grants.
+// The config change is persisted by the caller.
+func (ch *Channels) removePluginPermissions(pluginID string) *model.AppError {
+	ch.pluginPermissionsLock.Lock()
+	defer ch.pluginPermissionsLock.Unlock()
+
+	if appErr := ch.removePluginPermissionsFromRoles(pluginID, nil); appErr != nil {
+		return appErr
+	}
+
+	if _, ok := ch.cfgSvc.Config().PluginSettings.RegisteredPermissions[pluginID]; ok {
+		ch.cfgSvc.UpdateConfig(func(cfg *model.Config) {
+			delete(cfg.PluginSettings.RegisteredPermissions, pluginID)
+		})
+	}
+
+	return nil
+}
+
+// grantPluginPermissions grants the given permissions to system admins and to their default roles.
+func (ch *Channels) grantPluginPermissions(pluginID string, permissions []*model.PluginPermission) *model.AppError {
+	grants := map[string][]string{}
+	for _, permission := range permissions {
+		id := model.PluginPermissionId(pluginID, permission.Id)
+		grants[model.SystemAdminRoleId] = append(grants[model.SystemAdminRoleId], id)
+		for _, roleName := range permission.DefaultRoles {
+			grants[roleName] = append(grants[roleName], id)
+		}
+	}
+
+	var offset int
+	for {
+		schemes, err := ch.srv.Store().Scheme().GetAllPage("", offset, pluginPermissionsSchemesPerPage)
+		if err != nil {
+			return model.NewAppError("grantPluginPermissions", "app.scheme.get_all_page.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, scheme := range schemes {
+			for roleName, schemeRoleName := range schemeRolesByBuiltInRole(scheme) {
+				grants[schemeRoleName] = append(grants[schemeRoleName], grants[roleName]...)
+			}
+		}
+
+		if len(schemes) < pluginPermissionsSchemesPerPage {
+			break
+		}
+		offset += pluginPermissionsSchemesPerPage
+	}
+
+	return ch.addPermissionsToRoles(grants)
+}
+
+// grantPluginPermissionsToScheme grants the permissions of the active plugins to the roles of a
+// newly created scheme, as if the scheme had existed when they were registered.
+func (ch *Channels) grantPluginPermissionsToScheme(scheme *model.Scheme) *model.AppError {
+	pluginsEnvironment := ch.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return nil
+	}
+
+	ch.pluginPermissionsLock.Lock()
+	defer ch.pluginPermissionsLock.Unlock()
+
+	grants := map[string][]string{}
+	for _, plugin := range pluginsEnvironment.Active() {
+		if plugin.Manifest == nil {
+			continue
+		}
+		for _, permission := range plugin.Manifest.Permissions {
+			for roleName, schemeRoleName := range schemeRolesByBuiltInRole(scheme) {
+				if slices.Contains(permission.DefaultRoles, roleName) {
+					grants[schemeRoleName] = append(grants[schemeRoleName], model.PluginPermissionId(plugin.Manifest.Id, permission.Id))
+				}
+			}
+		}
+	}
+
+	return ch.addPermissionsToRoles(grants)
+}
+
+// addPermissionsToRoles adds the given permissions, keyed by role name, to the roles missing them.
+func (ch *Channels) addPermissionsToRoles(grants map[string][]string) *model.AppError {
+	if len(grants) == 0 {
+		return nil
+	}
+
+	roleNames := make([]string, 0, len(grants))
+	for roleName := range grants {
+		roleNames = append(roleNames, roleName)
+	}
+
+	roles, err := ch.srv.Store().Role().GetByNames(roleNames)
+	if err != nil {
+		return model.NewAppError("addPermissionsToRoles", "app.role.get_by_names.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, role := range roles {
+		changed := false
+		for _, permission := range grants[role.Name] {
+			if !slices.Contains(role.Permissions, permission) {
+				role.Permissions = append(role.Permissions, permission)
+				changed = true
+			}
+		}
+
+		if changed {
+			if appErr := ch.saveRoleWithPluginPermissions(role); appErr != nil {
+				return appErr
+			}
+		}
+	}
+
+	return nil
+}
+
+// removePluginPermissionsFromRoles removes the permissions of the given plugin from every role,
+// except those listed in keep.
+func (ch *Channels) removePluginPermissionsFromRoles(pluginID string, keep []string) *model.AppError {
+	roles, err := ch.srv.Store().Role().GetAll()
+	if err != nil {
+		return model.NewAppError("removePluginPermissionsFromRoles", "app.role.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	prefix := model.PluginPermissionPrefixFor(pluginID)
+	for _, role := range roles {
+		permissions := slices.DeleteFunc(slices.Clone(role.Permissions), func(permission string) bool {
+			return strings.HasPrefix(permission, prefix) && !slices.Contains(keep, permission)
+		})
+		if len(permissions) == len(role.Permissions) {
+			continue
+		}
+
+		role.Permissions = permissions
+		if appErr := ch.saveRoleWithPluginPermissions(role); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
+
+func (ch *Channels) saveRoleWithPluginPermissions(role *model.Role) *model.AppError {
+	savedRole, err := ch.srv.Store().Role().Save(role)
+	if err != nil {
+		return model.NewAppError("saveRoleWithPluginPermissions", "app.role.save.insert.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if appErr := New(ServerConnector(ch)).sendUpdatedRoleEvent(savedRole); appErr != nil {
+		ch.srv.Log().Warn("Failed to send role updated event", mlog.String("role_name", savedRole.Name), mlog.Err(appErr))
+	}
+
+	return nil
+}
+
+// schemeRolesByBuiltInRole maps the built-in roles onto the roles the scheme overrides them with.
+func schemeRolesByBuiltInRole(scheme *model.Scheme) map[string]string {
+	roles := map[string]string{
+		model.TeamAdminRoleId:    scheme.DefaultTeamAdminRole,
+		model.TeamUserRoleId:     scheme.DefaultTeamUserRole,
+		model.TeamGuestRoleId:    scheme.DefaultTeamGuestRole,
+		model.ChannelAdminRoleId: scheme.DefaultChannelAdminRole,
+		model.ChannelUserRoleId:  scheme.DefaultChannelUserRole,
+		model.ChannelGuestRoleId: scheme.DefaultChannelGuestRole,
+	}
+	for roleName, schemeRoleName := range roles {
+		if schemeRoleName == "" {
+			delete(roles, roleName)
+		}
+	}
+	return roles
+}
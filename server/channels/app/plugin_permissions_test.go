@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSyncPluginPermissions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	pluginID := "com.mattermost.widgets"
+	manageWidgets := model.PluginPermissionId(pluginID, "manage_widgets")
+	viewWidgets := model.PluginPermissionId(pluginID, "view_widgets")
+
+	manifest := &model.Manifest{
+		Id: pluginID,
+		Permissions: []*model.PluginPermission{
+			{Id: "manage_widgets", Name: "Manage widgets", Scope: model.PluginPermissionScopeTeam, DefaultRoles: []string{model.TeamAdminRoleId}},
+			{Id: "view_widgets", Name: "View widgets", Scope: model.PluginPermissionScopeChannel, DefaultRoles: []string{model.ChannelUserRoleId}},
+		},
+	}
+
+	roleHasPermission := func(t *testing.T, roleName, permission string) bool {
+		t.Helper()
+		role, appErr := th.App.GetRoleByName(context.Background(), roleName)
+		require.Nil(t, appErr)
+		return slices.Contains(role.Permissions, permission)
+	}
+
+	scheme := th.SetupTeamScheme()
+
+	t.Run("grants new permissions to their default roles", func(t *testing.T) {
+		require.Nil(t, th.App.Channels().syncPluginPermissions(manifest))
+
+		assert.True(t, roleHasPermission(t, model.SystemAdminRoleId, manageWidgets))
+		assert.True(t, roleHasPermission(t, model.SystemAdminRoleId, viewWidgets))
+		assert.True(t, roleHasPermission(t, model.TeamAdminRoleId, manageWidgets))
+		assert.False(t, roleHasPermission(t, model.TeamUserRoleId, manageWidgets))
+		assert.True(t, roleHasPermission(t, model.ChannelUserRoleId, viewWidgets))
+		assert.True(t, roleHasPermission(t, scheme.DefaultTeamAdminRole, manageWidgets))
+		assert.True(t, roleHasPermission(t, scheme.DefaultChannelUserRole, viewWidgets))
+
+		assert.ElementsMatch(t, []string{manageWidgets, viewWidgets}, th.App.Config().PluginSettings.RegisteredPermissions[pluginID])
+	})
+
+	t.Run("grants permissions only once", func(t *testing.T) {
+		th.RemovePermissionFromRole(manageWidgets, model.TeamAdminRoleId)
+
+		require.Nil(t, th.App.Channels().syncPluginPermissions(manifest))
+		assert.False(t, roleHasPermission(t, model.TeamAdminRoleId, manageWidgets))
+	})
+
+	t.Run("removes permissions no longer declared", func(t *testing.T) {
+		upgraded := &model.Manifest{Id: pluginID, Permissions: manifest.Permissions[:1]}
+		require.Nil(t, th.App.Channels().syncPluginPermissions(upgraded))
+
+		assert.False(t, roleHasPermission(t, model.ChannelUserRoleId, viewWidgets))
+		assert.False(t, roleHasPermission(t, scheme.DefaultChannelUserRole, viewWidgets))
+		assert.True(t, roleHasPermission(t, model.SystemAdminRoleId, manageWidgets))
+		assert.Equal(t, []string{manageWidgets}, th.App.Config().PluginSettings.RegisteredPermissions[pluginID])
+	})
+
+	t.Run("removing the plugin strips its permissions", func(t *testing.T) {
+		require.Nil(t, th.App.Channels().removePluginPermissions(pluginID))
+
+		assert.False(t, roleHasPermission(t, model.SystemAdminRoleId, manageWidgets))
+		assert.False(t, roleHasPermission(t, scheme.DefaultTeamAdminRole, manageWidgets))
+		assert.NotContains(t, th.App.Config().PluginSettings.RegisteredPermissions, pluginID)
+	})
+
+	t.Run("plugin permissions are checked like core permissions", func(t *testing.T) {
+		require.Nil(t, th.App.Channels().syncPluginPermissions(manifest))
+
+		permission := &model.Permission{Id: manageWidgets}
+		assert.True(t, th.App.HasPermissionTo(th.SystemAdminUser.Id, permission))
+		assert.False(t, th.App.HasPermissionTo(th.BasicUser.Id, permission))
+
+		th.AddPermissionToRole(manageWidgets, model.TeamUserRoleId)
+		assert.True(t, th.App.HasPermissionToTeam(th.Context, th.BasicUser.Id, th.BasicTeam.Id, permission))
+	})
+
+	t.Run("plugins activated together don't overwrite each other's grants", func(t *testing.T) {
+		var wg sync.WaitGroup
+		var permissions []string
+		for i := range 5 {
+			otherPluginID := fmt.Sprintf("com.mattermost.other%d", i)
+			permissions = append(permissions, model.PluginPermissionId(otherPluginID, "use"))
+			otherManifest := &model.Manifest{
+				Id: otherPluginID,
+				Permissions: []*model.PluginPermission{
+					{Id: "use", Name: "Use", Scope: model.PluginPermissionScopeSystem, DefaultRoles: []string{model.SystemUserRoleId}},
+				},
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Nil(t, th.App.Channels().syncPluginPermissions(otherManifest))
+			}()
+		}
+		wg.Wait()
+
+		for _, permission := range permissions {
+			assert.True(t, roleHasPermission(t, model.SystemUserRoleId, permission), permission)
+		}
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
 )
 
@@ -146,6 +147,7 @@ func (ch *Channels) servePluginRequest(w http.ResponseWriter, r *http.Request, h
 	r.Header.Del("Mattermost-Plugin-ID")
 
 	r.Header.Del("Mattermost-User-Id")
+	ipFilterSession := &model.Session{}
 	if token != "" {
 		session, err := New(ServerConnector(ch)).GetSession(token)
 		defer ch.srv.platform.ReturnSessionToPool(session)
@@ -203,11 +205,24 @@ func (ch *Channels) servePluginRequest(w http.ResponseWriter, r *http.Request, h
 		if (session != nil && session.Id != "") && err == nil && csrfCheckPassed {
 			r.Header.Set("Mattermost-User-Id", session.UserId)
 			context.SessionId = session.Id
+			ipFilterSession = session
 
 			r.Header.Del(model.HeaderAuth)
 		}
 	}
 
+	ipAddress := utils.GetTrustedIPAddress(r, ch.cfgSvc.Config().ServiceSettings.TrustedProxyIPHeader)
+	rctx := request.EmptyContext(ch.srv.Log()).
+		WithPath(r.URL.Path).
+		WithUserAgent(r.UserAgent()).
+		WithXForwardedFor(r.Header.Get("X-Forwarded-For"))
+	if appErr := New(ServerConnector(ch)).CheckIPFilter(rctx, ipAddress, ipFilterSession); appErr != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(appErr.StatusCode)
+		w.Write([]byte(appErr.ToJSON()))
+		return
+	}
+
 	cookies := r.Cookies()
 	r.Header.Del("Cookie")
 	for _, c := range cookies {
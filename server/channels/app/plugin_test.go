@@ -137,6 +137,84 @@ func TestPluginKeyValueStore(t *testing.T) {
 	assert.Equal(t, []string{"key", "key3", "key4", hashedKey2}, list)
 }
 
+func TestPluginKeyValueStoreMulti(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	pluginID := model.NewId()
+
+	defer func() {
+		assert.Nil(t, th.App.DeleteAllKeysForPlugin(pluginID))
+	}()
+
+	applied, err := th.App.BatchPluginKeys(pluginID, []*model.PluginKVBatchOperation{
+		{Key: "user_1", Value: []byte("1")},
+		{Key: "user_2", Value: []byte("2")},
+		{Key: "user_3", Value: []byte("3")},
+		{Key: "team_1", Value: []byte("4")},
+	})
+	require.Nil(t, err)
+	require.True(t, applied)
+
+	// Keys written prior to v5.6 were hashed.
+	_, nErr := th.App.Srv().Store().Plugin().SaveOrUpdate(&model.PluginKeyValue{
+		PluginId: pluginID,
+		Key:      getHashedKey("legacy"),
+		Value:    []byte("5"),
+	})
+	require.NoError(t, nErr)
+
+	t.Run("get multi", func(t *testing.T) {
+		values, err := th.App.GetPluginKeys(pluginID, []string{"user_1", "team_1", "legacy", "missing"})
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]byte{
+			"user_1": []byte("1"),
+			"team_1": []byte("4"),
+			"legacy": []byte("5"),
+		}, values)
+	})
+
+	t.Run("list with prefix and cursor", func(t *testing.T) {
+		page, err := th.App.ListPluginKeysWithOptions(pluginID, model.PluginKVListOptions{Prefix: "user_", PerPage: 2})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"user_1", "user_2"}, page.Keys)
+		assert.Equal(t, "user_2", page.NextCursor)
+
+		page, err = th.App.ListPluginKeysWithOptions(pluginID, model.PluginKVListOptions{Prefix: "user_", PerPage: 2, Cursor: page.NextCursor})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"user_3"}, page.Keys)
+		assert.Empty(t, page.NextCursor)
+
+		_, err = th.App.ListPluginKeysWithOptions(pluginID, model.PluginKVListOptions{PerPage: model.PluginKVListMaxPerPage + 1})
+		require.NotNil(t, err)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		metadata, err := th.App.GetPluginKeyMetadata(pluginID, "user_1")
+		require.Nil(t, err)
+		require.NotNil(t, metadata)
+		assert.Equal(t, "user_1", metadata.Key)
+		assert.NotZero(t, metadata.CreateAt)
+
+		metadata, err = th.App.GetPluginKeyMetadata(pluginID, "missing")
+		require.Nil(t, err)
+		assert.Nil(t, metadata)
+	})
+
+	t.Run("failed batch writes nothing", func(t *testing.T) {
+		applied, err := th.App.BatchPluginKeys(pluginID, []*model.PluginKVBatchOperation{
+			{Key: "user_1", Value: []byte("new")},
+			{Key: "user_2", Value: []byte("new"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("other")}},
+		})
+		require.Nil(t, err)
+		assert.False(t, applied)
+
+		value, err := th.App.GetPluginKey(pluginID, "user_1")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("1"), value)
+	})
+}
+
 func TestPluginKeyValueStoreCompareAndSet(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -0,0 +1,461 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/utils"
+)
+
+const (
+	// pluginWatchPollInterval is how often watched plugin files are checked for changes. Build
+	// tools often delete and recreate their output directories, so polling is more reliable
+	// than filesystem notifications here. A change is only deployed once the files have been
+	// stable for a full interval, to avoid picking up a half-written bundle.
+	pluginWatchPollInterval = time.Second
+
+	// pluginWatchLogBufferSize bounds the log entries queued for a slow subscriber. Further
+	// entries are dropped rather than blocking the plugin.
+	pluginWatchLogBufferSize = 256
+)
+
+// pluginWatchOptionalDirs are copied along with the plugin if present, since plugins commonly
+// read files from them at runtime.
+var pluginWatchOptionalDirs = []string{"assets", "public"}
+
+type pluginWatchStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// pluginWatcher redeploys a plugin from a local directory whenever it changes, and fans out the
+// plugin's log entries to any subscribed developer tools.
+type pluginWatcher struct {
+	pluginID string
+	dir      string
+	stop     chan struct{}
+	done     chan struct{}
+
+	subscribersLock sync.Mutex
+	subscribers     map[chan *model.PluginWatchLogEntry]struct{}
+	closed          bool
+}
+
+func newPluginWatcher(pluginID, dir string) *pluginWatcher {
+	return &pluginWatcher{
+		pluginID:    pluginID,
+		dir:         dir,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+		subscribers: make(map[chan *model.PluginWatchLogEntry]struct{}),
+	}
+}
+
+func (w *pluginWatcher) log(level, message string, fields map[string]string) {
+	entry := &model.PluginWatchLogEntry{
+		Timestamp: model.GetMillis(),
+		Level:     level,
+		Message:   message,
+		Fields:    fields,
+	}
+
+	w.subscribersLock.Lock()
+	defer w.subscribersLock.Unlock()
+	for subscriber := range w.subscribers {
+		select {
+		case subscriber <- entry:
+		default:
+		}
+	}
+}
+
+func (w *pluginWatcher) subscribe() (<-chan *model.PluginWatchLogEntry, func()) {
+	subscriber := make(chan *model.PluginWatchLogEntry, pluginWatchLogBufferSize)
+
+	w.subscribersLock.Lock()
+	defer w.subscribersLock.Unlock()
+	if w.closed {
+		close(subscriber)
+		return subscriber, func() {}
+	}
+	w.subscribers[subscriber] = struct{}{}
+
+	return subscriber, func() {
+		w.subscribersLock.Lock()
+		defer w.subscribersLock.Unlock()
+		if _, ok := w.subscribers[subscriber]; ok {
+			delete(w.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+}
+
+func (w *pluginWatcher) closeSubscribers() {
+	w.subscribersLock.Lock()
+	defer w.subscribersLock.Unlock()
+	for subscriber := range w.subscribers {
+		close(subscriber)
+	}
+	w.subscribers = nil
+	w.closed = true
+}
+
+// pluginWatchAllowed refuses to watch plugins unless the server is set up for development. A
+// watched plugin bypasses signature verification and the file store, so this must never be
+// possible on a production server.
+func pluginWatchAllowed(cfg *model.Config) *model.AppError {
+	if !*cfg.PluginSettings.Enable {
+		return model.NewAppError("pluginWatchAllowed", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if !*cfg.ServiceSettings.EnableLocalMode {
+		return model.NewAppError("pluginWatchAllowed", "app.plugin.watch.local_mode_required.app_error", nil, "", http.StatusForbidden)
+	}
+
+	if !*cfg.ServiceSettings.EnableDeveloper {
+		return model.NewAppError("pluginWatchAllowed", "app.plugin.watch.developer_mode_required.app_error", nil, "", http.StatusForbidden)
+	}
+
+	if *cfg.ClusterSettings.Enable {
+		return model.NewAppError("pluginWatchAllowed", "app.plugin.watch.cluster.app_error", nil, "", http.StatusForbidden)
+	}
+
+	if *cfg.PluginSettings.RequirePluginSignature {
+		return model.NewAppError("pluginWatchAllowed", "app.plugin.watch.signature_required.app_error", nil, "", http.StatusForbidden)
+	}
+
+	return nil
+}
+
+// WatchPlugin deploys the plugin found in the given local directory and keeps redeploying it
+// whenever its manifest, server executables or webapp bundle change.
+func (a *App) WatchPlugin(dir string) (*model.Manifest, *model.AppError) {
+	return a.ch.watchPlugin(dir)
+}
+
+// UnwatchPlugin stops watching the given plugin. The plugin remains installed.
+func (a *App) UnwatchPlugin(pluginID string) *model.AppError {
+	return a.ch.unwatchPlugin(pluginID)
+}
+
+// PluginWatchLogSubscription receives the log entries of a watched plugin. Logs is closed once
+// the subscription is closed or the plugin is no longer watched.
+type PluginWatchLogSubscription struct {
+	Logs  <-chan *model.PluginWatchLogEntry
+	Close func()
+}
+
+// SubscribePluginWatchLogs streams the log entries of a watched plugin.
+func (a *App) SubscribePluginWatchLogs(pluginID string) (*PluginWatchLogSubscription, *model.AppError) {
+	watcher := a.ch.getPluginWatcher(pluginID)
+	if watcher == nil {
+		return nil, model.NewAppError("SubscribePluginWatchLogs", "app.plugin.watch.not_watched.app_error", nil, "", http.StatusNotFound)
+	}
+
+	logs, unsubscribe := watcher.subscribe()
+	return &PluginWatchLogSubscription{Logs: logs, Close: unsubscribe}, nil
+}
+
+func (ch *Channels) watchPlugin(dir string) (*model.Manifest, *model.AppError) {
+	if appErr := pluginWatchAllowed(ch.cfgSvc.Config()); appErr != nil {
+		return nil, appErr
+	}
+
+	if ch.GetPluginsEnvironment() == nil {
+		return nil, model.NewAppError("watchPlugin", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	dir = filepath.Clean(dir)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, model.NewAppError("watchPlugin", "app.plugin.watch.directory.app_error", map[string]any{"Path": dir}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	manifest, _, appErr := readWatchedPlugin(dir)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// Watching the same plugin again, possibly from another directory, replaces the watcher.
+	if appErr := ch.unwatchPlugin(manifest.Id); appErr != nil && appErr.StatusCode != http.StatusNotFound {
+		return nil, appErr
+	}
+
+	watcher := newPluginWatcher(manifest.Id, dir)
+	manifest, files, appErr := ch.deployWatchedPlugin(watcher)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	ch.pluginWatchersLock.Lock()
+	if ch.pluginWatchers == nil {
+		ch.pluginWatchers = make(map[string]*pluginWatcher)
+	}
+	ch.pluginWatchers[manifest.Id] = watcher
+	ch.pluginWatchersLock.Unlock()
+
+	ch.srv.Log().Info("Watching plugin for changes", mlog.String("plugin_id", manifest.Id), mlog.String("path", dir))
+	go ch.runPluginWatcher(watcher, files)
+
+	return manifest, nil
+}
+
+func (ch *Channels) unwatchPlugin(pluginID string) *model.AppError {
+	ch.pluginWatchersLock.Lock()
+	watcher, ok := ch.pluginWatchers[pluginID]
+	delete(ch.pluginWatchers, pluginID)
+	ch.pluginWatchersLock.Unlock()
+
+	if !ok {
+		return model.NewAppError("unwatchPlugin", "app.plugin.watch.not_watched.app_error", nil, "", http.StatusNotFound)
+	}
+
+	close(watcher.stop)
+	<-watcher.done
+
+	ch.srv.Log().Info("Stopped watching plugin for changes", mlog.String("plugin_id", pluginID))
+	return nil
+}
+
+// stopPluginWatchers stops watching every plugin, e.g. when plugins are shut down.
+func (ch *Channels) stopPluginWatchers() {
+	ch.pluginWatchersLock.Lock()
+	watchers := ch.pluginWatchers
+	ch.pluginWatchers = nil
+	ch.pluginWatchersLock.Unlock()
+
+	for _, watcher := range watchers {
+		close(watcher.stop)
+		<-watcher.done
+	}
+}
+
+func (ch *Channels) getPluginWatcher(pluginID string) *pluginWatcher {
+	ch.pluginWatchersLock.Lock()
+	defer ch.pluginWatchersLock.Unlock()
+	return ch.pluginWatchers[pluginID]
+}
+
+// publishPluginWatchLog forwards a log entry emitted by the plugin, or by the server while
+// supervising it, to the developer if the plugin is being watched.
+func (ch *Channels) publishPluginWatchLog(pluginID, level, message string, keyValuePairs ...any) {
+	watcher := ch.getPluginWatcher(pluginID)
+	if watcher == nil {
+		return
+	}
+
+	var fields map[string]string
+	if len(keyValuePairs) > 0 {
+		fields = make(map[string]string, len(keyValuePairs)/2)
+		for i := 0; i+1 < len(keyValuePairs); i += 2 {
+			fields[fmt.Sprint(keyValuePairs[i])] = fmt.Sprint(keyValuePairs[i+1])
+		}
+	}
+
+	watcher.log(level, message, fields)
+}
+
+func (ch *Channels) runPluginWatcher(watcher *pluginWatcher, files []string) {
+	defer close(watcher.done)
+	defer watcher.closeSubscribers()
+
+	logger := ch.srv.Log().With(mlog.String("plugin_id", watcher.pluginID), mlog.String("path", watcher.dir))
+
+	ticker := time.NewTicker(pluginWatchPollInterval)
+	defer ticker.Stop()
+
+	last := snapshotWatchedPlugin(watcher.dir, files)
+	pending := false
+	for {
+		select {
+		case <-watcher.stop:
+			return
+		case <-ticker.C:
+		}
+
+		if appErr := pluginWatchAllowed(ch.cfgSvc.Config()); appErr != nil {
+			logger.Warn("Stopped watching plugin for changes", mlog.Err(appErr))
+			watcher.log(model.PluginWatchLogLevelError, "Stopped watching plugin: "+appErr.Error(), nil)
+
+			ch.pluginWatchersLock.Lock()
+			if ch.pluginWatchers[watcher.pluginID] == watcher {
+				delete(ch.pluginWatchers, watcher.pluginID)
+			}
+			ch.pluginWatchersLock.Unlock()
+			return
+		}
+
+		// The manifest may reference different files after a change, but keep watching the
+		// previous ones while it can't be parsed.
+		if _, current, appErr := readWatchedPlugin(watcher.dir); appErr == nil {
+			files = current
+		}
+
+		snapshot := snapshotWatchedPlugin(watcher.dir, files)
+		if !maps.Equal(snapshot, last) {
+			last = snapshot
+			pending = true
+			continue
+		}
+
+		if !pending {
+			continue
+		}
+		pending = false
+
+		if _, current, appErr := ch.deployWatchedPlugin(watcher); appErr != nil {
+			logger.Warn("Failed to redeploy watched plugin", mlog.Err(appErr))
+			watcher.log(model.PluginWatchLogLevelError, "Failed to redeploy plugin: "+appErr.Error(), nil)
+		} else {
+			files = current
+			last = snapshotWatchedPlugin(watcher.dir, files)
+		}
+	}
+}
+
+// deployWatchedPlugin validates the plugin in the watched directory, copies its files into the
+// plugin directory and restarts it.
+func (ch *Channels) deployWatchedPlugin(watcher *pluginWatcher) (*model.Manifest, []string, *model.AppError) {
+	manifest, files, appErr := readWatchedPlugin(watcher.dir)
+	if appErr != nil {
+		return nil, nil, appErr
+	}
+
+	if manifest.Id != watcher.pluginID {
+		return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.watch.id_changed.app_error", map[string]any{"Id": watcher.pluginID}, "", http.StatusBadRequest)
+	}
+
+	pluginsEnvironment := ch.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	pluginsEnvironment.Deactivate(manifest.Id)
+	pluginsEnvironment.RemovePlugin(manifest.Id)
+
+	// The copy isn't flagged as managed by the file store, so syncing plugins leaves it alone.
+	bundlePath := filepath.Join(*ch.cfgSvc.Config().PluginSettings.Directory, manifest.Id)
+	if err := os.RemoveAll(bundlePath); err != nil {
+		return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.filesystem.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, file := range files {
+		src := filepath.Join(watcher.dir, file)
+		dst := filepath.Join(bundlePath, file)
+
+		info, err := os.Stat(src)
+		if err != nil {
+			return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.watch.missing_file.app_error", map[string]any{"Path": file}, "", http.StatusBadRequest).Wrap(err)
+		}
+
+		if info.IsDir() {
+			if err = os.MkdirAll(filepath.Dir(dst), 0700); err == nil {
+				err = utils.CopyDir(src, dst)
+			}
+		} else {
+			err = utils.CopyFile(src, dst)
+		}
+		if err != nil {
+			return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.filesystem.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if manifest.HasWebapp() {
+		updatedManifest, err := pluginsEnvironment.UnpackWebappBundle(manifest.Id)
+		if err != nil {
+			return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.webapp_bundle.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		manifest = updatedManifest
+	}
+
+	pluginState := ch.cfgSvc.Config().PluginSettings.PluginStates[manifest.Id]
+	if pluginState == nil || !pluginState.Enable {
+		// Enabling the plugin activates it through the config listener.
+		if appErr := ch.enablePlugin(manifest.Id); appErr != nil {
+			return nil, nil, appErr
+		}
+	} else {
+		if err := pluginsEnvironment.RestartPlugin(manifest.Id); err != nil {
+			return nil, nil, model.NewAppError("deployWatchedPlugin", "app.plugin.restart.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if err := ch.notifyPluginEnabled(manifest); err != nil {
+			ch.srv.Log().Warn("Failed to notify clients of redeployed plugin", mlog.String("plugin_id", manifest.Id), mlog.Err(err))
+		}
+	}
+
+	ch.srv.Log().Info("Deployed watched plugin", mlog.String("plugin_id", manifest.Id), mlog.String("version", manifest.Version))
+	watcher.log(model.PluginWatchLogLevelInfo, "Plugin deployed", map[string]string{"version": manifest.Version})
+
+	return manifest, files, nil
+}
+
+// readWatchedPlugin reads and validates the manifest in the given directory, returning the paths,
+// relative to the directory, of the files that make up the plugin.
+func readWatchedPlugin(dir string) (*model.Manifest, []string, *model.AppError) {
+	manifest, manifestPath, err := model.FindManifest(dir)
+	if err != nil {
+		return nil, nil, model.NewAppError("readWatchedPlugin", "app.plugin.manifest.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if err = manifest.IsValid(); err != nil {
+		return nil, nil, model.NewAppError("readWatchedPlugin", "app.plugin.manifest.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	files := []string{filepath.Base(manifestPath)}
+	if manifest.Server != nil {
+		if manifest.Server.Executable != "" {
+			files = append(files, manifest.Server.Executable)
+		}
+		for _, executable := range manifest.Server.Executables {
+			files = append(files, executable)
+		}
+	}
+	if manifest.HasWebapp() {
+		files = append(files, manifest.Webapp.BundlePath)
+	}
+
+	for i, file := range files {
+		file = filepath.Clean(file)
+		if !filepath.IsLocal(file) {
+			return nil, nil, model.NewAppError("readWatchedPlugin", "app.plugin.watch.invalid_path.app_error", map[string]any{"Path": file}, "", http.StatusBadRequest)
+		}
+		files[i] = file
+	}
+
+	for _, optionalDir := range pluginWatchOptionalDirs {
+		if info, err := os.Stat(filepath.Join(dir, optionalDir)); err == nil && info.IsDir() {
+			files = append(files, optionalDir)
+		}
+	}
+
+	return manifest, files, nil
+}
+
+// snapshotWatchedPlugin records the size and modification time of the given files, and of every
+// file within the given directories.
+func snapshotWatchedPlugin(dir string, files []string) map[string]pluginWatchStamp {
+	snapshot := make(map[string]pluginWatchStamp, len(files))
+	for _, file := range files {
+		_ = filepath.WalkDir(filepath.Join(dir, file), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			if info, err := d.Info(); err == nil {
+				snapshot[path] = pluginWatchStamp{size: info.Size(), modTime: info.ModTime()}
+			}
+			return nil
+		})
+	}
+	return snapshot
+}
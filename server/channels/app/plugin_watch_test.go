@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestPluginWatchAllowed(t *testing.T) {
+	newConfig := func() *model.Config {
+		cfg := &model.Config{}
+		cfg.SetDefaults()
+		cfg.PluginSettings.Enable = model.NewPointer(true)
+		cfg.PluginSettings.RequirePluginSignature = model.NewPointer(false)
+		cfg.ServiceSettings.EnableLocalMode = model.NewPointer(true)
+		cfg.ServiceSettings.EnableDeveloper = model.NewPointer(true)
+		return cfg
+	}
+
+	require.Nil(t, pluginWatchAllowed(newConfig()))
+
+	for name, tc := range map[string]struct {
+		update  func(cfg *model.Config)
+		errorID string
+	}{
+		"plugins disabled": {
+			update:  func(cfg *model.Config) { cfg.PluginSettings.Enable = model.NewPointer(false) },
+			errorID: "app.plugin.disabled.app_error",
+		},
+		"local mode disabled": {
+			update:  func(cfg *model.Config) { cfg.ServiceSettings.EnableLocalMode = model.NewPointer(false) },
+			errorID: "app.plugin.watch.local_mode_required.app_error",
+		},
+		"developer mode disabled": {
+			update:  func(cfg *model.Config) { cfg.ServiceSettings.EnableDeveloper = model.NewPointer(false) },
+			errorID: "app.plugin.watch.developer_mode_required.app_error",
+		},
+		"cluster enabled": {
+			update:  func(cfg *model.Config) { cfg.ClusterSettings.Enable = model.NewPointer(true) },
+			errorID: "app.plugin.watch.cluster.app_error",
+		},
+		"signatures required": {
+			update:  func(cfg *model.Config) { cfg.PluginSettings.RequirePluginSignature = model.NewPointer(true) },
+			errorID: "app.plugin.watch.signature_required.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := newConfig()
+			tc.update(cfg)
+
+			appErr := pluginWatchAllowed(cfg)
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.errorID, appErr.Id)
+		})
+	}
+}
+
+func TestReadWatchedPlugin(t *testing.T) {
+	writeFile := func(t *testing.T, path, contents string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	}
+
+	t.Run("lists the files the manifest references", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "plugin.json"), `{
+			"id": "com.mattermost.demo",
+			"name": "Demo",
+			"version": "1.0.0",
+			"server": {"executables": {"linux-amd64": "server/dist/plugin-linux-amd64"}},
+			"webapp": {"bundle_path": "webapp/dist/main.js"}
+		}`)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "assets"), 0700))
+
+		manifest, files, appErr := readWatchedPlugin(dir)
+		require.Nil(t, appErr)
+		assert.Equal(t, "com.mattermost.demo", manifest.Id)
+		assert.ElementsMatch(t, []string{
+			"plugin.json",
+			filepath.Join("server", "dist", "plugin-linux-amd64"),
+			filepath.Join("webapp", "dist", "main.js"),
+			"assets",
+		}, files)
+	})
+
+	t.Run("rejects files outside the plugin directory", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "plugin.json"), `{
+			"id": "com.mattermost.demo",
+			"name": "Demo",
+			"version": "1.0.0",
+			"webapp": {"bundle_path": "../main.js"}
+		}`)
+
+		_, _, appErr := readWatchedPlugin(dir)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.plugin.watch.invalid_path.app_error", appErr.Id)
+	})
+
+	t.Run("rejects an invalid manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "plugin.json"), `{"id": "x"}`)
+
+		_, _, appErr := readWatchedPlugin(dir)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+}
+
+func TestSnapshotWatchedPlugin(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "assets"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plugin.json"), []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "assets", "icon.svg"), []byte("<svg/>"), 0600))
+
+	files := []string{"plugin.json", "assets", "missing"}
+	snapshot := snapshotWatchedPlugin(dir, files)
+	assert.Len(t, snapshot, 2)
+	assert.Equal(t, snapshot, snapshotWatchedPlugin(dir, files))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "assets", "icon.svg"), []byte("<svg></svg>"), 0600))
+	assert.NotEqual(t, snapshot, snapshotWatchedPlugin(dir, files))
+}
+
+func TestPluginWatcherSubscribers(t *testing.T) {
+	watcher := newPluginWatcher("com.mattermost.demo", t.TempDir())
+
+	logs, unsubscribe := watcher.subscribe()
+	otherLogs, _ := watcher.subscribe()
+
+	watcher.log(model.PluginWatchLogLevelInfo, "hello", map[string]string{"key": "value"})
+	entry := <-logs
+	assert.Equal(t, "hello", entry.Message)
+	assert.Equal(t, model.PluginWatchLogLevelInfo, entry.Level)
+	assert.Equal(t, "value", entry.Fields["key"])
+	assert.Equal(t, "hello", (<-otherLogs).Message)
+
+	unsubscribe()
+	_, ok := <-logs
+	assert.False(t, ok)
+	unsubscribe()
+
+	watcher.closeSubscribers()
+	_, ok = <-otherLogs
+	assert.False(t, ok)
+
+	lateLogs, _ := watcher.subscribe()
+	_, ok = <-lateLogs
+	assert.False(t, ok)
+}
@@ -526,6 +526,14 @@ func (a *App) handlePostEvents(c request.CTX, post *model.Post, user *model.User
 		})
 	}
 
+	a.Srv().Go(func() {
+		a.sendSavedSearchAlerts(c, post, channel, user)
+	})
+
+	a.Srv().Go(func() {
+		a.autoTranslatePost(c, post)
+	})
+
 	if triggerWebhooks {
 		a.Srv().Go(func() {
 			if err := a.handleWebhookEvents(c, post, team, channel, user); err != nil {
@@ -731,8 +739,29 @@ func (a *App) UpdatePost(c request.CTX, receivedUpdatedPost *model.Post, safeUpd
 			hooks.MessageHasBeenUpdated(pluginContext, pluginNewPost, pluginOldPost)
 			return true
 		}, plugin.MessageHasBeenUpdatedID)
+
+		if pluginNewPost.IsPinned != pluginOldPost.IsPinned {
+			if pluginNewPost.IsPinned {
+				a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+					hooks.PostHasBeenPinned(pluginContext, pluginNewPost)
+					return true
+				}, plugin.PostHasBeenPinnedID)
+			} else {
+				a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+					hooks.PostHasBeenUnpinned(pluginContext, pluginNewPost)
+					return true
+				}, plugin.PostHasBeenUnpinnedID)
+			}
+		}
 	})
 
+	if newPost.Message != oldPost.Message {
+		translatedPost := rpost.Clone()
+		a.Srv().Go(func() {
+			a.autoTranslatePost(c, translatedPost)
+		})
+	}
+
 	rpost = a.PreparePostForClientWithEmbedsAndImages(c, rpost, false, true, true)
 
 	// Ensure IsFollowing is nil since this updated post will be broadcast to all users
@@ -1637,6 +1666,17 @@ func (a *App) SearchPostsInTeam(teamID string, paramsList []*model.SearchParams)
 }
 
 func (a *App) SearchPostsForUser(c request.CTX, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int) (*model.PostSearchResults, *model.AppError) {
+	return a.SearchPostsForUserWithOptions(c, terms, userID, teamID, isOrSearch, includeDeletedChannels, timeZoneOffset, page, perPage, model.PostSearchOptions{})
+}
+
+// SearchPostsForUserWithOptions searches the posts like SearchPostsForUser, sorting them and
+// including snippets and facets in the results as requested by the options. Search backends
+// that can't sort by relevance sort by time, and those that can't count facets return none.
+func (a *App) SearchPostsForUserWithOptions(c request.CTX, terms string, userID string, teamID string, isOrSearch bool, includeDeletedChannels bool, timeZoneOffset int, page, perPage int, options model.PostSearchOptions) (*model.PostSearchResults, *model.AppError) {
+	if appErr := options.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
 	var postSearchResults *model.PostSearchResults
 	paramsList := model.ParseSearchParams(strings.TrimSpace(terms), timeZoneOffset)
 	includeDeleted := includeDeletedChannels && *a.Config().TeamSettings.ExperimentalViewArchivedChannels
@@ -1650,6 +1690,8 @@ func (a *App) SearchPostsForUser(c request.CTX, terms string, userID string, tea
 	for _, params := range paramsList {
 		params.OrTerms = isOrSearch
 		params.IncludeDeletedChannels = includeDeleted
+		params.SortBy = options.SortBy
+		params.IncludeFacets = options.IncludeFacets
 		// Don't allow users to search for "*"
 		if params.Terms != "*" {
 			// TODO: we have to send channel ids
@@ -1683,10 +1725,26 @@ func (a *App) SearchPostsForUser(c request.CTX, terms string, userID string, tea
 		}
 	}
 
-	if appErr := a.filterInaccessiblePosts(postSearchResults.PostList, filterPostOptions{assumeSortedCreatedAt: true}); appErr != nil {
+	if appErr := a.filterInaccessiblePosts(postSearchResults.PostList, filterPostOptions{assumeSortedCreatedAt: options.SortBy != model.SearchSortByRelevance}); appErr != nil {
 		return nil, appErr
 	}
 
+	if options.IncludeSnippets {
+		postSearchResults.Snippets = make(map[string]*model.PostSearchSnippet, len(postSearchResults.Order))
+		for _, postID := range postSearchResults.Order {
+			post, ok := postSearchResults.Posts[postID]
+			if !ok {
+				continue
+			}
+			// The database search doesn't return which words matched.
+			matches := postSearchResults.Matches[postID]
+			if len(matches) == 0 {
+				matches = model.FindSearchTermMatches(post.Message, finalParamsList)
+			}
+			postSearchResults.Snippets[postID] = model.MakePostSearchSnippet(post.Message, matches)
+		}
+	}
+
 	return postSearchResults, nil
 }
 
@@ -2100,14 +2158,27 @@ func (a *App) GetEditHistoryForPost(postID string) ([]*model.Post, *model.AppErr
 	return posts, nil
 }
 
-func (a *App) SetPostReminder(rctx request.CTX, postID, userID string, targetTime int64) *model.AppError {
+// SetPostReminder reminds the user of the post at the target time of the reminder, which is
+// resolved in the timezone of the user when it's set relative to now.
+func (a *App) SetPostReminder(rctx request.CTX, postID, userID string, reminder *model.PostReminder) *model.AppError {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr := reminder.ResolveTargetTime(time.Now(), user.GetTimezoneLocation()); appErr != nil {
+		return appErr
+	}
+	if appErr := reminder.IsValid(); appErr != nil {
+		return appErr
+	}
+	targetTime := reminder.TargetTime
+
 	// Store the reminder in the DB
-	reminder := &model.PostReminder{
+	err := a.Srv().Store().Post().SetPostReminder(&model.PostReminder{
 		PostId:     postID,
 		UserId:     userID,
 		TargetTime: targetTime,
-	}
-	err := a.Srv().Store().Post().SetPostReminder(reminder)
+	})
 	if err != nil {
 		return model.NewAppError("SetPostReminder", model.NoTranslation, nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2161,6 +2232,38 @@ func (a *App) SetPostReminder(rctx request.CTX, postID, userID string, targetTim
 	return nil
 }
 
+// GetPostRemindersForUser returns the pending reminders of the user for posts of the channels the
+// user is still a member of.
+func (a *App) GetPostRemindersForUser(rctx request.CTX, userID string) ([]*model.PostReminder, *model.AppError) {
+	reminders, err := a.Srv().Store().Post().GetPostRemindersForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetPostRemindersForUser", "app.post_reminder.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return reminders, nil
+}
+
+// DeletePostReminder cancels the reminder of the post for the user.
+func (a *App) DeletePostReminder(postID, userID string) *model.AppError {
+	if err := a.Srv().Store().Post().DeletePostReminder(postID, userID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeletePostReminder", "app.post_reminder.delete.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeletePostReminder", "app.post_reminder.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) deletePostReminders(c request.CTX, postID string) {
+	if err := a.Srv().Store().Post().DeletePostRemindersForPost(postID); err != nil {
+		c.Logger().Warn("Failed to delete the reminders of the post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+}
+
 func (a *App) CheckPostReminders(rctx request.CTX) {
 	rctx = rctx.WithLogger(rctx.Logger().With(mlog.String("component", "post_reminders")))
 	systemBot, appErr := a.GetSystemBot(rctx)
@@ -2205,6 +2308,11 @@ func (a *App) CheckPostReminders(rctx request.CTX) {
 				continue
 			}
 
+			// The user may have lost access to the post since setting the reminder.
+			if !a.HasPermissionToChannel(rctx, userID, metadata.ChannelId, model.PermissionReadChannelContent) {
+				continue
+			}
+
 			T := i18n.GetUserTranslations(metadata.UserLocale)
 			dm := &model.Post{
 				ChannelId: ch.Id,
@@ -2666,6 +2774,14 @@ func (a *App) CleanUpAfterPostDeletion(c request.CTX, post *model.Post, deleteBy
 		a.deleteFlaggedPosts(c, post.Id)
 	})
 
+	a.Srv().Go(func() {
+		a.deletePostTranslations(c, post.Id)
+	})
+
+	a.Srv().Go(func() {
+		a.deletePostReminders(c, post.Id)
+	})
+
 	pluginPost := post.ForPlugin()
 	pluginContext := pluginContext(c)
 	a.Srv().Go(func() {
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
@@ -58,6 +59,15 @@ func (a *App) SaveAcknowledgementForPost(c request.CTX, postID, userID string) (
 
 	a.sendAcknowledgementEvent(c, model.WebsocketEventAcknowledgementAdded, acknowledgement, post)
 
+	pluginAcknowledgement := *acknowledgement
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.PostHasBeenAcknowledged(pluginContext, &pluginAcknowledgement)
+			return true
+		}, plugin.PostHasBeenAcknowledgedID)
+	})
+
 	return acknowledgement, nil
 }
 
@@ -0,0 +1,184 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+// sharesReadReceipts returns whether the user opted in to share when they have seen posts.
+func (a *App) sharesReadReceipts(userID string) bool {
+	preference, err := a.Srv().Store().Preference().Get(userID, model.PreferenceCategoryDisplaySettings, model.PreferenceNameShareReadReceipts)
+	return err == nil && preference.Value == "true"
+}
+
+// usersSharingReadReceipts returns which of the given users opted in to share when they have seen posts.
+func (a *App) usersSharingReadReceipts(userIDs []string) (map[string]bool, *model.AppError) {
+	preferences, err := a.Srv().Store().Preference().GetForUsers(userIDs, model.PreferenceCategoryDisplaySettings, model.PreferenceNameShareReadReceipts)
+	if err != nil {
+		return nil, model.NewAppError("usersSharingReadReceipts", "app.preference.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	sharing := make(map[string]bool, len(preferences))
+	for _, preference := range preferences {
+		if preference.Value == "true" {
+			sharing[preference.UserId] = true
+		}
+	}
+	return sharing, nil
+}
+
+// SanitizeChannelMembersReadReceipts hides when the members last viewed their channels from
+// anyone but themselves if read receipts are enabled, unless they share their read receipts, as
+// the time would otherwise tell which posts they have seen.
+func (a *App) SanitizeChannelMembersReadReceipts(members model.ChannelMembers, requesterID string) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableReadReceipts {
+		return nil
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.UserId != requesterID {
+			userIDs = append(userIDs, member.UserId)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	sharing, appErr := a.usersSharingReadReceipts(userIDs)
+	if appErr != nil {
+		return appErr
+	}
+	for i := range members {
+		if members[i].UserId != requesterID && !sharing[members[i].UserId] {
+			members[i].LastViewedAt = 0
+		}
+	}
+	return nil
+}
+
+// channelHasReadReceipts returns whether read receipts are shared in the channel, which is the
+// case of direct and group messages, and of channels with up to the configured number of members.
+func (a *App) channelHasReadReceipts(c request.CTX, channel *model.Channel) (bool, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableReadReceipts {
+		return false, nil
+	}
+	if channel.IsGroupOrDirect() {
+		return true, nil
+	}
+
+	maxMembers := *a.Config().ServiceSettings.ReadReceiptsMaxChannelMembers
+	if maxMembers == 0 {
+		return false, nil
+	}
+	count, appErr := a.GetChannelMemberCount(c, channel.Id)
+	if appErr != nil {
+		return false, appErr
+	}
+
+	return count <= int64(maxMembers), nil
+}
+
+// GetPostReadReceipts returns the members of the channel of the post who have seen it, leaving
+// out its author and the members who don't share their read receipts.
+func (a *App) GetPostReadReceipts(c request.CTX, post *model.Post) (*model.PostReadReceipts, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableReadReceipts {
+		return nil, model.NewAppError("GetPostReadReceipts", "app.read_receipt.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	channel, appErr := a.GetChannel(c, post.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	hasReadReceipts, appErr := a.channelHasReadReceipts(c, channel)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if !hasReadReceipts {
+		return nil, model.NewAppError("GetPostReadReceipts", "app.read_receipt.channel_too_large.app_error", map[string]any{"Max": *a.Config().ServiceSettings.ReadReceiptsMaxChannelMembers}, "", http.StatusBadRequest)
+	}
+
+	members, appErr := a.GetChannelMembersPage(c, channel.Id, 0, max(*a.Config().ServiceSettings.ReadReceiptsMaxChannelMembers, model.ChannelGroupMaxUsers))
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var seenBy model.ChannelMembers
+	userIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.UserId == post.UserId || member.LastViewedAt < post.CreateAt {
+			continue
+		}
+		seenBy = append(seenBy, member)
+		userIDs = append(userIDs, member.UserId)
+	}
+	sharing, appErr := a.usersSharingReadReceipts(userIDs)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	receipts := &model.PostReadReceipts{PostId: post.Id, SeenBy: []*model.ReadReceipt{}}
+	for _, member := range seenBy {
+		if !sharing[member.UserId] {
+			continue
+		}
+		receipts.SeenBy = append(receipts.SeenBy, &model.ReadReceipt{
+			UserId:       member.UserId,
+			ChannelId:    channel.Id,
+			LastViewedAt: member.LastViewedAt,
+		})
+	}
+
+	// The members who saw the post first come first.
+	sort.Slice(receipts.SeenBy, func(i, j int) bool {
+		if receipts.SeenBy[i].LastViewedAt != receipts.SeenBy[j].LastViewedAt {
+			return receipts.SeenBy[i].LastViewedAt < receipts.SeenBy[j].LastViewedAt
+		}
+		return receipts.SeenBy[i].UserId < receipts.SeenBy[j].UserId
+	})
+
+	return receipts, nil
+}
+
+// publishReadReceipts tells the members of the channels the user viewed, up to the given times,
+// that the user has seen their posts, if the user shares read receipts. Callers check that read
+// receipts are enabled first, so that viewing channels costs nothing more when they are not.
+func (a *App) publishReadReceipts(c request.CTX, userID string, lastViewedAtTimes map[string]int64) {
+	if !a.sharesReadReceipts(userID) {
+		return
+	}
+
+	for channelID, lastViewedAt := range lastViewedAtTimes {
+		channel, appErr := a.GetChannel(c, channelID)
+		if appErr != nil {
+			c.Logger().Warn("Failed to get the channel to publish a read receipt", mlog.String("channel_id", channelID), mlog.Err(appErr))
+			continue
+		}
+		hasReadReceipts, appErr := a.channelHasReadReceipts(c, channel)
+		if appErr != nil {
+			c.Logger().Warn("Failed to check whether the channel has read receipts", mlog.String("channel_id", channelID), mlog.Err(appErr))
+			continue
+		}
+		if !hasReadReceipts {
+			continue
+		}
+
+		receiptJSON, err := json.Marshal(&model.ReadReceipt{UserId: userID, ChannelId: channelID, LastViewedAt: lastViewedAt})
+		if err != nil {
+			c.Logger().Warn("Failed to encode the read receipt", mlog.String("channel_id", channelID), mlog.Err(err))
+			continue
+		}
+
+		message := model.NewWebSocketEvent(model.WebsocketEventReadReceipt, "", channelID, "", nil, "")
+		message.Add("read_receipt", string(receiptJSON))
+		a.Publish(message)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	savedSearchAlertsCacheSize = 10000
+	// savedSearchAlertsCacheTTL bounds how long changes to channel membership take to affect
+	// which saved searches are evaluated against the posts of a channel.
+	savedSearchAlertsCacheTTL = time.Minute
+	// savedSearchAlertInterval is the minimum interval between alerts of a saved search, so
+	// that a busy channel doesn't flood its user with direct messages.
+	savedSearchAlertInterval = 5 * time.Minute
+)
+
+func (a *App) CreateSavedSearch(c request.CTX, search *model.SavedSearch) (*model.SavedSearch, *model.AppError) {
+	count, err := a.Srv().Store().SavedSearch().CountForUser(search.UserId)
+	if err != nil {
+		return nil, model.NewAppError("CreateSavedSearch", "app.saved_search.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if count >= model.SavedSearchMaxPerUser {
+		return nil, model.NewAppError("CreateSavedSearch", "app.saved_search.limit_reached.app_error", map[string]any{"Max": model.SavedSearchMaxPerUser}, "", http.StatusBadRequest)
+	}
+
+	search.Id = ""
+	search.CreateAt = 0
+
+	saved, err := a.Srv().Store().SavedSearch().Save(search)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateSavedSearch", "app.saved_search.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	a.invalidateSavedSearchAlertsCache()
+
+	return saved, nil
+}
+
+func (a *App) GetSavedSearch(id string) (*model.SavedSearch, *model.AppError) {
+	search, err := a.Srv().Store().SavedSearch().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetSavedSearch", "app.saved_search.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetSavedSearch", "app.saved_search.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return search, nil
+}
+
+func (a *App) GetSavedSearchesForUser(userID string) ([]*model.SavedSearch, *model.AppError) {
+	searches, err := a.Srv().Store().SavedSearch().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetSavedSearchesForUser", "app.saved_search.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return searches, nil
+}
+
+func (a *App) PatchSavedSearch(search *model.SavedSearch, patch *model.SavedSearchPatch) (*model.SavedSearch, *model.AppError) {
+	search.Patch(patch)
+
+	updated, err := a.Srv().Store().SavedSearch().Update(search)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PatchSavedSearch", "app.saved_search.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("PatchSavedSearch", "app.saved_search.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	a.invalidateSavedSearchAlertsCache()
+
+	return updated, nil
+}
+
+func (a *App) DeleteSavedSearch(id string) *model.AppError {
+	if err := a.Srv().Store().SavedSearch().Delete(id); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteSavedSearch", "app.saved_search.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteSavedSearch", "app.saved_search.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	a.invalidateSavedSearchAlertsCache()
+
+	return nil
+}
+
+// RunSavedSearch searches the posts matching the given saved search, as its user.
+func (a *App) RunSavedSearch(c request.CTX, search *model.SavedSearch, page, perPage int) (*model.PostSearchResults, *model.AppError) {
+	return a.SearchPostsForUser(c, search.Terms, search.UserId, search.TeamId, search.IsOrSearch, false, search.TimeZoneOffset, page, perPage)
+}
+
+// sendSavedSearchAlerts sends a direct message from the system bot to the
+// members of the channel whose searches with alerts match the new post.
+// Searches are evaluated in memory against the post, and only for the users
+// still able to read the channel. A search alerts at most once per
+// savedSearchAlertInterval.
+func (a *App) sendSavedSearchAlerts(c request.CTX, post *model.Post, channel *model.Channel, poster *model.User) {
+	if !*a.Config().ServiceSettings.EnableSavedSearchAlerts {
+		return
+	}
+
+	// Don't evaluate system messages, nor the alerts themselves.
+	if post.Type != model.PostTypeDefault || poster.Username == model.BotSystemBotUsername {
+		return
+	}
+
+	searches, err := a.getSavedSearchAlertsForChannel(channel)
+	if err != nil {
+		c.Logger().Error("Failed to get saved searches with alerts", mlog.String("channel_id", channel.Id), mlog.Err(err))
+		return
+	}
+
+	var userIDs []string
+	matchedNames := map[string][]string{}
+	for _, search := range searches {
+		if search.UserId == post.UserId || !search.MatchesPost(post, channel, poster) {
+			continue
+		}
+		var alerted bool
+		if a.Srv().savedSearchAlertsSentCache.Get(search.Id, &alerted) == nil {
+			continue
+		}
+		if err := a.Srv().savedSearchAlertsSentCache.SetWithDefaultExpiry(search.Id, true); err != nil {
+			c.Logger().Warn("Failed to record saved search alert", mlog.String("saved_search_id", search.Id), mlog.Err(err))
+		}
+		if _, ok := matchedNames[search.UserId]; !ok {
+			userIDs = append(userIDs, search.UserId)
+		}
+		matchedNames[search.UserId] = append(matchedNames[search.UserId], search.Name)
+	}
+
+	if len(userIDs) == 0 {
+		return
+	}
+
+	systemBot, appErr := a.GetSystemBot(c)
+	if appErr != nil {
+		c.Logger().Error("Failed to get the system bot", mlog.Err(appErr))
+		return
+	}
+
+	link := a.GetSiteURL() + "/_redirect/pl/" + post.Id
+	for _, userID := range userIDs {
+		// Membership was checked when fetching the searches, but the user may
+		// have lost access to the channel since, e.g. by being deactivated or
+		// losing a permission.
+		if !a.HasPermissionToChannel(c, userID, channel.Id, model.PermissionReadChannelContent) {
+			continue
+		}
+
+		user, appErr := a.GetUser(userID)
+		if appErr != nil {
+			c.Logger().Warn("Failed to get user for saved search alert", mlog.String("user_id", userID), mlog.Err(appErr))
+			continue
+		}
+
+		dmChannel, appErr := a.GetOrCreateDirectChannel(c, userID, systemBot.UserId)
+		if appErr != nil {
+			c.Logger().Error("Failed to get the direct channel with the system bot", mlog.String("user_id", userID), mlog.Err(appErr))
+			continue
+		}
+
+		T := i18n.GetUserTranslations(user.Locale)
+		alert := &model.Post{
+			ChannelId: dmChannel.Id,
+			UserId:    systemBot.UserId,
+			Type:      model.PostTypeDefault,
+			Message: T("app.saved_search.alert.message", map[string]any{
+				"Names": strings.Join(matchedNames[userID], ", "),
+				"Link":  link,
+			}),
+		}
+
+		if _, appErr := a.CreatePost(c, alert, dmChannel, false, false); appErr != nil {
+			c.Logger().Error("Failed to post saved search alert", mlog.String("user_id", userID), mlog.Err(appErr))
+		}
+	}
+}
+
+// deleteSavedSearches removes the searches saved by the user.
+func (a *App) deleteSavedSearches(userID string) *model.AppError {
+	if err := a.Srv().Store().SavedSearch().PermanentDeleteByUser(userID); err != nil {
+		return model.NewAppError("deleteSavedSearches", "app.saved_search.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	a.invalidateSavedSearchAlertsCache()
+
+	return nil
+}
+
+// invalidateSavedSearchAlertsCache clears the cached saved searches with alerts. Searches aren't
+// tied to a single channel, so the whole cache is cleared when any of them changes, on every node
+// of the cluster.
+func (a *App) invalidateSavedSearchAlertsCache() {
+	if err := a.Srv().savedSearchAlertsCache.Purge(); err != nil {
+		a.Log().Warn("Failed to purge the saved search alerts cache", mlog.Err(err))
+	}
+
+	if a.Cluster() != nil && *a.Config().CacheSettings.CacheType == model.CacheTypeLRU {
+		a.Cluster().SendClusterMessage(&model.ClusterMessage{
+			Event:    model.ClusterEventInvalidateCacheForSavedSearchAlerts,
+			SendType: model.ClusterSendBestEffort,
+		})
+	}
+}
+
+// getSavedSearchAlertsForChannel returns the saved searches with alerts of the members of the
+// channel, from the cache when possible.
+func (a *App) getSavedSearchAlertsForChannel(channel *model.Channel) ([]*model.SavedSearch, error) {
+	var searches []*model.SavedSearch
+	if err := a.Srv().savedSearchAlertsCache.Get(channel.Id, &searches); err == nil {
+		return searches, nil
+	}
+
+	searches, err := a.Srv().Store().SavedSearch().GetAlertsForChannel(channel.Id, channel.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.Srv().savedSearchAlertsCache.SetWithDefaultExpiry(channel.Id, searches); err != nil {
+		a.Log().Warn("Failed to cache saved search alerts", mlog.String("channel_id", channel.Id), mlog.Err(err))
+	}
+
+	return searches, nil
+}
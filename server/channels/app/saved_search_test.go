@@ -0,0 +1,113 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCreateSavedSearchLimit(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	for i := 0; i < model.SavedSearchMaxPerUser; i++ {
+		_, appErr := th.App.CreateSavedSearch(th.Context, &model.SavedSearch{UserId: th.BasicUser.Id, Name: model.NewId(), Terms: "term"})
+		require.Nil(t, appErr)
+	}
+
+	_, appErr := th.App.CreateSavedSearch(th.Context, &model.SavedSearch{UserId: th.BasicUser.Id, Name: model.NewId(), Terms: "term"})
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	assert.Equal(t, "app.saved_search.limit_reached.app_error", appErr.Id)
+
+	_, appErr = th.App.CreateSavedSearch(th.Context, &model.SavedSearch{UserId: th.BasicUser2.Id, Name: model.NewId(), Terms: "term"})
+	require.Nil(t, appErr, "the limit applies per user")
+}
+
+func TestSendSavedSearchAlerts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	_, appErr := th.App.CreateSavedSearch(th.Context, &model.SavedSearch{
+		UserId: th.BasicUser2.Id,
+		Name:   "Outages",
+		Terms:  "outage",
+		Alert:  true,
+	})
+	require.Nil(t, appErr)
+
+	systemBot, appErr := th.App.GetSystemBot(th.Context)
+	require.Nil(t, appErr)
+	dmChannel, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.BasicUser2.Id, systemBot.UserId)
+	require.Nil(t, appErr)
+
+	alertCount := func() int {
+		posts, appErr := th.App.GetPosts(dmChannel.Id, 0, 100)
+		require.Nil(t, appErr)
+		return len(posts.Order)
+	}
+
+	newPost := func(message string) *model.Post {
+		return &model.Post{
+			Id:        model.NewId(),
+			ChannelId: th.BasicChannel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   message,
+			CreateAt:  model.GetMillis(),
+		}
+	}
+
+	t.Run("no match", func(t *testing.T) {
+		th.App.sendSavedSearchAlerts(th.Context, newPost("all good"), th.BasicChannel, th.BasicUser)
+		assert.Equal(t, 0, alertCount())
+	})
+
+	t.Run("match", func(t *testing.T) {
+		post := newPost("there is an outage")
+		th.App.sendSavedSearchAlerts(th.Context, post, th.BasicChannel, th.BasicUser)
+		require.Equal(t, 1, alertCount())
+
+		posts, appErr := th.App.GetPosts(dmChannel.Id, 0, 1)
+		require.Nil(t, appErr)
+		alert := posts.Posts[posts.Order[0]]
+		assert.Contains(t, alert.Message, "Outages")
+		assert.Contains(t, alert.Message, post.Id)
+	})
+
+	t.Run("repeated matches alert once per interval", func(t *testing.T) {
+		th.App.sendSavedSearchAlerts(th.Context, newPost("another outage"), th.BasicChannel, th.BasicUser)
+		assert.Equal(t, 1, alertCount())
+	})
+
+	require.NoError(t, th.App.Srv().savedSearchAlertsSentCache.Purge())
+
+	t.Run("own posts", func(t *testing.T) {
+		post := newPost("there is an outage")
+		post.UserId = th.BasicUser2.Id
+		th.App.sendSavedSearchAlerts(th.Context, post, th.BasicChannel, th.BasicUser2)
+		assert.Equal(t, 1, alertCount())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableSavedSearchAlerts = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableSavedSearchAlerts = true })
+
+		th.App.sendSavedSearchAlerts(th.Context, newPost("there is an outage"), th.BasicChannel, th.BasicUser)
+		assert.Equal(t, 1, alertCount())
+	})
+
+	t.Run("left channel", func(t *testing.T) {
+		appErr := th.App.LeaveChannel(th.Context, th.BasicChannel.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+
+		th.App.sendSavedSearchAlerts(th.Context, newPost("there is an outage"), th.BasicChannel, th.BasicUser)
+		assert.Equal(t, 1, alertCount())
+	})
+}
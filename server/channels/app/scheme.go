@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
 
@@ -104,6 +105,11 @@ func (a *App) CreateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError
 			return nil, model.NewAppError("CreateScheme", "app.scheme.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 		}
 	}
+
+	if appErr := a.ch.grantPluginPermissionsToScheme(scheme); appErr != nil {
+		a.Log().Warn("Failed to grant plugin permissions to scheme", mlog.String("scheme_id", scheme.Id), mlog.Err(appErr))
+	}
+
 	return scheme, nil
 }
 
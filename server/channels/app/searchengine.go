@@ -9,6 +9,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine"
 )
 
 func (a *App) TestElasticsearch(rctx request.CTX, cfg *model.Config) *model.AppError {
@@ -65,6 +66,15 @@ func (a *App) PurgeBleveIndexes(c request.CTX) *model.AppError {
 	return nil
 }
 
+// RollbackBleveIndexes restores the Bleve indexes replaced by the last reindex.
+func (a *App) RollbackBleveIndexes(c request.CTX) *model.AppError {
+	engine, ok := a.SearchEngine().BleveEngine.(*bleveengine.BleveEngine)
+	if !ok {
+		return model.NewAppError("RollbackBleveIndexes", "searchengine.bleve.disabled.error", nil, "", http.StatusNotImplemented)
+	}
+	return engine.RollbackReindex(c)
+}
+
 func (a *App) ActiveSearchBackend() string {
 	return a.ch.srv.platform.SearchEngine.ActiveEngine()
 }
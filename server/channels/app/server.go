@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -49,6 +50,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_process"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_users_to_csv"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/extract_content"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/guestexpiry"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/hosted_purchase_screening"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_delete"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_process"
@@ -63,6 +65,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/refresh_post_stats"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/resend_invitation_email"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/s3_path_migration"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/user_data_export"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
 	"github.com/mattermost/mattermost/server/v8/config"
@@ -72,6 +75,8 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/remotecluster"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine/indexer"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine"
+	opensearchindexer "github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine/indexer"
 	"github.com/mattermost/mattermost/server/v8/platform/services/sharedchannel"
 	"github.com/mattermost/mattermost/server/v8/platform/services/telemetry"
 	"github.com/mattermost/mattermost/server/v8/platform/services/tracing"
@@ -126,6 +131,16 @@ type Server struct {
 	clusterLeaderListenerId string
 	loggerLicenseListenerId string
 
+	// savedSearchAlertsCache holds the saved searches with alerts of each channel, and
+	// savedSearchAlertsSentCache the saved searches that alerted recently.
+	savedSearchAlertsCache     cache.Cache
+	savedSearchAlertsSentCache cache.Cache
+
+	// ipFilterBlockedCache holds, per address and session, when a blocked attempt was last
+	// audited and how many were blocked since without being audited.
+	ipFilterBlockedCache cache.Cache
+	ipFilterBlockedMut   sync.Mutex
+
 	platform         *platform.PlatformService
 	platformOptions  []platform.Option
 	telemetryService *telemetry.TelemetryService
@@ -139,6 +154,8 @@ type Server struct {
 	phase2PermissionsMigrationComplete bool
 
 	Audit *audit.Audit
+	// auditEvents queues the audit records to save to the database, see onAuditRecord.
+	auditEvents *auditEventWriter
 
 	joinCluster  bool
 	skipPostInit bool
@@ -147,6 +164,8 @@ type Server struct {
 	IPFiltering             einterfaces.IPFilteringInterface
 	OutgoingOAuthConnection einterfaces.OutgoingOAuthConnectionInterface
 
+	ipFilter atomic.Pointer[ipFilter]
+
 	tracer *tracing.Tracer
 
 	ch *Channels
@@ -317,6 +336,28 @@ func NewServer(options ...Option) (*Server, error) {
 	}); err != nil {
 		return nil, errors.Wrap(err, "Unable to create opengraphdata cache")
 	}
+	if s.savedSearchAlertsCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
+		Name:                   "saved_search_alerts",
+		Size:                   savedSearchAlertsCacheSize,
+		DefaultExpiry:          savedSearchAlertsCacheTTL,
+		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForSavedSearchAlerts,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create saved search alerts cache")
+	}
+	if s.savedSearchAlertsSentCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
+		Name:          "saved_search_alerts_sent",
+		Size:          savedSearchAlertsCacheSize,
+		DefaultExpiry: savedSearchAlertInterval,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create saved search alerts sent cache")
+	}
+	if s.ipFilterBlockedCache, err = s.platform.CacheProvider().NewCache(&cache.CacheOptions{
+		Name:          "ip_filter_blocked",
+		Size:          ipFilterBlockedCacheSize,
+		DefaultExpiry: ipFilterBlockedCacheTTL,
+	}); err != nil {
+		return nil, errors.Wrap(err, "Unable to create IP filter blocked attempts cache")
+	}
 
 	s.createPushNotificationsHub(request.EmptyContext(s.Log()))
 
@@ -495,6 +536,7 @@ func NewServer(options ...Option) (*Server, error) {
 	})
 
 	app.initElasticsearchChannelIndexCheck()
+	app.initBlevePostIndexCheck()
 
 	return s, nil
 }
@@ -707,6 +749,9 @@ func (s *Server) Shutdown() {
 	s.platform.StopSearchEngine()
 
 	s.Audit.Shutdown()
+	if s.auditEvents != nil {
+		s.auditEvents.stop()
+	}
 
 	s.platform.StopFeatureFlagUpdateJob()
 
@@ -1484,6 +1529,8 @@ func (s *Server) initJobs() {
 	if jobsElasticsearchIndexerInterface != nil {
 		builder := jobsElasticsearchIndexerInterface(s)
 		s.Jobs.RegisterJobType(model.JobTypeElasticsearchPostIndexing, builder.MakeWorker(), nil)
+	} else if engine, ok := s.platform.SearchEngine.ElasticsearchEngine.(*opensearchengine.OpenSearchEngine); ok {
+		s.Jobs.RegisterJobType(model.JobTypeElasticsearchPostIndexing, opensearchindexer.MakeWorker(s.Jobs, engine), nil)
 	}
 
 	if jobsLdapSyncInterface != nil {
@@ -1515,6 +1562,12 @@ func (s *Server) initJobs() {
 		expirynotify.MakeScheduler(s.Jobs),
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeGuestExpiry,
+		guestexpiry.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())).ProcessGuestAccountExpiry),
+		guestexpiry.MakeScheduler(s.Jobs),
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeProductNotices,
 		product_notices.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
@@ -1560,6 +1613,12 @@ func (s *Server) initJobs() {
 		nil,
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeUserDataExport,
+		user_data_export.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeActiveUsers,
 		active_users.MakeWorker(s.Jobs, s.Store(), func() einterfaces.MetricsInterface { return s.GetMetrics() }),
@@ -155,6 +155,74 @@ func (a *App) GetSessions(c request.CTX, userID string) ([]*model.Session, *mode
 	return sessions, nil
 }
 
+// GetSessionDevices returns the device inventory for the user, built from their
+// sessions. Sessions backing personal access tokens are not devices and are left out.
+func (a *App) GetSessionDevices(c request.CTX, userID string) ([]*model.SessionDevice, *model.AppError) {
+	sessions, appErr := a.GetSessions(c, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var currentSessionID string
+	if c.Session() != nil {
+		currentSessionID = c.Session().Id
+	}
+
+	devices := make([]*model.SessionDevice, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsUserAccessToken() || session.IsExpired() {
+			continue
+		}
+
+		device := model.NewSessionDevice(session)
+		device.IsCurrent = session.Id == currentSessionID
+		devices = append(devices, device)
+	}
+
+	return devices, nil
+}
+
+// UpdateSessionDeviceName sets the user facing name of the device behind the session.
+func (a *App) UpdateSessionDeviceName(c request.CTX, session *model.Session, name string) *model.AppError {
+	if !model.IsValidSessionDeviceName(name) {
+		return model.NewAppError("UpdateSessionDeviceName", "app.session.update_device_name.invalid_name.app_error", map[string]any{"MaxLength": model.SessionDeviceNameMaxRunes}, "", http.StatusBadRequest)
+	}
+
+	if appErr := a.SetExtraSessionProps(session, map[string]string{model.SessionPropDeviceName: name}); appErr != nil {
+		return appErr
+	}
+
+	// Cached copies of the session would otherwise write the old name back
+	// the next time their props are updated.
+	a.ClearSessionCacheForUser(session.UserId)
+
+	return nil
+}
+
+// UpdateSessionIPAddressIfNeeded records the address the session was last used
+// from, if it changed since the last time it was recorded.
+func (a *App) UpdateSessionIPAddressIfNeeded(c request.CTX, session *model.Session) {
+	ipAddress := c.IPAddress()
+	if session == nil || session.Id == "" || session.Local || ipAddress == "" {
+		return
+	}
+
+	if session.Props[model.SessionPropLastIpAddress] == ipAddress {
+		return
+	}
+
+	// The session is owned by the current request, so update a copy of it.
+	sessionCopy := session.DeepCopy()
+	a.Srv().Go(func() {
+		if appErr := a.SetExtraSessionProps(sessionCopy, map[string]string{model.SessionPropLastIpAddress: ipAddress}); appErr != nil {
+			c.Logger().Warn("Failed to update the last IP address of the session", mlog.String("session_id", sessionCopy.Id), mlog.Err(appErr))
+			return
+		}
+
+		a.AddSessionToCache(sessionCopy)
+	})
+}
+
 // limitNumberOfSessions revokes userId's least recently used sessions to keep the number below
 // maxSessionsLimit; MM-55320
 func (a *App) limitNumberOfSessions(c request.CTX, userId string) *model.AppError {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 )
@@ -108,6 +109,9 @@ func (a *App) SetCustomStatus(c request.CTX, userID string, cs *model.CustomStat
 		c.Logger().Error("Can't add recent custom status for", mlog.String("userID", userID), mlog.Err(err))
 	}
 
+	pluginCustomStatus := *cs
+	a.notifyCustomStatusChanged(c, userID, &pluginCustomStatus)
+
 	return nil
 }
 
@@ -123,9 +127,23 @@ func (a *App) RemoveCustomStatus(c request.CTX, userID string) *model.AppError {
 		return updateErr
 	}
 
+	a.notifyCustomStatusChanged(c, userID, nil)
+
 	return nil
 }
 
+// notifyCustomStatusChanged invokes the UserCustomStatusHasChanged hook. cs is nil if the custom
+// status was cleared.
+func (a *App) notifyCustomStatusChanged(c request.CTX, userID string, cs *model.CustomStatus) {
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.UserCustomStatusHasChanged(pluginContext, userID, cs)
+			return true
+		}, plugin.UserCustomStatusHasChangedID)
+	})
+}
+
 func (a *App) GetCustomStatus(userID string) (*model.CustomStatus, *model.AppError) {
 	user, err := a.GetUser(userID)
 	if err != nil {
@@ -136,6 +136,15 @@ func (a *App) CreateTeam(c request.CTX, team *model.Team) (*model.Team, *model.A
 		}
 	}
 
+	createdTeam := rteam.ShallowCopy()
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.TeamHasBeenCreated(pluginContext, createdTeam)
+			return true
+		}, plugin.TeamHasBeenCreatedID)
+	})
+
 	return rteam, nil
 }
 
@@ -163,6 +172,8 @@ func (a *App) CreateTeamWithUser(c request.CTX, team *model.Team, userID string)
 }
 
 func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
+	previousTeam := a.getTeamBeforeUpdate(team.Id)
+
 	oldTeam, err := a.ch.srv.teamService.UpdateTeam(team, teams.UpdateOptions{Sanitized: true})
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -187,9 +198,42 @@ func (a *App) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
 		return nil, appErr
 	}
 
+	a.notifyTeamUpdated(oldTeam, previousTeam)
+
 	return oldTeam, nil
 }
 
+// getTeamBeforeUpdate returns the current state of the team so that plugins can be notified of
+// changes to it, or nil if no plugins are running.
+func (a *App) getTeamBeforeUpdate(teamID string) *model.Team {
+	if a.GetPluginsEnvironment() == nil {
+		return nil
+	}
+
+	team, err := a.Srv().Store().Team().Get(teamID)
+	if err != nil {
+		return nil
+	}
+
+	return team
+}
+
+// notifyTeamUpdated invokes the TeamHasBeenUpdated hook, unless the previous state of the team
+// is unknown.
+func (a *App) notifyTeamUpdated(newTeam, oldTeam *model.Team) {
+	if oldTeam == nil {
+		return
+	}
+
+	newTeam = newTeam.ShallowCopy()
+	a.Srv().Go(func() {
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.TeamHasBeenUpdated(&plugin.Context{}, newTeam, oldTeam)
+			return true
+		}, plugin.TeamHasBeenUpdatedID)
+	})
+}
+
 // RenameTeam is used to rename the team Name and the DisplayName fields
 func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError) {
 	// check if name is occupied
@@ -209,6 +253,8 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 		team.DisplayName = newDisplayName
 	}
 
+	previousTeam := a.getTeamBeforeUpdate(team.Id)
+
 	newTeam, err := a.ch.srv.teamService.UpdateTeam(team, teams.UpdateOptions{})
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -229,6 +275,8 @@ func (a *App) RenameTeam(team *model.Team, newTeamName string, newDisplayName st
 		}
 	}
 
+	a.notifyTeamUpdated(newTeam, previousTeam)
+
 	return newTeam, nil
 }
 
@@ -273,6 +321,7 @@ func (a *App) UpdateTeamPrivacy(teamID string, teamType string, allowOpenInvite
 	if err != nil {
 		return err
 	}
+	previousTeam := oldTeam.ShallowCopy()
 
 	// Force a regeneration of the invite token if changing a team to restricted.
 	if (allowOpenInvite != oldTeam.AllowOpenInvite || teamType != oldTeam.Type) && (!allowOpenInvite || teamType == model.TeamInvite) {
@@ -300,10 +349,14 @@ func (a *App) UpdateTeamPrivacy(teamID string, teamType string, allowOpenInvite
 		return appErr
 	}
 
+	a.notifyTeamUpdated(oldTeam, previousTeam)
+
 	return nil
 }
 
 func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *model.AppError) {
+	previousTeam := a.getTeamBeforeUpdate(teamID)
+
 	team, err := a.ch.srv.teamService.PatchTeam(teamID, patch)
 	if err != nil {
 		var invErr *store.ErrInvalidInput
@@ -328,6 +381,8 @@ func (a *App) PatchTeam(teamID string, patch *model.TeamPatch) (*model.Team, *mo
 		return nil, appErr
 	}
 
+	a.notifyTeamUpdated(team, previousTeam)
+
 	return team, nil
 }
 
@@ -407,6 +462,7 @@ func (a *App) UpdateTeamMemberRoles(c request.CTX, teamID string, userID string,
 	if member == nil {
 		return nil, model.NewAppError("UpdateTeamMemberRoles", "api.team.update_member_roles.not_a_member", nil, "userId="+userID+" teamId="+teamID, http.StatusBadRequest)
 	}
+	oldMember := *member
 
 	schemeGuestRole, schemeUserRole, schemeAdminRole, err := a.GetSchemeRolesForTeam(teamID)
 	if err != nil {
@@ -473,6 +529,8 @@ func (a *App) UpdateTeamMemberRoles(c request.CTX, teamID string, userID string,
 		return nil, appErr
 	}
 
+	a.notifyTeamMemberRolesChanged(c, member, &oldMember)
+
 	return member, nil
 }
 
@@ -481,6 +539,7 @@ func (a *App) UpdateTeamMemberSchemeRoles(c request.CTX, teamID string, userID s
 	if err != nil {
 		return nil, err
 	}
+	oldMember := *member
 
 	if member.SchemeGuest {
 		return nil, model.NewAppError("UpdateTeamMemberSchemeRoles", "api.team.update_team_member_roles.guest.app_error", nil, "", http.StatusBadRequest)
@@ -516,9 +575,29 @@ func (a *App) UpdateTeamMemberSchemeRoles(c request.CTX, teamID string, userID s
 		return nil, appErr
 	}
 
+	a.notifyTeamMemberRolesChanged(c, member, &oldMember)
+
 	return member, nil
 }
 
+// notifyTeamMemberRolesChanged invokes the TeamMemberRolesHaveChanged hook if the roles of the
+// team member differ from oldMember.
+func (a *App) notifyTeamMemberRolesChanged(c request.CTX, member, oldMember *model.TeamMember) {
+	if member.ExplicitRoles == oldMember.ExplicitRoles && member.SchemeGuest == oldMember.SchemeGuest &&
+		member.SchemeUser == oldMember.SchemeUser && member.SchemeAdmin == oldMember.SchemeAdmin {
+		return
+	}
+
+	newMember := *member
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.TeamMemberRolesHaveChanged(pluginContext, &newMember, oldMember)
+			return true
+		}, plugin.TeamMemberRolesHaveChangedID)
+	})
+}
+
 func (a *App) sendUpdatedTeamMemberEvent(member *model.TeamMember) *model.AppError {
 	message := model.NewWebSocketEvent(model.WebsocketEventMemberroleUpdated, "", "", member.UserId, nil, "")
 	tmJSON, jsonErr := json.Marshal(member)
@@ -1503,10 +1582,23 @@ func (a *App) prepareInviteGuestsToChannels(teamID string, guestsInvite *model.G
 			return nil, nil, nil, model.NewAppError("prepareInviteGuestsToChannels", "api.team.invite_guests.channel_in_invalid_team.app_error", nil, "", http.StatusBadRequest)
 		}
 	}
+
+	if guestsInvite.SponsorId == "" {
+		guestsInvite.SponsorId = senderId
+	}
+	if err := a.validateGuestSponsor(guestsInvite.SponsorId); err != nil {
+		return nil, nil, nil, err
+	}
 	return user, team, channels, nil
 }
 
 func (a *App) InviteGuestsToChannelsGracefully(rctx request.CTX, teamID string, guestsInvite *model.GuestsInvite, senderId string) ([]*model.EmailInviteWithError, *model.AppError) {
+	return a.inviteGuestsToChannelsGracefully(rctx, teamID, guestsInvite, senderId, a.guestInviteRequiresApproval(senderId))
+}
+
+// inviteGuestsToChannelsGracefully sends the guest invites, or queues them for
+// a system admin to approve if requireApproval is set.
+func (a *App) inviteGuestsToChannelsGracefully(rctx request.CTX, teamID string, guestsInvite *model.GuestsInvite, senderId string, requireApproval bool) ([]*model.EmailInviteWithError, *model.AppError) {
 	if !*a.Config().ServiceSettings.EnableEmailInvitations {
 		return nil, model.NewAppError("InviteGuestsToChannelsGracefully", "api.team.invite_members.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
@@ -1531,14 +1623,18 @@ func (a *App) InviteGuestsToChannelsGracefully(rctx request.CTX, teamID string,
 		inviteListWithErrors = append(inviteListWithErrors, invite)
 	}
 
-	if len(goodEmails) > 0 {
+	if len(goodEmails) > 0 && requireApproval {
+		if _, err := a.savePendingGuestInvite(team, user, guestsInvite, goodEmails); err != nil {
+			return nil, err
+		}
+	} else if len(goodEmails) > 0 {
 		nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
 		senderProfileImage, _, err := a.GetProfileImage(user)
 		if err != nil {
 			rctx.Logger().Warn("Unable to get the sender user profile image.", mlog.String("user_id", user.Id), mlog.String("team_id", team.Id), mlog.Err(err))
 		}
 
-		eErr := a.Srv().EmailService.SendGuestInviteEmails(team, channels, user.GetDisplayName(nameFormat), user.Id, senderProfileImage, goodEmails, a.GetSiteURL(), guestsInvite.Message, true, user.IsSystemAdmin(), a.UserIsFirstAdmin(rctx, user))
+		eErr := a.Srv().EmailService.SendGuestInviteEmails(team, channels, user.GetDisplayName(nameFormat), user.Id, guestsInvite.SponsorId, senderProfileImage, goodEmails, a.GetSiteURL(), guestsInvite.Message, true, user.IsSystemAdmin(), a.UserIsFirstAdmin(rctx, user))
 		if eErr != nil {
 			switch {
 			case errors.Is(eErr, email.SendMailError):
@@ -1631,13 +1727,18 @@ func (a *App) InviteGuestsToChannels(rctx request.CTX, teamID string, guestsInvi
 		return model.NewAppError("InviteGuestsToChannels", "api.team.invite_members.invalid_email.app_error", map[string]any{"Addresses": s}, "", http.StatusBadRequest)
 	}
 
+	if a.guestInviteRequiresApproval(senderId) {
+		_, err := a.savePendingGuestInvite(team, user, guestsInvite, guestsInvite.Emails)
+		return err
+	}
+
 	nameFormat := *a.Config().TeamSettings.TeammateNameDisplay
 	senderProfileImage, _, err := a.GetProfileImage(user)
 	if err != nil {
 		rctx.Logger().Warn("Unable to get the sender user profile image.", mlog.String("user_id", user.Id), mlog.String("team_id", team.Id), mlog.Err(err))
 	}
 
-	eErr := a.Srv().EmailService.SendGuestInviteEmails(team, channels, user.GetDisplayName(nameFormat), user.Id, senderProfileImage, guestsInvite.Emails, a.GetSiteURL(), guestsInvite.Message, false, user.IsSystemAdmin(), a.UserIsFirstAdmin(rctx, user))
+	eErr := a.Srv().EmailService.SendGuestInviteEmails(team, channels, user.GetDisplayName(nameFormat), user.Id, guestsInvite.SponsorId, senderProfileImage, guestsInvite.Emails, a.GetSiteURL(), guestsInvite.Message, false, user.IsSystemAdmin(), a.UserIsFirstAdmin(rctx, user))
 	if eErr != nil {
 		switch {
 		case errors.Is(eErr, email.NoRateLimiterError):
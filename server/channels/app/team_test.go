@@ -1572,6 +1572,7 @@ func TestInviteGuestsToChannelsGracefully(t *testing.T) {
 			mock.AnythingOfType("[]*model.Channel"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("string"),
+			th.BasicUser.Id,
 			mock.AnythingOfType("[]uint8"),
 			[]string{"idontexist@mattermost.com"},
 			"",
@@ -1599,6 +1600,7 @@ func TestInviteGuestsToChannelsGracefully(t *testing.T) {
 			mock.AnythingOfType("[]*model.Channel"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("string"),
+			th.BasicUser.Id,
 			mock.AnythingOfType("[]uint8"),
 			[]string{"idontexist@mattermost.com"},
 			"",
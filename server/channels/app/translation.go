@@ -0,0 +1,252 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/services/translation"
+)
+
+// pluginTranslationProvider translates texts through the TranslateText hook of a plugin.
+type pluginTranslationProvider struct {
+	hooks         plugin.Hooks
+	pluginContext *plugin.Context
+}
+
+func (p *pluginTranslationProvider) Name() string {
+	return model.TranslationProviderPlugin
+}
+
+func (p *pluginTranslationProvider) Translate(_ context.Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error) {
+	translations, err := p.hooks.TranslateText(p.pluginContext, texts, sourceLanguage, targetLocale)
+	if err != nil {
+		return nil, err
+	}
+	// A plugin not implementing the hook returns no translations.
+	if len(translations) != len(texts) {
+		return nil, errors.New("the plugin didn't return a translation per text")
+	}
+	return translations, nil
+}
+
+func (a *App) translationProvider(c request.CTX) (translation.Provider, *model.AppError) {
+	settings := a.Config().TranslationSettings
+	if !*settings.Enable {
+		return nil, model.NewAppError("translationProvider", "app.translation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	switch *settings.Provider {
+	case model.TranslationProviderLibreTranslate:
+		client := a.HTTPService().MakeClient(true)
+		client.Timeout = time.Duration(*settings.RequestTimeoutSeconds) * time.Second
+		return translation.NewLibreTranslateProvider(client, *settings.LibreTranslateURL, *settings.LibreTranslateAPIKey), nil
+	case model.TranslationProviderPlugin:
+		hooks, err := a.ch.HooksForPlugin(*settings.PluginId)
+		if err != nil {
+			return nil, model.NewAppError("translationProvider", "app.translation.plugin_unavailable.app_error", map[string]any{"PluginId": *settings.PluginId}, "", http.StatusServiceUnavailable).Wrap(err)
+		}
+		return &pluginTranslationProvider{hooks: hooks, pluginContext: pluginContext(c)}, nil
+	default:
+		return nil, model.NewAppError("translationProvider", "app.translation.disabled.app_error", nil, "provider="+*settings.Provider, http.StatusNotImplemented)
+	}
+}
+
+// TranslatePost translates the message of the post into the locale.
+func (a *App) TranslatePost(c request.CTX, post *model.Post, locale string) (*model.PostTranslation, *model.AppError) {
+	translations, appErr := a.translatePosts(c, []*model.Post{post}, locale)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if len(translations) == 0 {
+		return nil, model.NewAppError("TranslatePost", "app.translation.translate_post.no_message.app_error", nil, "post_id="+post.Id, http.StatusBadRequest)
+	}
+
+	return translations[0], nil
+}
+
+// TranslatePostThread translates the messages of the thread of the post into the locale, up to
+// model.TranslationThreadMaxPosts of its oldest posts.
+func (a *App) TranslatePostThread(c request.CTX, postID, locale string) ([]*model.PostTranslation, *model.AppError) {
+	list, appErr := a.GetPostThread(postID, model.GetPostsOptions{SkipFetchThreads: true}, c.Session().UserId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	posts := list.ToSlice()
+	slices.SortFunc(posts, func(p1, p2 *model.Post) int {
+		return cmp.Compare(p1.CreateAt, p2.CreateAt)
+	})
+	if len(posts) > model.TranslationThreadMaxPosts {
+		posts = posts[:model.TranslationThreadMaxPosts]
+	}
+
+	return a.translatePosts(c, posts, locale)
+}
+
+// translatePosts returns the translations of the messages of the posts into the locale, in the
+// order of the posts. Cached translations are used while the posts aren't edited, and the rest
+// are translated at once and cached. Posts without a message to translate are skipped.
+func (a *App) translatePosts(c request.CTX, posts []*model.Post, locale string) ([]*model.PostTranslation, *model.AppError) {
+	provider, appErr := a.translationProvider(c)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var postIDs []string
+	for _, post := range posts {
+		postIDs = append(postIDs, post.Id)
+	}
+
+	cached, err := a.Srv().Store().Translation().GetPostTranslations(postIDs, locale)
+	if err != nil {
+		return nil, model.NewAppError("translatePosts", "app.translation.get_post_translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	translations := make(map[string]*model.PostTranslation, len(cached))
+	for _, postTranslation := range cached {
+		translations[postTranslation.PostId] = postTranslation
+	}
+
+	var untranslated []*model.Post
+	var texts []string
+	for _, post := range posts {
+		if post.IsSystemMessage() || post.Message == "" {
+			continue
+		}
+		if postTranslation, ok := translations[post.Id]; ok && !postTranslation.IsStale(post) {
+			continue
+		}
+		untranslated = append(untranslated, post)
+		texts = append(texts, post.Message)
+	}
+
+	if len(untranslated) > 0 {
+		translated, err := provider.Translate(c.Context(), texts, "", locale)
+		if err != nil {
+			return nil, model.NewAppError("translatePosts", "app.translation.translate.app_error", nil, "", http.StatusBadGateway).Wrap(err)
+		}
+
+		for i, post := range untranslated {
+			postTranslation := &model.PostTranslation{
+				PostId:         post.Id,
+				Locale:         locale,
+				PostEditAt:     post.EditAt,
+				Message:        translated[i].Text,
+				SourceLanguage: translated[i].SourceLanguage,
+				Provider:       provider.Name(),
+			}
+			if saved, err := a.Srv().Store().Translation().SavePostTranslation(postTranslation); err != nil {
+				c.Logger().Warn("Failed to cache the translation of a post", mlog.String("post_id", post.Id), mlog.String("locale", locale), mlog.Err(err))
+				postTranslation.CreateAt = model.GetMillis()
+			} else {
+				postTranslation = saved
+			}
+			translations[post.Id] = postTranslation
+		}
+	}
+
+	result := []*model.PostTranslation{}
+	for _, post := range posts {
+		if postTranslation, ok := translations[post.Id]; ok && !post.IsSystemMessage() && post.Message != "" {
+			result = append(result, postTranslation)
+		}
+	}
+
+	return result, nil
+}
+
+func (a *App) GetChannelTranslationSettings(channelID string) (*model.ChannelTranslationSettings, *model.AppError) {
+	settings, err := a.Srv().Store().Translation().GetChannelSettings(channelID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return &model.ChannelTranslationSettings{ChannelId: channelID}, nil
+		default:
+			return nil, model.NewAppError("GetChannelTranslationSettings", "app.translation.get_channel_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return settings, nil
+}
+
+func (a *App) UpdateChannelTranslationSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, *model.AppError) {
+	saved, err := a.Srv().Store().Translation().SaveChannelSettings(settings)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("UpdateChannelTranslationSettings", "app.translation.save_channel_settings.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return saved, nil
+}
+
+// autoTranslatePost translates a new or edited post of a channel with auto-translation into the
+// locales of the members of the channel, and sends the translations to the channel.
+func (a *App) autoTranslatePost(c request.CTX, post *model.Post) {
+	if !*a.Config().TranslationSettings.Enable || post.IsSystemMessage() || post.Message == "" {
+		return
+	}
+
+	settings, appErr := a.GetChannelTranslationSettings(post.ChannelId)
+	if appErr != nil {
+		c.Logger().Warn("Failed to get the translation settings of the channel", mlog.String("channel_id", post.ChannelId), mlog.Err(appErr))
+		return
+	}
+	if !settings.AutoTranslate {
+		return
+	}
+
+	locales, err := a.Srv().Store().Translation().GetChannelMemberLocales(post.ChannelId)
+	if err != nil {
+		c.Logger().Warn("Failed to get the locales of the members of the channel", mlog.String("channel_id", post.ChannelId), mlog.Err(err))
+		return
+	}
+	if len(locales) > model.TranslationAutoMaxLocales {
+		c.Logger().Debug("Too many locales to translate the post into", mlog.String("post_id", post.Id), mlog.Int("locales", len(locales)))
+		locales = locales[:model.TranslationAutoMaxLocales]
+	}
+
+	for _, locale := range locales {
+		translations, appErr := a.translatePosts(c, []*model.Post{post}, locale)
+		if appErr != nil {
+			c.Logger().Warn("Failed to auto-translate the post", mlog.String("post_id", post.Id), mlog.String("locale", locale), mlog.Err(appErr))
+			continue
+		}
+
+		for _, postTranslation := range translations {
+			translationJSON, err := json.Marshal(postTranslation)
+			if err != nil {
+				c.Logger().Warn("Failed to encode the translation of the post", mlog.String("post_id", post.Id), mlog.Err(err))
+				continue
+			}
+
+			message := model.NewWebSocketEvent(model.WebsocketEventPostTranslated, "", post.ChannelId, "", nil, "")
+			message.Add("translation", string(translationJSON))
+			a.Publish(message)
+		}
+	}
+}
+
+// deletePostTranslations removes the cached translations of a deleted post.
+func (a *App) deletePostTranslations(c request.CTX, postID string) {
+	if err := a.Srv().Store().Translation().PermanentDeletePostTranslations(postID); err != nil {
+		c.Logger().Warn("Failed to delete the translations of the post", mlog.String("post_id", postID), mlog.Err(err))
+	}
+}
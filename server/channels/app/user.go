@@ -102,6 +102,22 @@ func (a *App) CreateUserWithToken(c request.CTX, user *model.User, token *model.
 		return nil, err
 	}
 
+	if token.Type == TokenTypeGuestInvitation {
+		// Invites sent before guests had sponsors are sponsored by their sender.
+		sponsorId := tokenData["sponsorId"]
+		if sponsorId == "" {
+			sponsorId = senderId
+		}
+		if err := a.createGuestAccount(ruser.Id, sponsorId); err != nil {
+			// A guest without an account would never expire, so the invite
+			// is left for the guest to try again.
+			if deleteErr := a.PermanentDeleteUser(c, ruser); deleteErr != nil {
+				c.Logger().Warn("Failed to delete the guest whose account couldn't be created", mlog.String("user_id", ruser.Id), mlog.Err(deleteErr))
+			}
+			return nil, err
+		}
+	}
+
 	if _, err := a.JoinUserToTeam(c, team, ruser, ""); err != nil {
 		return nil, err
 	}
@@ -1341,11 +1357,29 @@ func (a *App) UpdateUser(c request.CTX, user *model.User, sendNotifications bool
 	a.InvalidateCacheForUser(user.Id)
 	a.onUserProfileChange(user.Id)
 
+	a.notifyUserUpdated(c, newUser, userUpdate.Old)
+
 	newUser.Sanitize(map[string]bool{})
 
 	return newUser, nil
 }
 
+// notifyUserUpdated invokes the UserHasBeenUpdated hook with sanitized copies of the users.
+func (a *App) notifyUserUpdated(c request.CTX, newUser, oldUser *model.User) {
+	pluginNewUser := newUser.DeepCopy()
+	pluginNewUser.Sanitize(map[string]bool{})
+	pluginOldUser := oldUser.DeepCopy()
+	pluginOldUser.Sanitize(map[string]bool{})
+
+	a.Srv().Go(func() {
+		pluginContext := pluginContext(c)
+		a.ch.RunMultiHook(func(hooks plugin.Hooks) bool {
+			hooks.UserHasBeenUpdated(pluginContext, pluginNewUser, pluginOldUser)
+			return true
+		}, plugin.UserHasBeenUpdatedID)
+	})
+}
+
 func (a *App) UpdateUserActive(c request.CTX, userID string, active bool) *model.AppError {
 	user, err := a.GetUser(userID)
 
@@ -1756,6 +1790,8 @@ func (a *App) UpdateUserRolesWithUser(c request.CTX, user *model.User, newRoles
 		a.Publish(message)
 	}
 
+	a.notifyUserUpdated(c, ruser, result.Data.Old)
+
 	return ruser, nil
 }
 
@@ -1777,6 +1813,22 @@ func (a *App) PermanentDeleteUser(rctx request.CTX, user *model.User) *model.App
 		return model.NewAppError("PermanentDeleteUser", "app.user_access_token.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().LoginFingerprint().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.login_fingerprint.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.deleteGuestAccount(user.Id); err != nil {
+		return err
+	}
+
+	if err := a.deleteUserDataExports(user.Id); err != nil {
+		return err
+	}
+
+	if err := a.deleteSavedSearches(user.Id); err != nil {
+		return err
+	}
+
 	if err := a.Srv().Store().OAuth().PermanentDeleteAuthDataByUser(user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.oauth.permanent_delete_auth_data_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -2390,6 +2442,9 @@ func (a *App) PromoteGuestToUser(c request.CTX, user *model.User, requestorId st
 	if nErr != nil {
 		return model.NewAppError("PromoteGuestToUser", "app.user.promote_guest.user_update.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 	}
+	if err := a.deleteGuestAccount(user.Id); err != nil {
+		c.Logger().Warn("Failed to delete the guest account of a promoted guest", mlog.String("user_id", user.Id), mlog.Err(err))
+	}
 	userTeams, nErr := a.Srv().Store().Team().GetTeamsByUserId(user.Id)
 	if nErr != nil {
 		return model.NewAppError("PromoteGuestToUser", "app.team.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
@@ -2450,6 +2505,9 @@ func (a *App) DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
 	if nErr != nil {
 		return model.NewAppError("DemoteUserToGuest", "app.user.demote_user_to_guest.user_update.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
 	}
+	if err := a.ensureGuestAccount(user.Id); err != nil {
+		return err
+	}
 
 	a.sendUpdatedUserEvent(*demotedUser)
 	if uErr := a.ch.srv.platform.UpdateSessionsIsGuest(c, demotedUser, demotedUser.IsGuest()); uErr != nil {
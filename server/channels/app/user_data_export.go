@@ -0,0 +1,381 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const userDataExportBatchSize = 1000
+
+// RequestUserDataExport schedules an export of the user's own data. Users can
+// request at most one export every ExportSettings.UserDataExportIntervalHours.
+func (a *App) RequestUserDataExport(rctx request.CTX, userID string) (*model.UserDataExport, *model.AppError) {
+	if !*a.Config().ExportSettings.EnableUserDataExport {
+		return nil, model.NewAppError("RequestUserDataExport", "app.user_data_export.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	job, appErr := a.Srv().Jobs.NewJob(rctx, model.JobTypeUserDataExport, map[string]string{"user_id": userID})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// The job is only saved along with the export, once the store has checked that the user
+	// didn't request another export within the interval.
+	intervalHours := *a.Config().ExportSettings.UserDataExportIntervalHours
+	since := job.CreateAt - int64(intervalHours)*time.Hour.Milliseconds()
+	export, err := a.Srv().Store().UserDataExport().SaveWithJob(&model.UserDataExport{
+		JobId:    job.Id,
+		UserId:   userID,
+		CreateAt: job.CreateAt,
+	}, job, since)
+	if err != nil {
+		var appErr *model.AppError
+		var leErr *store.ErrLimitExceeded
+		switch {
+		case errors.As(err, &leErr):
+			return nil, model.NewAppError("RequestUserDataExport", "app.user_data_export.rate_limited.app_error", map[string]any{"Hours": intervalHours}, "", http.StatusTooManyRequests)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RequestUserDataExport", "app.user_data_export.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	export.Status = job.Status
+
+	return export, nil
+}
+
+// GetLatestUserDataExport returns the most recent data export requested by the
+// user, along with the status of the job producing it.
+func (a *App) GetLatestUserDataExport(rctx request.CTX, userID string) (*model.UserDataExport, *model.AppError) {
+	export, err := a.Srv().Store().UserDataExport().GetLatestForUser(userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetLatestUserDataExport", "app.user_data_export.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetLatestUserDataExport", "app.user_data_export.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	job, appErr := a.GetJob(rctx, export.JobId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	export.Status = job.Status
+
+	return export, nil
+}
+
+// GetUserDataExportFile returns a reader for the archive of the given export,
+// as long as it belongs to the user and its download link hasn't expired.
+func (a *App) GetUserDataExportFile(userID, jobID string) (filestore.ReadCloseSeeker, *model.AppError) {
+	export, err := a.Srv().Store().UserDataExport().Get(jobID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetUserDataExportFile", "app.user_data_export.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetUserDataExportFile", "app.user_data_export.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// Other users' exports are reported as missing so as not to reveal them.
+	if export.UserId != userID || export.ExpiresAt == 0 {
+		return nil, model.NewAppError("GetUserDataExportFile", "app.user_data_export.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	if !export.IsDownloadable(model.GetMillis()) {
+		return nil, model.NewAppError("GetUserDataExportFile", "app.user_data_export.expired.app_error", nil, "", http.StatusGone)
+	}
+
+	return a.ExportFileReader(filepath.Join(*a.Config().ExportSettings.Directory, export.FileName()))
+}
+
+// ExportUserData writes the archive of a user_data_export job to the export
+// directory, then emails the user a link to download it.
+func (a *App) ExportUserData(rctx request.CTX, job *model.Job) *model.AppError {
+	user, appErr := a.GetUser(job.Data["user_id"])
+	if appErr != nil {
+		return appErr
+	}
+
+	fileName := (&model.UserDataExport{JobId: job.Id}).FileName()
+	rd, wr := io.Pipe()
+	writeErr := make(chan *model.AppError, 1)
+	go func() {
+		_, appErr := a.WriteExportFileContext(context.Background(), rd, filepath.Join(*a.Config().ExportSettings.Directory, fileName))
+		if appErr != nil {
+			// Unblock the writer, which gets the error back from its next write.
+			rd.CloseWithError(appErr)
+		}
+		writeErr <- appErr
+	}()
+
+	appErr = a.writeUserDataExport(rctx, job, user, wr)
+	if appErr != nil {
+		wr.CloseWithError(appErr)
+	} else {
+		wr.Close()
+	}
+	if err := <-writeErr; err != nil && appErr == nil {
+		appErr = err
+	}
+	if appErr != nil {
+		return appErr
+	}
+
+	export, err := a.Srv().Store().UserDataExport().Get(job.Id)
+	if err != nil {
+		return model.NewAppError("ExportUserData", "app.user_data_export.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	expiryHours := *a.Config().ExportSettings.UserDataExportLinkExpiryHours
+	export.ExpiresAt = model.GetMillis() + int64(expiryHours)*time.Hour.Milliseconds()
+	if _, err := a.Srv().Store().UserDataExport().Update(export); err != nil {
+		return model.NewAppError("ExportUserData", "app.user_data_export.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if *a.Config().EmailSettings.SendEmailNotifications {
+		link := fmt.Sprintf("%s/api/v4/users/%s/data_export/%s/download", a.GetSiteURL(), user.Id, job.Id)
+		if err := a.Srv().EmailService.SendUserDataExportReadyEmail(user.Email, user.Locale, a.GetSiteURL(), link, expiryHours); err != nil {
+			rctx.Logger().Warn("Failed to send the user data export email", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+	}
+
+	return nil
+}
+
+// writeUserDataExport writes a zip archive of the user's data. The profile,
+// posts, direct messages and custom emoji use the bulk export format, while the
+// preferences, reactions and uploaded files, which it doesn't cover in full,
+// are written as JSON alongside.
+func (a *App) writeUserDataExport(rctx request.CTX, job *model.Job, user *model.User, writer io.Writer) *model.AppError {
+	if job.Data == nil {
+		job.Data = make(model.StringMap)
+	}
+
+	zipWr := zip.NewWriter(writer)
+	defer zipWr.Close()
+
+	w, err := zipWr.Create("export.jsonl")
+	if err != nil {
+		return model.NewAppError("writeUserDataExport", "app.export.zip_create.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if appErr := a.exportVersion(w); appErr != nil {
+		return appErr
+	}
+
+	userLine, profilePicture, appErr := a.buildUserLine(rctx, user, true, true)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr = a.exportWriteLine(w, userLine); appErr != nil {
+		return appErr
+	}
+
+	if appErr = a.exportUserPosts(rctx, job, w, user.Id); appErr != nil {
+		return appErr
+	}
+
+	if appErr = a.exportUserDirectPosts(rctx, job, w, user.Id); appErr != nil {
+		return appErr
+	}
+
+	emojiPaths, appErr := a.exportUserCustomEmoji(w, user.Id)
+	if appErr != nil {
+		return appErr
+	}
+
+	preferences, appErr := a.GetPreferencesForUser(rctx, user.Id)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr = writeUserDataExportJSON(zipWr, "preferences.json", preferences); appErr != nil {
+		return appErr
+	}
+
+	reactions, appErr := a.getAllReactionsForUser(user.Id)
+	if appErr != nil {
+		return appErr
+	}
+	if appErr = writeUserDataExportJSON(zipWr, "reactions.json", reactions); appErr != nil {
+		return appErr
+	}
+
+	fileInfos, err := a.Srv().Store().FileInfo().GetForUser(user.Id)
+	if err != nil {
+		return model.NewAppError("writeUserDataExport", "app.user_data_export.get_files.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if appErr = writeUserDataExportJSON(zipWr, "files.json", fileInfos); appErr != nil {
+		return appErr
+	}
+
+	for _, info := range fileInfos {
+		if appErr = a.exportFile("", info.Path, zipWr); appErr != nil {
+			rctx.Logger().Warn("Unable to export file", mlog.String("file_id", info.Id), mlog.Err(appErr))
+		}
+	}
+	updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "files_exported", len(fileInfos))
+
+	for _, emojiPath := range emojiPaths {
+		if appErr = a.exportFile("", emojiPath, zipWr); appErr != nil {
+			rctx.Logger().Warn("Unable to export emoji", mlog.String("emoji_path", emojiPath), mlog.Err(appErr))
+		}
+	}
+
+	if profilePicture != "" {
+		if appErr = a.exportFile("", profilePicture, zipWr); appErr != nil {
+			rctx.Logger().Warn("Unable to export profile picture", mlog.String("profile_picture", profilePicture), mlog.Err(appErr))
+		}
+	}
+
+	return nil
+}
+
+func writeUserDataExportJSON(zipWr *zip.Writer, name string, v any) *model.AppError {
+	w, err := zipWr.Create(name)
+	if err != nil {
+		return model.NewAppError("writeUserDataExportJSON", "app.export.zip_create.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return model.NewAppError("writeUserDataExportJSON", "app.export.export_write_line.json_marshall.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// exportUserPosts exports the threads the user started or replied to in the team channels they are
+// still a member of.
+func (a *App) exportUserPosts(rctx request.CTX, job *model.Job, writer io.Writer, userID string) *model.AppError {
+	afterID := strings.Repeat("0", 26)
+	cnt := 0
+	for {
+		posts, err := a.Srv().Store().Post().GetParentsForUserExportAfter(userID, userDataExportBatchSize, afterID)
+		if err != nil {
+			return model.NewAppError("exportUserPosts", "app.post.get_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if len(posts) == 0 {
+			return nil
+		}
+		cnt += len(posts)
+		updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "posts_exported", cnt)
+
+		for _, post := range posts {
+			afterID = post.Id
+
+			postLine, _, appErr := a.buildPostLine(rctx, post, false)
+			if appErr != nil {
+				return appErr
+			}
+
+			if appErr := a.exportWriteLine(writer, postLine); appErr != nil {
+				return appErr
+			}
+		}
+	}
+}
+
+// exportUserDirectPosts exports the threads of the direct and group channels the user is a member of.
+func (a *App) exportUserDirectPosts(rctx request.CTX, job *model.Job, writer io.Writer, userID string) *model.AppError {
+	afterID := strings.Repeat("0", 26)
+	cnt := 0
+	for {
+		posts, err := a.Srv().Store().Post().GetDirectPostParentsForUserExportAfter(userID, userDataExportBatchSize, afterID)
+		if err != nil {
+			return model.NewAppError("exportUserDirectPosts", "app.post.get_direct_posts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if len(posts) == 0 {
+			return nil
+		}
+		cnt += len(posts)
+		updateJobProgress(rctx.Logger(), a.Srv().Store(), job, "direct_posts_exported", cnt)
+
+		for _, post := range posts {
+			afterID = post.Id
+
+			postLine, _, appErr := a.buildDirectPostLine(rctx, post, false)
+			if appErr != nil {
+				return appErr
+			}
+
+			if appErr := a.exportWriteLine(writer, postLine); appErr != nil {
+				return appErr
+			}
+		}
+	}
+}
+
+// exportUserCustomEmoji exports the custom emoji created by the user, returning
+// the paths of their images.
+func (a *App) exportUserCustomEmoji(writer io.Writer, userID string) ([]string, *model.AppError) {
+	var emojiPaths []string
+	for offset := 0; ; offset += userDataExportBatchSize {
+		emojis, err := a.Srv().Store().Emoji().GetByCreator(userID, offset, userDataExportBatchSize)
+		if err != nil {
+			return nil, model.NewAppError("exportUserCustomEmoji", "app.emoji.get_by_creator.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		for _, emoji := range emojis {
+			filePath := filepath.Join("emoji", emoji.Id, "image")
+			emojiPaths = append(emojiPaths, filePath)
+			if appErr := a.exportWriteLine(writer, ImportLineFromEmoji(emoji, filePath)); appErr != nil {
+				return nil, appErr
+			}
+		}
+
+		if len(emojis) < userDataExportBatchSize {
+			return emojiPaths, nil
+		}
+	}
+}
+
+func (a *App) getAllReactionsForUser(userID string) ([]*model.Reaction, *model.AppError) {
+	reactions := []*model.Reaction{}
+	for {
+		batch, err := a.Srv().Store().Reaction().GetForUser(userID, len(reactions), userDataExportBatchSize)
+		if err != nil {
+			return nil, model.NewAppError("getAllReactionsForUser", "app.reaction.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		reactions = append(reactions, batch...)
+		if len(batch) < userDataExportBatchSize {
+			return reactions, nil
+		}
+	}
+}
+
+// deleteUserDataExports removes the records of the user's data exports. Their
+// archives are removed by the export_delete job once their links expire.
+func (a *App) deleteUserDataExports(userID string) *model.AppError {
+	if err := a.Srv().Store().UserDataExport().PermanentDeleteByUser(userID); err != nil {
+		return model.NewAppError("deleteUserDataExports", "app.user_data_export.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
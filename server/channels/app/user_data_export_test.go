@@ -0,0 +1,136 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+func TestRequestUserDataExport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.RequestUserDataExport(th.Context, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExportSettings.EnableUserDataExport = true })
+
+	export, appErr := th.App.RequestUserDataExport(th.Context, th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicUser.Id, export.UserId)
+	assert.Zero(t, export.ExpiresAt)
+
+	job, appErr := th.App.GetJob(th.Context, export.JobId)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.JobTypeUserDataExport, job.Type)
+	assert.Equal(t, th.BasicUser.Id, job.Data["user_id"])
+
+	t.Run("rate limited", func(t *testing.T) {
+		_, appErr := th.App.RequestUserDataExport(th.Context, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusTooManyRequests, appErr.StatusCode)
+
+		_, appErr = th.App.RequestUserDataExport(th.Context, th.BasicUser2.Id)
+		require.Nil(t, appErr, "the limit applies per user")
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		_, appErr := th.App.GetUserDataExportFile(th.BasicUser.Id, export.JobId)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		export.ExpiresAt = model.GetMillis() - 1000
+		_, err := th.App.Srv().Store().UserDataExport().Update(export)
+		require.NoError(t, err)
+
+		_, appErr := th.App.GetUserDataExportFile(th.BasicUser.Id, export.JobId)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusGone, appErr.StatusCode)
+
+		_, appErr = th.App.GetUserDataExportFile(th.BasicUser2.Id, export.JobId)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode, "other users' exports are hidden")
+	})
+}
+
+func TestWriteUserDataExport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	// The basic post is the only one of the user in team channels.
+	th.CreatePost(th.CreateDmChannel(th.BasicUser2))
+
+	otherPost, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		UserId:    th.BasicUser2.Id,
+		Message:   "not in the export",
+	}, "", true)
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.SaveReactionForPost(th.Context, &model.Reaction{
+		UserId:    th.BasicUser.Id,
+		PostId:    otherPost.Id,
+		EmojiName: "smile",
+	})
+	require.Nil(t, appErr)
+
+	job := &model.Job{Id: model.NewId(), Data: model.StringMap{"user_id": th.BasicUser.Id}}
+	var buf bytes.Buffer
+	require.Nil(t, th.App.writeUserDataExport(th.Context, job, th.BasicUser, &buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	for _, name := range []string{"export.jsonl", "preferences.json", "reactions.json", "files.json"} {
+		require.Contains(t, files, name)
+	}
+
+	rd, err := files["export.jsonl"].Open()
+	require.NoError(t, err)
+	defer rd.Close()
+
+	lineTypes := map[string]int{}
+	scanner := bufio.NewScanner(rd)
+	for scanner.Scan() {
+		var line imports.LineImportData
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lineTypes[line.Type]++
+
+		if line.Type == "user" {
+			assert.Equal(t, th.BasicUser.Username, *line.User.Username)
+		}
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, 1, lineTypes["user"])
+	assert.Equal(t, 1, lineTypes["post"], "only the threads the user took part in are exported")
+	assert.Equal(t, 1, lineTypes["direct_post"])
+
+	rd, err = files["reactions.json"].Open()
+	require.NoError(t, err)
+	defer rd.Close()
+	var reactions []*model.Reaction
+	require.NoError(t, json.NewDecoder(rd).Decode(&reactions))
+	require.Len(t, reactions, 1)
+	assert.Equal(t, otherPost.Id, reactions[0].PostId)
+}
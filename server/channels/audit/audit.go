@@ -18,6 +18,11 @@ type Audit struct {
 
 	// OnError is called when an error occurs while writing an audit record.
 	OnError func(err error)
+
+	// OnRecord is called after a record, including any checkpoint, has been queued for writing.
+	OnRecord func(level mlog.Level, rec Record)
+
+	chain *hashChain
 }
 
 func (a *Audit) Init(maxQueueSize int) {
@@ -29,8 +34,63 @@ func (a *Audit) Init(maxQueueSize int) {
 	)
 }
 
+// EnableHashChain links every subsequently logged record to the one before it
+// by including the hash of the previous record in its own hash, so that edited,
+// removed or reordered records can be detected. Every checkpointInterval records
+// a checkpoint signed with sign is logged; a zero interval disables checkpoints.
+func (a *Audit) EnableHashChain(checkpointInterval int, sign SignFunc) {
+	a.chain = newHashChain(checkpointInterval, sign)
+}
+
 // LogRecord emits an audit record with complete info.
 func (a *Audit) LogRecord(level mlog.Level, rec Record) {
+	if a.chain == nil {
+		a.logRecord(level, rec)
+		a.onRecord(level, rec)
+		return
+	}
+
+	// The lock is held while queueing so that records are written in chain order.
+	a.chain.mux.Lock()
+	rec = a.linkAndLog(level, rec)
+
+	var checkpoint *Record
+	if a.chain.checkpointDue() {
+		checkpoint = a.logCheckpoint()
+	}
+	a.chain.mux.Unlock()
+
+	a.onRecord(level, rec)
+	if checkpoint != nil {
+		a.onRecord(mlog.LvlAuditCLI, *checkpoint)
+	}
+}
+
+// linkAndLog must be called with the chain lock held.
+func (a *Audit) linkAndLog(level mlog.Level, rec Record) Record {
+	rec, err := a.chain.link(rec)
+	if err != nil {
+		a.onLoggerError(fmt.Errorf("failed to hash audit record %s: %w", rec.EventName, err))
+	}
+
+	a.logRecord(level, rec)
+	return rec
+}
+
+// logCheckpoint must be called with the chain lock held.
+func (a *Audit) logCheckpoint() *Record {
+	checkpoint, err := a.chain.checkpoint()
+	if err != nil {
+		a.onLoggerError(fmt.Errorf("failed to sign audit checkpoint: %w", err))
+	}
+
+	checkpoint = a.linkAndLog(mlog.LvlAuditCLI, checkpoint)
+	a.chain.sinceCheckpoint = 0
+
+	return &checkpoint
+}
+
+func (a *Audit) logRecord(level mlog.Level, rec Record) {
 	flds := []mlog.Field{
 		mlog.String(KeyEventName, rec.EventName),
 		mlog.String(KeyStatus, rec.Status),
@@ -40,9 +100,24 @@ func (a *Audit) LogRecord(level mlog.Level, rec Record) {
 		mlog.Any(KeyError, rec.Error),
 	}
 
+	if rec.Hash != "" {
+		flds = append(flds,
+			mlog.String(KeyChainID, rec.ChainId),
+			mlog.Int(KeySequence, rec.Sequence),
+			mlog.String(KeyPrevHash, rec.PrevHash),
+			mlog.String(KeyHash, rec.Hash),
+		)
+	}
+
 	a.logger.Log(level, "", flds...)
 }
 
+func (a *Audit) onRecord(level mlog.Level, rec Record) {
+	if a.OnRecord != nil {
+		a.OnRecord(level, rec)
+	}
+}
+
 // Configure sets zero or more target to output audit logs to.
 func (a *Audit) Configure(cfg mlog.LoggerConfiguration) error {
 	return a.logger.ConfigureTargets(cfg, nil)
@@ -58,7 +133,16 @@ func (a *Audit) Flush() error {
 }
 
 // Shutdown cleanly stops the audit engine after making best efforts to flush all targets.
+// With hash chaining enabled, a final checkpoint covering the end of the chain is logged first.
 func (a *Audit) Shutdown() error {
+	if a.chain != nil {
+		a.chain.mux.Lock()
+		if a.chain.sinceCheckpoint > 0 {
+			a.logCheckpoint()
+		}
+		a.chain.mux.Unlock()
+	}
+
 	err := a.logger.Shutdown()
 	if err != nil {
 		a.onLoggerError(err)
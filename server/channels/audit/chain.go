@@ -0,0 +1,166 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// hashedKeys are the record fields covered by a record's hash. Fields added by
+// the log formatter, such as the timestamp, are not covered.
+var hashedKeys = []string{
+	KeyChainID,
+	KeySequence,
+	KeyPrevHash,
+	KeyEventName,
+	KeyStatus,
+	KeyActor,
+	KeyEvent,
+	KeyMeta,
+	KeyError,
+}
+
+// SignFunc signs the digest of a checkpoint, returning an ASN.1 encoded ECDSA signature.
+type SignFunc func(digest []byte) ([]byte, error)
+
+// hashChain links each record to the one logged before it. A new chain is
+// started every time the server starts, so chains are per node and per process.
+type hashChain struct {
+	mux sync.Mutex
+
+	id              string
+	sequence        int64
+	lastHash        string
+	sinceCheckpoint int
+
+	checkpointInterval int
+	sign               SignFunc
+}
+
+func newHashChain(checkpointInterval int, sign SignFunc) *hashChain {
+	return &hashChain{
+		id:                 model.NewId(),
+		checkpointInterval: checkpointInterval,
+		sign:               sign,
+	}
+}
+
+// link stamps the record with the next position in the chain and its hash.
+// Must be called with the chain lock held.
+func (c *hashChain) link(rec Record) (Record, error) {
+	rec.ChainId = c.id
+	rec.Sequence = c.sequence + 1
+	rec.PrevHash = c.lastHash
+
+	hash, err := hashRecordFields(recordFields(rec))
+	if err != nil {
+		return rec, err
+	}
+	rec.Hash = hash
+
+	c.sequence = rec.Sequence
+	c.lastHash = hash
+	c.sinceCheckpoint++
+
+	return rec, nil
+}
+
+// checkpointDue returns true once checkpointInterval records have been linked
+// since the last checkpoint. Must be called with the chain lock held.
+func (c *hashChain) checkpointDue() bool {
+	return c.checkpointInterval > 0 && c.sinceCheckpoint >= c.checkpointInterval
+}
+
+// checkpoint returns a record vouching for the chain up to and including the
+// last linked record. The signature is left empty if no signer is configured.
+// Must be called with the chain lock held.
+func (c *hashChain) checkpoint() (Record, error) {
+	rec := Record{
+		EventName: EventNameCheckpoint,
+		Status:    Success,
+		EventData: EventData{
+			Parameters: map[string]any{
+				KeyCheckpointSequence: c.sequence,
+				KeyCheckpointHash:     c.lastHash,
+			},
+		},
+		Meta: map[string]any{},
+	}
+
+	var signErr error
+	if c.sign != nil {
+		signature, err := c.sign(CheckpointDigest(c.id, c.sequence, c.lastHash))
+		if err == nil {
+			rec.EventData.Parameters[KeySignature] = base64.StdEncoding.EncodeToString(signature)
+		}
+		signErr = err
+	}
+
+	return rec, signErr
+}
+
+// checkpointDomain prefixes the signed payload of every checkpoint. Checkpoints are signed with
+// the server's general purpose signing key, so the prefix keeps a signature made for any other
+// purpose from passing as a checkpoint signature.
+const checkpointDomain = "mattermost-audit-checkpoint-v1\x00"
+
+// CheckpointDigest returns the digest signed by a checkpoint covering the given
+// chain up to the given sequence number and hash.
+func CheckpointDigest(chainID string, sequence int64, hash string) []byte {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s%s:%d:%s", checkpointDomain, chainID, sequence, hash)))
+	return sum[:]
+}
+
+func recordFields(rec Record) map[string]any {
+	return map[string]any{
+		KeyChainID:   rec.ChainId,
+		KeySequence:  rec.Sequence,
+		KeyPrevHash:  rec.PrevHash,
+		KeyEventName: rec.EventName,
+		KeyStatus:    rec.Status,
+		KeyActor:     rec.Actor,
+		KeyEvent:     rec.EventData,
+		KeyMeta:      rec.Meta,
+		KeyError:     rec.Error,
+	}
+}
+
+// hashRecordFields returns the hex encoded SHA-256 hash of the canonical JSON
+// encoding of the given fields. The fields are encoded, decoded and encoded
+// again so that the hash computed when logging a record matches the one
+// computed from the decoded log line when verifying it.
+func hashRecordFields(fields map[string]any) (string, error) {
+	canonical, err := canonicalJSON(fields)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func canonicalJSON(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(generic)
+}
@@ -20,6 +20,19 @@ const (
 	KeyIPAddress = "ip_address"
 	KeyClusterID = "cluster_id"
 
+	KeyChainID  = "chain_id"
+	KeySequence = "sequence"
+	KeyPrevHash = "prev_hash"
+	KeyHash     = "hash"
+
+	KeyCheckpointSequence = "checkpoint_sequence"
+	KeyCheckpointHash     = "checkpoint_hash"
+	KeySignature          = "signature"
+
+	EventNameCheckpoint = "auditCheckpoint"
+
+	DefCheckpointInterval = 1000
+
 	Success = "success"
 	Attempt = "attempt"
 	Fail    = "fail"
@@ -11,6 +11,12 @@ type Record struct {
 	Actor     EventActor             `json:"actor"`
 	Meta      map[string]interface{} `json:"meta"`
 	Error     EventError             `json:"error,omitempty"`
+
+	// Hash chain links, filled in when the record is logged with hash chaining enabled.
+	ChainId  string `json:"chain_id,omitempty"`
+	Sequence int64  `json:"sequence,omitempty"`
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
 }
 
 // EventData contains all event specific data about the modified entity
@@ -0,0 +1,214 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	IssueInvalidRecord    = "invalid_record"
+	IssueTampered         = "tampered"
+	IssueGap              = "gap"
+	IssueOutOfOrder       = "out_of_order"
+	IssueBrokenLink       = "broken_link"
+	IssueUnsigned         = "unsigned_checkpoint"
+	IssueInvalidSignature = "invalid_signature"
+
+	maxVerifyLineSize = 64 * 1024 * 1024
+)
+
+// VerifyIssue describes a problem found in an audit log.
+type VerifyIssue struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	ChainId  string `json:"chain_id,omitempty"`
+	Sequence int64  `json:"sequence,omitempty"`
+	Kind     string `json:"kind"`
+	Message  string `json:"message"`
+}
+
+// VerifyResult summarizes the verification of one or more audit log files.
+type VerifyResult struct {
+	Records             int           `json:"records"`
+	UnchainedRecords    int           `json:"unchained_records"`
+	Chains              int           `json:"chains"`
+	Checkpoints         int           `json:"checkpoints"`
+	VerifiedCheckpoints int           `json:"verified_checkpoints"`
+	Issues              []VerifyIssue `json:"issues"`
+}
+
+// OK returns true if no issues were found.
+func (r *VerifyResult) OK() bool {
+	return len(r.Issues) == 0
+}
+
+type chainState struct {
+	sequence int64
+	hash     string
+}
+
+// Verifier walks audit logs written in JSON format with hash chaining enabled,
+// and reports records that were edited, removed, reordered or inserted.
+// Files must be passed in the order they were written, e.g. oldest rotated
+// file first, as chains continue across log rotation.
+type Verifier struct {
+	publicKey *ecdsa.PublicKey
+	chains    map[string]*chainState
+	result    VerifyResult
+}
+
+// NewVerifier creates a Verifier. If publicKey is nil, checkpoint signatures are not checked.
+func NewVerifier(publicKey *ecdsa.PublicKey) *Verifier {
+	return &Verifier{
+		publicKey: publicKey,
+		chains:    make(map[string]*chainState),
+		result:    VerifyResult{Issues: []VerifyIssue{}},
+	}
+}
+
+// Result returns the outcome of all files verified so far.
+func (v *Verifier) Result() *VerifyResult {
+	return &v.result
+}
+
+// Verify checks the records read from r, continuing any chains seen in
+// previously verified files. The name is used to identify the file in issues.
+func (v *Verifier) Verify(name string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxVerifyLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		v.verifyLine(name, line, data)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (v *Verifier) verifyLine(name string, line int, data []byte) {
+	addIssue := func(chainID string, sequence int64, kind, format string, args ...any) {
+		v.result.Issues = append(v.result.Issues, VerifyIssue{
+			File:     name,
+			Line:     line,
+			ChainId:  chainID,
+			Sequence: sequence,
+			Kind:     kind,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		addIssue("", 0, IssueInvalidRecord, "record is not valid JSON: %v", err)
+		return
+	}
+
+	v.result.Records++
+
+	hash, _ := fields[KeyHash].(string)
+	if hash == "" {
+		v.result.UnchainedRecords++
+		return
+	}
+
+	chainID, _ := fields[KeyChainID].(string)
+	prevHash, _ := fields[KeyPrevHash].(string)
+	number, _ := fields[KeySequence].(json.Number)
+	sequence, err := number.Int64()
+	if chainID == "" || err != nil || sequence < 1 {
+		addIssue(chainID, 0, IssueInvalidRecord, "record has an invalid chain id or sequence number")
+		return
+	}
+
+	hashed := make(map[string]any, len(hashedKeys))
+	for _, key := range hashedKeys {
+		hashed[key] = fields[key]
+	}
+
+	expectedHash, err := hashRecordFields(hashed)
+	if err != nil {
+		addIssue(chainID, sequence, IssueInvalidRecord, "unable to hash record: %v", err)
+		return
+	}
+	if expectedHash != hash {
+		addIssue(chainID, sequence, IssueTampered, "record content does not match its hash")
+	}
+
+	state, ok := v.chains[chainID]
+	switch {
+	case !ok:
+		v.result.Chains++
+		if sequence != 1 {
+			addIssue(chainID, sequence, IssueGap, "chain starts at sequence %d, records 1 to %d are missing", sequence, sequence-1)
+		}
+		state = &chainState{}
+		v.chains[chainID] = state
+	case sequence <= state.sequence:
+		addIssue(chainID, sequence, IssueOutOfOrder, "record follows sequence %d", state.sequence)
+	case sequence > state.sequence+1:
+		addIssue(chainID, sequence, IssueGap, "records %d to %d are missing", state.sequence+1, sequence-1)
+	case prevHash != state.hash:
+		addIssue(chainID, sequence, IssueBrokenLink, "record does not link to the hash of record %d", state.sequence)
+	}
+
+	if fields[KeyEventName] == EventNameCheckpoint {
+		v.verifyCheckpoint(fields, chainID, sequence, prevHash, addIssue)
+	}
+
+	if sequence > state.sequence {
+		state.sequence = sequence
+		state.hash = hash
+	}
+}
+
+func (v *Verifier) verifyCheckpoint(fields map[string]any, chainID string, sequence int64, prevHash string, addIssue func(string, int64, string, string, ...any)) {
+	v.result.Checkpoints++
+
+	event, _ := fields[KeyEvent].(map[string]any)
+	params, _ := event["parameters"].(map[string]any)
+	checkpointHash, _ := params[KeyCheckpointHash].(string)
+	number, _ := params[KeyCheckpointSequence].(json.Number)
+	checkpointSequence, err := number.Int64()
+	if err != nil || checkpointSequence != sequence-1 || checkpointHash != prevHash {
+		addIssue(chainID, sequence, IssueBrokenLink, "checkpoint does not cover the record before it")
+		return
+	}
+
+	if v.publicKey == nil {
+		return
+	}
+
+	encoded, _ := params[KeySignature].(string)
+	if encoded == "" {
+		addIssue(chainID, sequence, IssueUnsigned, "checkpoint is not signed")
+		return
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || !ecdsa.VerifyASN1(v.publicKey, CheckpointDigest(chainID, checkpointSequence, checkpointHash), signature) {
+		addIssue(chainID, sequence, IssueInvalidSignature, "checkpoint signature does not match the public key")
+		return
+	}
+
+	v.result.VerifiedCheckpoints++
+}
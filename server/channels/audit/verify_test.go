@@ -0,0 +1,187 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func writeChainedLog(t *testing.T, key *ecdsa.PrivateKey, checkpointInterval int, count int) []string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "audit.log")
+	cfg := mlog.TargetCfg{
+		Type:    "file",
+		Format:  "json",
+		Options: json.RawMessage(fmt.Sprintf(`{"filename": "%s"}`, filePath)),
+		Levels:  []mlog.Level{mlog.LvlAuditCLI, mlog.LvlAuditAPI, mlog.LvlAuditPerms, mlog.LvlAuditContent},
+	}
+
+	adt := &Audit{}
+	adt.Init(DefMaxQueueSize)
+	require.NoError(t, adt.Configure(map[string]mlog.TargetCfg{"file": cfg}))
+
+	var sign SignFunc
+	if key != nil {
+		sign = func(digest []byte) ([]byte, error) {
+			return ecdsa.SignASN1(rand.Reader, key, digest)
+		}
+	}
+	adt.EnableHashChain(checkpointInterval, sign)
+
+	var logged []Record
+	adt.OnRecord = func(level mlog.Level, rec Record) {
+		logged = append(logged, rec)
+	}
+
+	for i := 0; i < count; i++ {
+		rec := Record{
+			EventName: "updateUser",
+			Status:    Success,
+			Actor:     EventActor{UserId: model.NewId(), IpAddress: "10.0.0.1"},
+			Meta:      map[string]any{KeyAPIPath: "/api/v4/users/me", "attempt": i, "ratio": 0.5},
+		}
+		AddEventParameter(&rec, "username", fmt.Sprintf("user<%d>", i))
+		adt.LogRecord(mlog.LvlAuditAPI, rec)
+	}
+	require.NoError(t, adt.Shutdown())
+
+	for i, rec := range logged {
+		assert.Equal(t, int64(i+1), rec.Sequence)
+		assert.NotEmpty(t, rec.Hash)
+	}
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func verifyLines(t *testing.T, publicKey *ecdsa.PublicKey, lines []string) *VerifyResult {
+	t.Helper()
+
+	verifier := NewVerifier(publicKey)
+	require.NoError(t, verifier.Verify("audit.log", strings.NewReader(strings.Join(lines, "\n"))))
+	return verifier.Result()
+}
+
+func issueKinds(result *VerifyResult) []string {
+	kinds := []string{}
+	for _, issue := range result.Issues {
+		kinds = append(kinds, issue.Kind)
+	}
+	return kinds
+}
+
+func TestVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// 5 records with a checkpoint after every 2, plus the final checkpoint on shutdown.
+	lines := writeChainedLog(t, key, 2, 5)
+	require.Len(t, lines, 8)
+
+	t.Run("intact log", func(t *testing.T) {
+		result := verifyLines(t, &key.PublicKey, lines)
+		assert.True(t, result.OK(), result.Issues)
+		assert.Equal(t, 8, result.Records)
+		assert.Equal(t, 1, result.Chains)
+		assert.Equal(t, 3, result.Checkpoints)
+		assert.Equal(t, 3, result.VerifiedCheckpoints)
+	})
+
+	t.Run("edited record", func(t *testing.T) {
+		tampered := append([]string{}, lines...)
+		tampered[1] = strings.Replace(tampered[1], "user\\u003c1\\u003e", "admin", 1)
+		require.NotEqual(t, lines[1], tampered[1])
+
+		result := verifyLines(t, &key.PublicKey, tampered)
+		assert.Equal(t, []string{IssueTampered}, issueKinds(result))
+		assert.Equal(t, 2, result.Issues[0].Line)
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		removed := append(append([]string{}, lines[:3]...), lines[4:]...)
+
+		result := verifyLines(t, &key.PublicKey, removed)
+		assert.Equal(t, []string{IssueGap}, issueKinds(result))
+	})
+
+	t.Run("reordered records", func(t *testing.T) {
+		reordered := append([]string{}, lines...)
+		reordered[3], reordered[4] = reordered[4], reordered[3]
+
+		result := verifyLines(t, &key.PublicKey, reordered)
+		assert.Equal(t, []string{IssueGap, IssueOutOfOrder}, issueKinds(result))
+	})
+
+	t.Run("wrong public key", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		result := verifyLines(t, &otherKey.PublicKey, lines)
+		assert.Equal(t, []string{IssueInvalidSignature, IssueInvalidSignature, IssueInvalidSignature}, issueKinds(result))
+	})
+
+	t.Run("signatures not checked without a public key", func(t *testing.T) {
+		result := verifyLines(t, nil, lines)
+		assert.True(t, result.OK())
+		assert.Equal(t, 3, result.Checkpoints)
+		assert.Zero(t, result.VerifiedCheckpoints)
+	})
+
+	t.Run("unchained and invalid lines", func(t *testing.T) {
+		result := verifyLines(t, nil, []string{`{"event_name":"login","status":"success"}`, `not json`})
+		assert.Equal(t, 1, result.UnchainedRecords)
+		assert.Equal(t, []string{IssueInvalidRecord}, issueKinds(result))
+	})
+}
+
+func TestVerifierUnsignedCheckpoints(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	lines := writeChainedLog(t, nil, 0, 3)
+	require.Len(t, lines, 4)
+
+	result := verifyLines(t, &key.PublicKey, lines)
+	assert.Equal(t, []string{IssueUnsigned}, issueKinds(result))
+}
+
+func TestVerifierAcrossFiles(t *testing.T) {
+	lines := writeChainedLog(t, nil, 0, 4)
+
+	verifier := NewVerifier(nil)
+	require.NoError(t, verifier.Verify("audit.log.1", strings.NewReader(strings.Join(lines[:2], "\n"))))
+	require.NoError(t, verifier.Verify("audit.log", strings.NewReader(strings.Join(lines[2:], "\n"))))
+	assert.True(t, verifier.Result().OK())
+
+	verifier = NewVerifier(nil)
+	require.NoError(t, verifier.Verify("audit.log", strings.NewReader(strings.Join(lines[2:], "\n"))))
+	require.Len(t, verifier.Result().Issues, 1)
+	assert.Equal(t, IssueGap, verifier.Result().Issues[0].Kind)
+	assert.Equal(t, "audit.log", verifier.Result().Issues[0].File)
+}
+
+func TestCheckpointDigestIsDomainSeparated(t *testing.T) {
+	chainID := model.NewId()
+	undomained := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s", chainID, 1, "hash")))
+
+	assert.NotEqual(t, undomained[:], CheckpointDigest(chainID, 1, "hash"))
+}
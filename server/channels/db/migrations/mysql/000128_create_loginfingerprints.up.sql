@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS LoginFingerprints (
+    UserId varchar(26) NOT NULL,
+    Type varchar(32) NOT NULL,
+    Value varchar(256) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    LastSeenAt bigint(20) NOT NULL,
+    PRIMARY KEY (UserId, Type, Value)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS AuditEvents (
+    Id varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    EventName varchar(128) NOT NULL,
+    Status varchar(32) NOT NULL,
+    Level varchar(32) NOT NULL,
+    UserId varchar(64) NOT NULL,
+    SessionId varchar(26) NOT NULL,
+    IpAddress varchar(64) NOT NULL,
+    ApiPath varchar(512) NOT NULL,
+    ObjectType varchar(64) NOT NULL,
+    ChainId varchar(26) NOT NULL,
+    Sequence bigint(20) NOT NULL,
+    Hash varchar(64) NOT NULL,
+    Record mediumtext NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_auditevents_create_at (CreateAt),
+    KEY idx_auditevents_user_id_create_at (UserId, CreateAt),
+    KEY idx_auditevents_event_name_create_at (EventName, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS GuestAccounts (
+    UserId varchar(26) NOT NULL,
+    SponsorId varchar(26) NOT NULL,
+    ExpiresAt bigint(20) NOT NULL,
+    ExpiryNotifiedAt bigint(20) NOT NULL,
+    ExpiryDeactivatedAt bigint(20) NOT NULL DEFAULT 0,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (UserId),
+    KEY idx_guestaccounts_expires_at (ExpiresAt),
+    KEY idx_guestaccounts_sponsor_id (SponsorId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS PendingGuestInvites (
+    Id varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    SenderId varchar(26) NOT NULL,
+    SponsorId varchar(26) NOT NULL,
+    Emails text NOT NULL,
+    Channels text NOT NULL,
+    Message text NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_pendingguestinvites_create_at (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
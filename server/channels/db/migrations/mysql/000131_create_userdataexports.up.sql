@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS UserDataExports (
+    JobId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    ExpiresAt bigint(20) NOT NULL,
+    PRIMARY KEY (JobId),
+    KEY idx_userdataexports_user_id_create_at (UserId, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
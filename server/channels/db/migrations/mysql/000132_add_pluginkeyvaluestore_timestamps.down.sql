@@ -0,0 +1,29 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PluginKeyValueStore'
+        AND table_schema = DATABASE()
+        AND column_name = 'UpdateAt'
+    ),
+    'ALTER TABLE PluginKeyValueStore DROP COLUMN UpdateAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PluginKeyValueStore'
+        AND table_schema = DATABASE()
+        AND column_name = 'CreateAt'
+    ),
+    'ALTER TABLE PluginKeyValueStore DROP COLUMN CreateAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
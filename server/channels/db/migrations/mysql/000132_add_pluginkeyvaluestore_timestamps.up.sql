@@ -0,0 +1,32 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PluginKeyValueStore'
+        AND table_schema = DATABASE()
+        AND column_name = 'CreateAt'
+    ),
+    'ALTER TABLE PluginKeyValueStore ADD COLUMN CreateAt bigint(20) NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PluginKeyValueStore'
+        AND table_schema = DATABASE()
+        AND column_name = 'UpdateAt'
+    ),
+    'ALTER TABLE PluginKeyValueStore ADD COLUMN UpdateAt bigint(20) NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+UPDATE PluginKeyValueStore SET CreateAt = ROUND(UNIX_TIMESTAMP(NOW(3))*1000) WHERE CreateAt = 0;
+UPDATE PluginKeyValueStore SET UpdateAt = CreateAt WHERE UpdateAt = 0;
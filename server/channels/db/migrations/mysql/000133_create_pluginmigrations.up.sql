@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS PluginMigrations (
+    PluginId varchar(190) NOT NULL,
+    Version bigint(20) NOT NULL,
+    Name varchar(255) NOT NULL,
+    Down longtext NOT NULL,
+    AppliedAt bigint(20) NOT NULL,
+    PRIMARY KEY (PluginId, Version)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS SavedSearches (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    Name varchar(64) NOT NULL,
+    Terms text NOT NULL,
+    IsOrSearch tinyint(1) NOT NULL,
+    Params text NOT NULL,
+    Alert tinyint(1) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_savedsearches_user_id (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
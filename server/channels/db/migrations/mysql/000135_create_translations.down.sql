@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ChannelTranslationSettings;
+DROP TABLE IF EXISTS PostTranslations;
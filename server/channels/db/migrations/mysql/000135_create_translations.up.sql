@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS PostTranslations (
+    PostId varchar(26) NOT NULL,
+    Locale varchar(5) NOT NULL,
+    PostEditAt bigint(20) NOT NULL,
+    Message text NOT NULL,
+    SourceLanguage varchar(16) NOT NULL,
+    Provider varchar(64) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (PostId, Locale)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS ChannelTranslationSettings (
+    ChannelId varchar(26) NOT NULL,
+    AutoTranslate tinyint(1) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (ChannelId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
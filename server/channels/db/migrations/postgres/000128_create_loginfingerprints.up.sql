@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS loginfingerprints (
+    userid varchar(26) NOT NULL,
+    type varchar(32) NOT NULL,
+    value varchar(256) NOT NULL,
+    createat bigint NOT NULL,
+    lastseenat bigint NOT NULL,
+    PRIMARY KEY (userid, type, value)
+);
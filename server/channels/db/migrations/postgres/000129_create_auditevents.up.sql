@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS auditevents (
+    id varchar(26) PRIMARY KEY,
+    createat bigint NOT NULL,
+    eventname varchar(128) NOT NULL,
+    status varchar(32) NOT NULL,
+    level varchar(32) NOT NULL,
+    userid varchar(64) NOT NULL,
+    sessionid varchar(26) NOT NULL,
+    ipaddress varchar(64) NOT NULL,
+    apipath varchar(512) NOT NULL,
+    objecttype varchar(64) NOT NULL,
+    chainid varchar(26) NOT NULL,
+    sequence bigint NOT NULL,
+    hash varchar(64) NOT NULL,
+    record text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auditevents_create_at ON auditevents (createat);
+CREATE INDEX IF NOT EXISTS idx_auditevents_user_id_create_at ON auditevents (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_auditevents_event_name_create_at ON auditevents (eventname, createat);
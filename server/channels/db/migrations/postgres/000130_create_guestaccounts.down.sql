@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS pendingguestinvites;
+DROP TABLE IF EXISTS guestaccounts;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS guestaccounts (
+    userid varchar(26) PRIMARY KEY,
+    sponsorid varchar(26) NOT NULL,
+    expiresat bigint NOT NULL,
+    expirynotifiedat bigint NOT NULL,
+    expirydeactivatedat bigint NOT NULL DEFAULT 0,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_guestaccounts_expires_at ON guestaccounts (expiresat);
+CREATE INDEX IF NOT EXISTS idx_guestaccounts_sponsor_id ON guestaccounts (sponsorid);
+
+CREATE TABLE IF NOT EXISTS pendingguestinvites (
+    id varchar(26) PRIMARY KEY,
+    createat bigint NOT NULL,
+    teamid varchar(26) NOT NULL,
+    senderid varchar(26) NOT NULL,
+    sponsorid varchar(26) NOT NULL,
+    emails text NOT NULL,
+    channels text NOT NULL,
+    message text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_pendingguestinvites_create_at ON pendingguestinvites (createat);
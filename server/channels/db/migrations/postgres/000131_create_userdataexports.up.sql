@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS userdataexports (
+    jobid varchar(26) PRIMARY KEY,
+    userid varchar(26) NOT NULL,
+    createat bigint NOT NULL,
+    expiresat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_userdataexports_user_id_create_at ON userdataexports (userid, createat);
@@ -0,0 +1,2 @@
+ALTER TABLE pluginkeyvaluestore DROP COLUMN IF EXISTS updateat;
+ALTER TABLE pluginkeyvaluestore DROP COLUMN IF EXISTS createat;
@@ -0,0 +1,5 @@
+ALTER TABLE pluginkeyvaluestore ADD COLUMN IF NOT EXISTS createat bigint NOT NULL DEFAULT 0;
+ALTER TABLE pluginkeyvaluestore ADD COLUMN IF NOT EXISTS updateat bigint NOT NULL DEFAULT 0;
+
+UPDATE pluginkeyvaluestore SET createat = (extract(epoch FROM now()) * 1000)::bigint WHERE createat = 0;
+UPDATE pluginkeyvaluestore SET updateat = createat WHERE updateat = 0;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS pluginmigrations (
+    pluginid varchar(190) NOT NULL,
+    version bigint NOT NULL,
+    name varchar(255) NOT NULL,
+    down text NOT NULL,
+    appliedat bigint NOT NULL,
+    PRIMARY KEY (pluginid, version)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS savedsearches (
+    id varchar(26) PRIMARY KEY,
+    userid varchar(26) NOT NULL,
+    teamid varchar(26) NOT NULL,
+    name varchar(64) NOT NULL,
+    terms text NOT NULL,
+    isorsearch boolean NOT NULL,
+    params text NOT NULL,
+    alert boolean NOT NULL,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_savedsearches_user_id ON savedsearches (userid);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS channeltranslationsettings;
+DROP TABLE IF EXISTS posttranslations;
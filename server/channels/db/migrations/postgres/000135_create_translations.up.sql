@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS posttranslations (
+    postid varchar(26) NOT NULL,
+    locale varchar(5) NOT NULL,
+    postediat bigint NOT NULL,
+    message text NOT NULL,
+    sourcelanguage varchar(16) NOT NULL,
+    provider varchar(64) NOT NULL,
+    createat bigint NOT NULL,
+    PRIMARY KEY (postid, locale)
+);
+
+CREATE TABLE IF NOT EXISTS channeltranslationsettings (
+    channelid varchar(26) PRIMARY KEY,
+    autotranslate boolean NOT NULL,
+    updateat bigint NOT NULL
+);
@@ -34,37 +34,16 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 
 		exportPath := *app.Config().ExportSettings.Directory
 		retentionTime := time.Duration(*app.Config().ExportSettings.RetentionDays) * 24 * time.Hour
-		exports, appErr := app.ListExportDirectory(exportPath)
-		if appErr != nil {
-			return appErr
-		}
-
 		errors := merror.New()
-		for i := range exports {
-			filename := filepath.Base(exports[i])
-
-			// Ignore files that were not created by the bulk export command
-			if !strings.HasSuffix(filename, "_export.zip") {
-				continue
-			}
-
-			modTime, appErr := app.ExportFileModTime(filepath.Join(exportPath, filename))
-			if appErr != nil {
-				logger.Debug("Worker: Failed to get file modification time",
-					mlog.Err(appErr), mlog.String("export", exports[i]))
-				errors.Append(appErr)
-				continue
-			}
+		if err := deleteExpiredExports(logger, app, exportPath, retentionTime, errors); err != nil {
+			return err
+		}
 
-			if time.Now().After(modTime.Add(retentionTime)) {
-				// remove file data from storage.
-				if appErr := app.RemoveExportFile(exports[i]); appErr != nil {
-					logger.Debug("Worker: Failed to remove file",
-						mlog.Err(appErr), mlog.String("export", exports[i]))
-					errors.Append(appErr)
-					continue
-				}
-			}
+		// Users' own data exports are only kept for as long as they can be downloaded.
+		userDataExportPath := filepath.Join(exportPath, model.UserDataExportDir)
+		linkExpiryTime := time.Duration(*app.Config().ExportSettings.UserDataExportLinkExpiryHours) * time.Hour
+		if err := deleteExpiredExports(logger, app, userDataExportPath, linkExpiryTime, errors); err != nil {
+			return err
 		}
 
 		if err := errors.ErrorOrNil(); err != nil {
@@ -75,3 +54,39 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 	worker := jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
 	return worker
 }
+
+func deleteExpiredExports(logger mlog.LoggerIFace, app AppIface, exportPath string, retentionTime time.Duration, errors *merror.MError) *model.AppError {
+	exports, appErr := app.ListExportDirectory(exportPath)
+	if appErr != nil {
+		return appErr
+	}
+
+	for i := range exports {
+		filename := filepath.Base(exports[i])
+
+		// Ignore files that were not created by the bulk export command
+		if !strings.HasSuffix(filename, "_export.zip") {
+			continue
+		}
+
+		modTime, appErr := app.ExportFileModTime(filepath.Join(exportPath, filename))
+		if appErr != nil {
+			logger.Debug("Worker: Failed to get file modification time",
+				mlog.Err(appErr), mlog.String("export", exports[i]))
+			errors.Append(appErr)
+			continue
+		}
+
+		if time.Now().After(modTime.Add(retentionTime)) {
+			// remove file data from storage.
+			if appErr := app.RemoveExportFile(exports[i]); appErr != nil {
+				logger.Debug("Worker: Failed to remove file",
+					mlog.Err(appErr), mlog.String("export", exports[i]))
+				errors.Append(appErr)
+				continue
+			}
+		}
+	}
+
+	return nil
+}
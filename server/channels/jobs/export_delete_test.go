@@ -51,6 +51,7 @@ func TestExportDelete(t *testing.T) {
 	dirs := []string{
 		"data",
 		"data/subfolder",
+		model.UserDataExportDir,
 	}
 
 	for _, dir := range dirs {
@@ -73,6 +74,15 @@ func TestExportDelete(t *testing.T) {
 	err = os.Chtimes(filepath.Join(exportDir, "test_export.zip"), oldTime, oldTime)
 	require.NoError(t, err)
 
+	// Users' data exports are deleted once their download links expire
+	for _, file := range []string{"expired_export.zip", "downloadable_export.zip"} {
+		err = os.WriteFile(filepath.Join(exportDir, model.UserDataExportDir, file), []byte("test"), 0644)
+		require.NoError(t, err)
+	}
+	expiredTime := time.Now().Add(-(model.ExportSettingsDefaultUserDataExportLinkExpiryHours + 1) * time.Hour)
+	err = os.Chtimes(filepath.Join(exportDir, model.UserDataExportDir, "expired_export.zip"), expiredTime, expiredTime)
+	require.NoError(t, err)
+
 	// Start the workers
 	th.SetupWorkers(t)
 
@@ -86,6 +96,7 @@ func TestExportDelete(t *testing.T) {
 		"data.json",
 		"data/file.txt",
 		"data/subfolder/file.txt",
+		filepath.Join(model.UserDataExportDir, "downloadable_export.zip"),
 	} {
 		_, err := os.Stat(filepath.Join(exportDir, name))
 		require.NoError(t, err, "Expected file/directory to exist: %s", name)
@@ -95,6 +106,7 @@ func TestExportDelete(t *testing.T) {
 	for _, name := range []string{
 		"old_export.zip",
 		"test_export.zip",
+		filepath.Join(model.UserDataExportDir, "expired_export.zip"),
 	} {
 		_, err := os.Stat(filepath.Join(exportDir, name))
 		require.True(t, os.IsNotExist(err), "Expected file to be deleted: %s", name)
@@ -60,6 +60,12 @@ func (srv *JobServer) CreateJobOnce(c request.CTX, jobType string, jobData map[s
 	return job, nil
 }
 
+// NewJob returns a pending job of the given type without saving it, for callers that save it
+// along with records of their own.
+func (srv *JobServer) NewJob(c request.CTX, jobType string, jobData map[string]string) (*model.Job, *model.AppError) {
+	return srv._createJob(c, jobType, jobData)
+}
+
 func (srv *JobServer) _createJob(c request.CTX, jobType string, jobData map[string]string) (*model.Job, *model.AppError) {
 	job := model.Job{
 		Id:       model.NewId(),
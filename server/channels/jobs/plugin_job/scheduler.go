@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin_job
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+func MakeScheduler(jobServer *jobs.JobServer, app AppIface, pluginID, jobType string, interval time.Duration) *jobs.PeriodicScheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		if !*cfg.PluginSettings.Enable {
+			return false
+		}
+		active, _ := app.IsPluginActive(pluginID)
+		return active
+	}
+	return jobs.NewPeriodicScheduler(jobServer, jobType, interval, isEnabled)
+}
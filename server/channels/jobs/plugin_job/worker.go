@@ -0,0 +1,151 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin_job
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+type AppIface interface {
+	IsPluginActive(pluginID string) (bool, error)
+	RunPluginJob(pluginID string, job *model.Job) error
+}
+
+// Worker runs the jobs of a type registered by a plugin through the plugin's RunPluginJob hook.
+// Jobs are only claimed while the plugin is active on this server, leaving them to the other
+// servers of the cluster otherwise.
+type Worker struct {
+	name      string
+	pluginID  string
+	stop      chan bool
+	stopped   chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+	logger    mlog.LoggerIFace
+	app       AppIface
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface, pluginID, jobType string) *Worker {
+	worker := Worker{
+		name:      jobType,
+		pluginID:  pluginID,
+		stop:      make(chan bool, 1),
+		stopped:   make(chan bool, 1),
+		jobs:      make(chan model.Job),
+		jobServer: jobServer,
+		logger:    jobServer.Logger().With(mlog.String("worker_name", jobType), mlog.String("plugin_id", pluginID)),
+		app:       app,
+	}
+
+	return &worker
+}
+
+func (worker *Worker) Run() {
+	worker.logger.Debug("Worker started")
+
+	defer func() {
+		worker.logger.Debug("Worker finished")
+		worker.stopped <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stop:
+			worker.logger.Debug("Worker received stop signal")
+			return
+		case job := <-worker.jobs:
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *Worker) Stop() {
+	worker.logger.Debug("Worker stopping")
+	worker.stop <- true
+	<-worker.stopped
+}
+
+func (worker *Worker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *Worker) IsEnabled(cfg *model.Config) bool {
+	return *cfg.PluginSettings.Enable
+}
+
+func (worker *Worker) DoJob(job *model.Job) {
+	logger := worker.logger.With(jobs.JobLoggerFields(job)...)
+	logger.Debug("Worker: Received a new candidate job.")
+
+	if active, err := worker.app.IsPluginActive(worker.pluginID); err != nil || !active {
+		logger.Debug("Worker: Plugin isn't active, leaving the job to other servers", mlog.Err(err))
+		return
+	}
+
+	if claimed, err := worker.jobServer.ClaimJob(job); err != nil {
+		logger.Warn("Worker experienced an error while trying to claim job", mlog.Err(err))
+		return
+	} else if !claimed {
+		return
+	}
+
+	c := request.EmptyContext(logger)
+
+	// We get the job again because ClaimJob changes the job status.
+	newJob, appErr := worker.jobServer.GetJob(c, job.Id)
+	if appErr != nil {
+		logger.Error("Worker: Failed to get job", mlog.Err(appErr))
+		worker.setJobError(logger, job, appErr)
+		return
+	}
+	job = newJob
+
+	runErr := worker.app.RunPluginJob(worker.pluginID, job)
+
+	// The plugin may have returned early since cancellation was requested while it was running.
+	job, appErr = worker.jobServer.GetJob(c, job.Id)
+	if appErr != nil {
+		logger.Error("Worker: Failed to get job", mlog.Err(appErr))
+		return
+	}
+	if job.Status == model.JobStatusCancelRequested {
+		logger.Info("Worker: Job has been canceled")
+		if appErr := worker.jobServer.SetJobCanceled(job); appErr != nil {
+			logger.Error("Worker: Failed to mark job as canceled", mlog.Err(appErr))
+		}
+		return
+	}
+
+	if runErr != nil {
+		logger.Error("Worker: Plugin job failed", mlog.Err(runErr))
+		worker.setJobError(logger, job, model.NewAppError("DoJob", "app.job.error", nil, "", http.StatusInternalServerError).Wrap(runErr))
+		return
+	}
+
+	logger.Info("Worker: Job is complete")
+	worker.setJobSuccess(logger, job)
+}
+
+func (worker *Worker) setJobSuccess(logger mlog.LoggerIFace, job *model.Job) {
+	if err := worker.jobServer.SetJobProgress(job, 100); err != nil {
+		logger.Error("Worker: Failed to update progress for job", mlog.Err(err))
+		worker.setJobError(logger, job, err)
+	}
+
+	if err := worker.jobServer.SetJobSuccess(job); err != nil {
+		logger.Error("Worker: Failed to set success for job", mlog.Err(err))
+		worker.setJobError(logger, job, err)
+	}
+}
+
+func (worker *Worker) setJobError(logger mlog.LoggerIFace, job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		logger.Error("Worker: Failed to set job error", mlog.Err(err))
+	}
+}
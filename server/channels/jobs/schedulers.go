@@ -6,6 +6,7 @@ package jobs
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -26,9 +27,12 @@ type Schedulers struct {
 	clusterLeaderChanged chan bool
 	listenerId           string
 	jobs                 *JobServer
-	isLeader             bool
 	running              bool
 
+	// mut protects the following fields, since job types may be registered while the
+	// schedulers are running.
+	mut          sync.Mutex
+	isLeader     bool
 	schedulers   map[string]Scheduler
 	nextRunTimes map[string]*time.Time
 }
@@ -39,8 +43,31 @@ var (
 	ErrSchedulersUninitialized = errors.New("job schedulers are not initialized")
 )
 
+// AddScheduler adds the scheduler of the given job type, replacing any previous one. If the
+// schedulers are running, the next run time of the job type is computed right away.
 func (schedulers *Schedulers) AddScheduler(name string, scheduler Scheduler) {
+	schedulers.mut.Lock()
+	defer schedulers.mut.Unlock()
+
 	schedulers.schedulers[name] = scheduler
+
+	if schedulers.running {
+		cfg := schedulers.jobs.Config()
+		if !schedulers.isLeader || !scheduler.Enabled(cfg) {
+			schedulers.nextRunTimes[name] = nil
+		} else {
+			schedulers.setNextRunTime(cfg, name, time.Now(), false)
+		}
+	}
+}
+
+// RemoveScheduler removes the scheduler of the given job type.
+func (schedulers *Schedulers) RemoveScheduler(name string) {
+	schedulers.mut.Lock()
+	defer schedulers.mut.Unlock()
+
+	delete(schedulers.schedulers, name)
+	delete(schedulers.nextRunTimes, name)
 }
 
 // Start starts the schedulers. This call is not safe for concurrent use.
@@ -59,6 +86,7 @@ func (schedulers *Schedulers) Start() {
 		}()
 
 		now := time.Now()
+		schedulers.mut.Lock()
 		for name, scheduler := range schedulers.schedulers {
 			if !scheduler.Enabled(schedulers.jobs.Config()) {
 				schedulers.nextRunTimes[name] = nil
@@ -66,6 +94,7 @@ func (schedulers *Schedulers) Start() {
 				schedulers.setNextRunTime(schedulers.jobs.Config(), name, now, false)
 			}
 		}
+		schedulers.mut.Unlock()
 
 		for {
 			timer := time.NewTimer(1 * time.Minute)
@@ -77,6 +106,7 @@ func (schedulers *Schedulers) Start() {
 			case now = <-timer.C:
 				cfg := schedulers.jobs.Config()
 
+				schedulers.mut.Lock()
 				for name, nextTime := range schedulers.nextRunTimes {
 					if nextTime == nil {
 						continue
@@ -95,7 +125,9 @@ func (schedulers *Schedulers) Start() {
 						schedulers.setNextRunTime(cfg, name, now, true)
 					}
 				}
+				schedulers.mut.Unlock()
 			case newCfg := <-schedulers.configChanged:
+				schedulers.mut.Lock()
 				for name, scheduler := range schedulers.schedulers {
 					if !schedulers.isLeader || !scheduler.Enabled(newCfg) {
 						schedulers.nextRunTimes[name] = nil
@@ -103,7 +135,9 @@ func (schedulers *Schedulers) Start() {
 						schedulers.setNextRunTime(newCfg, name, now, false)
 					}
 				}
+				schedulers.mut.Unlock()
 			case isLeader := <-schedulers.clusterLeaderChanged:
+				schedulers.mut.Lock()
 				for name := range schedulers.schedulers {
 					schedulers.isLeader = isLeader
 					if !isLeader {
@@ -112,6 +146,7 @@ func (schedulers *Schedulers) Start() {
 						schedulers.setNextRunTime(schedulers.jobs.Config(), name, now, false)
 					}
 				}
+				schedulers.mut.Unlock()
 			}
 			timer.Stop()
 		}
@@ -131,6 +166,7 @@ func (schedulers *Schedulers) Stop() {
 	schedulers.running = false
 }
 
+// setNextRunTime must be called with mut held.
 func (schedulers *Schedulers) setNextRunTime(cfg *model.Config, name string, now time.Time, pendingJobs bool) {
 	scheduler := schedulers.schedulers[name]
 
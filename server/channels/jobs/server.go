@@ -65,17 +65,33 @@ func (srv *JobServer) Logger() mlog.LoggerIFace {
 	return srv.logger
 }
 
+// RegisterJobType registers the worker and scheduler of a job type, replacing any previous
+// ones. Job types registered once the job server is running, such as those of plugins, are
+// started right away.
 func (srv *JobServer) RegisterJobType(name string, worker model.Worker, scheduler Scheduler) {
 	srv.mut.Lock()
-	defer srv.mut.Unlock()
+	workers, schedulers := srv.workers, srv.schedulers
+	srv.mut.Unlock()
+
 	if worker != nil {
-		srv.workers.AddWorker(name, worker)
+		workers.AddWorker(name, worker)
 	}
 	if scheduler != nil {
-		srv.schedulers.AddScheduler(name, scheduler)
+		schedulers.AddScheduler(name, scheduler)
 	}
 }
 
+// UnregisterJobType stops and removes the worker and scheduler of a job type. Existing jobs of
+// the type are kept, but no longer run. It waits for a running job of the type to complete.
+func (srv *JobServer) UnregisterJobType(name string) {
+	srv.mut.Lock()
+	workers, schedulers := srv.workers, srv.schedulers
+	srv.mut.Unlock()
+
+	workers.RemoveWorker(name)
+	schedulers.RemoveScheduler(name)
+}
+
 func (srv *JobServer) StartWorkers() error {
 	srv.mut.Lock()
 	defer srv.mut.Unlock()
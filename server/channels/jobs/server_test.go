@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
 )
 
 func TestStartWorkers(t *testing.T) {
@@ -121,3 +123,122 @@ func TestStopSchedulers(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+type testWorker struct {
+	running chan bool
+	stop    chan bool
+	jobs    chan model.Job
+}
+
+func newTestWorker() *testWorker {
+	return &testWorker{
+		running: make(chan bool, 1),
+		stop:    make(chan bool),
+		jobs:    make(chan model.Job),
+	}
+}
+
+func (w *testWorker) Run() {
+	w.running <- true
+	<-w.stop
+	w.running <- false
+}
+
+func (w *testWorker) Stop() {
+	w.stop <- true
+}
+
+func (w *testWorker) JobChannel() chan<- model.Job {
+	return w.jobs
+}
+
+func (w *testWorker) IsEnabled(_ *model.Config) bool {
+	return true
+}
+
+// slowStoppingWorker stops only once released, like a worker waiting for its current job.
+type slowStoppingWorker struct {
+	*testWorker
+	release chan bool
+}
+
+func (w *slowStoppingWorker) Stop() {
+	<-w.release
+	w.testWorker.Stop()
+}
+
+func TestRegisterJobType(t *testing.T) {
+	t.Run("while running", func(t *testing.T) {
+		jobServer, _, _ := makeJobServer(t)
+		jobServer.initWorkers()
+		jobServer.initSchedulers()
+		err := jobServer.StartWorkers()
+		require.NoError(t, err)
+		// Parking the go routing to let the worker watcher start
+		time.Sleep(1 * time.Millisecond)
+
+		worker := newTestWorker()
+		jobServer.RegisterJobType("plugin_test", worker, nil)
+		require.True(t, <-worker.running)
+		require.Equal(t, worker, jobServer.workers.Get("plugin_test"))
+
+		replacement := newTestWorker()
+		jobServer.RegisterJobType("plugin_test", replacement, nil)
+		require.False(t, <-worker.running)
+		require.True(t, <-replacement.running)
+
+		jobServer.UnregisterJobType("plugin_test")
+		require.False(t, <-replacement.running)
+		require.Nil(t, jobServer.workers.Get("plugin_test"))
+
+		err = jobServer.StopWorkers()
+		require.NoError(t, err)
+	})
+
+	t.Run("not running", func(t *testing.T) {
+		jobServer, _, _ := makeJobServer(t)
+		jobServer.initWorkers()
+		jobServer.initSchedulers()
+
+		worker := newTestWorker()
+		jobServer.RegisterJobType("plugin_test", worker, nil)
+		require.Equal(t, worker, jobServer.workers.Get("plugin_test"))
+		require.Empty(t, worker.running)
+
+		jobServer.UnregisterJobType("plugin_test")
+		require.Nil(t, jobServer.workers.Get("plugin_test"))
+	})
+
+	t.Run("workers remain available while one is stopping", func(t *testing.T) {
+		jobServer, _, _ := makeJobServer(t)
+		jobServer.initWorkers()
+		jobServer.initSchedulers()
+		err := jobServer.StartWorkers()
+		require.NoError(t, err)
+		// Parking the go routing to let the worker watcher start
+		time.Sleep(1 * time.Millisecond)
+
+		worker := &slowStoppingWorker{testWorker: newTestWorker(), release: make(chan bool)}
+		jobServer.RegisterJobType("plugin_test", worker, nil)
+		require.True(t, <-worker.running)
+
+		done := make(chan bool)
+		go func() {
+			jobServer.UnregisterJobType("plugin_test")
+			close(done)
+		}()
+
+		require.Eventually(t, func() bool { return jobServer.workers.Get("plugin_test") == nil }, time.Second, 10*time.Millisecond)
+		other := newTestWorker()
+		jobServer.RegisterJobType("other_test", other, nil)
+		require.True(t, <-other.running)
+
+		close(worker.release)
+		<-done
+		require.False(t, <-worker.running)
+
+		jobServer.UnregisterJobType("other_test")
+		err = jobServer.StopWorkers()
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package user_data_export
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+type AppIface interface {
+	ExportUserData(rctx request.CTX, job *model.Job) *model.AppError
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
+	const workerName = "UserDataExport"
+
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.ExportSettings.EnableUserDataExport
+	}
+	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
+		defer jobServer.HandleJobPanic(logger, job)
+
+		if appErr := app.ExportUserData(request.EmptyContext(logger), job); appErr != nil {
+			return appErr
+		}
+
+		return nil
+	}
+	return jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
+}
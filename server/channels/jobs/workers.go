@@ -5,6 +5,7 @@ package jobs
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/configservice"
@@ -15,6 +16,9 @@ type Workers struct {
 	ConfigService configservice.ConfigService
 	Watcher       *Watcher
 
+	// mut protects workers and running, since job types may be registered while the workers
+	// are running.
+	mut     sync.RWMutex
 	workers map[string]model.Worker
 
 	listenerId string
@@ -34,11 +38,47 @@ func NewWorkers(configService configservice.ConfigService) *Workers {
 	}
 }
 
+// AddWorker adds the worker of the given job type, replacing any previous one. If the workers
+// are running, the worker is started right away when enabled.
 func (workers *Workers) AddWorker(name string, worker model.Worker) {
+	workers.mut.Lock()
+	previous := workers.runningWorker(name)
 	workers.workers[name] = worker
+	if workers.running && worker.IsEnabled(workers.ConfigService.Config()) {
+		go worker.Run()
+	}
+	workers.mut.Unlock()
+
+	if previous != nil {
+		previous.Stop()
+	}
+}
+
+// RemoveWorker removes the worker of the given job type, stopping it if running.
+func (workers *Workers) RemoveWorker(name string) {
+	workers.mut.Lock()
+	previous := workers.runningWorker(name)
+	delete(workers.workers, name)
+	workers.mut.Unlock()
+
+	if previous != nil {
+		previous.Stop()
+	}
+}
+
+// runningWorker returns the worker of the given job type if it is running. Stopping a worker
+// waits for its current job, so callers must not hold mut while doing so.
+func (workers *Workers) runningWorker(name string) model.Worker {
+	if w, ok := workers.workers[name]; ok && workers.running && w.IsEnabled(workers.ConfigService.Config()) {
+		return w
+	}
+	return nil
 }
 
 func (workers *Workers) Get(name string) model.Worker {
+	workers.mut.RLock()
+	defer workers.mut.RUnlock()
+
 	return workers.workers[name]
 }
 
@@ -47,6 +87,9 @@ func (workers *Workers) Get(name string) model.Worker {
 func (workers *Workers) Start() {
 	mlog.Info("Starting workers")
 
+	workers.mut.Lock()
+	defer workers.mut.Unlock()
+
 	for _, w := range workers.workers {
 		if w.IsEnabled(workers.ConfigService.Config()) {
 			go w.Run()
@@ -62,6 +105,9 @@ func (workers *Workers) Start() {
 func (workers *Workers) handleConfigChange(oldConfig *model.Config, newConfig *model.Config) {
 	mlog.Debug("Workers received config change.")
 
+	workers.mut.RLock()
+	defer workers.mut.RUnlock()
+
 	for _, w := range workers.workers {
 		if w.IsEnabled(oldConfig) && !w.IsEnabled(newConfig) {
 			w.Stop()
@@ -79,6 +125,9 @@ func (workers *Workers) Stop() {
 
 	workers.Watcher.Stop()
 
+	workers.mut.Lock()
+	defer workers.mut.Unlock()
+
 	for _, w := range workers.workers {
 		if w.IsEnabled(workers.ConfigService.Config()) {
 			w.Stop()
@@ -24,6 +24,7 @@ const mySQLDeadlockCode = uint16(1213)
 type RetryLayer struct {
 	store.Store
 	AuditStore                      store.AuditStore
+	AuditEventStore                 store.AuditEventStore
 	BotStore                        store.BotStore
 	ChannelStore                    store.ChannelStore
 	ChannelBookmarkStore            store.ChannelBookmarkStore
@@ -37,13 +38,16 @@ type RetryLayer struct {
 	EmojiStore                      store.EmojiStore
 	FileInfoStore                   store.FileInfoStore
 	GroupStore                      store.GroupStore
+	GuestAccountStore               store.GuestAccountStore
 	JobStore                        store.JobStore
 	LicenseStore                    store.LicenseStore
 	LinkMetadataStore               store.LinkMetadataStore
+	LoginFingerprintStore           store.LoginFingerprintStore
 	NotifyAdminStore                store.NotifyAdminStore
 	OAuthStore                      store.OAuthStore
 	OutgoingOAuthConnectionStore    store.OutgoingOAuthConnectionStore
 	PluginStore                     store.PluginStore
+	PluginMigrationStore            store.PluginMigrationStore
 	PostStore                       store.PostStore
 	PostAcknowledgementStore        store.PostAcknowledgementStore
 	PostPersistentNotificationStore store.PostPersistentNotificationStore
@@ -54,6 +58,7 @@ type RetryLayer struct {
 	RemoteClusterStore              store.RemoteClusterStore
 	RetentionPolicyStore            store.RetentionPolicyStore
 	RoleStore                       store.RoleStore
+	SavedSearchStore                store.SavedSearchStore
 	SchemeStore                     store.SchemeStore
 	SessionStore                    store.SessionStore
 	SharedChannelStore              store.SharedChannelStore
@@ -63,9 +68,11 @@ type RetryLayer struct {
 	TermsOfServiceStore             store.TermsOfServiceStore
 	ThreadStore                     store.ThreadStore
 	TokenStore                      store.TokenStore
+	TranslationStore                store.TranslationStore
 	UploadSessionStore              store.UploadSessionStore
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
+	UserDataExportStore             store.UserDataExportStore
 	UserTermsOfServiceStore         store.UserTermsOfServiceStore
 	WebhookStore                    store.WebhookStore
 }
@@ -74,6 +81,10 @@ func (s *RetryLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *RetryLayer) AuditEvent() store.AuditEventStore {
+	return s.AuditEventStore
+}
+
 func (s *RetryLayer) Bot() store.BotStore {
 	return s.BotStore
 }
@@ -126,6 +137,10 @@ func (s *RetryLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *RetryLayer) GuestAccount() store.GuestAccountStore {
+	return s.GuestAccountStore
+}
+
 func (s *RetryLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -138,6 +153,10 @@ func (s *RetryLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *RetryLayer) LoginFingerprint() store.LoginFingerprintStore {
+	return s.LoginFingerprintStore
+}
+
 func (s *RetryLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -154,6 +173,10 @@ func (s *RetryLayer) Plugin() store.PluginStore {
 	return s.PluginStore
 }
 
+func (s *RetryLayer) PluginMigration() store.PluginMigrationStore {
+	return s.PluginMigrationStore
+}
+
 func (s *RetryLayer) Post() store.PostStore {
 	return s.PostStore
 }
@@ -194,6 +217,10 @@ func (s *RetryLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *RetryLayer) SavedSearch() store.SavedSearchStore {
+	return s.SavedSearchStore
+}
+
 func (s *RetryLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -230,6 +257,10 @@ func (s *RetryLayer) Token() store.TokenStore {
 	return s.TokenStore
 }
 
+func (s *RetryLayer) Translation() store.TranslationStore {
+	return s.TranslationStore
+}
+
 func (s *RetryLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
@@ -242,6 +273,10 @@ func (s *RetryLayer) UserAccessToken() store.UserAccessTokenStore {
 	return s.UserAccessTokenStore
 }
 
+func (s *RetryLayer) UserDataExport() store.UserDataExportStore {
+	return s.UserDataExportStore
+}
+
 func (s *RetryLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
@@ -255,6 +290,11 @@ type RetryLayerAuditStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerAuditEventStore struct {
+	store.AuditEventStore
+	Root *RetryLayer
+}
+
 type RetryLayerBotStore struct {
 	store.BotStore
 	Root *RetryLayer
@@ -320,6 +360,11 @@ type RetryLayerGroupStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerGuestAccountStore struct {
+	store.GuestAccountStore
+	Root *RetryLayer
+}
+
 type RetryLayerJobStore struct {
 	store.JobStore
 	Root *RetryLayer
@@ -335,6 +380,11 @@ type RetryLayerLinkMetadataStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerLoginFingerprintStore struct {
+	store.LoginFingerprintStore
+	Root *RetryLayer
+}
+
 type RetryLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *RetryLayer
@@ -355,6 +405,11 @@ type RetryLayerPluginStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerPluginMigrationStore struct {
+	store.PluginMigrationStore
+	Root *RetryLayer
+}
+
 type RetryLayerPostStore struct {
 	store.PostStore
 	Root *RetryLayer
@@ -405,6 +460,11 @@ type RetryLayerRoleStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerSavedSearchStore struct {
+	store.SavedSearchStore
+	Root *RetryLayer
+}
+
 type RetryLayerSchemeStore struct {
 	store.SchemeStore
 	Root *RetryLayer
@@ -450,6 +510,11 @@ type RetryLayerTokenStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerTranslationStore struct {
+	store.TranslationStore
+	Root *RetryLayer
+}
+
 type RetryLayerUploadSessionStore struct {
 	store.UploadSessionStore
 	Root *RetryLayer
@@ -465,6 +530,11 @@ type RetryLayerUserAccessTokenStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerUserDataExportStore struct {
+	store.UserDataExportStore
+	Root *RetryLayer
+}
+
 type RetryLayerUserTermsOfServiceStore struct {
 	store.UserTermsOfServiceStore
 	Root *RetryLayer
@@ -554,6 +624,48 @@ func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
 
 }
 
+func (s *RetryLayerAuditEventStore) Save(event *model.AuditEvent) (*model.AuditEvent, error) {
+
+	tries := 0
+	for {
+		result, err := s.AuditEventStore.Save(event)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAuditEventStore) Search(opts model.AuditEventSearchOpts) ([]*model.AuditEvent, error) {
+
+	tries := 0
+	for {
+		result, err := s.AuditEventStore.Search(opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 
 	tries := 0
@@ -4106,6 +4218,27 @@ func (s *RetryLayerEmojiStore) Get(c request.CTX, id string, allowFromCache bool
 
 }
 
+func (s *RetryLayerEmojiStore) GetByCreator(creatorID string, offset int, limit int) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetByCreator(creatorID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerEmojiStore) GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error) {
 
 	tries := 0
@@ -5798,11 +5931,11 @@ func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 
 }
 
-func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
+func (s *RetryLayerGuestAccountStore) DeletePendingInvite(id string) error {
 
 	tries := 0
 	for {
-		err := s.JobStore.Cleanup(expiryTime, batchSize)
+		err := s.GuestAccountStore.DeletePendingInvite(id)
 		if err == nil {
 			return nil
 		}
@@ -5819,11 +5952,11 @@ func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 
 }
 
-func (s *RetryLayerJobStore) Delete(id string) (string, error) {
+func (s *RetryLayerGuestAccountStore) Get(userID string) (*model.GuestAccount, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Delete(id)
+		result, err := s.GuestAccountStore.Get(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -5840,11 +5973,11 @@ func (s *RetryLayerJobStore) Delete(id string) (string, error) {
 
 }
 
-func (s *RetryLayerJobStore) Get(c request.CTX, id string) (*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) GetExpired(now int64, limit int) ([]*model.GuestAccount, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Get(c, id)
+		result, err := s.GuestAccountStore.GetExpired(now, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5861,11 +5994,11 @@ func (s *RetryLayerJobStore) Get(c request.CTX, id string) (*model.Job, error) {
 
 }
 
-func (s *RetryLayerJobStore) GetAllByStatus(c request.CTX, status string) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) GetExpiring(before int64, limit int) ([]*model.GuestAccount, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByStatus(c, status)
+		result, err := s.GuestAccountStore.GetExpiring(before, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5882,11 +6015,11 @@ func (s *RetryLayerJobStore) GetAllByStatus(c request.CTX, status string) ([]*mo
 
 }
 
-func (s *RetryLayerJobStore) GetAllByType(c request.CTX, jobType string) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) GetPendingInvite(id string) (*model.PendingGuestInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByType(c, jobType)
+		result, err := s.GuestAccountStore.GetPendingInvite(id)
 		if err == nil {
 			return result, nil
 		}
@@ -5903,11 +6036,11 @@ func (s *RetryLayerJobStore) GetAllByType(c request.CTX, jobType string) ([]*mod
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypeAndStatus(c request.CTX, jobType string, status string) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) GetPendingInvites(offset int, limit int) ([]*model.PendingGuestInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypeAndStatus(c, jobType, status)
+		result, err := s.GuestAccountStore.GetPendingInvites(offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5924,11 +6057,11 @@ func (s *RetryLayerJobStore) GetAllByTypeAndStatus(c request.CTX, jobType string
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypeAndStatusPage(c request.CTX, jobType []string, status string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) GetUntrackedGuestIds(limit int) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypeAndStatusPage(c, jobType, status, offset, limit)
+		result, err := s.GuestAccountStore.GetUntrackedGuestIds(limit)
 		if err == nil {
 			return result, nil
 		}
@@ -5945,32 +6078,32 @@ func (s *RetryLayerJobStore) GetAllByTypeAndStatusPage(c request.CTX, jobType []
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypePage(c request.CTX, jobType string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypePage(c, jobType, offset, limit)
+		err := s.GuestAccountStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) GetAllByTypesPage(c request.CTX, jobTypes []string, offset int, limit int) ([]*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) Save(account *model.GuestAccount) (*model.GuestAccount, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetAllByTypesPage(c, jobTypes, offset, limit)
+		result, err := s.GuestAccountStore.Save(account)
 		if err == nil {
 			return result, nil
 		}
@@ -5987,11 +6120,11 @@ func (s *RetryLayerJobStore) GetAllByTypesPage(c request.CTX, jobTypes []string,
 
 }
 
-func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
+func (s *RetryLayerGuestAccountStore) SavePendingInvite(invite *model.PendingGuestInvite) (*model.PendingGuestInvite, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
+		result, err := s.GuestAccountStore.SavePendingInvite(invite)
 		if err == nil {
 			return result, nil
 		}
@@ -6008,11 +6141,11 @@ func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType stri
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
+func (s *RetryLayerGuestAccountStore) Update(account *model.GuestAccount) (*model.GuestAccount, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
+		result, err := s.GuestAccountStore.Update(account)
 		if err == nil {
 			return result, nil
 		}
@@ -6029,32 +6162,32 @@ func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType
 
 }
 
-func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
+func (s *RetryLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
+		err := s.JobStore.Cleanup(expiryTime, batchSize)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
+func (s *RetryLayerJobStore) Delete(id string) (string, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.Save(job)
+		result, err := s.JobStore.Delete(id)
 		if err == nil {
 			return result, nil
 		}
@@ -6071,11 +6204,11 @@ func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 
 }
 
-func (s *RetryLayerJobStore) SaveOnce(job *model.Job) (*model.Job, error) {
+func (s *RetryLayerJobStore) Get(c request.CTX, id string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.SaveOnce(job)
+		result, err := s.JobStore.Get(c, id)
 		if err == nil {
 			return result, nil
 		}
@@ -6092,11 +6225,11 @@ func (s *RetryLayerJobStore) SaveOnce(job *model.Job) (*model.Job, error) {
 
 }
 
-func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
+func (s *RetryLayerJobStore) GetAllByStatus(c request.CTX, status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
+		result, err := s.JobStore.GetAllByStatus(c, status)
 		if err == nil {
 			return result, nil
 		}
@@ -6113,11 +6246,11 @@ func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
+func (s *RetryLayerJobStore) GetAllByType(c request.CTX, jobType string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatus(id, status)
+		result, err := s.JobStore.GetAllByType(c, jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -6134,11 +6267,11 @@ func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job,
 
 }
 
-func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
+func (s *RetryLayerJobStore) GetAllByTypeAndStatus(c request.CTX, jobType string, status string) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
+		result, err := s.JobStore.GetAllByTypeAndStatus(c, jobType, status)
 		if err == nil {
 			return result, nil
 		}
@@ -6155,11 +6288,11 @@ func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus
 
 }
 
-func (s *RetryLayerLicenseStore) Get(c request.CTX, id string) (*model.LicenseRecord, error) {
+func (s *RetryLayerJobStore) GetAllByTypeAndStatusPage(c request.CTX, jobType []string, status string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.Get(c, id)
+		result, err := s.JobStore.GetAllByTypeAndStatusPage(c, jobType, status, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6176,11 +6309,11 @@ func (s *RetryLayerLicenseStore) Get(c request.CTX, id string) (*model.LicenseRe
 
 }
 
-func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
+func (s *RetryLayerJobStore) GetAllByTypePage(c request.CTX, jobType string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.LicenseStore.GetAll()
+		result, err := s.JobStore.GetAllByTypePage(c, jobType, offset, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -6197,32 +6330,32 @@ func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 
 }
 
-func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) error {
+func (s *RetryLayerJobStore) GetAllByTypesPage(c request.CTX, jobTypes []string, offset int, limit int) ([]*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.LicenseStore.Save(license)
+		result, err := s.JobStore.GetAllByTypesPage(c, jobTypes, offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
+func (s *RetryLayerJobStore) GetCountByStatusAndType(status string, jobType string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Get(url, timestamp)
+		result, err := s.JobStore.GetCountByStatusAndType(status, jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -6239,11 +6372,11 @@ func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.L
 
 }
 
-func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
+func (s *RetryLayerJobStore) GetNewestJobByStatusAndType(status string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.LinkMetadataStore.Save(linkMetadata)
+		result, err := s.JobStore.GetNewestJobByStatusAndType(status, jobType)
 		if err == nil {
 			return result, nil
 		}
@@ -6260,32 +6393,32 @@ func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 
 }
 
-func (s *RetryLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
+func (s *RetryLayerJobStore) GetNewestJobByStatusesAndType(statuses []string, jobType string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.NotifyAdminStore.DeleteBefore(trial, now)
+		result, err := s.JobStore.GetNewestJobByStatusesAndType(statuses, jobType)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
+func (s *RetryLayerJobStore) Save(job *model.Job) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.Get(trial)
+		result, err := s.JobStore.Save(job)
 		if err == nil {
 			return result, nil
 		}
@@ -6302,11 +6435,11 @@ func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData,
 
 }
 
-func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
+func (s *RetryLayerJobStore) SaveOnce(job *model.Job) (*model.Job, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
+		result, err := s.JobStore.SaveOnce(job)
 		if err == nil {
 			return result, nil
 		}
@@ -6323,11 +6456,11 @@ func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, fe
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
+func (s *RetryLayerJobStore) UpdateOptimistically(job *model.Job, currentStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.NotifyAdminStore.Save(data)
+		result, err := s.JobStore.UpdateOptimistically(job, currentStatus)
 		if err == nil {
 			return result, nil
 		}
@@ -6344,53 +6477,53 @@ func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.N
 
 }
 
-func (s *RetryLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+func (s *RetryLayerJobStore) UpdateStatus(id string, status string) (*model.Job, error) {
 
 	tries := 0
 	for {
-		err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+		result, err := s.JobStore.UpdateStatus(id, status)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
+func (s *RetryLayerJobStore) UpdateStatusOptimistically(id string, currentStatus string, newStatus string) (bool, error) {
 
 	tries := 0
 	for {
-		err := s.OAuthStore.DeleteApp(id)
+		result, err := s.JobStore.UpdateStatusOptimistically(id, currentStatus, newStatus)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *RetryLayerLicenseStore) Get(c request.CTX, id string) (*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessData(token)
+		result, err := s.LicenseStore.Get(c, id)
 		if err == nil {
 			return result, nil
 		}
@@ -6407,11 +6540,11 @@ func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, e
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *RetryLayerLicenseStore) GetAll() ([]*model.LicenseRecord, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+		result, err := s.LicenseStore.GetAll()
 		if err == nil {
 			return result, nil
 		}
@@ -6428,32 +6561,32 @@ func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model
 
 }
 
-func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+func (s *RetryLayerLicenseStore) Save(license *model.LicenseRecord) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+		err := s.LicenseStore.Save(license)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+func (s *RetryLayerLinkMetadataStore) Get(url string, timestamp int64) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApp(id)
+		result, err := s.LinkMetadataStore.Get(url, timestamp)
 		if err == nil {
 			return result, nil
 		}
@@ -6470,11 +6603,11 @@ func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
 
 }
 
-func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*model.LinkMetadata, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+		result, err := s.LinkMetadataStore.Save(linkMetadata)
 		if err == nil {
 			return result, nil
 		}
@@ -6491,11 +6624,11 @@ func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int
 
 }
 
-func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerLoginFingerprintStore) CountForUser(userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetApps(offset, limit)
+		result, err := s.LoginFingerprintStore.CountForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -6512,11 +6645,11 @@ func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+func (s *RetryLayerLoginFingerprintStore) Get(userID string, fingerprintType string, value string) (*model.LoginFingerprint, error) {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthData(code)
+		result, err := s.LoginFingerprintStore.Get(userID, fingerprintType, value)
 		if err == nil {
 			return result, nil
 		}
@@ -6533,21 +6666,336 @@ func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error)
 
 }
 
-func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+func (s *RetryLayerLoginFingerprintStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
 	for {
-		result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+		err := s.LoginFingerprintStore.PermanentDeleteByUser(userID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerLoginFingerprintStore) Save(fingerprint *model.LoginFingerprint) (*model.LoginFingerprint, error) {
+
+	tries := 0
+	for {
+		result, err := s.LoginFingerprintStore.Save(fingerprint)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
+
+	tries := 0
+	for {
+		err := s.NotifyAdminStore.DeleteBefore(trial, now)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
+
+	tries := 0
+	for {
+		result, err := s.NotifyAdminStore.Get(trial)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
+
+	tries := 0
+	for {
+		result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
+
+	tries := 0
+	for {
+		result, err := s.NotifyAdminStore.Save(data)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+
+	tries := 0
+	for {
+		err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) DeleteApp(id string) error {
+
+	tries := 0
+	for {
+		err := s.OAuthStore.DeleteApp(id)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAccessData(token)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAccessDataByUserForApp(userID string, clientId string) ([]*model.AccessData, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAccessDataByUserForApp(userID, clientId)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetApp(id string) (*model.OAuthApp, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetApp(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAppByUser(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAppByUser(userID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetApps(offset int, limit int) ([]*model.OAuthApp, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetApps(offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAuthData(code string) (*model.AuthData, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAuthData(code)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerOAuthStore) GetAuthorizedApps(userID string, offset int, limit int) ([]*model.OAuthApp, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.GetAuthorizedApps(userID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
@@ -6911,6 +7359,27 @@ func (s *RetryLayerOutgoingOAuthConnectionStore) UpdateConnection(c request.CTX,
 
 }
 
+func (s *RetryLayerPluginStore) Batch(pluginID string, ops []*model.PluginKVBatchOperation) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.PluginStore.Batch(pluginID, ops)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 
 	tries := 0
@@ -7037,6 +7506,48 @@ func (s *RetryLayerPluginStore) Get(pluginID string, key string) (*model.PluginK
 
 }
 
+func (s *RetryLayerPluginStore) GetMetadata(pluginID string, key string) (*model.PluginKVMetadata, error) {
+
+	tries := 0
+	for {
+		result, err := s.PluginStore.GetMetadata(pluginID, key)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPluginStore) GetMulti(pluginID string, keys []string) ([]*model.PluginKeyValue, error) {
+
+	tries := 0
+	for {
+		result, err := s.PluginStore.GetMulti(pluginID, keys)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 
 	tries := 0
@@ -7058,6 +7569,27 @@ func (s *RetryLayerPluginStore) List(pluginID string, page int, perPage int) ([]
 
 }
 
+func (s *RetryLayerPluginStore) ListWithOptions(pluginID string, options model.PluginKVListOptions) ([]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.PluginStore.ListWithOptions(pluginID, options)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 
 	tries := 0
@@ -7100,6 +7632,69 @@ func (s *RetryLayerPluginStore) SetWithOptions(pluginID string, key string, valu
 
 }
 
+func (s *RetryLayerPluginMigrationStore) Apply(migration *model.PluginMigration) error {
+
+	tries := 0
+	for {
+		err := s.PluginMigrationStore.Apply(migration)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPluginMigrationStore) GetAll(pluginID string) ([]*model.PluginMigration, error) {
+
+	tries := 0
+	for {
+		result, err := s.PluginMigrationStore.GetAll(pluginID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPluginMigrationStore) Rollback(migration *model.PluginMigration) error {
+
+	tries := 0
+	for {
+		err := s.PluginMigrationStore.Rollback(migration)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
 
 	tries := 0
@@ -7190,11 +7785,95 @@ func (s *RetryLayerPostStore) Delete(rctx request.CTX, postID string, timestamp
 
 }
 
-func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+func (s *RetryLayerPostStore) DeletePostReminder(postID string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.PostStore.DeletePostReminder(postID, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostStore) DeletePostRemindersForPost(postID string) error {
+
+	tries := 0
+	for {
+		err := s.PostStore.DeletePostRemindersForPost(postID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostStore) DeleteUserPostRemindersForChannel(userID string, channelID string) error {
+
+	tries := 0
+	for {
+		err := s.PostStore.DeleteUserPostRemindersForChannel(userID, channelID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.Get(ctx, id, opts, userID, sanitizeOptions)
+		result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID, includeArchivedChannels)
 		if err == nil {
 			return result, nil
 		}
@@ -7211,11 +7890,11 @@ func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.Get
 
 }
 
-func (s *RetryLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
+func (s *RetryLayerPostStore) GetDirectPostParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.DirectPostForExport, error) {
 
 	tries := 0
 	for {
-		result, err := s.PostStore.GetDirectPostParentsForExportAfter(limit, afterID, includeArchivedChannels)
+		result, err := s.PostStore.GetDirectPostParentsForUserExportAfter(userID, limit, afterID)
 		if err == nil {
 			return result, nil
 		}
@@ -7412,6 +8091,27 @@ func (s *RetryLayerPostStore) GetParentsForExportAfter(limit int, afterID string
 
 }
 
+func (s *RetryLayerPostStore) GetParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.PostForExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.GetParentsForUserExportAfter(userID, limit, afterID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
 
 	tries := 0
@@ -7517,6 +8217,27 @@ func (s *RetryLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 
 }
 
+func (s *RetryLayerPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.GetPostRemindersForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
@@ -7664,6 +8385,27 @@ func (s *RetryLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int6
 
 }
 
+func (s *RetryLayerPostStore) GetPostsCreatedSince(channelID string, since int64, beforeTime int64, beforeID string, limit int) ([]*model.Post, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.GetPostsCreatedSince(channelID, since, beforeTime, beforeID, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
@@ -8552,6 +9294,27 @@ func (s *RetryLayerPreferenceStore) GetCategoryAndName(category string, nane str
 
 }
 
+func (s *RetryLayerPreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
+
+	tries := 0
+	for {
+		result, err := s.PreferenceStore.GetForUsers(userIDs, category, name)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
@@ -8825,6 +9588,27 @@ func (s *RetryLayerReactionStore) GetForPostSince(postId string, since int64, ex
 
 }
 
+func (s *RetryLayerReactionStore) GetForUser(userID string, offset int, limit int) ([]*model.Reaction, error) {
+
+	tries := 0
+	for {
+		result, err := s.ReactionStore.GetForUser(userID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerReactionStore) GetSingle(userID string, postID string, remoteID string, emojiName string) (*model.Reaction, error) {
 
 	tries := 0
@@ -9690,28 +10474,196 @@ func (s *RetryLayerRoleStore) PermanentDeleteAll() error {
 
 	tries := 0
 	for {
-		err := s.RoleStore.PermanentDeleteAll()
+		err := s.RoleStore.PermanentDeleteAll()
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+
+	tries := 0
+	for {
+		result, err := s.RoleStore.Save(role)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) CountForUser(userID string) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.SavedSearchStore.CountForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) Delete(id string) error {
+
+	tries := 0
+	for {
+		err := s.SavedSearchStore.Delete(id)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) Get(id string) (*model.SavedSearch, error) {
+
+	tries := 0
+	for {
+		result, err := s.SavedSearchStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) GetAlertsForChannel(channelID string, teamID string) ([]*model.SavedSearch, error) {
+
+	tries := 0
+	for {
+		result, err := s.SavedSearchStore.GetAlertsForChannel(channelID, teamID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) GetForUser(userID string) ([]*model.SavedSearch, error) {
+
+	tries := 0
+	for {
+		result, err := s.SavedSearchStore.GetForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.SavedSearchStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSavedSearchStore) Save(search *model.SavedSearch) (*model.SavedSearch, error) {
+
+	tries := 0
+	for {
+		result, err := s.SavedSearchStore.Save(search)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *RetryLayerSavedSearchStore) Update(search *model.SavedSearch) (*model.SavedSearch, error) {
 
 	tries := 0
 	for {
-		result, err := s.RoleStore.Save(role)
+		result, err := s.SavedSearchStore.Update(search)
 		if err == nil {
 			return result, nil
 		}
@@ -12854,6 +13806,27 @@ func (s *RetryLayerTokenStore) GetByToken(token string) (*model.Token, error) {
 
 }
 
+func (s *RetryLayerTokenStore) GetTokensByTypeAndExtraPrefix(tokenType string, extraPrefix string, since int64) ([]*model.Token, error) {
+
+	tries := 0
+	for {
+		result, err := s.TokenStore.GetTokensByTypeAndExtraPrefix(tokenType, extraPrefix, since)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
 
 	tries := 0
@@ -12896,6 +13869,132 @@ func (s *RetryLayerTokenStore) Save(recovery *model.Token) error {
 
 }
 
+func (s *RetryLayerTranslationStore) GetChannelMemberLocales(channelID string) ([]string, error) {
+
+	tries := 0
+	for {
+		result, err := s.TranslationStore.GetChannelMemberLocales(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerTranslationStore) GetChannelSettings(channelID string) (*model.ChannelTranslationSettings, error) {
+
+	tries := 0
+	for {
+		result, err := s.TranslationStore.GetChannelSettings(channelID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerTranslationStore) GetPostTranslations(postIDs []string, locale string) ([]*model.PostTranslation, error) {
+
+	tries := 0
+	for {
+		result, err := s.TranslationStore.GetPostTranslations(postIDs, locale)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerTranslationStore) PermanentDeletePostTranslations(postID string) error {
+
+	tries := 0
+	for {
+		err := s.TranslationStore.PermanentDeletePostTranslations(postID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerTranslationStore) SaveChannelSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error) {
+
+	tries := 0
+	for {
+		result, err := s.TranslationStore.SaveChannelSettings(settings)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerTranslationStore) SavePostTranslation(translation *model.PostTranslation) (*model.PostTranslation, error) {
+
+	tries := 0
+	for {
+		result, err := s.TranslationStore.SavePostTranslation(translation)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUploadSessionStore) Delete(id string) error {
 
 	tries := 0
@@ -14849,6 +15948,132 @@ func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 
 }
 
+func (s *RetryLayerUserDataExportStore) Get(jobID string) (*model.UserDataExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserDataExportStore.Get(jobID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUserDataExportStore) GetLatestForUser(userID string) (*model.UserDataExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserDataExportStore.GetLatestForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUserDataExportStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.UserDataExportStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUserDataExportStore) Save(export *model.UserDataExport) (*model.UserDataExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserDataExportStore.Save(export)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUserDataExportStore) SaveWithJob(export *model.UserDataExport, job *model.Job, since int64) (*model.UserDataExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserDataExportStore.SaveWithJob(export, job, since)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUserDataExportStore) Update(export *model.UserDataExport) (*model.UserDataExport, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserDataExportStore.Update(export)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 
 	tries := 0
@@ -15491,6 +16716,7 @@ func New(childStore store.Store) *RetryLayer {
 	}
 
 	newStore.AuditStore = &RetryLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AuditEventStore = &RetryLayerAuditEventStore{AuditEventStore: childStore.AuditEvent(), Root: &newStore}
 	newStore.BotStore = &RetryLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &RetryLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
 	newStore.ChannelBookmarkStore = &RetryLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
@@ -15504,13 +16730,16 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.EmojiStore = &RetryLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &RetryLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &RetryLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.GuestAccountStore = &RetryLayerGuestAccountStore{GuestAccountStore: childStore.GuestAccount(), Root: &newStore}
 	newStore.JobStore = &RetryLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &RetryLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &RetryLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.LoginFingerprintStore = &RetryLayerLoginFingerprintStore{LoginFingerprintStore: childStore.LoginFingerprint(), Root: &newStore}
 	newStore.NotifyAdminStore = &RetryLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &RetryLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.OutgoingOAuthConnectionStore = &RetryLayerOutgoingOAuthConnectionStore{OutgoingOAuthConnectionStore: childStore.OutgoingOAuthConnection(), Root: &newStore}
 	newStore.PluginStore = &RetryLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
+	newStore.PluginMigrationStore = &RetryLayerPluginMigrationStore{PluginMigrationStore: childStore.PluginMigration(), Root: &newStore}
 	newStore.PostStore = &RetryLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &RetryLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
 	newStore.PostPersistentNotificationStore = &RetryLayerPostPersistentNotificationStore{PostPersistentNotificationStore: childStore.PostPersistentNotification(), Root: &newStore}
@@ -15521,6 +16750,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.RemoteClusterStore = &RetryLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &RetryLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &RetryLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.SavedSearchStore = &RetryLayerSavedSearchStore{SavedSearchStore: childStore.SavedSearch(), Root: &newStore}
 	newStore.SchemeStore = &RetryLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &RetryLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &RetryLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
@@ -15530,9 +16760,11 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.TermsOfServiceStore = &RetryLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &RetryLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &RetryLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.TranslationStore = &RetryLayerTranslationStore{TranslationStore: childStore.Translation(), Root: &newStore}
 	newStore.UploadSessionStore = &RetryLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
 	newStore.UserStore = &RetryLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &RetryLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserDataExportStore = &RetryLayerUserDataExportStore{UserDataExportStore: childStore.UserDataExport(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &RetryLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
 	newStore.WebhookStore = &RetryLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
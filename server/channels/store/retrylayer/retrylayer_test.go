@@ -62,6 +62,13 @@ func genStore() *mocks.Store {
 	mock.On("PostPersistentNotification").Return(&mocks.PostPersistentNotificationStore{})
 	mock.On("DesktopTokens").Return(&mocks.DesktopTokensStore{})
 	mock.On("ChannelBookmark").Return(&mocks.ChannelBookmarkStore{})
+	mock.On("LoginFingerprint").Return(&mocks.LoginFingerprintStore{})
+	mock.On("AuditEvent").Return(&mocks.AuditEventStore{})
+	mock.On("GuestAccount").Return(&mocks.GuestAccountStore{})
+	mock.On("UserDataExport").Return(&mocks.UserDataExportStore{})
+	mock.On("PluginMigration").Return(&mocks.PluginMigrationStore{})
+	mock.On("SavedSearch").Return(&mocks.SavedSearchStore{})
+	mock.On("Translation").Return(&mocks.TranslationStore{})
 	return mock
 }
 
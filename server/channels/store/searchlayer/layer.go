@@ -21,6 +21,7 @@ type SearchStore struct {
 	channel      *SearchChannelStore
 	post         *SearchPostStore
 	fileInfo     *SearchFileInfoStore
+	reaction     *SearchReactionStore
 	configValue  atomic.Pointer[model.Config]
 }
 
@@ -35,6 +36,7 @@ func NewSearchLayer(baseStore store.Store, searchEngine *searchengine.Broker, cf
 	searchStore.team = &SearchTeamStore{TeamStore: baseStore.Team(), rootStore: searchStore}
 	searchStore.user = &SearchUserStore{UserStore: baseStore.User(), rootStore: searchStore}
 	searchStore.fileInfo = &SearchFileInfoStore{FileInfoStore: baseStore.FileInfo(), rootStore: searchStore}
+	searchStore.reaction = &SearchReactionStore{ReactionStore: baseStore.Reaction(), rootStore: searchStore}
 
 	return searchStore
 }
@@ -59,6 +61,10 @@ func (s *SearchStore) FileInfo() store.FileInfoStore {
 	return s.fileInfo
 }
 
+func (s *SearchStore) Reaction() store.ReactionStore {
+	return s.reaction
+}
+
 func (s *SearchStore) Team() store.TeamStore {
 	return s.team
 }
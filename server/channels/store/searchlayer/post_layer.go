@@ -4,6 +4,10 @@
 package searchlayer
 
 import (
+	"cmp"
+	"slices"
+	"sync"
+
 	"github.com/pkg/errors"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -13,12 +17,20 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
 )
 
+// searchAffinitySize is the number of channels, and of users, ranked higher in the searches
+// sorted by relevance.
+const searchAffinitySize = 10
+
 type SearchPostStore struct {
 	store.PostStore
 	rootStore *SearchStore
 }
 
 func (s SearchPostStore) indexPost(rctx request.CTX, post *model.Post) {
+	// The metadata is loaded once, by the first engine to index the post.
+	indexed := sync.OnceValue(func() *model.Post {
+		return s.withSearchMetadata(rctx, post)[0]
+	})
 	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
 		if engine.IsIndexingEnabled() {
 			runIndexFn(rctx, engine, func(engineCopy searchengine.SearchEngineInterface) {
@@ -27,7 +39,7 @@ func (s SearchPostStore) indexPost(rctx request.CTX, post *model.Post) {
 					rctx.Logger().Error("Couldn't get channel for post for SearchEngine indexing.", mlog.String("channel_id", post.ChannelId), mlog.String("search_engine", engineCopy.GetName()), mlog.String("post_id", post.Id), mlog.Err(chanErr))
 					return
 				}
-				if err := engineCopy.IndexPost(post, channel.TeamId); err != nil {
+				if err := engineCopy.IndexPost(indexed(), channel.TeamId); err != nil {
 					rctx.Logger().Warn("Encountered error indexing post", mlog.String("post_id", post.Id), mlog.String("search_engine", engineCopy.GetName()), mlog.Err(err))
 					return
 				}
@@ -36,6 +48,87 @@ func (s SearchPostStore) indexPost(rctx request.CTX, post *model.Post) {
 	}
 }
 
+func (s SearchPostStore) indexPostFromID(rctx request.CTX, postID string) {
+	post, err := s.PostStore.GetSingle(rctx, postID, false)
+	if err != nil {
+		rctx.Logger().Warn("Couldn't get post for SearchEngine indexing.", mlog.String("post_id", postID), mlog.Err(err))
+		return
+	}
+	s.indexPost(rctx, post)
+}
+
+// withSearchMetadata returns copies of the posts with the reactions, the files
+// and the priority indexed by the search engines, loading those missing with a
+// single query each.
+func (s SearchPostStore) withSearchMetadata(rctx request.CTX, posts ...*model.Post) []*model.Post {
+	indexed := make([]*model.Post, 0, len(posts))
+	postsByID := make(map[string]*model.Post, len(posts))
+	filePosts := map[string]*model.Post{}
+	var reactedPostIDs, fileIDs, priorityPostIDs []string
+	for _, post := range posts {
+		indexedPost := post.Clone()
+		if post.Metadata != nil {
+			indexedPost.Metadata = post.Metadata.Copy()
+		} else {
+			indexedPost.Metadata = &model.PostMetadata{}
+		}
+		indexed = append(indexed, indexedPost)
+		postsByID[post.Id] = indexedPost
+
+		if post.HasReactions && len(indexedPost.Metadata.Reactions) == 0 {
+			reactedPostIDs = append(reactedPostIDs, post.Id)
+		}
+		if len(post.FileIds) > 0 && len(indexedPost.Metadata.Files) == 0 {
+			for _, fileID := range post.FileIds {
+				fileIDs = append(fileIDs, fileID)
+				filePosts[fileID] = indexedPost
+			}
+		}
+		if indexedPost.Metadata.Priority == nil {
+			priorityPostIDs = append(priorityPostIDs, post.Id)
+		}
+	}
+
+	if len(reactedPostIDs) > 0 {
+		reactions, err := s.rootStore.Reaction().BulkGetForPosts(reactedPostIDs)
+		if err != nil {
+			rctx.Logger().Warn("Couldn't get reactions of posts for SearchEngine indexing.", mlog.Int("post_count", len(reactedPostIDs)), mlog.Err(err))
+		}
+		for _, reaction := range reactions {
+			if post, ok := postsByID[reaction.PostId]; ok {
+				post.Metadata.Reactions = append(post.Metadata.Reactions, reaction)
+			}
+		}
+	}
+
+	if len(fileIDs) > 0 {
+		files, err := s.rootStore.FileInfo().GetByIds(fileIDs)
+		if err != nil {
+			rctx.Logger().Warn("Couldn't get files of posts for SearchEngine indexing.", mlog.Int("file_count", len(fileIDs)), mlog.Err(err))
+		}
+		for _, file := range files {
+			if post, ok := filePosts[file.Id]; ok {
+				post.Metadata.Files = append(post.Metadata.Files, file)
+			}
+		}
+	}
+
+	if len(priorityPostIDs) > 0 {
+		// Posts without a priority have none stored.
+		priorities, err := s.rootStore.PostPriority().GetForPosts(priorityPostIDs)
+		if err != nil {
+			rctx.Logger().Warn("Couldn't get priorities of posts for SearchEngine indexing.", mlog.Int("post_count", len(priorityPostIDs)), mlog.Err(err))
+		}
+		for _, priority := range priorities {
+			if post, ok := postsByID[priority.PostId]; ok {
+				post.Metadata.Priority = priority
+			}
+		}
+	}
+
+	return indexed
+}
+
 func (s SearchPostStore) deletePostIndex(rctx request.CTX, post *model.Post) {
 	for _, engine := range s.rootStore.searchEngine.GetActiveEngines() {
 		if engine.IsIndexingEnabled() {
@@ -161,27 +254,99 @@ func (s SearchPostStore) searchPostsForUserByEngine(engine searchengine.SearchEn
 		return nil, errors.Wrap(err2, "error getting channel for user")
 	}
 
-	postIds, matches, err := engine.SearchPosts(userChannels, paramsList, page, perPage)
-	if err != nil {
-		return nil, err
+	for _, params := range paramsList {
+		if params.IsFlagged && params.FlaggedPostIds == nil {
+			flagged, err := s.rootStore.Preference().GetCategory(userId, model.PreferenceCategoryFlaggedPost)
+			if err != nil {
+				return nil, errors.Wrap(err, "error getting flagged posts for user")
+			}
+			params.FlaggedPostIds = make([]string, 0, len(flagged))
+			for _, preference := range flagged {
+				params.FlaggedPostIds = append(params.FlaggedPostIds, preference.Name)
+			}
+		}
+	}
+
+	if paramsList[0].SortBy == model.SearchSortByRelevance {
+		if err := s.setSearchAffinity(paramsList, userChannels, userId, teamId); err != nil {
+			return nil, err
+		}
+	}
+
+	var postIds []string
+	var matches model.PostSearchMatches
+	var facets *model.PostSearchFacets
+	var appErr *model.AppError
+	if facetedEngine, ok := engine.(searchengine.FacetedPostSearchEngine); ok && paramsList[0].IncludeFacets {
+		postIds, matches, facets, appErr = facetedEngine.SearchPostsWithFacets(userChannels, paramsList, page, perPage)
+	} else {
+		postIds, matches, appErr = engine.SearchPosts(userChannels, paramsList, page, perPage)
+	}
+	if appErr != nil {
+		return nil, appErr
 	}
 
-	// Get the posts
+	// Get the posts, keeping the order of the search results.
 	postList := model.NewPostList()
 	if len(postIds) > 0 {
 		posts, err := s.PostStore.GetPostsByIds(postIds)
 		if err != nil {
 			return nil, err
 		}
+		postsById := make(map[string]*model.Post, len(posts))
 		for _, p := range posts {
-			if p.DeleteAt == 0 {
+			postsById[p.Id] = p
+		}
+		for _, postId := range postIds {
+			if p, ok := postsById[postId]; ok && p.DeleteAt == 0 {
 				postList.AddPost(p)
 				postList.AddOrder(p.Id)
 			}
 		}
 	}
 
-	return model.MakePostSearchResults(postList, matches), nil
+	results := model.MakePostSearchResults(postList, matches)
+	results.Facets = facets
+	return results, nil
+}
+
+// setSearchAffinity sets the channels and the users the user interacts the most with, which
+// the search engines rank higher when sorting by relevance: the channels the user viewed last,
+// and the other users of the direct messages with the latest activity.
+func (s SearchPostStore) setSearchAffinity(paramsList []*model.SearchParams, userChannels model.ChannelList, userId, teamId string) error {
+	members, err := s.rootStore.Channel().GetMembersForUser(teamId, userId)
+	if err != nil {
+		return errors.Wrap(err, "error getting channel members for user")
+	}
+	slices.SortFunc(members, func(a, b model.ChannelMember) int {
+		return cmp.Compare(b.LastViewedAt, a.LastViewedAt)
+	})
+	channelIds := []string{}
+	for _, member := range members[:min(len(members), searchAffinitySize)] {
+		channelIds = append(channelIds, member.ChannelId)
+	}
+
+	directChannels := model.ChannelList{}
+	for _, channel := range userChannels {
+		if channel.Type == model.ChannelTypeDirect {
+			directChannels = append(directChannels, channel)
+		}
+	}
+	slices.SortFunc(directChannels, func(a, b *model.Channel) int {
+		return cmp.Compare(b.LastPostAt, a.LastPostAt)
+	})
+	userIds := []string{}
+	for _, channel := range directChannels[:min(len(directChannels), searchAffinitySize)] {
+		if otherUserId := channel.GetOtherUserIdForDM(userId); otherUserId != "" {
+			userIds = append(userIds, otherUserId)
+		}
+	}
+
+	for _, params := range paramsList {
+		params.AffinityChannelIds = channelIds
+		params.AffinityUserIds = userIds
+	}
+	return nil
 }
 
 func (s SearchPostStore) SearchPostsForUser(rctx request.CTX, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.PostSearchResults, error) {
@@ -0,0 +1,33 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package searchlayer
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// SearchReactionStore reindexes the posts whose reactions change, as they are
+// searched by the has:reaction and reacted: modifiers.
+type SearchReactionStore struct {
+	store.ReactionStore
+	rootStore *SearchStore
+}
+
+func (s SearchReactionStore) Save(reaction *model.Reaction) (*model.Reaction, error) {
+	savedReaction, err := s.ReactionStore.Save(reaction)
+	if err == nil {
+		s.rootStore.post.indexPostFromID(request.EmptyContext(s.rootStore.Logger()), savedReaction.PostId)
+	}
+	return savedReaction, err
+}
+
+func (s SearchReactionStore) Delete(reaction *model.Reaction) (*model.Reaction, error) {
+	deletedReaction, err := s.ReactionStore.Delete(reaction)
+	if err == nil {
+		s.rootStore.post.indexPostFromID(request.EmptyContext(s.rootStore.Logger()), deletedReaction.PostId)
+	}
+	return deletedReaction, err
+}
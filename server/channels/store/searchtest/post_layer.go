@@ -280,6 +280,41 @@ var searchPostStoreTests = []searchTest{
 		Fn:   testSearchPostDeleted,
 		Tags: []string{EngineAll},
 	},
+	{
+		Name: "Should be able to filter pinned and flagged posts",
+		Fn:   testSearchPinnedAndFlaggedPosts,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should be able to filter root posts and replies",
+		Fn:   testSearchRootPostsAndReplies,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should be able to filter posts with files or links",
+		Fn:   testSearchPostsWithFilesOrLinks,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should be able to filter posts with reactions",
+		Fn:   testSearchPostsWithReactions,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should be able to filter posts by mentions",
+		Fn:   testSearchPostsByMentions,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should be able to filter posts by priority",
+		Fn:   testSearchPostsByPriority,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
+	{
+		Name: "Should return the facets of the search results when requested",
+		Fn:   testSearchPostsWithFacets,
+		Tags: []string{EngineMySQL, EnginePostgres, EngineBleve},
+	},
 }
 
 func TestSearchPostStore(t *testing.T, s store.Store, testEngine *SearchTestEngine) {
@@ -1988,3 +2023,198 @@ func testSearchPostDeleted(t *testing.T, th *SearchTestHelper) {
 		require.Len(t, results.Posts, 0)
 	})
 }
+
+func testSearchPinnedAndFlaggedPosts(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers pinned", "", model.PostTypeDefault, 0, true)
+	require.NoError(t, err)
+	p2, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers flagged", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers plain", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	err = th.Store.Preference().Save(model.Preferences{
+		{UserId: th.User.Id, Category: model.PreferenceCategoryFlaggedPost, Name: p2.Id, Value: "true"},
+	})
+	require.NoError(t, err)
+	defer th.Store.Preference().Delete(th.User.Id, model.PreferenceCategoryFlaggedPost, p2.Id)
+
+	t.Run("pinned", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "modifiers", IsPinned: true}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+
+	t.Run("pinned without terms", func(t *testing.T) {
+		params := &model.SearchParams{IsPinned: true}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p1.Id, results.Posts)
+	})
+
+	t.Run("flagged", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "modifiers", IsFlagged: true}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 1)
+		th.checkPostInSearchResults(t, p2.Id, results.Posts)
+	})
+
+	t.Run("flagged by another user", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "modifiers", IsFlagged: true}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User2.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+
+		require.Len(t, results.Posts, 0)
+	})
+}
+
+func testSearchRootPostsAndReplies(t *testing.T, th *SearchTestHelper) {
+	root, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers root", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	reply, err := th.createReply(th.User.Id, "modifiers reply", "", root, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	params := &model.SearchParams{Terms: "modifiers", IsRoot: true}
+	results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, root.Id, results.Posts)
+
+	params = &model.SearchParams{Terms: "modifiers", InThread: true}
+	results, err = th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, reply.Id, results.Posts)
+}
+
+func testSearchPostsWithFilesOrLinks(t *testing.T, th *SearchTestHelper) {
+	withFile := th.createPostModel(th.User.Id, th.ChannelBasic.Id, "modifiers file", "", model.PostTypeDefault, 1000000, false)
+	withFile.FileIds = model.StringArray{model.NewId()}
+	withFile, err := th.Store.Post().Save(th.Context, withFile)
+	require.NoError(t, err)
+	withLink, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers https://example.com", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers plain", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	params := &model.SearchParams{Terms: "modifiers", HasFile: true}
+	results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, withFile.Id, results.Posts)
+
+	params = &model.SearchParams{Terms: "modifiers", HasLink: true}
+	results, err = th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, withLink.Id, results.Posts)
+}
+
+func testSearchPostsWithReactions(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers reacted", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers plain", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	_, err = th.Store.Reaction().Save(&model.Reaction{UserId: th.User2.Id, PostId: p1.Id, EmojiName: "tada", ChannelId: p1.ChannelId})
+	require.NoError(t, err)
+	defer th.Store.Reaction().PermanentDeleteByUser(th.User2.Id)
+
+	params := &model.SearchParams{Terms: "modifiers", HasReaction: true}
+	results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, p1.Id, results.Posts)
+
+	params = &model.SearchParams{Terms: "modifiers", ReactedEmojis: []string{"tada"}}
+	results, err = th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, p1.Id, results.Posts)
+
+	params = &model.SearchParams{Terms: "modifiers", ReactedEmojis: []string{"smile"}}
+	results, err = th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 0)
+}
+
+func testSearchPostsByMentions(t *testing.T, th *SearchTestHelper) {
+	p1, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers @"+th.User2.Username+" please", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers @"+th.User2.Username+"x please", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	params := &model.SearchParams{Terms: "modifiers", Mentions: []string{th.User2.Username}}
+	results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, p1.Id, results.Posts)
+
+	// Partial mentions are filtered before the results are paged.
+	results, err = th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, p1.Id, results.Posts)
+}
+
+func testSearchPostsByPriority(t *testing.T, th *SearchTestHelper) {
+	urgent := th.createPostModel(th.User.Id, th.ChannelBasic.Id, "modifiers urgent", "", model.PostTypeDefault, 1000000, false)
+	urgent.Metadata = &model.PostMetadata{Priority: &model.PostPriority{
+		Priority:                model.NewPointer(model.PostPriorityUrgent),
+		RequestedAck:            model.NewPointer(false),
+		PersistentNotifications: model.NewPointer(false),
+	}}
+	urgent, err := th.Store.Post().Save(th.Context, urgent)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "modifiers standard", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+
+	params := &model.SearchParams{Terms: "modifiers", Priorities: []string{model.PostPriorityUrgent}}
+	results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, results.Posts, 1)
+	th.checkPostInSearchResults(t, urgent.Id, results.Posts)
+}
+
+func testSearchPostsWithFacets(t *testing.T, th *SearchTestHelper) {
+	_, err := th.createPost(th.User.Id, th.ChannelBasic.Id, "facets first", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User.Id, th.ChannelBasic.Id, "facets second", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	_, err = th.createPost(th.User2.Id, th.ChannelBasic.Id, "facets third", "", model.PostTypeDefault, 0, false)
+	require.NoError(t, err)
+	defer th.deleteUserPosts(th.User.Id)
+	defer th.deleteUserPosts(th.User2.Id)
+
+	t.Run("without facets", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "facets"}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+		require.Len(t, results.Posts, 3)
+		require.Nil(t, results.Facets)
+	})
+
+	t.Run("with facets", func(t *testing.T) {
+		params := &model.SearchParams{Terms: "facets", IncludeFacets: true}
+		results, err := th.Store.Post().SearchPostsForUser(th.Context, []*model.SearchParams{params}, th.User.Id, th.Team.Id, 0, 20)
+		require.NoError(t, err)
+		require.Len(t, results.Posts, 3)
+		require.NotNil(t, results.Facets)
+		require.Equal(t, []*model.PostSearchFacet{{Value: th.ChannelBasic.Id, Count: 3}}, results.Facets.Channels)
+		require.ElementsMatch(t, []*model.PostSearchFacet{{Value: th.User.Id, Count: 2}, {Value: th.User2.Id, Count: 1}}, results.Facets.Users)
+		require.Equal(t, []*model.PostSearchFacet{{Value: model.PostSearchDateFacetOlder, Count: 3}}, results.Facets.Dates)
+		require.Empty(t, results.Facets.FileTypes)
+	})
+}
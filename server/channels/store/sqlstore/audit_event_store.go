@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlAuditEventStore struct {
+	*SqlStore
+
+	auditEventSelectQuery sq.SelectBuilder
+}
+
+func newSqlAuditEventStore(sqlStore *SqlStore) store.AuditEventStore {
+	s := &SqlAuditEventStore{SqlStore: sqlStore}
+
+	s.auditEventSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"CreateAt",
+			"EventName",
+			"Status",
+			"Level",
+			"UserId",
+			"SessionId",
+			"IpAddress",
+			"ApiPath",
+			"ObjectType",
+			"ChainId",
+			"Sequence",
+			"Hash",
+			"Record",
+		).
+		From("AuditEvents")
+
+	return s
+}
+
+func (s *SqlAuditEventStore) Save(event *model.AuditEvent) (*model.AuditEvent, error) {
+	event.PreSave()
+	if err := event.IsValid(); err != nil {
+		return nil, err
+	}
+
+	// The record is passed as a string so that it is stored as text rather than binary.
+	query, args, err := s.getQueryBuilder().
+		Insert("AuditEvents").
+		Columns("Id", "CreateAt", "EventName", "Status", "Level", "UserId", "SessionId", "IpAddress", "ApiPath", "ObjectType", "ChainId", "Sequence", "Hash", "Record").
+		Values(event.Id, event.CreateAt, event.EventName, event.Status, event.Level, event.UserId, event.SessionId, event.IpAddress, event.ApiPath, event.ObjectType, event.ChainId, event.Sequence, event.Hash, string(event.Record)).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_audit_event_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save AuditEvent with eventName=%s", event.EventName)
+	}
+
+	return event, nil
+}
+
+func (s *SqlAuditEventStore) Search(opts model.AuditEventSearchOpts) ([]*model.AuditEvent, error) {
+	if opts.PerPage < 0 || opts.PerPage > model.AuditEventSearchMaxPerPage {
+		return nil, store.NewErrOutOfBounds(opts.PerPage)
+	}
+
+	if opts.Page < 0 {
+		return nil, store.NewErrOutOfBounds(opts.Page)
+	}
+
+	perPage := opts.PerPage
+	if perPage == 0 {
+		perPage = model.AuditEventSearchDefaultPerPage
+	}
+
+	query := s.auditEventSelectQuery.
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(perPage)).
+		Offset(uint64(opts.Page * perPage))
+
+	if opts.UserId != "" {
+		query = query.Where(sq.Eq{"UserId": opts.UserId})
+	}
+
+	if opts.EventName != "" {
+		query = query.Where(sq.Eq{"EventName": opts.EventName})
+	}
+
+	if opts.Since > 0 {
+		query = query.Where(sq.GtOrEq{"CreateAt": opts.Since})
+	}
+
+	if opts.Until > 0 {
+		query = query.Where(sq.LtOrEq{"CreateAt": opts.Until})
+	}
+
+	events := []*model.AuditEvent{}
+	if err := s.GetReplicaX().SelectBuilder(&events, query); err != nil {
+		return nil, errors.Wrap(err, "failed to search AuditEvents")
+	}
+
+	return events, nil
+}
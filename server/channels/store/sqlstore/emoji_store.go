@@ -88,6 +88,15 @@ func (es SqlEmojiStore) GetList(offset, limit int, sort string) ([]*model.Emoji,
 	return emojis, nil
 }
 
+func (es SqlEmojiStore) GetByCreator(creatorID string, offset, limit int) ([]*model.Emoji, error) {
+	emojis := []*model.Emoji{}
+
+	if err := es.GetReplicaX().Select(&emojis, "SELECT * FROM Emoji WHERE CreatorId = ? AND DeleteAt = 0 ORDER BY Name LIMIT ? OFFSET ?", creatorID, limit, offset); err != nil {
+		return nil, errors.Wrapf(err, "could not get emojis created by user with id=%s", creatorID)
+	}
+	return emojis, nil
+}
+
 func (es SqlEmojiStore) Delete(emoji *model.Emoji, time int64) error {
 	if sqlResult, err := es.GetMasterX().Exec(
 		`UPDATE
@@ -0,0 +1,255 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlGuestAccountStore struct {
+	*SqlStore
+
+	guestAccountSelectQuery sq.SelectBuilder
+}
+
+func newSqlGuestAccountStore(sqlStore *SqlStore) store.GuestAccountStore {
+	s := &SqlGuestAccountStore{SqlStore: sqlStore}
+
+	s.guestAccountSelectQuery = s.getQueryBuilder().
+		Select(
+			"GuestAccounts.UserId",
+			"GuestAccounts.SponsorId",
+			"GuestAccounts.ExpiresAt",
+			"GuestAccounts.ExpiryNotifiedAt",
+			"GuestAccounts.ExpiryDeactivatedAt",
+			"GuestAccounts.CreateAt",
+			"GuestAccounts.UpdateAt",
+		).
+		From("GuestAccounts")
+
+	return s
+}
+
+func (s *SqlGuestAccountStore) Save(account *model.GuestAccount) (*model.GuestAccount, error) {
+	account.PreSave()
+	if err := account.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("GuestAccounts").
+		Columns("UserId", "SponsorId", "ExpiresAt", "ExpiryNotifiedAt", "ExpiryDeactivatedAt", "CreateAt", "UpdateAt").
+		Values(account.UserId, account.SponsorId, account.ExpiresAt, account.ExpiryNotifiedAt, account.ExpiryDeactivatedAt, account.CreateAt, account.UpdateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_guest_account_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save GuestAccount with userId=%s", account.UserId)
+	}
+
+	return account, nil
+}
+
+func (s *SqlGuestAccountStore) Get(userID string) (*model.GuestAccount, error) {
+	query := s.guestAccountSelectQuery.Where(sq.Eq{"GuestAccounts.UserId": userID})
+
+	var account model.GuestAccount
+	if err := s.GetReplicaX().GetBuilder(&account, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("GuestAccount", userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get GuestAccount with userId=%s", userID)
+	}
+
+	return &account, nil
+}
+
+func (s *SqlGuestAccountStore) Update(account *model.GuestAccount) (*model.GuestAccount, error) {
+	account.PreUpdate()
+	if err := account.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("GuestAccounts").
+		SetMap(map[string]any{
+			"SponsorId":           account.SponsorId,
+			"ExpiresAt":           account.ExpiresAt,
+			"ExpiryNotifiedAt":    account.ExpiryNotifiedAt,
+			"ExpiryDeactivatedAt": account.ExpiryDeactivatedAt,
+			"UpdateAt":            account.UpdateAt,
+		}).
+		Where(sq.Eq{"UserId": account.UserId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "update_guest_account_tosql")
+	}
+
+	res, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update GuestAccount with userId=%s", account.UserId)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("GuestAccount", account.UserId)
+	}
+
+	return account, nil
+}
+
+func (s *SqlGuestAccountStore) getActive(where sq.Sqlizer, limit int) ([]*model.GuestAccount, error) {
+	query := s.guestAccountSelectQuery.
+		Join("Users ON Users.Id = GuestAccounts.UserId").
+		Where(sq.Eq{"Users.DeleteAt": 0}).
+		Where(sq.Gt{"GuestAccounts.ExpiresAt": 0}).
+		Where(where).
+		OrderBy("GuestAccounts.ExpiresAt ASC").
+		Limit(uint64(limit))
+
+	accounts := []*model.GuestAccount{}
+	if err := s.GetMasterX().SelectBuilder(&accounts, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find GuestAccounts")
+	}
+
+	return accounts, nil
+}
+
+func (s *SqlGuestAccountStore) GetExpiring(before int64, limit int) ([]*model.GuestAccount, error) {
+	return s.getActive(sq.And{
+		sq.LtOrEq{"GuestAccounts.ExpiresAt": before},
+		sq.Eq{"GuestAccounts.ExpiryNotifiedAt": 0},
+	}, limit)
+}
+
+func (s *SqlGuestAccountStore) GetExpired(now int64, limit int) ([]*model.GuestAccount, error) {
+	return s.getActive(sq.LtOrEq{"GuestAccounts.ExpiresAt": now}, limit)
+}
+
+func (s *SqlGuestAccountStore) GetUntrackedGuestIds(limit int) ([]string, error) {
+	query := s.getQueryBuilder().
+		Select("Users.Id").
+		From("Users").
+		LeftJoin("GuestAccounts ON GuestAccounts.UserId = Users.Id").
+		Where(sq.Like{"Users.Roles": "%" + model.SystemGuestRoleId + "%"}).
+		Where(sq.Eq{"Users.DeleteAt": 0}).
+		Where(sq.Eq{"GuestAccounts.UserId": nil}).
+		OrderBy("Users.Id").
+		Limit(uint64(limit))
+
+	userIDs := []string{}
+	if err := s.GetMasterX().SelectBuilder(&userIDs, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find guests without a GuestAccount")
+	}
+
+	return userIDs, nil
+}
+
+func (s *SqlGuestAccountStore) PermanentDeleteByUser(userID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("GuestAccounts").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_guest_account_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete GuestAccount with userId=%s", userID)
+	}
+
+	return nil
+}
+
+func (s *SqlGuestAccountStore) SavePendingInvite(invite *model.PendingGuestInvite) (*model.PendingGuestInvite, error) {
+	invite.PreSave()
+	if err := invite.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("PendingGuestInvites").
+		Columns("Id", "CreateAt", "TeamId", "SenderId", "SponsorId", "Emails", "Channels", "Message").
+		Values(invite.Id, invite.CreateAt, invite.TeamId, invite.SenderId, invite.SponsorId, invite.Emails, invite.Channels, invite.Message).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_pending_guest_invite_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PendingGuestInvite with id=%s", invite.Id)
+	}
+
+	return invite, nil
+}
+
+func (s *SqlGuestAccountStore) pendingInviteSelectQuery() sq.SelectBuilder {
+	return s.getQueryBuilder().
+		Select("Id", "CreateAt", "TeamId", "SenderId", "SponsorId", "Emails", "Channels", "Message").
+		From("PendingGuestInvites")
+}
+
+func (s *SqlGuestAccountStore) GetPendingInvite(id string) (*model.PendingGuestInvite, error) {
+	query := s.pendingInviteSelectQuery().Where(sq.Eq{"Id": id})
+
+	var invite model.PendingGuestInvite
+	if err := s.GetMasterX().GetBuilder(&invite, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PendingGuestInvite", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get PendingGuestInvite with id=%s", id)
+	}
+
+	return &invite, nil
+}
+
+func (s *SqlGuestAccountStore) GetPendingInvites(offset, limit int) ([]*model.PendingGuestInvite, error) {
+	query := s.pendingInviteSelectQuery().
+		OrderBy("CreateAt ASC", "Id ASC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	invites := []*model.PendingGuestInvite{}
+	if err := s.GetReplicaX().SelectBuilder(&invites, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find PendingGuestInvites")
+	}
+
+	return invites, nil
+}
+
+func (s *SqlGuestAccountStore) DeletePendingInvite(id string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("PendingGuestInvites").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_pending_guest_invite_tosql")
+	}
+
+	res, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PendingGuestInvite with id=%s", id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("PendingGuestInvite", id)
+	}
+
+	return nil
+}
@@ -32,18 +32,27 @@ func newSqlJobStore(sqlStore *SqlStore) store.JobStore {
 	return &SqlJobStore{sqlStore}
 }
 
-func (jss SqlJobStore) Save(job *model.Job) (*model.Job, error) {
+// jobInsertQuery returns the query inserting the given job.
+func (ss *SqlStore) jobInsertQuery(job *model.Job) (sq.InsertBuilder, error) {
 	jsonData, err := json.Marshal(job.Data)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed marshalling job data")
+		return sq.InsertBuilder{}, errors.Wrap(err, "failed marshalling job data")
 	}
-	if jss.IsBinaryParamEnabled() {
+	if ss.IsBinaryParamEnabled() {
 		jsonData = AppendBinaryFlag(jsonData)
 	}
-	query := jss.getQueryBuilder().
+
+	return ss.getQueryBuilder().
 		Insert("Jobs").
 		Columns("Id", "Type", "Priority", "CreateAt", "StartAt", "LastActivityAt", "Status", "Progress", "Data").
-		Values(job.Id, job.Type, job.Priority, job.CreateAt, job.StartAt, job.LastActivityAt, job.Status, job.Progress, jsonData)
+		Values(job.Id, job.Type, job.Priority, job.CreateAt, job.StartAt, job.LastActivityAt, job.Status, job.Progress, jsonData), nil
+}
+
+func (jss SqlJobStore) Save(job *model.Job) (*model.Job, error) {
+	query, err := jss.jobInsertQuery(job)
+	if err != nil {
+		return nil, err
+	}
 
 	queryString, args, err := query.ToSql()
 	if err != nil {
@@ -0,0 +1,104 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlLoginFingerprintStore struct {
+	*SqlStore
+}
+
+func newSqlLoginFingerprintStore(sqlStore *SqlStore) store.LoginFingerprintStore {
+	return &SqlLoginFingerprintStore{sqlStore}
+}
+
+func (s *SqlLoginFingerprintStore) Get(userID, fingerprintType, value string) (*model.LoginFingerprint, error) {
+	query := s.getQueryBuilder().
+		Select("UserId", "Type", "Value", "CreateAt", "LastSeenAt").
+		From("LoginFingerprints").
+		Where(sq.Eq{
+			"UserId": userID,
+			"Type":   fingerprintType,
+			"Value":  value,
+		})
+
+	var fingerprint model.LoginFingerprint
+	if err := s.GetMasterX().GetBuilder(&fingerprint, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("LoginFingerprint", userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get LoginFingerprint with userId=%s", userID)
+	}
+
+	return &fingerprint, nil
+}
+
+// Save inserts the fingerprint. If the user already has a matching fingerprint,
+// only its LastSeenAt is updated.
+func (s *SqlLoginFingerprintStore) Save(fingerprint *model.LoginFingerprint) (*model.LoginFingerprint, error) {
+	fingerprint.PreSave()
+	if err := fingerprint.IsValid(); err != nil {
+		return nil, err
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("LoginFingerprints").
+		Columns("UserId", "Type", "Value", "CreateAt", "LastSeenAt").
+		Values(fingerprint.UserId, fingerprint.Type, fingerprint.Value, fingerprint.CreateAt, fingerprint.LastSeenAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		builder = builder.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE LastSeenAt = ?", fingerprint.LastSeenAt))
+	} else {
+		builder = builder.SuffixExpr(sq.Expr("ON CONFLICT (UserId, Type, Value) DO UPDATE SET LastSeenAt = ?", fingerprint.LastSeenAt))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_login_fingerprint_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to save LoginFingerprint")
+	}
+
+	return fingerprint, nil
+}
+
+func (s *SqlLoginFingerprintStore) CountForUser(userID string) (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("LoginFingerprints").
+		Where(sq.Eq{"UserId": userID})
+
+	var count int64
+	if err := s.GetMasterX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to count LoginFingerprints with userId=%s", userID)
+	}
+
+	return count, nil
+}
+
+func (s *SqlLoginFingerprintStore) PermanentDeleteByUser(userID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("LoginFingerprints").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_login_fingerprints_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete LoginFingerprints with userId=%s", userID)
+	}
+
+	return nil
+}
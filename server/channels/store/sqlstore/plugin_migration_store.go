@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"context"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlPluginMigrationStore struct {
+	*SqlStore
+}
+
+func newSqlPluginMigrationStore(sqlStore *SqlStore) store.PluginMigrationStore {
+	return &SqlPluginMigrationStore{SqlStore: sqlStore}
+}
+
+func (s *SqlPluginMigrationStore) GetAll(pluginID string) ([]*model.PluginMigration, error) {
+	query := s.getQueryBuilder().
+		Select("PluginId", "Version", "Name", "Down", "AppliedAt").
+		From("PluginMigrations").
+		Where(sq.Eq{"PluginId": pluginID}).
+		OrderBy("Version ASC")
+
+	migrations := []*model.PluginMigration{}
+	if err := s.GetMasterX().SelectBuilder(&migrations, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get PluginMigrations with pluginId=%s", pluginID)
+	}
+
+	return migrations, nil
+}
+
+// Apply runs the migration and records it in a single transaction. Note that MySQL implicitly
+// commits most schema changes, so a failing migration may leave partial changes behind there.
+func (s *SqlPluginMigrationStore) Apply(migration *model.PluginMigration) (err error) {
+	migration.PreSave()
+	if appErr := migration.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().
+		Insert("PluginMigrations").
+		Columns("PluginId", "Version", "Name", "Down", "AppliedAt").
+		Values(migration.PluginId, migration.Version, migration.Name, migration.Down, migration.AppliedAt)); err != nil {
+		if IsUniqueConstraintError(err, []string{"PRIMARY", "pluginmigrations_pkey"}) {
+			return store.NewErrConflict("PluginMigration", err, "pluginId="+migration.PluginId)
+		}
+		return errors.Wrapf(err, "failed to save PluginMigration with pluginId=%s", migration.PluginId)
+	}
+
+	// Run the plugin's SQL verbatim, and without the query timeout since schema changes may take a
+	// while.
+	if _, err = transaction.Tx.ExecContext(context.Background(), migration.Up); err != nil {
+		return errors.Wrapf(err, "failed to apply migration %d of plugin %s", migration.Version, migration.PluginId)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlPluginMigrationStore) Rollback(migration *model.PluginMigration) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if migration.Down != "" {
+		if _, err = transaction.Tx.ExecContext(context.Background(), migration.Down); err != nil {
+			return errors.Wrapf(err, "failed to roll back migration %d of plugin %s", migration.Version, migration.PluginId)
+		}
+	}
+
+	result, err := transaction.ExecBuilder(s.getQueryBuilder().
+		Delete("PluginMigrations").
+		Where(sq.Eq{"PluginId": migration.PluginId, "Version": migration.Version}))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete PluginMigration with pluginId=%s", migration.PluginId)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("PluginMigration", migration.PluginId)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
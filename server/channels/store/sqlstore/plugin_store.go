@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	sq "github.com/mattermost/squirrel"
 	"github.com/pkg/errors"
@@ -42,17 +43,7 @@ func (ps SqlPluginStore) SaveOrUpdate(kv *model.PluginKeyValue) (*model.PluginKe
 		return kv, nil
 	}
 
-	query := ps.getQueryBuilder().
-		Insert("PluginKeyValueStore").
-		Columns("PluginId", "PKey", "PValue", "ExpireAt").
-		Values(kv.PluginId, kv.Key, kv.Value, kv.ExpireAt)
-	if ps.DriverName() == model.DatabaseDriverPostgres {
-		query = query.SuffixExpr(sq.Expr("ON CONFLICT (pluginid, pkey) DO UPDATE SET PValue = ?, ExpireAt = ?", kv.Value, kv.ExpireAt))
-	} else if ps.DriverName() == model.DatabaseDriverMysql {
-		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE PValue = ?, ExpireAt = ?", kv.Value, kv.ExpireAt))
-	}
-
-	queryString, args, err := query.ToSql()
+	queryString, args, err := ps.upsertQuery(kv, model.GetMillis()).ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "plugin_tosql")
 	}
@@ -64,6 +55,22 @@ func (ps SqlPluginStore) SaveOrUpdate(kv *model.PluginKeyValue) (*model.PluginKe
 	return kv, nil
 }
 
+// upsertQuery builds an insert of the given key value, replacing the value and expiry
+// of an existing key while preserving its creation time.
+func (ps SqlPluginStore) upsertQuery(kv *model.PluginKeyValue, now int64) sq.InsertBuilder {
+	query := ps.getQueryBuilder().
+		Insert("PluginKeyValueStore").
+		Columns("PluginId", "PKey", "PValue", "ExpireAt", "CreateAt", "UpdateAt").
+		Values(kv.PluginId, kv.Key, kv.Value, kv.ExpireAt, now, now)
+	if ps.DriverName() == model.DatabaseDriverPostgres {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (pluginid, pkey) DO UPDATE SET PValue = ?, ExpireAt = ?, UpdateAt = ?", kv.Value, kv.ExpireAt, now))
+	} else if ps.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE PValue = ?, ExpireAt = ?, UpdateAt = ?", kv.Value, kv.ExpireAt, now))
+	}
+
+	return query
+}
+
 func (ps SqlPluginStore) CompareAndSet(kv *model.PluginKeyValue, oldValue []byte) (bool, error) {
 	if err := kv.IsValid(); err != nil {
 		return false, err
@@ -93,10 +100,11 @@ func (ps SqlPluginStore) CompareAndSet(kv *model.PluginKeyValue, oldValue []byte
 		}
 
 		// Insert if oldValue is nil
+		now := model.GetMillis()
 		queryString, args, err = ps.getQueryBuilder().
 			Insert("PluginKeyValueStore").
-			Columns("PluginId", "PKey", "PValue", "ExpireAt").
-			Values(kv.PluginId, kv.Key, kv.Value, kv.ExpireAt).ToSql()
+			Columns("PluginId", "PKey", "PValue", "ExpireAt", "CreateAt", "UpdateAt").
+			Values(kv.PluginId, kv.Key, kv.Value, kv.ExpireAt, now, now).ToSql()
 		if err != nil {
 			return false, errors.Wrap(err, "plugin_tosql")
 		}
@@ -118,6 +126,7 @@ func (ps SqlPluginStore) CompareAndSet(kv *model.PluginKeyValue, oldValue []byte
 			Update("PluginKeyValueStore").
 			Set("PValue", kv.Value).
 			Set("ExpireAt", kv.ExpireAt).
+			Set("UpdateAt", currentTime).
 			Where(sq.Eq{"PluginId": kv.PluginId}).
 			Where(sq.Eq{"PKey": kv.Key}).
 			Where(sq.Eq{"PValue": oldValue}).
@@ -355,3 +364,199 @@ func (ps SqlPluginStore) List(pluginId string, offset int, limit int) ([]string,
 
 	return keys, nil
 }
+
+func (ps SqlPluginStore) ListWithOptions(pluginId string, options model.PluginKVListOptions) ([]string, error) {
+	options.SetDefaults()
+
+	query := ps.getQueryBuilder().
+		Select("PKey").
+		From("PluginKeyValueStore").
+		Where(sq.Eq{"PluginId": pluginId}).
+		Where(sq.Or{
+			sq.Eq{"ExpireAt": int(0)},
+			sq.Gt{"ExpireAt": model.GetMillis()},
+		}).
+		OrderBy("PKey").
+		Limit(uint64(options.PerPage))
+
+	if options.Prefix != "" {
+		query = query.Where(sq.Like{"PKey": escapeLikePrefix(options.Prefix) + "%"})
+	}
+	if options.Cursor != "" {
+		query = query.Where(sq.Gt{"PKey": options.Cursor})
+	}
+	if options.EndKey != "" {
+		query = query.Where(sq.Lt{"PKey": options.EndKey})
+	}
+
+	keys := []string{}
+	if err := ps.GetReplicaX().SelectBuilder(&keys, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to list PluginKeyValues with pluginId=%s", pluginId)
+	}
+
+	return keys, nil
+}
+
+// escapeLikePrefix escapes the LIKE wildcards in a key prefix, so that they are matched literally.
+func escapeLikePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix)
+}
+
+func (ps SqlPluginStore) GetMulti(pluginId string, keys []string) ([]*model.PluginKeyValue, error) {
+	kvs := []*model.PluginKeyValue{}
+	if len(keys) == 0 {
+		return kvs, nil
+	}
+
+	query := ps.getQueryBuilder().
+		Select("PluginId", "PKey", "PValue", "ExpireAt").
+		From("PluginKeyValueStore").
+		Where(sq.Eq{"PluginId": pluginId}).
+		Where(sq.Eq{"PKey": keys}).
+		Where(sq.Or{
+			sq.Eq{"ExpireAt": int(0)},
+			sq.Gt{"ExpireAt": model.GetMillis()},
+		})
+
+	if err := ps.GetReplicaX().SelectBuilder(&kvs, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get PluginKeyValues with pluginId=%s", pluginId)
+	}
+
+	return kvs, nil
+}
+
+func (ps SqlPluginStore) GetMetadata(pluginId, key string) (*model.PluginKVMetadata, error) {
+	query := ps.getQueryBuilder().
+		Select("PKey", "CreateAt", "UpdateAt", "ExpireAt").
+		From("PluginKeyValueStore").
+		Where(sq.Eq{"PluginId": pluginId}).
+		Where(sq.Eq{"PKey": key}).
+		Where(sq.Or{
+			sq.Eq{"ExpireAt": int(0)},
+			sq.Gt{"ExpireAt": model.GetMillis()},
+		})
+
+	var metadata model.PluginKVMetadata
+	if err := ps.GetReplicaX().GetBuilder(&metadata, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PluginKeyValue", fmt.Sprintf("pluginId=%s, key=%s", pluginId, key))
+		}
+		return nil, errors.Wrapf(err, "failed to get PluginKeyValue metadata with pluginId=%s and key=%s", pluginId, key)
+	}
+
+	return &metadata, nil
+}
+
+func (ps SqlPluginStore) Batch(pluginId string, ops []*model.PluginKVBatchOperation) (_ bool, err error) {
+	if appErr := model.IsValidPluginKVBatch(ops); appErr != nil {
+		return false, appErr
+	}
+
+	kvs := make([]*model.PluginKeyValue, 0, len(ops))
+	for _, op := range ops {
+		kv, appErr := model.NewPluginKeyValueFromOptions(pluginId, op.Key, op.Value, op.Options)
+		if appErr != nil {
+			return false, appErr
+		}
+		if appErr = kv.IsValid(); appErr != nil {
+			return false, appErr
+		}
+		kvs = append(kvs, kv)
+	}
+
+	transaction, err := ps.GetMasterX().Beginx()
+	if err != nil {
+		return false, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	now := model.GetMillis()
+	for i, op := range ops {
+		applied, err := ps.applyBatchOperation(transaction, kvs[i], op.Options, now)
+		if err != nil {
+			return false, err
+		}
+		if !applied {
+			return false, nil
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return false, errors.Wrap(err, "commit_transaction")
+	}
+
+	return true, nil
+}
+
+// applyBatchOperation writes a single batch operation within the given transaction,
+// honouring the same compare and set semantics as CompareAndSet and CompareAndDelete.
+func (ps SqlPluginStore) applyBatchOperation(transaction *sqlxTxWrapper, kv *model.PluginKeyValue, opt model.PluginKVSetOptions, now int64) (bool, error) {
+	if opt.Atomic && opt.OldValue == nil {
+		if kv.Value == nil {
+			// nil can't be stored, so there is nothing to compare with.
+			return false, nil
+		}
+
+		// Delete any existing, expired value, then insert only if the key is absent.
+		if _, err := transaction.ExecBuilder(ps.getQueryBuilder().
+			Delete("PluginKeyValueStore").
+			Where(sq.Eq{"PluginId": kv.PluginId}).
+			Where(sq.Eq{"PKey": kv.Key}).
+			Where(sq.NotEq{"ExpireAt": int(0)}).
+			Where(sq.Lt{"ExpireAt": now})); err != nil {
+			return false, errors.Wrap(err, "failed to delete PluginKeyValue")
+		}
+
+		if _, err := transaction.ExecBuilder(ps.getQueryBuilder().
+			Insert("PluginKeyValueStore").
+			Columns("PluginId", "PKey", "PValue", "ExpireAt", "CreateAt", "UpdateAt").
+			Values(kv.PluginId, kv.Key, kv.Value, kv.ExpireAt, now, now)); err != nil {
+			if IsUniqueConstraintError(err, []string{"PRIMARY", "PluginId", "Key", "PKey", "pkey"}) {
+				return false, nil
+			}
+			return false, errors.Wrap(err, "failed to insert PluginKeyValue")
+		}
+
+		return true, nil
+	}
+
+	if opt.Atomic {
+		var current []byte
+		err := transaction.GetBuilder(&current, ps.getQueryBuilder().
+			Select("PValue").
+			From("PluginKeyValueStore").
+			Where(sq.Eq{"PluginId": kv.PluginId}).
+			Where(sq.Eq{"PKey": kv.Key}).
+			Where(sq.Or{
+				sq.Eq{"ExpireAt": int(0)},
+				sq.Gt{"ExpireAt": now},
+			}).
+			Suffix("FOR UPDATE"))
+		if err == sql.ErrNoRows {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Wrapf(err, "failed to get PluginKeyValue with pluginId=%s and key=%s", kv.PluginId, kv.Key)
+		}
+
+		if !bytes.Equal(current, opt.OldValue) {
+			return false, nil
+		}
+	}
+
+	if kv.Value == nil {
+		if _, err := transaction.ExecBuilder(ps.getQueryBuilder().
+			Delete("PluginKeyValueStore").
+			Where(sq.Eq{"PluginId": kv.PluginId}).
+			Where(sq.Eq{"PKey": kv.Key})); err != nil {
+			return false, errors.Wrapf(err, "failed to delete PluginKeyValue with pluginId=%s and key=%s", kv.PluginId, kv.Key)
+		}
+
+		return true, nil
+	}
+
+	if _, err := transaction.ExecBuilder(ps.upsertQuery(kv, now)); err != nil {
+		return false, errors.Wrap(err, "failed to upsert PluginKeyValue")
+	}
+
+	return true, nil
+}
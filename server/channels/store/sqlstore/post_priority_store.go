@@ -51,7 +51,7 @@ func (s *SqlPostPriorityStore) GetForPosts(postIds []string) ([]*model.PostPrior
 			Where(sq.Eq{"PostId": postIds[i:j]})
 
 		var priorityBatch []*model.PostPriority
-		err := s.GetReplicaX().SelectBuilder(&priority, query)
+		err := s.GetReplicaX().SelectBuilder(&priorityBatch, query)
 
 		if err != nil {
 			return nil, err
@@ -1963,6 +1963,87 @@ func (s *SqlPostStore) buildCreateDateFilterClause(params *model.SearchParams, b
 	return builder
 }
 
+// buildSearchModifiersClause filters the posts by the search modifiers such as
+// is:pinned, has:file or priority:urgent.
+func (s *SqlPostStore) buildSearchModifiersClause(userId string, params *model.SearchParams, builder sq.SelectBuilder) (sq.SelectBuilder, error) {
+	if params.IsPinned {
+		builder = builder.Where(sq.Eq{"IsPinned": true})
+	}
+
+	if params.IsRoot {
+		builder = builder.Where(sq.Eq{"RootId": ""})
+	}
+
+	if params.InThread {
+		builder = builder.Where(sq.NotEq{"RootId": ""})
+	}
+
+	if params.HasFile {
+		builder = builder.Where(sq.NotEq{"FileIds": []string{"", "[]"}})
+	}
+
+	if params.HasLink {
+		builder = builder.Where(sq.Or{
+			sq.Like{"LOWER(Message)": "%http://%"},
+			sq.Like{"LOWER(Message)": "%https://%"},
+		})
+	}
+
+	if params.HasReaction {
+		builder = builder.Where(sq.Eq{"HasReactions": true})
+	}
+
+	// Mentions are matched as model.MessageMentions parses them: the username must not be
+	// preceded by a word character, nor followed by one other than trailing punctuation.
+	regexpOperator := "~"
+	if s.DriverName() == model.DatabaseDriverMysql {
+		regexpOperator = "REGEXP"
+	}
+	for _, username := range params.Mentions {
+		pattern := "(^|[^a-z0-9_])@" + regexp.QuoteMeta(strings.ToLower(username)) + "[._:-]*([^a-z0-9._:-]|$)"
+		builder = builder.Where("LOWER(Message) "+regexpOperator+" ?", pattern)
+	}
+
+	var subQueries []sq.SelectBuilder
+
+	if params.IsFlagged {
+		subQueries = append(subQueries, s.getSubQueryBuilder().
+			Select("Name").
+			From("Preferences").
+			Where(sq.Eq{
+				"UserId":   userId,
+				"Category": model.PreferenceCategoryFlaggedPost,
+			}))
+	}
+
+	for _, emojiName := range params.ReactedEmojis {
+		subQueries = append(subQueries, s.getSubQueryBuilder().
+			Select("PostId").
+			From("Reactions").
+			Where(sq.Eq{
+				"EmojiName": emojiName,
+				"DeleteAt":  0,
+			}))
+	}
+
+	if len(params.Priorities) > 0 {
+		subQueries = append(subQueries, s.getSubQueryBuilder().
+			Select("PostId").
+			From("PostsPriority").
+			Where(sq.Eq{"Priority": params.Priorities}))
+	}
+
+	for _, subQuery := range subQueries {
+		subQueryClause, subQueryArgs, err := subQuery.ToSql()
+		if err != nil {
+			return builder, err
+		}
+		builder = builder.Where(fmt.Sprintf("Id IN (%s)", subQueryClause), subQueryArgs...)
+	}
+
+	return builder, nil
+}
+
 func (s *SqlPostStore) buildSearchTeamFilterClause(teamId string, builder sq.SelectBuilder) sq.SelectBuilder {
 	if teamId == "" {
 		return builder
@@ -2044,7 +2125,8 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 	if params.Terms == "" && params.ExcludedTerms == "" &&
 		len(params.InChannels) == 0 && len(params.ExcludedChannels) == 0 &&
 		len(params.FromUsers) == 0 && len(params.ExcludedUsers) == 0 &&
-		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" {
+		params.OnDate == "" && params.AfterDate == "" && params.BeforeDate == "" &&
+		!params.HasModifiers() {
 		return list, nil
 	}
 
@@ -2063,6 +2145,10 @@ func (s *SqlPostStore) search(teamId string, userId string, params *model.Search
 		return nil, errors.Wrap(err, "failed to build search post filter clause")
 	}
 	baseQuery = s.buildCreateDateFilterClause(params, baseQuery)
+	baseQuery, err = s.buildSearchModifiersClause(userId, params, baseQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build search modifiers clause")
+	}
 
 	termMap := map[string]bool{}
 	terms := params.Terms
@@ -2428,6 +2514,29 @@ func (s *SqlPostStore) GetPostsCreatedAt(channelId string, time int64) ([]*model
 	return posts, nil
 }
 
+func (s *SqlPostStore) GetPostsCreatedSince(channelID string, since, beforeTime int64, beforeID string, limit int) ([]*model.Post, error) {
+	query := s.getQueryBuilder().
+		Select("p.*, (SELECT count(*) FROM Posts WHERE Posts.RootId = (CASE WHEN p.RootId = '' THEN p.Id ELSE p.RootId END) AND Posts.DeleteAt = 0) as ReplyCount").
+		From("Posts p").
+		Where(sq.Eq{"p.ChannelId": channelID, "p.DeleteAt": 0}).
+		Where(sq.Gt{"p.CreateAt": since}).
+		OrderBy("p.CreateAt DESC", "p.Id DESC").
+		Limit(uint64(limit))
+
+	if beforeID != "" {
+		query = query.Where(sq.Or{
+			sq.Lt{"p.CreateAt": beforeTime},
+			sq.And{sq.Eq{"p.CreateAt": beforeTime}, sq.Lt{"p.Id": beforeID}},
+		})
+	}
+
+	posts := []*model.Post{}
+	if err := s.GetReplicaX().SelectBuilder(&posts, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Posts with channelId=%s", channelID)
+	}
+	return posts, nil
+}
+
 func (s *SqlPostStore) GetPostsByIds(postIds []string) ([]*model.Post, error) {
 	baseQuery := s.getQueryBuilder().Select("p.*, (SELECT count(*) FROM Posts WHERE Posts.RootId = (CASE WHEN p.RootId = '' THEN p.Id ELSE p.RootId END) AND Posts.DeleteAt = 0) as ReplyCount").
 		From("Posts p").
@@ -2647,20 +2756,40 @@ func (s *SqlPostStore) GetMaxPostSize() int {
 }
 
 func (s *SqlPostStore) GetParentsForExportAfter(limit int, afterId string, includeArchivedChannel bool) ([]*model.PostForExport, error) {
+	return s.getParentsForExportAfter("", limit, afterId, includeArchivedChannel)
+}
+
+func (s *SqlPostStore) GetParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.PostForExport, error) {
+	return s.getParentsForExportAfter(userID, limit, afterID, true)
+}
+
+// getParentsForExportAfter returns the root posts after afterId. If userID is set, only the
+// roots of the threads that the user started or replied to in the channels they are a member
+// of are returned.
+func (s *SqlPostStore) getParentsForExportAfter(userID string, limit int, afterId string, includeArchivedChannel bool) ([]*model.PostForExport, error) {
 	for {
+		rootIdsQuery := s.getQueryBuilder().
+			Select("Id").
+			From("Posts").
+			Where(sq.And{
+				sq.Gt{"Posts.Id": afterId},
+				sq.Eq{"Posts.RootId": ""},
+				sq.Eq{"Posts.DeleteAt": 0},
+			}).
+			OrderBy("Posts.Id").
+			Limit(uint64(limit))
+
+		if userID != "" {
+			// Threads of the channels the user left are left out, as they include the replies
+			// of other users the user can no longer read.
+			rootIdsQuery = rootIdsQuery.Where(sq.Or{
+				sq.Eq{"Posts.UserId": userID},
+				sq.Expr("EXISTS (SELECT 1 FROM Posts Replies WHERE Replies.RootId = Posts.Id AND Replies.UserId = ? AND Replies.DeleteAt = 0)", userID),
+			}).Where(sq.Expr("Posts.ChannelId IN (SELECT ChannelId FROM ChannelMembers WHERE UserId = ?)", userID))
+		}
+
 		rootIds := []string{}
-		err := s.GetReplicaX().Select(&rootIds,
-			`SELECT
-				Id
-			FROM
-				Posts
-			WHERE
-				Posts.Id > ?
-				AND Posts.RootId = ''
-				AND Posts.DeleteAt = 0
-			ORDER BY Posts.Id
-			LIMIT ?`,
-			afterId, limit)
+		err := s.GetReplicaX().SelectBuilder(&rootIds, rootIdsQuery)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to find Posts")
 		}
@@ -2746,6 +2875,16 @@ func (s *SqlPostStore) GetRepliesForExport(rootId string) ([]*model.ReplyForExpo
 }
 
 func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
+	return s.getDirectPostParentsForExportAfter("", limit, afterId, includeArchivedChannels)
+}
+
+func (s *SqlPostStore) GetDirectPostParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.DirectPostForExport, error) {
+	return s.getDirectPostParentsForExportAfter(userID, limit, afterID, true)
+}
+
+// getDirectPostParentsForExportAfter returns the root posts of direct and group channels after
+// afterId. If userID is set, only the channels that the user is a member of are considered.
+func (s *SqlPostStore) getDirectPostParentsForExportAfter(userID string, limit int, afterId string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error) {
 	aggFn := "COALESCE(json_agg(u1.username) FILTER (WHERE u1.username IS NOT NULL), '[]')"
 	if s.DriverName() == model.DatabaseDriverMysql {
 		aggFn = "IF (COUNT(u1.Username) = 0, JSON_ARRAY(), JSON_ARRAYAGG(u1.Username))"
@@ -2775,6 +2914,10 @@ func (s *SqlPostStore) GetDirectPostParentsForExportAfter(limit int, afterId str
 		)
 	}
 
+	if userID != "" {
+		query = query.Where(sq.Expr("p.ChannelId IN (SELECT ChannelId FROM ChannelMembers WHERE UserId = ?)", userID))
+	}
+
 	queryString, args, err := query.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "post_tosql")
@@ -2871,7 +3014,52 @@ func (s *SqlPostStore) SearchPostsForUser(rctx request.CTX, paramsList []*model.
 
 	posts.SortByCreateAt()
 
-	return model.MakePostSearchResults(posts, nil), nil
+	results := model.MakePostSearchResults(posts, nil)
+
+	// The database returns all the matching posts at once, so their facets can be counted here.
+	if paramsList[0].IncludeFacets {
+		fileExtensions, err := s.getFileExtensionsForPosts(posts)
+		if err != nil {
+			return nil, err
+		}
+		results.Facets = model.CountPostSearchFacets(posts, fileExtensions, model.GetMillis())
+	}
+
+	return results, nil
+}
+
+// getFileExtensionsForPosts returns the distinct extensions of the files of the posts, by post id.
+func (s *SqlPostStore) getFileExtensionsForPosts(posts *model.PostList) (map[string][]string, error) {
+	postIds := []string{}
+	for _, post := range posts.Posts {
+		if len(post.FileIds) > 0 {
+			postIds = append(postIds, post.Id)
+		}
+	}
+
+	fileExtensions := map[string][]string{}
+	if len(postIds) == 0 {
+		return fileExtensions, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select("DISTINCT PostId, Extension").
+		From("FileInfo").
+		Where(sq.Eq{"PostId": postIds, "DeleteAt": 0}).
+		Where(sq.NotEq{"Extension": ""})
+
+	var rows []struct {
+		PostId    string
+		Extension string
+	}
+	if err := s.GetReplicaX().SelectBuilder(&rows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get file extensions of posts")
+	}
+
+	for _, row := range rows {
+		fileExtensions[row.PostId] = append(fileExtensions[row.PostId], row.Extension)
+	}
+	return fileExtensions, nil
 }
 
 func (s *SqlPostStore) GetOldestEntityCreationTime() (int64, error) {
@@ -3298,3 +3486,65 @@ func (s *SqlPostStore) GetPostReminderMetadata(postID string) (*store.PostRemind
 
 	return meta, nil
 }
+
+func (s *SqlPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	query := s.getQueryBuilder().
+		Select("pr.PostId", "pr.UserId", "pr.TargetTime").
+		From("PostReminders pr").
+		InnerJoin("Posts p ON p.Id = pr.PostId").
+		InnerJoin("ChannelMembers cm ON cm.ChannelId = p.ChannelId AND cm.UserId = pr.UserId").
+		Where(sq.Eq{"pr.UserId": userID}).
+		OrderBy("pr.TargetTime", "pr.PostId")
+
+	reminders := []*model.PostReminder{}
+	if err := s.GetReplicaX().SelectBuilder(&reminders, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get post reminders for userId=%s", userID)
+	}
+
+	return reminders, nil
+}
+
+func (s *SqlPostStore) DeletePostReminder(postID, userID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostReminders").
+		Where(sq.Eq{"PostId": postID, "UserId": userID})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete post reminder with postId=%s", postID)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("PostReminder", postID)
+	}
+
+	return nil
+}
+
+func (s *SqlPostStore) DeletePostRemindersForPost(postID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostReminders").
+		Where(sq.Eq{"PostId": postID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete post reminders with postId=%s", postID)
+	}
+
+	return nil
+}
+
+func (s *SqlPostStore) DeleteUserPostRemindersForChannel(userID, channelID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostReminders").
+		Where(sq.Eq{"UserId": userID}).
+		Where(sq.Expr("PostId IN (SELECT Id FROM Posts WHERE ChannelId = ?)", channelID))
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete post reminders with userId=%s and channelId=%s", userID, channelID)
+	}
+
+	return nil
+}
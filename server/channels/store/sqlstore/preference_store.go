@@ -139,6 +139,28 @@ func (s SqlPreferenceStore) Get(userId string, category string, name string) (*m
 	return &preference, nil
 }
 
+func (s SqlPreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
+	preferences := model.Preferences{}
+	if len(userIDs) == 0 {
+		return preferences, nil
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Preferences").
+		Where(sq.Eq{"UserId": userIDs}).
+		Where(sq.Eq{"Category": category}).
+		Where(sq.Eq{"Name": name}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get preferences")
+	}
+	if err = s.GetReplicaX().Select(&preferences, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find Preferences with category=%s, name=%s", category, name)
+	}
+	return preferences, nil
+}
+
 func (s SqlPreferenceStore) GetCategoryAndName(category string, name string) (model.Preferences, error) {
 	var preferences model.Preferences
 	query, args, err := s.getQueryBuilder().
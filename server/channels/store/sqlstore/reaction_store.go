@@ -102,6 +102,25 @@ func (s *SqlReactionStore) GetForPost(postId string, allowFromCache bool) ([]*mo
 	return reactions, nil
 }
 
+// GetForUser returns the reactions made by the given user, oldest first.
+func (s *SqlReactionStore) GetForUser(userID string, offset, limit int) ([]*model.Reaction, error) {
+	builder := s.getQueryBuilder().
+		Select("UserId", "PostId", "EmojiName", "CreateAt", "COALESCE(UpdateAt, CreateAt) As UpdateAt",
+			"COALESCE(DeleteAt, 0) As DeleteAt", "RemoteId", "ChannelId").
+		From("Reactions").
+		Where(sq.Eq{"UserId": userID}).
+		Where(sq.Eq{"COALESCE(DeleteAt, 0)": 0}).
+		OrderBy("CreateAt", "PostId", "EmojiName").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	reactions := []*model.Reaction{}
+	if err := s.GetReplicaX().SelectBuilder(&reactions, builder); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Reactions with userId=%s", userID)
+	}
+	return reactions, nil
+}
+
 func (s *SqlReactionStore) ExistsOnPost(postId string, emojiName string) (bool, error) {
 	query := s.getQueryBuilder().
 		Select("1").
@@ -0,0 +1,253 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// dbSavedSearch is a saved search with its search parameters serialized as JSON.
+type dbSavedSearch struct {
+	Id         string
+	UserId     string
+	TeamId     string
+	Name       string
+	Terms      string
+	IsOrSearch bool
+	Params     string
+	Alert      bool
+	CreateAt   int64
+	UpdateAt   int64
+}
+
+func (s *dbSavedSearch) toModel() (*model.SavedSearch, error) {
+	search := &model.SavedSearch{
+		Id:         s.Id,
+		UserId:     s.UserId,
+		TeamId:     s.TeamId,
+		Name:       s.Name,
+		Terms:      s.Terms,
+		IsOrSearch: s.IsOrSearch,
+		Alert:      s.Alert,
+		CreateAt:   s.CreateAt,
+		UpdateAt:   s.UpdateAt,
+	}
+	if err := json.Unmarshal([]byte(s.Params), &search.Params); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal params of SavedSearch with id=%s", s.Id)
+	}
+	if len(search.Params) > 0 {
+		search.TimeZoneOffset = search.Params[0].TimeZoneOffset
+	}
+
+	return search, nil
+}
+
+type SqlSavedSearchStore struct {
+	*SqlStore
+
+	savedSearchSelectQuery sq.SelectBuilder
+}
+
+func newSqlSavedSearchStore(sqlStore *SqlStore) store.SavedSearchStore {
+	s := &SqlSavedSearchStore{SqlStore: sqlStore}
+
+	s.savedSearchSelectQuery = s.getQueryBuilder().
+		Select(
+			"SavedSearches.Id",
+			"SavedSearches.UserId",
+			"SavedSearches.TeamId",
+			"SavedSearches.Name",
+			"SavedSearches.Terms",
+			"SavedSearches.IsOrSearch",
+			"SavedSearches.Params",
+			"SavedSearches.Alert",
+			"SavedSearches.CreateAt",
+			"SavedSearches.UpdateAt",
+		).
+		From("SavedSearches")
+
+	return s
+}
+
+func (s *SqlSavedSearchStore) Save(search *model.SavedSearch) (*model.SavedSearch, error) {
+	search.PreSave()
+	if err := search.IsValid(); err != nil {
+		return nil, err
+	}
+
+	params, err := json.Marshal(search.Params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal params")
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("SavedSearches").
+		Columns("Id", "UserId", "TeamId", "Name", "Terms", "IsOrSearch", "Params", "Alert", "CreateAt", "UpdateAt").
+		Values(search.Id, search.UserId, search.TeamId, search.Name, search.Terms, search.IsOrSearch, string(params), search.Alert, search.CreateAt, search.UpdateAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_saved_search_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save SavedSearch with id=%s", search.Id)
+	}
+
+	return search, nil
+}
+
+func (s *SqlSavedSearchStore) Get(id string) (*model.SavedSearch, error) {
+	query := s.savedSearchSelectQuery.Where(sq.Eq{"SavedSearches.Id": id})
+
+	var search dbSavedSearch
+	if err := s.GetReplicaX().GetBuilder(&search, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("SavedSearch", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get SavedSearch with id=%s", id)
+	}
+
+	return search.toModel()
+}
+
+func (s *SqlSavedSearchStore) getMany(query sq.SelectBuilder) ([]*model.SavedSearch, error) {
+	var dbSearches []*dbSavedSearch
+	if err := s.GetReplicaX().SelectBuilder(&dbSearches, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find SavedSearches")
+	}
+
+	searches := make([]*model.SavedSearch, 0, len(dbSearches))
+	for _, dbSearch := range dbSearches {
+		search, err := dbSearch.toModel()
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, search)
+	}
+
+	return searches, nil
+}
+
+func (s *SqlSavedSearchStore) GetForUser(userID string) ([]*model.SavedSearch, error) {
+	return s.getMany(s.savedSearchSelectQuery.
+		Where(sq.Eq{"SavedSearches.UserId": userID}).
+		OrderBy("SavedSearches.Name", "SavedSearches.Id"))
+}
+
+func (s *SqlSavedSearchStore) CountForUser(userID string) (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("SavedSearches").
+		Where(sq.Eq{"UserId": userID})
+
+	var count int64
+	if err := s.GetReplicaX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to count SavedSearches with userId=%s", userID)
+	}
+
+	return count, nil
+}
+
+func (s *SqlSavedSearchStore) GetAlertsForChannel(channelID, teamID string) ([]*model.SavedSearch, error) {
+	return s.getMany(s.savedSearchSelectQuery.
+		Join("ChannelMembers ON ChannelMembers.UserId = SavedSearches.UserId").
+		Join("Users ON Users.Id = SavedSearches.UserId").
+		Where(sq.Eq{
+			"ChannelMembers.ChannelId": channelID,
+			"SavedSearches.Alert":      true,
+			"SavedSearches.TeamId":     []string{"", teamID},
+			"Users.DeleteAt":           0,
+		}))
+}
+
+func (s *SqlSavedSearchStore) Update(search *model.SavedSearch) (*model.SavedSearch, error) {
+	search.PreUpdate()
+	if err := search.IsValid(); err != nil {
+		return nil, err
+	}
+
+	params, err := json.Marshal(search.Params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal params")
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("SavedSearches").
+		SetMap(map[string]any{
+			"Name":       search.Name,
+			"Terms":      search.Terms,
+			"IsOrSearch": search.IsOrSearch,
+			"Params":     string(params),
+			"Alert":      search.Alert,
+			"UpdateAt":   search.UpdateAt,
+		}).
+		Where(sq.Eq{"Id": search.Id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "update_saved_search_tosql")
+	}
+
+	res, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update SavedSearch with id=%s", search.Id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("SavedSearch", search.Id)
+	}
+
+	return search, nil
+}
+
+func (s *SqlSavedSearchStore) Delete(id string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("SavedSearches").
+		Where(sq.Eq{"Id": id}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_saved_search_tosql")
+	}
+
+	res, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete SavedSearch with id=%s", id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("SavedSearch", id)
+	}
+
+	return nil
+}
+
+func (s *SqlSavedSearchStore) PermanentDeleteByUser(userID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("SavedSearches").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_saved_searches_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete SavedSearches with userId=%s", userID)
+	}
+
+	return nil
+}
@@ -111,6 +111,13 @@ type SqlStoreStores struct {
 	postPersistentNotification store.PostPersistentNotificationStore
 	desktopTokens              store.DesktopTokensStore
 	channelBookmarks           store.ChannelBookmarkStore
+	loginFingerprint           store.LoginFingerprintStore
+	auditEvent                 store.AuditEventStore
+	guestAccount               store.GuestAccountStore
+	userDataExport             store.UserDataExportStore
+	pluginMigration            store.PluginMigrationStore
+	savedSearch                store.SavedSearchStore
+	translation                store.TranslationStore
 }
 
 type SqlStore struct {
@@ -236,6 +243,13 @@ func New(settings model.SqlSettings, logger mlog.LoggerIFace, metrics einterface
 	store.stores.postPersistentNotification = newSqlPostPersistentNotificationStore(store)
 	store.stores.desktopTokens = newSqlDesktopTokensStore(store, metrics)
 	store.stores.channelBookmarks = newSqlChannelBookmarkStore(store)
+	store.stores.loginFingerprint = newSqlLoginFingerprintStore(store)
+	store.stores.auditEvent = newSqlAuditEventStore(store)
+	store.stores.guestAccount = newSqlGuestAccountStore(store)
+	store.stores.userDataExport = newSqlUserDataExportStore(store)
+	store.stores.pluginMigration = newSqlPluginMigrationStore(store)
+	store.stores.savedSearch = newSqlSavedSearchStore(store)
+	store.stores.translation = newSqlTranslationStore(store)
 
 	store.stores.preference.(*SqlPreferenceStore).deleteUnusedFeatures()
 
@@ -1039,6 +1053,34 @@ func (ss *SqlStore) ChannelBookmark() store.ChannelBookmarkStore {
 	return ss.stores.channelBookmarks
 }
 
+func (ss *SqlStore) LoginFingerprint() store.LoginFingerprintStore {
+	return ss.stores.loginFingerprint
+}
+
+func (ss *SqlStore) AuditEvent() store.AuditEventStore {
+	return ss.stores.auditEvent
+}
+
+func (ss *SqlStore) GuestAccount() store.GuestAccountStore {
+	return ss.stores.guestAccount
+}
+
+func (ss *SqlStore) UserDataExport() store.UserDataExportStore {
+	return ss.stores.userDataExport
+}
+
+func (ss *SqlStore) PluginMigration() store.PluginMigrationStore {
+	return ss.stores.pluginMigration
+}
+
+func (ss *SqlStore) SavedSearch() store.SavedSearchStore {
+	return ss.stores.savedSearch
+}
+
+func (ss *SqlStore) Translation() store.TranslationStore {
+	return ss.stores.translation
+}
+
 func (ss *SqlStore) DropAllTables() {
 	if ss.DriverName() == model.DatabaseDriverPostgres {
 		ss.masterX.Exec(`DO
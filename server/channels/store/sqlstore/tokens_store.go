@@ -85,6 +85,25 @@ func (s SqlTokenStore) GetAllTokensByType(tokenType string) ([]*model.Token, err
 	return tokens, nil
 }
 
+func (s SqlTokenStore) GetTokensByTypeAndExtraPrefix(tokenType string, extraPrefix string, since int64) ([]*model.Token, error) {
+	tokens := []*model.Token{}
+	query, args, err := s.getQueryBuilder().
+		Select("*").
+		From("Tokens").
+		Where(sq.Eq{"Type": tokenType}).
+		Where(sq.GtOrEq{"CreateAt": since}).
+		Where("Extra LIKE ? ESCAPE '*'", sanitizeSearchTerm(extraPrefix, "*")+"%").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get tokens by type and extra prefix")
+	}
+
+	if err := s.GetReplicaX().Select(&tokens, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get tokens of Type=%s", tokenType)
+	}
+	return tokens, nil
+}
+
 func (s SqlTokenStore) RemoveAllTokensByType(tokenType string) error {
 	if _, err := s.GetMasterX().Exec("DELETE FROM Tokens WHERE Type = ?", tokenType); err != nil {
 		return errors.Wrapf(err, "failed to remove all Tokens with Type=%s", tokenType)
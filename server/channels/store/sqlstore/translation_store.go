@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlTranslationStore struct {
+	*SqlStore
+
+	postTranslationSelectQuery sq.SelectBuilder
+}
+
+func newSqlTranslationStore(sqlStore *SqlStore) store.TranslationStore {
+	s := &SqlTranslationStore{SqlStore: sqlStore}
+
+	s.postTranslationSelectQuery = s.getQueryBuilder().
+		Select(
+			"PostTranslations.PostId",
+			"PostTranslations.Locale",
+			"PostTranslations.PostEditAt",
+			"PostTranslations.Message",
+			"PostTranslations.SourceLanguage",
+			"PostTranslations.Provider",
+			"PostTranslations.CreateAt",
+		).
+		From("PostTranslations")
+
+	return s
+}
+
+func (s *SqlTranslationStore) SavePostTranslation(translation *model.PostTranslation) (*model.PostTranslation, error) {
+	translation.PreSave()
+	if err := translation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("PostTranslations").
+		Columns("PostId", "Locale", "PostEditAt", "Message", "SourceLanguage", "Provider", "CreateAt").
+		Values(translation.PostId, translation.Locale, translation.PostEditAt, translation.Message, translation.SourceLanguage, translation.Provider, translation.CreateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		builder = builder.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE PostEditAt = ?, Message = ?, SourceLanguage = ?, Provider = ?, CreateAt = ?",
+			translation.PostEditAt, translation.Message, translation.SourceLanguage, translation.Provider, translation.CreateAt))
+	} else {
+		builder = builder.SuffixExpr(sq.Expr("ON CONFLICT (PostId, Locale) DO UPDATE SET PostEditAt = ?, Message = ?, SourceLanguage = ?, Provider = ?, CreateAt = ?",
+			translation.PostEditAt, translation.Message, translation.SourceLanguage, translation.Provider, translation.CreateAt))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_post_translation_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save PostTranslation with postId=%s", translation.PostId)
+	}
+
+	return translation, nil
+}
+
+func (s *SqlTranslationStore) GetPostTranslations(postIDs []string, locale string) ([]*model.PostTranslation, error) {
+	translations := []*model.PostTranslation{}
+	if len(postIDs) == 0 {
+		return translations, nil
+	}
+
+	query := s.postTranslationSelectQuery.Where(sq.Eq{
+		"PostTranslations.PostId": postIDs,
+		"PostTranslations.Locale": locale,
+	})
+
+	if err := s.GetReplicaX().SelectBuilder(&translations, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find PostTranslations")
+	}
+
+	return translations, nil
+}
+
+func (s *SqlTranslationStore) PermanentDeletePostTranslations(postID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostTranslations").
+		Where(sq.Eq{"PostId": postID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete PostTranslations with postId=%s", postID)
+	}
+
+	return nil
+}
+
+func (s *SqlTranslationStore) GetChannelSettings(channelID string) (*model.ChannelTranslationSettings, error) {
+	query := s.getQueryBuilder().
+		Select("ChannelId", "AutoTranslate", "UpdateAt").
+		From("ChannelTranslationSettings").
+		Where(sq.Eq{"ChannelId": channelID})
+
+	var settings model.ChannelTranslationSettings
+	if err := s.GetReplicaX().GetBuilder(&settings, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ChannelTranslationSettings", channelID)
+		}
+		return nil, errors.Wrapf(err, "failed to get ChannelTranslationSettings with channelId=%s", channelID)
+	}
+
+	return &settings, nil
+}
+
+func (s *SqlTranslationStore) SaveChannelSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error) {
+	settings.PreSave()
+	if err := settings.IsValid(); err != nil {
+		return nil, err
+	}
+
+	builder := s.getQueryBuilder().
+		Insert("ChannelTranslationSettings").
+		Columns("ChannelId", "AutoTranslate", "UpdateAt").
+		Values(settings.ChannelId, settings.AutoTranslate, settings.UpdateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		builder = builder.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE AutoTranslate = ?, UpdateAt = ?", settings.AutoTranslate, settings.UpdateAt))
+	} else {
+		builder = builder.SuffixExpr(sq.Expr("ON CONFLICT (ChannelId) DO UPDATE SET AutoTranslate = ?, UpdateAt = ?", settings.AutoTranslate, settings.UpdateAt))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_channel_translation_settings_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ChannelTranslationSettings with channelId=%s", settings.ChannelId)
+	}
+
+	return settings, nil
+}
+
+func (s *SqlTranslationStore) GetChannelMemberLocales(channelID string) ([]string, error) {
+	query := s.getQueryBuilder().
+		Select("DISTINCT Users.Locale").
+		From("ChannelMembers").
+		Join("Users ON Users.Id = ChannelMembers.UserId").
+		Where(sq.Eq{
+			"ChannelMembers.ChannelId": channelID,
+			"Users.DeleteAt":           0,
+		}).
+		Where(sq.NotEq{"Users.Locale": ""}).
+		OrderBy("Users.Locale")
+
+	locales := []string{}
+	if err := s.GetReplicaX().SelectBuilder(&locales, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the locales of the members of the channel with channelId=%s", channelID)
+	}
+
+	return locales, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlUserDataExportStore struct {
+	*SqlStore
+
+	userDataExportSelectQuery sq.SelectBuilder
+}
+
+func newSqlUserDataExportStore(sqlStore *SqlStore) store.UserDataExportStore {
+	s := &SqlUserDataExportStore{SqlStore: sqlStore}
+
+	s.userDataExportSelectQuery = s.getQueryBuilder().
+		Select(
+			"UserDataExports.JobId",
+			"UserDataExports.UserId",
+			"UserDataExports.CreateAt",
+			"UserDataExports.ExpiresAt",
+		).
+		From("UserDataExports")
+
+	return s
+}
+
+func (s *SqlUserDataExportStore) Save(export *model.UserDataExport) (*model.UserDataExport, error) {
+	export.PreSave()
+	if err := export.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Insert("UserDataExports").
+		Columns("JobId", "UserId", "CreateAt", "ExpiresAt").
+		Values(export.JobId, export.UserId, export.CreateAt, export.ExpiresAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "save_user_data_export_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to save UserDataExport with jobId=%s", export.JobId)
+	}
+
+	return export, nil
+}
+
+func (s *SqlUserDataExportStore) SaveWithJob(export *model.UserDataExport, job *model.Job, since int64) (_ *model.UserDataExport, err error) {
+	export.PreSave()
+	if appErr := export.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	jobQuery, err := s.jobInsertQuery(job)
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent requests of the same user can't both see no recent export, one of them fails
+	// to serialize instead.
+	tx, err := s.GetMasterX().BeginXWithIsolation(&sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(tx, &err)
+
+	var count int
+	if err = tx.GetBuilder(&count, s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("UserDataExports").
+		Where(sq.Eq{"UserId": export.UserId}).
+		Where(sq.Gt{"CreateAt": since})); err != nil {
+		return nil, errors.Wrapf(err, "failed to count UserDataExports with userId=%s", export.UserId)
+	}
+	if count > 0 {
+		return nil, store.NewErrLimitExceeded("UserDataExport", count, "userId="+export.UserId)
+	}
+
+	if _, err = tx.ExecBuilder(jobQuery); err != nil {
+		return nil, s.userDataExportSaveError(err, export, "failed to save Job")
+	}
+
+	if _, err = tx.ExecBuilder(s.getQueryBuilder().
+		Insert("UserDataExports").
+		Columns("JobId", "UserId", "CreateAt", "ExpiresAt").
+		Values(export.JobId, export.UserId, export.CreateAt, export.ExpiresAt)); err != nil {
+		return nil, s.userDataExportSaveError(err, export, "failed to save UserDataExport")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, s.userDataExportSaveError(err, export, "commit_transaction")
+	}
+
+	return export, nil
+}
+
+// userDataExportSaveError reports a concurrent request of the same user that won the race to
+// save an export as ErrLimitExceeded.
+func (s *SqlUserDataExportStore) userDataExportSaveError(err error, export *model.UserDataExport, msg string) error {
+	if isRepeatableError(err) {
+		return store.NewErrLimitExceeded("UserDataExport", 1, "userId="+export.UserId)
+	}
+	return errors.Wrap(err, msg)
+}
+
+func (s *SqlUserDataExportStore) Get(jobID string) (*model.UserDataExport, error) {
+	query := s.userDataExportSelectQuery.Where(sq.Eq{"UserDataExports.JobId": jobID})
+
+	var export model.UserDataExport
+	if err := s.GetReplicaX().GetBuilder(&export, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UserDataExport", jobID)
+		}
+		return nil, errors.Wrapf(err, "failed to get UserDataExport with jobId=%s", jobID)
+	}
+
+	return &export, nil
+}
+
+func (s *SqlUserDataExportStore) GetLatestForUser(userID string) (*model.UserDataExport, error) {
+	query := s.userDataExportSelectQuery.
+		Where(sq.Eq{"UserDataExports.UserId": userID}).
+		OrderBy("UserDataExports.CreateAt DESC").
+		Limit(1)
+
+	var export model.UserDataExport
+	if err := s.GetReplicaX().GetBuilder(&export, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UserDataExport", "userId="+userID)
+		}
+		return nil, errors.Wrapf(err, "failed to get UserDataExport with userId=%s", userID)
+	}
+
+	return &export, nil
+}
+
+func (s *SqlUserDataExportStore) Update(export *model.UserDataExport) (*model.UserDataExport, error) {
+	if err := export.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query, args, err := s.getQueryBuilder().
+		Update("UserDataExports").
+		Set("ExpiresAt", export.ExpiresAt).
+		Where(sq.Eq{"JobId": export.JobId}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "update_user_data_export_tosql")
+	}
+
+	res, err := s.GetMasterX().Exec(query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update UserDataExport with jobId=%s", export.JobId)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rows affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("UserDataExport", export.JobId)
+	}
+
+	return export, nil
+}
+
+func (s *SqlUserDataExportStore) PermanentDeleteByUser(userID string) error {
+	query, args, err := s.getQueryBuilder().
+		Delete("UserDataExports").
+		Where(sq.Eq{"UserId": userID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "delete_user_data_export_tosql")
+	}
+
+	if _, err := s.GetMasterX().Exec(query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete UserDataExports with userId=%s", userID)
+	}
+
+	return nil
+}
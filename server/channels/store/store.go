@@ -91,6 +91,13 @@ type Store interface {
 	PostPersistentNotification() PostPersistentNotificationStore
 	DesktopTokens() DesktopTokensStore
 	ChannelBookmark() ChannelBookmarkStore
+	LoginFingerprint() LoginFingerprintStore
+	AuditEvent() AuditEventStore
+	GuestAccount() GuestAccountStore
+	UserDataExport() UserDataExportStore
+	PluginMigration() PluginMigrationStore
+	SavedSearch() SavedSearchStore
+	Translation() TranslationStore
 }
 
 type RetentionPolicyStore interface {
@@ -384,6 +391,11 @@ type PostStore interface {
 	ClearCaches()
 	InvalidateLastPostTimeCache(channelID string)
 	GetPostsCreatedAt(channelID string, timestamp int64) ([]*model.Post, error)
+	// GetPostsCreatedSince returns up to limit undeleted posts of the channel created after the
+	// time, newest first. If beforeID is set, only the posts that sort before the post created at
+	// beforeTime with that id are returned, so the posts can be paged by the time and id of the
+	// last one.
+	GetPostsCreatedSince(channelID string, since, beforeTime int64, beforeID string, limit int) ([]*model.Post, error)
 	Overwrite(rctx request.CTX, post *model.Post) (*model.Post, error)
 	OverwriteMultiple(posts []*model.Post) ([]*model.Post, int, error)
 	GetPostsByIds(postIds []string) ([]*model.Post, error)
@@ -396,6 +408,11 @@ type PostStore interface {
 	GetParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.PostForExport, error)
 	GetRepliesForExport(parentID string) ([]*model.ReplyForExport, error)
 	GetDirectPostParentsForExportAfter(limit int, afterID string, includeArchivedChannels bool) ([]*model.DirectPostForExport, error)
+	// GetParentsForUserExportAfter returns the root posts of the threads the user started or replied to in the team
+	// channels they are still a member of.
+	GetParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.PostForExport, error)
+	// GetDirectPostParentsForUserExportAfter returns the root posts of the direct and group channels the user is a member of.
+	GetDirectPostParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.DirectPostForExport, error)
 	SearchPostsForUser(rctx request.CTX, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.PostSearchResults, error)
 	GetOldestEntityCreationTime() (int64, error)
 	HasAutoResponsePostByUserSince(options model.GetPostsSinceOptions, userId string) (bool, error)
@@ -403,6 +420,12 @@ type PostStore interface {
 	SetPostReminder(reminder *model.PostReminder) error
 	GetPostReminders(now int64) ([]*model.PostReminder, error)
 	GetPostReminderMetadata(postID string) (*PostReminderMetadata, error)
+	// GetPostRemindersForUser returns the reminders of the user for posts of the channels the user
+	// is a member of, soonest first.
+	GetPostRemindersForUser(userID string) ([]*model.PostReminder, error)
+	DeletePostReminder(postID, userID string) error
+	DeletePostRemindersForPost(postID string) error
+	DeleteUserPostRemindersForChannel(userID, channelID string) error
 	// GetNthRecentPostTime returns the CreateAt time of the nth most recent post.
 	GetNthRecentPostTime(n int64) (int64, error)
 }
@@ -528,6 +551,11 @@ type AuditStore interface {
 	PermanentDeleteByUser(userID string) error
 }
 
+type AuditEventStore interface {
+	Save(event *model.AuditEvent) (*model.AuditEvent, error)
+	Search(opts model.AuditEventSearchOpts) ([]*model.AuditEvent, error)
+}
+
 type ClusterDiscoveryStore interface {
 	Save(discovery *model.ClusterDiscovery) error
 	Delete(discovery *model.ClusterDiscovery) (bool, error)
@@ -655,6 +683,9 @@ type PreferenceStore interface {
 	GetCategory(userID string, category string) (model.Preferences, error)
 	GetCategoryAndName(category string, nane string) (model.Preferences, error)
 	Get(userID string, category string, name string) (*model.Preference, error)
+	// GetForUsers returns the preference with the given category and name of each of the users
+	// who have it set.
+	GetForUsers(userIDs []string, category string, name string) (model.Preferences, error)
 	GetAll(userID string) (model.Preferences, error)
 	Delete(userID, category, name string) error
 	DeleteCategory(userID string, category string) error
@@ -677,6 +708,9 @@ type TokenStore interface {
 	GetByToken(token string) (*model.Token, error)
 	Cleanup(expiryTime int64)
 	GetAllTokensByType(tokenType string) ([]*model.Token, error)
+	// GetTokensByTypeAndExtraPrefix returns the tokens of the given type created since the given
+	// time whose extra data starts with the given prefix.
+	GetTokensByTypeAndExtraPrefix(tokenType string, extraPrefix string, since int64) ([]*model.Token, error)
 	RemoveAllTokensByType(tokenType string) error
 }
 
@@ -688,12 +722,90 @@ type DesktopTokensStore interface {
 	DeleteOlderThan(minCreatedAt int64) error
 }
 
+type LoginFingerprintStore interface {
+	Get(userID, fingerprintType, value string) (*model.LoginFingerprint, error)
+	Save(fingerprint *model.LoginFingerprint) (*model.LoginFingerprint, error)
+	CountForUser(userID string) (int64, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type GuestAccountStore interface {
+	Save(account *model.GuestAccount) (*model.GuestAccount, error)
+	Get(userID string) (*model.GuestAccount, error)
+	Update(account *model.GuestAccount) (*model.GuestAccount, error)
+	// GetExpiring returns the accounts of active guests expiring at or before
+	// the given time whose expiry hasn't been notified yet.
+	GetExpiring(before int64, limit int) ([]*model.GuestAccount, error)
+	// GetExpired returns the accounts of active guests that expired at or
+	// before the given time.
+	GetExpired(now int64, limit int) ([]*model.GuestAccount, error)
+	// GetUntrackedGuestIds returns the ids of active guests without an account,
+	// such as users demoted to guests or synchronized from SAML or LDAP.
+	GetUntrackedGuestIds(limit int) ([]string, error)
+	PermanentDeleteByUser(userID string) error
+	SavePendingInvite(invite *model.PendingGuestInvite) (*model.PendingGuestInvite, error)
+	GetPendingInvite(id string) (*model.PendingGuestInvite, error)
+	GetPendingInvites(offset, limit int) ([]*model.PendingGuestInvite, error)
+	DeletePendingInvite(id string) error
+}
+
+type UserDataExportStore interface {
+	Save(export *model.UserDataExport) (*model.UserDataExport, error)
+	// SaveWithJob saves the export along with the job producing it, unless the user already
+	// requested an export after the given time, in which case it returns ErrLimitExceeded.
+	SaveWithJob(export *model.UserDataExport, job *model.Job, since int64) (*model.UserDataExport, error)
+	Get(jobID string) (*model.UserDataExport, error)
+	// GetLatestForUser returns the most recently requested export of the given user.
+	GetLatestForUser(userID string) (*model.UserDataExport, error)
+	Update(export *model.UserDataExport) (*model.UserDataExport, error)
+	PermanentDeleteByUser(userID string) error
+}
+
+type PluginMigrationStore interface {
+	// GetAll returns the migrations applied by the given plugin, ordered by version.
+	GetAll(pluginID string) ([]*model.PluginMigration, error)
+	// Apply runs the migration's Up SQL and records the migration in a single transaction.
+	Apply(migration *model.PluginMigration) error
+	// Rollback runs the migration's Down SQL and forgets the migration in a single transaction.
+	Rollback(migration *model.PluginMigration) error
+}
+
+type SavedSearchStore interface {
+	Save(search *model.SavedSearch) (*model.SavedSearch, error)
+	Get(id string) (*model.SavedSearch, error)
+	// GetForUser returns the searches saved by the given user, ordered by name.
+	GetForUser(userID string) ([]*model.SavedSearch, error)
+	CountForUser(userID string) (int64, error)
+	// GetAlertsForChannel returns the searches with alerts of the active members of the given
+	// channel, restricted to the given team or to no team.
+	GetAlertsForChannel(channelID, teamID string) ([]*model.SavedSearch, error)
+	Update(search *model.SavedSearch) (*model.SavedSearch, error)
+	Delete(id string) error
+	PermanentDeleteByUser(userID string) error
+}
+
+type TranslationStore interface {
+	// SavePostTranslation saves the translation, replacing the translation of the post into the
+	// same locale if any.
+	SavePostTranslation(translation *model.PostTranslation) (*model.PostTranslation, error)
+	// GetPostTranslations returns the translations of the posts into the locale, including the
+	// stale ones.
+	GetPostTranslations(postIDs []string, locale string) ([]*model.PostTranslation, error)
+	PermanentDeletePostTranslations(postID string) error
+	GetChannelSettings(channelID string) (*model.ChannelTranslationSettings, error)
+	SaveChannelSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error)
+	// GetChannelMemberLocales returns the distinct locales of the active members of the channel.
+	GetChannelMemberLocales(channelID string) ([]string, error)
+}
+
 type EmojiStore interface {
 	Save(emoji *model.Emoji) (*model.Emoji, error)
 	Get(c request.CTX, id string, allowFromCache bool) (*model.Emoji, error)
 	GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error)
 	GetMultipleByName(c request.CTX, names []string) ([]*model.Emoji, error)
 	GetList(offset, limit int, sort string) ([]*model.Emoji, error)
+	// GetByCreator returns the custom emoji created by the given user, sorted by name.
+	GetByCreator(creatorID string, offset, limit int) ([]*model.Emoji, error)
 	Delete(emoji *model.Emoji, timestamp int64) error
 	Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error)
 }
@@ -747,6 +859,7 @@ type ReactionStore interface {
 	Save(reaction *model.Reaction) (*model.Reaction, error)
 	Delete(reaction *model.Reaction) (*model.Reaction, error)
 	GetForPost(postID string, allowFromCache bool) ([]*model.Reaction, error)
+	GetForUser(userID string, offset, limit int) ([]*model.Reaction, error)
 	GetForPostSince(postId string, since int64, excludeRemoteId string, inclDeleted bool) ([]*model.Reaction, error)
 	GetUniqueCountForPost(postId string) (int, error)
 	ExistsOnPost(postId string, emojiName string) (bool, error)
@@ -804,6 +917,12 @@ type PluginStore interface {
 	DeleteAllForPlugin(PluginID string) error
 	DeleteAllExpired() error
 	List(pluginID string, page, perPage int) ([]string, error)
+	ListWithOptions(pluginID string, options model.PluginKVListOptions) ([]string, error)
+	GetMulti(pluginID string, keys []string) ([]*model.PluginKeyValue, error)
+	GetMetadata(pluginID, key string) (*model.PluginKVMetadata, error)
+	// Batch applies all operations in a single transaction. It returns false, and
+	// writes nothing, if the old value of any atomic operation does not match.
+	Batch(pluginID string, ops []*model.PluginKVBatchOperation) (bool, error)
 }
 
 type RoleStore interface {
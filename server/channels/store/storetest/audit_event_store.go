@@ -0,0 +1,111 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestAuditEventStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testAuditEventStoreSave(t, rctx, ss) })
+	t.Run("Search", func(t *testing.T) { testAuditEventStoreSearch(t, rctx, ss) })
+}
+
+func testAuditEventStoreSave(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.AuditEvent().Save(&model.AuditEvent{Record: json.RawMessage(`{}`)})
+		require.Error(t, err)
+	})
+
+	userID := model.NewId()
+	event, err := ss.AuditEvent().Save(&model.AuditEvent{
+		EventName: "updateUser",
+		Status:    "success",
+		Level:     "audit-api",
+		UserId:    userID,
+		ApiPath:   "/api/v4/users/me",
+		ChainId:   model.NewId(),
+		Sequence:  7,
+		Hash:      "0b514ec9ba73c362cfe6ad7079cc4a6981339bf299ad45193f0e745fa2f64304",
+		Record:    json.RawMessage(`{"event_name":"updateUser","status":"success"}`),
+	})
+	require.NoError(t, err)
+	assert.True(t, model.IsValidId(event.Id))
+	assert.NotZero(t, event.CreateAt)
+
+	events, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{UserId: userID})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.Id, events[0].Id)
+	assert.Equal(t, int64(7), events[0].Sequence)
+	assert.JSONEq(t, string(event.Record), string(events[0].Record))
+}
+
+func testAuditEventStoreSearch(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	save := func(userID, eventName string, createAt int64) *model.AuditEvent {
+		event, err := ss.AuditEvent().Save(&model.AuditEvent{
+			CreateAt:  createAt,
+			EventName: eventName,
+			Status:    "success",
+			UserId:    userID,
+			Record:    json.RawMessage(`{}`),
+		})
+		require.NoError(t, err)
+		return event
+	}
+
+	e1 := save(userID, "login", 1000)
+	e2 := save(userID, "updateUser", 2000)
+	e3 := save(userID, "login", 3000)
+	e4 := save(otherUserID, "login", 2500)
+
+	ids := func(events []*model.AuditEvent) []string {
+		result := []string{}
+		for _, event := range events {
+			result = append(result, event.Id)
+		}
+		return result
+	}
+
+	t.Run("by user, newest first", func(t *testing.T) {
+		events, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{UserId: userID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e3.Id, e2.Id, e1.Id}, ids(events))
+	})
+
+	t.Run("by user and event name", func(t *testing.T) {
+		events, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{UserId: userID, EventName: "login"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e3.Id, e1.Id}, ids(events))
+	})
+
+	t.Run("by time range", func(t *testing.T) {
+		events, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{EventName: "login", Since: 2000, Until: 3000})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{e3.Id, e4.Id}, ids(events))
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		events, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{UserId: userID, Page: 1, PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e1.Id}, ids(events))
+	})
+
+	t.Run("per page out of bounds", func(t *testing.T) {
+		_, err := ss.AuditEvent().Search(model.AuditEventSearchOpts{PerPage: model.AuditEventSearchMaxPerPage + 1})
+		var outErr *store.ErrOutOfBounds
+		require.ErrorAs(t, err, &outErr)
+	})
+}
@@ -21,6 +21,7 @@ func TestEmojiStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("EmojiGetByName", func(t *testing.T) { testEmojiGetByName(t, rctx, ss) })
 	t.Run("EmojiGetMultipleByName", func(t *testing.T) { testEmojiGetMultipleByName(t, rctx, ss) })
 	t.Run("EmojiGetList", func(t *testing.T) { testEmojiGetList(t, rctx, ss) })
+	t.Run("EmojiGetByCreator", func(t *testing.T) { testEmojiGetByCreator(t, rctx, ss) })
 	t.Run("EmojiSearch", func(t *testing.T) { testEmojiSearch(t, rctx, ss) })
 }
 
@@ -237,6 +238,47 @@ func testEmojiGetList(t *testing.T, rctx request.CTX, ss store.Store) {
 	assert.Equal(t, emojis[2].Name, remojis[1].Name)
 }
 
+func testEmojiGetByCreator(t *testing.T, rctx request.CTX, ss store.Store) {
+	creatorID := model.NewId()
+	emojis := []model.Emoji{
+		{
+			CreatorId: creatorID,
+			Name:      "b" + model.NewId(),
+		},
+		{
+			CreatorId: creatorID,
+			Name:      "a" + model.NewId(),
+		},
+		{
+			CreatorId: model.NewId(),
+			Name:      "c" + model.NewId(),
+		},
+	}
+
+	for i, emoji := range emojis {
+		data, err := ss.Emoji().Save(&emoji)
+		require.NoError(t, err)
+		emojis[i] = *data
+	}
+	defer func() {
+		for _, emoji := range emojis {
+			err := ss.Emoji().Delete(&emoji, time.Now().Unix())
+			require.NoError(t, err)
+		}
+	}()
+
+	remojis, err := ss.Emoji().GetByCreator(creatorID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, remojis, 2)
+	assert.Equal(t, emojis[1].Id, remojis[0].Id)
+	assert.Equal(t, emojis[0].Id, remojis[1].Id)
+
+	remojis, err = ss.Emoji().GetByCreator(creatorID, 1, 100)
+	require.NoError(t, err)
+	require.Len(t, remojis, 1)
+	assert.Equal(t, emojis[0].Id, remojis[0].Id)
+}
+
 func testEmojiSearch(t *testing.T, rctx request.CTX, ss store.Store) {
 	emojis := []model.Emoji{
 		{
@@ -0,0 +1,216 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestGuestAccountStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("SaveGetAndUpdate", func(t *testing.T) { testGuestAccountStoreSaveGetAndUpdate(t, rctx, ss) })
+	t.Run("GetExpiringAndExpired", func(t *testing.T) { testGuestAccountStoreGetExpiringAndExpired(t, rctx, ss) })
+	t.Run("GetUntrackedGuestIds", func(t *testing.T) { testGuestAccountStoreGetUntrackedGuestIds(t, rctx, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testGuestAccountStorePermanentDeleteByUser(t, rctx, ss) })
+	t.Run("PendingInvites", func(t *testing.T) { testGuestAccountStorePendingInvites(t, rctx, ss) })
+}
+
+func saveGuestForAccountStore(t *testing.T, rctx request.CTX, ss store.Store, deleteAt int64) *model.User {
+	user, err := ss.User().Save(rctx, &model.User{
+		Email:    MakeEmail(),
+		Username: model.NewUsername(),
+		Roles:    model.SystemGuestRoleId,
+		DeleteAt: deleteAt,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ss.User().PermanentDelete(rctx, user.Id)) })
+
+	return user
+}
+
+func testGuestAccountStoreSaveGetAndUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	sponsorID := model.NewId()
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ss.GuestAccount().Get(userID)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.GuestAccount().Save(&model.GuestAccount{UserId: userID, SponsorId: "invalid"})
+		require.Error(t, err)
+	})
+
+	saved, err := ss.GuestAccount().Save(&model.GuestAccount{
+		UserId:    userID,
+		SponsorId: sponsorID,
+		ExpiresAt: 5000,
+		CreateAt:  1000,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.GuestAccount().PermanentDeleteByUser(userID)) }()
+	assert.Equal(t, int64(1000), saved.UpdateAt)
+
+	account, err := ss.GuestAccount().Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, saved, account)
+
+	account.ExpiresAt = 9000
+	account.ExpiryNotifiedAt = 0
+	_, err = ss.GuestAccount().Update(account)
+	require.NoError(t, err)
+
+	updated, err := ss.GuestAccount().Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9000), updated.ExpiresAt)
+	assert.Greater(t, updated.UpdateAt, int64(1000))
+
+	t.Run("update missing account", func(t *testing.T) {
+		_, err := ss.GuestAccount().Update(&model.GuestAccount{
+			UserId:    model.NewId(),
+			SponsorId: sponsorID,
+			CreateAt:  1000,
+		})
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testGuestAccountStoreGetExpiringAndExpired(t *testing.T, rctx request.CTX, ss store.Store) {
+	sponsorID := model.NewId()
+
+	save := func(user *model.User, expiresAt, notifiedAt int64) {
+		_, err := ss.GuestAccount().Save(&model.GuestAccount{
+			UserId:           user.Id,
+			SponsorId:        sponsorID,
+			ExpiresAt:        expiresAt,
+			ExpiryNotifiedAt: notifiedAt,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, ss.GuestAccount().PermanentDeleteByUser(user.Id)) })
+	}
+
+	expired := saveGuestForAccountStore(t, rctx, ss, 0)
+	save(expired, 1000, 500)
+	expiring := saveGuestForAccountStore(t, rctx, ss, 0)
+	save(expiring, 3000, 0)
+	neverExpires := saveGuestForAccountStore(t, rctx, ss, 0)
+	save(neverExpires, 0, 0)
+	deactivated := saveGuestForAccountStore(t, rctx, ss, 100)
+	save(deactivated, 1000, 0)
+
+	accounts, err := ss.GuestAccount().GetExpiring(5000, 100)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, expiring.Id, accounts[0].UserId)
+
+	accounts, err = ss.GuestAccount().GetExpired(2000, 100)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, expired.Id, accounts[0].UserId)
+
+	accounts, err = ss.GuestAccount().GetExpired(5000, 1)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, expired.Id, accounts[0].UserId)
+}
+
+func testGuestAccountStoreGetUntrackedGuestIds(t *testing.T, rctx request.CTX, ss store.Store) {
+	tracked := saveGuestForAccountStore(t, rctx, ss, 0)
+	_, err := ss.GuestAccount().Save(&model.GuestAccount{UserId: tracked.Id})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ss.GuestAccount().PermanentDeleteByUser(tracked.Id)) })
+
+	untracked := saveGuestForAccountStore(t, rctx, ss, 0)
+	deactivated := saveGuestForAccountStore(t, rctx, ss, 100)
+
+	member, err := ss.User().Save(rctx, &model.User{
+		Email:    MakeEmail(),
+		Username: model.NewUsername(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ss.User().PermanentDelete(rctx, member.Id)) })
+
+	userIDs, err := ss.GuestAccount().GetUntrackedGuestIds(1000)
+	require.NoError(t, err)
+	assert.Contains(t, userIDs, untracked.Id)
+	assert.NotContains(t, userIDs, tracked.Id)
+	assert.NotContains(t, userIDs, deactivated.Id)
+	assert.NotContains(t, userIDs, member.Id)
+}
+
+func testGuestAccountStorePermanentDeleteByUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	_, err := ss.GuestAccount().Save(&model.GuestAccount{UserId: userID, SponsorId: model.NewId()})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.GuestAccount().PermanentDeleteByUser(userID))
+
+	_, err = ss.GuestAccount().Get(userID)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testGuestAccountStorePendingInvites(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.GuestAccount().SavePendingInvite(&model.PendingGuestInvite{TeamId: model.NewId()})
+		require.Error(t, err)
+	})
+
+	first, err := ss.GuestAccount().SavePendingInvite(&model.PendingGuestInvite{
+		CreateAt:  1000,
+		TeamId:    model.NewId(),
+		SenderId:  model.NewId(),
+		SponsorId: model.NewId(),
+		Emails:    model.StringArray{"guest1@example.com", "guest2@example.com"},
+		Channels:  model.StringArray{model.NewId()},
+		Message:   "welcome",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.Id)
+
+	second, err := ss.GuestAccount().SavePendingInvite(&model.PendingGuestInvite{
+		CreateAt:  2000,
+		TeamId:    model.NewId(),
+		SenderId:  model.NewId(),
+		SponsorId: model.NewId(),
+		Emails:    model.StringArray{"guest3@example.com"},
+		Channels:  model.StringArray{model.NewId()},
+	})
+	require.NoError(t, err)
+
+	invite, err := ss.GuestAccount().GetPendingInvite(first.Id)
+	require.NoError(t, err)
+	assert.Equal(t, first, invite)
+
+	invites, err := ss.GuestAccount().GetPendingInvites(0, 100)
+	require.NoError(t, err)
+	require.Len(t, invites, 2)
+	assert.Equal(t, first.Id, invites[0].Id)
+	assert.Equal(t, second.Id, invites[1].Id)
+
+	invites, err = ss.GuestAccount().GetPendingInvites(1, 100)
+	require.NoError(t, err)
+	require.Len(t, invites, 1)
+	assert.Equal(t, second.Id, invites[0].Id)
+
+	require.NoError(t, ss.GuestAccount().DeletePendingInvite(first.Id))
+	require.NoError(t, ss.GuestAccount().DeletePendingInvite(second.Id))
+
+	_, err = ss.GuestAccount().GetPendingInvite(first.Id)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	err = ss.GuestAccount().DeletePendingInvite(first.Id)
+	require.ErrorAs(t, err, &nfErr)
+}
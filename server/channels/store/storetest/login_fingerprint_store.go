@@ -0,0 +1,114 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestLoginFingerprintStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("SaveAndGet", func(t *testing.T) { testLoginFingerprintStoreSaveAndGet(t, rctx, ss) })
+	t.Run("CountForUser", func(t *testing.T) { testLoginFingerprintStoreCountForUser(t, rctx, ss) })
+	t.Run("PermanentDeleteByUser", func(t *testing.T) { testLoginFingerprintStorePermanentDeleteByUser(t, rctx, ss) })
+}
+
+func testLoginFingerprintStoreSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ss.LoginFingerprint().Get(userID, model.LoginFingerprintTypeIPRange, "10.0.0.0/24")
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.LoginFingerprint().Save(&model.LoginFingerprint{UserId: userID, Type: "unknown", Value: "value"})
+		require.Error(t, err)
+	})
+
+	saved, err := ss.LoginFingerprint().Save(&model.LoginFingerprint{
+		UserId:   userID,
+		Type:     model.LoginFingerprintTypeIPRange,
+		Value:    "10.0.0.0/24",
+		CreateAt: 1000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), saved.LastSeenAt)
+
+	fingerprint, err := ss.LoginFingerprint().Get(userID, model.LoginFingerprintTypeIPRange, "10.0.0.0/24")
+	require.NoError(t, err)
+	assert.Equal(t, saved, fingerprint)
+
+	t.Run("saving again only updates last seen", func(t *testing.T) {
+		_, err := ss.LoginFingerprint().Save(&model.LoginFingerprint{
+			UserId:     userID,
+			Type:       model.LoginFingerprintTypeIPRange,
+			Value:      "10.0.0.0/24",
+			CreateAt:   2000,
+			LastSeenAt: 3000,
+		})
+		require.NoError(t, err)
+
+		fingerprint, err := ss.LoginFingerprint().Get(userID, model.LoginFingerprintTypeIPRange, "10.0.0.0/24")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), fingerprint.CreateAt)
+		assert.Equal(t, int64(3000), fingerprint.LastSeenAt)
+	})
+
+	t.Run("types are kept apart", func(t *testing.T) {
+		_, err := ss.LoginFingerprint().Get(userID, model.LoginFingerprintTypeUserAgent, "10.0.0.0/24")
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testLoginFingerprintStoreCountForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	count, err := ss.LoginFingerprint().CountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	for _, fingerprint := range []*model.LoginFingerprint{
+		{UserId: userID, Type: model.LoginFingerprintTypeIPRange, Value: "10.0.0.0/24"},
+		{UserId: userID, Type: model.LoginFingerprintTypeIPRange, Value: "10.0.1.0/24"},
+		{UserId: userID, Type: model.LoginFingerprintTypeUserAgent, Value: "Linux|Linux|Firefox"},
+		{UserId: model.NewId(), Type: model.LoginFingerprintTypeIPRange, Value: "10.0.0.0/24"},
+	} {
+		_, err = ss.LoginFingerprint().Save(fingerprint)
+		require.NoError(t, err)
+	}
+
+	count, err = ss.LoginFingerprint().CountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
+
+func testLoginFingerprintStorePermanentDeleteByUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	otherUserID := model.NewId()
+
+	for _, id := range []string{userID, otherUserID} {
+		_, err := ss.LoginFingerprint().Save(&model.LoginFingerprint{UserId: id, Type: model.LoginFingerprintTypeIPRange, Value: "10.0.0.0/24"})
+		require.NoError(t, err)
+	}
+
+	err := ss.LoginFingerprint().PermanentDeleteByUser(userID)
+	require.NoError(t, err)
+
+	count, err := ss.LoginFingerprint().CountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = ss.LoginFingerprint().CountForUser(otherUserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
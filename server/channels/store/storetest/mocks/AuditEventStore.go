@@ -0,0 +1,89 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditEventStore is an autogenerated mock type for the AuditEventStore type
+type AuditEventStore struct {
+	mock.Mock
+}
+
+// Save provides a mock function with given fields: event
+func (_m *AuditEventStore) Save(event *model.AuditEvent) (*model.AuditEvent, error) {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AuditEvent) (*model.AuditEvent, error)); ok {
+		return rf(event)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AuditEvent) *model.AuditEvent); ok {
+		r0 = rf(event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AuditEvent) error); ok {
+		r1 = rf(event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: opts
+func (_m *AuditEventStore) Search(opts model.AuditEventSearchOpts) ([]*model.AuditEvent, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*model.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.AuditEventSearchOpts) ([]*model.AuditEvent, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(model.AuditEventSearchOpts) []*model.AuditEvent); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.AuditEventSearchOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAuditEventStore creates a new instance of AuditEventStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditEventStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditEventStore {
+	mock := &AuditEventStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
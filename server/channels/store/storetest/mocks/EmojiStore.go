@@ -63,6 +63,36 @@ func (_m *EmojiStore) Get(c request.CTX, id string, allowFromCache bool) (*model
 	return r0, r1
 }
 
+// GetByCreator provides a mock function with given fields: creatorID, offset, limit
+func (_m *EmojiStore) GetByCreator(creatorID string, offset int, limit int) ([]*model.Emoji, error) {
+	ret := _m.Called(creatorID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCreator")
+	}
+
+	var r0 []*model.Emoji
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.Emoji, error)); ok {
+		return rf(creatorID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.Emoji); ok {
+		r0 = rf(creatorID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Emoji)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(creatorID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByName provides a mock function with given fields: c, name, allowFromCache
 func (_m *EmojiStore) GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error) {
 	ret := _m.Called(c, name, allowFromCache)
@@ -0,0 +1,335 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GuestAccountStore is an autogenerated mock type for the GuestAccountStore type
+type GuestAccountStore struct {
+	mock.Mock
+}
+
+// DeletePendingInvite provides a mock function with given fields: id
+func (_m *GuestAccountStore) DeletePendingInvite(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: userID
+func (_m *GuestAccountStore) Get(userID string) (*model.GuestAccount, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.GuestAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.GuestAccount, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.GuestAccount); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GuestAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpired provides a mock function with given fields: now, limit
+func (_m *GuestAccountStore) GetExpired(now int64, limit int) ([]*model.GuestAccount, error) {
+	ret := _m.Called(now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpired")
+	}
+
+	var r0 []*model.GuestAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.GuestAccount, error)); ok {
+		return rf(now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.GuestAccount); ok {
+		r0 = rf(now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.GuestAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpiring provides a mock function with given fields: before, limit
+func (_m *GuestAccountStore) GetExpiring(before int64, limit int) ([]*model.GuestAccount, error) {
+	ret := _m.Called(before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiring")
+	}
+
+	var r0 []*model.GuestAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.GuestAccount, error)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.GuestAccount); ok {
+		r0 = rf(before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.GuestAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingInvite provides a mock function with given fields: id
+func (_m *GuestAccountStore) GetPendingInvite(id string) (*model.PendingGuestInvite, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingInvite")
+	}
+
+	var r0 *model.PendingGuestInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PendingGuestInvite, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PendingGuestInvite); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PendingGuestInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPendingInvites provides a mock function with given fields: offset, limit
+func (_m *GuestAccountStore) GetPendingInvites(offset int, limit int) ([]*model.PendingGuestInvite, error) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingInvites")
+	}
+
+	var r0 []*model.PendingGuestInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.PendingGuestInvite, error)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.PendingGuestInvite); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PendingGuestInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUntrackedGuestIds provides a mock function with given fields: limit
+func (_m *GuestAccountStore) GetUntrackedGuestIds(limit int) ([]string, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUntrackedGuestIds")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]string, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []string); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeleteByUser provides a mock function with given fields: userID
+func (_m *GuestAccountStore) PermanentDeleteByUser(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: account
+func (_m *GuestAccountStore) Save(account *model.GuestAccount) (*model.GuestAccount, error) {
+	ret := _m.Called(account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.GuestAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.GuestAccount) (*model.GuestAccount, error)); ok {
+		return rf(account)
+	}
+	if rf, ok := ret.Get(0).(func(*model.GuestAccount) *model.GuestAccount); ok {
+		r0 = rf(account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GuestAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.GuestAccount) error); ok {
+		r1 = rf(account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavePendingInvite provides a mock function with given fields: invite
+func (_m *GuestAccountStore) SavePendingInvite(invite *model.PendingGuestInvite) (*model.PendingGuestInvite, error) {
+	ret := _m.Called(invite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePendingInvite")
+	}
+
+	var r0 *model.PendingGuestInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PendingGuestInvite) (*model.PendingGuestInvite, error)); ok {
+		return rf(invite)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PendingGuestInvite) *model.PendingGuestInvite); ok {
+		r0 = rf(invite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PendingGuestInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PendingGuestInvite) error); ok {
+		r1 = rf(invite)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: account
+func (_m *GuestAccountStore) Update(account *model.GuestAccount) (*model.GuestAccount, error) {
+	ret := _m.Called(account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *model.GuestAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.GuestAccount) (*model.GuestAccount, error)); ok {
+		return rf(account)
+	}
+	if rf, ok := ret.Get(0).(func(*model.GuestAccount) *model.GuestAccount); ok {
+		r0 = rf(account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.GuestAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.GuestAccount) error); ok {
+		r1 = rf(account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGuestAccountStore creates a new instance of GuestAccountStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGuestAccountStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GuestAccountStore {
+	mock := &GuestAccountStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LoginFingerprintStore is an autogenerated mock type for the LoginFingerprintStore type
+type LoginFingerprintStore struct {
+	mock.Mock
+}
+
+// CountForUser provides a mock function with given fields: userID
+func (_m *LoginFingerprintStore) CountForUser(userID string) (int64, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountForUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: userID, fingerprintType, value
+func (_m *LoginFingerprintStore) Get(userID string, fingerprintType string, value string) (*model.LoginFingerprint, error) {
+	ret := _m.Called(userID, fingerprintType, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.LoginFingerprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.LoginFingerprint, error)); ok {
+		return rf(userID, fingerprintType, value)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.LoginFingerprint); ok {
+		r0 = rf(userID, fingerprintType, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoginFingerprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(userID, fingerprintType, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeleteByUser provides a mock function with given fields: userID
+func (_m *LoginFingerprintStore) PermanentDeleteByUser(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: fingerprint
+func (_m *LoginFingerprintStore) Save(fingerprint *model.LoginFingerprint) (*model.LoginFingerprint, error) {
+	ret := _m.Called(fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.LoginFingerprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.LoginFingerprint) (*model.LoginFingerprint, error)); ok {
+		return rf(fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(*model.LoginFingerprint) *model.LoginFingerprint); ok {
+		r0 = rf(fingerprint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LoginFingerprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.LoginFingerprint) error); ok {
+		r1 = rf(fingerprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLoginFingerprintStore creates a new instance of LoginFingerprintStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoginFingerprintStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoginFingerprintStore {
+	mock := &LoginFingerprintStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PluginMigrationStore is an autogenerated mock type for the PluginMigrationStore type
+type PluginMigrationStore struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: migration
+func (_m *PluginMigrationStore) Apply(migration *model.PluginMigration) error {
+	ret := _m.Called(migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.PluginMigration) error); ok {
+		r0 = rf(migration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: pluginID
+func (_m *PluginMigrationStore) GetAll(pluginID string) ([]*model.PluginMigration, error) {
+	ret := _m.Called(pluginID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*model.PluginMigration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.PluginMigration, error)); ok {
+		return rf(pluginID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.PluginMigration); ok {
+		r0 = rf(pluginID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PluginMigration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pluginID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rollback provides a mock function with given fields: migration
+func (_m *PluginMigrationStore) Rollback(migration *model.PluginMigration) error {
+	ret := _m.Called(migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.PluginMigration) error); ok {
+		r0 = rf(migration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPluginMigrationStore creates a new instance of PluginMigrationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPluginMigrationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PluginMigrationStore {
+	mock := &PluginMigrationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
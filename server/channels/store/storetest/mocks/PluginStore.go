@@ -14,6 +14,34 @@ type PluginStore struct {
 	mock.Mock
 }
 
+// Batch provides a mock function with given fields: pluginID, ops
+func (_m *PluginStore) Batch(pluginID string, ops []*model.PluginKVBatchOperation) (bool, error) {
+	ret := _m.Called(pluginID, ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []*model.PluginKVBatchOperation) (bool, error)); ok {
+		return rf(pluginID, ops)
+	}
+	if rf, ok := ret.Get(0).(func(string, []*model.PluginKVBatchOperation) bool); ok {
+		r0 = rf(pluginID, ops)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []*model.PluginKVBatchOperation) error); ok {
+		r1 = rf(pluginID, ops)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CompareAndDelete provides a mock function with given fields: keyVal, oldValue
 func (_m *PluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 	ret := _m.Called(keyVal, oldValue)
@@ -154,6 +182,66 @@ func (_m *PluginStore) Get(pluginID string, key string) (*model.PluginKeyValue,
 	return r0, r1
 }
 
+// GetMetadata provides a mock function with given fields: pluginID, key
+func (_m *PluginStore) GetMetadata(pluginID string, key string) (*model.PluginKVMetadata, error) {
+	ret := _m.Called(pluginID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadata")
+	}
+
+	var r0 *model.PluginKVMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.PluginKVMetadata, error)); ok {
+		return rf(pluginID, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.PluginKVMetadata); ok {
+		r0 = rf(pluginID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PluginKVMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(pluginID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMulti provides a mock function with given fields: pluginID, keys
+func (_m *PluginStore) GetMulti(pluginID string, keys []string) ([]*model.PluginKeyValue, error) {
+	ret := _m.Called(pluginID, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMulti")
+	}
+
+	var r0 []*model.PluginKeyValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) ([]*model.PluginKeyValue, error)); ok {
+		return rf(pluginID, keys)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) []*model.PluginKeyValue); ok {
+		r0 = rf(pluginID, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PluginKeyValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(pluginID, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: pluginID, page, perPage
 func (_m *PluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 	ret := _m.Called(pluginID, page, perPage)
@@ -184,6 +272,36 @@ func (_m *PluginStore) List(pluginID string, page int, perPage int) ([]string, e
 	return r0, r1
 }
 
+// ListWithOptions provides a mock function with given fields: pluginID, options
+func (_m *PluginStore) ListWithOptions(pluginID string, options model.PluginKVListOptions) ([]string, error) {
+	ret := _m.Called(pluginID, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithOptions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, model.PluginKVListOptions) ([]string, error)); ok {
+		return rf(pluginID, options)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.PluginKVListOptions) []string); ok {
+		r0 = rf(pluginID, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.PluginKVListOptions) error); ok {
+		r1 = rf(pluginID, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveOrUpdate provides a mock function with given fields: keyVal
 func (_m *PluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 	ret := _m.Called(keyVal)
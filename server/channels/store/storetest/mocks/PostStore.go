@@ -131,6 +131,60 @@ func (_m *PostStore) Delete(rctx request.CTX, postID string, timestamp int64, de
 	return r0
 }
 
+// DeletePostReminder provides a mock function with given fields: postID, userID
+func (_m *PostStore) DeletePostReminder(postID string, userID string) error {
+	ret := _m.Called(postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePostReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(postID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePostRemindersForPost provides a mock function with given fields: postID
+func (_m *PostStore) DeletePostRemindersForPost(postID string) error {
+	ret := _m.Called(postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePostRemindersForPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserPostRemindersForChannel provides a mock function with given fields: userID, channelID
+func (_m *PostStore) DeleteUserPostRemindersForChannel(userID string, channelID string) error {
+	ret := _m.Called(userID, channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserPostRemindersForChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, channelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id, opts, userID, sanitizeOptions
 func (_m *PostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	ret := _m.Called(ctx, id, opts, userID, sanitizeOptions)
@@ -191,6 +245,36 @@ func (_m *PostStore) GetDirectPostParentsForExportAfter(limit int, afterID strin
 	return r0, r1
 }
 
+// GetDirectPostParentsForUserExportAfter provides a mock function with given fields: userID, limit, afterID
+func (_m *PostStore) GetDirectPostParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.DirectPostForExport, error) {
+	ret := _m.Called(userID, limit, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectPostParentsForUserExportAfter")
+	}
+
+	var r0 []*model.DirectPostForExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) ([]*model.DirectPostForExport, error)); ok {
+		return rf(userID, limit, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) []*model.DirectPostForExport); ok {
+		r0 = rf(userID, limit, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DirectPostForExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(userID, limit, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEditHistoryForPost provides a mock function with given fields: postId
 func (_m *PostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
 	ret := _m.Called(postId)
@@ -463,6 +547,36 @@ func (_m *PostStore) GetParentsForExportAfter(limit int, afterID string, include
 	return r0, r1
 }
 
+// GetParentsForUserExportAfter provides a mock function with given fields: userID, limit, afterID
+func (_m *PostStore) GetParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.PostForExport, error) {
+	ret := _m.Called(userID, limit, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParentsForUserExportAfter")
+	}
+
+	var r0 []*model.PostForExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) ([]*model.PostForExport, error)); ok {
+		return rf(userID, limit, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) []*model.PostForExport); ok {
+		r0 = rf(userID, limit, afterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostForExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(userID, limit, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPostAfterTime provides a mock function with given fields: channelID, timestamp, collapsedThreads
 func (_m *PostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
 	ret := _m.Called(channelID, timestamp, collapsedThreads)
@@ -609,6 +723,36 @@ func (_m *PostStore) GetPostReminders(now int64) ([]*model.PostReminder, error)
 	return r0, r1
 }
 
+// GetPostRemindersForUser provides a mock function with given fields: userID
+func (_m *PostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostRemindersForUser")
+	}
+
+	var r0 []*model.PostReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.PostReminder, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.PostReminder); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPosts provides a mock function with given fields: options, allowFromCache, sanitizeOptions
 func (_m *PostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	ret := _m.Called(options, allowFromCache, sanitizeOptions)
@@ -819,6 +963,36 @@ func (_m *PostStore) GetPostsCreatedAt(channelID string, timestamp int64) ([]*mo
 	return r0, r1
 }
 
+// GetPostsCreatedSince provides a mock function with given fields: channelID, since, beforeTime, beforeID, limit
+func (_m *PostStore) GetPostsCreatedSince(channelID string, since int64, beforeTime int64, beforeID string, limit int) ([]*model.Post, error) {
+	ret := _m.Called(channelID, since, beforeTime, beforeID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostsCreatedSince")
+	}
+
+	var r0 []*model.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64, string, int) ([]*model.Post, error)); ok {
+		return rf(channelID, since, beforeTime, beforeID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, string, int) []*model.Post); ok {
+		r0 = rf(channelID, since, beforeTime, beforeID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, string, int) error); ok {
+		r1 = rf(channelID, since, beforeTime, beforeID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPostsSince provides a mock function with given fields: options, allowFromCache, sanitizeOptions
 func (_m *PostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	ret := _m.Called(options, allowFromCache, sanitizeOptions)
@@ -44,6 +44,26 @@ func (_m *Store) Audit() store.AuditStore {
 	return r0
 }
 
+// AuditEvent provides a mock function with given fields:
+func (_m *Store) AuditEvent() store.AuditEventStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEvent")
+	}
+
+	var r0 store.AuditEventStore
+	if rf, ok := ret.Get(0).(func() store.AuditEventStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.AuditEventStore)
+		}
+	}
+
+	return r0
+}
+
 // Bot provides a mock function with given fields:
 func (_m *Store) Bot() store.BotStore {
 	ret := _m.Called()
@@ -508,6 +528,26 @@ func (_m *Store) Group() store.GroupStore {
 	return r0
 }
 
+// GuestAccount provides a mock function with given fields:
+func (_m *Store) GuestAccount() store.GuestAccountStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GuestAccount")
+	}
+
+	var r0 store.GuestAccountStore
+	if rf, ok := ret.Get(0).(func() store.GuestAccountStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.GuestAccountStore)
+		}
+	}
+
+	return r0
+}
+
 // Job provides a mock function with given fields:
 func (_m *Store) Job() store.JobStore {
 	ret := _m.Called()
@@ -593,6 +633,26 @@ func (_m *Store) Logger() mlog.LoggerIFace {
 	return r0
 }
 
+// LoginFingerprint provides a mock function with given fields:
+func (_m *Store) LoginFingerprint() store.LoginFingerprintStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginFingerprint")
+	}
+
+	var r0 store.LoginFingerprintStore
+	if rf, ok := ret.Get(0).(func() store.LoginFingerprintStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.LoginFingerprintStore)
+		}
+	}
+
+	return r0
+}
+
 // MarkSystemRanUnitTests provides a mock function with given fields:
 func (_m *Store) MarkSystemRanUnitTests() {
 	_m.Called()
@@ -678,6 +738,26 @@ func (_m *Store) Plugin() store.PluginStore {
 	return r0
 }
 
+// PluginMigration provides a mock function with given fields:
+func (_m *Store) PluginMigration() store.PluginMigrationStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PluginMigration")
+	}
+
+	var r0 store.PluginMigrationStore
+	if rf, ok := ret.Get(0).(func() store.PluginMigrationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PluginMigrationStore)
+		}
+	}
+
+	return r0
+}
+
 // Post provides a mock function with given fields:
 func (_m *Store) Post() store.PostStore {
 	ret := _m.Called()
@@ -919,6 +999,26 @@ func (_m *Store) Role() store.RoleStore {
 	return r0
 }
 
+// SavedSearch provides a mock function with given fields:
+func (_m *Store) SavedSearch() store.SavedSearchStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavedSearch")
+	}
+
+	var r0 store.SavedSearchStore
+	if rf, ok := ret.Get(0).(func() store.SavedSearchStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.SavedSearchStore)
+		}
+	}
+
+	return r0
+}
+
 // Scheme provides a mock function with given fields:
 func (_m *Store) Scheme() store.SchemeStore {
 	ret := _m.Called()
@@ -1158,6 +1258,26 @@ func (_m *Store) TotalSearchDbConnections() int {
 	return r0
 }
 
+// Translation provides a mock function with given fields:
+func (_m *Store) Translation() store.TranslationStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Translation")
+	}
+
+	var r0 store.TranslationStore
+	if rf, ok := ret.Get(0).(func() store.TranslationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.TranslationStore)
+		}
+	}
+
+	return r0
+}
+
 // UnlockFromMaster provides a mock function with given fields:
 func (_m *Store) UnlockFromMaster() {
 	_m.Called()
@@ -1223,6 +1343,26 @@ func (_m *Store) UserAccessToken() store.UserAccessTokenStore {
 	return r0
 }
 
+// UserDataExport provides a mock function with given fields:
+func (_m *Store) UserDataExport() store.UserDataExportStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserDataExport")
+	}
+
+	var r0 store.UserDataExportStore
+	if rf, ok := ret.Get(0).(func() store.UserDataExportStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UserDataExportStore)
+		}
+	}
+
+	return r0
+}
+
 // UserTermsOfService provides a mock function with given fields:
 func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	ret := _m.Called()
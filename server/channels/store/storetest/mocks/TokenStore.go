@@ -97,6 +97,36 @@ func (_m *TokenStore) GetByToken(token string) (*model.Token, error) {
 	return r0, r1
 }
 
+// GetTokensByTypeAndExtraPrefix provides a mock function with given fields: tokenType, extraPrefix, since
+func (_m *TokenStore) GetTokensByTypeAndExtraPrefix(tokenType string, extraPrefix string, since int64) ([]*model.Token, error) {
+	ret := _m.Called(tokenType, extraPrefix, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokensByTypeAndExtraPrefix")
+	}
+
+	var r0 []*model.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int64) ([]*model.Token, error)); ok {
+		return rf(tokenType, extraPrefix, since)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) []*model.Token); ok {
+		r0 = rf(tokenType, extraPrefix, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) error); ok {
+		r1 = rf(tokenType, extraPrefix, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveAllTokensByType provides a mock function with given fields: tokenType
 func (_m *TokenStore) RemoveAllTokensByType(tokenType string) error {
 	ret := _m.Called(tokenType)
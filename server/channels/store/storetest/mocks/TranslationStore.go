@@ -0,0 +1,197 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TranslationStore is an autogenerated mock type for the TranslationStore type
+type TranslationStore struct {
+	mock.Mock
+}
+
+// GetChannelMemberLocales provides a mock function with given fields: channelID
+func (_m *TranslationStore) GetChannelMemberLocales(channelID string) ([]string, error) {
+	ret := _m.Called(channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChannelMemberLocales")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(channelID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(channelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChannelSettings provides a mock function with given fields: channelID
+func (_m *TranslationStore) GetChannelSettings(channelID string) (*model.ChannelTranslationSettings, error) {
+	ret := _m.Called(channelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChannelSettings")
+	}
+
+	var r0 *model.ChannelTranslationSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ChannelTranslationSettings, error)); ok {
+		return rf(channelID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ChannelTranslationSettings); ok {
+		r0 = rf(channelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelTranslationSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(channelID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostTranslations provides a mock function with given fields: postIDs, locale
+func (_m *TranslationStore) GetPostTranslations(postIDs []string, locale string) ([]*model.PostTranslation, error) {
+	ret := _m.Called(postIDs, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostTranslations")
+	}
+
+	var r0 []*model.PostTranslation
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string) ([]*model.PostTranslation, error)); ok {
+		return rf(postIDs, locale)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string) []*model.PostTranslation); ok {
+		r0 = rf(postIDs, locale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostTranslation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string) error); ok {
+		r1 = rf(postIDs, locale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeletePostTranslations provides a mock function with given fields: postID
+func (_m *TranslationStore) PermanentDeletePostTranslations(postID string) error {
+	ret := _m.Called(postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeletePostTranslations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveChannelSettings provides a mock function with given fields: settings
+func (_m *TranslationStore) SaveChannelSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error) {
+	ret := _m.Called(settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveChannelSettings")
+	}
+
+	var r0 *model.ChannelTranslationSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error)); ok {
+		return rf(settings)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ChannelTranslationSettings) *model.ChannelTranslationSettings); ok {
+		r0 = rf(settings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChannelTranslationSettings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ChannelTranslationSettings) error); ok {
+		r1 = rf(settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavePostTranslation provides a mock function with given fields: translation
+func (_m *TranslationStore) SavePostTranslation(translation *model.PostTranslation) (*model.PostTranslation, error) {
+	ret := _m.Called(translation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePostTranslation")
+	}
+
+	var r0 *model.PostTranslation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PostTranslation) (*model.PostTranslation, error)); ok {
+		return rf(translation)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PostTranslation) *model.PostTranslation); ok {
+		r0 = rf(translation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostTranslation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PostTranslation) error); ok {
+		r1 = rf(translation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTranslationStore creates a new instance of TranslationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTranslationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TranslationStore {
+	mock := &TranslationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
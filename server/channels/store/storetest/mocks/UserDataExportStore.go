@@ -0,0 +1,197 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserDataExportStore is an autogenerated mock type for the UserDataExportStore type
+type UserDataExportStore struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: jobID
+func (_m *UserDataExportStore) Get(jobID string) (*model.UserDataExport, error) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.UserDataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.UserDataExport, error)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.UserDataExport); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestForUser provides a mock function with given fields: userID
+func (_m *UserDataExportStore) GetLatestForUser(userID string) (*model.UserDataExport, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestForUser")
+	}
+
+	var r0 *model.UserDataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.UserDataExport, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.UserDataExport); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeleteByUser provides a mock function with given fields: userID
+func (_m *UserDataExportStore) PermanentDeleteByUser(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: export
+func (_m *UserDataExportStore) Save(export *model.UserDataExport) (*model.UserDataExport, error) {
+	ret := _m.Called(export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.UserDataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport) (*model.UserDataExport, error)); ok {
+		return rf(export)
+	}
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport) *model.UserDataExport); ok {
+		r0 = rf(export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.UserDataExport) error); ok {
+		r1 = rf(export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveWithJob provides a mock function with given fields: export, job, since
+func (_m *UserDataExportStore) SaveWithJob(export *model.UserDataExport, job *model.Job, since int64) (*model.UserDataExport, error) {
+	ret := _m.Called(export, job, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWithJob")
+	}
+
+	var r0 *model.UserDataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport, *model.Job, int64) (*model.UserDataExport, error)); ok {
+		return rf(export, job, since)
+	}
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport, *model.Job, int64) *model.UserDataExport); ok {
+		r0 = rf(export, job, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.UserDataExport, *model.Job, int64) error); ok {
+		r1 = rf(export, job, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: export
+func (_m *UserDataExportStore) Update(export *model.UserDataExport) (*model.UserDataExport, error) {
+	ret := _m.Called(export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *model.UserDataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport) (*model.UserDataExport, error)); ok {
+		return rf(export)
+	}
+	if rf, ok := ret.Get(0).(func(*model.UserDataExport) *model.UserDataExport); ok {
+		r0 = rf(export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserDataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.UserDataExport) error); ok {
+		r1 = rf(export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserDataExportStore creates a new instance of UserDataExportStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserDataExportStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserDataExportStore {
+	mock := &UserDataExportStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
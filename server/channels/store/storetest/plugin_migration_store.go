@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestPluginMigrationStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("ApplyAndRollback", func(t *testing.T) { testPluginMigrationStoreApplyAndRollback(t, rctx, ss) })
+	t.Run("FailedApply", func(t *testing.T) { testPluginMigrationStoreFailedApply(t, rctx, ss) })
+}
+
+func testPluginMigrationStoreApplyAndRollback(t *testing.T, rctx request.CTX, ss store.Store) {
+	pluginID := "com.mattermost." + strings.ToLower(model.NewId())
+	table := "plugin_migration_test_" + strings.ToLower(model.NewId()[:8])
+
+	migrations, err := ss.PluginMigration().GetAll(pluginID)
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+
+	t.Run("invalid", func(t *testing.T) {
+		err := ss.PluginMigration().Apply(&model.PluginMigration{PluginId: pluginID, Version: 1})
+		require.Error(t, err)
+	})
+
+	first := &model.PluginMigration{
+		PluginId: pluginID,
+		Version:  1,
+		Name:     "create_table",
+		Up:       "CREATE TABLE " + table + " (id varchar(26) PRIMARY KEY)",
+		Down:     "DROP TABLE " + table,
+	}
+	require.NoError(t, ss.PluginMigration().Apply(first))
+	assert.NotZero(t, first.AppliedAt)
+
+	second := &model.PluginMigration{
+		PluginId: pluginID,
+		Version:  2,
+		Name:     "add_column",
+		Up:       "ALTER TABLE " + table + " ADD COLUMN name varchar(64)",
+		Down:     "ALTER TABLE " + table + " DROP COLUMN name",
+	}
+	require.NoError(t, ss.PluginMigration().Apply(second))
+
+	t.Run("already applied", func(t *testing.T) {
+		err := ss.PluginMigration().Apply(&model.PluginMigration{
+			PluginId: pluginID,
+			Version:  2,
+			Up:       "SELECT 1",
+		})
+		var cErr *store.ErrConflict
+		require.ErrorAs(t, err, &cErr)
+	})
+
+	migrations, err = ss.PluginMigration().GetAll(pluginID)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "create_table", migrations[0].Name)
+	assert.Equal(t, first.Down, migrations[0].Down)
+	assert.Empty(t, migrations[0].Up)
+	assert.Equal(t, int64(2), migrations[1].Version)
+
+	require.NoError(t, ss.PluginMigration().Rollback(migrations[1]))
+	require.NoError(t, ss.PluginMigration().Rollback(migrations[0]))
+
+	migrations, err = ss.PluginMigration().GetAll(pluginID)
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+
+	t.Run("rollback unknown migration", func(t *testing.T) {
+		err := ss.PluginMigration().Rollback(&model.PluginMigration{PluginId: pluginID, Version: 1})
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testPluginMigrationStoreFailedApply(t *testing.T, rctx request.CTX, ss store.Store) {
+	pluginID := "com.mattermost." + strings.ToLower(model.NewId())
+
+	err := ss.PluginMigration().Apply(&model.PluginMigration{
+		PluginId: pluginID,
+		Version:  1,
+		Up:       "NOT VALID SQL",
+	})
+	require.Error(t, err)
+
+	migrations, err := ss.PluginMigration().GetAll(pluginID)
+	require.NoError(t, err)
+	assert.Empty(t, migrations)
+}
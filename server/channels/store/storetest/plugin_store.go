@@ -6,6 +6,7 @@ package storetest
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,10 @@ func TestPluginStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore)
 	t.Run("DeleteAllForPlugin", func(t *testing.T) { testPluginDeleteAllForPlugin(t, rctx, ss) })
 	t.Run("DeleteAllExpired", func(t *testing.T) { testPluginDeleteAllExpired(t, rctx, ss) })
 	t.Run("List", func(t *testing.T) { testPluginList(t, rctx, ss) })
+	t.Run("ListWithOptions", func(t *testing.T) { testPluginListWithOptions(t, rctx, ss) })
+	t.Run("GetMulti", func(t *testing.T) { testPluginGetMulti(t, rctx, ss) })
+	t.Run("GetMetadata", func(t *testing.T) { testPluginGetMetadata(t, rctx, ss) })
+	t.Run("Batch", func(t *testing.T) { testPluginBatch(t, rctx, ss) })
 }
 
 func setupKVs(t *testing.T, rctx request.CTX, ss store.Store) (string, func()) {
@@ -1370,3 +1375,228 @@ func testPluginList(t *testing.T, rctx request.CTX, ss store.Store) {
 		})
 	})
 }
+
+func saveKVs(t *testing.T, ss store.Store, pluginId string, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		_, err := ss.Plugin().SaveOrUpdate(&model.PluginKeyValue{
+			PluginId: pluginId,
+			Key:      key,
+			Value:    []byte("value_" + key),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func testPluginListWithOptions(t *testing.T, rctx request.CTX, ss store.Store) {
+	_, tearDown := setupKVs(t, rctx, ss)
+	defer tearDown()
+
+	pluginId := model.NewId()
+	saveKVs(t, ss, pluginId, "user_a", "user_b", "user_c", "user%d", "userx", "team_a")
+	_, err := ss.Plugin().SaveOrUpdate(&model.PluginKeyValue{
+		PluginId: pluginId,
+		Key:      "user_expired",
+		Value:    []byte("value"),
+		ExpireAt: 1,
+	})
+	require.NoError(t, err)
+
+	t.Run("no options lists all keys", func(t *testing.T) {
+		keys, err := ss.Plugin().ListWithOptions(pluginId, model.PluginKVListOptions{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"team_a", "user%d", "user_a", "user_b", "user_c", "userx"}, keys)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		keys, err := ss.Plugin().ListWithOptions(pluginId, model.PluginKVListOptions{Prefix: "user_"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_a", "user_b", "user_c"}, keys)
+
+		keys, err = ss.Plugin().ListWithOptions(pluginId, model.PluginKVListOptions{Prefix: "user%"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user%d"}, keys)
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		opts := model.PluginKVListOptions{Prefix: "user_", PerPage: 2}
+		keys, err := ss.Plugin().ListWithOptions(pluginId, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_a", "user_b"}, keys)
+
+		opts.Cursor = keys[len(keys)-1]
+		keys, err = ss.Plugin().ListWithOptions(pluginId, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_c"}, keys)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		keys, err := ss.Plugin().ListWithOptions(pluginId, model.PluginKVListOptions{Cursor: "user_a", EndKey: "user_c"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_b"}, keys)
+	})
+}
+
+func testPluginGetMulti(t *testing.T, rctx request.CTX, ss store.Store) {
+	pluginId, tearDown := setupKVs(t, rctx, ss)
+	defer tearDown()
+
+	saveKVs(t, ss, pluginId, "a", "b")
+	_, err := ss.Plugin().SaveOrUpdate(&model.PluginKeyValue{
+		PluginId: pluginId,
+		Key:      "expired",
+		Value:    []byte("value"),
+		ExpireAt: 1,
+	})
+	require.NoError(t, err)
+
+	t.Run("no keys", func(t *testing.T) {
+		kvs, err := ss.Plugin().GetMulti(pluginId, nil)
+		require.NoError(t, err)
+		assert.Empty(t, kvs)
+	})
+
+	t.Run("skips missing and expired keys", func(t *testing.T) {
+		kvs, err := ss.Plugin().GetMulti(pluginId, []string{"a", "b", "expired", "missing"})
+		require.NoError(t, err)
+		require.Len(t, kvs, 2)
+
+		values := map[string]string{}
+		for _, kv := range kvs {
+			assert.Equal(t, pluginId, kv.PluginId)
+			values[kv.Key] = string(kv.Value)
+		}
+		assert.Equal(t, map[string]string{"a": "value_a", "b": "value_b"}, values)
+	})
+
+	t.Run("other plugin", func(t *testing.T) {
+		kvs, err := ss.Plugin().GetMulti(model.NewId(), []string{"a", "b"})
+		require.NoError(t, err)
+		assert.Empty(t, kvs)
+	})
+}
+
+func testPluginGetMetadata(t *testing.T, rctx request.CTX, ss store.Store) {
+	pluginId, tearDown := setupKVs(t, rctx, ss)
+	defer tearDown()
+
+	t.Run("missing key", func(t *testing.T) {
+		metadata, err := ss.Plugin().GetMetadata(pluginId, model.NewId())
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+		assert.Nil(t, metadata)
+	})
+
+	t.Run("tracks create and update times", func(t *testing.T) {
+		key := model.NewId()
+		expireAt := model.GetMillis() + 60*1000
+		_, err := ss.Plugin().SaveOrUpdate(&model.PluginKeyValue{PluginId: pluginId, Key: key, Value: []byte("1"), ExpireAt: expireAt})
+		require.NoError(t, err)
+
+		metadata, err := ss.Plugin().GetMetadata(pluginId, key)
+		require.NoError(t, err)
+		assert.Equal(t, key, metadata.Key)
+		assert.NotZero(t, metadata.CreateAt)
+		assert.Equal(t, metadata.CreateAt, metadata.UpdateAt)
+		assert.Equal(t, expireAt, metadata.ExpireAt)
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = ss.Plugin().SaveOrUpdate(&model.PluginKeyValue{PluginId: pluginId, Key: key, Value: []byte("2")})
+		require.NoError(t, err)
+
+		updated, err := ss.Plugin().GetMetadata(pluginId, key)
+		require.NoError(t, err)
+		assert.Equal(t, metadata.CreateAt, updated.CreateAt)
+		assert.Greater(t, updated.UpdateAt, metadata.UpdateAt)
+		assert.Zero(t, updated.ExpireAt)
+	})
+}
+
+func testPluginBatch(t *testing.T, rctx request.CTX, ss store.Store) {
+	getValue := func(t *testing.T, pluginId, key string) []byte {
+		t.Helper()
+		kv, err := ss.Plugin().Get(pluginId, key)
+		if _, ok := err.(*store.ErrNotFound); ok {
+			return nil
+		}
+		require.NoError(t, err)
+		return kv.Value
+	}
+
+	t.Run("invalid operation", func(t *testing.T) {
+		pluginId, tearDown := setupKVs(t, rctx, ss)
+		defer tearDown()
+
+		applied, err := ss.Plugin().Batch(pluginId, []*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1"), Options: model.PluginKVSetOptions{OldValue: []byte("0")}},
+		})
+		require.Error(t, err)
+		assert.False(t, applied)
+	})
+
+	t.Run("sets and deletes", func(t *testing.T) {
+		pluginId, tearDown := setupKVs(t, rctx, ss)
+		defer tearDown()
+
+		saveKVs(t, ss, pluginId, "c")
+
+		applied, err := ss.Plugin().Batch(pluginId, []*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1")},
+			{Key: "b", Value: []byte("2"), Options: model.PluginKVSetOptions{ExpireInSeconds: 60}},
+			{Key: "c"},
+		})
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		assert.Equal(t, []byte("1"), getValue(t, pluginId, "a"))
+		assert.Equal(t, []byte("2"), getValue(t, pluginId, "b"))
+		assert.Nil(t, getValue(t, pluginId, "c"))
+	})
+
+	t.Run("atomic operations succeed when values match", func(t *testing.T) {
+		pluginId, tearDown := setupKVs(t, rctx, ss)
+		defer tearDown()
+
+		saveKVs(t, ss, pluginId, "a", "b")
+
+		applied, err := ss.Plugin().Batch(pluginId, []*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("value_a")}},
+			{Key: "b", Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("value_b")}},
+			{Key: "c", Value: []byte("3"), Options: model.PluginKVSetOptions{Atomic: true}},
+		})
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		assert.Equal(t, []byte("1"), getValue(t, pluginId, "a"))
+		assert.Nil(t, getValue(t, pluginId, "b"))
+		assert.Equal(t, []byte("3"), getValue(t, pluginId, "c"))
+	})
+
+	t.Run("failed atomic operation rolls back the batch", func(t *testing.T) {
+		pluginId, tearDown := setupKVs(t, rctx, ss)
+		defer tearDown()
+
+		saveKVs(t, ss, pluginId, "a", "b")
+
+		testCases := map[string]*model.PluginKVBatchOperation{
+			"different old value": {Key: "b", Value: []byte("2"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("other")}},
+			"missing key":         {Key: "missing", Value: []byte("2"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("value_b")}},
+			"existing key insert": {Key: "b", Value: []byte("2"), Options: model.PluginKVSetOptions{Atomic: true}},
+		}
+
+		for description, op := range testCases {
+			t.Run(description, func(t *testing.T) {
+				applied, err := ss.Plugin().Batch(pluginId, []*model.PluginKVBatchOperation{
+					{Key: "a", Value: []byte("1")},
+					op,
+				})
+				require.NoError(t, err)
+				assert.False(t, applied)
+
+				assert.Equal(t, []byte("value_a"), getValue(t, pluginId, "a"))
+				assert.Equal(t, []byte("value_b"), getValue(t, pluginId, "b"))
+			})
+		}
+	})
+}
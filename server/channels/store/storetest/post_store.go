@@ -44,6 +44,7 @@ func TestPostStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("GetFlaggedPosts", func(t *testing.T) { testPostStoreGetFlaggedPosts(t, rctx, ss) })
 	t.Run("GetFlaggedPostsForChannel", func(t *testing.T) { testPostStoreGetFlaggedPostsForChannel(t, rctx, ss) })
 	t.Run("GetPostsCreatedAt", func(t *testing.T) { testPostStoreGetPostsCreatedAt(t, rctx, ss) })
+	t.Run("GetPostsCreatedSince", func(t *testing.T) { testPostStoreGetPostsCreatedSince(t, rctx, ss) })
 	t.Run("Overwrite", func(t *testing.T) { testPostStoreOverwrite(t, rctx, ss) })
 	t.Run("OverwriteMultiple", func(t *testing.T) { testPostStoreOverwriteMultiple(t, rctx, ss) })
 	t.Run("GetPostsByIds", func(t *testing.T) { testPostStoreGetPostsByIds(t, rctx, ss) })
@@ -56,6 +57,8 @@ func TestPostStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("GetDirectPostParentsForExportAfter", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfter(t, rctx, ss, s) })
 	t.Run("GetDirectPostParentsForExportAfterDeleted", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfterDeleted(t, rctx, ss, s) })
 	t.Run("GetDirectPostParentsForExportAfterBatched", func(t *testing.T) { testPostStoreGetDirectPostParentsForExportAfterBatched(t, rctx, ss, s) })
+	t.Run("GetParentsForUserExportAfter", func(t *testing.T) { testPostStoreGetParentsForUserExportAfter(t, rctx, ss) })
+	t.Run("GetDirectPostParentsForUserExportAfter", func(t *testing.T) { testPostStoreGetDirectPostParentsForUserExportAfter(t, rctx, ss, s) })
 	t.Run("GetForThread", func(t *testing.T) { testPostStoreGetForThread(t, rctx, ss) })
 	t.Run("HasAutoResponsePostByUserSince", func(t *testing.T) { testHasAutoResponsePostByUserSince(t, rctx, ss) })
 	t.Run("GetPostsSinceUpdateForSync", func(t *testing.T) { testGetPostsSinceUpdateForSync(t, rctx, ss, s) })
@@ -63,6 +66,8 @@ func TestPostStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("SetPostReminder", func(t *testing.T) { testSetPostReminder(t, rctx, ss, s) })
 	t.Run("GetPostReminders", func(t *testing.T) { testGetPostReminders(t, rctx, ss, s) })
 	t.Run("GetPostReminderMetadata", func(t *testing.T) { testGetPostReminderMetadata(t, rctx, ss, s) })
+	t.Run("GetPostRemindersForUser", func(t *testing.T) { testGetPostRemindersForUser(t, rctx, ss) })
+	t.Run("DeletePostReminders", func(t *testing.T) { testDeletePostReminders(t, rctx, ss) })
 	t.Run("GetNthRecentPostTime", func(t *testing.T) { testGetNthRecentPostTime(t, rctx, ss) })
 	t.Run("GetEditHistoryForPost", func(t *testing.T) { testGetEditHistoryForPost(t, rctx, ss) })
 }
@@ -3629,6 +3634,60 @@ func testPostStoreGetPostsCreatedAt(t *testing.T, rctx request.CTX, ss store.Sto
 	assert.Equal(t, 2, len(r1))
 }
 
+func testPostStoreGetPostsCreatedSince(t *testing.T, rctx request.CTX, ss store.Store) {
+	channelID := model.NewId()
+	since := model.GetMillis()
+
+	save := func(createAt int64, rootID string) *model.Post {
+		post, err := ss.Post().Save(rctx, &model.Post{
+			ChannelId: channelID,
+			UserId:    model.NewId(),
+			Message:   NewTestId(),
+			RootId:    rootID,
+			CreateAt:  createAt,
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	save(since, "")
+	root := save(since+1, "")
+	sameTime := save(since+1, "")
+	reply := save(since+2, root.Id)
+	deleted := save(since+3, "")
+	require.NoError(t, ss.Post().Delete(rctx, deleted.Id, model.GetMillis(), ""))
+
+	postIDs := func(posts []*model.Post) []string {
+		ids := make([]string, 0, len(posts))
+		for _, post := range posts {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+
+	first, second := root, sameTime
+	if second.Id < first.Id {
+		first, second = second, first
+	}
+
+	posts, err := ss.Post().GetPostsCreatedSince(channelID, since, 0, "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{reply.Id, second.Id, first.Id}, postIDs(posts))
+	for _, post := range posts {
+		if post.Id == root.Id {
+			assert.Equal(t, int64(1), post.ReplyCount)
+		}
+	}
+
+	posts, err = ss.Post().GetPostsCreatedSince(channelID, since, 0, "", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{reply.Id}, postIDs(posts))
+
+	posts, err = ss.Post().GetPostsCreatedSince(channelID, since, second.CreateAt, second.Id, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{first.Id}, postIDs(posts))
+}
+
 func testPostStoreOverwriteMultiple(t *testing.T, rctx request.CTX, ss store.Store) {
 	teamId := model.NewId()
 	channel1, err := ss.Channel().Save(rctx, &model.Channel{
@@ -4499,6 +4558,121 @@ func testPostStoreGetRepliesForExport(t *testing.T, rctx request.CTX, ss store.S
 	assert.Equal(t, reply1.Username, u1.Username)
 }
 
+func testPostStoreGetParentsForUserExportAfter(t *testing.T, rctx request.CTX, ss store.Store) {
+	team, err := ss.Team().Save(&model.Team{
+		DisplayName: "Name",
+		Name:        NewTestId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	channel, err := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "Channel",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	var users []*model.User
+	for i := 0; i < 2; i++ {
+		user, err := ss.User().Save(rctx, &model.User{
+			Username: model.NewUsername(),
+			Email:    MakeEmail(),
+		})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+
+	leftChannel, err := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      team.Id,
+		DisplayName: "Left channel",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, err)
+
+	_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{
+		ChannelId:   channel.Id,
+		UserId:      users[0].Id,
+		NotifyProps: model.GetDefaultChannelNotifyProps(),
+	})
+	require.NoError(t, err)
+
+	savePost := func(channelID, userID, rootID string) *model.Post {
+		post, err := ss.Post().Save(rctx, &model.Post{
+			ChannelId: channelID,
+			UserId:    userID,
+			RootId:    rootID,
+			Message:   NewTestId(),
+		})
+		require.NoError(t, err)
+		return post
+	}
+
+	started := savePost(channel.Id, users[0].Id, "")
+	repliedTo := savePost(channel.Id, users[1].Id, "")
+	savePost(channel.Id, users[0].Id, repliedTo.Id)
+	savePost(channel.Id, users[1].Id, "")
+	savePost(leftChannel.Id, users[0].Id, "")
+
+	posts, err := ss.Post().GetParentsForUserExportAfter(users[0].Id, 10, strings.Repeat("0", 26))
+	require.NoError(t, err)
+
+	var ids []string
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+		assert.Equal(t, team.Name, post.TeamName)
+		assert.Equal(t, channel.Name, post.ChannelName)
+	}
+	assert.ElementsMatch(t, []string{started.Id, repliedTo.Id}, ids)
+}
+
+func testPostStoreGetDirectPostParentsForUserExportAfter(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	var users []*model.User
+	for i := 0; i < 3; i++ {
+		user, err := ss.User().Save(rctx, &model.User{
+			Username: model.NewUsername(),
+			Email:    MakeEmail(),
+		})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+
+	saveDirectChannel := func(user1, user2 *model.User) *model.Channel {
+		channel, err := ss.Channel().SaveDirectChannel(rctx, &model.Channel{
+			Name: model.GetDMNameFromIds(user1.Id, user2.Id),
+			Type: model.ChannelTypeDirect,
+		}, &model.ChannelMember{
+			UserId:      user1.Id,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		}, &model.ChannelMember{
+			UserId:      user2.Id,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.NoError(t, err)
+		return channel
+	}
+
+	member := saveDirectChannel(users[0], users[1])
+	other := saveDirectChannel(users[1], users[2])
+
+	received, err := ss.Post().Save(rctx, &model.Post{ChannelId: member.Id, UserId: users[1].Id, Message: NewTestId()})
+	require.NoError(t, err)
+	_, err = ss.Post().Save(rctx, &model.Post{ChannelId: other.Id, UserId: users[1].Id, Message: NewTestId()})
+	require.NoError(t, err)
+
+	posts, err := ss.Post().GetDirectPostParentsForUserExportAfter(users[0].Id, 10, strings.Repeat("0", 26))
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, received.Id, posts[0].Id)
+	assert.ElementsMatch(t, []string{users[0].Username, users[1].Username}, *posts[0].ChannelMembers)
+
+	// Manually truncate Channels table until testlib can handle cleanups
+	s.GetMasterX().Exec("TRUNCATE Channels")
+}
+
 func testPostStoreGetDirectPostParentsForExportAfter(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	teamId := model.NewId()
 
@@ -5036,6 +5210,98 @@ func testGetPostReminders(t *testing.T, rctx request.CTX, ss store.Store, s SqlS
 	require.Len(t, reminders, 0)
 }
 
+func testGetPostRemindersForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := NewTestId()
+	otherUserID := NewTestId()
+
+	var postIDs []string
+	for _, targetTime := range []int64{300, 100, 200} {
+		post, err := ss.Post().Save(rctx, &model.Post{UserId: otherUserID, ChannelId: NewTestId(), Message: "hi there"})
+		require.NoError(t, err)
+		postIDs = append(postIDs, post.Id)
+
+		for _, id := range []string{userID, otherUserID} {
+			_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{ChannelId: post.ChannelId, UserId: id, NotifyProps: model.GetDefaultChannelNotifyProps()})
+			require.NoError(t, err)
+			require.NoError(t, ss.Post().SetPostReminder(&model.PostReminder{TargetTime: targetTime, PostId: post.Id, UserId: id}))
+		}
+	}
+
+	// The user isn't a member of the channel of this post.
+	post, err := ss.Post().Save(rctx, &model.Post{UserId: otherUserID, ChannelId: NewTestId(), Message: "hi there"})
+	require.NoError(t, err)
+	require.NoError(t, ss.Post().SetPostReminder(&model.PostReminder{TargetTime: 50, PostId: post.Id, UserId: userID}))
+
+	reminders, err := ss.Post().GetPostRemindersForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PostReminder{
+		{TargetTime: 100, PostId: postIDs[1], UserId: userID},
+		{TargetTime: 200, PostId: postIDs[2], UserId: userID},
+		{TargetTime: 300, PostId: postIDs[0], UserId: userID},
+	}, reminders)
+
+	reminders, err = ss.Post().GetPostRemindersForUser(NewTestId())
+	require.NoError(t, err)
+	assert.Empty(t, reminders)
+}
+
+func testDeletePostReminders(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := NewTestId()
+	otherUserID := NewTestId()
+	channelID := NewTestId()
+
+	otherChannelID := NewTestId()
+	for _, channelID := range []string{channelID, otherChannelID} {
+		for _, id := range []string{userID, otherUserID} {
+			_, err := ss.Channel().SaveMember(rctx, &model.ChannelMember{ChannelId: channelID, UserId: id, NotifyProps: model.GetDefaultChannelNotifyProps()})
+			require.NoError(t, err)
+		}
+	}
+
+	var postIDs []string
+	for _, channelID := range []string{channelID, channelID, otherChannelID} {
+		post, err := ss.Post().Save(rctx, &model.Post{UserId: otherUserID, ChannelId: channelID, Message: "hi there"})
+		require.NoError(t, err)
+		postIDs = append(postIDs, post.Id)
+
+		for _, id := range []string{userID, otherUserID} {
+			require.NoError(t, ss.Post().SetPostReminder(&model.PostReminder{TargetTime: 100, PostId: post.Id, UserId: id}))
+		}
+	}
+
+	remindedPostIDs := func(userID string) []string {
+		reminders, err := ss.Post().GetPostRemindersForUser(userID)
+		require.NoError(t, err)
+		ids := []string{}
+		for _, reminder := range reminders {
+			ids = append(ids, reminder.PostId)
+		}
+		return ids
+	}
+
+	t.Run("DeletePostReminder", func(t *testing.T) {
+		require.NoError(t, ss.Post().DeletePostReminder(postIDs[0], userID))
+		assert.ElementsMatch(t, postIDs[1:], remindedPostIDs(userID))
+		assert.ElementsMatch(t, postIDs, remindedPostIDs(otherUserID))
+
+		err := ss.Post().DeletePostReminder(postIDs[0], userID)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("DeleteUserPostRemindersForChannel", func(t *testing.T) {
+		require.NoError(t, ss.Post().DeleteUserPostRemindersForChannel(otherUserID, channelID))
+		assert.ElementsMatch(t, postIDs[2:], remindedPostIDs(otherUserID))
+		assert.ElementsMatch(t, postIDs[1:], remindedPostIDs(userID))
+	})
+
+	t.Run("DeletePostRemindersForPost", func(t *testing.T) {
+		require.NoError(t, ss.Post().DeletePostRemindersForPost(postIDs[2]))
+		assert.Empty(t, remindedPostIDs(otherUserID))
+		assert.ElementsMatch(t, postIDs[1:2], remindedPostIDs(userID))
+	})
+}
+
 func testGetPostReminderMetadata(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	team := &model.Team{
 		Name:        "teamname",
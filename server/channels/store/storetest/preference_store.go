@@ -17,6 +17,7 @@ import (
 func TestPreferenceStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("PreferenceSave", func(t *testing.T) { testPreferenceSave(t, rctx, ss) })
 	t.Run("PreferenceGet", func(t *testing.T) { testPreferenceGet(t, rctx, ss) })
+	t.Run("PreferenceGetForUsers", func(t *testing.T) { testPreferenceGetForUsers(t, rctx, ss) })
 	t.Run("PreferenceGetCategory", func(t *testing.T) { testPreferenceGetCategory(t, rctx, ss) })
 	t.Run("PreferenceGetAll", func(t *testing.T) { testPreferenceGetAll(t, rctx, ss) })
 	t.Run("PreferenceDeleteByUser", func(t *testing.T) { testPreferenceDeleteByUser(t, rctx, ss) })
@@ -103,6 +104,54 @@ func testPreferenceGet(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.Error(t, err, "no error on getting a missing preference")
 }
 
+func testPreferenceGetForUsers(t *testing.T, rctx request.CTX, ss store.Store) {
+	userId1 := model.NewId()
+	userId2 := model.NewId()
+	category := model.PreferenceCategoryDisplaySettings
+	name := model.NewId()
+
+	preferences := model.Preferences{
+		{
+			UserId:   userId1,
+			Category: category,
+			Name:     name,
+			Value:    "true",
+		},
+		{
+			UserId:   userId2,
+			Category: category,
+			Name:     name,
+			Value:    "false",
+		},
+		{
+			UserId:   userId1,
+			Category: category,
+			Name:     model.NewId(),
+		},
+		{
+			UserId:   userId2,
+			Category: model.NewId(),
+			Name:     name,
+		},
+		{
+			UserId:   model.NewId(),
+			Category: category,
+			Name:     name,
+		},
+	}
+
+	err := ss.Preference().Save(preferences)
+	require.NoError(t, err)
+
+	data, err := ss.Preference().GetForUsers([]string{userId1, userId2, model.NewId()}, category, name)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, model.Preferences{preferences[0], preferences[1]}, data)
+
+	data, err = ss.Preference().GetForUsers([]string{}, category, name)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+}
+
 func testPreferenceGetCategory(t *testing.T, rctx request.CTX, ss store.Store) {
 	userId := model.NewId()
 	category := model.PreferenceCategoryDirectChannelShow
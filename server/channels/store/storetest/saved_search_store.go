@@ -0,0 +1,149 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestSavedSearchStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("SaveGetUpdateAndDelete", func(t *testing.T) { testSavedSearchStoreSaveGetUpdateAndDelete(t, rctx, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testSavedSearchStoreGetForUser(t, rctx, ss) })
+	t.Run("GetAlertsForChannel", func(t *testing.T) { testSavedSearchStoreGetAlertsForChannel(t, rctx, ss) })
+}
+
+func testSavedSearchStoreSaveGetUpdateAndDelete(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	defer func() { require.NoError(t, ss.SavedSearch().PermanentDeleteByUser(userID)) }()
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.SavedSearch().Save(&model.SavedSearch{UserId: userID, Name: "empty"})
+		require.Error(t, err)
+	})
+
+	saved, err := ss.SavedSearch().Save(&model.SavedSearch{
+		UserId:         userID,
+		Name:           "Incidents",
+		Terms:          "INC-* after:2024-01-01",
+		TimeZoneOffset: 3600,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, saved.Id)
+	assert.NotZero(t, saved.CreateAt)
+
+	search, err := ss.SavedSearch().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "Incidents", search.Name)
+	assert.Equal(t, "INC-* after:2024-01-01", search.Terms)
+	require.Len(t, search.Params, 1)
+	assert.Equal(t, "INC-*", search.Params[0].Terms)
+	assert.Equal(t, "2024-01-01", search.Params[0].AfterDate)
+	assert.Equal(t, 3600, search.TimeZoneOffset)
+	assert.False(t, search.Alert)
+
+	search.Terms = "outage"
+	search.Alert = true
+	_, err = ss.SavedSearch().Update(search)
+	require.NoError(t, err)
+
+	search, err = ss.SavedSearch().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "outage", search.Params[0].Terms)
+	assert.Equal(t, 3600, search.Params[0].TimeZoneOffset)
+	assert.True(t, search.Alert)
+
+	require.NoError(t, ss.SavedSearch().Delete(saved.Id))
+
+	_, err = ss.SavedSearch().Get(saved.Id)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	err = ss.SavedSearch().Delete(saved.Id)
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.SavedSearch().Update(search)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testSavedSearchStoreGetForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	defer func() { require.NoError(t, ss.SavedSearch().PermanentDeleteByUser(userID)) }()
+
+	for _, name := range []string{"b", "c", "a"} {
+		_, err := ss.SavedSearch().Save(&model.SavedSearch{UserId: userID, Name: name, Terms: "term"})
+		require.NoError(t, err)
+	}
+	otherUserID := model.NewId()
+	_, err := ss.SavedSearch().Save(&model.SavedSearch{UserId: otherUserID, Name: "other", Terms: "term"})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.SavedSearch().PermanentDeleteByUser(otherUserID)) }()
+
+	searches, err := ss.SavedSearch().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, searches, 3)
+	assert.Equal(t, "a", searches[0].Name)
+	assert.Equal(t, "c", searches[2].Name)
+
+	count, err := ss.SavedSearch().CountForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	require.NoError(t, ss.SavedSearch().PermanentDeleteByUser(userID))
+	searches, err = ss.SavedSearch().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, searches)
+}
+
+func testSavedSearchStoreGetAlertsForChannel(t *testing.T, rctx request.CTX, ss store.Store) {
+	teamID := model.NewId()
+
+	channel := &model.Channel{TeamId: teamID, Name: model.NewId(), DisplayName: "Incidents", Type: model.ChannelTypeOpen}
+	_, err := ss.Channel().Save(rctx, channel, -1)
+	require.NoError(t, err)
+
+	member, err := ss.User().Save(rctx, &model.User{Username: model.NewUsername(), Email: MakeEmail()})
+	require.NoError(t, err)
+	deactivated, err := ss.User().Save(rctx, &model.User{Username: model.NewUsername(), Email: MakeEmail(), DeleteAt: model.GetMillis()})
+	require.NoError(t, err)
+	nonMember, err := ss.User().Save(rctx, &model.User{Username: model.NewUsername(), Email: MakeEmail()})
+	require.NoError(t, err)
+
+	for _, user := range []*model.User{member, deactivated} {
+		_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{ChannelId: channel.Id, UserId: user.Id, NotifyProps: model.GetDefaultChannelNotifyProps()})
+		require.NoError(t, err)
+	}
+
+	save := func(userID, teamID string, alert bool) *model.SavedSearch {
+		search, err := ss.SavedSearch().Save(&model.SavedSearch{UserId: userID, TeamId: teamID, Name: model.NewId(), Terms: "outage", Alert: alert})
+		require.NoError(t, err)
+		return search
+	}
+
+	allTeams := save(member.Id, "", true)
+	sameTeam := save(member.Id, teamID, true)
+	save(member.Id, model.NewId(), true)
+	save(member.Id, "", false)
+	save(deactivated.Id, "", true)
+	save(nonMember.Id, "", true)
+	defer func() {
+		for _, user := range []*model.User{member, deactivated, nonMember} {
+			require.NoError(t, ss.SavedSearch().PermanentDeleteByUser(user.Id))
+		}
+	}()
+
+	searches, err := ss.SavedSearch().GetAlertsForChannel(channel.Id, teamID)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(searches))
+	for _, search := range searches {
+		ids = append(ids, search.Id)
+	}
+	assert.ElementsMatch(t, []string{allTeams.Id, sameTeam.Id}, ids)
+}
@@ -65,6 +65,13 @@ type Store struct {
 	PostPersistentNotificationStore mocks.PostPersistentNotificationStore
 	DesktopTokensStore              mocks.DesktopTokensStore
 	ChannelBookmarkStore            mocks.ChannelBookmarkStore
+	LoginFingerprintStore           mocks.LoginFingerprintStore
+	AuditEventStore                 mocks.AuditEventStore
+	GuestAccountStore               mocks.GuestAccountStore
+	UserDataExportStore             mocks.UserDataExportStore
+	PluginMigrationStore            mocks.PluginMigrationStore
+	SavedSearchStore                mocks.SavedSearchStore
+	TranslationStore                mocks.TranslationStore
 }
 
 func (s *Store) SetContext(context context.Context)            { s.context = context }
@@ -123,6 +130,31 @@ func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 func (s *Store) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return &s.PostPersistentNotificationStore
 }
+func (s *Store) LoginFingerprint() store.LoginFingerprintStore {
+	return &s.LoginFingerprintStore
+}
+func (s *Store) AuditEvent() store.AuditEventStore {
+	return &s.AuditEventStore
+}
+func (s *Store) GuestAccount() store.GuestAccountStore {
+	return &s.GuestAccountStore
+}
+
+func (s *Store) UserDataExport() store.UserDataExportStore {
+	return &s.UserDataExportStore
+}
+
+func (s *Store) PluginMigration() store.PluginMigrationStore {
+	return &s.PluginMigrationStore
+}
+
+func (s *Store) SavedSearch() store.SavedSearchStore {
+	return &s.SavedSearchStore
+}
+
+func (s *Store) Translation() store.TranslationStore {
+	return &s.TranslationStore
+}
 func (s *Store) MarkSystemRanUnitTests()             { /* do nothing */ }
 func (s *Store) Close()                              { /* do nothing */ }
 func (s *Store) LockToMaster()                       { /* do nothing */ }
@@ -188,5 +220,12 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.PostPersistentNotificationStore,
 		&s.DesktopTokensStore,
 		&s.ChannelBookmarkStore,
+		&s.LoginFingerprintStore,
+		&s.AuditEventStore,
+		&s.GuestAccountStore,
+		&s.UserDataExportStore,
+		&s.PluginMigrationStore,
+		&s.SavedSearchStore,
+		&s.TranslationStore,
 	)
 }
@@ -16,6 +16,7 @@ import (
 
 func TestTokensStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("TokensCleanup", func(t *testing.T) { testTokensCleanup(t, rctx, ss) })
+	t.Run("GetTokensByTypeAndExtraPrefix", func(t *testing.T) { testGetTokensByTypeAndExtraPrefix(t, rctx, ss) })
 }
 
 func testTokensCleanup(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -41,3 +42,28 @@ func testTokensCleanup(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.NoError(t, err)
 	assert.Len(t, tokens, 0)
 }
+
+func testGetTokensByTypeAndExtraPrefix(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+	tokenType := model.NewId()
+	prefix := `{"UserId":"` + model.NewId() + `"`
+
+	matching := &model.Token{Token: model.NewRandomString(model.TokenSize), CreateAt: now, Type: tokenType, Extra: prefix + `,"Value":"a"}`}
+	for _, token := range []*model.Token{
+		matching,
+		{Token: model.NewRandomString(model.TokenSize), CreateAt: now - 1000, Type: tokenType, Extra: prefix + `,"Value":"b"}`},
+		{Token: model.NewRandomString(model.TokenSize), CreateAt: now, Type: tokenType, Extra: `{"UserId":"` + model.NewId() + `"}`},
+		{Token: model.NewRandomString(model.TokenSize), CreateAt: now, Type: model.NewId(), Extra: prefix + `}`},
+	} {
+		require.NoError(t, ss.Token().Save(token))
+	}
+
+	tokens, err := ss.Token().GetTokensByTypeAndExtraPrefix(tokenType, prefix, now-500)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, matching.Token, tokens[0].Token)
+
+	tokens, err = ss.Token().GetTokensByTypeAndExtraPrefix(tokenType, `{"UserId":"%`, 0)
+	require.NoError(t, err)
+	assert.Empty(t, tokens, "wildcards in the prefix must match literally")
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestTranslationStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("SaveAndGetPostTranslations", func(t *testing.T) { testTranslationStoreSaveAndGetPostTranslations(t, rctx, ss) })
+	t.Run("PermanentDeletePostTranslations", func(t *testing.T) { testTranslationStorePermanentDeletePostTranslations(t, rctx, ss) })
+	t.Run("ChannelSettings", func(t *testing.T) { testTranslationStoreChannelSettings(t, rctx, ss) })
+	t.Run("GetChannelMemberLocales", func(t *testing.T) { testTranslationStoreGetChannelMemberLocales(t, rctx, ss) })
+}
+
+func testTranslationStoreSaveAndGetPostTranslations(t *testing.T, rctx request.CTX, ss store.Store) {
+	postID := model.NewId()
+	otherPostID := model.NewId()
+	defer func() {
+		require.NoError(t, ss.Translation().PermanentDeletePostTranslations(postID))
+		require.NoError(t, ss.Translation().PermanentDeletePostTranslations(otherPostID))
+	}()
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: postID, Message: "hallo"})
+		require.Error(t, err)
+	})
+
+	saved, err := ss.Translation().SavePostTranslation(&model.PostTranslation{
+		PostId:         postID,
+		Locale:         "de",
+		Message:        "hallo",
+		SourceLanguage: "en",
+		Provider:       model.TranslationProviderLibreTranslate,
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.CreateAt)
+
+	_, err = ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: postID, Locale: "fr", Message: "bonjour"})
+	require.NoError(t, err)
+	_, err = ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: otherPostID, Locale: "de", Message: "welt"})
+	require.NoError(t, err)
+
+	translations, err := ss.Translation().GetPostTranslations([]string{postID, otherPostID, model.NewId()}, "de")
+	require.NoError(t, err)
+	require.Len(t, translations, 2)
+	byPost := map[string]*model.PostTranslation{}
+	for _, translation := range translations {
+		byPost[translation.PostId] = translation
+	}
+	assert.Equal(t, *saved, *byPost[postID])
+	assert.Equal(t, "welt", byPost[otherPostID].Message)
+
+	t.Run("overwrite the translation of an edited post", func(t *testing.T) {
+		_, err := ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: postID, Locale: "de", PostEditAt: 1234, Message: "hallo welt"})
+		require.NoError(t, err)
+
+		translations, err := ss.Translation().GetPostTranslations([]string{postID}, "de")
+		require.NoError(t, err)
+		require.Len(t, translations, 1)
+		assert.Equal(t, "hallo welt", translations[0].Message)
+		assert.Equal(t, int64(1234), translations[0].PostEditAt)
+	})
+
+	t.Run("no posts", func(t *testing.T) {
+		translations, err := ss.Translation().GetPostTranslations(nil, "de")
+		require.NoError(t, err)
+		assert.Empty(t, translations)
+	})
+}
+
+func testTranslationStorePermanentDeletePostTranslations(t *testing.T, rctx request.CTX, ss store.Store) {
+	postID := model.NewId()
+	otherPostID := model.NewId()
+	defer func() { require.NoError(t, ss.Translation().PermanentDeletePostTranslations(otherPostID)) }()
+
+	for _, locale := range []string{"de", "fr"} {
+		_, err := ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: postID, Locale: locale, Message: "message"})
+		require.NoError(t, err)
+	}
+	_, err := ss.Translation().SavePostTranslation(&model.PostTranslation{PostId: otherPostID, Locale: "de", Message: "message"})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.Translation().PermanentDeletePostTranslations(postID))
+
+	for _, locale := range []string{"de", "fr"} {
+		translations, err := ss.Translation().GetPostTranslations([]string{postID}, locale)
+		require.NoError(t, err)
+		assert.Empty(t, translations)
+	}
+
+	translations, err := ss.Translation().GetPostTranslations([]string{otherPostID}, "de")
+	require.NoError(t, err)
+	assert.Len(t, translations, 1)
+}
+
+func testTranslationStoreChannelSettings(t *testing.T, rctx request.CTX, ss store.Store) {
+	channelID := model.NewId()
+
+	_, err := ss.Translation().GetChannelSettings(channelID)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.Translation().SaveChannelSettings(&model.ChannelTranslationSettings{ChannelId: "invalid"})
+	require.Error(t, err)
+
+	saved, err := ss.Translation().SaveChannelSettings(&model.ChannelTranslationSettings{ChannelId: channelID, AutoTranslate: true})
+	require.NoError(t, err)
+	assert.NotZero(t, saved.UpdateAt)
+
+	settings, err := ss.Translation().GetChannelSettings(channelID)
+	require.NoError(t, err)
+	assert.Equal(t, *saved, *settings)
+
+	_, err = ss.Translation().SaveChannelSettings(&model.ChannelTranslationSettings{ChannelId: channelID, AutoTranslate: false})
+	require.NoError(t, err)
+
+	settings, err = ss.Translation().GetChannelSettings(channelID)
+	require.NoError(t, err)
+	assert.False(t, settings.AutoTranslate)
+}
+
+func testTranslationStoreGetChannelMemberLocales(t *testing.T, rctx request.CTX, ss store.Store) {
+	channel := &model.Channel{TeamId: model.NewId(), Name: model.NewId(), DisplayName: "Translations", Type: model.ChannelTypeOpen}
+	_, err := ss.Channel().Save(rctx, channel, -1)
+	require.NoError(t, err)
+
+	var members []*model.User
+	for _, user := range []*model.User{
+		{Locale: "de"},
+		{Locale: "de"},
+		{Locale: "fr"},
+		{Locale: "es", DeleteAt: model.GetMillis()},
+	} {
+		user.Username = model.NewUsername()
+		user.Email = MakeEmail()
+		saved, err := ss.User().Save(rctx, user)
+		require.NoError(t, err)
+		members = append(members, saved)
+	}
+	_, err = ss.User().Save(rctx, &model.User{Username: model.NewUsername(), Email: MakeEmail(), Locale: "ja"})
+	require.NoError(t, err)
+
+	for _, user := range members {
+		_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{ChannelId: channel.Id, UserId: user.Id, NotifyProps: model.GetDefaultChannelNotifyProps()})
+		require.NoError(t, err)
+	}
+
+	locales, err := ss.Translation().GetChannelMemberLocales(channel.Id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de", "fr"}, locales)
+}
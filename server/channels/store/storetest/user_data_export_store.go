@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestUserDataExportStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("SaveGetAndUpdate", func(t *testing.T) { testUserDataExportStoreSaveGetAndUpdate(t, rctx, ss) })
+	t.Run("GetLatestForUser", func(t *testing.T) { testUserDataExportStoreGetLatestForUser(t, rctx, ss) })
+	t.Run("SaveWithJob", func(t *testing.T) { testUserDataExportStoreSaveWithJob(t, rctx, ss) })
+}
+
+func testUserDataExportStoreSaveGetAndUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	jobID := model.NewId()
+	userID := model.NewId()
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ss.UserDataExport().Get(jobID)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ss.UserDataExport().Save(&model.UserDataExport{JobId: jobID})
+		require.Error(t, err)
+	})
+
+	saved, err := ss.UserDataExport().Save(&model.UserDataExport{
+		JobId:  jobID,
+		UserId: userID,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.UserDataExport().PermanentDeleteByUser(userID)) }()
+	assert.NotZero(t, saved.CreateAt)
+
+	saved.ExpiresAt = saved.CreateAt + 1000
+	_, err = ss.UserDataExport().Update(saved)
+	require.NoError(t, err)
+
+	export, err := ss.UserDataExport().Get(jobID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, export.UserId)
+	assert.Equal(t, saved.CreateAt+1000, export.ExpiresAt)
+
+	t.Run("update unknown export", func(t *testing.T) {
+		_, err := ss.UserDataExport().Update(&model.UserDataExport{
+			JobId:    model.NewId(),
+			UserId:   userID,
+			CreateAt: 1000,
+		})
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testUserDataExportStoreGetLatestForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	_, err := ss.UserDataExport().GetLatestForUser(userID)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	for _, createAt := range []int64{1000, 3000, 2000} {
+		_, err = ss.UserDataExport().Save(&model.UserDataExport{
+			JobId:    model.NewId(),
+			UserId:   userID,
+			CreateAt: createAt,
+		})
+		require.NoError(t, err)
+	}
+	otherUserID := model.NewId()
+	_, err = ss.UserDataExport().Save(&model.UserDataExport{
+		JobId:    model.NewId(),
+		UserId:   otherUserID,
+		CreateAt: 4000,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.UserDataExport().PermanentDeleteByUser(otherUserID)) }()
+
+	latest, err := ss.UserDataExport().GetLatestForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), latest.CreateAt)
+
+	require.NoError(t, ss.UserDataExport().PermanentDeleteByUser(userID))
+	_, err = ss.UserDataExport().GetLatestForUser(userID)
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testUserDataExportStoreSaveWithJob(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	defer func() { require.NoError(t, ss.UserDataExport().PermanentDeleteByUser(userID)) }()
+
+	newJob := func(createAt int64) *model.Job {
+		return &model.Job{
+			Id:       model.NewId(),
+			Type:     model.JobTypeUserDataExport,
+			CreateAt: createAt,
+			Status:   model.JobStatusPending,
+			Data:     map[string]string{"user_id": userID},
+		}
+	}
+
+	job := newJob(2000)
+	export, err := ss.UserDataExport().SaveWithJob(&model.UserDataExport{JobId: job.Id, UserId: userID, CreateAt: job.CreateAt}, job, 0)
+	require.NoError(t, err)
+	defer func() { _, _ = ss.Job().Delete(job.Id) }()
+	assert.Equal(t, job.Id, export.JobId)
+
+	savedJob, err := ss.Job().Get(rctx, job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, userID, savedJob.Data["user_id"])
+
+	t.Run("export requested since", func(t *testing.T) {
+		job := newJob(3000)
+		_, err := ss.UserDataExport().SaveWithJob(&model.UserDataExport{JobId: job.Id, UserId: userID, CreateAt: job.CreateAt}, job, 1000)
+		var leErr *store.ErrLimitExceeded
+		require.ErrorAs(t, err, &leErr)
+
+		_, err = ss.Job().Get(rctx, job.Id)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr, "the job must not be saved")
+	})
+
+	t.Run("no export requested since", func(t *testing.T) {
+		job := newJob(3000)
+		_, err := ss.UserDataExport().SaveWithJob(&model.UserDataExport{JobId: job.Id, UserId: userID, CreateAt: job.CreateAt}, job, 2000)
+		require.NoError(t, err)
+		defer func() { _, _ = ss.Job().Delete(job.Id) }()
+	})
+}
@@ -20,6 +20,7 @@ type TimerLayer struct {
 	store.Store
 	Metrics                         einterfaces.MetricsInterface
 	AuditStore                      store.AuditStore
+	AuditEventStore                 store.AuditEventStore
 	BotStore                        store.BotStore
 	ChannelStore                    store.ChannelStore
 	ChannelBookmarkStore            store.ChannelBookmarkStore
@@ -33,13 +34,16 @@ type TimerLayer struct {
 	EmojiStore                      store.EmojiStore
 	FileInfoStore                   store.FileInfoStore
 	GroupStore                      store.GroupStore
+	GuestAccountStore               store.GuestAccountStore
 	JobStore                        store.JobStore
 	LicenseStore                    store.LicenseStore
 	LinkMetadataStore               store.LinkMetadataStore
+	LoginFingerprintStore           store.LoginFingerprintStore
 	NotifyAdminStore                store.NotifyAdminStore
 	OAuthStore                      store.OAuthStore
 	OutgoingOAuthConnectionStore    store.OutgoingOAuthConnectionStore
 	PluginStore                     store.PluginStore
+	PluginMigrationStore            store.PluginMigrationStore
 	PostStore                       store.PostStore
 	PostAcknowledgementStore        store.PostAcknowledgementStore
 	PostPersistentNotificationStore store.PostPersistentNotificationStore
@@ -50,6 +54,7 @@ type TimerLayer struct {
 	RemoteClusterStore              store.RemoteClusterStore
 	RetentionPolicyStore            store.RetentionPolicyStore
 	RoleStore                       store.RoleStore
+	SavedSearchStore                store.SavedSearchStore
 	SchemeStore                     store.SchemeStore
 	SessionStore                    store.SessionStore
 	SharedChannelStore              store.SharedChannelStore
@@ -59,9 +64,11 @@ type TimerLayer struct {
 	TermsOfServiceStore             store.TermsOfServiceStore
 	ThreadStore                     store.ThreadStore
 	TokenStore                      store.TokenStore
+	TranslationStore                store.TranslationStore
 	UploadSessionStore              store.UploadSessionStore
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
+	UserDataExportStore             store.UserDataExportStore
 	UserTermsOfServiceStore         store.UserTermsOfServiceStore
 	WebhookStore                    store.WebhookStore
 }
@@ -70,6 +77,10 @@ func (s *TimerLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *TimerLayer) AuditEvent() store.AuditEventStore {
+	return s.AuditEventStore
+}
+
 func (s *TimerLayer) Bot() store.BotStore {
 	return s.BotStore
 }
@@ -122,6 +133,10 @@ func (s *TimerLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *TimerLayer) GuestAccount() store.GuestAccountStore {
+	return s.GuestAccountStore
+}
+
 func (s *TimerLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -134,6 +149,10 @@ func (s *TimerLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *TimerLayer) LoginFingerprint() store.LoginFingerprintStore {
+	return s.LoginFingerprintStore
+}
+
 func (s *TimerLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -150,6 +169,10 @@ func (s *TimerLayer) Plugin() store.PluginStore {
 	return s.PluginStore
 }
 
+func (s *TimerLayer) PluginMigration() store.PluginMigrationStore {
+	return s.PluginMigrationStore
+}
+
 func (s *TimerLayer) Post() store.PostStore {
 	return s.PostStore
 }
@@ -190,6 +213,10 @@ func (s *TimerLayer) Role() store.RoleStore {
 	return s.RoleStore
 }
 
+func (s *TimerLayer) SavedSearch() store.SavedSearchStore {
+	return s.SavedSearchStore
+}
+
 func (s *TimerLayer) Scheme() store.SchemeStore {
 	return s.SchemeStore
 }
@@ -226,6 +253,10 @@ func (s *TimerLayer) Token() store.TokenStore {
 	return s.TokenStore
 }
 
+func (s *TimerLayer) Translation() store.TranslationStore {
+	return s.TranslationStore
+}
+
 func (s *TimerLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
@@ -238,6 +269,10 @@ func (s *TimerLayer) UserAccessToken() store.UserAccessTokenStore {
 	return s.UserAccessTokenStore
 }
 
+func (s *TimerLayer) UserDataExport() store.UserDataExportStore {
+	return s.UserDataExportStore
+}
+
 func (s *TimerLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
@@ -251,6 +286,11 @@ type TimerLayerAuditStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerAuditEventStore struct {
+	store.AuditEventStore
+	Root *TimerLayer
+}
+
 type TimerLayerBotStore struct {
 	store.BotStore
 	Root *TimerLayer
@@ -316,6 +356,11 @@ type TimerLayerGroupStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerGuestAccountStore struct {
+	store.GuestAccountStore
+	Root *TimerLayer
+}
+
 type TimerLayerJobStore struct {
 	store.JobStore
 	Root *TimerLayer
@@ -331,6 +376,11 @@ type TimerLayerLinkMetadataStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerLoginFingerprintStore struct {
+	store.LoginFingerprintStore
+	Root *TimerLayer
+}
+
 type TimerLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *TimerLayer
@@ -351,6 +401,11 @@ type TimerLayerPluginStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerPluginMigrationStore struct {
+	store.PluginMigrationStore
+	Root *TimerLayer
+}
+
 type TimerLayerPostStore struct {
 	store.PostStore
 	Root *TimerLayer
@@ -401,6 +456,11 @@ type TimerLayerRoleStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerSavedSearchStore struct {
+	store.SavedSearchStore
+	Root *TimerLayer
+}
+
 type TimerLayerSchemeStore struct {
 	store.SchemeStore
 	Root *TimerLayer
@@ -446,6 +506,11 @@ type TimerLayerTokenStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerTranslationStore struct {
+	store.TranslationStore
+	Root *TimerLayer
+}
+
 type TimerLayerUploadSessionStore struct {
 	store.UploadSessionStore
 	Root *TimerLayer
@@ -461,6 +526,11 @@ type TimerLayerUserAccessTokenStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerUserDataExportStore struct {
+	store.UserDataExportStore
+	Root *TimerLayer
+}
+
 type TimerLayerUserTermsOfServiceStore struct {
 	store.UserTermsOfServiceStore
 	Root *TimerLayer
@@ -519,6 +589,38 @@ func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
 	return err
 }
 
+func (s *TimerLayerAuditEventStore) Save(event *model.AuditEvent) (*model.AuditEvent, error) {
+	start := time.Now()
+
+	result, err := s.AuditEventStore.Save(event)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditEventStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAuditEventStore) Search(opts model.AuditEventSearchOpts) ([]*model.AuditEvent, error) {
+	start := time.Now()
+
+	result, err := s.AuditEventStore.Search(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditEventStore.Search", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 	start := time.Now()
 
@@ -3341,6 +3443,22 @@ func (s *TimerLayerEmojiStore) Get(c request.CTX, id string, allowFromCache bool
 	return result, err
 }
 
+func (s *TimerLayerEmojiStore) GetByCreator(creatorID string, offset int, limit int) ([]*model.Emoji, error) {
+	start := time.Now()
+
+	result, err := s.EmojiStore.GetByCreator(creatorID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.GetByCreator", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerEmojiStore) GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error) {
 	start := time.Now()
 
@@ -4651,6 +4769,182 @@ func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 	return result, err
 }
 
+func (s *TimerLayerGuestAccountStore) DeletePendingInvite(id string) error {
+	start := time.Now()
+
+	err := s.GuestAccountStore.DeletePendingInvite(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.DeletePendingInvite", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerGuestAccountStore) Get(userID string) (*model.GuestAccount, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.Get(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) GetExpired(now int64, limit int) ([]*model.GuestAccount, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.GetExpired(now, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.GetExpired", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) GetExpiring(before int64, limit int) ([]*model.GuestAccount, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.GetExpiring(before, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.GetExpiring", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) GetPendingInvite(id string) (*model.PendingGuestInvite, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.GetPendingInvite(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.GetPendingInvite", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) GetPendingInvites(offset int, limit int) ([]*model.PendingGuestInvite, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.GetPendingInvites(offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.GetPendingInvites", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) GetUntrackedGuestIds(limit int) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.GetUntrackedGuestIds(limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.GetUntrackedGuestIds", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.GuestAccountStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerGuestAccountStore) Save(account *model.GuestAccount) (*model.GuestAccount, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.Save(account)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) SavePendingInvite(invite *model.PendingGuestInvite) (*model.PendingGuestInvite, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.SavePendingInvite(invite)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.SavePendingInvite", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGuestAccountStore) Update(account *model.GuestAccount) (*model.GuestAccount, error) {
+	start := time.Now()
+
+	result, err := s.GuestAccountStore.Update(account)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GuestAccountStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerJobStore) Cleanup(expiryTime int64, batchSize int) error {
 	start := time.Now()
 
@@ -5003,10 +5297,10 @@ func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
+func (s *TimerLayerLoginFingerprintStore) CountForUser(userID string) (int64, error) {
 	start := time.Now()
 
-	err := s.NotifyAdminStore.DeleteBefore(trial, now)
+	result, err := s.LoginFingerprintStore.CountForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5014,15 +5308,15 @@ func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.DeleteBefore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginFingerprintStore.CountForUser", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
+func (s *TimerLayerLoginFingerprintStore) Get(userID string, fingerprintType string, value string) (*model.LoginFingerprint, error) {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.Get(trial)
+	result, err := s.LoginFingerprintStore.Get(userID, fingerprintType, value)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5030,15 +5324,15 @@ func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginFingerprintStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
+func (s *TimerLayerLoginFingerprintStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
+	err := s.LoginFingerprintStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5046,15 +5340,15 @@ func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, fe
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.GetDataByUserIdAndFeature", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginFingerprintStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
+func (s *TimerLayerLoginFingerprintStore) Save(fingerprint *model.LoginFingerprint) (*model.LoginFingerprint, error) {
 	start := time.Now()
 
-	result, err := s.NotifyAdminStore.Save(data)
+	result, err := s.LoginFingerprintStore.Save(fingerprint)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5062,15 +5356,15 @@ func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.N
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("LoginFingerprintStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 	start := time.Now()
 
-	err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+	err := s.NotifyAdminStore.DeleteBefore(trial, now)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5078,15 +5372,15 @@ func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.DeleteBefore", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
+func (s *TimerLayerNotifyAdminStore) Get(trial bool) ([]*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	err := s.OAuthStore.DeleteApp(id)
+	result, err := s.NotifyAdminStore.Get(trial)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5094,15 +5388,15 @@ func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Get", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+func (s *TimerLayerNotifyAdminStore) GetDataByUserIdAndFeature(userId string, feature model.MattermostFeature) ([]*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAccessData(token)
+	result, err := s.NotifyAdminStore.GetDataByUserIdAndFeature(userId, feature)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5110,15 +5404,79 @@ func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, e
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.GetDataByUserIdAndFeature", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+func (s *TimerLayerNotifyAdminStore) Save(data *model.NotifyAdminData) (*model.NotifyAdminData, error) {
 	start := time.Now()
 
-	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
+	result, err := s.NotifyAdminStore.Save(data)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerNotifyAdminStore) Update(userId string, requiredPlan string, requiredFeature model.MattermostFeature, now int64) error {
+	start := time.Now()
+
+	err := s.NotifyAdminStore.Update(userId, requiredPlan, requiredFeature, now)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("NotifyAdminStore.Update", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) DeleteApp(id string) error {
+	start := time.Now()
+
+	err := s.OAuthStore.DeleteApp(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.DeleteApp", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerOAuthStore) GetAccessData(token string) (*model.AccessData, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.GetAccessData(token)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.GetAccessData", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerOAuthStore) GetAccessDataByRefreshToken(token string) (*model.AccessData, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.GetAccessDataByRefreshToken(token)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -5499,6 +5857,22 @@ func (s *TimerLayerOutgoingOAuthConnectionStore) UpdateConnection(c request.CTX,
 	return result, err
 }
 
+func (s *TimerLayerPluginStore) Batch(pluginID string, ops []*model.PluginKVBatchOperation) (bool, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.Batch(pluginID, ops)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.Batch", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPluginStore) CompareAndDelete(keyVal *model.PluginKeyValue, oldValue []byte) (bool, error) {
 	start := time.Now()
 
@@ -5595,6 +5969,38 @@ func (s *TimerLayerPluginStore) Get(pluginID string, key string) (*model.PluginK
 	return result, err
 }
 
+func (s *TimerLayerPluginStore) GetMetadata(pluginID string, key string) (*model.PluginKVMetadata, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.GetMetadata(pluginID, key)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.GetMetadata", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginStore) GetMulti(pluginID string, keys []string) ([]*model.PluginKeyValue, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.GetMulti(pluginID, keys)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.GetMulti", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]string, error) {
 	start := time.Now()
 
@@ -5611,6 +6017,22 @@ func (s *TimerLayerPluginStore) List(pluginID string, page int, perPage int) ([]
 	return result, err
 }
 
+func (s *TimerLayerPluginStore) ListWithOptions(pluginID string, options model.PluginKVListOptions) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.PluginStore.ListWithOptions(pluginID, options)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginStore.ListWithOptions", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPluginStore) SaveOrUpdate(keyVal *model.PluginKeyValue) (*model.PluginKeyValue, error) {
 	start := time.Now()
 
@@ -5643,6 +6065,54 @@ func (s *TimerLayerPluginStore) SetWithOptions(pluginID string, key string, valu
 	return result, err
 }
 
+func (s *TimerLayerPluginMigrationStore) Apply(migration *model.PluginMigration) error {
+	start := time.Now()
+
+	err := s.PluginMigrationStore.Apply(migration)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginMigrationStore.Apply", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPluginMigrationStore) GetAll(pluginID string) ([]*model.PluginMigration, error) {
+	start := time.Now()
+
+	result, err := s.PluginMigrationStore.GetAll(pluginID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginMigrationStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPluginMigrationStore) Rollback(migration *model.PluginMigration) error {
+	start := time.Now()
+
+	err := s.PluginMigrationStore.Rollback(migration)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PluginMigrationStore.Rollback", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerPostStore) AnalyticsPostCount(options *model.PostCountOptions) (int64, error) {
 	start := time.Now()
 
@@ -5722,6 +6192,54 @@ func (s *TimerLayerPostStore) Delete(rctx request.CTX, postID string, timestamp
 	return err
 }
 
+func (s *TimerLayerPostStore) DeletePostReminder(postID string, userID string) error {
+	start := time.Now()
+
+	err := s.PostStore.DeletePostReminder(postID, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeletePostReminder", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) DeletePostRemindersForPost(postID string) error {
+	start := time.Now()
+
+	err := s.PostStore.DeletePostRemindersForPost(postID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeletePostRemindersForPost", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostStore) DeleteUserPostRemindersForChannel(userID string, channelID string) error {
+	start := time.Now()
+
+	err := s.PostStore.DeleteUserPostRemindersForChannel(userID, channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeleteUserPostRemindersForChannel", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	start := time.Now()
 
@@ -5754,6 +6272,22 @@ func (s *TimerLayerPostStore) GetDirectPostParentsForExportAfter(limit int, afte
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetDirectPostParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.DirectPostForExport, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetDirectPostParentsForUserExportAfter(userID, limit, afterID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetDirectPostParentsForUserExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetEditHistoryForPost(postId string) ([]*model.Post, error) {
 	start := time.Now()
 
@@ -5914,6 +6448,22 @@ func (s *TimerLayerPostStore) GetParentsForExportAfter(limit int, afterID string
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetParentsForUserExportAfter(userID string, limit int, afterID string) ([]*model.PostForExport, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetParentsForUserExportAfter(userID, limit, afterID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetParentsForUserExportAfter", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetPostAfterTime(channelID string, timestamp int64, collapsedThreads bool) (*model.Post, error) {
 	start := time.Now()
 
@@ -5994,6 +6544,22 @@ func (s *TimerLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostRemindersForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostRemindersForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	start := time.Now()
 
@@ -6106,6 +6672,22 @@ func (s *TimerLayerPostStore) GetPostsCreatedAt(channelID string, timestamp int6
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetPostsCreatedSince(channelID string, since int64, beforeTime int64, beforeID string, limit int) ([]*model.Post, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostsCreatedSince(channelID, since, beforeTime, beforeID, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostsCreatedSince", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetPostsSince(options model.GetPostsSinceOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	start := time.Now()
 
@@ -6793,6 +7375,22 @@ func (s *TimerLayerPreferenceStore) GetCategoryAndName(category string, nane str
 	return result, err
 }
 
+func (s *TimerLayerPreferenceStore) GetForUsers(userIDs []string, category string, name string) (model.Preferences, error) {
+	start := time.Now()
+
+	result, err := s.PreferenceStore.GetForUsers(userIDs, category, name)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetForUsers", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
@@ -7001,6 +7599,22 @@ func (s *TimerLayerReactionStore) GetForPostSince(postId string, since int64, ex
 	return result, err
 }
 
+func (s *TimerLayerReactionStore) GetForUser(userID string, offset int, limit int) ([]*model.Reaction, error) {
+	start := time.Now()
+
+	result, err := s.ReactionStore.GetForUser(userID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ReactionStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerReactionStore) GetSingle(userID string, postID string, remoteID string, emojiName string) (*model.Reaction, error) {
 	start := time.Now()
 
@@ -7428,15 +8042,143 @@ func (s *TimerLayerRetentionPolicyStore) GetTeamPoliciesForUser(userID string, o
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamPoliciesForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeams", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamsCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.Patch(patch)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Patch", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
+	start := time.Now()
+
+	err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveChannels", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+	start := time.Now()
+
+	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+	start := time.Now()
+
+	result, err := s.RetentionPolicyStore.Save(policy)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.AllChannelSchemeRoles()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+	start := time.Now()
+
+	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, limit int) ([]*model.Team, error) {
+func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
 	start := time.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeams(policyId, offset, limit)
+	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7444,15 +8186,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeams(policyId string, offset int, l
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64, error) {
+func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 	start := time.Now()
 
-	result, err := s.RetentionPolicyStore.GetTeamsCount(policyId)
+	result, err := s.RoleStore.Delete(roleID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7460,15 +8202,15 @@ func (s *TimerLayerRetentionPolicyStore) GetTeamsCount(policyId string) (int64,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.GetTeamsCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
 	start := time.Now()
 
-	result, err := s.RetentionPolicyStore.Patch(patch)
+	result, err := s.RoleStore.Get(roleID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7476,15 +8218,15 @@ func (s *TimerLayerRetentionPolicyStore) Patch(patch *model.RetentionPolicyWithT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Patch", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channelIds []string) error {
+func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
 	start := time.Now()
 
-	err := s.RetentionPolicyStore.RemoveChannels(policyId, channelIds)
+	result, err := s.RoleStore.GetAll()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7492,15 +8234,15 @@ func (s *TimerLayerRetentionPolicyStore) RemoveChannels(policyId string, channel
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveChannels", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []string) error {
+func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
 	start := time.Now()
 
-	err := s.RetentionPolicyStore.RemoveTeams(policyId, teamIds)
+	result, err := s.RoleStore.GetByName(ctx, name)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7508,15 +8250,15 @@ func (s *TimerLayerRetentionPolicyStore) RemoveTeams(policyId string, teamIds []
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.RemoveTeams", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithTeamAndChannelIDs) (*model.RetentionPolicyWithTeamAndChannelCounts, error) {
+func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
 	start := time.Now()
 
-	result, err := s.RetentionPolicyStore.Save(policy)
+	result, err := s.RoleStore.GetByNames(names)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7524,15 +8266,15 @@ func (s *TimerLayerRetentionPolicyStore) Save(policy *model.RetentionPolicyWithT
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RetentionPolicyStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
+func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
 	start := time.Now()
 
-	result, err := s.RoleStore.AllChannelSchemeRoles()
+	err := s.RoleStore.PermanentDeleteAll()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7540,15 +8282,15 @@ func (s *TimerLayerRoleStore) AllChannelSchemeRoles() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.AllChannelSchemeRoles", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string) (map[string]*model.RolePermissions, error) {
+func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.ChannelHigherScopedPermissions(roleNames)
+	result, err := s.RoleStore.Save(role)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7556,15 +8298,15 @@ func (s *TimerLayerRoleStore) ChannelHigherScopedPermissions(roleNames []string)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelHigherScopedPermissions", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) CountForUser(userID string) (int64, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.ChannelRolesUnderTeamRole(roleName)
+	result, err := s.SavedSearchStore.CountForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7572,15 +8314,15 @@ func (s *TimerLayerRoleStore) ChannelRolesUnderTeamRole(roleName string) ([]*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.ChannelRolesUnderTeamRole", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.CountForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) Delete(id string) error {
 	start := time.Now()
 
-	result, err := s.RoleStore.Delete(roleID)
+	err := s.SavedSearchStore.Delete(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7588,15 +8330,15 @@ func (s *TimerLayerRoleStore) Delete(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) Get(id string) (*model.SavedSearch, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.Get(roleID)
+	result, err := s.SavedSearchStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7604,15 +8346,15 @@ func (s *TimerLayerRoleStore) Get(roleID string) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) GetAlertsForChannel(channelID string, teamID string) ([]*model.SavedSearch, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetAll()
+	result, err := s.SavedSearchStore.GetAlertsForChannel(channelID, teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7620,15 +8362,15 @@ func (s *TimerLayerRoleStore) GetAll() ([]*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.GetAlertsForChannel", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) GetForUser(userID string) ([]*model.SavedSearch, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetByName(ctx, name)
+	result, err := s.SavedSearchStore.GetForUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7636,15 +8378,15 @@ func (s *TimerLayerRoleStore) GetByName(ctx context.Context, name string) (*mode
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByName", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.GetForUser", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
-	result, err := s.RoleStore.GetByNames(names)
+	err := s.SavedSearchStore.PermanentDeleteByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7652,15 +8394,15 @@ func (s *TimerLayerRoleStore) GetByNames(names []string) ([]*model.Role, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.GetByNames", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.PermanentDeleteByUser", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
+func (s *TimerLayerSavedSearchStore) Save(search *model.SavedSearch) (*model.SavedSearch, error) {
 	start := time.Now()
 
-	err := s.RoleStore.PermanentDeleteAll()
+	result, err := s.SavedSearchStore.Save(search)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7668,15 +8410,15 @@ func (s *TimerLayerRoleStore) PermanentDeleteAll() error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.PermanentDeleteAll", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
+func (s *TimerLayerSavedSearchStore) Update(search *model.SavedSearch) (*model.SavedSearch, error) {
 	start := time.Now()
 
-	result, err := s.RoleStore.Save(role)
+	result, err := s.SavedSearchStore.Update(search)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -7684,7 +8426,7 @@ func (s *TimerLayerRoleStore) Save(role *model.Role) (*model.Role, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("RoleStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("SavedSearchStore.Update", success, elapsed)
 	}
 	return result, err
 }
@@ -10102,6 +10844,22 @@ func (s *TimerLayerTokenStore) GetByToken(token string) (*model.Token, error) {
 	return result, err
 }
 
+func (s *TimerLayerTokenStore) GetTokensByTypeAndExtraPrefix(tokenType string, extraPrefix string, since int64) ([]*model.Token, error) {
+	start := time.Now()
+
+	result, err := s.TokenStore.GetTokensByTypeAndExtraPrefix(tokenType, extraPrefix, since)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TokenStore.GetTokensByTypeAndExtraPrefix", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerTokenStore) RemoveAllTokensByType(tokenType string) error {
 	start := time.Now()
 
@@ -10134,6 +10892,102 @@ func (s *TimerLayerTokenStore) Save(recovery *model.Token) error {
 	return err
 }
 
+func (s *TimerLayerTranslationStore) GetChannelMemberLocales(channelID string) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.TranslationStore.GetChannelMemberLocales(channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.GetChannelMemberLocales", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTranslationStore) GetChannelSettings(channelID string) (*model.ChannelTranslationSettings, error) {
+	start := time.Now()
+
+	result, err := s.TranslationStore.GetChannelSettings(channelID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.GetChannelSettings", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTranslationStore) GetPostTranslations(postIDs []string, locale string) ([]*model.PostTranslation, error) {
+	start := time.Now()
+
+	result, err := s.TranslationStore.GetPostTranslations(postIDs, locale)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.GetPostTranslations", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTranslationStore) PermanentDeletePostTranslations(postID string) error {
+	start := time.Now()
+
+	err := s.TranslationStore.PermanentDeletePostTranslations(postID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.PermanentDeletePostTranslations", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerTranslationStore) SaveChannelSettings(settings *model.ChannelTranslationSettings) (*model.ChannelTranslationSettings, error) {
+	start := time.Now()
+
+	result, err := s.TranslationStore.SaveChannelSettings(settings)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.SaveChannelSettings", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerTranslationStore) SavePostTranslation(translation *model.PostTranslation) (*model.PostTranslation, error) {
+	start := time.Now()
+
+	result, err := s.TranslationStore.SavePostTranslation(translation)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TranslationStore.SavePostTranslation", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUploadSessionStore) Delete(id string) error {
 	start := time.Now()
 
@@ -11698,6 +12552,102 @@ func (s *TimerLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 	return err
 }
 
+func (s *TimerLayerUserDataExportStore) Get(jobID string) (*model.UserDataExport, error) {
+	start := time.Now()
+
+	result, err := s.UserDataExportStore.Get(jobID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserDataExportStore) GetLatestForUser(userID string) (*model.UserDataExport, error) {
+	start := time.Now()
+
+	result, err := s.UserDataExportStore.GetLatestForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.GetLatestForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserDataExportStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.UserDataExportStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerUserDataExportStore) Save(export *model.UserDataExport) (*model.UserDataExport, error) {
+	start := time.Now()
+
+	result, err := s.UserDataExportStore.Save(export)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserDataExportStore) SaveWithJob(export *model.UserDataExport, job *model.Job, since int64) (*model.UserDataExport, error) {
+	start := time.Now()
+
+	result, err := s.UserDataExportStore.SaveWithJob(export, job, since)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.SaveWithJob", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUserDataExportStore) Update(export *model.UserDataExport) (*model.UserDataExport, error) {
+	start := time.Now()
+
+	result, err := s.UserDataExportStore.Update(export)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserDataExportStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 	start := time.Now()
 
@@ -12219,6 +13169,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	}
 
 	newStore.AuditStore = &TimerLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AuditEventStore = &TimerLayerAuditEventStore{AuditEventStore: childStore.AuditEvent(), Root: &newStore}
 	newStore.BotStore = &TimerLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &TimerLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
 	newStore.ChannelBookmarkStore = &TimerLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
@@ -12232,13 +13183,16 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.EmojiStore = &TimerLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &TimerLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
 	newStore.GroupStore = &TimerLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.GuestAccountStore = &TimerLayerGuestAccountStore{GuestAccountStore: childStore.GuestAccount(), Root: &newStore}
 	newStore.JobStore = &TimerLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &TimerLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &TimerLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.LoginFingerprintStore = &TimerLayerLoginFingerprintStore{LoginFingerprintStore: childStore.LoginFingerprint(), Root: &newStore}
 	newStore.NotifyAdminStore = &TimerLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &TimerLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.OutgoingOAuthConnectionStore = &TimerLayerOutgoingOAuthConnectionStore{OutgoingOAuthConnectionStore: childStore.OutgoingOAuthConnection(), Root: &newStore}
 	newStore.PluginStore = &TimerLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
+	newStore.PluginMigrationStore = &TimerLayerPluginMigrationStore{PluginMigrationStore: childStore.PluginMigration(), Root: &newStore}
 	newStore.PostStore = &TimerLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &TimerLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
 	newStore.PostPersistentNotificationStore = &TimerLayerPostPersistentNotificationStore{PostPersistentNotificationStore: childStore.PostPersistentNotification(), Root: &newStore}
@@ -12249,6 +13203,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.RemoteClusterStore = &TimerLayerRemoteClusterStore{RemoteClusterStore: childStore.RemoteCluster(), Root: &newStore}
 	newStore.RetentionPolicyStore = &TimerLayerRetentionPolicyStore{RetentionPolicyStore: childStore.RetentionPolicy(), Root: &newStore}
 	newStore.RoleStore = &TimerLayerRoleStore{RoleStore: childStore.Role(), Root: &newStore}
+	newStore.SavedSearchStore = &TimerLayerSavedSearchStore{SavedSearchStore: childStore.SavedSearch(), Root: &newStore}
 	newStore.SchemeStore = &TimerLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &TimerLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &TimerLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
@@ -12258,9 +13213,11 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.TermsOfServiceStore = &TimerLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &TimerLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &TimerLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.TranslationStore = &TimerLayerTranslationStore{TranslationStore: childStore.Translation(), Root: &newStore}
 	newStore.UploadSessionStore = &TimerLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
+	newStore.UserDataExportStore = &TimerLayerUserDataExportStore{UserDataExportStore: childStore.UserDataExport(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &TimerLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
 	newStore.WebhookStore = &TimerLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
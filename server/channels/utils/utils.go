@@ -113,6 +113,30 @@ func GetIPAddress(r *http.Request, trustedProxyIPHeader []string) string {
 	return host
 }
 
+// GetTrustedIPAddress returns the client address as recorded by the closest
+// trusted proxy. Proxies append to headers such as X-Forwarded-For, so unlike
+// GetIPAddress, the last address in the header is used as the ones before it
+// may have been sent by the client itself. It should be used wherever the
+// address grants or denies access.
+func GetTrustedIPAddress(r *http.Request, trustedProxyIPHeader []string) string {
+	for _, proxyHeader := range trustedProxyIPHeader {
+		values := r.Header.Values(proxyHeader)
+		if len(values) == 0 {
+			continue
+		}
+
+		addresses := strings.Split(values[len(values)-1], ",")
+		address := strings.TrimSpace(addresses[len(addresses)-1])
+		if address != "" && net.ParseIP(address) != nil {
+			return address
+		}
+	}
+
+	host, _, _ := net.SplitHostPort(r.RemoteAddr)
+
+	return host
+}
+
 func GetHostnameFromSiteURL(siteURL string) string {
 	u, err := url.Parse(siteURL)
 	if err != nil {
@@ -197,6 +197,65 @@ func TestGetIPAddress(t *testing.T) {
 	})
 }
 
+func TestGetTrustedIPAddress(t *testing.T) {
+	t.Run("Multiple IPs in the X-Forwarded-For", func(t *testing.T) {
+		httpRequest := http.Request{
+			Header: http.Header{
+				"X-Forwarded-For": []string{"10.0.0.1,  10.0.0.2, 10.0.0.3"},
+			},
+			RemoteAddr: "10.2.0.1:12345",
+		}
+
+		assert.Equal(t, "10.0.0.3", GetTrustedIPAddress(&httpRequest, []string{"X-Forwarded-For"}))
+	})
+
+	t.Run("Multiple X-Forwarded-For headers", func(t *testing.T) {
+		httpRequest := http.Request{
+			Header: http.Header{
+				"X-Forwarded-For": []string{"10.0.0.1", "10.0.0.2"},
+			},
+			RemoteAddr: "10.2.0.1:12345",
+		}
+
+		assert.Equal(t, "10.0.0.2", GetTrustedIPAddress(&httpRequest, []string{"X-Forwarded-For"}))
+	})
+
+	t.Run("Spoofed address appended to by proxy", func(t *testing.T) {
+		httpRequest := http.Request{
+			Header: http.Header{
+				"X-Forwarded-For": []string{"192.168.0.1, 203.0.113.5"},
+			},
+			RemoteAddr: "10.2.0.1:12345",
+		}
+
+		assert.Equal(t, "192.168.0.1", GetIPAddress(&httpRequest, []string{"X-Forwarded-For"}))
+		assert.Equal(t, "203.0.113.5", GetTrustedIPAddress(&httpRequest, []string{"X-Forwarded-For"}))
+	})
+
+	t.Run("Falls back to the next header", func(t *testing.T) {
+		httpRequest := http.Request{
+			Header: http.Header{
+				"X-Forwarded-For": []string{"10.0.0.1, not-an-ip"},
+				"X-Real-Ip":       []string{"10.1.0.1"},
+			},
+			RemoteAddr: "10.2.0.1:12345",
+		}
+
+		assert.Equal(t, "10.1.0.1", GetTrustedIPAddress(&httpRequest, []string{"X-Forwarded-For", "X-Real-Ip"}))
+	})
+
+	t.Run("Headers not trusted", func(t *testing.T) {
+		httpRequest := http.Request{
+			Header: http.Header{
+				"X-Forwarded-For": []string{"10.0.0.1"},
+			},
+			RemoteAddr: "10.2.0.1:12345",
+		}
+
+		assert.Equal(t, "10.2.0.1", GetTrustedIPAddress(&httpRequest, nil))
+	})
+}
+
 func TestRemoveStringFromSlice(t *testing.T) {
 	a := []string{"one", "two", "three", "four", "five", "six"}
 	expected := []string{"one", "two", "three", "five", "six"}
@@ -532,6 +532,17 @@ func (c *Context) RequireReportId() *Context {
 	return c
 }
 
+func (c *Context) RequireSavedSearchId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.SavedSearchId) {
+		c.SetInvalidURLParam("saved_search_id")
+	}
+	return c
+}
+
 func (c *Context) RequireEmojiId() *Context {
 	if c.Err != nil {
 		return c
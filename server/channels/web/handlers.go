@@ -383,6 +383,12 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Local mode requests are never filtered, so that admins locked out by
+	// IPFilteringSettings can still fix them with mmctl --local.
+	if c.Err == nil && !h.IsLocal {
+		c.Err = c.App.CheckIPFilter(c.AppContext, utils.GetTrustedIPAddress(r, c.App.Config().ServiceSettings.TrustedProxyIPHeader), c.AppContext.Session())
+	}
+
 	if c.Err == nil {
 		h.HandleFunc(c, w, r)
 	}
@@ -1083,3 +1083,151 @@ func TestHandlerServeHTTPRequestPayloadLimit(t *testing.T) {
 		assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
 	})
 }
+
+func TestHandlerServeHTTPIPFiltering(t *testing.T) {
+	setupIPFiltering := func(t *testing.T, settings model.IPFilteringSettings) *TestHelper {
+		th := SetupWithStoreMock(t)
+
+		mockStore := th.App.Srv().Store().(*mocks.Store)
+		mockUserStore := mocks.UserStore{}
+		mockUserStore.On("Count", mock.Anything).Return(int64(10), nil)
+		mockPostStore := mocks.PostStore{}
+		mockPostStore.On("GetMaxPostSize").Return(65535, nil)
+		mockSystemStore := mocks.SystemStore{}
+		mockSystemStore.On("GetByName", "UpgradedFromTE").Return(&model.System{Name: "UpgradedFromTE", Value: "false"}, nil)
+		mockSystemStore.On("GetByName", "InstallationDate").Return(&model.System{Name: "InstallationDate", Value: "10"}, nil)
+		mockSystemStore.On("GetByName", "FirstServerRunTimestamp").Return(&model.System{Name: "FirstServerRunTimestamp", Value: "10"}, nil)
+
+		mockStore.On("User").Return(&mockUserStore)
+		mockStore.On("Post").Return(&mockPostStore)
+		mockStore.On("System").Return(&mockSystemStore)
+		mockStore.On("GetDBSchemaVersion").Return(1, nil)
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			cfg.IPFilteringSettings = settings
+			cfg.IPFilteringSettings.Enable = model.NewPointer(true)
+			cfg.IPFilteringSettings.SetDefaults()
+			cfg.ServiceSettings.TrustedProxyIPHeader = []string{"X-Forwarded-For"}
+		})
+
+		return th
+	}
+
+	serve := func(th *TestHelper, handler http.Handler, remoteAddr string, forwardedFor string) int {
+		request := httptest.NewRequest("GET", "/api/v4/test", nil)
+		request.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			request.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		return response.Code
+	}
+
+	t.Run("allowed ranges", func(t *testing.T) {
+		th := setupIPFiltering(t, model.IPFilteringSettings{AllowedIPRanges: model.NewPointer("10.0.0.0/8 192.168.1.10")})
+		defer th.TearDown()
+
+		handler := New(th.Server).NewHandler(noOpHandler)
+		assert.Equal(t, http.StatusOK, serve(th, handler, "10.1.2.3:1234", ""))
+		assert.Equal(t, http.StatusOK, serve(th, handler, "192.168.1.10:1234", ""))
+		assert.Equal(t, http.StatusForbidden, serve(th, handler, "192.168.1.11:1234", ""))
+	})
+
+	t.Run("denied ranges take precedence", func(t *testing.T) {
+		th := setupIPFiltering(t, model.IPFilteringSettings{
+			AllowedIPRanges: model.NewPointer("10.0.0.0/8"),
+			DeniedIPRanges:  model.NewPointer("10.0.0.0/24"),
+		})
+		defer th.TearDown()
+
+		handler := New(th.Server).NewHandler(noOpHandler)
+		assert.Equal(t, http.StatusOK, serve(th, handler, "10.0.1.1:1234", ""))
+		assert.Equal(t, http.StatusForbidden, serve(th, handler, "10.0.0.1:1234", ""))
+	})
+
+	t.Run("address appended by trusted proxy is used", func(t *testing.T) {
+		th := setupIPFiltering(t, model.IPFilteringSettings{AllowedIPRanges: model.NewPointer("10.0.0.0/8")})
+		defer th.TearDown()
+
+		handler := New(th.Server).NewHandler(noOpHandler)
+		assert.Equal(t, http.StatusOK, serve(th, handler, "172.16.0.1:1234", "10.0.0.1"))
+		assert.Equal(t, http.StatusForbidden, serve(th, handler, "172.16.0.1:1234", "10.0.0.1, 203.0.113.5"))
+	})
+
+	t.Run("local mode is not filtered", func(t *testing.T) {
+		th := setupIPFiltering(t, model.IPFilteringSettings{AllowedIPRanges: model.NewPointer("10.0.0.0/8")})
+		defer th.TearDown()
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnableLocalMode = true
+		})
+
+		handler := Handler{
+			Srv:        th.Server,
+			HandleFunc: noOpHandler,
+			IsLocal:    true,
+		}
+		assert.Equal(t, http.StatusOK, serve(th, handler, "@", ""))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th := setupIPFiltering(t, model.IPFilteringSettings{AllowedIPRanges: model.NewPointer("10.0.0.0/8")})
+		defer th.TearDown()
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.IPFilteringSettings.Enable = false
+		})
+
+		handler := New(th.Server).NewHandler(noOpHandler)
+		assert.Equal(t, http.StatusOK, serve(th, handler, "192.168.1.11:1234", ""))
+	})
+}
+
+func TestHandlerServeHTTPIPFilteringRoles(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.IPFilteringSettings.Enable = true
+		*cfg.IPFilteringSettings.AllowedIPRanges = "10.0.0.0/8"
+		cfg.IPFilteringSettings.RoleIPRanges = map[string]string{
+			model.SystemAdminRoleId: "10.8.0.0/16",
+		}
+	})
+
+	createSession := func(user *model.User) *model.Session {
+		session := &model.Session{
+			UserId: user.Id,
+			Roles:  user.Roles,
+		}
+		th.App.SetSessionExpireInHours(session, 24)
+		session, err := th.App.CreateSession(th.Context, session)
+		require.Nil(t, err)
+		return session
+	}
+
+	handler := Handler{
+		Srv:            th.Server,
+		HandleFunc:     noOpHandler,
+		RequireSession: true,
+	}
+
+	serve := func(session *model.Session, remoteAddr string) int {
+		request := httptest.NewRequest("GET", "/api/v4/test", nil)
+		request.RemoteAddr = remoteAddr
+		request.Header.Set(model.HeaderAuth, model.HeaderBearer+" "+session.Token)
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		return response.Code
+	}
+
+	userSession := createSession(th.BasicUser)
+	adminSession := createSession(th.SystemAdminUser)
+
+	assert.Equal(t, http.StatusOK, serve(userSession, "10.1.0.1:1234"))
+	assert.Equal(t, http.StatusForbidden, serve(userSession, "192.168.0.1:1234"))
+
+	assert.Equal(t, http.StatusOK, serve(adminSession, "10.8.0.1:1234"))
+	assert.Equal(t, http.StatusForbidden, serve(adminSession, "10.1.0.1:1234"))
+}
@@ -41,6 +41,7 @@ type Params struct {
 	CommandId                 string
 	HookId                    string
 	ReportId                  string
+	SavedSearchId             string
 	EmojiId                   string
 	AppId                     string
 	Email                     string
@@ -145,6 +146,7 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.CommandId = props["command_id"]
 	params.HookId = props["hook_id"]
 	params.ReportId = props["report_id"]
+	params.SavedSearchId = props["saved_search_id"]
 	params.EmojiId = props["emoji_id"]
 	params.AppId = props["app_id"]
 	params.Email = props["email"]
@@ -59,7 +59,13 @@ type Client interface {
 	RemovePlugin(ctx context.Context, id string) (*model.Response, error)
 	EnablePlugin(ctx context.Context, id string) (*model.Response, error)
 	DisablePlugin(ctx context.Context, id string) (*model.Response, error)
+	DisablePluginForced(ctx context.Context, id string) (*model.Response, error)
 	GetPlugins(ctx context.Context) (*model.PluginsResponse, *model.Response, error)
+	WatchPlugin(ctx context.Context, path string) (*model.Manifest, *model.Response, error)
+	UnwatchPlugin(ctx context.Context, pluginID string) (*model.Response, error)
+	GetPluginWatchLogs(ctx context.Context, pluginID string) (io.ReadCloser, *model.Response, error)
+	GetPluginMigrations(ctx context.Context, id string) ([]*model.PluginMigration, *model.Response, error)
+	RollbackPluginMigrations(ctx context.Context, id string, toVersion int64) ([]*model.PluginMigration, *model.Response, error)
 	GetUser(ctx context.Context, userID, etag string) (*model.User, *model.Response, error)
 	GetUserByUsername(ctx context.Context, userName, etag string) (*model.User, *model.Response, error)
 	GetUserByEmail(ctx context.Context, email, etag string) (*model.User, *model.Response, error)
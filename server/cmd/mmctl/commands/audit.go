@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Management of audit logs",
+}
+
+var AuditVerifyCmd = &cobra.Command{
+	Use:   "verify [logfiles]",
+	Short: "Verify the integrity of audit log files",
+	Long: `Verify that audit log files written with hash chaining enabled (ExperimentalAuditSettings.HashChainEnabled) have not been tampered with, reporting any records that were edited, removed, reordered or inserted.
+Audit logs must be written in JSON format. Pass rotated files in the order they were written, oldest first, as chains continue across files. Files ending in .gz are decompressed.
+To check checkpoint signatures, pass the server's public key, found as AsymmetricSigningPublicKey in the client config.`,
+	Example: "  audit verify audit.log.1.gz audit.log --public-key MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE...",
+	Args:    cobra.MinimumNArgs(1),
+	RunE:    auditVerifyCmdF,
+}
+
+func init() {
+	AuditVerifyCmd.Flags().String("public-key", "", "Base64 encoded public key used to check checkpoint signatures. If not set, signatures are not checked.")
+
+	AuditCmd.AddCommand(
+		AuditVerifyCmd,
+	)
+
+	RootCmd.AddCommand(AuditCmd)
+}
+
+func parseAuditPublicKey(encoded string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, errors.Wrap(err, "public key is not valid base64")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an ECDSA key")
+	}
+
+	return ecdsaKey, nil
+}
+
+func verifyAuditLogFile(verifier *audit.Verifier, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decompress %s", path)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	return verifier.Verify(path, reader)
+}
+
+func auditVerifyCmdF(cmd *cobra.Command, args []string) error {
+	var publicKey *ecdsa.PublicKey
+	if encoded, _ := cmd.Flags().GetString("public-key"); encoded != "" {
+		var err error
+		if publicKey, err = parseAuditPublicKey(encoded); err != nil {
+			return err
+		}
+	}
+
+	verifier := audit.NewVerifier(publicKey)
+	for _, path := range args {
+		if err := verifyAuditLogFile(verifier, path); err != nil {
+			return err
+		}
+	}
+
+	result := verifier.Result()
+	for _, issue := range result.Issues {
+		printer.PrintT("{{.File}}:{{.Line}}: {{.Kind}}: {{.Message}}", issue)
+	}
+
+	printer.PrintT("Verified {{.Records}} records in {{.Chains}} chains, with {{.Checkpoints}} checkpoints of which {{.VerifiedCheckpoints}} had their signature checked. {{.UnchainedRecords}} records were not chained.", result)
+
+	if !result.OK() {
+		return fmt.Errorf("found %d issues in the audit log", len(result.Issues))
+	}
+
+	if publicKey == nil && result.Checkpoints > 0 {
+		printer.PrintWarning("Checkpoint signatures were not checked as no public key was given.")
+	}
+
+	return nil
+}
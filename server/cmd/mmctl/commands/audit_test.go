@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) writeAuditLog(key *ecdsa.PrivateKey) string {
+	filePath := filepath.Join(s.T().TempDir(), "audit.log")
+
+	adt := &audit.Audit{}
+	adt.Init(audit.DefMaxQueueSize)
+	err := adt.Configure(map[string]mlog.TargetCfg{
+		"file": {
+			Type:    "file",
+			Format:  "json",
+			Options: json.RawMessage(fmt.Sprintf(`{"filename": "%s"}`, filePath)),
+			Levels:  []mlog.Level{mlog.LvlAuditCLI, mlog.LvlAuditAPI},
+		},
+	})
+	s.Require().NoError(err)
+
+	adt.EnableHashChain(2, func(digest []byte) ([]byte, error) {
+		return ecdsa.SignASN1(rand.Reader, key, digest)
+	})
+	for i := 0; i < 3; i++ {
+		adt.LogRecord(mlog.LvlAuditAPI, audit.Record{EventName: "login", Status: audit.Success})
+	}
+	s.Require().NoError(adt.Shutdown())
+
+	return filePath
+}
+
+func (s *MmctlUnitTestSuite) TestAuditVerifyCmd() {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	s.Require().NoError(err)
+	publicKey := base64.StdEncoding.EncodeToString(der)
+
+	s.Run("intact log", func() {
+		printer.Clean()
+		filePath := s.writeAuditLog(key)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("public-key", publicKey, "")
+
+		err := auditVerifyCmdF(cmd, []string{filePath})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		result := printer.GetLines()[0].(*audit.VerifyResult)
+		s.Equal(5, result.Records)
+		s.Equal(2, result.VerifiedCheckpoints)
+		s.Empty(printer.GetErrorLines())
+	})
+
+	s.Run("tampered log", func() {
+		printer.Clean()
+		filePath := s.writeAuditLog(key)
+
+		data, err := os.ReadFile(filePath)
+		s.Require().NoError(err)
+		lines := strings.Split(string(data), "\n")
+		lines = append(lines[:1], lines[2:]...)
+		s.Require().NoError(os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0600))
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("public-key", publicKey, "")
+
+		err = auditVerifyCmdF(cmd, []string{filePath})
+		s.Require().EqualError(err, "found 1 issues in the audit log")
+		s.Require().Len(printer.GetLines(), 2)
+		issue := printer.GetLines()[0].(audit.VerifyIssue)
+		s.Equal(audit.IssueGap, issue.Kind)
+		s.Equal(2, issue.Line)
+	})
+
+	s.Run("invalid public key", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("public-key", "not a key", "")
+
+		err := auditVerifyCmdF(cmd, []string{"audit.log"})
+		s.Require().Error(err)
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("missing file", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("public-key", "", "")
+
+		err := auditVerifyCmdF(cmd, []string{filepath.Join(s.T().TempDir(), "missing.log")})
+		s.Require().Error(err)
+	})
+}
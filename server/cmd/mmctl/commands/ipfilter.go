@@ -0,0 +1,98 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+var IPFilterCmd = &cobra.Command{
+	Use:   "ipfilter",
+	Short: "Management of IP filtering",
+}
+
+var IPFilterShowCmd = &cobra.Command{
+	Use:     "show",
+	Short:   "Show the IP filtering settings",
+	Long:    "Show the IP ranges that are allowed or denied access to the server.",
+	Example: "  ipfilter show",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(ipFilterShowCmdF),
+}
+
+var IPFilterDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable IP filtering",
+	Long: `Disable IP filtering, keeping the configured IP ranges so that it can be enabled again once they are fixed.
+Requests through local mode are never filtered, so use this command with --local to regain access to a server whose IP filtering settings block every admin.`,
+	Example: "  ipfilter disable --local",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(ipFilterDisableCmdF),
+}
+
+func init() {
+	IPFilterCmd.AddCommand(
+		IPFilterShowCmd,
+		IPFilterDisableCmd,
+	)
+
+	RootCmd.AddCommand(IPFilterCmd)
+}
+
+func ipFilterShowCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	config, _, err := c.GetConfig(context.TODO())
+	if err != nil {
+		return err
+	}
+
+	printer.PrintT(`Enabled: {{.Enable}}
+Allowed IP ranges: {{.AllowedIPRanges}}
+Denied IP ranges: {{.DeniedIPRanges}}{{range $role, $ranges := .RoleIPRanges}}
+IP ranges for {{$role}}: {{$ranges}}{{end}}`, ipFilteringSettingsOutput(config.IPFilteringSettings))
+
+	return nil
+}
+
+func ipFilterDisableCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	config, _, err := c.GetConfig(context.TODO())
+	if err != nil {
+		return err
+	}
+
+	config.IPFilteringSettings.Enable = model.NewPointer(false)
+	newConfig, _, err := c.PatchConfig(context.TODO(), config)
+	if err != nil {
+		return err
+	}
+
+	printer.PrintT("IP filtering disabled", ipFilteringSettingsOutput(newConfig.IPFilteringSettings))
+
+	return nil
+}
+
+type ipFilteringSettings struct {
+	Enable          bool              `json:"enable"`
+	AllowedIPRanges string            `json:"allowed_ip_ranges"`
+	DeniedIPRanges  string            `json:"denied_ip_ranges"`
+	RoleIPRanges    map[string]string `json:"role_ip_ranges"`
+}
+
+// ipFilteringSettingsOutput dereferences the settings for printing, as the
+// config may come from a server that predates them.
+func ipFilteringSettingsOutput(settings model.IPFilteringSettings) *ipFilteringSettings {
+	settings.SetDefaults()
+
+	return &ipFilteringSettings{
+		Enable:          *settings.Enable,
+		AllowedIPRanges: *settings.AllowedIPRanges,
+		DeniedIPRanges:  *settings.DeniedIPRanges,
+		RoleIPRanges:    settings.RoleIPRanges,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+)
+
+func (s *MmctlUnitTestSuite) TestIPFilterShowCmd() {
+	s.Run("Show IP filtering settings", func() {
+		printer.Clean()
+
+		config := &model.Config{}
+		config.SetDefaults()
+		config.IPFilteringSettings.Enable = model.NewPointer(true)
+		config.IPFilteringSettings.AllowedIPRanges = model.NewPointer("10.0.0.0/8")
+		config.IPFilteringSettings.RoleIPRanges = map[string]string{model.SystemAdminRoleId: "10.8.0.0/16"}
+
+		s.client.
+			EXPECT().
+			GetConfig(context.TODO()).
+			Return(config, &model.Response{}, nil).
+			Times(1)
+
+		err := ipFilterShowCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(&ipFilteringSettings{
+			Enable:          true,
+			AllowedIPRanges: "10.0.0.0/8",
+			RoleIPRanges:    map[string]string{model.SystemAdminRoleId: "10.8.0.0/16"},
+		}, printer.GetLines()[0])
+	})
+
+	s.Run("Fail to get the config", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfig(context.TODO()).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := ipFilterShowCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().EqualError(err, "mock error")
+		s.Require().Empty(printer.GetLines())
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestIPFilterDisableCmd() {
+	s.Run("Disable IP filtering", func() {
+		printer.Clean()
+
+		config := &model.Config{}
+		config.SetDefaults()
+		config.IPFilteringSettings.Enable = model.NewPointer(true)
+		config.IPFilteringSettings.AllowedIPRanges = model.NewPointer("10.0.0.0/8")
+
+		s.client.
+			EXPECT().
+			GetConfig(context.TODO()).
+			Return(config, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			PatchConfig(context.TODO(), config).
+			DoAndReturn(func(_ context.Context, patch *model.Config) (*model.Config, *model.Response, error) {
+				s.Require().False(*patch.IPFilteringSettings.Enable)
+				s.Require().Equal("10.0.0.0/8", *patch.IPFilteringSettings.AllowedIPRanges)
+				return patch, &model.Response{}, nil
+			}).
+			Times(1)
+
+		err := ipFilterDisableCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().False(printer.GetLines()[0].(*ipFilteringSettings).Enable)
+	})
+
+	s.Run("Fail to patch the config", func() {
+		printer.Clean()
+
+		config := &model.Config{}
+		config.SetDefaults()
+
+		s.client.
+			EXPECT().
+			GetConfig(context.TODO()).
+			Return(config, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			PatchConfig(context.TODO(), config).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := ipFilterDisableCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().EqualError(err, "mock error")
+		s.Require().Empty(printer.GetLines())
+	})
+}
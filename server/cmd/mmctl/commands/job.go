@@ -48,6 +48,16 @@ var updateJobCmd = &cobra.Command{
 	RunE: withClient(updateJobCmdF),
 }
 
+var cancelJobCmd = &cobra.Command{
+	Use:   "cancel [jobs]",
+	Short: "Cancel jobs",
+	Long:  "Request the cancellation of pending or in progress jobs, including those of plugins.",
+	Example: `  job cancel myJobID
+	job cancel myJobID1 myJobID2`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: withClient(cancelJobCmdF),
+}
+
 func init() {
 	listJobsCmd.Flags().Int("page", 0, "Page number to fetch for the list of import jobs")
 	listJobsCmd.Flags().Int("per-page", 5, "Number of import jobs to be fetched")
@@ -61,6 +71,7 @@ func init() {
 	JobCmd.AddCommand(
 		listJobsCmd,
 		updateJobCmd,
+		cancelJobCmd,
 	)
 
 	RootCmd.AddCommand(JobCmd)
@@ -129,6 +140,25 @@ func updateJobCmdF(c client.Client, cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func cancelJobCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	var result *multierror.Error
+	for _, jobID := range args {
+		if !model.IsValidId(jobID) {
+			result = multierror.Append(result, fmt.Errorf("invalid job ID: %s", jobID))
+			continue
+		}
+
+		if _, err := c.CancelJob(context.TODO(), jobID); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to cancel job %s: %w", jobID, err))
+			continue
+		}
+
+		printer.Print(fmt.Sprintf("Cancellation of job %s requested", jobID))
+	}
+
+	return result.ErrorOrNil()
+}
+
 func jobListCmdF(c client.Client, command *cobra.Command, jobType string, status string) error {
 	page, err := command.Flags().GetInt("page")
 	if err != nil {
@@ -5,6 +5,9 @@ package commands
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/mattermost/mattermost/server/public/model"
 
@@ -202,3 +205,44 @@ func (s *MmctlUnitTestSuite) TestUpdateJobCmdF() {
 		s.Require().Nil(err)
 	})
 }
+
+func (s *MmctlUnitTestSuite) TestCancelJobCmdF() {
+	s.Run("cancel jobs", func() {
+		printer.Clean()
+		id1 := model.NewId()
+		id2 := model.NewId()
+
+		s.client.
+			EXPECT().
+			CancelJob(context.TODO(), id1).
+			Return(&model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			CancelJob(context.TODO(), id2).
+			Return(&model.Response{}, nil).
+			Times(1)
+
+		err := cancelJobCmdF(s.client, &cobra.Command{}, []string{id1, id2})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(fmt.Sprintf("Cancellation of job %s requested", id1), printer.GetLines()[0])
+	})
+
+	s.Run("invalid and failing jobs", func() {
+		printer.Clean()
+		id := model.NewId()
+
+		s.client.
+			EXPECT().
+			CancelJob(context.TODO(), id).
+			Return(&model.Response{StatusCode: http.StatusNotFound}, errors.New("not found")).
+			Times(1)
+
+		err := cancelJobCmdF(s.client, &cobra.Command{}, []string{"invalid", id})
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "invalid job ID: invalid")
+		s.Require().Contains(err.Error(), "failed to cancel job "+id)
+		s.Require().Empty(printer.GetLines())
+	})
+}
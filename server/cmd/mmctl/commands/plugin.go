@@ -4,10 +4,18 @@
 package commands
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
 
@@ -64,7 +72,7 @@ var PluginEnableCmd = &cobra.Command{
 var PluginDisableCmd = &cobra.Command{
 	Use:     "disable [plugins]",
 	Short:   "Disable plugins",
-	Long:    "Disable plugins. Disabled plugins are immediately removed from the user interface and logged out of all sessions.",
+	Long:    "Disable plugins. Disabled plugins are immediately removed from the user interface and logged out of all sessions. Plugins that enabled plugins depend on can only be disabled with --force.",
 	Example: `  plugin disable hovercardexample pluginexample`,
 	RunE:    withClient(pluginDisableCmdF),
 	Args:    cobra.MinimumNArgs(1),
@@ -78,9 +86,58 @@ var PluginListCmd = &cobra.Command{
 	RunE:    withClient(pluginListCmdF),
 }
 
+var PluginWatchCmd = &cobra.Command{
+	Use:   "watch <path>",
+	Short: "Deploy a plugin from a local directory and redeploy it on changes",
+	Long: `Deploy the plugin in a directory on the server's machine, and redeploy it whenever its manifest, server executables or webapp bundle change, streaming the plugin's logs until interrupted.
+Only available in local mode, with plugins and developer mode enabled. Not available in High Availability mode or when plugin signatures are required.`,
+	Example: `  # Watch the plugin built into the current directory
+  $ mmctl --local plugin watch .`,
+	RunE: withClient(pluginWatchCmdF),
+	Args: cobra.ExactArgs(1),
+}
+
+var PluginMigrationsCmd = &cobra.Command{
+	Use:   "migrations",
+	Short: "Management of plugin database migrations",
+}
+
+var PluginMigrationsStatusCmd = &cobra.Command{
+	Use:     "status <plugin-id>",
+	Short:   "List the database migrations applied by a plugin",
+	Long:    "List the database migrations a plugin applied through its store migrations, ordered by version.",
+	Example: `  plugin migrations status com.mattermost.demo-plugin`,
+	RunE:    withClient(pluginMigrationsStatusCmdF),
+	Args:    cobra.ExactArgs(1),
+}
+
+var PluginMigrationsRollbackCmd = &cobra.Command{
+	Use:   "rollback <plugin-id>",
+	Short: "Roll back the database migrations of a plugin",
+	Long: `Roll back the database migrations a plugin applied after the given version, newest first. Without --to-version, only the latest migration is rolled back.
+The plugin must be disabled, and each migration rolled back must provide down SQL.`,
+	Example: `  # Roll back the latest migration
+  $ mmctl plugin migrations rollback com.mattermost.demo-plugin
+
+  # Roll back every migration applied after version 3
+  $ mmctl plugin migrations rollback com.mattermost.demo-plugin --to-version 3
+
+  # Roll back all migrations
+  $ mmctl plugin migrations rollback com.mattermost.demo-plugin --to-version 0`,
+	RunE: withClient(pluginMigrationsRollbackCmdF),
+	Args: cobra.ExactArgs(1),
+}
+
 func init() {
 	PluginAddCmd.Flags().BoolP("force", "f", false, "overwrite a previously installed plugin with the same ID, if any")
 	PluginInstallURLCmd.Flags().BoolP("force", "f", false, "overwrite a previously installed plugin with the same ID, if any")
+	PluginDisableCmd.Flags().BoolP("force", "f", false, "disable plugins even if enabled plugins depend on them")
+	PluginMigrationsRollbackCmd.Flags().Int64("to-version", 0, "roll back the migrations applied after this version")
+
+	PluginMigrationsCmd.AddCommand(
+		PluginMigrationsStatusCmd,
+		PluginMigrationsRollbackCmd,
+	)
 
 	PluginCmd.AddCommand(
 		PluginAddCmd,
@@ -89,6 +146,8 @@ func init() {
 		PluginEnableCmd,
 		PluginDisableCmd,
 		PluginListCmd,
+		PluginWatchCmd,
+		PluginMigrationsCmd,
 	)
 	RootCmd.AddCommand(PluginCmd)
 }
@@ -167,9 +226,17 @@ func pluginEnableCmdF(c client.Client, cmd *cobra.Command, args []string) error
 }
 
 func pluginDisableCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	force, _ := cmd.Flags().GetBool("force")
 	var multiErr *multierror.Error
 	for _, plugin := range args {
-		if _, err := c.DisablePlugin(context.TODO(), plugin); err != nil {
+		var err error
+		if force {
+			_, err = c.DisablePluginForced(context.TODO(), plugin)
+		} else {
+			_, err = c.DisablePlugin(context.TODO(), plugin)
+		}
+
+		if err != nil {
 			printer.PrintError("Unable to disable plugin: " + plugin + ". Error: " + err.Error())
 			multiErr = multierror.Append(multiErr, err)
 		} else {
@@ -205,3 +272,140 @@ func pluginListCmdF(c client.Client, cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func pluginWatchCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	path, err := filepath.Abs(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to resolve plugin path: %w", err)
+	}
+
+	manifest, _, err := c.WatchPlugin(context.TODO(), path)
+	if err != nil {
+		return fmt.Errorf("unable to watch plugin: %w", err)
+	}
+	printer.PrintT("Watching plugin {{.Id}} ({{.Name}}, Version: {{.Version}}). Press Ctrl+C to stop.", manifest)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logsErr := streamPluginWatchLogs(ctx, c, manifest.Id)
+
+	if _, err := c.UnwatchPlugin(context.TODO(), manifest.Id); err != nil {
+		return fmt.Errorf("unable to stop watching plugin: %w", err)
+	}
+	printer.Print("Stopped watching plugin " + manifest.Id)
+
+	return logsErr
+}
+
+func pluginMigrationsStatusCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	migrations, _, err := c.GetPluginMigrations(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("unable to get plugin migrations: %w", err)
+	}
+
+	if len(migrations) == 0 {
+		printer.Print("No migrations applied by plugin " + args[0])
+		return nil
+	}
+
+	for _, migration := range migrations {
+		printPluginMigration(migration)
+	}
+
+	return nil
+}
+
+func pluginMigrationsRollbackCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	toVersion, _ := cmd.Flags().GetInt64("to-version")
+	if toVersion < 0 {
+		return errors.New("--to-version must not be negative")
+	}
+
+	if !cmd.Flags().Changed("to-version") {
+		migrations, _, err := c.GetPluginMigrations(context.TODO(), args[0])
+		if err != nil {
+			return fmt.Errorf("unable to get plugin migrations: %w", err)
+		}
+
+		if len(migrations) == 0 {
+			printer.Print("No migrations to roll back for plugin " + args[0])
+			return nil
+		}
+
+		toVersion = 0
+		if len(migrations) > 1 {
+			toVersion = migrations[len(migrations)-2].Version
+		}
+	}
+
+	rolledBack, _, err := c.RollbackPluginMigrations(context.TODO(), args[0], toVersion)
+	for _, migration := range rolledBack {
+		printer.PrintT("Rolled back migration {{.Version}}: {{.Name}}", migration)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to roll back plugin migrations: %w", err)
+	}
+
+	if len(rolledBack) == 0 {
+		printer.Print("No migrations to roll back for plugin " + args[0])
+	}
+
+	return nil
+}
+
+func printPluginMigration(migration *model.PluginMigration) {
+	appliedAt := model.GetTimeForMillis(migration.AppliedAt).Format(time.RFC3339)
+	reversible := "yes"
+	if migration.Down == "" {
+		reversible = "no"
+	}
+
+	printer.PrintT(fmt.Sprintf("{{.Version}}: {{.Name}}, Applied: %s, Reversible: %s", appliedAt, reversible), migration)
+}
+
+func streamPluginWatchLogs(ctx context.Context, c client.Client, pluginID string) error {
+	logs, _, err := c.GetPluginWatchLogs(ctx, pluginID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("unable to stream plugin logs: %w", err)
+	}
+	defer logs.Close()
+
+	scanner := bufio.NewScanner(logs)
+	for scanner.Scan() {
+		var entry model.PluginWatchLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			printer.PrintError("Unable to decode plugin log entry: " + err.Error())
+			continue
+		}
+		printer.Print(formatPluginWatchLogEntry(&entry))
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("unable to stream plugin logs: %w", err)
+	}
+
+	return nil
+}
+
+func formatPluginWatchLogEntry(entry *model.PluginWatchLogEntry) string {
+	var sb strings.Builder
+	sb.WriteString(model.GetTimeForMillis(entry.Timestamp).Format("15:04:05.000"))
+	sb.WriteString(" ")
+	sb.WriteString(strings.ToUpper(entry.Level))
+	sb.WriteString(" ")
+	sb.WriteString(entry.Message)
+
+	keys := make([]string, 0, len(entry.Fields))
+	for key := range entry.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sb.WriteString(" " + key + "=" + entry.Fields[key])
+	}
+
+	return sb.String()
+}
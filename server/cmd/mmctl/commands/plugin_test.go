@@ -5,8 +5,10 @@ package commands
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/golang/mock/gomock"
@@ -272,6 +274,26 @@ func (s *MmctlUnitTestSuite) TestPluginDisableCmd() {
 		s.Require().Equal(printer.GetErrorLines()[0], "Unable to disable plugin: "+args[0]+". Error: "+mockError.Error())
 		s.Require().Equal(printer.GetErrorLines()[1], "Unable to disable plugin: "+args[3]+". Error: "+mockError.Error())
 	})
+
+	s.Run("Force disable 1 plugin", func() {
+		printer.Clean()
+		arg := "plug1"
+
+		s.client.
+			EXPECT().
+			DisablePluginForced(context.TODO(), arg).
+			Return(&model.Response{StatusCode: http.StatusOK}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("force", true, "")
+
+		err := pluginDisableCmdF(s.client, cmd, []string{arg})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(printer.GetLines()[0], "Disabled plugin: "+arg)
+		s.Require().Len(printer.GetErrorLines(), 0)
+	})
 }
 
 func (s *MmctlUnitTestSuite) TestPluginEnableCmd() {
@@ -617,3 +639,193 @@ func (s *MmctlUnitTestSuite) TestPluginDeleteCmd() {
 		s.Require().Equal("Unable to delete plugin: "+args[2]+". Error: "+mockErrors[1].Error(), printer.GetErrorLines()[1])
 	})
 }
+
+func (s *MmctlUnitTestSuite) TestPluginWatchCmd() {
+	manifest := &model.Manifest{Id: "com.mattermost.demo", Name: "Demo", Version: "1.0.0"}
+	path, err := filepath.Abs("demo")
+	s.Require().NoError(err)
+
+	s.Run("Watch a plugin and stream its logs", func() {
+		printer.Clean()
+
+		logs := `{"timestamp":1700000000000,"level":"info","message":"Plugin deployed","fields":{"version":"1.0.0"}}
+not json
+{"timestamp":1700000001000,"level":"error","message":"Failed to reach upstream","fields":{"url":"https://example.com","attempt":"2"}}
+`
+
+		s.client.
+			EXPECT().
+			WatchPlugin(context.TODO(), path).
+			Return(manifest, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetPluginWatchLogs(gomock.Any(), manifest.Id).
+			Return(io.NopCloser(strings.NewReader(logs)), &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			UnwatchPlugin(context.TODO(), manifest.Id).
+			Return(&model.Response{StatusCode: http.StatusOK}, nil).
+			Times(1)
+
+		err := pluginWatchCmdF(s.client, &cobra.Command{}, []string{"demo"})
+		s.Require().NoError(err)
+
+		lines := printer.GetLines()
+		s.Require().Len(lines, 4)
+		s.Require().Equal(manifest, lines[0])
+		s.Require().True(strings.HasSuffix(lines[1].(string), " INFO Plugin deployed version=1.0.0"))
+		s.Require().True(strings.HasSuffix(lines[2].(string), " ERROR Failed to reach upstream attempt=2 url=https://example.com"))
+		s.Require().Equal("Stopped watching plugin "+manifest.Id, lines[3])
+		s.Require().Len(printer.GetErrorLines(), 1)
+	})
+
+	s.Run("Fail to watch a plugin", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			WatchPlugin(context.TODO(), path).
+			Return(nil, &model.Response{StatusCode: http.StatusForbidden}, errors.New("developer mode required")).
+			Times(1)
+
+		err := pluginWatchCmdF(s.client, &cobra.Command{}, []string{"demo"})
+		s.Require().EqualError(err, "unable to watch plugin: developer mode required")
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestPluginMigrationsStatusCmd() {
+	pluginID := "com.mattermost.demo"
+
+	s.Run("List applied migrations", func() {
+		printer.Clean()
+		migrations := []*model.PluginMigration{
+			{PluginId: pluginID, Version: 1, Name: "create_table", Down: "DROP TABLE t", AppliedAt: 1700000000000},
+			{PluginId: pluginID, Version: 2, Name: "add_column", AppliedAt: 1700000001000},
+		}
+
+		s.client.
+			EXPECT().
+			GetPluginMigrations(context.TODO(), pluginID).
+			Return(migrations, &model.Response{}, nil).
+			Times(1)
+
+		err := pluginMigrationsStatusCmdF(s.client, &cobra.Command{}, []string{pluginID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(migrations[0], printer.GetLines()[0])
+		s.Require().Equal(migrations[1], printer.GetLines()[1])
+	})
+
+	s.Run("No applied migrations", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetPluginMigrations(context.TODO(), pluginID).
+			Return([]*model.PluginMigration{}, &model.Response{}, nil).
+			Times(1)
+
+		err := pluginMigrationsStatusCmdF(s.client, &cobra.Command{}, []string{pluginID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal("No migrations applied by plugin "+pluginID, printer.GetLines()[0])
+	})
+
+	s.Run("Fail to list migrations", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetPluginMigrations(context.TODO(), pluginID).
+			Return(nil, &model.Response{StatusCode: http.StatusForbidden}, errors.New("mock error")).
+			Times(1)
+
+		err := pluginMigrationsStatusCmdF(s.client, &cobra.Command{}, []string{pluginID})
+		s.Require().EqualError(err, "unable to get plugin migrations: mock error")
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestPluginMigrationsRollbackCmd() {
+	pluginID := "com.mattermost.demo"
+	migrations := []*model.PluginMigration{
+		{PluginId: pluginID, Version: 1, Name: "create_table"},
+		{PluginId: pluginID, Version: 3, Name: "add_column"},
+	}
+
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().Int64("to-version", 0, "")
+		return cmd
+	}
+
+	s.Run("Roll back the latest migration", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetPluginMigrations(context.TODO(), pluginID).
+			Return(migrations, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			RollbackPluginMigrations(context.TODO(), pluginID, int64(1)).
+			Return([]*model.PluginMigration{migrations[1]}, &model.Response{}, nil).
+			Times(1)
+
+		err := pluginMigrationsRollbackCmdF(s.client, newCmd(), []string{pluginID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(migrations[1], printer.GetLines()[0])
+	})
+
+	s.Run("Roll back to a version", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			RollbackPluginMigrations(context.TODO(), pluginID, int64(0)).
+			Return([]*model.PluginMigration{migrations[1], migrations[0]}, &model.Response{}, nil).
+			Times(1)
+
+		cmd := newCmd()
+		s.Require().NoError(cmd.Flags().Set("to-version", "0"))
+
+		err := pluginMigrationsRollbackCmdF(s.client, cmd, []string{pluginID})
+		s.Require().NoError(err)
+		s.Require().Len(printer.GetLines(), 2)
+	})
+
+	s.Run("Nothing to roll back", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetPluginMigrations(context.TODO(), pluginID).
+			Return([]*model.PluginMigration{}, &model.Response{}, nil).
+			Times(1)
+
+		err := pluginMigrationsRollbackCmdF(s.client, newCmd(), []string{pluginID})
+		s.Require().NoError(err)
+		s.Require().Equal([]any{"No migrations to roll back for plugin " + pluginID}, printer.GetLines())
+	})
+
+	s.Run("Partial rollback", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			RollbackPluginMigrations(context.TODO(), pluginID, int64(0)).
+			Return([]*model.PluginMigration{migrations[1]}, &model.Response{StatusCode: http.StatusBadRequest}, errors.New("irreversible")).
+			Times(1)
+
+		cmd := newCmd()
+		s.Require().NoError(cmd.Flags().Set("to-version", "0"))
+
+		err := pluginMigrationsRollbackCmdF(s.client, cmd, []string{pluginID})
+		s.Require().EqualError(err, "unable to roll back plugin migrations: irreversible")
+		s.Require().Len(printer.GetLines(), 1)
+	})
+}
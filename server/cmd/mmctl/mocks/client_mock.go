@@ -493,6 +493,21 @@ func (mr *MockClientMockRecorder) DisablePlugin(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisablePlugin", reflect.TypeOf((*MockClient)(nil).DisablePlugin), arg0, arg1)
 }
 
+// DisablePluginForced mocks base method.
+func (m *MockClient) DisablePluginForced(arg0 context.Context, arg1 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisablePluginForced", arg0, arg1)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisablePluginForced indicates an expected call of DisablePluginForced.
+func (mr *MockClientMockRecorder) DisablePluginForced(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisablePluginForced", reflect.TypeOf((*MockClient)(nil).DisablePluginForced), arg0, arg1)
+}
+
 // DoAPIPost mocks base method.
 func (m *MockClient) DoAPIPost(arg0 context.Context, arg1, arg2 string) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -1101,6 +1116,38 @@ func (mr *MockClientMockRecorder) GetPingWithOptions(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPingWithOptions", reflect.TypeOf((*MockClient)(nil).GetPingWithOptions), arg0, arg1)
 }
 
+// GetPluginMigrations mocks base method.
+func (m *MockClient) GetPluginMigrations(arg0 context.Context, arg1 string) ([]*model.PluginMigration, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPluginMigrations", arg0, arg1)
+	ret0, _ := ret[0].([]*model.PluginMigration)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPluginMigrations indicates an expected call of GetPluginMigrations.
+func (mr *MockClientMockRecorder) GetPluginMigrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPluginMigrations", reflect.TypeOf((*MockClient)(nil).GetPluginMigrations), arg0, arg1)
+}
+
+// GetPluginWatchLogs mocks base method.
+func (m *MockClient) GetPluginWatchLogs(arg0 context.Context, arg1 string) (io.ReadCloser, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPluginWatchLogs", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPluginWatchLogs indicates an expected call of GetPluginWatchLogs.
+func (mr *MockClientMockRecorder) GetPluginWatchLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPluginWatchLogs", reflect.TypeOf((*MockClient)(nil).GetPluginWatchLogs), arg0, arg1)
+}
+
 // GetPlugins mocks base method.
 func (m *MockClient) GetPlugins(arg0 context.Context) (*model.PluginsResponse, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1995,6 +2042,22 @@ func (mr *MockClientMockRecorder) RevokeUserAccessToken(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserAccessToken", reflect.TypeOf((*MockClient)(nil).RevokeUserAccessToken), arg0, arg1)
 }
 
+// RollbackPluginMigrations mocks base method.
+func (m *MockClient) RollbackPluginMigrations(arg0 context.Context, arg1 string, arg2 int64) ([]*model.PluginMigration, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackPluginMigrations", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.PluginMigration)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RollbackPluginMigrations indicates an expected call of RollbackPluginMigrations.
+func (mr *MockClientMockRecorder) RollbackPluginMigrations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackPluginMigrations", reflect.TypeOf((*MockClient)(nil).RollbackPluginMigrations), arg0, arg1, arg2)
+}
+
 // SearchTeams mocks base method.
 func (m *MockClient) SearchTeams(arg0 context.Context, arg1 *model.TeamSearch) ([]*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2071,6 +2134,21 @@ func (mr *MockClientMockRecorder) SyncLdap(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLdap", reflect.TypeOf((*MockClient)(nil).SyncLdap), arg0, arg1)
 }
 
+// UnwatchPlugin mocks base method.
+func (m *MockClient) UnwatchPlugin(arg0 context.Context, arg1 string) (*model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwatchPlugin", arg0, arg1)
+	ret0, _ := ret[0].(*model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnwatchPlugin indicates an expected call of UnwatchPlugin.
+func (mr *MockClientMockRecorder) UnwatchPlugin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwatchPlugin", reflect.TypeOf((*MockClient)(nil).UnwatchPlugin), arg0, arg1)
+}
+
 // UpdateChannelPrivacy mocks base method.
 func (m *MockClient) UpdateChannelPrivacy(arg0 context.Context, arg1 string, arg2 model.ChannelType) (*model.Channel, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2382,3 +2460,19 @@ func (mr *MockClientMockRecorder) VerifyUserEmailWithoutToken(arg0, arg1 interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserEmailWithoutToken", reflect.TypeOf((*MockClient)(nil).VerifyUserEmailWithoutToken), arg0, arg1)
 }
+
+// WatchPlugin mocks base method.
+func (m *MockClient) WatchPlugin(arg0 context.Context, arg1 string) (*model.Manifest, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPlugin", arg0, arg1)
+	ret0, _ := ret[0].(*model.Manifest)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchPlugin indicates an expected call of WatchPlugin.
+func (mr *MockClientMockRecorder) WatchPlugin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPlugin", reflect.TypeOf((*MockClient)(nil).WatchPlugin), arg0, arg1)
+}
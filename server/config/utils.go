@@ -65,6 +65,10 @@ func desanitize(actual, target *model.Config) {
 		*target.ElasticsearchSettings.Password = *actual.ElasticsearchSettings.Password
 	}
 
+	if *target.TranslationSettings.LibreTranslateAPIKey == model.FakeSetting {
+		*target.TranslationSettings.LibreTranslateAPIKey = *actual.TranslationSettings.LibreTranslateAPIKey
+	}
+
 	if len(target.SqlSettings.DataSourceReplicas) == len(actual.SqlSettings.DataSourceReplicas) {
 		for i, value := range target.SqlSettings.DataSourceReplicas {
 			if value == model.FakeSetting {
@@ -72,6 +72,8 @@ type MetricsInterface interface {
 	ObservePluginMultiHookIterationDuration(pluginID string, elapsed float64)
 	ObservePluginMultiHookDuration(elapsed float64)
 	ObservePluginAPIDuration(pluginID, apiName string, success bool, elapsed float64)
+	IncrementPluginHookTimeoutCounter(pluginID, hookName string)
+	IncrementPluginCircuitBreakerTripCounter(pluginID string)
 
 	ObserveEnabledUsers(users int64)
 	GetLoggerMetricsCollector() mlog.MetricsCollector
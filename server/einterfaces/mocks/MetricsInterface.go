@@ -203,6 +203,16 @@ func (_m *MetricsInterface) IncrementNotificationUnsupportedCounter(notification
 	_m.Called(notificationType, notSentReason, platform)
 }
 
+// IncrementPluginCircuitBreakerTripCounter provides a mock function with given fields: pluginID
+func (_m *MetricsInterface) IncrementPluginCircuitBreakerTripCounter(pluginID string) {
+	_m.Called(pluginID)
+}
+
+// IncrementPluginHookTimeoutCounter provides a mock function with given fields: pluginID, hookName
+func (_m *MetricsInterface) IncrementPluginHookTimeoutCounter(pluginID string, hookName string) {
+	_m.Called(pluginID, hookName)
+}
+
 // IncrementPostBroadcast provides a mock function with given fields:
 func (_m *MetricsInterface) IncrementPostBroadcast() {
 	_m.Called()
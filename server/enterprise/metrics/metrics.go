@@ -167,6 +167,8 @@ type MetricsInterfaceImpl struct {
 	PluginMultiHookTimeHistogram       *prometheus.HistogramVec
 	PluginMultiHookServerTimeHistogram prometheus.Histogram
 	PluginAPITimeHistogram             *prometheus.HistogramVec
+	PluginHookTimeoutCounter           *prometheus.CounterVec
+	PluginCircuitBreakerTripCounter    *prometheus.CounterVec
 
 	LoggerQueueGauge      *DynamicGauge
 	LoggerLoggedCounters  *DynamicCounter
@@ -494,6 +496,7 @@ func New(ps *platform.PlatformService, driver, dataSource string) *MetricsInterf
 		model.ClusterEventPluginEvent,
 		model.ClusterEventInvalidateCacheForTermsOfService,
 		model.ClusterEventBusyStateChanged,
+		model.ClusterEventInvalidateCacheForSavedSearchAlerts,
 	} {
 		m.ClusterEventMap[event] = m.ClusterEventTypeCounters.With(prometheus.Labels{"name": string(event)})
 	}
@@ -863,6 +866,30 @@ func New(ps *platform.PlatformService, driver, dataSource string) *MetricsInterf
 	)
 	m.Registry.MustRegister(m.PluginAPITimeHistogram)
 
+	m.PluginHookTimeoutCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemPlugin,
+			Name:        "hook_timeouts_total",
+			Help:        "Total number of plugin hook invocations abandoned after exceeding their execution budget.",
+			ConstLabels: additionalLabels,
+		},
+		[]string{"plugin_id", "hook_name"},
+	)
+	m.Registry.MustRegister(m.PluginHookTimeoutCounter)
+
+	m.PluginCircuitBreakerTripCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemPlugin,
+			Name:        "circuit_breaker_trips_total",
+			Help:        "Total number of times a plugin's hooks were temporarily bypassed after repeated failures.",
+			ConstLabels: additionalLabels,
+		},
+		[]string{"plugin_id"},
+	)
+	m.Registry.MustRegister(m.PluginCircuitBreakerTripCounter)
+
 	// Logging subsystem
 
 	m.LoggerQueueGauge = NewDynamicGauge(
@@ -1663,6 +1690,14 @@ func (mi *MetricsInterfaceImpl) ObservePluginAPIDuration(pluginID, apiName strin
 	mi.PluginAPITimeHistogram.With(prometheus.Labels{"plugin_id": pluginID, "api_name": apiName, "success": strconv.FormatBool(success)}).Observe(elapsed)
 }
 
+func (mi *MetricsInterfaceImpl) IncrementPluginHookTimeoutCounter(pluginID, hookName string) {
+	mi.PluginHookTimeoutCounter.With(prometheus.Labels{"plugin_id": pluginID, "hook_name": hookName}).Inc()
+}
+
+func (mi *MetricsInterfaceImpl) IncrementPluginCircuitBreakerTripCounter(pluginID string) {
+	mi.PluginCircuitBreakerTripCounter.With(prometheus.Labels{"plugin_id": pluginID}).Inc()
+}
+
 func (mi *MetricsInterfaceImpl) GetLoggerMetricsCollector() mlog.MetricsCollector {
 	return &LoggerMetricsCollector{
 		queueGauge:      mi.LoggerQueueGauge,
@@ -4,10 +4,12 @@
 package bleveengine
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,8 +17,23 @@ import (
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
 	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
 	"github.com/blevesearch/bleve/v2/mapping"
 
+	// Register the language analyzers that can be configured for the text of messages.
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/da"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/de"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/en"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/es"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/fi"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/fr"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/it"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/nl"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/no"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/pt"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/ru"
+	_ "github.com/blevesearch/bleve/v2/analysis/lang/sv"
+
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
@@ -28,22 +45,99 @@ const (
 	FileIndex    = "files"
 	UserIndex    = "users"
 	ChannelIndex = "channels"
+
+	postIndexSettingsKey = "mattermost_post_index_settings"
+
+	// The indexes filled by a reindex are stored next to the live ones, which are kept once
+	// replaced so they can be restored.
+	shadowIndexSuffix   = ".shadow"
+	previousIndexSuffix = ".previous"
+	rollbackIndexSuffix = ".rollback"
 )
 
+var indexNames = []string{PostIndex, FileIndex, UserIndex, ChannelIndex}
+
+// PostIndexSettings are the settings the text of the posts is analyzed with. They are stored
+// in the post index, as changing them requires indexing all the posts again.
+type PostIndexSettings struct {
+	TextAnalyzer       string
+	DetectPostLanguage bool
+}
+
+// legacyPostIndexSettings are the settings of the post indexes created before they were
+// stored in the index.
+var legacyPostIndexSettings = PostIndexSettings{
+	TextAnalyzer: standard.Name,
+}
+
+// textAnalyzers returns the analyzers used for the text of the posts in the index.
+func (s PostIndexSettings) textAnalyzers() []string {
+	if s.DetectPostLanguage && s.TextAnalyzer != cjk.AnalyzerName {
+		return []string{s.TextAnalyzer, cjk.AnalyzerName}
+	}
+	return []string{s.TextAnalyzer}
+}
+
+// setPostLanguage sets the language of the post if the post index detects the language of
+// the posts and it can be detected from the message.
+func (s PostIndexSettings) setPostLanguage(blvPost *BLVPost) {
+	analyzers := s.textAnalyzers()
+	if len(analyzers) < 2 {
+		return
+	}
+
+	if language := detectTextLanguage(blvPost.Message); slices.Contains(analyzers[1:], language) {
+		blvPost.Language = language
+	}
+}
+
+// ShadowIndexes are the indexes filled by a reindex while the live ones serve the searches.
+// Everything written to the live indexes is written to them too, until they replace the live
+// ones.
+type ShadowIndexes struct {
+	PostIndex         bleve.Index
+	FileIndex         bleve.Index
+	UserIndex         bleve.Index
+	ChannelIndex      bleve.Index
+	postIndexSettings PostIndexSettings
+}
+
+// SetPostLanguage sets the language of the post as the shadow post index analyzes it.
+func (s *ShadowIndexes) SetPostLanguage(blvPost *BLVPost) {
+	s.postIndexSettings.setPostLanguage(blvPost)
+}
+
+func (s *ShadowIndexes) close() error {
+	for _, index := range []bleve.Index{s.PostIndex, s.FileIndex, s.UserIndex, s.ChannelIndex} {
+		if index == nil {
+			continue
+		}
+		if err := index.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BleveEngine struct {
-	PostIndex    bleve.Index
-	FileIndex    bleve.Index
-	UserIndex    bleve.Index
-	ChannelIndex bleve.Index
-	Mutex        sync.RWMutex
-	ready        int32
-	cfg          *model.Config
-	indexSync    bool
+	PostIndex         bleve.Index
+	FileIndex         bleve.Index
+	UserIndex         bleve.Index
+	ChannelIndex      bleve.Index
+	Mutex             sync.RWMutex
+	ready             int32
+	cfg               *model.Config
+	indexSync         bool
+	postIndexSettings PostIndexSettings
+
+	// Shadow holds the indexes filled by the reindex in progress, if any.
+	Shadow *ShadowIndexes
 }
 
 var keywordMapping *mapping.FieldMapping
 var standardMapping *mapping.FieldMapping
 var dateMapping *mapping.FieldMapping
+var booleanMapping *mapping.FieldMapping
 
 func init() {
 	keywordMapping = bleve.NewTextFieldMapping()
@@ -53,6 +147,8 @@ func init() {
 	standardMapping.Analyzer = standard.Name
 
 	dateMapping = bleve.NewNumericFieldMapping()
+
+	booleanMapping = bleve.NewBooleanFieldMapping()
 }
 
 func getChannelIndexMapping() *mapping.IndexMappingImpl {
@@ -70,20 +166,41 @@ func getChannelIndexMapping() *mapping.IndexMappingImpl {
 	return indexMapping
 }
 
-func getPostIndexMapping() *mapping.IndexMappingImpl {
+func getPostDocumentMapping(textAnalyzer string) *mapping.DocumentMapping {
+	textMapping := bleve.NewTextFieldMapping()
+	textMapping.Analyzer = textAnalyzer
+
 	postMapping := bleve.NewDocumentMapping()
 	postMapping.AddFieldMappingsAt("Id", keywordMapping)
 	postMapping.AddFieldMappingsAt("TeamId", keywordMapping)
 	postMapping.AddFieldMappingsAt("ChannelId", keywordMapping)
 	postMapping.AddFieldMappingsAt("UserId", keywordMapping)
 	postMapping.AddFieldMappingsAt("CreateAt", dateMapping)
-	postMapping.AddFieldMappingsAt("Message", standardMapping)
+	postMapping.AddFieldMappingsAt("Message", textMapping)
 	postMapping.AddFieldMappingsAt("Type", keywordMapping)
 	postMapping.AddFieldMappingsAt("Hashtags", standardMapping)
-	postMapping.AddFieldMappingsAt("Attachments", standardMapping)
+	postMapping.AddFieldMappingsAt("Attachments", textMapping)
+	postMapping.AddFieldMappingsAt("RootId", keywordMapping)
+	postMapping.AddFieldMappingsAt("IsPinned", booleanMapping)
+	postMapping.AddFieldMappingsAt("HasFiles", booleanMapping)
+	postMapping.AddFieldMappingsAt("HasLink", booleanMapping)
+	postMapping.AddFieldMappingsAt("HasReaction", booleanMapping)
+	postMapping.AddFieldMappingsAt("Reactions", keywordMapping)
+	postMapping.AddFieldMappingsAt("Mentions", keywordMapping)
+	postMapping.AddFieldMappingsAt("Priority", keywordMapping)
+	postMapping.AddFieldMappingsAt("FileExtensions", keywordMapping)
+	postMapping.AddFieldMappingsAt("Language", keywordMapping)
+
+	return postMapping
+}
 
+func getPostIndexMapping(settings PostIndexSettings) *mapping.IndexMappingImpl {
 	indexMapping := bleve.NewIndexMapping()
-	indexMapping.AddDocumentMapping("_default", postMapping)
+	indexMapping.AddDocumentMapping("_default", getPostDocumentMapping(settings.TextAnalyzer))
+	if settings.DetectPostLanguage && settings.TextAnalyzer != cjk.AnalyzerName {
+		// Posts detected as written in a CJK language are typed as such, see BLVPost.BleveType.
+		indexMapping.AddDocumentMapping(cjk.AnalyzerName, getPostDocumentMapping(cjk.AnalyzerName))
+	}
 
 	return indexMapping
 }
@@ -129,10 +246,10 @@ func (b *BleveEngine) getIndexDir(indexName string) string {
 	return filepath.Join(*b.cfg.BleveSettings.IndexDir, indexName+".bleve")
 }
 
-func (b *BleveEngine) createOrOpenIndex(indexName string, mapping *mapping.IndexMappingImpl) (bleve.Index, error) {
+func (b *BleveEngine) createOrOpenIndex(indexName string, mapping *mapping.IndexMappingImpl) (bleve.Index, bool, error) {
 	indexPath := b.getIndexDir(indexName)
 	if index, err := bleve.Open(indexPath); err == nil {
-		return index, nil
+		return index, false, nil
 	}
 
 	index, err := bleve.NewUsing(indexPath, mapping, "scorch", "scorch", map[string]any{
@@ -140,9 +257,97 @@ func (b *BleveEngine) createOrOpenIndex(indexName string, mapping *mapping.Index
 		"forceSegmentVersion": 15,
 	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	return index, true, nil
+}
+
+func postIndexSettingsFromConfig(cfg *model.Config) PostIndexSettings {
+	return PostIndexSettings{
+		TextAnalyzer:       *cfg.BleveSettings.TextAnalyzer,
+		DetectPostLanguage: *cfg.BleveSettings.DetectPostLanguage,
+	}
+}
+
+// openPostIndex opens the post index, creating it with the configured settings if it doesn't
+// exist. The settings of an existing index are read from it, so they may not match the
+// configured ones until the posts are indexed again.
+func (b *BleveEngine) openPostIndex(indexName string) (bleve.Index, PostIndexSettings, error) {
+	settings := postIndexSettingsFromConfig(b.cfg)
+	index, created, err := b.createOrOpenIndex(indexName, getPostIndexMapping(settings))
+	if err != nil {
+		return nil, settings, err
+	}
+
+	if created {
+		data, err := json.Marshal(settings)
+		if err != nil {
+			index.Close()
+			return nil, settings, err
+		}
+		if err := index.SetInternal([]byte(postIndexSettingsKey), data); err != nil {
+			index.Close()
+			return nil, settings, err
+		}
+	} else {
+		data, err := index.GetInternal([]byte(postIndexSettingsKey))
+		if err != nil {
+			index.Close()
+			return nil, settings, err
+		}
+		settings = legacyPostIndexSettings
+		if data != nil {
+			if err := json.Unmarshal(data, &settings); err != nil {
+				index.Close()
+				return nil, settings, err
+			}
+		}
 	}
-	return index, nil
+
+	return index, settings, nil
+}
+
+// openShadowIndexes opens the shadow indexes, creating the missing ones.
+func (b *BleveEngine) openShadowIndexes() error {
+	shadow := &ShadowIndexes{}
+
+	var err error
+	shadow.PostIndex, shadow.postIndexSettings, err = b.openPostIndex(PostIndex + shadowIndexSuffix)
+	if err == nil {
+		shadow.FileIndex, _, err = b.createOrOpenIndex(FileIndex+shadowIndexSuffix, getFileIndexMapping())
+	}
+	if err == nil {
+		shadow.UserIndex, _, err = b.createOrOpenIndex(UserIndex+shadowIndexSuffix, getUserIndexMapping())
+	}
+	if err == nil {
+		shadow.ChannelIndex, _, err = b.createOrOpenIndex(ChannelIndex+shadowIndexSuffix, getChannelIndexMapping())
+	}
+	if err != nil {
+		shadow.close()
+		return err
+	}
+
+	b.Shadow = shadow
+	return nil
+}
+
+// closeShadowIndexes closes the shadow indexes, deleting them if the reindex is abandoned.
+func (b *BleveEngine) closeShadowIndexes(remove bool) error {
+	if b.Shadow != nil {
+		if err := b.Shadow.close(); err != nil {
+			return err
+		}
+		b.Shadow = nil
+	}
+
+	if remove {
+		for _, indexName := range indexNames {
+			if err := os.RemoveAll(b.getIndexDir(indexName + shadowIndexSuffix)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (b *BleveEngine) openIndexes() *model.AppError {
@@ -151,26 +356,34 @@ func (b *BleveEngine) openIndexes() *model.AppError {
 	}
 
 	var err error
-	b.PostIndex, err = b.createOrOpenIndex(PostIndex, getPostIndexMapping())
+	b.PostIndex, b.postIndexSettings, err = b.openPostIndex(PostIndex)
 	if err != nil {
 		return model.NewAppError("Bleveengine.Start", "bleveengine.create_post_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
-	b.FileIndex, err = b.createOrOpenIndex(FileIndex, getFileIndexMapping())
+	b.FileIndex, _, err = b.createOrOpenIndex(FileIndex, getFileIndexMapping())
 	if err != nil {
 		return model.NewAppError("Bleveengine.Start", "bleveengine.create_file_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
-	b.UserIndex, err = b.createOrOpenIndex(UserIndex, getUserIndexMapping())
+	b.UserIndex, _, err = b.createOrOpenIndex(UserIndex, getUserIndexMapping())
 	if err != nil {
 		return model.NewAppError("Bleveengine.Start", "bleveengine.create_user_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
-	b.ChannelIndex, err = b.createOrOpenIndex(ChannelIndex, getChannelIndexMapping())
+	b.ChannelIndex, _, err = b.createOrOpenIndex(ChannelIndex, getChannelIndexMapping())
 	if err != nil {
 		return model.NewAppError("Bleveengine.Start", "bleveengine.create_channel_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	// The shadow indexes of a reindex interrupted by a restart are opened again, so the
+	// indexing job resumes filling them. The channel index is the last one created.
+	if _, err := os.Stat(b.getIndexDir(ChannelIndex + shadowIndexSuffix)); err == nil {
+		if err := b.openShadowIndexes(); err != nil {
+			return model.NewAppError("Bleveengine.Start", "bleveengine.create_shadow_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
 	atomic.StoreInt32(&b.ready, 1)
 	return nil
 }
@@ -205,6 +418,10 @@ func (b *BleveEngine) closeIndexes() *model.AppError {
 		if err := b.ChannelIndex.Close(); err != nil {
 			return model.NewAppError("Bleveengine.Stop", "bleveengine.stop_channel_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 		}
+
+		if err := b.closeShadowIndexes(false); err != nil {
+			return model.NewAppError("Bleveengine.Stop", "bleveengine.stop_shadow_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 
 	atomic.StoreInt32(&b.ready, 0)
@@ -269,6 +486,13 @@ func (b *BleveEngine) deleteIndexes() *model.AppError {
 	if err := os.RemoveAll(b.getIndexDir(FileIndex)); err != nil {
 		return model.NewAppError("Bleveengine.PurgeIndexes", "bleveengine.purge_file_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
+	for _, indexName := range indexNames {
+		for _, suffix := range []string{shadowIndexSuffix, previousIndexSuffix} {
+			if err := os.RemoveAll(b.getIndexDir(indexName + suffix)); err != nil {
+				return model.NewAppError("Bleveengine.PurgeIndexes", "bleveengine.purge_shadow_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -292,6 +516,174 @@ func (b *BleveEngine) PurgeIndexes(rctx request.CTX) *model.AppError {
 	return b.openIndexes()
 }
 
+// SetPostLanguage sets the language of the post if the post index detects the language of
+// the posts and it can be detected from the message. The caller must hold the engine mutex.
+func (b *BleveEngine) SetPostLanguage(blvPost *BLVPost) {
+	b.postIndexSettings.setPostLanguage(blvPost)
+}
+
+// IsPostIndexOutdated returns true if the post index was created with settings different to
+// the ones of the given configuration. In that case the posts have to be indexed again, which
+// the indexing job does.
+func (b *BleveEngine) IsPostIndexOutdated(cfg *model.Config) bool {
+	b.Mutex.RLock()
+	defer b.Mutex.RUnlock()
+
+	return b.IsActive() && b.postIndexSettings != postIndexSettingsFromConfig(cfg)
+}
+
+// StartShadowReindex creates empty shadow indexes, with the configured settings, for the
+// indexing job to fill while the live indexes serve the searches. Any other reindex in progress
+// is abandoned.
+func (b *BleveEngine) StartShadowReindex(rctx request.CTX) *model.AppError {
+	b.Mutex.Lock()
+	defer b.Mutex.Unlock()
+
+	if !b.IsActive() {
+		return model.NewAppError("Bleveengine.StartShadowReindex", "bleveengine.not_started.error", nil, "", http.StatusInternalServerError)
+	}
+
+	if err := b.closeShadowIndexes(true); err != nil {
+		return model.NewAppError("Bleveengine.StartShadowReindex", "bleveengine.purge_shadow_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := b.openShadowIndexes(); err != nil {
+		return model.NewAppError("Bleveengine.StartShadowReindex", "bleveengine.create_shadow_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Started reindexing Bleve into shadow indexes",
+		mlog.String("text_analyzer", b.Shadow.postIndexSettings.TextAnalyzer),
+		mlog.Bool("detect_post_language", b.Shadow.postIndexSettings.DetectPostLanguage),
+	)
+	return nil
+}
+
+// IsShadowReindexing returns true if a reindex is filling the shadow indexes.
+func (b *BleveEngine) IsShadowReindexing() bool {
+	b.Mutex.RLock()
+	defer b.Mutex.RUnlock()
+
+	return b.IsActive() && b.Shadow != nil
+}
+
+// swapIndexDirs replaces the directories of the live indexes with the ones with the given
+// suffix, which take the suffix of the replaced ones. The indexes must be closed. If any
+// rename fails, those already done are undone, leaving the live indexes in place.
+func (b *BleveEngine) swapIndexDirs(suffix, replacedSuffix string) (err error) {
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(undo) - 1; i >= 0; i-- {
+			if undoErr := undo[i](); undoErr != nil {
+				mlog.Error("Error restoring the Bleve index directories", mlog.Err(undoErr))
+			}
+		}
+	}()
+
+	for _, indexName := range indexNames {
+		live := b.getIndexDir(indexName)
+		replacement := b.getIndexDir(indexName + suffix)
+		replaced := b.getIndexDir(indexName + replacedSuffix)
+
+		if err = os.RemoveAll(replaced); err != nil {
+			return err
+		}
+		if err = os.Rename(live, replaced); err == nil {
+			undo = append(undo, func() error { return os.Rename(replaced, live) })
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		if err = os.Rename(replacement, live); err != nil {
+			return err
+		}
+		undo = append(undo, func() error { return os.Rename(live, replacement) })
+	}
+	return nil
+}
+
+// CompleteShadowReindex replaces the live indexes with the shadow ones. The replaced indexes
+// are kept, so they can be restored with RollbackReindex.
+func (b *BleveEngine) CompleteShadowReindex(rctx request.CTX) *model.AppError {
+	b.Mutex.Lock()
+	defer b.Mutex.Unlock()
+
+	if !b.IsActive() || b.Shadow == nil {
+		return model.NewAppError("Bleveengine.CompleteShadowReindex", "bleveengine.complete_shadow_reindex.not_reindexing.error", nil, "", http.StatusInternalServerError)
+	}
+
+	if err := b.closeIndexes(); err != nil {
+		return err
+	}
+
+	swapErr := b.swapIndexDirs(shadowIndexSuffix, previousIndexSuffix)
+
+	// The indexes are opened again even if the swap failed, so the searches are served by
+	// whichever indexes are in place.
+	if err := b.openIndexes(); err != nil {
+		return err
+	}
+	if swapErr != nil {
+		return model.NewAppError("Bleveengine.CompleteShadowReindex", "bleveengine.complete_shadow_reindex.swap.error", nil, "", http.StatusInternalServerError).Wrap(swapErr)
+	}
+
+	rctx.Logger().Info("Replaced the Bleve indexes with the reindexed ones")
+	return nil
+}
+
+// HasPreviousIndexes returns true if the indexes replaced by the last reindex are kept.
+func (b *BleveEngine) HasPreviousIndexes() bool {
+	b.Mutex.RLock()
+	defer b.Mutex.RUnlock()
+
+	return b.hasPreviousIndexes()
+}
+
+func (b *BleveEngine) hasPreviousIndexes() bool {
+	for _, indexName := range indexNames {
+		if _, err := os.Stat(b.getIndexDir(indexName + previousIndexSuffix)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// RollbackReindex restores the indexes replaced by the last reindex, keeping the current ones
+// in their place, so rolling back again restores them.
+func (b *BleveEngine) RollbackReindex(rctx request.CTX) *model.AppError {
+	b.Mutex.Lock()
+	defer b.Mutex.Unlock()
+
+	if *b.cfg.BleveSettings.IndexDir == "" || !b.hasPreviousIndexes() {
+		return model.NewAppError("Bleveengine.RollbackReindex", "bleveengine.rollback_reindex.no_previous_indexes.error", nil, "", http.StatusBadRequest)
+	}
+
+	if err := b.closeIndexes(); err != nil {
+		return err
+	}
+
+	// The current indexes are set aside while the previous ones take their place.
+	swapErr := b.swapIndexDirs(previousIndexSuffix, rollbackIndexSuffix)
+	if swapErr == nil {
+		for _, indexName := range indexNames {
+			if swapErr = os.Rename(b.getIndexDir(indexName+rollbackIndexSuffix), b.getIndexDir(indexName+previousIndexSuffix)); swapErr != nil {
+				break
+			}
+		}
+	}
+
+	if err := b.openIndexes(); err != nil {
+		return err
+	}
+	if swapErr != nil {
+		return model.NewAppError("Bleveengine.RollbackReindex", "bleveengine.rollback_reindex.swap.error", nil, "", http.StatusInternalServerError).Wrap(swapErr)
+	}
+
+	rctx.Logger().Info("Restored the Bleve indexes replaced by the last reindex")
+	return nil
+}
+
 func (b *BleveEngine) PurgeIndexList(rctx request.CTX, indexes []string) *model.AppError {
 	return model.NewAppError("Bleve.PurgeIndex", "bleveengine.purge_list.not_implemented", nil, "not implemented", http.StatusNotFound)
 }
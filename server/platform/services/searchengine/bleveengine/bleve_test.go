@@ -4,10 +4,12 @@
 package bleveengine
 
 import (
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -233,3 +235,259 @@ func (s *BleveEngineTestSuite) TestDeletePosts() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), 1, int(numberDocs))
 }
+
+func startTestBleveEngine(t *testing.T, indexDir, textAnalyzer string, detectPostLanguage bool) *BleveEngine {
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.BleveSettings.EnableIndexing = model.NewPointer(true)
+	cfg.BleveSettings.EnableSearching = model.NewPointer(true)
+	cfg.BleveSettings.IndexDir = model.NewPointer(indexDir)
+	cfg.BleveSettings.TextAnalyzer = model.NewPointer(textAnalyzer)
+	cfg.BleveSettings.DetectPostLanguage = model.NewPointer(detectPostLanguage)
+
+	engine := NewBleveEngine(cfg)
+	require.Nil(t, engine.Start())
+	t.Cleanup(func() {
+		require.Nil(t, engine.Stop())
+	})
+	return engine
+}
+
+func TestBlevePostIndexSettings(t *testing.T) {
+	teamID := model.NewId()
+	userID := model.NewId()
+	channel := &model.Channel{Id: model.NewId()}
+
+	newEngine := startTestBleveEngine
+
+	indexPost := func(t *testing.T, engine *BleveEngine, message string) *model.Post {
+		post := createPost(userID, channel.Id)
+		post.Message = message
+		require.Nil(t, engine.IndexPost(post, teamID))
+		return post
+	}
+
+	search := func(t *testing.T, engine *BleveEngine, terms string) []string {
+		postIDs, _, appErr := engine.SearchPosts(model.ChannelList{channel}, model.ParseSearchParams(terms, 0), 0, 20)
+		require.Nil(t, appErr)
+		return postIDs
+	}
+
+	t.Run("should analyze the messages with the configured analyzer", func(t *testing.T) {
+		engine := newEngine(t, t.TempDir(), "de", false)
+
+		post := indexPost(t, engine, "Die Häuser sind schön")
+
+		require.Equal(t, []string{post.Id}, search(t, engine, "haus"))
+	})
+
+	t.Run("should analyze the messages written in a CJK language with the CJK analyzer", func(t *testing.T) {
+		engine := newEngine(t, t.TempDir(), "en", true)
+
+		post1 := indexPost(t, engine, "東京駅で会いましょう")
+		post2 := indexPost(t, engine, "See you at the meetings")
+
+		require.Equal(t, []string{post1.Id}, search(t, engine, "東京"))
+		require.Equal(t, []string{post2.Id}, search(t, engine, "meeting"))
+	})
+
+	t.Run("should store the settings in the post index", func(t *testing.T) {
+		indexDir := t.TempDir()
+		engine := newEngine(t, indexDir, "de", false)
+		require.Nil(t, engine.Stop())
+
+		engine = newEngine(t, indexDir, "fr", false)
+		assert.Equal(t, PostIndexSettings{TextAnalyzer: "de"}, engine.postIndexSettings)
+
+		cfg := engine.cfg.Clone()
+		assert.True(t, engine.IsPostIndexOutdated(cfg))
+		cfg.BleveSettings.TextAnalyzer = model.NewPointer("de")
+		assert.False(t, engine.IsPostIndexOutdated(cfg))
+	})
+
+}
+
+func TestBleveShadowReindex(t *testing.T) {
+	rctx := request.TestContext(t)
+	teamID := model.NewId()
+	userID := model.NewId()
+	channel := &model.Channel{Id: model.NewId()}
+
+	indexPost := func(t *testing.T, engine *BleveEngine, message string) *model.Post {
+		post := createPost(userID, channel.Id)
+		post.Message = message
+		require.Nil(t, engine.IndexPost(post, teamID))
+		return post
+	}
+
+	search := func(t *testing.T, engine *BleveEngine, terms string) []string {
+		postIDs, _, appErr := engine.SearchPosts(model.ChannelList{channel}, model.ParseSearchParams(terms, 0), 0, 20)
+		require.Nil(t, appErr)
+		return postIDs
+	}
+
+	t.Run("should keep searching the live indexes while reindexing", func(t *testing.T) {
+		engine := startTestBleveEngine(t, t.TempDir(), "standard", false)
+		post1 := indexPost(t, engine, "Die Häuser sind schön")
+
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		require.True(t, engine.IsShadowReindexing())
+
+		post2 := indexPost(t, engine, "Die Häuser sind alt")
+		assert.ElementsMatch(t, []string{post1.Id, post2.Id}, search(t, engine, "häuser"))
+
+		count, err := engine.Shadow.PostIndex.DocCount()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), count, "the new posts should be written to the shadow index too")
+	})
+
+	t.Run("should replace the live indexes with the shadow ones", func(t *testing.T) {
+		engine := startTestBleveEngine(t, t.TempDir(), "standard", false)
+		indexPost(t, engine, "Die Häuser sind schön")
+
+		engine.cfg.BleveSettings.TextAnalyzer = model.NewPointer("de")
+		require.True(t, engine.IsPostIndexOutdated(engine.cfg))
+
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		post := indexPost(t, engine, "Die Häuser sind alt")
+
+		require.Nil(t, engine.CompleteShadowReindex(rctx))
+		assert.False(t, engine.IsShadowReindexing())
+		assert.False(t, engine.IsPostIndexOutdated(engine.cfg))
+		assert.True(t, engine.HasPreviousIndexes())
+		assert.Equal(t, []string{post.Id}, search(t, engine, "haus"))
+	})
+
+	t.Run("should keep the live indexes if they can't all be replaced", func(t *testing.T) {
+		engine := startTestBleveEngine(t, t.TempDir(), "standard", false)
+		post := indexPost(t, engine, "Message before the reindex")
+
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		require.NoError(t, os.RemoveAll(engine.getIndexDir(indexNames[len(indexNames)-1]+shadowIndexSuffix)))
+
+		require.NotNil(t, engine.CompleteShadowReindex(rctx))
+		assert.Equal(t, []string{post.Id}, search(t, engine, "message"))
+	})
+
+	t.Run("should resume reindexing after a restart", func(t *testing.T) {
+		indexDir := t.TempDir()
+		engine := startTestBleveEngine(t, indexDir, "standard", false)
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		indexPost(t, engine, "Reindexed message")
+		require.Nil(t, engine.Stop())
+
+		engine = startTestBleveEngine(t, indexDir, "standard", false)
+		require.True(t, engine.IsShadowReindexing())
+		count, err := engine.Shadow.PostIndex.DocCount()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), count)
+	})
+
+	t.Run("should roll back to the indexes replaced by the reindex", func(t *testing.T) {
+		engine := startTestBleveEngine(t, t.TempDir(), "standard", false)
+
+		appErr := engine.RollbackReindex(rctx)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+
+		post1 := indexPost(t, engine, "Message before the reindex")
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		require.Nil(t, engine.CompleteShadowReindex(rctx))
+		assert.Empty(t, search(t, engine, "message"))
+
+		require.Nil(t, engine.RollbackReindex(rctx))
+		assert.Equal(t, []string{post1.Id}, search(t, engine, "message"))
+
+		// Rolling back again restores the reindexed indexes.
+		require.Nil(t, engine.RollbackReindex(rctx))
+		assert.Empty(t, search(t, engine, "message"))
+	})
+
+	t.Run("should delete the shadow and previous indexes when purging", func(t *testing.T) {
+		engine := startTestBleveEngine(t, t.TempDir(), "standard", false)
+		require.Nil(t, engine.StartShadowReindex(rctx))
+		require.Nil(t, engine.CompleteShadowReindex(rctx))
+		require.Nil(t, engine.StartShadowReindex(rctx))
+
+		require.Nil(t, engine.PurgeIndexes(rctx))
+		assert.False(t, engine.IsShadowReindexing())
+		assert.False(t, engine.HasPreviousIndexes())
+	})
+}
+
+func TestBleveSearchPostsWithFacets(t *testing.T) {
+	engine := startTestBleveEngine(t, t.TempDir(), "en", false)
+
+	teamID := model.NewId()
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+	channel1 := &model.Channel{Id: model.NewId()}
+	channel2 := &model.Channel{Id: model.NewId()}
+	now := model.GetMillis()
+	day := int64(24 * 60 * 60 * 1000)
+
+	indexPost := func(channel *model.Channel, userID, message string, createAt int64, files ...*model.FileInfo) *model.Post {
+		post := createPost(userID, channel.Id)
+		post.Message = message
+		post.CreateAt = createAt
+		if len(files) > 0 {
+			post.Metadata = &model.PostMetadata{Files: files}
+		}
+		require.Nil(t, engine.IndexPost(post, teamID))
+		return post
+	}
+
+	search := func(terms string, modify func(params *model.SearchParams)) ([]string, model.PostSearchMatches, *model.PostSearchFacets) {
+		paramsList := model.ParseSearchParams(terms, 0)
+		for _, params := range paramsList {
+			modify(params)
+		}
+		postIDs, matches, facets, appErr := engine.SearchPostsWithFacets(model.ChannelList{channel1, channel2}, paramsList, 0, 20)
+		require.Nil(t, appErr)
+		return postIDs, matches, facets
+	}
+
+	post1 := indexPost(channel1, userID1, "Deploying the service, the deployment is deployed", now-60*day, &model.FileInfo{Extension: "pdf"})
+	post2 := indexPost(channel1, userID2, "Deploy the service", now-59*day)
+	post3 := indexPost(channel2, userID1, "Deploy the other service", now-2*day, &model.FileInfo{Extension: "pdf"}, &model.FileInfo{Extension: "png"})
+	indexPost(channel2, userID2, "Unrelated message", now-3*day)
+
+	t.Run("should sort by time and return the matched words", func(t *testing.T) {
+		postIDs, matches, facets := search("deploy", func(params *model.SearchParams) {})
+
+		assert.Equal(t, []string{post3.Id, post2.Id, post1.Id}, postIDs)
+		assert.ElementsMatch(t, []string{"Deploying", "deployed"}, matches[post1.Id])
+		assert.Equal(t, []string{"Deploy"}, matches[post2.Id])
+		assert.Nil(t, facets)
+	})
+
+	t.Run("should rank by term frequency and recency", func(t *testing.T) {
+		postIDs, _, _ := search("deploy", func(params *model.SearchParams) {
+			params.SortBy = model.SearchSortByRelevance
+		})
+
+		assert.Equal(t, post3.Id, postIDs[0])
+		assert.Equal(t, []string{post1.Id, post2.Id}, postIDs[1:])
+	})
+
+	t.Run("should rank the posts of the channels and users with affinity higher", func(t *testing.T) {
+		postIDs, _, _ := search("service", func(params *model.SearchParams) {
+			params.SortBy = model.SearchSortByRelevance
+			params.AffinityUserIds = []string{userID2}
+		})
+
+		assert.Equal(t, []string{post2.Id, post3.Id, post1.Id}, postIDs)
+	})
+
+	t.Run("should count the facets", func(t *testing.T) {
+		_, _, facets := search("deploy", func(params *model.SearchParams) {
+			params.IncludeFacets = true
+		})
+
+		require.NotNil(t, facets)
+		assert.Equal(t, []*model.PostSearchFacet{{Value: channel1.Id, Count: 2}, {Value: channel2.Id, Count: 1}}, facets.Channels)
+		assert.ElementsMatch(t, []*model.PostSearchFacet{{Value: userID1, Count: 2}, {Value: userID2, Count: 1}}, facets.Users)
+		assert.Equal(t, []*model.PostSearchFacet{{Value: model.PostSearchDateFacetWeek, Count: 1}, {Value: model.PostSearchDateFacetYear, Count: 2}}, facets.Dates)
+		assert.Equal(t, []*model.PostSearchFacet{{Value: "pdf", Count: 2}, {Value: "png", Count: 1}}, facets.FileTypes)
+	})
+}
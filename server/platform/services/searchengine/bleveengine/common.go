@@ -4,7 +4,11 @@
 package bleveengine
 
 import (
+	"slices"
 	"strings"
+	"unicode"
+
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
@@ -28,15 +32,34 @@ type BLVUser struct {
 }
 
 type BLVPost struct {
-	Id          string
-	TeamId      string
-	ChannelId   string
-	UserId      string
-	CreateAt    int64
-	Message     string
-	Type        string
-	Hashtags    []string
-	Attachments string
+	Id             string
+	TeamId         string
+	ChannelId      string
+	UserId         string
+	CreateAt       int64
+	Message        string
+	Type           string
+	Hashtags       []string
+	Attachments    string
+	RootId         string
+	IsPinned       bool
+	HasFiles       bool
+	HasLink        bool
+	HasReaction    bool
+	Reactions      []string
+	Mentions       []string
+	Priority       string
+	FileExtensions []string
+	Language       string
+}
+
+// BleveType returns the document type of the post, which selects the mapping of the language
+// the post was detected to be written in.
+func (p *BLVPost) BleveType() string {
+	if p.Language == "" {
+		return "_default"
+	}
+	return p.Language
 }
 
 type BLVFile struct {
@@ -119,16 +142,49 @@ func BLVPostFromPost(post *model.Post, teamId string) *BLVPost {
 }
 
 func BLVPostFromPostForIndexing(post *model.PostForIndexing) *BLVPost {
-	return &BLVPost{
-		Id:        post.Id,
-		TeamId:    post.TeamId,
-		ChannelId: post.ChannelId,
-		UserId:    post.UserId,
-		CreateAt:  post.CreateAt,
-		Message:   post.Message,
-		Type:      post.Type,
-		Hashtags:  strings.Fields(post.Hashtags),
+	blvPost := &BLVPost{
+		Id:          post.Id,
+		TeamId:      post.TeamId,
+		ChannelId:   post.ChannelId,
+		UserId:      post.UserId,
+		CreateAt:    post.CreateAt,
+		Message:     post.Message,
+		Type:        post.Type,
+		Hashtags:    strings.Fields(post.Hashtags),
+		RootId:      post.RootId,
+		IsPinned:    post.IsPinned,
+		HasFiles:    len(post.FileIds) > 0,
+		HasLink:     model.MessageHasLink(post.Message),
+		HasReaction: post.HasReactions,
+		Mentions:    model.MessageMentions(post.Message),
+	}
+
+	if post.Metadata != nil {
+		for _, reaction := range post.Metadata.Reactions {
+			blvPost.Reactions = append(blvPost.Reactions, reaction.EmojiName)
+		}
+		if priority := post.GetPriority(); priority != nil && priority.Priority != nil {
+			blvPost.Priority = *priority.Priority
+		}
+		for _, file := range post.Metadata.Files {
+			if file.Extension != "" && !slices.Contains(blvPost.FileExtensions, file.Extension) {
+				blvPost.FileExtensions = append(blvPost.FileExtensions, file.Extension)
+			}
+		}
+	}
+
+	return blvPost
+}
+
+// detectTextLanguage returns the analyzer for the language the text is written in when its
+// script identifies it, or an empty string otherwise.
+func detectTextLanguage(text string) string {
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			return cjk.AnalyzerName
+		}
 	}
+	return ""
 }
 
 func splitFilenameWords(name string) string {
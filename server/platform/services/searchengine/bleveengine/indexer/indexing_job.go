@@ -26,6 +26,24 @@ const (
 	estimatedUserCount    = 10000
 )
 
+// progressDataKeys are the keys of the job data storing the progress of the reindex.
+var progressDataKeys = []string{
+	"start_time",
+	"start_post_id",
+	"start_channel_id",
+	"start_user_id",
+	"start_file_id",
+	"original_start_time",
+	"done_posts_count",
+	"total_posts_count",
+	"done_channels_count",
+	"total_channels_count",
+	"done_users_count",
+	"total_users_count",
+	"done_files_count",
+	"total_files_count",
+}
+
 type BleveIndexerWorker struct {
 	name string
 	// stateMut protects stopCh and helps enforce
@@ -169,6 +187,26 @@ func (worker *BleveIndexerWorker) DoJob(job *model.Job) {
 		return
 	}
 
+	if job.Data == nil {
+		job.Data = make(model.StringMap)
+	}
+
+	// Everything is indexed into shadow indexes while the live ones keep serving the searches,
+	// and they replace the live ones once the job finishes. A job resumes filling the shadow
+	// indexes it created, unless they are gone, in which case it starts over.
+	if job.Data["shadow_indexes"] != "true" || !worker.engine.IsShadowReindexing() {
+		if appErr := worker.engine.StartShadowReindex(request.EmptyContext(logger)); appErr != nil {
+			if err := worker.jobServer.SetJobError(job, appErr); err != nil {
+				logger.Error("Worker: Failed to set job error", mlog.Err(err), mlog.NamedErr("set_error", appErr))
+			}
+			return
+		}
+		for _, key := range progressDataKeys {
+			delete(job.Data, key)
+		}
+		job.Data["shadow_indexes"] = "true"
+	}
+
 	progress := IndexingProgress{
 		Now:          time.Now(),
 		DonePosts:    false,
@@ -232,6 +270,10 @@ func (worker *BleveIndexerWorker) DoJob(job *model.Job) {
 	if id, ok := job.Data["start_file_id"]; ok {
 		progress.LastFileID = id
 	}
+	progress.DonePostsCount, _ = strconv.ParseInt(job.Data["done_posts_count"], 10, 64)
+	progress.DoneChannelsCount, _ = strconv.ParseInt(job.Data["done_channels_count"], 10, 64)
+	progress.DoneUsersCount, _ = strconv.ParseInt(job.Data["done_users_count"], 10, 64)
+	progress.DoneFilesCount, _ = strconv.ParseInt(job.Data["done_files_count"], 10, 64)
 
 	// Counting all posts may fail or timeout when the posts table is large. If this happens, log a warning, but carry
 	// on with the indexing job anyway. The only issue is that the progress % reporting will be inaccurate.
@@ -304,16 +346,20 @@ func (worker *BleveIndexerWorker) DoJob(job *model.Job) {
 			}
 
 			// Storing the batch progress in metadata.
-			if job.Data == nil {
-				job.Data = make(model.StringMap)
-			}
-
 			job.Data["start_time"] = strconv.FormatInt(progress.LastEntityTime, 10)
 			job.Data["start_post_id"] = progress.LastPostID
 			job.Data["start_channel_id"] = progress.LastChannelID
 			job.Data["start_user_id"] = progress.LastUserID
 			job.Data["start_file_id"] = progress.LastFileID
 			job.Data["original_start_time"] = strconv.FormatInt(progress.StartAtTime, 10)
+			job.Data["done_posts_count"] = strconv.FormatInt(progress.DonePostsCount, 10)
+			job.Data["total_posts_count"] = strconv.FormatInt(progress.TotalPostsCount, 10)
+			job.Data["done_channels_count"] = strconv.FormatInt(progress.DoneChannelsCount, 10)
+			job.Data["total_channels_count"] = strconv.FormatInt(progress.TotalChannelsCount, 10)
+			job.Data["done_users_count"] = strconv.FormatInt(progress.DoneUsersCount, 10)
+			job.Data["total_users_count"] = strconv.FormatInt(progress.TotalUsersCount, 10)
+			job.Data["done_files_count"] = strconv.FormatInt(progress.DoneFilesCount, 10)
+			job.Data["total_files_count"] = strconv.FormatInt(progress.TotalFilesCount, 10)
 			job.Data["end_time"] = strconv.FormatInt(progress.EndAtTime, 10)
 
 			if err := worker.jobServer.SetJobProgress(job, progress.CurrentProgress()); err != nil {
@@ -325,6 +371,14 @@ func (worker *BleveIndexerWorker) DoJob(job *model.Job) {
 			}
 
 			if progress.IsDone() {
+				if appErr := worker.engine.CompleteShadowReindex(request.EmptyContext(logger)); appErr != nil {
+					logger.Error("Worker: Failed to replace the indexes with the reindexed ones", mlog.Err(appErr))
+					if err := worker.jobServer.SetJobError(job, appErr); err != nil {
+						logger.Error("Worker: Failed to set error for job", mlog.Err(err), mlog.NamedErr("set_error", appErr))
+					}
+					return
+				}
+
 				if err := worker.jobServer.SetJobSuccess(job); err != nil {
 					logger.Error("Worker: Failed to set success for job", mlog.Err(err))
 					if err2 := worker.jobServer.SetJobError(job, err); err2 != nil {
@@ -381,6 +435,10 @@ func (worker *BleveIndexerWorker) IndexPostsBatch(logger mlog.LoggerIFace, progr
 		return progress, nil
 	}
 
+	if err := worker.loadPostsSearchMetadata(posts); err != nil {
+		return progress, err
+	}
+
 	lastPost, err := worker.BulkIndexPosts(posts, progress)
 	if err != nil {
 		return progress, err
@@ -401,22 +459,90 @@ func (worker *BleveIndexerWorker) IndexPostsBatch(logger mlog.LoggerIFace, progr
 	return progress, nil
 }
 
+// loadPostsSearchMetadata sets the reactions, the priority and the files of the posts,
+// which are indexed along with them.
+func (worker *BleveIndexerWorker) loadPostsSearchMetadata(posts []*model.PostForIndexing) *model.AppError {
+	postsByID := make(map[string]*model.PostForIndexing, len(posts))
+	postIDs := make([]string, 0, len(posts))
+	reactedPostIDs := []string{}
+	postsByFileID := map[string]*model.PostForIndexing{}
+	for _, post := range posts {
+		postsByID[post.Id] = post
+		postIDs = append(postIDs, post.Id)
+		if post.HasReactions {
+			reactedPostIDs = append(reactedPostIDs, post.Id)
+		}
+		for _, fileID := range post.FileIds {
+			postsByFileID[fileID] = post
+		}
+		if post.Metadata == nil {
+			post.Metadata = &model.PostMetadata{}
+		}
+	}
+
+	if len(postsByFileID) > 0 {
+		fileIDs := make([]string, 0, len(postsByFileID))
+		for fileID := range postsByFileID {
+			fileIDs = append(fileIDs, fileID)
+		}
+		files, err := worker.jobServer.Store.FileInfo().GetByIds(fileIDs)
+		if err != nil {
+			return model.NewAppError("IndexPostsBatch", "bleveengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, file := range files {
+			if post, ok := postsByFileID[file.Id]; ok {
+				post.Metadata.Files = append(post.Metadata.Files, file)
+			}
+		}
+	}
+
+	if len(reactedPostIDs) > 0 {
+		reactions, err := worker.jobServer.Store.Reaction().BulkGetForPosts(reactedPostIDs)
+		if err != nil {
+			return model.NewAppError("IndexPostsBatch", "bleveengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, reaction := range reactions {
+			if post, ok := postsByID[reaction.PostId]; ok {
+				post.Metadata.Reactions = append(post.Metadata.Reactions, reaction)
+			}
+		}
+	}
+
+	priorities, err := worker.jobServer.Store.PostPriority().GetForPosts(postIDs)
+	if err != nil {
+		return model.NewAppError("IndexPostsBatch", "bleveengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	for _, priority := range priorities {
+		if post, ok := postsByID[priority.PostId]; ok {
+			post.Metadata.Priority = priority
+		}
+	}
+
+	return nil
+}
+
 func (worker *BleveIndexerWorker) BulkIndexPosts(posts []*model.PostForIndexing, progress IndexingProgress) (*model.Post, *model.AppError) {
-	batch := worker.engine.PostIndex.NewBatch()
+	worker.engine.Mutex.RLock()
+	defer worker.engine.Mutex.RUnlock()
+
+	shadow, appErr := worker.shadowIndexes("BleveIndexerWorker.BulkIndexPosts")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	batch := shadow.PostIndex.NewBatch()
 
 	for _, post := range posts {
 		if post.DeleteAt == 0 {
 			searchPost := bleveengine.BLVPostFromPostForIndexing(post)
+			shadow.SetPostLanguage(searchPost)
 			batch.Index(searchPost.Id, searchPost)
 		} else {
 			batch.Delete(post.Id)
 		}
 	}
 
-	worker.engine.Mutex.RLock()
-	defer worker.engine.Mutex.RUnlock()
-
-	if err := worker.engine.PostIndex.Batch(batch); err != nil {
+	if err := shadow.PostIndex.Batch(batch); err != nil {
 		return nil, model.NewAppError("BleveIndexerWorker.BulkIndexPosts", "bleveengine.indexer.do_job.bulk_index_posts.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 	return &posts[len(posts)-1].Post, nil
@@ -469,7 +595,15 @@ func (worker *BleveIndexerWorker) IndexFilesBatch(logger mlog.LoggerIFace, progr
 }
 
 func (worker *BleveIndexerWorker) BulkIndexFiles(files []*model.FileForIndexing, progress IndexingProgress) (*model.FileInfo, *model.AppError) {
-	batch := worker.engine.FileIndex.NewBatch()
+	worker.engine.Mutex.RLock()
+	defer worker.engine.Mutex.RUnlock()
+
+	shadow, appErr := worker.shadowIndexes("BleveIndexerWorker.BulkIndexFiles")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	batch := shadow.FileIndex.NewBatch()
 
 	for _, file := range files {
 		if file.ShouldIndex() {
@@ -480,10 +614,7 @@ func (worker *BleveIndexerWorker) BulkIndexFiles(files []*model.FileForIndexing,
 		}
 	}
 
-	worker.engine.Mutex.RLock()
-	defer worker.engine.Mutex.RUnlock()
-
-	if err := worker.engine.FileIndex.Batch(batch); err != nil {
+	if err := shadow.FileIndex.Batch(batch); err != nil {
 		return nil, model.NewAppError("BleveIndexerWorker.BulkIndexPosts", "bleveengine.indexer.do_job.bulk_index_files.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 	return &files[len(files)-1].FileInfo, nil
@@ -536,8 +667,8 @@ func (worker *BleveIndexerWorker) IndexChannelsBatch(logger mlog.LoggerIFace, pr
 }
 
 func (worker *BleveIndexerWorker) BulkIndexChannels(logger mlog.LoggerIFace, channels []*model.Channel, progress IndexingProgress) (*model.Channel, *model.AppError) {
-	batch := worker.engine.ChannelIndex.NewBatch()
-
+	// The members are fetched before locking the engine, not to block the swap of the indexes.
+	searchChannels := make([]*bleveengine.BLVChannel, 0, len(channels))
 	for _, channel := range channels {
 		if channel.DeleteAt == 0 {
 			var userIDs []string
@@ -555,17 +686,30 @@ func (worker *BleveIndexerWorker) BulkIndexChannels(logger mlog.LoggerIFace, cha
 				return nil, model.NewAppError("BleveIndexerWorker.BulkIndexChannels", "bleveengine.indexer.do_job.bulk_index_channels.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
 			}
 
-			searchChannel := bleveengine.BLVChannelFromChannel(channel, userIDs, teamMemberIDs)
-			batch.Index(searchChannel.Id, searchChannel)
-		} else {
-			batch.Delete(channel.Id)
+			searchChannels = append(searchChannels, bleveengine.BLVChannelFromChannel(channel, userIDs, teamMemberIDs))
 		}
 	}
 
 	worker.engine.Mutex.RLock()
 	defer worker.engine.Mutex.RUnlock()
 
-	if err := worker.engine.ChannelIndex.Batch(batch); err != nil {
+	shadow, appErr := worker.shadowIndexes("BleveIndexerWorker.BulkIndexChannels")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	batch := shadow.ChannelIndex.NewBatch()
+
+	for _, searchChannel := range searchChannels {
+		batch.Index(searchChannel.Id, searchChannel)
+	}
+	for _, channel := range channels {
+		if channel.DeleteAt != 0 {
+			batch.Delete(channel.Id)
+		}
+	}
+
+	if err := shadow.ChannelIndex.Batch(batch); err != nil {
 		return nil, model.NewAppError("BleveIndexerWorker.BulkIndexChannels", "bleveengine.indexer.do_job.bulk_index_channels.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 	return channels[len(channels)-1], nil
@@ -617,7 +761,15 @@ func (worker *BleveIndexerWorker) IndexUsersBatch(logger mlog.LoggerIFace, progr
 }
 
 func (worker *BleveIndexerWorker) BulkIndexUsers(logger mlog.LoggerIFace, users []*model.UserForIndexing, progress IndexingProgress) (*model.UserForIndexing, *model.AppError) {
-	batch := worker.engine.UserIndex.NewBatch()
+	worker.engine.Mutex.RLock()
+	defer worker.engine.Mutex.RUnlock()
+
+	shadow, appErr := worker.shadowIndexes("BleveIndexerWorker.BulkIndexUsers")
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	batch := shadow.UserIndex.NewBatch()
 
 	for _, user := range users {
 		if user.DeleteAt == 0 {
@@ -628,11 +780,17 @@ func (worker *BleveIndexerWorker) BulkIndexUsers(logger mlog.LoggerIFace, users
 		}
 	}
 
-	worker.engine.Mutex.RLock()
-	defer worker.engine.Mutex.RUnlock()
-
-	if err := worker.engine.UserIndex.Batch(batch); err != nil {
+	if err := shadow.UserIndex.Batch(batch); err != nil {
 		return nil, model.NewAppError("BleveIndexerWorker.BulkIndexUsers", "bleveengine.indexer.do_job.bulk_index_users.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 	return users[len(users)-1], nil
 }
+
+// shadowIndexes returns the shadow indexes the job fills, which are gone if the indexes were
+// purged in the meantime. The caller must hold the engine mutex.
+func (worker *BleveIndexerWorker) shadowIndexes(where string) (*bleveengine.ShadowIndexes, *model.AppError) {
+	if worker.engine.Shadow == nil {
+		return nil, model.NewAppError(where, "bleveengine.indexer.do_job.shadow_indexes_missing.error", nil, "", http.StatusInternalServerError)
+	}
+	return worker.engine.Shadow, nil
+}
@@ -8,6 +8,8 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -43,8 +45,10 @@ func TestBleveIndexer(t *testing.T) {
 
 		cfg := &model.Config{
 			BleveSettings: model.BleveSettings{
-				EnableIndexing: model.NewPointer(true),
-				IndexDir:       model.NewPointer(tempDir),
+				EnableIndexing:     model.NewPointer(true),
+				IndexDir:           model.NewPointer(tempDir),
+				TextAnalyzer:       model.NewPointer(model.BleveSettingsDefaultTextAnalyzer),
+				DetectPostLanguage: model.NewPointer(false),
 			},
 		}
 
@@ -67,4 +71,76 @@ func TestBleveIndexer(t *testing.T) {
 
 		worker.DoJob(job)
 	})
+	t.Run("Reindex into shadow indexes and replace the live ones", func(t *testing.T) {
+		mockStore := &storetest.Store{}
+		defer mockStore.AssertExpectations(t)
+
+		cfg := &model.Config{}
+		cfg.SetDefaults()
+		cfg.BleveSettings.EnableIndexing = model.NewPointer(true)
+		cfg.BleveSettings.EnableSearching = model.NewPointer(true)
+		cfg.BleveSettings.IndexDir = model.NewPointer(t.TempDir())
+
+		bleveEngine := bleveengine.NewBleveEngine(cfg)
+		require.Nil(t, bleveEngine.Start())
+		t.Cleanup(func() {
+			require.Nil(t, bleveEngine.Stop())
+		})
+
+		channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId()}
+		stalePost := &model.Post{Id: model.NewId(), ChannelId: channel.Id, Message: "indexed before the job", CreateAt: model.GetMillis() - 2000}
+		require.Nil(t, bleveEngine.IndexPost(stalePost, channel.TeamId))
+
+		post := &model.PostForIndexing{TeamId: channel.TeamId}
+		post.Id = model.NewId()
+		post.ChannelId = channel.Id
+		post.Message = "indexed by the job"
+		post.CreateAt = model.GetMillis() - 1000
+
+		job := &model.Job{
+			Id:       model.NewId(),
+			CreateAt: model.GetMillis(),
+			Status:   model.JobStatusPending,
+			Type:     model.JobTypeBlevePostIndexing,
+		}
+
+		mockStore.JobStore.On("UpdateStatusOptimistically", job.Id, model.JobStatusPending, model.JobStatusInProgress).Return(true, nil)
+		mockStore.JobStore.On("UpdateOptimistically", job, model.JobStatusInProgress).Return(true, nil)
+		mockStore.JobStore.On("UpdateStatus", job.Id, model.JobStatusSuccess).Return(job, nil)
+		mockStore.JobStore.On("Get", mock.Anything, job.Id).Return(job, nil).Maybe()
+		mockStore.PostStore.On("GetOldestEntityCreationTime").Return(post.CreateAt, nil)
+		mockStore.PostStore.On("AnalyticsPostCount", mock.Anything).Return(int64(1), nil)
+		mockStore.ChannelStore.On("AnalyticsTypeCount", "", model.ChannelType("")).Return(int64(0), nil)
+		mockStore.UserStore.On("Count", mock.Anything).Return(int64(0), nil)
+		mockStore.FileInfoStore.On("CountAll").Return(int64(0), nil)
+		mockStore.PostStore.On("GetPostsBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.PostForIndexing{post}, nil).Once()
+		mockStore.PostStore.On("GetPostsBatchForIndexing", post.CreateAt, post.Id, mock.Anything).Return([]*model.PostForIndexing{}, nil).Once()
+		mockStore.PostPriorityStore.On("GetForPosts", []string{post.Id}).Return([]*model.PostPriority{}, nil)
+		mockStore.ChannelStore.On("GetChannelsBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.Channel{}, nil)
+		mockStore.UserStore.On("GetUsersBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.UserForIndexing{}, nil)
+		mockStore.FileInfoStore.On("GetFilesBatchForIndexing", post.CreateAt, "", true, mock.Anything).Return([]*model.FileForIndexing{}, nil)
+
+		worker := &BleveIndexerWorker{
+			jobServer: &jobs.JobServer{
+				Store: mockStore,
+				ConfigService: &testutils.StaticConfigService{
+					Cfg: cfg,
+				},
+			},
+			engine: bleveEngine,
+			logger: mlog.CreateConsoleTestLogger(t),
+		}
+
+		worker.DoJob(job)
+
+		assert.Equal(t, "true", job.Data["shadow_indexes"])
+		assert.Equal(t, "1", job.Data["done_posts_count"])
+		assert.Equal(t, "1", job.Data["total_posts_count"])
+		assert.False(t, bleveEngine.IsShadowReindexing())
+		assert.True(t, bleveEngine.HasPreviousIndexes())
+
+		postIDs, _, appErr := bleveEngine.SearchPosts(model.ChannelList{channel}, model.ParseSearchParams("indexed", 0), 0, 20)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{post.Id}, postIDs)
+	})
 }
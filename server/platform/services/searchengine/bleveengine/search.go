@@ -5,9 +5,11 @@ package bleveengine
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/search"
 	"github.com/blevesearch/bleve/v2/search/query"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -23,13 +25,61 @@ func (b *BleveEngine) IndexPost(post *model.Post, teamId string) *model.AppError
 	defer b.Mutex.RUnlock()
 
 	blvPost := BLVPostFromPost(post, teamId)
+	b.SetPostLanguage(blvPost)
 	if err := b.PostIndex.Index(blvPost.Id, blvPost); err != nil {
 		return model.NewAppError("Bleveengine.IndexPost", "bleveengine.index_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
+
+	if b.Shadow != nil {
+		// The shadow post index may analyze the posts differently.
+		blvPost = BLVPostFromPost(post, teamId)
+		b.Shadow.SetPostLanguage(blvPost)
+		if err := b.Shadow.PostIndex.Index(blvPost.Id, blvPost); err != nil {
+			return model.NewAppError("Bleveengine.IndexPost", "bleveengine.index_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
 	return nil
 }
 
+// postIndexes returns the post indexes every change is written to: the live one and, while
+// reindexing, the shadow one. The caller must hold the engine mutex.
+func (b *BleveEngine) postIndexes() []bleve.Index {
+	if b.Shadow != nil {
+		return []bleve.Index{b.PostIndex, b.Shadow.PostIndex}
+	}
+	return []bleve.Index{b.PostIndex}
+}
+
+func (b *BleveEngine) fileIndexes() []bleve.Index {
+	if b.Shadow != nil {
+		return []bleve.Index{b.FileIndex, b.Shadow.FileIndex}
+	}
+	return []bleve.Index{b.FileIndex}
+}
+
+func (b *BleveEngine) userIndexes() []bleve.Index {
+	if b.Shadow != nil {
+		return []bleve.Index{b.UserIndex, b.Shadow.UserIndex}
+	}
+	return []bleve.Index{b.UserIndex}
+}
+
+func (b *BleveEngine) channelIndexes() []bleve.Index {
+	if b.Shadow != nil {
+		return []bleve.Index{b.ChannelIndex, b.Shadow.ChannelIndex}
+	}
+	return []bleve.Index{b.ChannelIndex}
+}
+
 func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
+	postIds, matches, _, appErr := b.SearchPostsWithFacets(channels, searchParams, page, perPage)
+	return postIds, matches, appErr
+}
+
+func (b *BleveEngine) SearchPostsWithFacets(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.PostSearchFacets, *model.AppError) {
+	b.Mutex.RLock()
+	defer b.Mutex.RUnlock()
+
 	channelQueries := []query.Query{}
 	for _, channel := range channels {
 		channelIdQ := bleve.NewTermQuery(channel.Id)
@@ -145,6 +195,68 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 					notFilters = append(notFilters, onDateQ)
 				}
 			}
+
+			if params.IsFlagged {
+				// Flags aren't indexed, so the flagged posts are given by id.
+				if len(params.FlaggedPostIds) == 0 {
+					return []string{}, model.PostSearchMatches{}, nil, nil
+				}
+				flaggedPosts := []query.Query{}
+				for _, postId := range params.FlaggedPostIds {
+					postQ := bleve.NewTermQuery(postId)
+					postQ.SetField("Id")
+					flaggedPosts = append(flaggedPosts, postQ)
+				}
+				filters = append(filters, bleve.NewDisjunctionQuery(flaggedPosts...))
+			}
+
+			if params.IsPinned {
+				filters = append(filters, newTrueFieldQuery("IsPinned"))
+			}
+
+			if params.IsRoot || params.InThread {
+				rootQ := bleve.NewTermQuery("")
+				rootQ.SetField("RootId")
+				if params.IsRoot {
+					filters = append(filters, rootQ)
+				} else {
+					notFilters = append(notFilters, rootQ)
+				}
+			}
+
+			if params.HasFile {
+				filters = append(filters, newTrueFieldQuery("HasFiles"))
+			}
+
+			if params.HasLink {
+				filters = append(filters, newTrueFieldQuery("HasLink"))
+			}
+
+			if params.HasReaction {
+				filters = append(filters, newTrueFieldQuery("HasReaction"))
+			}
+
+			for _, emojiName := range params.ReactedEmojis {
+				reactionQ := bleve.NewTermQuery(emojiName)
+				reactionQ.SetField("Reactions")
+				filters = append(filters, reactionQ)
+			}
+
+			for _, username := range params.Mentions {
+				mentionQ := bleve.NewTermQuery(strings.ToLower(username))
+				mentionQ.SetField("Mentions")
+				filters = append(filters, mentionQ)
+			}
+
+			if len(params.Priorities) > 0 {
+				priorities := []query.Query{}
+				for _, priority := range params.Priorities {
+					priorityQ := bleve.NewTermQuery(priority)
+					priorityQ.SetField("Priority")
+					priorities = append(priorities, priorityQ)
+				}
+				filters = append(filters, bleve.NewDisjunctionQuery(priorities...))
+			}
 		}
 
 		if params.IsHashtag {
@@ -173,18 +285,12 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 				}
 
 				if len(terms) > 0 {
-					messageQ := bleve.NewMatchQuery(strings.Join(terms, " "))
-					messageQ.SetField("Message")
-					messageQ.SetOperator(termOperator)
-					termQueries = append(termQueries, messageQ)
+					termQueries = append(termQueries, b.newMessageMatchQuery(strings.Join(terms, " "), termOperator))
 				}
 			}
 
 			if params.ExcludedTerms != "" {
-				messageQ := bleve.NewMatchQuery(params.ExcludedTerms)
-				messageQ.SetField("Message")
-				messageQ.SetOperator(termOperator)
-				notTermQueries = append(notTermQueries, messageQ)
+				notTermQueries = append(notTermQueries, b.newMessageMatchQuery(params.ExcludedTerms, termOperator))
 			}
 		}
 	}
@@ -211,11 +317,27 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 		query.AddMustNot(notFilters...)
 	}
 
+	now := model.GetMillis()
+	sortByRelevance := searchParams[0].SortBy == model.SearchSortByRelevance
+	if sortByRelevance {
+		query.AddShould(getPostRelevanceQueries(searchParams[0], now)...)
+	}
+
 	search := bleve.NewSearchRequestOptions(query, perPage, page*perPage, false)
-	search.SortBy([]string{"-CreateAt"})
+	search.Fields = []string{"Message"}
+	search.IncludeLocations = true
+	if sortByRelevance {
+		search.SortBy([]string{"-_score", "-CreateAt"})
+	} else {
+		search.SortBy([]string{"-CreateAt"})
+	}
+	if searchParams[0].IncludeFacets {
+		addPostSearchFacets(search, now)
+	}
+
 	results, err := b.PostIndex.Search(search)
 	if err != nil {
-		return nil, nil, model.NewAppError("Bleveengine.SearchPosts", "bleveengine.search_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil, nil, nil, model.NewAppError("Bleveengine.SearchPosts", "bleveengine.search_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
 	postIds := []string{}
@@ -223,28 +345,182 @@ func (b *BleveEngine) SearchPosts(channels model.ChannelList, searchParams []*mo
 
 	for _, r := range results.Hits {
 		postIds = append(postIds, r.ID)
+		if postMatches := getMessageMatches(r); len(postMatches) > 0 {
+			matches[r.ID] = postMatches
+		}
 	}
 
-	return postIds, matches, nil
+	var facets *model.PostSearchFacets
+	if searchParams[0].IncludeFacets {
+		facets = getPostSearchFacets(results.Facets)
+	}
+
+	return postIds, matches, facets, nil
 }
 
-func (b *BleveEngine) deletePosts(searchRequest *bleve.SearchRequest, batchSize int) (int64, error) {
+// getPostRelevanceQueries returns the queries increasing the score of the recent posts, and
+// of those in the channels and from the users the searching user interacts the most with.
+func getPostRelevanceQueries(params *model.SearchParams, now int64) []query.Query {
+	const day = int64(24 * 60 * 60 * 1000)
+
+	// The boosts add up, so the score of a post decays with its age.
+	relevanceQueries := []query.Query{}
+	for _, recency := range []struct {
+		age   int64
+		boost float64
+	}{{day, 1.5}, {7 * day, 1}, {30 * day, 0.5}} {
+		since := float64(now - recency.age)
+		recencyQ := bleve.NewNumericRangeQuery(&since, nil)
+		recencyQ.SetField("CreateAt")
+		recencyQ.SetBoost(recency.boost)
+		relevanceQueries = append(relevanceQueries, recencyQ)
+	}
+
+	for _, channelId := range params.AffinityChannelIds {
+		channelQ := bleve.NewTermQuery(channelId)
+		channelQ.SetField("ChannelId")
+		relevanceQueries = append(relevanceQueries, channelQ)
+	}
+
+	for _, userId := range params.AffinityUserIds {
+		userQ := bleve.NewTermQuery(userId)
+		userQ.SetField("UserId")
+		relevanceQueries = append(relevanceQueries, userQ)
+	}
+
+	return relevanceQueries
+}
+
+func addPostSearchFacets(searchRequest *bleve.SearchRequest, now int64) {
+	searchRequest.AddFacet("channels", bleve.NewFacetRequest("ChannelId", model.PostSearchFacetSize))
+	searchRequest.AddFacet("users", bleve.NewFacetRequest("UserId", model.PostSearchFacetSize))
+	searchRequest.AddFacet("file_types", bleve.NewFacetRequest("FileExtensions", model.PostSearchFacetSize))
+
+	dateFacet := bleve.NewFacetRequest("CreateAt", len(model.PostSearchDateFacets))
+	for name, dateRange := range model.PostSearchDateFacetRanges(now) {
+		var start, end *float64
+		if dateRange[0] != 0 {
+			start = model.NewPointer(float64(dateRange[0]))
+		}
+		if dateRange[1] != 0 {
+			end = model.NewPointer(float64(dateRange[1]))
+		}
+		dateFacet.AddNumericRange(name, start, end)
+	}
+	searchRequest.AddFacet("dates", dateFacet)
+}
+
+func getPostSearchFacets(results search.FacetResults) *model.PostSearchFacets {
+	termFacets := func(name string) []*model.PostSearchFacet {
+		facets := []*model.PostSearchFacet{}
+		if result, ok := results[name]; ok {
+			for _, term := range result.Terms.Terms() {
+				facets = append(facets, &model.PostSearchFacet{Value: term.Term, Count: term.Count})
+			}
+		}
+		return facets
+	}
+
+	facets := &model.PostSearchFacets{
+		Channels:  termFacets("channels"),
+		Users:     termFacets("users"),
+		Dates:     []*model.PostSearchFacet{},
+		FileTypes: termFacets("file_types"),
+	}
+
+	if result, ok := results["dates"]; ok {
+		counts := map[string]int{}
+		for _, dateRange := range result.NumericRanges {
+			counts[dateRange.Name] = dateRange.Count
+		}
+		for _, name := range model.PostSearchDateFacets {
+			if counts[name] > 0 {
+				facets.Dates = append(facets.Dates, &model.PostSearchFacet{Value: name, Count: counts[name]})
+			}
+		}
+	}
+
+	return facets
+}
+
+// getMessageMatches returns the words of the message of the post matched by the search.
+func getMessageMatches(hit *search.DocumentMatch) []string {
+	message, ok := hit.Fields["Message"].(string)
+	if !ok {
+		return nil
+	}
+
+	words := []string{}
+	for _, locations := range hit.Locations["Message"] {
+		for _, location := range locations {
+			if location.Start >= location.End || location.End > uint64(len(message)) {
+				continue
+			}
+			if word := message[location.Start:location.End]; !slices.Contains(words, word) {
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// newMessageMatchQuery returns a query matching the text in the message of the posts, analyzing
+// it with every analyzer the messages are indexed with.
+func (b *BleveEngine) newMessageMatchQuery(text string, operator query.MatchQueryOperator) query.Query {
+	messageQueries := []query.Query{}
+	for _, analyzer := range b.postIndexSettings.textAnalyzers() {
+		messageQ := bleve.NewMatchQuery(text)
+		messageQ.SetField("Message")
+		messageQ.Analyzer = analyzer
+		messageQ.SetOperator(operator)
+		messageQueries = append(messageQueries, messageQ)
+	}
+
+	if len(messageQueries) == 1 {
+		return messageQueries[0]
+	}
+	return bleve.NewDisjunctionQuery(messageQueries...)
+}
+
+func newTrueFieldQuery(field string) query.Query {
+	boolQ := bleve.NewBoolFieldQuery(true)
+	boolQ.SetField(field)
+	return boolQ
+}
+
+// deleteFromIndexes deletes the documents matching the search request from all the indexes,
+// returning how many were deleted from the first one.
+func deleteFromIndexes(indexes []bleve.Index, searchRequest *bleve.SearchRequest, batchSize int) (int64, error) {
+	var deleted int64
+	for i, index := range indexes {
+		count, err := deleteFromIndex(index, searchRequest, batchSize)
+		if err != nil {
+			return -1, err
+		}
+		if i == 0 {
+			deleted = count
+		}
+	}
+	return deleted, nil
+}
+
+func deleteFromIndex(index bleve.Index, searchRequest *bleve.SearchRequest, batchSize int) (int64, error) {
 	resultsCount := int64(0)
 
 	for {
-		// As we are deleting the posts after fetching them, we need to keep
+		// As we are deleting the documents after fetching them, we need to keep
 		// From fixed always to 0
 		searchRequest.From = 0
 		searchRequest.Size = batchSize
-		results, err := b.PostIndex.Search(searchRequest)
+		results, err := index.Search(searchRequest)
 		if err != nil {
 			return -1, err
 		}
-		batch := b.PostIndex.NewBatch()
-		for _, post := range results.Hits {
-			batch.Delete(post.ID)
+		batch := index.NewBatch()
+		for _, hit := range results.Hits {
+			batch.Delete(hit.ID)
 		}
-		if err := b.PostIndex.Batch(batch); err != nil {
+		if err := index.Batch(batch); err != nil {
 			return -1, err
 		}
 		resultsCount += int64(results.Hits.Len())
@@ -256,6 +532,12 @@ func (b *BleveEngine) deletePosts(searchRequest *bleve.SearchRequest, batchSize
 	return resultsCount, nil
 }
 
+// deletePosts deletes the posts matching the search request, returning how many were deleted
+// from the live index.
+func (b *BleveEngine) deletePosts(searchRequest *bleve.SearchRequest, batchSize int) (int64, error) {
+	return deleteFromIndexes(b.postIndexes(), searchRequest, batchSize)
+}
+
 func (b *BleveEngine) DeleteChannelPosts(rctx request.CTX, channelID string) *model.AppError {
 	b.Mutex.RLock()
 	defer b.Mutex.RUnlock()
@@ -298,8 +580,10 @@ func (b *BleveEngine) DeletePost(post *model.Post) *model.AppError {
 	b.Mutex.RLock()
 	defer b.Mutex.RUnlock()
 
-	if err := b.PostIndex.Delete(post.Id); err != nil {
-		return model.NewAppError("Bleveengine.DeletePost", "bleveengine.delete_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.postIndexes() {
+		if err := index.Delete(post.Id); err != nil {
+			return model.NewAppError("Bleveengine.DeletePost", "bleveengine.delete_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -309,8 +593,10 @@ func (b *BleveEngine) IndexChannel(_ request.CTX, channel *model.Channel, userID
 	defer b.Mutex.RUnlock()
 
 	blvChannel := BLVChannelFromChannel(channel, userIDs, teamMemberIDs)
-	if err := b.ChannelIndex.Index(blvChannel.Id, blvChannel); err != nil {
-		return model.NewAppError("Bleveengine.IndexChannel", "bleveengine.index_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.channelIndexes() {
+		if err := index.Index(blvChannel.Id, blvChannel); err != nil {
+			return model.NewAppError("Bleveengine.IndexChannel", "bleveengine.index_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -397,8 +683,10 @@ func (b *BleveEngine) DeleteChannel(channel *model.Channel) *model.AppError {
 	b.Mutex.RLock()
 	defer b.Mutex.RUnlock()
 
-	if err := b.ChannelIndex.Delete(channel.Id); err != nil {
-		return model.NewAppError("Bleveengine.DeleteChannel", "bleveengine.delete_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.channelIndexes() {
+		if err := index.Delete(channel.Id); err != nil {
+			return model.NewAppError("Bleveengine.DeleteChannel", "bleveengine.delete_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -408,8 +696,10 @@ func (b *BleveEngine) IndexUser(_ request.CTX, user *model.User, teamsIds, chann
 	defer b.Mutex.RUnlock()
 
 	blvUser := BLVUserFromUserAndTeams(user, teamsIds, channelsIds)
-	if err := b.UserIndex.Index(blvUser.Id, blvUser); err != nil {
-		return model.NewAppError("Bleveengine.IndexUser", "bleveengine.index_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.userIndexes() {
+		if err := index.Index(blvUser.Id, blvUser); err != nil {
+			return model.NewAppError("Bleveengine.IndexUser", "bleveengine.index_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -556,8 +846,10 @@ func (b *BleveEngine) DeleteUser(user *model.User) *model.AppError {
 	b.Mutex.RLock()
 	defer b.Mutex.RUnlock()
 
-	if err := b.UserIndex.Delete(user.Id); err != nil {
-		return model.NewAppError("Bleveengine.DeleteUser", "bleveengine.delete_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.userIndexes() {
+		if err := index.Delete(user.Id); err != nil {
+			return model.NewAppError("Bleveengine.DeleteUser", "bleveengine.delete_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -567,8 +859,10 @@ func (b *BleveEngine) IndexFile(file *model.FileInfo, channelId string) *model.A
 	defer b.Mutex.RUnlock()
 
 	blvFile := BLVFileFromFileInfo(file, channelId)
-	if err := b.FileIndex.Index(blvFile.Id, blvFile); err != nil {
-		return model.NewAppError("Bleveengine.IndexFile", "bleveengine.index_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.fileIndexes() {
+		if err := index.Index(blvFile.Id, blvFile); err != nil {
+			return model.NewAppError("Bleveengine.IndexFile", "bleveengine.index_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
@@ -785,38 +1079,18 @@ func (b *BleveEngine) DeleteFile(fileID string) *model.AppError {
 	b.Mutex.RLock()
 	defer b.Mutex.RUnlock()
 
-	if err := b.FileIndex.Delete(fileID); err != nil {
-		return model.NewAppError("Bleveengine.DeleteFile", "bleveengine.delete_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	for _, index := range b.fileIndexes() {
+		if err := index.Delete(fileID); err != nil {
+			return model.NewAppError("Bleveengine.DeleteFile", "bleveengine.delete_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
 	}
 	return nil
 }
 
+// deleteFiles deletes the files matching the search request, returning how many were deleted
+// from the live index.
 func (b *BleveEngine) deleteFiles(searchRequest *bleve.SearchRequest, batchSize int) (int64, error) {
-	resultsCount := int64(0)
-
-	for {
-		// As we are deleting the files after fetching them, we need to keep
-		// From fixed always to 0
-		searchRequest.From = 0
-		searchRequest.Size = batchSize
-		results, err := b.FileIndex.Search(searchRequest)
-		if err != nil {
-			return -1, err
-		}
-		batch := b.FileIndex.NewBatch()
-		for _, file := range results.Hits {
-			batch.Delete(file.ID)
-		}
-		if err := b.FileIndex.Batch(batch); err != nil {
-			return -1, err
-		}
-		resultsCount += int64(results.Hits.Len())
-		if results.Hits.Len() < batchSize {
-			break
-		}
-	}
-
-	return resultsCount, nil
+	return deleteFromIndexes(b.fileIndexes(), searchRequest, batchSize)
 }
 
 func (b *BleveEngine) DeleteUserFiles(rctx request.CTX, userID string) *model.AppError {
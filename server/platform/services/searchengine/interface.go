@@ -52,3 +52,9 @@ type SearchEngineInterface interface {
 	DataRetentionDeleteIndexes(rctx request.CTX, cutoff time.Time) *model.AppError
 	IsChannelsIndexVerified() bool
 }
+
+// FacetedPostSearchEngine is implemented by the search engines able to count the facets of
+// all the posts matching a search along with the page of results.
+type FacetedPostSearchEngine interface {
+	SearchPostsWithFacets(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.PostSearchFacets, *model.AppError)
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package opensearchengine
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// client is a minimal client of the REST API shared by OpenSearch and Elasticsearch. Only the
+// endpoints available in both of them, and without a license, are used.
+type client struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	username   string
+	password   string
+}
+
+// responseError is the error returned by the cluster for a request.
+type responseError struct {
+	StatusCode int
+	Type       string
+	Reason     string
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Type, e.Reason)
+}
+
+func isResponseErrorType(err error, errorType string) bool {
+	var respErr *responseError
+	return errors.As(err, &respErr) && respErr.Type == errorType
+}
+
+func isNotFoundError(err error) bool {
+	var respErr *responseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
+
+func newClient(settings model.ElasticsearchSettings) (*client, error) {
+	baseURL, err := url.Parse(strings.TrimSuffix(*settings.ConnectionURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection URL: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: *settings.SkipTLSVerification,
+	}
+
+	if *settings.CA != "" {
+		ca, err := os.ReadFile(*settings.CA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA certificate: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to parse the CA certificate")
+		}
+	}
+
+	if *settings.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(*settings.ClientCert, *settings.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &client{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(*settings.RequestTimeoutSeconds) * time.Second,
+		},
+		baseURL:  baseURL,
+		username: *settings.Username,
+		password: *settings.Password,
+	}, nil
+}
+
+// do sends a request to the cluster, encoding the body as JSON unless it is already encoded,
+// and decodes the response into the result if it isn't nil.
+func (c *client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
+	var reader io.Reader
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		// Already encoded bodies are the newline delimited ones of the bulk API.
+		reader = bytes.NewReader(b)
+		contentType = "application/x-ndjson"
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	requestURL := *c.baseURL
+	requestURL.Path += path
+	requestURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL.String(), reader)
+	if err != nil {
+		return err
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		respErr := &responseError{StatusCode: resp.StatusCode}
+		var errorBody struct {
+			Error json.RawMessage `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&errorBody); err == nil && len(errorBody.Error) > 0 {
+			var details struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			}
+			if json.Unmarshal(errorBody.Error, &details) == nil {
+				respErr.Type = details.Type
+				respErr.Reason = details.Reason
+			} else {
+				respErr.Reason = string(errorBody.Error)
+			}
+		}
+		return respErr
+	}
+
+	if result == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+type clusterInfo struct {
+	Version struct {
+		Number       string `json:"number"`
+		Distribution string `json:"distribution"`
+	} `json:"version"`
+}
+
+func (c *client) info(ctx context.Context) (*clusterInfo, error) {
+	var info clusterInfo
+	if err := c.do(ctx, http.MethodGet, "/", nil, nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (c *client) putIndexTemplate(ctx context.Context, name string, template any) error {
+	return c.do(ctx, http.MethodPut, "/_index_template/"+name, nil, template, nil)
+}
+
+// createIndex creates the index, adding it to the aliases. An index that already exists is
+// not an error.
+func (c *client) createIndex(ctx context.Context, index string, aliases ...string) error {
+	body := map[string]any{}
+	if len(aliases) > 0 {
+		indexAliases := map[string]any{}
+		for _, alias := range aliases {
+			indexAliases[alias] = map[string]any{}
+		}
+		body["aliases"] = indexAliases
+	}
+
+	err := c.do(ctx, http.MethodPut, "/"+index, nil, body, nil)
+	if isResponseErrorType(err, "resource_already_exists_exception") {
+		return nil
+	}
+	return err
+}
+
+func (c *client) deleteIndexes(ctx context.Context, indexes []string) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	query := url.Values{"ignore_unavailable": {"true"}}
+	return c.do(ctx, http.MethodDelete, "/"+strings.Join(indexes, ","), query, nil, nil)
+}
+
+// getIndexes returns the names of the indexes matching the pattern.
+func (c *client) getIndexes(ctx context.Context, pattern string) ([]string, error) {
+	var rows []struct {
+		Index string `json:"index"`
+	}
+	query := url.Values{"format": {"json"}, "h": {"index"}}
+	if err := c.do(ctx, http.MethodGet, "/_cat/indices/"+pattern, query, nil, &rows); err != nil {
+		if isNotFoundError(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	indexes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		indexes = append(indexes, row.Index)
+	}
+	return indexes, nil
+}
+
+// getAliases returns the indexes of each of the aliases matching the pattern.
+func (c *client) getAliases(ctx context.Context, pattern string) (map[string][]string, error) {
+	var result map[string]struct {
+		Aliases map[string]any `json:"aliases"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/_alias/"+pattern, nil, nil, &result); err != nil {
+		if isNotFoundError(err) {
+			return map[string][]string{}, nil
+		}
+		return nil, err
+	}
+
+	aliases := map[string][]string{}
+	for index, indexAliases := range result {
+		for alias := range indexAliases.Aliases {
+			aliases[alias] = append(aliases[alias], index)
+		}
+	}
+	return aliases, nil
+}
+
+type aliasAction struct {
+	Add    *aliasActionTarget `json:"add,omitempty"`
+	Remove *aliasActionTarget `json:"remove,omitempty"`
+}
+
+type aliasActionTarget struct {
+	Index string `json:"index"`
+	Alias string `json:"alias"`
+}
+
+// updateAliases applies all the actions atomically.
+func (c *client) updateAliases(ctx context.Context, actions []aliasAction) error {
+	if len(actions) == 0 {
+		return nil
+	}
+	return c.do(ctx, http.MethodPost, "/_aliases", nil, map[string]any{"actions": actions}, nil)
+}
+
+// bulkRequest is a request to the bulk API.
+type bulkRequest struct {
+	body  bytes.Buffer
+	count int
+}
+
+func (b *bulkRequest) index(index, id string, doc any) error {
+	return b.add(map[string]any{"index": map[string]any{"_index": index, "_id": id}}, doc)
+}
+
+func (b *bulkRequest) delete(index, id string) error {
+	return b.add(map[string]any{"delete": map[string]any{"_index": index, "_id": id}}, nil)
+}
+
+func (b *bulkRequest) add(action, doc any) error {
+	encoder := json.NewEncoder(&b.body)
+	if err := encoder.Encode(action); err != nil {
+		return err
+	}
+	if doc != nil {
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+	}
+	b.count++
+	return nil
+}
+
+// bulk sends the bulk request, returning the first error of its operations. Deleting a
+// document that doesn't exist is not an error.
+func (c *client) bulk(ctx context.Context, request *bulkRequest, refresh bool) error {
+	if request.count == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	if refresh {
+		query.Set("refresh", "true")
+	}
+
+	var result struct {
+		Errors bool                         `json:"errors"`
+		Items  []map[string]bulkItemResults `json:"items"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/_bulk", query, request.body.Bytes(), &result); err != nil {
+		return err
+	}
+	if !result.Errors {
+		return nil
+	}
+
+	for _, item := range result.Items {
+		for operation, itemResult := range item {
+			if itemResult.Error == nil || (operation == "delete" && itemResult.Status == http.StatusNotFound) {
+				continue
+			}
+			return &responseError{StatusCode: itemResult.Status, Type: itemResult.Error.Type, Reason: itemResult.Error.Reason}
+		}
+	}
+	return nil
+}
+
+type bulkItemResults struct {
+	Status int `json:"status"`
+	Error  *struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error"`
+}
+
+type searchResult struct {
+	Hits struct {
+		Hits []searchHit `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]aggregationResult `json:"aggregations"`
+}
+
+type searchHit struct {
+	Id        string              `json:"_id"`
+	Highlight map[string][]string `json:"highlight"`
+}
+
+type aggregationResult struct {
+	Buckets []struct {
+		Key      any `json:"key"`
+		DocCount int `json:"doc_count"`
+	} `json:"buckets"`
+}
+
+// search runs the search in the targets, which may be missing.
+func (c *client) search(ctx context.Context, targets []string, body any) (*searchResult, error) {
+	query := url.Values{"ignore_unavailable": {"true"}, "allow_no_indices": {"true"}}
+	var result searchResult
+	if err := c.do(ctx, http.MethodPost, "/"+strings.Join(targets, ",")+"/_search", query, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// deleteByQuery deletes the documents of the targets matching the query, up to the maximum if
+// it isn't zero, returning how many were deleted.
+func (c *client) deleteByQuery(ctx context.Context, targets []string, query any, maxDocs int64) (int64, error) {
+	params := url.Values{
+		"ignore_unavailable": {"true"},
+		"allow_no_indices":   {"true"},
+		"conflicts":          {"proceed"},
+		"refresh":            {"true"},
+	}
+	if maxDocs > 0 {
+		params.Set("max_docs", strconv.FormatInt(maxDocs, 10))
+	}
+	var result struct {
+		Deleted int64 `json:"deleted"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/"+strings.Join(targets, ",")+"/_delete_by_query", params, map[string]any{"query": query}, &result); err != nil {
+		return 0, err
+	}
+	return result.Deleted, nil
+}
+
+func (c *client) refresh(ctx context.Context, targets []string) error {
+	query := url.Values{"ignore_unavailable": {"true"}, "allow_no_indices": {"true"}}
+	return c.do(ctx, http.MethodPost, "/"+strings.Join(targets, ",")+"/_refresh", query, nil, nil)
+}
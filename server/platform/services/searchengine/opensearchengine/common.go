@@ -0,0 +1,197 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package opensearchengine
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
+)
+
+type OSChannel struct {
+	Id            string            `json:"Id"`
+	Type          model.ChannelType `json:"Type"`
+	UserIDs       []string          `json:"UserIDs"`
+	TeamId        []string          `json:"TeamId"`
+	TeamMemberIDs []string          `json:"TeamMemberIDs"`
+	NameSuggest   []string          `json:"NameSuggest"`
+}
+
+type OSUser struct {
+	Id                         string   `json:"Id"`
+	SuggestionsWithFullname    []string `json:"SuggestionsWithFullname"`
+	SuggestionsWithoutFullname []string `json:"SuggestionsWithoutFullname"`
+	TeamsIds                   []string `json:"TeamsIds"`
+	ChannelsIds                []string `json:"ChannelsIds"`
+}
+
+type OSPost struct {
+	Id             string   `json:"Id"`
+	TeamId         string   `json:"TeamId"`
+	ChannelId      string   `json:"ChannelId"`
+	UserId         string   `json:"UserId"`
+	CreateAt       int64    `json:"CreateAt"`
+	Message        string   `json:"Message"`
+	Type           string   `json:"Type"`
+	Hashtags       []string `json:"Hashtags"`
+	RootId         string   `json:"RootId"`
+	IsPinned       bool     `json:"IsPinned"`
+	HasFiles       bool     `json:"HasFiles"`
+	HasLink        bool     `json:"HasLink"`
+	HasReaction    bool     `json:"HasReaction"`
+	Reactions      []string `json:"Reactions"`
+	Mentions       []string `json:"Mentions"`
+	Priority       string   `json:"Priority"`
+	FileExtensions []string `json:"FileExtensions"`
+}
+
+type OSFile struct {
+	Id        string `json:"Id"`
+	PostId    string `json:"PostId"`
+	CreatorId string `json:"CreatorId"`
+	ChannelId string `json:"ChannelId"`
+	CreateAt  int64  `json:"CreateAt"`
+	Name      string `json:"Name"`
+	Content   string `json:"Content"`
+	Extension string `json:"Extension"`
+}
+
+func OSChannelFromChannel(channel *model.Channel, userIDs, teamMemberIDs []string) *OSChannel {
+	displayNameInputs := searchengine.GetSuggestionInputsSplitBy(channel.DisplayName, " ")
+	nameInputs := searchengine.GetSuggestionInputsSplitByMultiple(channel.Name, []string{"-", "_"})
+
+	return &OSChannel{
+		Id:            channel.Id,
+		Type:          channel.Type,
+		TeamId:        []string{channel.TeamId},
+		NameSuggest:   append(displayNameInputs, nameInputs...),
+		UserIDs:       userIDs,
+		TeamMemberIDs: teamMemberIDs,
+	}
+}
+
+func OSUserFromUserAndTeams(user *model.User, teamsIds, channelsIds []string) *OSUser {
+	usernameSuggestions := searchengine.GetSuggestionInputsSplitByMultiple(user.Username, []string{".", "-", "_"})
+
+	fullnameStrings := []string{}
+	if user.FirstName != "" {
+		fullnameStrings = append(fullnameStrings, user.FirstName)
+	}
+	if user.LastName != "" {
+		fullnameStrings = append(fullnameStrings, user.LastName)
+	}
+
+	fullnameSuggestions := []string{}
+	if len(fullnameStrings) > 0 {
+		fullname := strings.Join(fullnameStrings, " ")
+		fullnameSuggestions = searchengine.GetSuggestionInputsSplitBy(fullname, " ")
+	}
+
+	nicknameSuggestions := []string{}
+	if user.Nickname != "" {
+		nicknameSuggestions = searchengine.GetSuggestionInputsSplitBy(user.Nickname, " ")
+	}
+
+	usernameAndNicknameSuggestions := append(usernameSuggestions, nicknameSuggestions...)
+
+	return &OSUser{
+		Id:                         user.Id,
+		SuggestionsWithFullname:    append(usernameAndNicknameSuggestions, fullnameSuggestions...),
+		SuggestionsWithoutFullname: usernameAndNicknameSuggestions,
+		TeamsIds:                   teamsIds,
+		ChannelsIds:                channelsIds,
+	}
+}
+
+func OSUserFromUserForIndexing(userForIndexing *model.UserForIndexing) *OSUser {
+	user := &model.User{
+		Id:        userForIndexing.Id,
+		Username:  userForIndexing.Username,
+		Nickname:  userForIndexing.Nickname,
+		FirstName: userForIndexing.FirstName,
+		LastName:  userForIndexing.LastName,
+		CreateAt:  userForIndexing.CreateAt,
+		DeleteAt:  userForIndexing.DeleteAt,
+	}
+
+	return OSUserFromUserAndTeams(user, userForIndexing.TeamsIds, userForIndexing.ChannelsIds)
+}
+
+func OSPostFromPost(post *model.Post, teamId string) *OSPost {
+	p := &model.PostForIndexing{
+		TeamId: teamId,
+	}
+	post.ShallowCopy(&p.Post)
+	return OSPostFromPostForIndexing(p)
+}
+
+func OSPostFromPostForIndexing(post *model.PostForIndexing) *OSPost {
+	osPost := &OSPost{
+		Id:          post.Id,
+		TeamId:      post.TeamId,
+		ChannelId:   post.ChannelId,
+		UserId:      post.UserId,
+		CreateAt:    post.CreateAt,
+		Message:     post.Message,
+		Type:        post.Type,
+		Hashtags:    strings.Fields(post.Hashtags),
+		RootId:      post.RootId,
+		IsPinned:    post.IsPinned,
+		HasFiles:    len(post.FileIds) > 0,
+		HasLink:     model.MessageHasLink(post.Message),
+		HasReaction: post.HasReactions,
+		Mentions:    model.MessageMentions(post.Message),
+	}
+
+	if post.Metadata != nil {
+		for _, reaction := range post.Metadata.Reactions {
+			osPost.Reactions = append(osPost.Reactions, reaction.EmojiName)
+		}
+		if priority := post.GetPriority(); priority != nil && priority.Priority != nil {
+			osPost.Priority = *priority.Priority
+		}
+		for _, file := range post.Metadata.Files {
+			if file.Extension != "" && !slices.Contains(osPost.FileExtensions, file.Extension) {
+				osPost.FileExtensions = append(osPost.FileExtensions, file.Extension)
+			}
+		}
+	}
+
+	return osPost
+}
+
+func splitFilenameWords(name string) string {
+	result := name
+	result = strings.ReplaceAll(result, "-", " ")
+	result = strings.ReplaceAll(result, ".", " ")
+	return result
+}
+
+func OSFileFromFileInfo(fileInfo *model.FileInfo, channelId string) *OSFile {
+	return &OSFile{
+		Id:        fileInfo.Id,
+		PostId:    fileInfo.PostId,
+		ChannelId: channelId,
+		CreatorId: fileInfo.CreatorId,
+		CreateAt:  fileInfo.CreateAt,
+		Content:   fileInfo.Content,
+		Extension: fileInfo.Extension,
+		Name:      fileInfo.Name + " " + splitFilenameWords(fileInfo.Name),
+	}
+}
+
+func OSFileFromFileForIndexing(file *model.FileForIndexing) *OSFile {
+	return &OSFile{
+		Id:        file.Id,
+		PostId:    file.PostId,
+		ChannelId: file.ChannelId,
+		CreatorId: file.CreatorId,
+		CreateAt:  file.CreateAt,
+		Content:   file.Content,
+		Extension: file.Extension,
+		Name:      file.Name + " " + splitFilenameWords(file.Name),
+	}
+}
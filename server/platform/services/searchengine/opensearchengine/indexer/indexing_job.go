@@ -0,0 +1,680 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package indexer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine"
+)
+
+const (
+	timeBetweenBatches = 100 * time.Millisecond
+
+	estimatedPostCount    = 10000000
+	estimatedFilesCount   = 100000
+	estimatedChannelCount = 100000
+	estimatedUserCount    = 10000
+)
+
+// OpenSearchIndexerWorker indexes everything into a new generation of the OpenSearch indexes,
+// swapping the aliases to it once done.
+type OpenSearchIndexerWorker struct {
+	name string
+	// stateMut protects stopCh and helps enforce
+	// ordering in case subsequent Run or Stop calls are made.
+	stateMut  sync.Mutex
+	stopCh    chan struct{}
+	stoppedCh chan bool
+	jobs      chan model.Job
+	jobServer *jobs.JobServer
+	logger    mlog.LoggerIFace
+	engine    *opensearchengine.OpenSearchEngine
+	stopped   bool
+
+	// generationsWait is how long to wait after starting a reindex before reading from the
+	// database, so every server writes to the new indexes by then.
+	generationsWait time.Duration
+}
+
+func MakeWorker(jobServer *jobs.JobServer, engine *opensearchengine.OpenSearchEngine) *OpenSearchIndexerWorker {
+	if engine == nil {
+		return nil
+	}
+	const workerName = "OpenSearchIndexer"
+	return &OpenSearchIndexerWorker{
+		name:            workerName,
+		stoppedCh:       make(chan bool, 1),
+		jobs:            make(chan model.Job),
+		jobServer:       jobServer,
+		logger:          jobServer.Logger().With(mlog.String("worker_name", workerName)),
+		engine:          engine,
+		stopped:         true,
+		generationsWait: opensearchengine.GenerationsRefreshInterval,
+	}
+}
+
+type IndexingProgress struct {
+	Now            time.Time
+	StartAtTime    int64
+	EndAtTime      int64
+	LastEntityTime int64
+
+	TotalPostsCount int64
+	DonePostsCount  int64
+	DonePosts       bool
+	LastPostID      string
+
+	TotalFilesCount int64
+	DoneFilesCount  int64
+	DoneFiles       bool
+	LastFileID      string
+
+	TotalChannelsCount int64
+	DoneChannelsCount  int64
+	DoneChannels       bool
+	LastChannelID      string
+
+	TotalUsersCount int64
+	DoneUsersCount  int64
+	DoneUsers       bool
+	LastUserID      string
+}
+
+func (ip *IndexingProgress) CurrentProgress() int64 {
+	total := ip.TotalPostsCount + ip.TotalChannelsCount + ip.TotalUsersCount + ip.TotalFilesCount
+	if total == 0 {
+		return 0
+	}
+	return (ip.DonePostsCount + ip.DoneChannelsCount + ip.DoneUsersCount + ip.DoneFilesCount) * 100 / total
+}
+
+func (ip *IndexingProgress) IsDone() bool {
+	return ip.DonePosts && ip.DoneChannels && ip.DoneUsers && ip.DoneFiles
+}
+
+func (worker *OpenSearchIndexerWorker) JobChannel() chan<- model.Job {
+	return worker.jobs
+}
+
+func (worker *OpenSearchIndexerWorker) IsEnabled(cfg *model.Config) bool {
+	return *cfg.ElasticsearchSettings.EnableIndexing
+}
+
+func (worker *OpenSearchIndexerWorker) Run() {
+	worker.stateMut.Lock()
+	// We have to re-assign the stop channel again, because
+	// it might happen that the job was restarted due to a config change.
+	if worker.stopped {
+		worker.stopped = false
+		worker.stopCh = make(chan struct{})
+	} else {
+		worker.stateMut.Unlock()
+		return
+	}
+	// Run is called from a separate goroutine and doesn't return.
+	// So we cannot Unlock in a defer clause.
+	worker.stateMut.Unlock()
+
+	worker.logger.Debug("Worker Started")
+
+	defer func() {
+		worker.logger.Debug("Worker: Finished")
+		worker.stoppedCh <- true
+	}()
+
+	for {
+		select {
+		case <-worker.stopCh:
+			worker.logger.Debug("Worker: Received stop signal")
+			return
+		case job := <-worker.jobs:
+			worker.DoJob(&job)
+		}
+	}
+}
+
+func (worker *OpenSearchIndexerWorker) Stop() {
+	worker.stateMut.Lock()
+	defer worker.stateMut.Unlock()
+
+	// Set to close, and if already closed before, then return.
+	if worker.stopped {
+		return
+	}
+	worker.stopped = true
+	worker.logger.Debug("Worker Stopping")
+	close(worker.stopCh)
+	<-worker.stoppedCh
+}
+
+func (worker *OpenSearchIndexerWorker) setJobError(logger mlog.LoggerIFace, job *model.Job, appError *model.AppError) {
+	if err := worker.jobServer.SetJobError(job, appError); err != nil {
+		logger.Error("Worker: Failed to set job error", mlog.Err(err), mlog.NamedErr("set_error", appError))
+	}
+}
+
+func (worker *OpenSearchIndexerWorker) DoJob(job *model.Job) {
+	logger := worker.logger.With(jobs.JobLoggerFields(job)...)
+	logger.Debug("Worker: Received a new candidate job.")
+
+	claimed, err := worker.jobServer.ClaimJob(job)
+	if err != nil {
+		logger.Warn("Worker: Error occurred while trying to claim job", mlog.Err(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	logger.Info("Worker: Indexing job claimed by worker")
+
+	if !worker.engine.IsActive() {
+		worker.setJobError(logger, job, model.NewAppError("OpenSearchIndexerWorker", "opensearchengine.indexer.do_job.engine_inactive", nil, "", http.StatusInternalServerError))
+		return
+	}
+
+	if job.Data == nil {
+		job.Data = make(model.StringMap)
+	}
+
+	// A job resumed after a restart carries on filling the generation it started, unless
+	// another job started a new one meanwhile.
+	generation := job.Data["generation"]
+	if !worker.engine.IsReindexing(generation) {
+		var appErr *model.AppError
+		generation, appErr = worker.engine.StartReindex(request.EmptyContext(logger))
+		if appErr != nil {
+			worker.setJobError(logger, job, appErr)
+			return
+		}
+		for _, key := range []string{"start_time", "start_post_id", "start_channel_id", "start_user_id", "start_file_id", "original_start_time", "end_time"} {
+			delete(job.Data, key)
+		}
+		job.Data["generation"] = generation
+
+		// The documents written before every server picks up the new generation are only
+		// written to the current indexes, so they are read from the database after that.
+		select {
+		case <-time.After(worker.generationsWait):
+		case <-worker.stopCh:
+			logger.Info("Worker: Indexing has been canceled via Worker Stop")
+			if err := worker.jobServer.SetJobCanceled(job); err != nil {
+				logger.Error("Worker: Failed to mark job as canceled", mlog.Err(err))
+			}
+			return
+		}
+	}
+
+	progress := IndexingProgress{
+		Now:          time.Now(),
+		DonePosts:    false,
+		DoneChannels: false,
+		DoneUsers:    false,
+		DoneFiles:    false,
+		StartAtTime:  0,
+		EndAtTime:    model.GetMillis(),
+	}
+
+	// Extract the start and end times, if they are set.
+	if startString, ok := job.Data["start_time"]; ok {
+		startInt, err := strconv.ParseInt(startString, 10, 64)
+		if err != nil {
+			logger.Error("Worker: Failed to parse start_time for job", mlog.String("start_time", startString), mlog.Err(err))
+			worker.setJobError(logger, job, model.NewAppError("OpenSearchIndexerWorker", "opensearchengine.indexer.do_job.parse_start_time.error", nil, "", http.StatusInternalServerError).Wrap(err))
+			return
+		}
+		progress.StartAtTime = startInt
+	} else {
+		// Set start time to oldest entity in the database.
+		// A user or a channel may be created before any post.
+		oldestEntityCreationTime, err := worker.jobServer.Store.Post().GetOldestEntityCreationTime()
+		if err != nil {
+			logger.Error("Worker: Failed to fetch oldest entity for job.", mlog.Err(err))
+			worker.setJobError(logger, job, model.NewAppError("OpenSearchIndexerWorker", "opensearchengine.indexer.do_job.get_oldest_entity.error", nil, "", http.StatusInternalServerError).Wrap(err))
+			return
+		}
+		progress.StartAtTime = oldestEntityCreationTime
+	}
+	progress.LastEntityTime = progress.StartAtTime
+	if startString, ok := job.Data["original_start_time"]; ok {
+		if startInt, err := strconv.ParseInt(startString, 10, 64); err == nil {
+			progress.StartAtTime = startInt
+		}
+	}
+
+	if endString, ok := job.Data["end_time"]; ok {
+		endInt, err := strconv.ParseInt(endString, 10, 64)
+		if err != nil {
+			logger.Error("Worker: Failed to parse end_time for job", mlog.String("end_time", endString), mlog.Err(err))
+			worker.setJobError(logger, job, model.NewAppError("OpenSearchIndexerWorker", "opensearchengine.indexer.do_job.parse_end_time.error", nil, "", http.StatusInternalServerError).Wrap(err))
+			return
+		}
+		progress.EndAtTime = endInt
+	}
+
+	if id, ok := job.Data["start_post_id"]; ok {
+		progress.LastPostID = id
+	}
+	if id, ok := job.Data["start_channel_id"]; ok {
+		progress.LastChannelID = id
+	}
+	if id, ok := job.Data["start_user_id"]; ok {
+		progress.LastUserID = id
+	}
+	if id, ok := job.Data["start_file_id"]; ok {
+		progress.LastFileID = id
+	}
+
+	// Counting all posts may fail or timeout when the posts table is large. If this happens, log a warning, but carry
+	// on with the indexing job anyway. The only issue is that the progress % reporting will be inaccurate.
+	if count, err := worker.jobServer.Store.Post().AnalyticsPostCount(&model.PostCountOptions{}); err != nil {
+		logger.Warn("Worker: Failed to fetch total post count for job. An estimated value will be used for progress reporting.", mlog.Err(err))
+		progress.TotalPostsCount = estimatedPostCount
+	} else {
+		progress.TotalPostsCount = count
+	}
+
+	// Same possible fail as above can happen when counting channels
+	if count, err := worker.jobServer.Store.Channel().AnalyticsTypeCount("", ""); err != nil {
+		logger.Warn("Worker: Failed to fetch total channel count for job. An estimated value will be used for progress reporting.", mlog.Err(err))
+		progress.TotalChannelsCount = estimatedChannelCount
+	} else {
+		progress.TotalChannelsCount = count
+	}
+
+	// Same possible fail as above can happen when counting users
+	if count, err := worker.jobServer.Store.User().Count(model.UserCountOptions{
+		IncludeBotAccounts: true, // This actually doesn't join with the bots table
+		// since ExcludeRegularUsers is set to false
+	}); err != nil {
+		logger.Warn("Worker: Failed to fetch total user count for job. An estimated value will be used for progress reporting.", mlog.Err(err))
+		progress.TotalUsersCount = estimatedUserCount
+	} else {
+		progress.TotalUsersCount = count
+	}
+
+	// Counting all files may fail or timeout when the file_info table is large. If this happens, log a warning, but carry
+	// on with the indexing job anyway. The only issue is that the progress % reporting will be inaccurate.
+	if count, err := worker.jobServer.Store.FileInfo().CountAll(); err != nil {
+		logger.Warn("Worker: Failed to fetch total file info count for job. An estimated value will be used for progress reporting.", mlog.Err(err))
+		progress.TotalFilesCount = estimatedFilesCount
+	} else {
+		progress.TotalFilesCount = count
+	}
+
+	var cancelContext request.CTX = request.EmptyContext(worker.logger)
+	cancelCtx, cancelCancelWatcher := context.WithCancel(context.Background())
+	cancelWatcherChan := make(chan struct{}, 1)
+	cancelContext = cancelContext.WithContext(cancelCtx)
+	go worker.jobServer.CancellationWatcher(cancelContext, job.Id, cancelWatcherChan)
+	defer cancelCancelWatcher()
+
+	for {
+		select {
+		case <-cancelWatcherChan:
+			logger.Info("Worker: Indexing job has been canceled via CancellationWatcher")
+			if err := worker.jobServer.SetJobCanceled(job); err != nil {
+				logger.Error("Worker: Failed to mark job as cancelled", mlog.Err(err))
+			}
+			return
+
+		case <-worker.stopCh:
+			logger.Info("Worker: Indexing has been canceled via Worker Stop")
+			if err := worker.jobServer.SetJobCanceled(job); err != nil {
+				logger.Error("Worker: Failed to mark job as canceled", mlog.Err(err))
+			}
+			return
+
+		case <-time.After(timeBetweenBatches):
+			var err *model.AppError
+			if progress, err = worker.IndexBatch(logger, generation, progress); err != nil {
+				logger.Error("Worker: Failed to index batch for job", mlog.Err(err))
+				worker.setJobError(logger, job, err)
+				return
+			}
+
+			// Storing the batch progress in metadata.
+			job.Data["start_time"] = strconv.FormatInt(progress.LastEntityTime, 10)
+			job.Data["start_post_id"] = progress.LastPostID
+			job.Data["start_channel_id"] = progress.LastChannelID
+			job.Data["start_user_id"] = progress.LastUserID
+			job.Data["start_file_id"] = progress.LastFileID
+			job.Data["original_start_time"] = strconv.FormatInt(progress.StartAtTime, 10)
+			job.Data["end_time"] = strconv.FormatInt(progress.EndAtTime, 10)
+
+			if err := worker.jobServer.SetJobProgress(job, progress.CurrentProgress()); err != nil {
+				logger.Error("Worker: Failed to set progress for job", mlog.Err(err))
+				if err2 := worker.jobServer.SetJobError(job, err); err2 != nil {
+					logger.Error("Worker: Failed to set error for job", mlog.Err(err2), mlog.NamedErr("set_error", err))
+				}
+				return
+			}
+
+			if progress.IsDone() {
+				if appErr := worker.engine.CompleteReindex(request.EmptyContext(logger), generation); appErr != nil {
+					worker.setJobError(logger, job, appErr)
+					return
+				}
+				if err := worker.jobServer.SetJobSuccess(job); err != nil {
+					logger.Error("Worker: Failed to set success for job", mlog.Err(err))
+					if err2 := worker.jobServer.SetJobError(job, err); err2 != nil {
+						logger.Error("Worker: Failed to set error for job", mlog.Err(err2), mlog.NamedErr("set_error", err))
+					}
+				}
+				logger.Info("Worker: Indexing job finished successfully")
+				return
+			}
+		}
+	}
+}
+
+func (worker *OpenSearchIndexerWorker) batchSize() int {
+	return *worker.jobServer.Config().ElasticsearchSettings.BatchSize
+}
+
+func (worker *OpenSearchIndexerWorker) IndexBatch(logger mlog.LoggerIFace, generation string, progress IndexingProgress) (IndexingProgress, *model.AppError) {
+	if !progress.DonePosts {
+		return worker.IndexPostsBatch(logger, generation, progress)
+	}
+	if !progress.DoneChannels {
+		return worker.IndexChannelsBatch(logger, generation, progress)
+	}
+	if !progress.DoneUsers {
+		return worker.IndexUsersBatch(logger, generation, progress)
+	}
+	if !progress.DoneFiles {
+		return worker.IndexFilesBatch(logger, generation, progress)
+	}
+	return progress, model.NewAppError("OpenSearchIndexerWorker", "opensearchengine.indexer.index_batch.nothing_left_to_index.error", nil, "", http.StatusInternalServerError)
+}
+
+func (worker *OpenSearchIndexerWorker) IndexPostsBatch(logger mlog.LoggerIFace, generation string, progress IndexingProgress) (IndexingProgress, *model.AppError) {
+	var posts []*model.PostForIndexing
+
+	tries := 0
+	for posts == nil {
+		var err error
+		posts, err = worker.jobServer.Store.Post().GetPostsBatchForIndexing(progress.LastEntityTime, progress.LastPostID, worker.batchSize())
+		if err != nil {
+			if tries >= 10 {
+				return progress, model.NewAppError("IndexPostsBatch", "app.post.get_posts_batch_for_indexing.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			logger.Warn("Failed to get posts batch for indexing. Retrying.", mlog.Err(err))
+
+			// Wait a bit before trying again.
+			time.Sleep(15 * time.Second)
+		}
+
+		tries++
+	}
+
+	// Handle zero messages.
+	if len(posts) == 0 {
+		progress.DonePosts = true
+		progress.LastEntityTime = progress.StartAtTime
+		return progress, nil
+	}
+
+	if err := worker.loadPostsSearchMetadata(posts); err != nil {
+		return progress, err
+	}
+
+	if err := worker.engine.BulkIndexPosts(generation, posts); err != nil {
+		return progress, err
+	}
+	lastPost := posts[len(posts)-1]
+
+	// Our exit condition is when the last post's createAt reaches the initial endAtTime
+	// set during job creation.
+	if progress.EndAtTime <= lastPost.CreateAt {
+		progress.DonePosts = true
+		progress.LastEntityTime = progress.StartAtTime
+	} else {
+		progress.LastEntityTime = lastPost.CreateAt
+	}
+
+	progress.LastPostID = lastPost.Id
+	progress.DonePostsCount += int64(len(posts))
+
+	return progress, nil
+}
+
+// loadPostsSearchMetadata sets the reactions, the priority and the files of the posts,
+// which are indexed along with them.
+func (worker *OpenSearchIndexerWorker) loadPostsSearchMetadata(posts []*model.PostForIndexing) *model.AppError {
+	postsByID := make(map[string]*model.PostForIndexing, len(posts))
+	postIDs := make([]string, 0, len(posts))
+	reactedPostIDs := []string{}
+	postsByFileID := map[string]*model.PostForIndexing{}
+	for _, post := range posts {
+		postsByID[post.Id] = post
+		postIDs = append(postIDs, post.Id)
+		if post.HasReactions {
+			reactedPostIDs = append(reactedPostIDs, post.Id)
+		}
+		for _, fileID := range post.FileIds {
+			postsByFileID[fileID] = post
+		}
+		if post.Metadata == nil {
+			post.Metadata = &model.PostMetadata{}
+		}
+	}
+
+	if len(postsByFileID) > 0 {
+		fileIDs := make([]string, 0, len(postsByFileID))
+		for fileID := range postsByFileID {
+			fileIDs = append(fileIDs, fileID)
+		}
+		files, err := worker.jobServer.Store.FileInfo().GetByIds(fileIDs)
+		if err != nil {
+			return model.NewAppError("IndexPostsBatch", "opensearchengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, file := range files {
+			if post, ok := postsByFileID[file.Id]; ok {
+				post.Metadata.Files = append(post.Metadata.Files, file)
+			}
+		}
+	}
+
+	if len(reactedPostIDs) > 0 {
+		reactions, err := worker.jobServer.Store.Reaction().BulkGetForPosts(reactedPostIDs)
+		if err != nil {
+			return model.NewAppError("IndexPostsBatch", "opensearchengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, reaction := range reactions {
+			if post, ok := postsByID[reaction.PostId]; ok {
+				post.Metadata.Reactions = append(post.Metadata.Reactions, reaction)
+			}
+		}
+	}
+
+	priorities, err := worker.jobServer.Store.PostPriority().GetForPosts(postIDs)
+	if err != nil {
+		return model.NewAppError("IndexPostsBatch", "opensearchengine.indexer.do_job.get_posts_metadata.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	for _, priority := range priorities {
+		if post, ok := postsByID[priority.PostId]; ok {
+			post.Metadata.Priority = priority
+		}
+	}
+
+	return nil
+}
+
+func (worker *OpenSearchIndexerWorker) IndexFilesBatch(logger mlog.LoggerIFace, generation string, progress IndexingProgress) (IndexingProgress, *model.AppError) {
+	var files []*model.FileForIndexing
+
+	tries := 0
+	for files == nil {
+		var err error
+		files, err = worker.jobServer.Store.FileInfo().GetFilesBatchForIndexing(progress.LastEntityTime, progress.LastFileID, true, worker.batchSize())
+		if err != nil {
+			if tries >= 10 {
+				return progress, model.NewAppError("IndexFilesBatch", "app.post.get_files_batch_for_indexing.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			logger.Warn("Failed to get files batch for indexing. Retrying.", mlog.Err(err))
+
+			// Wait a bit before trying again.
+			time.Sleep(15 * time.Second)
+		}
+
+		tries++
+	}
+
+	if len(files) == 0 {
+		progress.DoneFiles = true
+		progress.LastEntityTime = progress.StartAtTime
+		return progress, nil
+	}
+
+	if err := worker.engine.BulkIndexFiles(generation, files); err != nil {
+		return progress, err
+	}
+	lastFile := files[len(files)-1]
+
+	// Our exit condition is when the last file's createAt reaches the initial endAtTime
+	// set during job creation.
+	if progress.EndAtTime <= lastFile.CreateAt {
+		progress.DoneFiles = true
+		progress.LastEntityTime = progress.StartAtTime
+	} else {
+		progress.LastEntityTime = lastFile.CreateAt
+	}
+
+	progress.LastFileID = lastFile.Id
+	progress.DoneFilesCount += int64(len(files))
+
+	return progress, nil
+}
+
+func (worker *OpenSearchIndexerWorker) IndexChannelsBatch(logger mlog.LoggerIFace, generation string, progress IndexingProgress) (IndexingProgress, *model.AppError) {
+	var channels []*model.Channel
+
+	tries := 0
+	for channels == nil {
+		var nErr error
+		channels, nErr = worker.jobServer.Store.Channel().GetChannelsBatchForIndexing(progress.LastEntityTime, progress.LastChannelID, worker.batchSize())
+		if nErr != nil {
+			if tries >= 10 {
+				return progress, model.NewAppError("OpenSearchIndexerWorker.IndexChannelsBatch", "app.channel.get_channels_batch_for_indexing.get.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
+			}
+
+			logger.Warn("Failed to get channels batch for indexing. Retrying.", mlog.Err(nErr))
+
+			// Wait a bit before trying again.
+			time.Sleep(15 * time.Second)
+		}
+		tries++
+	}
+
+	if len(channels) == 0 {
+		progress.DoneChannels = true
+		progress.LastEntityTime = progress.StartAtTime
+		return progress, nil
+	}
+
+	if err := worker.BulkIndexChannels(generation, channels); err != nil {
+		return progress, err
+	}
+	lastChannel := channels[len(channels)-1]
+
+	// Our exit condition is when the last channel's createAt reaches the initial endAtTime
+	// set during job creation.
+	if progress.EndAtTime <= lastChannel.CreateAt {
+		progress.DoneChannels = true
+		progress.LastEntityTime = progress.StartAtTime
+	} else {
+		progress.LastEntityTime = lastChannel.CreateAt
+	}
+
+	progress.LastChannelID = lastChannel.Id
+	progress.DoneChannelsCount += int64(len(channels))
+
+	return progress, nil
+}
+
+func (worker *OpenSearchIndexerWorker) BulkIndexChannels(generation string, channels []*model.Channel) *model.AppError {
+	searchChannels := []*opensearchengine.OSChannel{}
+	deletedIds := []string{}
+
+	for _, channel := range channels {
+		if channel.DeleteAt != 0 {
+			deletedIds = append(deletedIds, channel.Id)
+			continue
+		}
+
+		var userIDs []string
+		var err error
+		if channel.Type == model.ChannelTypePrivate {
+			userIDs, err = worker.jobServer.Store.Channel().GetAllChannelMemberIdsByChannelId(channel.Id)
+			if err != nil {
+				return model.NewAppError("OpenSearchIndexerWorker.BulkIndexChannels", "opensearchengine.indexer.do_job.bulk_index_channels.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+
+		// Get teamMember ids from channelid
+		teamMemberIDs, err := worker.jobServer.Store.Channel().GetTeamMembersForChannel(channel.Id)
+		if err != nil {
+			return model.NewAppError("OpenSearchIndexerWorker.BulkIndexChannels", "opensearchengine.indexer.do_job.bulk_index_channels.batch_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		searchChannels = append(searchChannels, opensearchengine.OSChannelFromChannel(channel, userIDs, teamMemberIDs))
+	}
+
+	return worker.engine.BulkIndexChannels(generation, searchChannels, deletedIds)
+}
+
+func (worker *OpenSearchIndexerWorker) IndexUsersBatch(logger mlog.LoggerIFace, generation string, progress IndexingProgress) (IndexingProgress, *model.AppError) {
+	var users []*model.UserForIndexing
+
+	tries := 0
+	for users == nil {
+		if usersBatch, err := worker.jobServer.Store.User().GetUsersBatchForIndexing(progress.LastEntityTime, progress.LastUserID, worker.batchSize()); err != nil {
+			if tries >= 10 {
+				return progress, model.NewAppError("IndexUsersBatch", "app.user.get_users_batch_for_indexing.get_users.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			logger.Warn("Failed to get users batch for indexing. Retrying.", mlog.Err(err))
+
+			// Wait a bit before trying again.
+			time.Sleep(15 * time.Second)
+		} else {
+			users = usersBatch
+		}
+
+		tries++
+	}
+
+	if len(users) == 0 {
+		progress.DoneUsers = true
+		progress.LastEntityTime = progress.StartAtTime
+		return progress, nil
+	}
+
+	if err := worker.engine.BulkIndexUsers(generation, users); err != nil {
+		return progress, err
+	}
+	lastUser := users[len(users)-1]
+
+	// Our exit condition is when the last user's createAt reaches the initial endAtTime
+	// set during job creation.
+	if progress.EndAtTime <= lastUser.CreateAt {
+		progress.DoneUsers = true
+		progress.LastEntityTime = progress.StartAtTime
+	} else {
+		progress.LastEntityTime = lastUser.CreateAt
+	}
+	progress.LastUserID = lastUser.Id
+	progress.DoneUsersCount += int64(len(users))
+
+	return progress, nil
+}
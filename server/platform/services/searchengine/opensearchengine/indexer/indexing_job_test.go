@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
+	"github.com/mattermost/mattermost/server/v8/channels/utils/testutils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine/opensearchtest"
+)
+
+func TestOpenSearchIndexer(t *testing.T) {
+	server := opensearchtest.NewServer()
+	defer server.Close()
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.ElasticsearchSettings.ConnectionURL = model.NewPointer(server.URL)
+	cfg.ElasticsearchSettings.EnableIndexing = model.NewPointer(true)
+	cfg.ElasticsearchSettings.EnableSearching = model.NewPointer(true)
+
+	engine := opensearchengine.NewOpenSearchEngine(cfg)
+	require.Nil(t, engine.Start())
+	defer engine.Stop()
+
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId()}
+	post := &model.PostForIndexing{TeamId: channel.TeamId}
+	post.Id = model.NewId()
+	post.ChannelId = channel.Id
+	post.Message = "indexed by the job"
+	post.CreateAt = model.GetMillis() - 1000
+
+	mockStore := &storetest.Store{}
+	defer mockStore.AssertExpectations(t)
+
+	job := &model.Job{
+		Id:       model.NewId(),
+		CreateAt: model.GetMillis(),
+		Status:   model.JobStatusPending,
+		Type:     model.JobTypeElasticsearchPostIndexing,
+	}
+
+	mockStore.JobStore.On("UpdateStatusOptimistically", job.Id, model.JobStatusPending, model.JobStatusInProgress).Return(true, nil)
+	mockStore.JobStore.On("UpdateOptimistically", job, model.JobStatusInProgress).Return(true, nil)
+	mockStore.JobStore.On("UpdateStatus", job.Id, model.JobStatusSuccess).Return(job, nil)
+	mockStore.JobStore.On("Get", mock.Anything, job.Id).Return(job, nil).Maybe()
+	mockStore.PostStore.On("GetOldestEntityCreationTime").Return(post.CreateAt, nil)
+	mockStore.PostStore.On("AnalyticsPostCount", mock.Anything).Return(int64(1), nil)
+	mockStore.ChannelStore.On("AnalyticsTypeCount", "", model.ChannelType("")).Return(int64(0), nil)
+	mockStore.UserStore.On("Count", mock.Anything).Return(int64(0), nil)
+	mockStore.FileInfoStore.On("CountAll").Return(int64(0), nil)
+	mockStore.PostStore.On("GetPostsBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.PostForIndexing{post}, nil).Once()
+	mockStore.PostStore.On("GetPostsBatchForIndexing", post.CreateAt, post.Id, mock.Anything).Return([]*model.PostForIndexing{}, nil).Once()
+	mockStore.PostPriorityStore.On("GetForPosts", []string{post.Id}).Return([]*model.PostPriority{}, nil)
+	mockStore.ChannelStore.On("GetChannelsBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.Channel{}, nil)
+	mockStore.UserStore.On("GetUsersBatchForIndexing", post.CreateAt, "", mock.Anything).Return([]*model.UserForIndexing{}, nil)
+	mockStore.FileInfoStore.On("GetFilesBatchForIndexing", post.CreateAt, "", true, mock.Anything).Return([]*model.FileForIndexing{}, nil)
+
+	jobServer := &jobs.JobServer{
+		Store: mockStore,
+		ConfigService: &testutils.StaticConfigService{
+			Cfg: cfg,
+		},
+	}
+
+	worker := &OpenSearchIndexerWorker{
+		jobServer: jobServer,
+		engine:    engine,
+		logger:    mlog.CreateConsoleTestLogger(t),
+	}
+
+	previousIndexes := server.AliasIndexes("channels")
+	worker.DoJob(job)
+
+	generation := job.Data["generation"]
+	require.NotEmpty(t, generation)
+	assert.False(t, engine.IsReindexing(generation))
+	assert.NotEqual(t, previousIndexes, server.AliasIndexes("channels"))
+	assert.Equal(t, []string{"channels_" + generation}, server.AliasIndexes("channels"))
+	assert.Empty(t, server.AliasIndexes("channels_reindex"))
+
+	postIds, _, appErr := engine.SearchPosts(model.ChannelList{channel}, model.ParseSearchParams("indexed", 0), 0, 20)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{post.Id}, postIds)
+}
@@ -0,0 +1,706 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package opensearchengine
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+const (
+	EngineName   = "opensearch"
+	PostIndex    = "posts"
+	FileIndex    = "files"
+	UserIndex    = "users"
+	ChannelIndex = "channels"
+
+	// GenerationsRefreshInterval is how often the engine reads the generation of the indexes
+	// from the cluster, picking up the reindexes started and completed by other servers.
+	GenerationsRefreshInterval = 30 * time.Second
+
+	reindexAliasSuffix = "_reindex"
+
+	// The posts are stored in an index per month, so the posts older than the data retention
+	// period are deleted by deleting whole indexes.
+	postIndexWindowLayout = "2006_01"
+)
+
+var indexKinds = []string{PostIndex, FileIndex, UserIndex, ChannelIndex}
+
+// OpenSearchEngine is a search engine storing its indexes in an OpenSearch or Elasticsearch
+// cluster through their REST API.
+//
+// Every index is searched through an alias pointing to the indexes of the current generation.
+// Indexing everything again creates the indexes of a new generation, which are written to along
+// with the current ones, and swaps the aliases to them once filled, so the searches are served
+// all along.
+type OpenSearchEngine struct {
+	Mutex     sync.RWMutex
+	ready     int32
+	cfg       *model.Config
+	client    *client
+	version   string
+	indexSync bool
+
+	// generation is the generation of the searched indexes, and reindexGeneration the one
+	// being filled by an indexing job, if any.
+	generation        string
+	reindexGeneration string
+
+	// postIndexes caches the post indexes known to exist.
+	postIndexes    map[string]bool
+	postIndexesMut sync.Mutex
+
+	stopRefresh chan struct{}
+	refreshDone chan struct{}
+}
+
+func NewOpenSearchEngine(cfg *model.Config) *OpenSearchEngine {
+	return &OpenSearchEngine{
+		cfg:         cfg,
+		postIndexes: map[string]bool{},
+	}
+}
+
+func (e *OpenSearchEngine) prefix() string {
+	return *e.cfg.ElasticsearchSettings.IndexPrefix
+}
+
+func (e *OpenSearchEngine) aliasName(kind string) string {
+	return e.prefix() + kind
+}
+
+func (e *OpenSearchEngine) reindexAliasName(kind string) string {
+	return e.prefix() + kind + reindexAliasSuffix
+}
+
+// indexName returns the name of the index of the generation. The post indexes are named
+// after it followed by their time window.
+func (e *OpenSearchEngine) indexName(kind, generation string) string {
+	return e.prefix() + kind + "_" + generation
+}
+
+func (e *OpenSearchEngine) postIndexName(generation string, createAt int64) string {
+	return e.indexName(PostIndex, generation) + "_" + time.UnixMilli(createAt).UTC().Format(postIndexWindowLayout)
+}
+
+// postIndexWindow returns the start of the time window of the posts of the index.
+func (e *OpenSearchEngine) postIndexWindow(index string) (time.Time, bool) {
+	if !strings.HasPrefix(index, e.prefix()+PostIndex+"_") || len(index) < len(postIndexWindowLayout) {
+		return time.Time{}, false
+	}
+	window, err := time.Parse(postIndexWindowLayout, index[len(index)-len(postIndexWindowLayout):])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return window, true
+}
+
+// writeGenerations returns the generations whose indexes the documents are written to. The
+// caller must hold the engine mutex.
+func (e *OpenSearchEngine) writeGenerations() []string {
+	if e.reindexGeneration != "" {
+		return []string{e.generation, e.reindexGeneration}
+	}
+	return []string{e.generation}
+}
+
+func newGeneration(previous ...string) string {
+	generation := model.GetMillis()
+	for slices.Contains(previous, strconv.FormatInt(generation, 10)) {
+		generation++
+	}
+	return strconv.FormatInt(generation, 10)
+}
+
+func (e *OpenSearchEngine) indexTemplates() map[string]any {
+	settings := e.cfg.ElasticsearchSettings
+
+	keyword := map[string]any{"type": "keyword"}
+	text := map[string]any{"type": "text"}
+	long := map[string]any{"type": "long"}
+	boolean := map[string]any{"type": "boolean"}
+
+	template := func(kind string, shards, replicas int, properties map[string]any) map[string]any {
+		return map[string]any{
+			"index_patterns": []string{e.prefix() + kind + "_*"},
+			"template": map[string]any{
+				"settings": map[string]any{
+					"number_of_shards":   shards,
+					"number_of_replicas": replicas,
+				},
+				"mappings": map[string]any{
+					"dynamic":    false,
+					"properties": properties,
+				},
+			},
+		}
+	}
+
+	return map[string]any{
+		PostIndex: template(PostIndex, *settings.PostIndexShards, *settings.PostIndexReplicas, map[string]any{
+			"Id":             keyword,
+			"TeamId":         keyword,
+			"ChannelId":      keyword,
+			"UserId":         keyword,
+			"CreateAt":       long,
+			"Message":        text,
+			"Type":           keyword,
+			"Hashtags":       text,
+			"RootId":         keyword,
+			"IsPinned":       boolean,
+			"HasFiles":       boolean,
+			"HasLink":        boolean,
+			"HasReaction":    boolean,
+			"Reactions":      keyword,
+			"Mentions":       keyword,
+			"Priority":       keyword,
+			"FileExtensions": keyword,
+		}),
+		FileIndex: template(FileIndex, *settings.PostIndexShards, *settings.PostIndexReplicas, map[string]any{
+			"Id":        keyword,
+			"PostId":    keyword,
+			"CreatorId": keyword,
+			"ChannelId": keyword,
+			"CreateAt":  long,
+			"Name":      text,
+			"Content":   text,
+			"Extension": keyword,
+		}),
+		UserIndex: template(UserIndex, *settings.UserIndexShards, *settings.UserIndexReplicas, map[string]any{
+			"Id":                         keyword,
+			"SuggestionsWithFullname":    keyword,
+			"SuggestionsWithoutFullname": keyword,
+			"TeamsIds":                   keyword,
+			"ChannelsIds":                keyword,
+		}),
+		ChannelIndex: template(ChannelIndex, *settings.ChannelIndexShards, *settings.ChannelIndexReplicas, map[string]any{
+			"Id":            keyword,
+			"Type":          keyword,
+			"TeamId":        keyword,
+			"NameSuggest":   keyword,
+			"UserIDs":       keyword,
+			"TeamMemberIDs": keyword,
+		}),
+	}
+}
+
+// createGenerationIndexes creates the indexes of the generation, adding them to the alias.
+// The post indexes are created when the first post of their time window is indexed.
+func (e *OpenSearchEngine) createGenerationIndexes(ctx context.Context, generation string, aliasName func(string) string) error {
+	for _, kind := range indexKinds {
+		if kind == PostIndex {
+			continue
+		}
+		if err := e.client.createIndex(ctx, e.indexName(kind, generation), aliasName(kind)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadGenerations reads the generations of the indexes from the aliases pointing to them. The
+// caller must hold the engine mutex.
+func (e *OpenSearchEngine) loadGenerations(ctx context.Context) error {
+	aliases, err := e.client.getAliases(ctx, e.prefix()+"*")
+	if err != nil {
+		return err
+	}
+
+	generationOf := func(alias string) string {
+		indexes := aliases[alias]
+		if len(indexes) == 0 {
+			return ""
+		}
+		return strings.TrimPrefix(indexes[0], e.prefix()+ChannelIndex+"_")
+	}
+
+	generation := generationOf(e.aliasName(ChannelIndex))
+	reindexGeneration := generationOf(e.reindexAliasName(ChannelIndex))
+	if generation != e.generation || reindexGeneration != e.reindexGeneration {
+		e.clearPostIndexes()
+	}
+	e.generation = generation
+	e.reindexGeneration = reindexGeneration
+
+	if generation == "" {
+		return nil
+	}
+
+	// A post index may be created for a reindex completed meanwhile by another server, in
+	// which case it is added to the alias it is missing from.
+	postIndexes, err := e.client.getIndexes(ctx, e.indexName(PostIndex, generation)+"_*")
+	if err != nil {
+		return err
+	}
+	actions := []aliasAction{}
+	for _, index := range postIndexes {
+		if !slices.Contains(aliases[e.aliasName(PostIndex)], index) {
+			actions = append(actions, aliasAction{Add: &aliasActionTarget{Index: index, Alias: e.aliasName(PostIndex)}})
+		}
+		if reindexGeneration == "" && slices.Contains(aliases[e.reindexAliasName(PostIndex)], index) {
+			actions = append(actions, aliasAction{Remove: &aliasActionTarget{Index: index, Alias: e.reindexAliasName(PostIndex)}})
+		}
+	}
+	return e.client.updateAliases(ctx, actions)
+}
+
+// ensurePostIndex creates the index of the generation storing the posts created at the
+// given time if it doesn't exist yet, returning its name.
+func (e *OpenSearchEngine) ensurePostIndex(ctx context.Context, generation string, createAt int64) (string, error) {
+	index := e.postIndexName(generation, createAt)
+
+	e.postIndexesMut.Lock()
+	known := e.postIndexes[index]
+	e.postIndexesMut.Unlock()
+	if known {
+		return index, nil
+	}
+
+	alias := e.aliasName(PostIndex)
+	if generation != e.generation {
+		alias = e.reindexAliasName(PostIndex)
+	}
+	if err := e.client.createIndex(ctx, index, alias); err != nil {
+		return "", err
+	}
+
+	e.postIndexesMut.Lock()
+	e.postIndexes[index] = true
+	e.postIndexesMut.Unlock()
+
+	return index, nil
+}
+
+func (e *OpenSearchEngine) clearPostIndexes() {
+	e.postIndexesMut.Lock()
+	defer e.postIndexesMut.Unlock()
+
+	e.postIndexes = map[string]bool{}
+}
+
+func (e *OpenSearchEngine) refreshGenerations(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(GenerationsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			e.Mutex.Lock()
+			if err := e.loadGenerations(context.Background()); err != nil {
+				mlog.Warn("Failed to refresh the OpenSearch index generations", mlog.Err(err))
+			}
+			e.Mutex.Unlock()
+		}
+	}
+}
+
+func (e *OpenSearchEngine) Start() *model.AppError {
+	if !*e.cfg.ElasticsearchSettings.EnableIndexing {
+		return nil
+	}
+
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	if e.IsActive() {
+		return nil
+	}
+
+	mlog.Info("Starting OpenSearch", mlog.String("connection_url", *e.cfg.ElasticsearchSettings.ConnectionURL))
+
+	client, err := newClient(e.cfg.ElasticsearchSettings)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.Start", "opensearchengine.create_client.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	e.client = client
+
+	ctx := context.Background()
+	info, err := e.client.info(ctx)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.Start", "opensearchengine.connect.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	e.version = info.Version.Number
+
+	for kind, template := range e.indexTemplates() {
+		if err := e.client.putIndexTemplate(ctx, e.aliasName(kind), template); err != nil {
+			return model.NewAppError("Opensearchengine.Start", "opensearchengine.put_index_template.error", map[string]any{"Index": kind}, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.loadGenerations(ctx); err != nil {
+		return model.NewAppError("Opensearchengine.Start", "opensearchengine.load_generations.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if e.generation == "" {
+		generation := newGeneration()
+		if err := e.createGenerationIndexes(ctx, generation, e.aliasName); err != nil {
+			return model.NewAppError("Opensearchengine.Start", "opensearchengine.create_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		e.generation = generation
+	}
+
+	e.stopRefresh = make(chan struct{})
+	e.refreshDone = make(chan struct{})
+	go e.refreshGenerations(e.stopRefresh, e.refreshDone)
+
+	atomic.StoreInt32(&e.ready, 1)
+	return nil
+}
+
+func (e *OpenSearchEngine) Stop() *model.AppError {
+	e.Mutex.Lock()
+	if !e.IsActive() {
+		e.Mutex.Unlock()
+		return nil
+	}
+
+	mlog.Info("Stopping OpenSearch")
+
+	atomic.StoreInt32(&e.ready, 0)
+	close(e.stopRefresh)
+	refreshDone := e.refreshDone
+	e.clearPostIndexes()
+	e.Mutex.Unlock()
+
+	// The refresh takes the mutex, so it is waited for without holding it.
+	<-refreshDone
+	return nil
+}
+
+func (e *OpenSearchEngine) IsEnabled() bool {
+	return e.IsIndexingEnabled()
+}
+
+func (e *OpenSearchEngine) IsActive() bool {
+	return atomic.LoadInt32(&e.ready) == 1
+}
+
+func (e *OpenSearchEngine) IsIndexingSync() bool {
+	return e.indexSync
+}
+
+func (e *OpenSearchEngine) IsIndexingEnabled() bool {
+	return *e.cfg.ElasticsearchSettings.EnableIndexing
+}
+
+func (e *OpenSearchEngine) IsSearchEnabled() bool {
+	return *e.cfg.ElasticsearchSettings.EnableSearching
+}
+
+func (e *OpenSearchEngine) IsAutocompletionEnabled() bool {
+	return *e.cfg.ElasticsearchSettings.EnableAutocomplete
+}
+
+func (e *OpenSearchEngine) IsChannelsIndexVerified() bool {
+	return true
+}
+
+func (e *OpenSearchEngine) UpdateConfig(cfg *model.Config) {
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	// Connecting again when the connection settings change is done by the search engine
+	// config listener, which stops and starts the engine.
+	e.cfg = cfg
+}
+
+func (e *OpenSearchEngine) GetName() string {
+	return EngineName
+}
+
+func (e *OpenSearchEngine) GetFullVersion() string {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	return e.version
+}
+
+func (e *OpenSearchEngine) GetVersion() int {
+	major, _, _ := strings.Cut(e.GetFullVersion(), ".")
+	version, err := strconv.Atoi(major)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+func (e *OpenSearchEngine) GetPlugins() []string {
+	return []string{}
+}
+
+func (e *OpenSearchEngine) TestConfig(rctx request.CTX, cfg *model.Config) *model.AppError {
+	client, err := newClient(cfg.ElasticsearchSettings)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.TestConfig", "opensearchengine.create_client.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if _, err := client.info(rctx.Context()); err != nil {
+		return model.NewAppError("Opensearchengine.TestConfig", "opensearchengine.connect.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) RefreshIndexes(rctx request.CTX) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	if !e.IsActive() {
+		return nil
+	}
+
+	targets := []string{}
+	for _, kind := range indexKinds {
+		targets = append(targets, e.aliasName(kind), e.reindexAliasName(kind))
+	}
+	if err := e.client.refresh(rctx.Context(), targets); err != nil {
+		return model.NewAppError("Opensearchengine.RefreshIndexes", "opensearchengine.refresh_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// purgeKinds deletes the indexes of every generation of the kinds, replacing the ones of the
+// current generation with empty indexes. Any reindex in progress is abandoned. The caller must
+// hold the engine mutex.
+func (e *OpenSearchEngine) purgeKinds(ctx context.Context, kinds []string) *model.AppError {
+	ignored := []string{}
+	for _, index := range strings.Split(*e.cfg.ElasticsearchSettings.IgnoredPurgeIndexes, ",") {
+		if index = strings.TrimSpace(index); index != "" {
+			ignored = append(ignored, index)
+		}
+	}
+
+	if err := e.deleteGeneration(ctx, e.reindexGeneration); err != nil {
+		return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.delete_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, kind := range kinds {
+		indexes, err := e.client.getIndexes(ctx, e.prefix()+kind+"_*")
+		if err != nil {
+			return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.purge_indexes.error", map[string]any{"Index": kind}, "", http.StatusInternalServerError).Wrap(err)
+		}
+		indexes = slices.DeleteFunc(indexes, func(index string) bool {
+			return slices.Contains(ignored, index)
+		})
+		if err := e.client.deleteIndexes(ctx, indexes); err != nil {
+			return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.purge_indexes.error", map[string]any{"Index": kind}, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	e.clearPostIndexes()
+
+	generation := e.generation
+	if generation == "" {
+		generation = newGeneration()
+	}
+	if err := e.createGenerationIndexes(ctx, generation, e.aliasName); err != nil {
+		return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.create_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := e.loadGenerations(ctx); err != nil {
+		return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.load_generations.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) PurgeIndexes(rctx request.CTX) *model.AppError {
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	if !e.IsActive() {
+		return model.NewAppError("Opensearchengine.PurgeIndexes", "opensearchengine.not_started.error", nil, "", http.StatusInternalServerError)
+	}
+
+	rctx.Logger().Info("PurgeIndexes OpenSearch")
+	return e.purgeKinds(rctx.Context(), indexKinds)
+}
+
+// PurgeIndexList purges the indexes of the given kinds, which are posts, files, users
+// and channels.
+func (e *OpenSearchEngine) PurgeIndexList(rctx request.CTX, indexes []string) *model.AppError {
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	if !e.IsActive() {
+		return model.NewAppError("Opensearchengine.PurgeIndexList", "opensearchengine.not_started.error", nil, "", http.StatusInternalServerError)
+	}
+
+	for _, index := range indexes {
+		if !slices.Contains(indexKinds, index) {
+			return model.NewAppError("Opensearchengine.PurgeIndexList", "opensearchengine.purge_list.invalid_index.error", map[string]any{"Index": index}, "", http.StatusBadRequest)
+		}
+	}
+
+	rctx.Logger().Info("PurgeIndexList OpenSearch", mlog.Array("indexes", indexes))
+	return e.purgeKinds(rctx.Context(), indexes)
+}
+
+// DataRetentionDeleteIndexes deletes the posts created before the cutoff. The post indexes
+// whose time window ended before it are deleted as a whole.
+func (e *OpenSearchEngine) DataRetentionDeleteIndexes(rctx request.CTX, cutoff time.Time) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	if !e.IsActive() {
+		return nil
+	}
+
+	indexes, err := e.client.getIndexes(rctx.Context(), e.prefix()+PostIndex+"_*")
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DataRetentionDeleteIndexes", "opensearchengine.data_retention_delete_indexes.get_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	expired := []string{}
+	partial := []string{}
+	for _, index := range indexes {
+		window, ok := e.postIndexWindow(index)
+		if !ok {
+			continue
+		}
+		if !window.AddDate(0, 1, 0).After(cutoff) {
+			expired = append(expired, index)
+		} else if window.Before(cutoff) {
+			partial = append(partial, index)
+		}
+	}
+
+	if err := e.client.deleteIndexes(rctx.Context(), expired); err != nil {
+		return model.NewAppError("Opensearchengine.DataRetentionDeleteIndexes", "opensearchengine.data_retention_delete_indexes.delete_index.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	e.postIndexesMut.Lock()
+	for _, index := range expired {
+		delete(e.postIndexes, index)
+	}
+	e.postIndexesMut.Unlock()
+
+	if len(partial) > 0 {
+		deleted, err := e.client.deleteByQuery(rctx.Context(), partial, rangeQuery("CreateAt", nil, model.NewPointer(model.GetMillisForTime(cutoff))), 0)
+		if err != nil {
+			return model.NewAppError("Opensearchengine.DataRetentionDeleteIndexes", "opensearchengine.data_retention_delete_indexes.delete_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		rctx.Logger().Info("Posts older than the data retention cutoff deleted", mlog.Int("deleted", deleted))
+	}
+
+	rctx.Logger().Info("Post indexes older than the data retention cutoff deleted", mlog.Array("indexes", expired))
+
+	return nil
+}
+
+// StartReindex creates the indexes of a new generation, to be filled by an indexing job
+// before completing the reindex. The documents indexed meanwhile are written to both the
+// current and the new indexes. Any other reindex in progress is abandoned.
+func (e *OpenSearchEngine) StartReindex(rctx request.CTX) (string, *model.AppError) {
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	if !e.IsActive() {
+		return "", model.NewAppError("Opensearchengine.StartReindex", "opensearchengine.not_started.error", nil, "", http.StatusInternalServerError)
+	}
+
+	ctx := rctx.Context()
+	if e.reindexGeneration != "" {
+		if err := e.deleteGeneration(ctx, e.reindexGeneration); err != nil {
+			return "", model.NewAppError("Opensearchengine.StartReindex", "opensearchengine.delete_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	generation := newGeneration(e.generation, e.reindexGeneration)
+	if err := e.createGenerationIndexes(ctx, generation, e.reindexAliasName); err != nil {
+		return "", model.NewAppError("Opensearchengine.StartReindex", "opensearchengine.create_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	e.reindexGeneration = generation
+
+	rctx.Logger().Info("Started reindexing OpenSearch", mlog.String("generation", generation))
+
+	return generation, nil
+}
+
+// IsReindexing returns true if the generation is the one being reindexed.
+func (e *OpenSearchEngine) IsReindexing(generation string) bool {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	return e.IsActive() && generation != "" && e.reindexGeneration == generation
+}
+
+// CompleteReindex swaps the aliases to the indexes of the reindexed generation atomically,
+// and deletes the indexes of the previous one.
+func (e *OpenSearchEngine) CompleteReindex(rctx request.CTX, generation string) *model.AppError {
+	e.Mutex.Lock()
+	defer e.Mutex.Unlock()
+
+	if !e.IsActive() || generation == "" || e.reindexGeneration != generation {
+		return model.NewAppError("Opensearchengine.CompleteReindex", "opensearchengine.complete_reindex.not_reindexing.error", nil, "", http.StatusInternalServerError)
+	}
+
+	ctx := rctx.Context()
+	aliases, err := e.client.getAliases(ctx, e.prefix()+"*")
+	if err != nil {
+		return model.NewAppError("Opensearchengine.CompleteReindex", "opensearchengine.complete_reindex.swap_aliases.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	actions := []aliasAction{}
+	for _, kind := range indexKinds {
+		for _, index := range aliases[e.aliasName(kind)] {
+			actions = append(actions, aliasAction{Remove: &aliasActionTarget{Index: index, Alias: e.aliasName(kind)}})
+		}
+		for _, index := range aliases[e.reindexAliasName(kind)] {
+			actions = append(actions,
+				aliasAction{Remove: &aliasActionTarget{Index: index, Alias: e.reindexAliasName(kind)}},
+				aliasAction{Add: &aliasActionTarget{Index: index, Alias: e.aliasName(kind)}},
+			)
+		}
+	}
+	if err := e.client.updateAliases(ctx, actions); err != nil {
+		return model.NewAppError("Opensearchengine.CompleteReindex", "opensearchengine.complete_reindex.swap_aliases.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	previous := e.generation
+	e.generation = generation
+	e.reindexGeneration = ""
+	e.clearPostIndexes()
+
+	// The aliases are swapped already, so failing to delete the previous indexes only leaves
+	// them behind until the indexes are purged.
+	if err := e.deleteGeneration(ctx, previous); err != nil {
+		rctx.Logger().Warn("Failed to delete the previous OpenSearch indexes", mlog.String("generation", previous), mlog.Err(err))
+	}
+
+	rctx.Logger().Info("Completed reindexing OpenSearch", mlog.String("generation", generation), mlog.String("previous_generation", previous))
+
+	return nil
+}
+
+func (e *OpenSearchEngine) deleteGeneration(ctx context.Context, generation string) error {
+	if generation == "" {
+		return nil
+	}
+
+	indexes := []string{}
+	for _, kind := range indexKinds {
+		if kind != PostIndex {
+			indexes = append(indexes, e.indexName(kind, generation))
+			continue
+		}
+		postIndexes, err := e.client.getIndexes(ctx, e.indexName(PostIndex, generation)+"_*")
+		if err != nil {
+			return err
+		}
+		indexes = append(indexes, postIndexes...)
+	}
+	return e.client.deleteIndexes(ctx, indexes)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package opensearchengine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/opensearchengine/opensearchtest"
+)
+
+func setupEngine(t *testing.T) (*OpenSearchEngine, *opensearchtest.Server) {
+	t.Helper()
+
+	server := opensearchtest.NewServer()
+	t.Cleanup(server.Close)
+
+	engine := startEngine(t, server)
+	return engine, server
+}
+
+func startEngine(t *testing.T, server *opensearchtest.Server) *OpenSearchEngine {
+	t.Helper()
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.ElasticsearchSettings.ConnectionURL = model.NewPointer(server.URL)
+	cfg.ElasticsearchSettings.Backend = model.NewPointer(model.ElasticsearchSettingsOSBackend)
+	cfg.ElasticsearchSettings.EnableIndexing = model.NewPointer(true)
+	cfg.ElasticsearchSettings.EnableSearching = model.NewPointer(true)
+	cfg.ElasticsearchSettings.EnableAutocomplete = model.NewPointer(true)
+	cfg.ElasticsearchSettings.IndexPrefix = model.NewPointer("test_")
+
+	engine := NewOpenSearchEngine(cfg)
+	engine.indexSync = true
+	require.Nil(t, engine.Start())
+	t.Cleanup(func() {
+		require.Nil(t, engine.Stop())
+	})
+	return engine
+}
+
+func createPost(t *testing.T, engine *OpenSearchEngine, channel *model.Channel, message string, createAt time.Time) *model.Post {
+	t.Helper()
+
+	post := &model.Post{
+		Id:        model.NewId(),
+		ChannelId: channel.Id,
+		UserId:    model.NewId(),
+		Message:   message,
+		CreateAt:  model.GetMillisForTime(createAt),
+	}
+	require.Nil(t, engine.IndexPost(post, channel.TeamId))
+	return post
+}
+
+func searchPosts(t *testing.T, engine *OpenSearchEngine, channel *model.Channel, terms string) []string {
+	t.Helper()
+
+	params := model.ParseSearchParams(terms, 0)
+	ids, _, err := engine.SearchPosts(model.ChannelList{channel}, params, 0, 20)
+	require.Nil(t, err)
+	return ids
+}
+
+func TestStart(t *testing.T) {
+	engine, server := setupEngine(t)
+
+	assert.True(t, engine.IsActive())
+	assert.Equal(t, opensearchtest.Version, engine.GetFullVersion())
+	assert.Equal(t, 2, engine.GetVersion())
+
+	for _, kind := range []string{FileIndex, UserIndex, ChannelIndex} {
+		assert.Equal(t, []string{"test_" + kind + "_" + engine.generation}, server.AliasIndexes("test_"+kind))
+	}
+	// The post indexes are created along with their first post.
+	assert.Empty(t, server.Indexes("test_posts_*"))
+
+	t.Run("another server starting uses the same indexes", func(t *testing.T) {
+		other := startEngine(t, server)
+		assert.Equal(t, engine.generation, other.generation)
+		assert.Len(t, server.Indexes("test_channels_*"), 1)
+	})
+}
+
+func TestPosts(t *testing.T) {
+	engine, server := setupEngine(t)
+
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId()}
+	otherChannel := &model.Channel{Id: model.NewId(), TeamId: channel.TeamId}
+
+	january := createPost(t, engine, channel, "the release is scheduled", time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	february := createPost(t, engine, channel, "the release went out", time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC))
+	other := createPost(t, engine, otherChannel, "another release", time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC))
+
+	t.Run("posts are stored in monthly indexes", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"test_posts_" + engine.generation + "_2024_01",
+			"test_posts_" + engine.generation + "_2024_02",
+		}, server.AliasIndexes("test_posts"))
+	})
+
+	t.Run("search across the monthly indexes, newest first", func(t *testing.T) {
+		assert.Equal(t, []string{february.Id, january.Id}, searchPosts(t, engine, channel, "release"))
+		assert.Equal(t, []string{january.Id}, searchPosts(t, engine, channel, "scheduled"))
+		assert.Equal(t, []string{february.Id}, searchPosts(t, engine, channel, `"went out"`))
+		assert.Equal(t, []string{january.Id}, searchPosts(t, engine, channel, "sched*"))
+		assert.Equal(t, []string{other.Id}, searchPosts(t, engine, otherChannel, "release"))
+	})
+
+	t.Run("matches are highlighted", func(t *testing.T) {
+		_, matches, err := engine.SearchPosts(model.ChannelList{channel}, model.ParseSearchParams("release", 0), 0, 20)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"release"}, matches[january.Id])
+	})
+
+	t.Run("deleted posts are not found", func(t *testing.T) {
+		require.Nil(t, engine.DeletePost(february))
+		assert.Equal(t, []string{january.Id}, searchPosts(t, engine, channel, "release"))
+
+		require.Nil(t, engine.DeleteChannelPosts(request.TestContext(t), otherChannel.Id))
+		assert.Empty(t, searchPosts(t, engine, otherChannel, "release"))
+	})
+}
+
+func TestReindex(t *testing.T) {
+	engine, server := setupEngine(t)
+	rctx := request.TestContext(t)
+
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId(), Name: "town-square", DisplayName: "Town Square", Type: model.ChannelTypeOpen}
+	createAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	before := createPost(t, engine, channel, "written before the reindex", createAt)
+	require.Nil(t, engine.IndexChannel(rctx, channel, nil, nil))
+	previous := engine.generation
+
+	generation, appErr := engine.StartReindex(rctx)
+	require.Nil(t, appErr)
+	assert.NotEqual(t, previous, generation)
+	assert.True(t, engine.IsReindexing(generation))
+	assert.Equal(t, []string{"test_channels_" + generation}, server.AliasIndexes("test_channels_reindex"))
+
+	// The posts written meanwhile go to both generations.
+	during := createPost(t, engine, channel, "written during the reindex", createAt)
+	assert.ElementsMatch(t, []string{before.Id, during.Id}, server.DocumentIds("test_posts_"+previous+"_2024_03"))
+	assert.Equal(t, []string{during.Id}, server.DocumentIds("test_posts_"+generation+"_2024_03"))
+
+	// Searches are still served by the previous generation.
+	assert.ElementsMatch(t, []string{before.Id, during.Id}, searchPosts(t, engine, channel, "written"))
+
+	postForIndexing := &model.PostForIndexing{TeamId: channel.TeamId}
+	before.ShallowCopy(&postForIndexing.Post)
+	require.Nil(t, engine.BulkIndexPosts(generation, []*model.PostForIndexing{postForIndexing}))
+	require.Nil(t, engine.BulkIndexChannels(generation, []*OSChannel{OSChannelFromChannel(channel, nil, nil)}, nil))
+
+	requestsBefore := len(server.Requests())
+	require.Nil(t, engine.CompleteReindex(rctx, generation))
+
+	t.Run("the aliases are swapped in a single request", func(t *testing.T) {
+		aliasRequests := []opensearchtest.Request{}
+		for _, r := range server.Requests()[requestsBefore:] {
+			if r.Path == "/_aliases" {
+				aliasRequests = append(aliasRequests, r)
+			}
+		}
+		require.Len(t, aliasRequests, 1)
+		assert.Contains(t, aliasRequests[0].Body, `"remove"`)
+		assert.Contains(t, aliasRequests[0].Body, `"add"`)
+	})
+
+	t.Run("the previous generation is deleted", func(t *testing.T) {
+		assert.False(t, engine.IsReindexing(generation))
+		assert.Equal(t, generation, engine.generation)
+		for _, kind := range indexKinds {
+			for _, index := range server.Indexes("test_" + kind + "_*") {
+				assert.True(t, strings.HasPrefix(index, "test_"+kind+"_"+generation), index)
+			}
+			assert.Empty(t, server.AliasIndexes("test_"+kind+"_reindex"))
+		}
+	})
+
+	t.Run("searches are served by the new generation", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{before.Id, during.Id}, searchPosts(t, engine, channel, "written"))
+
+		channelIds, appErr := engine.SearchChannels(channel.TeamId, model.NewId(), "town", false)
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{channel.Id}, channelIds)
+	})
+
+	t.Run("completing a reindex that isn't running fails", func(t *testing.T) {
+		assert.NotNil(t, engine.CompleteReindex(rctx, generation))
+	})
+}
+
+func TestDataRetentionDeleteIndexes(t *testing.T) {
+	engine, server := setupEngine(t)
+
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId()}
+	january := createPost(t, engine, channel, "old message", time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	earlyFebruary := createPost(t, engine, channel, "old message", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC))
+	lateFebruary := createPost(t, engine, channel, "recent message", time.Date(2024, time.February, 20, 0, 0, 0, 0, time.UTC))
+	march := createPost(t, engine, channel, "recent message", time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC))
+
+	cutoff := time.Date(2024, time.February, 15, 0, 0, 0, 0, time.UTC)
+	require.Nil(t, engine.DataRetentionDeleteIndexes(request.TestContext(t), cutoff))
+
+	// The index of January is deleted as a whole, and the one of February only partially.
+	assert.Equal(t, []string{
+		"test_posts_" + engine.generation + "_2024_02",
+		"test_posts_" + engine.generation + "_2024_03",
+	}, server.Indexes("test_posts_*"))
+	assert.Equal(t, []string{lateFebruary.Id}, server.DocumentIds("test_posts_"+engine.generation+"_2024_02"))
+	assert.ElementsMatch(t, []string{lateFebruary.Id, march.Id}, searchPosts(t, engine, channel, "message"))
+	assert.NotContains(t, searchPosts(t, engine, channel, "message"), january.Id)
+	assert.NotContains(t, searchPosts(t, engine, channel, "message"), earlyFebruary.Id)
+
+	t.Run("posts of a deleted window can be indexed again", func(t *testing.T) {
+		post := createPost(t, engine, channel, "late import", time.Date(2024, time.January, 20, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, []string{post.Id}, searchPosts(t, engine, channel, "import"))
+	})
+}
+
+func TestPurgeIndexes(t *testing.T) {
+	engine, server := setupEngine(t)
+	rctx := request.TestContext(t)
+
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId(), Name: "town-square", DisplayName: "Town Square", Type: model.ChannelTypeOpen}
+	createPost(t, engine, channel, "purged message", time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC))
+	require.Nil(t, engine.IndexChannel(rctx, channel, nil, nil))
+
+	t.Run("purge a list of indexes", func(t *testing.T) {
+		require.Nil(t, engine.PurgeIndexList(rctx, []string{PostIndex}))
+		assert.Empty(t, server.Indexes("test_posts_*"))
+		assert.Empty(t, searchPosts(t, engine, channel, "purged"))
+		assert.Equal(t, []string{channel.Id}, server.DocumentIds("test_channels_"+engine.generation))
+
+		appErr := engine.PurgeIndexList(rctx, []string{"unknown"})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "opensearchengine.purge_list.invalid_index.error", appErr.Id)
+	})
+
+	t.Run("purge every index", func(t *testing.T) {
+		require.Nil(t, engine.PurgeIndexes(rctx))
+		assert.Empty(t, server.DocumentIds("test_channels_"+engine.generation))
+		assert.Equal(t, []string{"test_channels_" + engine.generation}, server.AliasIndexes("test_channels"))
+	})
+}
+
+func TestSearchUsers(t *testing.T) {
+	engine, _ := setupEngine(t)
+	rctx := request.TestContext(t)
+
+	teamId := model.NewId()
+	channelId := model.NewId()
+	member := &model.User{Id: model.NewId(), Username: "alice.smith", FirstName: "Alice"}
+	nonMember := &model.User{Id: model.NewId(), Username: "albert", Nickname: "Al"}
+	require.Nil(t, engine.IndexUser(rctx, member, []string{teamId}, []string{channelId}))
+	require.Nil(t, engine.IndexUser(rctx, nonMember, []string{teamId}, []string{}))
+
+	options := &model.UserSearchOptions{Limit: 10, AllowFullNames: true}
+
+	inChannel, notInChannel, appErr := engine.SearchUsersInChannel(teamId, channelId, nil, "al", options)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{member.Id}, inChannel)
+	assert.Equal(t, []string{nonMember.Id}, notInChannel)
+
+	inTeam, appErr := engine.SearchUsersInTeam(teamId, nil, "smi", options)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{member.Id}, inTeam)
+
+	require.Nil(t, engine.DeleteUser(member))
+	inTeam, appErr = engine.SearchUsersInTeam(teamId, nil, "al", options)
+	require.Nil(t, appErr)
+	assert.Equal(t, []string{nonMember.Id}, inTeam)
+}
@@ -0,0 +1,986 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package opensearchtest provides an in-memory stand-in of an OpenSearch cluster, so the
+// OpenSearch engine can be tested without running one.
+package opensearchtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Version is the version the server reports.
+const Version = "2.11.0"
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// Server serves the subset of the OpenSearch REST API used by the OpenSearch engine, keeping
+// the indexes in memory. It supports the index templates, the aliases, the bulk API and the
+// queries built by the engine, analyzing text by splitting it in lowercase words.
+type Server struct {
+	*httptest.Server
+
+	mut       sync.Mutex
+	templates map[string]map[string]string
+	indexes   map[string]*index
+	requests  []Request
+}
+
+type index struct {
+	aliases  map[string]bool
+	mappings map[string]string
+	docs     map[string]map[string]any
+}
+
+type scoredDoc struct {
+	index string
+	id    string
+	doc   map[string]any
+	score float64
+}
+
+// NewServer starts a server, which the caller must close.
+func NewServer() *Server {
+	s := &Server{
+		templates: map[string]map[string]string{},
+		indexes:   map[string]*index{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Requests returns the requests received by the server so far.
+func (s *Server) Requests() []Request {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// Indexes returns the sorted names of the indexes matching the pattern.
+func (s *Server) Indexes(pattern string) []string {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	names := []string{}
+	for name := range s.indexes {
+		if matched, _ := path.Match(pattern, name); matched {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AliasIndexes returns the sorted names of the indexes the alias points to.
+func (s *Server) AliasIndexes(alias string) []string {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	names := []string{}
+	for name, idx := range s.indexes {
+		if idx.aliases[alias] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DocumentIds returns the sorted ids of the documents in the index.
+func (s *Server) DocumentIds(name string) []string {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	ids := []string{}
+	if idx, ok := s.indexes[name]; ok {
+		for id := range idx.docs {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, errorType, reason string) {
+	writeJSON(w, status, map[string]any{
+		"error":  map[string]any{"type": errorType, "reason": reason},
+		"status": status,
+	})
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Body: string(body)})
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{
+			"version": map[string]any{"number": Version, "distribution": "opensearch"},
+		})
+	case parts[0] == "_index_template" && len(parts) == 2 && r.Method == http.MethodPut:
+		s.putIndexTemplate(w, body)
+	case parts[0] == "_cat" && len(parts) >= 2 && parts[1] == "indices":
+		pattern := "*"
+		if len(parts) == 3 {
+			pattern = parts[2]
+		}
+		rows := []map[string]any{}
+		for _, name := range s.resolveIndexes(pattern, false) {
+			rows = append(rows, map[string]any{"index": name})
+		}
+		writeJSON(w, http.StatusOK, rows)
+	case parts[0] == "_alias" && r.Method == http.MethodGet:
+		pattern := "*"
+		if len(parts) == 2 {
+			pattern = parts[1]
+		}
+		s.getAliases(w, pattern)
+	case parts[0] == "_aliases" && r.Method == http.MethodPost:
+		s.updateAliases(w, body)
+	case parts[0] == "_bulk" && r.Method == http.MethodPost:
+		s.bulk(w, body)
+	case len(parts) == 2 && parts[1] == "_search":
+		s.search(w, parts[0], body)
+	case len(parts) == 2 && parts[1] == "_delete_by_query":
+		s.deleteByQuery(w, parts[0], r.URL.Query().Get("max_docs"), body)
+	case len(parts) == 2 && parts[1] == "_refresh":
+		writeJSON(w, http.StatusOK, map[string]any{})
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		s.createIndex(w, parts[0], body)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		for _, name := range s.resolveIndexes(parts[0], false) {
+			delete(s.indexes, name)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"acknowledged": true})
+	default:
+		writeError(w, http.StatusBadRequest, "illegal_argument_exception", "unsupported request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func (s *Server) putIndexTemplate(w http.ResponseWriter, body []byte) {
+	var template struct {
+		IndexPatterns []string `json:"index_patterns"`
+		Template      struct {
+			Mappings struct {
+				Properties map[string]struct {
+					Type string `json:"type"`
+				} `json:"properties"`
+			} `json:"mappings"`
+		} `json:"template"`
+	}
+	if err := json.Unmarshal(body, &template); err != nil {
+		writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+
+	mappings := map[string]string{}
+	for field, property := range template.Template.Mappings.Properties {
+		mappings[field] = property.Type
+	}
+	for _, pattern := range template.IndexPatterns {
+		s.templates[pattern] = mappings
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"acknowledged": true})
+}
+
+// newIndex creates an index with the mappings of the templates matching its name.
+func (s *Server) newIndex(name string) *index {
+	idx := &index{
+		aliases:  map[string]bool{},
+		mappings: map[string]string{},
+		docs:     map[string]map[string]any{},
+	}
+	for pattern, mappings := range s.templates {
+		if matched, _ := path.Match(pattern, name); matched {
+			for field, fieldType := range mappings {
+				idx.mappings[field] = fieldType
+			}
+		}
+	}
+	s.indexes[name] = idx
+	return idx
+}
+
+func (s *Server) createIndex(w http.ResponseWriter, name string, body []byte) {
+	if _, ok := s.indexes[name]; ok {
+		writeError(w, http.StatusBadRequest, "resource_already_exists_exception", "index ["+name+"] already exists")
+		return
+	}
+
+	var request struct {
+		Aliases map[string]any `json:"aliases"`
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &request); err != nil {
+			writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+			return
+		}
+	}
+
+	idx := s.newIndex(name)
+	for alias := range request.Aliases {
+		idx.aliases[alias] = true
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"acknowledged": true, "index": name})
+}
+
+// resolveIndexes returns the sorted names of the indexes of the comma separated targets, which
+// may be indexes, aliases or patterns. Missing targets are ignored.
+func (s *Server) resolveIndexes(targets string, includeAliases bool) []string {
+	names := map[string]bool{}
+	for _, target := range strings.Split(targets, ",") {
+		for name, idx := range s.indexes {
+			if matched, _ := path.Match(target, name); matched {
+				names[name] = true
+				continue
+			}
+			if includeAliases {
+				for alias := range idx.aliases {
+					if matched, _ := path.Match(target, alias); matched {
+						names[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	sorted := []string{}
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func (s *Server) getAliases(w http.ResponseWriter, pattern string) {
+	result := map[string]any{}
+	for name, idx := range s.indexes {
+		aliases := map[string]any{}
+		for alias := range idx.aliases {
+			if matched, _ := path.Match(pattern, alias); matched {
+				aliases[alias] = map[string]any{}
+			}
+		}
+		if len(aliases) > 0 {
+			result[name] = map[string]any{"aliases": aliases}
+		}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) updateAliases(w http.ResponseWriter, body []byte) {
+	var request struct {
+		Actions []map[string]struct {
+			Index string `json:"index"`
+			Alias string `json:"alias"`
+		} `json:"actions"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+
+	// The actions are validated before applying any, as they are applied atomically.
+	type change struct {
+		index string
+		alias string
+		add   bool
+	}
+	changes := []change{}
+	for _, action := range request.Actions {
+		for name, target := range action {
+			indexes := s.resolveIndexes(target.Index, false)
+			if len(indexes) == 0 {
+				writeError(w, http.StatusNotFound, "index_not_found_exception", "no such index ["+target.Index+"]")
+				return
+			}
+			for _, indexName := range indexes {
+				switch name {
+				case "add":
+					changes = append(changes, change{indexName, target.Alias, true})
+				case "remove":
+					if !s.indexes[indexName].aliases[target.Alias] {
+						writeError(w, http.StatusNotFound, "aliases_not_found_exception", "aliases ["+target.Alias+"] missing")
+						return
+					}
+					changes = append(changes, change{indexName, target.Alias, false})
+				default:
+					writeError(w, http.StatusBadRequest, "illegal_argument_exception", "unsupported alias action "+name)
+					return
+				}
+			}
+		}
+	}
+
+	for _, c := range changes {
+		if c.add {
+			s.indexes[c.index].aliases[c.alias] = true
+		} else {
+			delete(s.indexes[c.index].aliases, c.alias)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"acknowledged": true})
+}
+
+func (s *Server) bulk(w http.ResponseWriter, body []byte) {
+	items := []any{}
+	hasErrors := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var action map[string]struct {
+			Index string `json:"_index"`
+			Id    string `json:"_id"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &action); err != nil {
+			writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+			return
+		}
+
+		for operation, target := range action {
+			switch operation {
+			case "index":
+				if !scanner.Scan() {
+					writeError(w, http.StatusBadRequest, "parse_exception", "missing document")
+					return
+				}
+				var doc map[string]any
+				if err := json.Unmarshal(scanner.Bytes(), &doc); err != nil {
+					writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+					return
+				}
+				idx, ok := s.indexes[target.Index]
+				if !ok {
+					idx = s.newIndex(target.Index)
+				}
+				idx.docs[target.Id] = doc
+				items = append(items, map[string]any{operation: map[string]any{"_index": target.Index, "_id": target.Id, "status": http.StatusCreated}})
+			case "delete":
+				item := map[string]any{"_index": target.Index, "_id": target.Id, "status": http.StatusOK}
+				if idx, ok := s.indexes[target.Index]; !ok {
+					item["status"] = http.StatusNotFound
+					item["error"] = map[string]any{"type": "index_not_found_exception", "reason": "no such index [" + target.Index + "]"}
+					hasErrors = true
+				} else if _, ok := idx.docs[target.Id]; !ok {
+					item["status"] = http.StatusNotFound
+					item["result"] = "not_found"
+				} else {
+					delete(idx.docs, target.Id)
+				}
+				items = append(items, map[string]any{operation: item})
+			default:
+				writeError(w, http.StatusBadRequest, "illegal_argument_exception", "unsupported bulk operation "+operation)
+				return
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"errors": hasErrors, "items": items})
+}
+
+// matchingDocs returns the documents of the targets matching the query.
+func (s *Server) matchingDocs(targets string, query map[string]any) ([]*scoredDoc, error) {
+	docs := []*scoredDoc{}
+	for _, name := range s.resolveIndexes(targets, true) {
+		idx := s.indexes[name]
+		for id, doc := range idx.docs {
+			matched, score, err := matchQuery(idx, doc, query)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				docs = append(docs, &scoredDoc{index: name, id: id, doc: doc, score: score})
+			}
+		}
+	}
+	return docs, nil
+}
+
+func (s *Server) search(w http.ResponseWriter, targets string, body []byte) {
+	var request struct {
+		Query     map[string]any   `json:"query"`
+		From      int              `json:"from"`
+		Size      *int             `json:"size"`
+		Sort      []map[string]any `json:"sort"`
+		Highlight *struct {
+			PreTags  []string       `json:"pre_tags"`
+			PostTags []string       `json:"post_tags"`
+			Fields   map[string]any `json:"fields"`
+		} `json:"highlight"`
+		Aggs map[string]map[string]json.RawMessage `json:"aggs"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+	if request.Query == nil {
+		request.Query = map[string]any{"match_all": map[string]any{}}
+	}
+
+	docs, err := s.matchingDocs(targets, request.Query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+	sortDocs(docs, request.Sort)
+
+	aggregations := map[string]any{}
+	for name, aggregation := range request.Aggs {
+		result, err := aggregate(docs, aggregation)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+			return
+		}
+		aggregations[name] = result
+	}
+
+	total := len(docs)
+	size := 10
+	if request.Size != nil {
+		size = *request.Size
+	}
+	docs = docs[min(request.From, len(docs)):]
+	docs = docs[:min(size, len(docs))]
+
+	hits := []any{}
+	for _, doc := range docs {
+		hit := map[string]any{"_index": doc.index, "_id": doc.id, "_score": doc.score}
+		if request.Highlight != nil && len(request.Highlight.PreTags) > 0 && len(request.Highlight.PostTags) > 0 {
+			highlight := map[string]any{}
+			for field := range request.Highlight.Fields {
+				if fragment, ok := highlightField(doc.doc, field, request.Query, request.Highlight.PreTags[0], request.Highlight.PostTags[0]); ok {
+					highlight[field] = []string{fragment}
+				}
+			}
+			if len(highlight) > 0 {
+				hit["highlight"] = highlight
+			}
+		}
+		hits = append(hits, hit)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"hits":         map[string]any{"total": map[string]any{"value": total, "relation": "eq"}, "hits": hits},
+		"aggregations": aggregations,
+	})
+}
+
+func (s *Server) deleteByQuery(w http.ResponseWriter, targets, maxDocs string, body []byte) {
+	var request struct {
+		Query map[string]any `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+
+	docs, err := s.matchingDocs(targets, request.Query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+	if maxDocs != "" {
+		limit, err := strconv.Atoi(maxDocs)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "illegal_argument_exception", err.Error())
+			return
+		}
+		docs = docs[:min(limit, len(docs))]
+	}
+
+	for _, doc := range docs {
+		delete(s.indexes[doc.index].docs, doc.id)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"deleted": len(docs)})
+}
+
+var wordRegex = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// analyze splits the text in lowercase words.
+func analyze(text string) []string {
+	words := wordRegex.FindAllString(text, -1)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return words
+}
+
+// fieldValues returns the values of the field of the document as strings, analyzing the text
+// fields.
+func fieldValues(idx *index, doc map[string]any, field string) []string {
+	var raw []any
+	switch value := doc[field].(type) {
+	case nil:
+		return nil
+	case []any:
+		raw = value
+	default:
+		raw = []any{value}
+	}
+
+	values := []string{}
+	for _, value := range raw {
+		text := fmt.Sprint(value)
+		if idx.mappings[field] == "text" {
+			values = append(values, analyze(text)...)
+		} else {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+func numericValues(doc map[string]any, field string) []float64 {
+	values := []float64{}
+	switch value := doc[field].(type) {
+	case float64:
+		values = append(values, value)
+	case []any:
+		for _, v := range value {
+			if f, ok := v.(float64); ok {
+				values = append(values, f)
+			}
+		}
+	}
+	return values
+}
+
+// fieldParams returns the only field of the query params, and its value.
+func fieldParams(params any) (string, any, error) {
+	fields, ok := params.(map[string]any)
+	if !ok || len(fields) != 1 {
+		return "", nil, fmt.Errorf("expected a single field, got %v", params)
+	}
+	for field, value := range fields {
+		return field, value, nil
+	}
+	return "", nil, nil
+}
+
+func asQueries(value any) []map[string]any {
+	queries := []map[string]any{}
+	switch v := value.(type) {
+	case []any:
+		for _, q := range v {
+			if query, ok := q.(map[string]any); ok {
+				queries = append(queries, query)
+			}
+		}
+	case map[string]any:
+		queries = append(queries, v)
+	}
+	return queries
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// matchQuery returns whether the document matches the query, and its score.
+func matchQuery(idx *index, doc map[string]any, query map[string]any) (bool, float64, error) {
+	if len(query) != 1 {
+		return false, 0, fmt.Errorf("expected a single query type, got %v", query)
+	}
+
+	for queryType, params := range query {
+		switch queryType {
+		case "match_all":
+			return true, 1, nil
+
+		case "bool":
+			clauses, _ := params.(map[string]any)
+			score := 0.0
+			for _, q := range asQueries(clauses["must"]) {
+				matched, s, err := matchQuery(idx, doc, q)
+				if err != nil || !matched {
+					return false, 0, err
+				}
+				score += s
+			}
+			for _, q := range asQueries(clauses["filter"]) {
+				matched, _, err := matchQuery(idx, doc, q)
+				if err != nil || !matched {
+					return false, 0, err
+				}
+			}
+			for _, q := range asQueries(clauses["must_not"]) {
+				matched, _, err := matchQuery(idx, doc, q)
+				if err != nil || matched {
+					return false, 0, err
+				}
+			}
+
+			should := asQueries(clauses["should"])
+			minimumShouldMatch := 0
+			if value, ok := toFloat(clauses["minimum_should_match"]); ok {
+				minimumShouldMatch = int(value)
+			} else if len(should) > 0 && clauses["must"] == nil && clauses["filter"] == nil {
+				minimumShouldMatch = 1
+			}
+			shouldMatched := 0
+			for _, q := range should {
+				matched, s, err := matchQuery(idx, doc, q)
+				if err != nil {
+					return false, 0, err
+				}
+				if matched {
+					shouldMatched++
+					score += s
+				}
+			}
+			return shouldMatched >= minimumShouldMatch, score, nil
+
+		case "term", "terms":
+			field, value, err := fieldParams(params)
+			if err != nil {
+				return false, 0, err
+			}
+			boost := 1.0
+			var expected []any
+			if queryType == "terms" {
+				expected, _ = value.([]any)
+			} else if valueParams, ok := value.(map[string]any); ok {
+				expected = []any{valueParams["value"]}
+				if b, ok := toFloat(valueParams["boost"]); ok {
+					boost = b
+				}
+			} else {
+				expected = []any{value}
+			}
+
+			values := fieldValues(idx, doc, field)
+			if len(values) == 0 {
+				// Missing and empty fields match the empty string.
+				values = []string{""}
+			}
+			for _, e := range expected {
+				if slices.Contains(values, fmt.Sprint(e)) {
+					return true, boost, nil
+				}
+			}
+			return false, 0, nil
+
+		case "range":
+			field, value, err := fieldParams(params)
+			if err != nil {
+				return false, 0, err
+			}
+			limits, _ := value.(map[string]any)
+			boost := 1.0
+			if b, ok := toFloat(limits["boost"]); ok {
+				boost = b
+			}
+			for _, v := range numericValues(doc, field) {
+				if inRange(v, limits) {
+					return true, boost, nil
+				}
+			}
+			return false, 0, nil
+
+		case "match", "match_phrase", "prefix":
+			field, value, err := fieldParams(params)
+			if err != nil {
+				return false, 0, err
+			}
+			text, operator := "", "or"
+			if valueParams, ok := value.(map[string]any); ok {
+				text = fmt.Sprint(valueParams["query"])
+				if op, ok := valueParams["operator"].(string); ok {
+					operator = strings.ToLower(op)
+				}
+			} else {
+				text = fmt.Sprint(value)
+			}
+
+			values := fieldValues(idx, doc, field)
+			switch queryType {
+			case "prefix":
+				for _, v := range values {
+					if strings.HasPrefix(v, text) {
+						return true, 1, nil
+					}
+				}
+				return false, 0, nil
+			case "match_phrase":
+				phrase := analyze(text)
+				for i := 0; i+len(phrase) <= len(values) && len(phrase) > 0; i++ {
+					if slices.Equal(values[i:i+len(phrase)], phrase) {
+						return true, float64(len(phrase)), nil
+					}
+				}
+				return false, 0, nil
+			default:
+				words := analyze(text)
+				if idx.mappings[field] != "text" {
+					words = []string{text}
+				}
+				matched := 0
+				for _, word := range words {
+					if slices.Contains(values, word) {
+						matched++
+					}
+				}
+				if matched == 0 || (operator == "and" && matched < len(words)) {
+					return false, 0, nil
+				}
+				return true, float64(matched), nil
+			}
+
+		default:
+			return false, 0, fmt.Errorf("unsupported query type %s", queryType)
+		}
+	}
+	return false, 0, nil
+}
+
+func inRange(value float64, limits map[string]any) bool {
+	if limit, ok := toFloat(limits["gte"]); ok && value < limit {
+		return false
+	}
+	if limit, ok := toFloat(limits["gt"]); ok && value <= limit {
+		return false
+	}
+	if limit, ok := toFloat(limits["lte"]); ok && value > limit {
+		return false
+	}
+	if limit, ok := toFloat(limits["lt"]); ok && value >= limit {
+		return false
+	}
+	return true
+}
+
+func sortDocs(docs []*scoredDoc, sortBy []map[string]any) {
+	if len(sortBy) == 0 {
+		sortBy = []map[string]any{{"_score": "desc"}}
+	}
+
+	compare := func(a, b *scoredDoc) int {
+		for _, criteria := range sortBy {
+			for field, order := range criteria {
+				var result int
+				if field == "_score" {
+					result = compareFloats(a.score, b.score)
+				} else {
+					aValues, bValues := numericValues(a.doc, field), numericValues(b.doc, field)
+					if len(aValues) > 0 && len(bValues) > 0 {
+						result = compareFloats(aValues[0], bValues[0])
+					} else {
+						result = strings.Compare(fmt.Sprint(a.doc[field]), fmt.Sprint(b.doc[field]))
+					}
+				}
+				if order == "desc" {
+					result = -result
+				}
+				if result != 0 {
+					return result
+				}
+			}
+		}
+		return strings.Compare(a.id, b.id)
+	}
+	slices.SortFunc(docs, compare)
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func aggregate(docs []*scoredDoc, aggregation map[string]json.RawMessage) (any, error) {
+	if params, ok := aggregation["terms"]; ok {
+		var terms struct {
+			Field string `json:"field"`
+			Size  int    `json:"size"`
+		}
+		if err := json.Unmarshal(params, &terms); err != nil {
+			return nil, err
+		}
+
+		counts := map[string]int{}
+		for _, doc := range docs {
+			seen := map[string]bool{}
+			var raw []any
+			switch value := doc.doc[terms.Field].(type) {
+			case nil:
+			case []any:
+				raw = value
+			default:
+				raw = []any{value}
+			}
+			for _, value := range raw {
+				key := fmt.Sprint(value)
+				if !seen[key] {
+					seen[key] = true
+					counts[key]++
+				}
+			}
+		}
+
+		keys := []string{}
+		for key := range counts {
+			keys = append(keys, key)
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			if counts[a] != counts[b] {
+				return counts[b] - counts[a]
+			}
+			return strings.Compare(a, b)
+		})
+		if terms.Size > 0 {
+			keys = keys[:min(terms.Size, len(keys))]
+		}
+
+		buckets := []any{}
+		for _, key := range keys {
+			buckets = append(buckets, map[string]any{"key": key, "doc_count": counts[key]})
+		}
+		return map[string]any{"buckets": buckets}, nil
+	}
+
+	if params, ok := aggregation["range"]; ok {
+		var ranges struct {
+			Field  string           `json:"field"`
+			Ranges []map[string]any `json:"ranges"`
+		}
+		if err := json.Unmarshal(params, &ranges); err != nil {
+			return nil, err
+		}
+
+		buckets := []any{}
+		for _, r := range ranges.Ranges {
+			limits := map[string]any{"gte": r["from"], "lt": r["to"]}
+			count := 0
+			for _, doc := range docs {
+				for _, value := range numericValues(doc.doc, ranges.Field) {
+					if inRange(value, limits) {
+						count++
+						break
+					}
+				}
+			}
+			bucket := map[string]any{"key": r["key"], "doc_count": count}
+			if r["from"] != nil {
+				bucket["from"] = r["from"]
+			}
+			if r["to"] != nil {
+				bucket["to"] = r["to"]
+			}
+			buckets = append(buckets, bucket)
+		}
+		return map[string]any{"buckets": buckets}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported aggregation %v", aggregation)
+}
+
+// queryWords collects the words and prefixes the query matches in the field, skipping the
+// negated clauses.
+func queryWords(query map[string]any, field string, words, prefixes map[string]bool) {
+	for queryType, params := range query {
+		switch queryType {
+		case "bool":
+			clauses, _ := params.(map[string]any)
+			for _, clause := range []string{"must", "filter", "should"} {
+				for _, q := range asQueries(clauses[clause]) {
+					queryWords(q, field, words, prefixes)
+				}
+			}
+		case "match", "match_phrase", "prefix":
+			fields, _ := params.(map[string]any)
+			value, ok := fields[field]
+			if !ok {
+				continue
+			}
+			text := fmt.Sprint(value)
+			if valueParams, ok := value.(map[string]any); ok {
+				text = fmt.Sprint(valueParams["query"])
+			}
+			if queryType == "prefix" {
+				prefixes[text] = true
+				continue
+			}
+			for _, word := range analyze(text) {
+				words[word] = true
+			}
+		}
+	}
+}
+
+// highlightField returns the text of the field with the words matched by the query surrounded
+// by the tags, if any.
+func highlightField(doc map[string]any, field string, query map[string]any, preTag, postTag string) (string, bool) {
+	text, ok := doc[field].(string)
+	if !ok {
+		return "", false
+	}
+
+	words := map[string]bool{}
+	prefixes := map[string]bool{}
+	queryWords(query, field, words, prefixes)
+
+	highlighted := false
+	result := wordRegex.ReplaceAllStringFunc(text, func(word string) string {
+		lower := strings.ToLower(word)
+		matched := words[lower]
+		for prefix := range prefixes {
+			matched = matched || strings.HasPrefix(lower, prefix)
+		}
+		if !matched {
+			return word
+		}
+		highlighted = true
+		return preTag + word + postTag
+	})
+	return result, highlighted
+}
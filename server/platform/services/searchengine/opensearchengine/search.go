@@ -0,0 +1,947 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package opensearchengine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+const (
+	// The highlight tags surround the words matched in the message of the posts.
+	highlightPreTag  = "<mm-match>"
+	highlightPostTag = "</mm-match>"
+)
+
+var (
+	searchTermRegex = regexp.MustCompile(`"[^"]+"|\S+`)
+	highlightRegex  = regexp.MustCompile(regexp.QuoteMeta(highlightPreTag) + `(.*?)` + regexp.QuoteMeta(highlightPostTag))
+)
+
+type boolQuery struct {
+	Must               []any
+	Filter             []any
+	Should             []any
+	MustNot            []any
+	MinimumShouldMatch int
+}
+
+func (q *boolQuery) source() map[string]any {
+	clauses := map[string]any{}
+	for name, queries := range map[string][]any{
+		"must":     q.Must,
+		"filter":   q.Filter,
+		"should":   q.Should,
+		"must_not": q.MustNot,
+	} {
+		if len(queries) > 0 {
+			clauses[name] = queries
+		}
+	}
+	if q.MinimumShouldMatch > 0 {
+		clauses["minimum_should_match"] = q.MinimumShouldMatch
+	}
+	return map[string]any{"bool": clauses}
+}
+
+func termQuery(field string, value any) map[string]any {
+	return map[string]any{"term": map[string]any{field: value}}
+}
+
+func termsQuery(field string, values []string) map[string]any {
+	return map[string]any{"terms": map[string]any{field: values}}
+}
+
+// rangeQuery returns a query matching the values from gte, inclusive, to lt, exclusive. A
+// nil limit is unbounded.
+func rangeQuery(field string, gte, lt *int64) map[string]any {
+	limits := map[string]any{}
+	if gte != nil {
+		limits["gte"] = *gte
+	}
+	if lt != nil {
+		limits["lt"] = *lt
+	}
+	return map[string]any{"range": map[string]any{field: limits}}
+}
+
+func matchQuery(field, text string, orTerms bool) map[string]any {
+	operator := "and"
+	if orTerms {
+		operator = "or"
+	}
+	return map[string]any{"match": map[string]any{field: map[string]any{"query": text, "operator": operator}}}
+}
+
+// textQueries returns the queries matching the terms in any of the fields. Quoted terms are
+// matched as phrases, and terms ending with an asterisk as prefixes.
+func textQueries(fields []string, terms string, orTerms bool) []any {
+	fieldsQuery := func(fieldQuery func(field string) map[string]any) map[string]any {
+		if len(fields) == 1 {
+			return fieldQuery(fields[0])
+		}
+		q := &boolQuery{MinimumShouldMatch: 1}
+		for _, field := range fields {
+			q.Should = append(q.Should, fieldQuery(field))
+		}
+		return q.source()
+	}
+
+	queries := []any{}
+	words := []string{}
+	for _, term := range searchTermRegex.FindAllString(terms, -1) {
+		switch {
+		case strings.HasPrefix(term, `"`) && strings.HasSuffix(term, `"`) && len(term) > 1:
+			phrase := strings.Trim(term, `"`)
+			queries = append(queries, fieldsQuery(func(field string) map[string]any {
+				return map[string]any{"match_phrase": map[string]any{field: phrase}}
+			}))
+		case strings.HasSuffix(term, "*"):
+			prefix := strings.ToLower(strings.TrimSuffix(term, "*"))
+			queries = append(queries, fieldsQuery(func(field string) map[string]any {
+				return map[string]any{"prefix": map[string]any{field: prefix}}
+			}))
+		default:
+			words = append(words, term)
+		}
+	}
+
+	if len(words) > 0 {
+		text := strings.Join(words, " ")
+		queries = append(queries, fieldsQuery(func(field string) map[string]any {
+			return matchQuery(field, text, orTerms)
+		}))
+	}
+	return queries
+}
+
+// addDateFilters adds the filters of the date modifiers of the search.
+func addDateFilters(q *boolQuery, params *model.SearchParams) {
+	if params.OnDate != "" {
+		before, after := params.GetOnDateMillis()
+		q.Filter = append(q.Filter, rangeQuery("CreateAt", &before, &after))
+		return
+	}
+
+	if params.AfterDate != "" || params.BeforeDate != "" {
+		var gte, lt *int64
+		if params.AfterDate != "" {
+			gte = model.NewPointer(params.GetAfterDateMillis())
+		}
+		if params.BeforeDate != "" {
+			lt = model.NewPointer(params.GetBeforeDateMillis())
+		}
+		q.Filter = append(q.Filter, rangeQuery("CreateAt", gte, lt))
+	}
+
+	if params.ExcludedAfterDate != "" {
+		q.MustNot = append(q.MustNot, rangeQuery("CreateAt", model.NewPointer(params.GetExcludedAfterDateMillis()), nil))
+	}
+
+	if params.ExcludedBeforeDate != "" {
+		q.MustNot = append(q.MustNot, rangeQuery("CreateAt", nil, model.NewPointer(params.GetExcludedBeforeDateMillis())))
+	}
+
+	if params.ExcludedDate != "" {
+		before, after := params.GetExcludedDateMillis()
+		q.MustNot = append(q.MustNot, rangeQuery("CreateAt", &before, &after))
+	}
+}
+
+func channelIds(channels model.ChannelList) []string {
+	ids := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		ids = append(ids, channel.Id)
+	}
+	return ids
+}
+
+func (e *OpenSearchEngine) IndexPost(post *model.Post, teamId string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	ctx := context.Background()
+	osPost := OSPostFromPost(post, teamId)
+	request := &bulkRequest{}
+	for _, generation := range e.writeGenerations() {
+		index, err := e.ensurePostIndex(ctx, generation, post.CreateAt)
+		if err != nil {
+			return model.NewAppError("Opensearchengine.IndexPost", "opensearchengine.create_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if err := request.index(index, osPost.Id, osPost); err != nil {
+			return model.NewAppError("Opensearchengine.IndexPost", "opensearchengine.index_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(ctx, request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.IndexPost", "opensearchengine.index_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// BulkIndexPosts indexes the posts in the indexes of the generation, deleting the deleted ones.
+func (e *OpenSearchEngine) BulkIndexPosts(generation string, posts []*model.PostForIndexing) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	ctx := context.Background()
+	request := &bulkRequest{}
+	for _, post := range posts {
+		index, err := e.ensurePostIndex(ctx, generation, post.CreateAt)
+		if err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexPosts", "opensearchengine.create_indexes.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if post.DeleteAt == 0 {
+			err = request.index(index, post.Id, OSPostFromPostForIndexing(post))
+		} else {
+			err = request.delete(index, post.Id)
+		}
+		if err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexPosts", "opensearchengine.bulk_index_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(ctx, request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.BulkIndexPosts", "opensearchengine.bulk_index_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) SearchPosts(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.AppError) {
+	postIds, matches, _, appErr := e.SearchPostsWithFacets(channels, searchParams, page, perPage)
+	return postIds, matches, appErr
+}
+
+func (e *OpenSearchEngine) SearchPostsWithFacets(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, model.PostSearchMatches, *model.PostSearchFacets, *model.AppError) {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	q := &boolQuery{
+		Filter: []any{
+			termsQuery("ChannelId", channelIds(channels)),
+			termQuery("Type", ""),
+		},
+	}
+
+	var termQueries []any
+	var notTermQueries []any
+	orTerms := searchParams[0].OrTerms
+
+	for i, params := range searchParams {
+		// Date, channels and FromUsers filters come in all
+		// searchParams iteration, and as they are global to the
+		// query, we only need to process them once
+		if i == 0 {
+			if len(params.InChannels) > 0 {
+				q.Filter = append(q.Filter, termsQuery("ChannelId", params.InChannels))
+			}
+
+			if len(params.ExcludedChannels) > 0 {
+				q.MustNot = append(q.MustNot, termsQuery("ChannelId", params.ExcludedChannels))
+			}
+
+			if len(params.FromUsers) > 0 {
+				q.Filter = append(q.Filter, termsQuery("UserId", params.FromUsers))
+			}
+
+			if len(params.ExcludedUsers) > 0 {
+				q.MustNot = append(q.MustNot, termsQuery("UserId", params.ExcludedUsers))
+			}
+
+			addDateFilters(q, params)
+
+			if params.IsFlagged {
+				// Flags aren't indexed, so the flagged posts are given by id.
+				if len(params.FlaggedPostIds) == 0 {
+					return []string{}, model.PostSearchMatches{}, nil, nil
+				}
+				q.Filter = append(q.Filter, termsQuery("Id", params.FlaggedPostIds))
+			}
+
+			if params.IsPinned {
+				q.Filter = append(q.Filter, termQuery("IsPinned", true))
+			}
+
+			if params.IsRoot {
+				q.Filter = append(q.Filter, termQuery("RootId", ""))
+			} else if params.InThread {
+				q.MustNot = append(q.MustNot, termQuery("RootId", ""))
+			}
+
+			if params.HasFile {
+				q.Filter = append(q.Filter, termQuery("HasFiles", true))
+			}
+
+			if params.HasLink {
+				q.Filter = append(q.Filter, termQuery("HasLink", true))
+			}
+
+			if params.HasReaction {
+				q.Filter = append(q.Filter, termQuery("HasReaction", true))
+			}
+
+			for _, emojiName := range params.ReactedEmojis {
+				q.Filter = append(q.Filter, termQuery("Reactions", emojiName))
+			}
+
+			for _, username := range params.Mentions {
+				q.Filter = append(q.Filter, termQuery("Mentions", strings.ToLower(username)))
+			}
+
+			if len(params.Priorities) > 0 {
+				q.Filter = append(q.Filter, termsQuery("Priority", params.Priorities))
+			}
+		}
+
+		if params.IsHashtag {
+			if params.Terms != "" {
+				termQueries = append(termQueries, matchQuery("Hashtags", params.Terms, orTerms))
+			} else if params.ExcludedTerms != "" {
+				notTermQueries = append(notTermQueries, matchQuery("Hashtags", params.ExcludedTerms, orTerms))
+			}
+		} else {
+			if params.Terms != "" {
+				termQueries = append(termQueries, textQueries([]string{"Message"}, params.Terms, orTerms)...)
+			}
+
+			if params.ExcludedTerms != "" {
+				notTermQueries = append(notTermQueries, matchQuery("Message", params.ExcludedTerms, orTerms))
+			}
+		}
+	}
+
+	if len(termQueries) > 0 || len(notTermQueries) > 0 {
+		allTermsQ := &boolQuery{MustNot: notTermQueries}
+		if orTerms {
+			allTermsQ.Should = termQueries
+			allTermsQ.MinimumShouldMatch = 1
+		} else {
+			allTermsQ.Must = termQueries
+		}
+		q.Must = append(q.Must, allTermsQ.source())
+	}
+
+	now := model.GetMillis()
+	sortByRelevance := searchParams[0].SortBy == model.SearchSortByRelevance
+	if sortByRelevance {
+		q.Should = getPostRelevanceQueries(searchParams[0], now)
+	}
+
+	body := map[string]any{
+		"query":   q.source(),
+		"from":    page * perPage,
+		"size":    perPage,
+		"_source": false,
+		"highlight": map[string]any{
+			"pre_tags":  []string{highlightPreTag},
+			"post_tags": []string{highlightPostTag},
+			"fields": map[string]any{
+				"Message": map[string]any{"number_of_fragments": 0},
+			},
+		},
+	}
+	if sortByRelevance {
+		body["sort"] = []any{map[string]any{"_score": "desc"}, map[string]any{"CreateAt": "desc"}}
+	} else {
+		body["sort"] = []any{map[string]any{"CreateAt": "desc"}}
+	}
+	if searchParams[0].IncludeFacets {
+		body["aggs"] = getPostSearchAggregations(now)
+	}
+
+	result, err := e.client.search(context.Background(), []string{e.aliasName(PostIndex)}, body)
+	if err != nil {
+		return nil, nil, nil, model.NewAppError("Opensearchengine.SearchPosts", "opensearchengine.search_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	postIds := []string{}
+	matches := model.PostSearchMatches{}
+	for _, hit := range result.Hits.Hits {
+		postIds = append(postIds, hit.Id)
+		if postMatches := getHighlightedWords(hit.Highlight["Message"]); len(postMatches) > 0 {
+			matches[hit.Id] = postMatches
+		}
+	}
+
+	var facets *model.PostSearchFacets
+	if searchParams[0].IncludeFacets {
+		facets = getPostSearchFacets(result.Aggregations)
+	}
+
+	return postIds, matches, facets, nil
+}
+
+// getPostRelevanceQueries returns the queries increasing the score of the recent posts, and
+// of those in the channels and from the users the searching user interacts the most with.
+func getPostRelevanceQueries(params *model.SearchParams, now int64) []any {
+	const day = int64(24 * 60 * 60 * 1000)
+
+	// The boosts add up, so the score of a post decays with its age.
+	relevanceQueries := []any{}
+	for _, recency := range []struct {
+		age   int64
+		boost float64
+	}{{day, 1.5}, {7 * day, 1}, {30 * day, 0.5}} {
+		relevanceQueries = append(relevanceQueries, map[string]any{
+			"range": map[string]any{"CreateAt": map[string]any{"gte": now - recency.age, "boost": recency.boost}},
+		})
+	}
+
+	for _, channelId := range params.AffinityChannelIds {
+		relevanceQueries = append(relevanceQueries, termQuery("ChannelId", channelId))
+	}
+
+	for _, userId := range params.AffinityUserIds {
+		relevanceQueries = append(relevanceQueries, termQuery("UserId", userId))
+	}
+
+	return relevanceQueries
+}
+
+func getPostSearchAggregations(now int64) map[string]any {
+	termsAggregation := func(field string) map[string]any {
+		return map[string]any{"terms": map[string]any{"field": field, "size": model.PostSearchFacetSize}}
+	}
+
+	dateRanges := model.PostSearchDateFacetRanges(now)
+	ranges := []any{}
+	for _, name := range model.PostSearchDateFacets {
+		dateRange := map[string]any{"key": name}
+		if dateRanges[name][0] != 0 {
+			dateRange["from"] = dateRanges[name][0]
+		}
+		if dateRanges[name][1] != 0 {
+			dateRange["to"] = dateRanges[name][1]
+		}
+		ranges = append(ranges, dateRange)
+	}
+
+	return map[string]any{
+		"channels":   termsAggregation("ChannelId"),
+		"users":      termsAggregation("UserId"),
+		"file_types": termsAggregation("FileExtensions"),
+		"dates":      map[string]any{"range": map[string]any{"field": "CreateAt", "ranges": ranges}},
+	}
+}
+
+func getPostSearchFacets(aggregations map[string]aggregationResult) *model.PostSearchFacets {
+	bucketFacets := func(name string) []*model.PostSearchFacet {
+		facets := []*model.PostSearchFacet{}
+		for _, bucket := range aggregations[name].Buckets {
+			if bucket.DocCount > 0 {
+				facets = append(facets, &model.PostSearchFacet{Value: fmt.Sprint(bucket.Key), Count: bucket.DocCount})
+			}
+		}
+		return facets
+	}
+
+	return &model.PostSearchFacets{
+		Channels:  bucketFacets("channels"),
+		Users:     bucketFacets("users"),
+		Dates:     bucketFacets("dates"),
+		FileTypes: bucketFacets("file_types"),
+	}
+}
+
+// getHighlightedWords returns the words surrounded by the highlight tags in the fragments.
+func getHighlightedWords(fragments []string) []string {
+	words := []string{}
+	for _, fragment := range fragments {
+		for _, match := range highlightRegex.FindAllStringSubmatch(fragment, -1) {
+			if !slices.Contains(words, match[1]) {
+				words = append(words, match[1])
+			}
+		}
+	}
+	return words
+}
+
+// deletePosts deletes the posts matching the query from the indexes of every generation.
+func (e *OpenSearchEngine) deletePosts(ctx context.Context, query any) (int64, error) {
+	return e.client.deleteByQuery(ctx, []string{e.aliasName(PostIndex), e.reindexAliasName(PostIndex)}, query, 0)
+}
+
+func (e *OpenSearchEngine) DeletePost(post *model.Post) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	request := &bulkRequest{}
+	for _, generation := range e.writeGenerations() {
+		if err := request.delete(e.postIndexName(generation, post.CreateAt), post.Id); err != nil {
+			return model.NewAppError("Opensearchengine.DeletePost", "opensearchengine.delete_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(context.Background(), request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.DeletePost", "opensearchengine.delete_post.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) DeleteChannelPosts(rctx request.CTX, channelID string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	deleted, err := e.deletePosts(rctx.Context(), termQuery("ChannelId", channelID))
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DeleteChannelPosts", "opensearchengine.delete_channel_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Posts for channel deleted", mlog.String("channel_id", channelID), mlog.Int("deleted", deleted))
+
+	return nil
+}
+
+func (e *OpenSearchEngine) DeleteUserPosts(rctx request.CTX, userID string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	deleted, err := e.deletePosts(rctx.Context(), termQuery("UserId", userID))
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DeleteUserPosts", "opensearchengine.delete_user_posts.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Posts for user deleted", mlog.String("user_id", userID), mlog.Int("deleted", deleted))
+
+	return nil
+}
+
+// indexDocument indexes the document in the index of the kind of every generation written to.
+func (e *OpenSearchEngine) indexDocument(ctx context.Context, kind, id string, doc any) error {
+	request := &bulkRequest{}
+	for _, generation := range e.writeGenerations() {
+		if err := request.index(e.indexName(kind, generation), id, doc); err != nil {
+			return err
+		}
+	}
+	return e.client.bulk(ctx, request, e.indexSync)
+}
+
+// deleteDocument deletes the document from the index of the kind of every generation written to.
+func (e *OpenSearchEngine) deleteDocument(ctx context.Context, kind, id string) error {
+	request := &bulkRequest{}
+	for _, generation := range e.writeGenerations() {
+		if err := request.delete(e.indexName(kind, generation), id); err != nil {
+			return err
+		}
+	}
+	return e.client.bulk(ctx, request, e.indexSync)
+}
+
+func (e *OpenSearchEngine) IndexChannel(_ request.CTX, channel *model.Channel, userIDs, teamMemberIDs []string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	osChannel := OSChannelFromChannel(channel, userIDs, teamMemberIDs)
+	if err := e.indexDocument(context.Background(), ChannelIndex, osChannel.Id, osChannel); err != nil {
+		return model.NewAppError("Opensearchengine.IndexChannel", "opensearchengine.index_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// BulkIndexChannels indexes the channels in the index of the generation, deleting the ones
+// with the deleted ids.
+func (e *OpenSearchEngine) BulkIndexChannels(generation string, channels []*OSChannel, deletedIds []string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	index := e.indexName(ChannelIndex, generation)
+	request := &bulkRequest{}
+	for _, channel := range channels {
+		if err := request.index(index, channel.Id, channel); err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexChannels", "opensearchengine.bulk_index_channels.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	for _, id := range deletedIds {
+		if err := request.delete(index, id); err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexChannels", "opensearchengine.bulk_index_channels.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(context.Background(), request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.BulkIndexChannels", "opensearchengine.bulk_index_channels.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) SearchChannels(teamId, userID, term string, isGuest bool) ([]string, *model.AppError) {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	q := &boolQuery{}
+	if teamId != "" {
+		q.Filter = append(q.Filter, termQuery("TeamId", teamId))
+	} else {
+		q.Filter = append(q.Filter, termQuery("TeamMemberIDs", userID))
+	}
+
+	if isGuest {
+		q.Filter = append(q.Filter, termQuery("UserIDs", userID))
+	} else {
+		notPrivateQ := &boolQuery{MustNot: []any{termQuery("Type", model.ChannelTypePrivate)}}
+		privateMemberQ := &boolQuery{Filter: []any{termQuery("Type", model.ChannelTypePrivate), termQuery("UserIDs", userID)}}
+		channelTypeQ := &boolQuery{Should: []any{notPrivateQ.source(), privateMemberQ.source()}, MinimumShouldMatch: 1}
+		q.Filter = append(q.Filter, channelTypeQ.source())
+	}
+
+	if term != "" {
+		q.Filter = append(q.Filter, map[string]any{"prefix": map[string]any{"NameSuggest": strings.ToLower(term)}})
+	}
+
+	body := map[string]any{
+		"query":   q.source(),
+		"size":    model.ChannelSearchDefaultLimit,
+		"_source": false,
+	}
+	result, err := e.client.search(context.Background(), []string{e.aliasName(ChannelIndex)}, body)
+	if err != nil {
+		return nil, model.NewAppError("Opensearchengine.SearchChannels", "opensearchengine.search_channels.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	channelIds := []string{}
+	for _, hit := range result.Hits.Hits {
+		channelIds = append(channelIds, hit.Id)
+	}
+
+	return channelIds, nil
+}
+
+func (e *OpenSearchEngine) DeleteChannel(channel *model.Channel) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	if err := e.deleteDocument(context.Background(), ChannelIndex, channel.Id); err != nil {
+		return model.NewAppError("Opensearchengine.DeleteChannel", "opensearchengine.delete_channel.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) IndexUser(_ request.CTX, user *model.User, teamsIds, channelsIds []string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	osUser := OSUserFromUserAndTeams(user, teamsIds, channelsIds)
+	if err := e.indexDocument(context.Background(), UserIndex, osUser.Id, osUser); err != nil {
+		return model.NewAppError("Opensearchengine.IndexUser", "opensearchengine.index_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// BulkIndexUsers indexes the users in the index of the generation, deleting the deleted ones.
+func (e *OpenSearchEngine) BulkIndexUsers(generation string, users []*model.UserForIndexing) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	index := e.indexName(UserIndex, generation)
+	request := &bulkRequest{}
+	for _, user := range users {
+		var err error
+		if user.DeleteAt == 0 {
+			err = request.index(index, user.Id, OSUserFromUserForIndexing(user))
+		} else {
+			err = request.delete(index, user.Id)
+		}
+		if err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexUsers", "opensearchengine.bulk_index_users.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(context.Background(), request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.BulkIndexUsers", "opensearchengine.bulk_index_users.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func userSuggestionsField(options *model.UserSearchOptions) string {
+	if options.AllowFullNames {
+		return "SuggestionsWithFullname"
+	}
+	return "SuggestionsWithoutFullname"
+}
+
+func (e *OpenSearchEngine) searchUserIds(q *boolQuery, limit int) ([]string, error) {
+	body := map[string]any{
+		"query":   q.source(),
+		"size":    limit,
+		"_source": false,
+	}
+	result, err := e.client.search(context.Background(), []string{e.aliasName(UserIndex)}, body)
+	if err != nil {
+		return nil, err
+	}
+
+	userIds := []string{}
+	for _, hit := range result.Hits.Hits {
+		userIds = append(userIds, hit.Id)
+	}
+	return userIds, nil
+}
+
+func (e *OpenSearchEngine) SearchUsersInChannel(teamId, channelId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, []string, *model.AppError) {
+	if restrictedToChannels != nil && len(restrictedToChannels) == 0 {
+		return []string{}, []string{}, nil
+	}
+
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	// users in channel
+	uchanQ := &boolQuery{Filter: []any{termQuery("ChannelsIds", channelId)}}
+	if term != "" {
+		uchanQ.Filter = append(uchanQ.Filter, map[string]any{"prefix": map[string]any{userSuggestionsField(options): strings.ToLower(term)}})
+	}
+
+	uchanIds, err := e.searchUserIds(uchanQ, options.Limit)
+	if err != nil {
+		return nil, nil, model.NewAppError("Opensearchengine.SearchUsersInChannel", "opensearchengine.search_users_in_channel.uchan.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// users not in channel
+	nuchanQ := &boolQuery{
+		Filter:  []any{termQuery("TeamsIds", teamId)},
+		MustNot: []any{termQuery("ChannelsIds", channelId)},
+	}
+	if term != "" {
+		nuchanQ.Filter = append(nuchanQ.Filter, map[string]any{"prefix": map[string]any{userSuggestionsField(options): strings.ToLower(term)}})
+	}
+	if len(restrictedToChannels) > 0 {
+		nuchanQ.Filter = append(nuchanQ.Filter, termsQuery("ChannelsIds", restrictedToChannels))
+	}
+
+	nuchanIds, err := e.searchUserIds(nuchanQ, options.Limit)
+	if err != nil {
+		return nil, nil, model.NewAppError("Opensearchengine.SearchUsersInChannel", "opensearchengine.search_users_in_channel.nuchan.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return uchanIds, nuchanIds, nil
+}
+
+func (e *OpenSearchEngine) SearchUsersInTeam(teamId string, restrictedToChannels []string, term string, options *model.UserSearchOptions) ([]string, *model.AppError) {
+	if restrictedToChannels != nil && len(restrictedToChannels) == 0 {
+		return []string{}, nil
+	}
+
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	q := &boolQuery{}
+	if term != "" {
+		q.Filter = append(q.Filter, map[string]any{"prefix": map[string]any{userSuggestionsField(options): strings.ToLower(term)}})
+	}
+
+	if len(restrictedToChannels) > 0 {
+		// restricted channels are already filtered by team, so we
+		// can search only those matches
+		q.Filter = append(q.Filter, termsQuery("ChannelsIds", restrictedToChannels))
+	} else if teamId != "" {
+		// this means that we only need to restrict by team
+		q.Filter = append(q.Filter, termQuery("TeamsIds", teamId))
+	}
+
+	usersIds, err := e.searchUserIds(q, options.Limit)
+	if err != nil {
+		return nil, model.NewAppError("Opensearchengine.SearchUsersInTeam", "opensearchengine.search_users_in_team.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return usersIds, nil
+}
+
+func (e *OpenSearchEngine) DeleteUser(user *model.User) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	if err := e.deleteDocument(context.Background(), UserIndex, user.Id); err != nil {
+		return model.NewAppError("Opensearchengine.DeleteUser", "opensearchengine.delete_user.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) IndexFile(file *model.FileInfo, channelId string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	osFile := OSFileFromFileInfo(file, channelId)
+	if err := e.indexDocument(context.Background(), FileIndex, osFile.Id, osFile); err != nil {
+		return model.NewAppError("Opensearchengine.IndexFile", "opensearchengine.index_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// BulkIndexFiles indexes the files in the index of the generation, deleting the ones which
+// shouldn't be indexed.
+func (e *OpenSearchEngine) BulkIndexFiles(generation string, files []*model.FileForIndexing) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	index := e.indexName(FileIndex, generation)
+	request := &bulkRequest{}
+	for _, file := range files {
+		var err error
+		if file.ShouldIndex() {
+			err = request.index(index, file.Id, OSFileFromFileForIndexing(file))
+		} else {
+			err = request.delete(index, file.Id)
+		}
+		if err != nil {
+			return model.NewAppError("Opensearchengine.BulkIndexFiles", "opensearchengine.bulk_index_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if err := e.client.bulk(context.Background(), request, e.indexSync); err != nil {
+		return model.NewAppError("Opensearchengine.BulkIndexFiles", "opensearchengine.bulk_index_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+func (e *OpenSearchEngine) SearchFiles(channels model.ChannelList, searchParams []*model.SearchParams, page, perPage int) ([]string, *model.AppError) {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	q := &boolQuery{Filter: []any{termsQuery("ChannelId", channelIds(channels))}}
+
+	var termQueries []any
+	var notTermQueries []any
+	orTerms := searchParams[0].OrTerms
+	textFields := []string{"Name", "Content"}
+
+	for i, params := range searchParams {
+		// Date, channels and FromUsers filters come in all
+		// searchParams iteration, and as they are global to the
+		// query, we only need to process them once
+		if i == 0 {
+			if len(params.InChannels) > 0 {
+				q.Filter = append(q.Filter, termsQuery("ChannelId", params.InChannels))
+			}
+
+			if len(params.ExcludedChannels) > 0 {
+				q.MustNot = append(q.MustNot, termsQuery("ChannelId", params.ExcludedChannels))
+			}
+
+			if len(params.FromUsers) > 0 {
+				q.Filter = append(q.Filter, termsQuery("CreatorId", params.FromUsers))
+			}
+
+			if len(params.ExcludedUsers) > 0 {
+				q.MustNot = append(q.MustNot, termsQuery("CreatorId", params.ExcludedUsers))
+			}
+
+			if len(params.Extensions) > 0 {
+				q.Filter = append(q.Filter, termsQuery("Extension", params.Extensions))
+			}
+
+			if len(params.ExcludedExtensions) > 0 {
+				q.MustNot = append(q.MustNot, termsQuery("Extension", params.ExcludedExtensions))
+			}
+
+			addDateFilters(q, params)
+		}
+
+		if params.Terms != "" {
+			termQueries = append(termQueries, textQueries(textFields, params.Terms, orTerms)...)
+		}
+
+		if params.ExcludedTerms != "" {
+			notTermQueries = append(notTermQueries, (&boolQuery{
+				Should:             []any{matchQuery("Name", params.ExcludedTerms, orTerms), matchQuery("Content", params.ExcludedTerms, orTerms)},
+				MinimumShouldMatch: 1,
+			}).source())
+		}
+	}
+
+	if len(termQueries) > 0 || len(notTermQueries) > 0 {
+		allTermsQ := &boolQuery{MustNot: notTermQueries}
+		if orTerms {
+			allTermsQ.Should = termQueries
+			allTermsQ.MinimumShouldMatch = 1
+		} else {
+			allTermsQ.Must = termQueries
+		}
+		q.Must = append(q.Must, allTermsQ.source())
+	}
+
+	body := map[string]any{
+		"query":   q.source(),
+		"from":    page * perPage,
+		"size":    perPage,
+		"sort":    []any{map[string]any{"CreateAt": "desc"}},
+		"_source": false,
+	}
+	result, err := e.client.search(context.Background(), []string{e.aliasName(FileIndex)}, body)
+	if err != nil {
+		return nil, model.NewAppError("Opensearchengine.SearchFiles", "opensearchengine.search_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	fileIds := []string{}
+	for _, hit := range result.Hits.Hits {
+		fileIds = append(fileIds, hit.Id)
+	}
+
+	return fileIds, nil
+}
+
+func (e *OpenSearchEngine) DeleteFile(fileID string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	if err := e.deleteDocument(context.Background(), FileIndex, fileID); err != nil {
+		return model.NewAppError("Opensearchengine.DeleteFile", "opensearchengine.delete_file.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return nil
+}
+
+// deleteFiles deletes the files matching the query from the indexes of every generation, up
+// to the limit if it isn't zero.
+func (e *OpenSearchEngine) deleteFiles(ctx context.Context, query any, limit int64) (int64, error) {
+	return e.client.deleteByQuery(ctx, []string{e.aliasName(FileIndex), e.reindexAliasName(FileIndex)}, query, limit)
+}
+
+func (e *OpenSearchEngine) DeleteUserFiles(rctx request.CTX, userID string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	deleted, err := e.deleteFiles(rctx.Context(), termQuery("CreatorId", userID), 0)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DeleteUserFiles", "opensearchengine.delete_user_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Files for user deleted", mlog.String("user_id", userID), mlog.Int("deleted", deleted))
+
+	return nil
+}
+
+func (e *OpenSearchEngine) DeletePostFiles(rctx request.CTX, postID string) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	deleted, err := e.deleteFiles(rctx.Context(), termQuery("PostId", postID), 0)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DeletePostFiles", "opensearchengine.delete_post_files.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Files for post deleted", mlog.String("post_id", postID), mlog.Int("deleted", deleted))
+
+	return nil
+}
+
+func (e *OpenSearchEngine) DeleteFilesBatch(rctx request.CTX, endTime, limit int64) *model.AppError {
+	e.Mutex.RLock()
+	defer e.Mutex.RUnlock()
+
+	deleted, err := e.deleteFiles(rctx.Context(), rangeQuery("CreateAt", nil, &endTime), limit)
+	if err != nil {
+		return model.NewAppError("Opensearchengine.DeleteFilesBatch", "opensearchengine.delete_files_batch.error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	rctx.Logger().Info("Files in batch deleted", mlog.Int("endTime", endTime), mlog.Int("limit", limit), mlog.Int("deleted", deleted))
+
+	return nil
+}
@@ -51,6 +51,7 @@ const (
 	TrackConfigNotificationLog     = "config_notifications_log"
 	TrackConfigFile                = "config_file"
 	TrackConfigRate                = "config_rate"
+	TrackConfigIPFiltering         = "config_ip_filtering"
 	TrackConfigEmail               = "config_email"
 	TrackConfigPrivacy             = "config_privacy"
 	TrackConfigTheme               = "config_theme"
@@ -58,6 +59,7 @@ const (
 	TrackConfigLDAP                = "config_ldap"
 	TrackConfigCompliance          = "config_compliance"
 	TrackConfigLocalization        = "config_localization"
+	TrackConfigTranslation         = "config_translation"
 	TrackConfigSAML                = "config_saml"
 	TrackConfigPassword            = "config_password"
 	TrackConfigCluster             = "config_cluster"
@@ -484,6 +486,8 @@ func (ts *TelemetryService) trackConfig() {
 		"maximum_login_attempts":                                  *cfg.ServiceSettings.MaximumLoginAttempts,
 		"extend_session_length_with_activity":                     *cfg.ServiceSettings.ExtendSessionLengthWithActivity,
 		"terminate_sessions_on_password_change":                   *cfg.ServiceSettings.TerminateSessionsOnPasswordChange,
+		"enable_new_login_location_alerts":                        *cfg.ServiceSettings.EnableNewLoginLocationAlerts,
+		"require_new_login_location_verification":                 *cfg.ServiceSettings.RequireNewLoginLocationVerification,
 		"session_length_web_in_hours":                             *cfg.ServiceSettings.SessionLengthWebInHours,
 		"session_length_mobile_in_hours":                          *cfg.ServiceSettings.SessionLengthMobileInHours,
 		"session_length_sso_in_hours":                             *cfg.ServiceSettings.SessionLengthSSOInHours,
@@ -507,6 +511,7 @@ func (ts *TelemetryService) trackConfig() {
 		"time_between_user_typing_updates_milliseconds":           *cfg.ServiceSettings.TimeBetweenUserTypingUpdatesMilliseconds,
 		"cluster_log_timeout_milliseconds":                        *cfg.ServiceSettings.ClusterLogTimeoutMilliseconds,
 		"enable_post_search":                                      *cfg.ServiceSettings.EnablePostSearch,
+		"enable_saved_search_alerts":                              *cfg.ServiceSettings.EnableSavedSearchAlerts,
 		"minimum_hashtag_length":                                  *cfg.ServiceSettings.MinimumHashtagLength,
 		"enable_user_statuses":                                    *cfg.ServiceSettings.EnableUserStatuses,
 		"enable_tutorial":                                         *cfg.ServiceSettings.EnableTutorial,
@@ -544,6 +549,8 @@ func (ts *TelemetryService) trackConfig() {
 		"persistent_notification_interval_minutes":                *cfg.ServiceSettings.PersistentNotificationIntervalMinutes,
 		"persistent_notification_max_count":                       *cfg.ServiceSettings.PersistentNotificationMaxCount,
 		"persistent_notification_max_recipients":                  *cfg.ServiceSettings.PersistentNotificationMaxRecipients,
+		"enable_read_receipts":                                    *cfg.ServiceSettings.EnableReadReceipts,
+		"read_receipts_max_channel_members":                       *cfg.ServiceSettings.ReadReceiptsMaxChannelMembers,
 		"allow_synced_drafts":                                     *cfg.ServiceSettings.AllowSyncedDrafts,
 		"refresh_post_stats_run_time":                             *cfg.ServiceSettings.RefreshPostStatsRunTime,
 		"maximum_payload_size":                                    *cfg.ServiceSettings.MaximumPayloadSizeBytes,
@@ -609,13 +616,16 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigAudit, map[string]any{
-		"file_enabled":          *cfg.ExperimentalAuditSettings.FileEnabled,
-		"file_max_size_mb":      *cfg.ExperimentalAuditSettings.FileMaxSizeMB,
-		"file_max_age_days":     *cfg.ExperimentalAuditSettings.FileMaxAgeDays,
-		"file_max_backups":      *cfg.ExperimentalAuditSettings.FileMaxBackups,
-		"file_compress":         *cfg.ExperimentalAuditSettings.FileCompress,
-		"file_max_queue_size":   *cfg.ExperimentalAuditSettings.FileMaxQueueSize,
-		"advanced_logging_json": len(cfg.ExperimentalAuditSettings.AdvancedLoggingJSON) != 0,
+		"file_enabled":                   *cfg.ExperimentalAuditSettings.FileEnabled,
+		"file_max_size_mb":               *cfg.ExperimentalAuditSettings.FileMaxSizeMB,
+		"file_max_age_days":              *cfg.ExperimentalAuditSettings.FileMaxAgeDays,
+		"file_max_backups":               *cfg.ExperimentalAuditSettings.FileMaxBackups,
+		"file_compress":                  *cfg.ExperimentalAuditSettings.FileCompress,
+		"file_max_queue_size":            *cfg.ExperimentalAuditSettings.FileMaxQueueSize,
+		"advanced_logging_json":          len(cfg.ExperimentalAuditSettings.AdvancedLoggingJSON) != 0,
+		"hash_chain_enabled":             *cfg.ExperimentalAuditSettings.HashChainEnabled,
+		"hash_chain_checkpoint_interval": *cfg.ExperimentalAuditSettings.HashChainCheckpointInterval,
+		"database_enabled":               *cfg.ExperimentalAuditSettings.DatabaseEnabled,
 	})
 
 	ts.SendTelemetry(TrackConfigNotificationLog, map[string]any{
@@ -693,6 +703,13 @@ func (ts *TelemetryService) trackConfig() {
 		"isdefault_vary_by_header": isDefault(cfg.RateLimitSettings.VaryByHeader, ""),
 	})
 
+	ts.SendTelemetry(TrackConfigIPFiltering, map[string]any{
+		"enable":                   *cfg.IPFilteringSettings.Enable,
+		"isdefault_allowed_ranges": isDefault(*cfg.IPFilteringSettings.AllowedIPRanges, ""),
+		"isdefault_denied_ranges":  isDefault(*cfg.IPFilteringSettings.DeniedIPRanges, ""),
+		"role_ranges_count":        len(cfg.IPFilteringSettings.RoleIPRanges),
+	})
+
 	ts.SendTelemetry(TrackConfigPrivacy, map[string]any{
 		"show_email_address": cfg.PrivacySettings.ShowEmailAddress,
 		"show_full_name":     cfg.PrivacySettings.ShowFullName,
@@ -769,6 +786,12 @@ func (ts *TelemetryService) trackConfig() {
 		"available_locales":     *cfg.LocalizationSettings.AvailableLocales,
 	})
 
+	ts.SendTelemetry(TrackConfigTranslation, map[string]any{
+		"enable":                  *cfg.TranslationSettings.Enable,
+		"provider":                *cfg.TranslationSettings.Provider,
+		"request_timeout_seconds": *cfg.TranslationSettings.RequestTimeoutSeconds,
+	})
+
 	ts.SendTelemetry(TrackConfigSAML, map[string]any{
 		"enable":                              *cfg.SamlSettings.Enable,
 		"enable_sync_with_ldap":               *cfg.SamlSettings.EnableSyncWithLdap,
@@ -913,6 +936,9 @@ func (ts *TelemetryService) trackConfig() {
 		"allow_email_accounts":                   *cfg.GuestAccountsSettings.AllowEmailAccounts,
 		"enforce_multifactor_authentication":     *cfg.GuestAccountsSettings.EnforceMultifactorAuthentication,
 		"isdefault_restrict_creation_to_domains": isDefault(*cfg.GuestAccountsSettings.RestrictCreationToDomains, ""),
+		"expiry_days":                            *cfg.GuestAccountsSettings.ExpiryDays,
+		"expiry_warning_days":                    *cfg.GuestAccountsSettings.ExpiryWarningDays,
+		"require_invite_approval":                *cfg.GuestAccountsSettings.RequireInviteApproval,
 	})
 
 	ts.SendTelemetry(TrackConfigImageProxy, map[string]any{
@@ -927,10 +953,15 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_searching":         *cfg.BleveSettings.EnableSearching,
 		"enable_autocomplete":      *cfg.BleveSettings.EnableAutocomplete,
 		"bulk_indexing_batch_size": *cfg.BleveSettings.BatchSize,
+		"text_analyzer":            *cfg.BleveSettings.TextAnalyzer,
+		"detect_post_language":     *cfg.BleveSettings.DetectPostLanguage,
 	})
 
 	ts.SendTelemetry(TrackConfigExport, map[string]any{
-		"retention_days": *cfg.ExportSettings.RetentionDays,
+		"retention_days":                     *cfg.ExportSettings.RetentionDays,
+		"enable_user_data_export":            *cfg.ExportSettings.EnableUserDataExport,
+		"user_data_export_interval_hours":    *cfg.ExportSettings.UserDataExportIntervalHours,
+		"user_data_export_link_expiry_hours": *cfg.ExportSettings.UserDataExportLinkExpiryHours,
 	})
 
 	ts.SendTelemetry(TrackConfigWrangler, map[string]any{
@@ -1455,18 +1486,25 @@ func (ts *TelemetryService) Shutdown() error {
 
 func (ts *TelemetryService) trackPluginConfig(cfg *model.Config, marketplaceURL string) {
 	pluginConfigData := map[string]any{
-		"enable_nps_survey":             pluginSetting(&cfg.PluginSettings, model.PluginIdNPS, "enablesurvey", true),
-		"enable":                        *cfg.PluginSettings.Enable,
-		"enable_uploads":                *cfg.PluginSettings.EnableUploads,
-		"allow_insecure_download_url":   *cfg.PluginSettings.AllowInsecureDownloadURL,
-		"enable_health_check":           *cfg.PluginSettings.EnableHealthCheck,
-		"enable_marketplace":            *cfg.PluginSettings.EnableMarketplace,
-		"require_pluginSignature":       *cfg.PluginSettings.RequirePluginSignature,
-		"enable_remote_marketplace":     *cfg.PluginSettings.EnableRemoteMarketplace,
-		"automatic_prepackaged_plugins": *cfg.PluginSettings.AutomaticPrepackagedPlugins,
-		"is_default_marketplace_url":    isDefault(*cfg.PluginSettings.MarketplaceURL, model.PluginSettingsDefaultMarketplaceURL),
-		"signature_public_key_files":    len(cfg.PluginSettings.SignaturePublicKeyFiles),
-		"chimera_oauth_proxy_url":       *cfg.PluginSettings.ChimeraOAuthProxyURL,
+		"enable_nps_survey":                     pluginSetting(&cfg.PluginSettings, model.PluginIdNPS, "enablesurvey", true),
+		"enable":                                *cfg.PluginSettings.Enable,
+		"enable_uploads":                        *cfg.PluginSettings.EnableUploads,
+		"allow_insecure_download_url":           *cfg.PluginSettings.AllowInsecureDownloadURL,
+		"enable_health_check":                   *cfg.PluginSettings.EnableHealthCheck,
+		"enable_marketplace":                    *cfg.PluginSettings.EnableMarketplace,
+		"require_pluginSignature":               *cfg.PluginSettings.RequirePluginSignature,
+		"enable_remote_marketplace":             *cfg.PluginSettings.EnableRemoteMarketplace,
+		"automatic_prepackaged_plugins":         *cfg.PluginSettings.AutomaticPrepackagedPlugins,
+		"is_default_marketplace_url":            isDefault(*cfg.PluginSettings.MarketplaceURL, model.PluginSettingsDefaultMarketplaceURL),
+		"signature_public_key_files":            len(cfg.PluginSettings.SignaturePublicKeyFiles),
+		"chimera_oauth_proxy_url":               *cfg.PluginSettings.ChimeraOAuthProxyURL,
+		"enforce_capabilities":                  *cfg.PluginSettings.EnforceCapabilities,
+		"hook_timeout_seconds":                  *cfg.PluginSettings.HookTimeoutSeconds,
+		"message_hook_timeout_milliseconds":     *cfg.PluginSettings.MessageHookTimeoutMilliseconds,
+		"hook_failure_threshold":                *cfg.PluginSettings.HookFailureThreshold,
+		"hook_circuit_breaker_cooldown_seconds": *cfg.PluginSettings.HookCircuitBreakerCooldownSeconds,
+		"wasm_memory_limit_mb":                  *cfg.PluginSettings.WasmMemoryLimitMB,
+		"wasm_max_execution_seconds":            *cfg.PluginSettings.WasmMaxExecutionSeconds,
 	}
 
 	// knownPluginIDs lists all known plugin IDs in the Marketplace
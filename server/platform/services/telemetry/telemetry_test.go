@@ -496,12 +496,14 @@ func TestRudderTelemetry(t *testing.T) {
 			TrackConfigNotificationLog,
 			TrackConfigFile,
 			TrackConfigRate,
+			TrackConfigIPFiltering,
 			TrackConfigEmail,
 			TrackConfigPrivacy,
 			TrackConfigOAuth,
 			TrackConfigLDAP,
 			TrackConfigCompliance,
 			TrackConfigLocalization,
+			TrackConfigTranslation,
 			TrackConfigSAML,
 			TrackConfigPassword,
 			TrackConfigCluster,
@@ -539,12 +541,14 @@ func TestRudderTelemetry(t *testing.T) {
 			TrackConfigNotificationLog,
 			TrackConfigFile,
 			TrackConfigRate,
+			TrackConfigIPFiltering,
 			TrackConfigEmail,
 			TrackConfigPrivacy,
 			TrackConfigOAuth,
 			TrackConfigLDAP,
 			TrackConfigCompliance,
 			TrackConfigLocalization,
+			TrackConfigTranslation,
 			TrackConfigSAML,
 			TrackConfigPassword,
 			TrackConfigCluster,
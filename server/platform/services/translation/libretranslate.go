@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// LibreTranslateProvider translates texts through the API of LibreTranslate, or of any service
+// compatible with it.
+type LibreTranslateProvider struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+}
+
+func NewLibreTranslateProvider(client *http.Client, baseURL, apiKey string) *LibreTranslateProvider {
+	return &LibreTranslateProvider{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+	}
+}
+
+func (p *LibreTranslateProvider) Name() string {
+	return model.TranslationProviderLibreTranslate
+}
+
+type libreTranslateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+	APIKey string   `json:"api_key,omitempty"`
+}
+
+type libreTranslateResponse struct {
+	TranslatedText   []string `json:"translatedText"`
+	DetectedLanguage []struct {
+		Language string `json:"language"`
+	} `json:"detectedLanguage"`
+	Error string `json:"error"`
+}
+
+func (p *LibreTranslateProvider) Translate(ctx context.Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error) {
+	if len(texts) == 0 {
+		return []*model.TextTranslation{}, nil
+	}
+
+	source := "auto"
+	if sourceLanguage != "" {
+		source = LibreTranslateLanguage(sourceLanguage)
+	}
+
+	body, err := json.Marshal(libreTranslateRequest{
+		Q:      texts,
+		Source: source,
+		Target: LibreTranslateLanguage(targetLocale),
+		Format: "text",
+		APIKey: p.apiKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/translate", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result libreTranslateResponse
+	decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		if result.Error != "" {
+			return nil, fmt.Errorf("translation failed with status %d: %s", resp.StatusCode, result.Error)
+		}
+		return nil, fmt.Errorf("translation failed with status %d", resp.StatusCode)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("failed to decode the translations: %w", decodeErr)
+	}
+	if len(result.TranslatedText) != len(texts) {
+		return nil, fmt.Errorf("got %d translations for %d texts", len(result.TranslatedText), len(texts))
+	}
+
+	translations := make([]*model.TextTranslation, len(texts))
+	for i, text := range result.TranslatedText {
+		translations[i] = &model.TextTranslation{Text: text, SourceLanguage: sourceLanguage}
+		// The detected languages are only returned when the source language is detected.
+		if i < len(result.DetectedLanguage) {
+			translations[i].SourceLanguage = result.DetectedLanguage[i].Language
+		}
+	}
+	return translations, nil
+}
+
+// LibreTranslateLanguage returns the LibreTranslate code of the language of a locale. The region
+// of the locale is dropped, except to tell traditional Chinese apart.
+func LibreTranslateLanguage(locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	switch locale {
+	case "zh-tw", "zh-hk", "zh-hant":
+		return "zt"
+	}
+
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
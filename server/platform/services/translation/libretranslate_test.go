@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/translation/translationtest"
+)
+
+func TestLibreTranslateProvider(t *testing.T) {
+	server := translationtest.NewServer()
+	defer server.Close()
+	server.APIKey = "secret"
+
+	provider := NewLibreTranslateProvider(http.DefaultClient, server.URL+"/", "secret")
+
+	t.Run("should translate the texts detecting their language", func(t *testing.T) {
+		translations, err := provider.Translate(context.Background(), []string{"hello", "world"}, "", "pt-BR")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.TextTranslation{
+			{Text: "[pt] hello", SourceLanguage: translationtest.SourceLanguage},
+			{Text: "[pt] world", SourceLanguage: translationtest.SourceLanguage},
+		}, translations)
+
+		requests := server.Requests()
+		require.NotEmpty(t, requests)
+		assert.Equal(t, translationtest.Request{Texts: []string{"hello", "world"}, Source: "auto", Target: "pt", APIKey: "secret"}, requests[len(requests)-1])
+	})
+
+	t.Run("should translate from the given language", func(t *testing.T) {
+		translations, err := provider.Translate(context.Background(), []string{"hallo"}, "de", "zh-TW")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.TextTranslation{{Text: "[zt] hallo", SourceLanguage: "de"}}, translations)
+	})
+
+	t.Run("should not send a request without texts", func(t *testing.T) {
+		count := len(server.Requests())
+		translations, err := provider.Translate(context.Background(), nil, "", "de")
+		require.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.Len(t, server.Requests(), count)
+	})
+
+	t.Run("should return the error of the server", func(t *testing.T) {
+		provider := NewLibreTranslateProvider(http.DefaultClient, server.URL, "wrong")
+		_, err := provider.Translate(context.Background(), []string{"hello"}, "", "de")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid API key")
+	})
+}
+
+func TestLibreTranslateLanguage(t *testing.T) {
+	for locale, expected := range map[string]string{
+		"en":    "en",
+		"pt-BR": "pt",
+		"zh-CN": "zh",
+		"zh-TW": "zt",
+		"zh_TW": "zt",
+		"de":    "de",
+	} {
+		assert.Equal(t, expected, LibreTranslateLanguage(locale), locale)
+	}
+}
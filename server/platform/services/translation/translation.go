@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package translation
+
+import (
+	"context"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// Provider translates texts between languages.
+type Provider interface {
+	// Name returns the name of the provider, stored with the translations it makes.
+	Name() string
+
+	// Translate translates the texts into the target locale, returning the translations in
+	// the order of the texts. The source language is detected if it's empty.
+	Translate(ctx context.Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error)
+}
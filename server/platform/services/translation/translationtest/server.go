@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package translationtest provides a stand-in of a LibreTranslate server, so translations can be
+// tested without running one.
+package translationtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+)
+
+// SourceLanguage is the language the server detects in every text.
+const SourceLanguage = "en"
+
+// Request is a translation request received by the server.
+type Request struct {
+	Texts  []string
+	Source string
+	Target string
+	APIKey string
+}
+
+// Server serves the translate endpoint of the LibreTranslate API. It translates a text by
+// prefixing it with the target language, e.g. "hello" into German is "[de] hello".
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the key the requests must have, if not empty.
+	APIKey string
+
+	mut      sync.Mutex
+	requests []Request
+}
+
+// NewServer starts a server, which the caller must close.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Requests returns the translation requests received by the server so far.
+func (s *Server) Requests() []Request {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// Translate returns the translation the server makes of the text.
+func Translate(text, target string) string {
+	return "[" + target + "] " + text
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/translate" {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	var body struct {
+		Q      []string `json:"q"`
+		Source string   `json:"source"`
+		Target string   `json:"target"`
+		APIKey string   `json:"api_key"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mut.Lock()
+	s.requests = append(s.requests, Request{Texts: body.Q, Source: body.Source, Target: body.Target, APIKey: body.APIKey})
+	s.mut.Unlock()
+
+	if s.APIKey != "" && body.APIKey != s.APIKey {
+		writeError(w, http.StatusForbidden, "Invalid API key")
+		return
+	}
+	if body.Target == "" {
+		writeError(w, http.StatusBadRequest, "Invalid request: missing target parameter")
+		return
+	}
+
+	response := map[string]any{}
+	translated := make([]string, len(body.Q))
+	detected := make([]map[string]any, len(body.Q))
+	for i, text := range body.Q {
+		translated[i] = Translate(text, body.Target)
+		detected[i] = map[string]any{"language": SourceLanguage, "confidence": 90}
+	}
+	response["translatedText"] = translated
+	if body.Source == "auto" {
+		response["detectedLanguage"] = detected
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	AuditEventNameMaxRunes = 128
+
+	AuditEventSearchDefaultPerPage = 60
+	AuditEventSearchMaxPerPage     = 200
+)
+
+// AuditEvent is an audit record persisted to the database so that it can be
+// queried without shipping audit logs elsewhere. Record holds the complete
+// record as written to the audit log.
+type AuditEvent struct {
+	Id         string          `json:"id"`
+	CreateAt   int64           `json:"create_at"`
+	EventName  string          `json:"event_name"`
+	Status     string          `json:"status"`
+	Level      string          `json:"level"`
+	UserId     string          `json:"user_id"`
+	SessionId  string          `json:"session_id"`
+	IpAddress  string          `json:"ip_address"`
+	ApiPath    string          `json:"api_path"`
+	ObjectType string          `json:"object_type"`
+	ChainId    string          `json:"chain_id,omitempty"`
+	Sequence   int64           `json:"sequence,omitempty"`
+	Hash       string          `json:"hash,omitempty"`
+	Record     json.RawMessage `json:"record"`
+}
+
+// AuditEventSearchOpts filters audit events. Empty fields are not filtered on.
+// Since and Until are inclusive bounds on CreateAt, in milliseconds.
+type AuditEventSearchOpts struct {
+	UserId    string
+	EventName string
+	Since     int64
+	Until     int64
+	Page      int
+	PerPage   int
+}
+
+func (e *AuditEvent) PreSave() {
+	if e.Id == "" {
+		e.Id = NewId()
+	}
+
+	if e.CreateAt == 0 {
+		e.CreateAt = GetMillis()
+	}
+}
+
+func (e *AuditEvent) IsValid() *AppError {
+	if !IsValidId(e.Id) {
+		return NewAppError("AuditEvent.IsValid", "model.audit_event.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if e.CreateAt == 0 {
+		return NewAppError("AuditEvent.IsValid", "model.audit_event.is_valid.create_at.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if e.EventName == "" || utf8.RuneCountInString(e.EventName) > AuditEventNameMaxRunes {
+		return NewAppError("AuditEvent.IsValid", "model.audit_event.is_valid.event_name.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	if !json.Valid(e.Record) {
+		return NewAppError("AuditEvent.IsValid", "model.audit_event.is_valid.record.app_error", nil, "id="+e.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEventIsValid(t *testing.T) {
+	event := &AuditEvent{
+		EventName: "updateUser",
+		Record:    []byte(`{"event_name":"updateUser"}`),
+	}
+	require.NotNil(t, event.IsValid())
+
+	event.PreSave()
+	require.Nil(t, event.IsValid())
+
+	event.EventName = ""
+	require.NotNil(t, event.IsValid())
+
+	event.EventName = strings.Repeat("a", AuditEventNameMaxRunes+1)
+	require.NotNil(t, event.IsValid())
+	event.EventName = "updateUser"
+
+	event.Record = []byte(`{"event_name":`)
+	require.NotNil(t, event.IsValid())
+}
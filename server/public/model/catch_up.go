@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	CatchUpDefaultLimit = 10
+	CatchUpMaxLimit     = 50
+
+	// The reasons a post is ranked in a catch-up.
+	CatchUpReasonMention          = "mention"
+	CatchUpReasonUrgent           = "urgent"
+	CatchUpReasonImportant        = "important"
+	CatchUpReasonRequestedAck     = "requested_ack"
+	CatchUpReasonAcknowledgements = "acknowledgements"
+	CatchUpReasonReactions        = "reactions"
+	CatchUpReasonReplies          = "replies"
+	CatchUpReasonPinned           = "pinned"
+)
+
+// CatchUp is a summary of the activity of a channel or thread since a time, made of its most
+// important posts ranked by the signals they have, such as mentions of the user or reactions.
+type CatchUp struct {
+	ChannelId string `json:"channel_id"`
+	// RootId is the root post of the thread summarized, empty for a channel.
+	RootId string `json:"root_id,omitempty"`
+	Since  int64  `json:"since"`
+	// TotalPosts is the number of posts created since the time.
+	TotalPosts int `json:"total_posts"`
+	// Truncated is true if only the latest posts created since the time were ranked, as there
+	// were too many. TruncatedBefore is then the creation time of the earliest post ranked.
+	Truncated       bool           `json:"truncated"`
+	TruncatedBefore int64          `json:"truncated_before,omitempty"`
+	Posts           []*CatchUpPost `json:"posts"`
+	Skipped         CatchUpSkipped `json:"skipped"`
+}
+
+// CatchUpPost is a post ranked in a catch-up.
+type CatchUpPost struct {
+	Post    *Post    `json:"post"`
+	Score   int      `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// CatchUpSkipped counts the posts created since the time that were left out of a catch-up.
+type CatchUpSkipped struct {
+	// NoSignal is the number of posts without any signal making them important.
+	NoSignal int `json:"no_signal"`
+	// OverLimit is the number of posts ranked lower than the ones returned.
+	OverLimit      int `json:"over_limit"`
+	SystemMessages int `json:"system_messages"`
+	// OwnPosts is the number of posts of the user.
+	OwnPosts int `json:"own_posts"`
+}
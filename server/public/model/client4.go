@@ -1696,6 +1696,31 @@ func (c *Client4) GetSessions(ctx context.Context, userId, etag string) ([]*Sess
 	return list, BuildResponse(r), nil
 }
 
+// GetSessionDevices returns the devices the user is currently logged in on, based on the provided user id string.
+func (c *Client4) GetSessionDevices(ctx context.Context, userId string) ([]*SessionDevice, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/sessions/devices", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*SessionDevice
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetSessionDevices", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// UpdateSessionDeviceName sets the display name of the device for the provided user id and session id strings.
+func (c *Client4) UpdateSessionDeviceName(ctx context.Context, userId, sessionId, name string) (*Response, error) {
+	requestBody := map[string]string{"session_id": sessionId, "name": name}
+	r, err := c.DoAPIPut(ctx, c.userRoute(userId)+"/sessions/devices/name", MapToJSON(requestBody))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // RevokeSession revokes a user session based on the provided user id and session id strings.
 func (c *Client4) RevokeSession(ctx context.Context, userId, sessionId string) (*Response, error) {
 	requestBody := map[string]string{"session_id": sessionId}
@@ -1793,6 +1818,17 @@ func (c *Client4) VerifyUserEmail(ctx context.Context, token string) (*Response,
 	return BuildResponse(r), nil
 }
 
+// VerifyLoginLocation confirms a login from a new location using the token sent by email.
+func (c *Client4) VerifyLoginLocation(ctx context.Context, token string) (*Response, error) {
+	requestBody := map[string]string{"token": token}
+	r, err := c.DoAPIPost(ctx, c.usersRoute()+"/login/location/verify", MapToJSON(requestBody))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // VerifyUserEmailWithoutToken will verify a user's email by its Id. (Requires manage system role)
 func (c *Client4) VerifyUserEmailWithoutToken(ctx context.Context, userId string) (*User, *Response, error) {
 	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/email/verify/member", "")
@@ -2877,6 +2913,195 @@ func (c *Client4) InviteGuestsToTeamGracefully(ctx context.Context, teamId strin
 	return list, BuildResponse(r), nil
 }
 
+// GetPendingGuestInvites returns a page of the guest invites waiting for a system admin to approve them.
+func (c *Client4) GetPendingGuestInvites(ctx context.Context, page, perPage int) ([]*PendingGuestInvite, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, "/guest_invites"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*PendingGuestInvite
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetPendingGuestInvites", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// ApprovePendingGuestInvite sends the emails of a guest invite waiting for approval.
+func (c *Client4) ApprovePendingGuestInvite(ctx context.Context, inviteId string) ([]*EmailInviteWithError, *Response, error) {
+	r, err := c.DoAPIPost(ctx, "/guest_invites/"+inviteId+"/approve", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*EmailInviteWithError
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("ApprovePendingGuestInvite", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// DeletePendingGuestInvite rejects a guest invite waiting for approval.
+func (c *Client4) DeletePendingGuestInvite(ctx context.Context, inviteId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, "/guest_invites/"+inviteId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetGuestAccount returns the sponsor and expiry date of a guest.
+func (c *Client4) GetGuestAccount(ctx context.Context, userId string) (*GuestAccount, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/guest_account", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var account GuestAccount
+	if err := json.NewDecoder(r.Body).Decode(&account); err != nil {
+		return nil, nil, NewAppError("GetGuestAccount", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &account, BuildResponse(r), nil
+}
+
+// ExtendGuestAccount moves the expiry date of a guest account. If expiresAt is zero,
+// the account is extended by the configured number of expiry days.
+func (c *Client4) ExtendGuestAccount(ctx context.Context, userId string, expiresAt int64) (*GuestAccount, *Response, error) {
+	buf, err := json.Marshal(GuestAccountExtension{ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, nil, NewAppError("ExtendGuestAccount", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(userId)+"/guest_account/extend", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var account GuestAccount
+	if err := json.NewDecoder(r.Body).Decode(&account); err != nil {
+		return nil, nil, NewAppError("ExtendGuestAccount", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &account, BuildResponse(r), nil
+}
+
+// RequestUserDataExport schedules an export of the user's own data.
+func (c *Client4) RequestUserDataExport(ctx context.Context, userId string) (*UserDataExport, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/data_export", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var export UserDataExport
+	if err := json.NewDecoder(r.Body).Decode(&export); err != nil {
+		return nil, nil, NewAppError("RequestUserDataExport", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &export, BuildResponse(r), nil
+}
+
+// GetUserDataExport returns the most recent data export requested by the user.
+func (c *Client4) GetUserDataExport(ctx context.Context, userId string) (*UserDataExport, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/data_export", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var export UserDataExport
+	if err := json.NewDecoder(r.Body).Decode(&export); err != nil {
+		return nil, nil, NewAppError("GetUserDataExport", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &export, BuildResponse(r), nil
+}
+
+// DownloadUserDataExport writes the archive of a finished user data export to wr.
+func (c *Client4) DownloadUserDataExport(ctx context.Context, userId, jobId string, wr io.Writer) (int64, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/data_export/"+jobId+"/download", "")
+	if err != nil {
+		return 0, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	n, err := io.Copy(wr, r.Body)
+	if err != nil {
+		return n, BuildResponse(r), NewAppError("DownloadUserDataExport", "model.client.copy.app_error", nil, "", r.StatusCode).Wrap(err)
+	}
+	return n, BuildResponse(r), nil
+}
+
+// CreateSavedSearch saves a post search of the user.
+func (c *Client4) CreateSavedSearch(ctx context.Context, search *SavedSearch) (*SavedSearch, *Response, error) {
+	buf, err := json.Marshal(search)
+	if err != nil {
+		return nil, nil, NewAppError("CreateSavedSearch", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(search.UserId)+"/saved_searches", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var saved SavedSearch
+	if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+		return nil, nil, NewAppError("CreateSavedSearch", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &saved, BuildResponse(r), nil
+}
+
+// GetSavedSearches returns the post searches saved by the user.
+func (c *Client4) GetSavedSearches(ctx context.Context, userId string) ([]*SavedSearch, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/saved_searches", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var searches []*SavedSearch
+	if err := json.NewDecoder(r.Body).Decode(&searches); err != nil {
+		return nil, nil, NewAppError("GetSavedSearches", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return searches, BuildResponse(r), nil
+}
+
+// PatchSavedSearch partially updates a saved search of the user.
+func (c *Client4) PatchSavedSearch(ctx context.Context, userId, savedSearchId string, patch *SavedSearchPatch) (*SavedSearch, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchSavedSearch", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.userRoute(userId)+"/saved_searches/"+savedSearchId+"/patch", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var search SavedSearch
+	if err := json.NewDecoder(r.Body).Decode(&search); err != nil {
+		return nil, nil, NewAppError("PatchSavedSearch", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &search, BuildResponse(r), nil
+}
+
+// DeleteSavedSearch deletes a saved search of the user.
+func (c *Client4) DeleteSavedSearch(ctx context.Context, userId, savedSearchId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userId)+"/saved_searches/"+savedSearchId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// RunSavedSearch returns a page of the posts matching a saved search of the user.
+func (c *Client4) RunSavedSearch(ctx context.Context, userId, savedSearchId string, page, perPage int) (*PostSearchResults, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/saved_searches/"+savedSearchId+"/run"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var results PostSearchResults
+	if err := json.NewDecoder(r.Body).Decode(&results); err != nil {
+		return nil, nil, NewAppError("RunSavedSearch", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &results, BuildResponse(r), nil
+}
+
 // InvalidateEmailInvites will invalidate active email invitations that have not been accepted by the user.
 func (c *Client4) InvalidateEmailInvites(ctx context.Context) (*Response, error) {
 	r, err := c.DoAPIDelete(ctx, c.teamsRoute()+"/invites/email")
@@ -3162,6 +3387,54 @@ func (c *Client4) GetChannel(ctx context.Context, channelId, etag string) (*Chan
 	return ch, BuildResponse(r), nil
 }
 
+// GetChannelCatchUp returns the most important posts of a channel since a time, or since the user
+// last viewed the channel if the time is zero.
+func (c *Client4) GetChannelCatchUp(ctx context.Context, channelId string, since int64, limit int) (*CatchUp, *Response, error) {
+	query := fmt.Sprintf("?since=%v&limit=%v", since, limit)
+	r, err := c.DoAPIGet(ctx, c.channelRoute(channelId)+"/catch_up"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var catchUp CatchUp
+	if err := json.NewDecoder(r.Body).Decode(&catchUp); err != nil {
+		return nil, nil, NewAppError("GetChannelCatchUp", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &catchUp, BuildResponse(r), nil
+}
+
+// GetChannelTranslationSettings returns the translation settings of a channel.
+func (c *Client4) GetChannelTranslationSettings(ctx context.Context, channelId string) (*ChannelTranslationSettings, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.channelRoute(channelId)+"/translation", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var settings ChannelTranslationSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		return nil, nil, NewAppError("GetChannelTranslationSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &settings, BuildResponse(r), nil
+}
+
+// UpdateChannelTranslationSettings updates the translation settings of a channel.
+func (c *Client4) UpdateChannelTranslationSettings(ctx context.Context, channelId string, settings *ChannelTranslationSettings) (*ChannelTranslationSettings, *Response, error) {
+	buf, err := json.Marshal(settings)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateChannelTranslationSettings", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.channelRoute(channelId)+"/translation", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var updated ChannelTranslationSettings
+	if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+		return nil, nil, NewAppError("UpdateChannelTranslationSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
 // GetChannelStats returns statistics for a channel.
 func (c *Client4) GetChannelStats(ctx context.Context, channelId string, etag string, excludeFilesCount bool) (*ChannelStats, *Response, error) {
 	route := c.channelRoute(channelId) + fmt.Sprintf("/stats?exclude_files_count=%v", excludeFilesCount)
@@ -3939,8 +4212,8 @@ func (c *Client4) SetPostUnread(ctx context.Context, userId string, postId strin
 }
 
 // SetPostReminder creates a post reminder for a given post at a specified time.
-// The time needs to be in UTC epoch in seconds. It is always truncated to a
-// 5 minute resolution minimum.
+// The time needs to be in UTC epoch in seconds, unless it's set relative to now
+// with In or At. It is always truncated to a 5 minute resolution minimum.
 func (c *Client4) SetPostReminder(ctx context.Context, reminder *PostReminder) (*Response, error) {
 	b, err := json.Marshal(reminder)
 	if err != nil {
@@ -3955,6 +4228,30 @@ func (c *Client4) SetPostReminder(ctx context.Context, reminder *PostReminder) (
 	return BuildResponse(r), nil
 }
 
+// GetPostReminders returns the pending post reminders of a user.
+func (c *Client4) GetPostReminders(ctx context.Context, userId string) ([]*PostReminder, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/posts/reminders", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var reminders []*PostReminder
+	if err := json.NewDecoder(r.Body).Decode(&reminders); err != nil {
+		return nil, nil, NewAppError("GetPostReminders", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return reminders, BuildResponse(r), nil
+}
+
+// DeletePostReminder cancels the reminder of a user for a post.
+func (c *Client4) DeletePostReminder(ctx context.Context, userId, postId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userId)+c.postRoute(postId)+"/reminder")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // PinPost pin a post based on provided post id string.
 func (c *Client4) PinPost(ctx context.Context, postId string) (*Response, error) {
 	r, err := c.DoAPIPost(ctx, c.postRoute(postId)+"/pin", "")
@@ -4095,6 +4392,67 @@ func (c *Client4) GetPostThreadWithOpts(ctx context.Context, postID string, etag
 	return &list, BuildResponse(r), nil
 }
 
+// TranslatePost translates the message of a post into the locale, or into the locale of the
+// user if empty.
+func (c *Client4) TranslatePost(ctx context.Context, postId, locale string) (*PostTranslation, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.postRoute(postId)+"/translate?locale="+url.QueryEscape(locale), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var translation PostTranslation
+	if err := json.NewDecoder(r.Body).Decode(&translation); err != nil {
+		return nil, nil, NewAppError("TranslatePost", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &translation, BuildResponse(r), nil
+}
+
+// TranslatePostThread translates the messages of the posts of a thread into the locale, or into
+// the locale of the user if empty.
+func (c *Client4) TranslatePostThread(ctx context.Context, postId, locale string) ([]*PostTranslation, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.postRoute(postId)+"/thread/translate?locale="+url.QueryEscape(locale), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var translations []*PostTranslation
+	if err := json.NewDecoder(r.Body).Decode(&translations); err != nil {
+		return nil, nil, NewAppError("TranslatePostThread", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return translations, BuildResponse(r), nil
+}
+
+// GetThreadCatchUp returns the most important posts of the thread of a post since a time, or
+// since the user last viewed the thread if the time is zero.
+func (c *Client4) GetThreadCatchUp(ctx context.Context, postId string, since int64, limit int) (*CatchUp, *Response, error) {
+	query := fmt.Sprintf("?since=%v&limit=%v", since, limit)
+	r, err := c.DoAPIGet(ctx, c.postRoute(postId)+"/thread/catch_up"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var catchUp CatchUp
+	if err := json.NewDecoder(r.Body).Decode(&catchUp); err != nil {
+		return nil, nil, NewAppError("GetThreadCatchUp", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &catchUp, BuildResponse(r), nil
+}
+
+// GetPostReadReceipts returns the members of the channel of a post who have seen it and share
+// their read receipts.
+func (c *Client4) GetPostReadReceipts(ctx context.Context, postId string) (*PostReadReceipts, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.postRoute(postId)+"/read_receipts", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var receipts PostReadReceipts
+	if err := json.NewDecoder(r.Body).Decode(&receipts); err != nil {
+		return nil, nil, NewAppError("GetPostReadReceipts", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &receipts, BuildResponse(r), nil
+}
+
 // GetPostsForChannel gets a page of posts with an array for ordering for a channel.
 func (c *Client4) GetPostsForChannel(ctx context.Context, channelId string, page, perPage int, etag string, collapsedThreads bool, includeDeleted bool) (*PostList, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
@@ -5852,6 +6210,37 @@ func (c *Client4) GetAudits(ctx context.Context, page int, perPage int, etag str
 	return audits, BuildResponse(r), nil
 }
 
+// GetAuditEvents returns the audit events saved to the database that match the given options, newest first.
+func (c *Client4) GetAuditEvents(ctx context.Context, opts AuditEventSearchOpts) ([]*AuditEvent, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(opts.Page))
+	values.Set("per_page", strconv.Itoa(opts.PerPage))
+	if opts.UserId != "" {
+		values.Set("user_id", opts.UserId)
+	}
+	if opts.EventName != "" {
+		values.Set("event_name", opts.EventName)
+	}
+	if opts.Since > 0 {
+		values.Set("since", strconv.FormatInt(opts.Since, 10))
+	}
+	if opts.Until > 0 {
+		values.Set("until", strconv.FormatInt(opts.Until, 10))
+	}
+
+	r, err := c.DoAPIGet(ctx, "/audits/events?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var events []*AuditEvent
+	if err := json.NewDecoder(r.Body).Decode(&events); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetAuditEvents", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return events, BuildResponse(r), nil
+}
+
 // Brand Section
 
 // GetBrandImage retrieves the previously uploaded brand image.
@@ -6253,6 +6642,16 @@ func (c *Client4) PurgeBleveIndexes(ctx context.Context) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// RollbackBleveIndexes restores the Bleve indexes replaced by the last reindex.
+func (c *Client4) RollbackBleveIndexes(ctx context.Context) (*Response, error) {
+	r, err := c.DoAPIPost(ctx, c.bleveRoute()+"/rollback_indexes", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // Data Retention Section
 
 // GetDataRetentionPolicy will get the current global data retention policy details.
@@ -7504,6 +7903,51 @@ func (c *Client4) DetachPlugin(ctx context.Context, pluginID string) (*Response,
 	return BuildResponse(r), nil
 }
 
+// WatchPlugin deploys the plugin found in a directory on the server's machine, and redeploys it
+// whenever its manifest, server executables or webapp bundle change.
+//
+// Only available in local mode, with developer mode enabled.
+func (c *Client4) WatchPlugin(ctx context.Context, path string) (*Manifest, *Response, error) {
+	buf, err := json.Marshal(&PluginWatchRequest{Path: path})
+	if err != nil {
+		return nil, nil, NewAppError("WatchPlugin", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPost(ctx, c.pluginsRoute()+"/watch", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var manifest Manifest
+	if err := json.NewDecoder(r.Body).Decode(&manifest); err != nil {
+		return nil, nil, NewAppError("WatchPlugin", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &manifest, BuildResponse(r), nil
+}
+
+// UnwatchPlugin stops watching a plugin's directory. The plugin stays deployed.
+//
+// Only available in local mode.
+func (c *Client4) UnwatchPlugin(ctx context.Context, pluginID string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.pluginRoute(pluginID)+"/watch")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetPluginWatchLogs streams the logs of a watched plugin as newline-delimited PluginWatchLogEntry
+// objects until the returned body is closed or the plugin is no longer watched.
+//
+// Only available in local mode.
+func (c *Client4) GetPluginWatchLogs(ctx context.Context, pluginID string) (io.ReadCloser, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.pluginRoute(pluginID)+"/watch/logs", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	return r.Body, BuildResponse(r), nil
+}
+
 // GetPlugins will return a list of plugin manifests for currently active plugins.
 func (c *Client4) GetPlugins(ctx context.Context) (*PluginsResponse, *Response, error) {
 	r, err := c.DoAPIGet(ctx, c.pluginsRoute(), "")
@@ -7569,6 +8013,72 @@ func (c *Client4) EnablePlugin(ctx context.Context, id string) (*Response, error
 	return BuildResponse(r), nil
 }
 
+// ApprovePluginCapabilities grants an installed plugin the capabilities requested by its manifest.
+func (c *Client4) ApprovePluginCapabilities(ctx context.Context, id string) (*Manifest, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.pluginRoute(id)+"/capabilities/approve", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var m Manifest
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		return nil, nil, NewAppError("ApprovePluginCapabilities", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &m, BuildResponse(r), nil
+}
+
+// GetPluginMigrations returns the database migrations applied by a plugin, ordered by version.
+func (c *Client4) GetPluginMigrations(ctx context.Context, id string) ([]*PluginMigration, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.pluginRoute(id)+"/migrations", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var migrations []*PluginMigration
+	if err := json.NewDecoder(r.Body).Decode(&migrations); err != nil {
+		return nil, nil, NewAppError("GetPluginMigrations", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return migrations, BuildResponse(r), nil
+}
+
+// RollbackPluginMigrations rolls back the database migrations of a disabled plugin applied after
+// the given version, newest first, returning those rolled back.
+func (c *Client4) RollbackPluginMigrations(ctx context.Context, id string, toVersion int64) ([]*PluginMigration, *Response, error) {
+	buf, err := json.Marshal(PluginMigrationRollbackRequest{ToVersion: toVersion})
+	if err != nil {
+		return nil, nil, NewAppError("RollbackPluginMigrations", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPost(ctx, c.pluginRoute(id)+"/migrations/rollback", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var migrations []*PluginMigration
+	if err := json.NewDecoder(r.Body).Decode(&migrations); err != nil {
+		return nil, nil, NewAppError("RollbackPluginMigrations", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return migrations, BuildResponse(r), nil
+}
+
+// GetPluginPermissions returns the permissions registered by the active plugins.
+func (c *Client4) GetPluginPermissions(ctx context.Context) ([]*Permission, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.pluginsRoute()+"/permissions", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*Permission
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetPluginPermissions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // DisablePlugin will disable an enabled plugin.
 func (c *Client4) DisablePlugin(ctx context.Context, id string) (*Response, error) {
 	r, err := c.DoAPIPost(ctx, c.pluginRoute(id)+"/disable", "")
@@ -7579,6 +8089,16 @@ func (c *Client4) DisablePlugin(ctx context.Context, id string) (*Response, erro
 	return BuildResponse(r), nil
 }
 
+// DisablePluginForced will disable an enabled plugin, even if enabled plugins depend on it.
+func (c *Client4) DisablePluginForced(ctx context.Context, id string) (*Response, error) {
+	r, err := c.DoAPIPost(ctx, c.pluginRoute(id)+"/disable?force=true", "")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // GetMarketplacePlugins will return a list of plugins that an admin can install.
 func (c *Client4) GetMarketplacePlugins(ctx context.Context, filter *MarketplacePluginFilter) ([]*MarketplacePlugin, *Response, error) {
 	route := c.pluginsRoute() + "/marketplace"
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -117,6 +118,7 @@ const (
 	ServiceSettingsDefaultUniqueReactionsPerPost = 50
 	ServiceSettingsDefaultMaxURLLength           = 2048
 	ServiceSettingsMaxUniqueReactionsPerPost     = 500
+	ServiceSettingsMaxReadReceiptsChannelMembers = 1000
 
 	TeamSettingsDefaultSiteName              = "Mattermost"
 	TeamSettingsDefaultMaxUsersPerTeam       = 50
@@ -136,6 +138,9 @@ const (
 	ExportSettingsDefaultDirectory     = "./export"
 	ExportSettingsDefaultRetentionDays = 30
 
+	ExportSettingsDefaultUserDataExportIntervalHours   = 24
+	ExportSettingsDefaultUserDataExportLinkExpiryHours = 24
+
 	EmailSettingsDefaultFeedbackOrganization = ""
 
 	SupportSettingsDefaultTermsOfServiceLink = "https://mattermost.com/pl/terms-of-use/"
@@ -212,8 +217,9 @@ const (
 	ElasticsearchSettingsESBackend                          = "elasticsearch"
 	ElasticsearchSettingsOSBackend                          = "opensearch"
 
-	BleveSettingsDefaultIndexDir  = ""
-	BleveSettingsDefaultBatchSize = 10000
+	BleveSettingsDefaultIndexDir     = ""
+	BleveSettingsDefaultBatchSize    = 10000
+	BleveSettingsDefaultTextAnalyzer = "standard"
 
 	DataRetentionSettingsDefaultMessageRetentionDays           = 365
 	DataRetentionSettingsDefaultMessageRetentionHours          = 0
@@ -233,6 +239,13 @@ const (
 	PluginSettingsDefaultMarketplaceURL    = "https://api.integrations.mattermost.com"
 	PluginSettingsOldMarketplaceURL        = "https://marketplace.integrations.mattermost.com"
 
+	PluginSettingsDefaultHookTimeoutSeconds                = 0
+	PluginSettingsDefaultMessageHookTimeoutMilliseconds    = 0
+	PluginSettingsDefaultHookFailureThreshold              = 5
+	PluginSettingsDefaultHookCircuitBreakerCooldownSeconds = 60
+	PluginSettingsDefaultWasmMemoryLimitMB                 = 128
+	PluginSettingsDefaultWasmMaxExecutionSeconds           = 60
+
 	ComplianceExportTypeCsv            = "csv"
 	ComplianceExportTypeActiance       = "actiance"
 	ComplianceExportTypeGlobalrelay    = "globalrelay"
@@ -273,6 +286,25 @@ func GetDefaultAppCustomURLSchemes() []string {
 	return []string{"mmauth://", "mmauthbeta://"}
 }
 
+// BleveTextAnalyzers are the analyzers the Bleve engine can use for the text of messages:
+// the standard analyzer, the CJK bigram analyzer and the Bleve language analyzers.
+var BleveTextAnalyzers = []string{
+	"standard",
+	"cjk",
+	"da",
+	"de",
+	"en",
+	"es",
+	"fi",
+	"fr",
+	"it",
+	"nl",
+	"no",
+	"pt",
+	"ru",
+	"sv",
+}
+
 var ServerTLSSupportedCiphers = map[string]uint16{
 	"TLS_RSA_WITH_RC4_128_SHA":                tls.TLS_RSA_WITH_RC4_128_SHA,
 	"TLS_RSA_WITH_3DES_EDE_CBC_SHA":           tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
@@ -350,6 +382,8 @@ type ServiceSettings struct {
 	AllowCookiesForSubdomains           *bool    `access:"write_restrictable,cloud_restrictable"`
 	ExtendSessionLengthWithActivity     *bool    `access:"environment_session_lengths,write_restrictable,cloud_restrictable"`
 	TerminateSessionsOnPasswordChange   *bool    `access:"environment_session_lengths,write_restrictable,cloud_restrictable"`
+	EnableNewLoginLocationAlerts        *bool    `access:"environment_session_lengths,write_restrictable,cloud_restrictable"`
+	RequireNewLoginLocationVerification *bool    `access:"environment_session_lengths,write_restrictable,cloud_restrictable"`
 
 	// Deprecated
 	SessionLengthWebInDays  *int `access:"environment_session_lengths,write_restrictable,cloud_restrictable"` // telemetry: none
@@ -374,6 +408,7 @@ type ServiceSettings struct {
 	TimeBetweenUserTypingUpdatesMilliseconds          *int64  `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	EnablePostSearch                                  *bool   `access:"write_restrictable,cloud_restrictable"`
 	EnableFileSearch                                  *bool   `access:"write_restrictable"`
+	EnableSavedSearchAlerts                           *bool   `access:"site_notifications,write_restrictable,cloud_restrictable"`
 	MinimumHashtagLength                              *int    `access:"environment_database,write_restrictable,cloud_restrictable"`
 	EnableUserTypingMessages                          *bool   `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	EnableChannelViewedMessages                       *bool   `access:"experimental_features,write_restrictable,cloud_restrictable"`
@@ -403,6 +438,8 @@ type ServiceSettings struct {
 	PersistentNotificationIntervalMinutes             *int  `access:"site_posts"`
 	PersistentNotificationMaxCount                    *int  `access:"site_posts"`
 	PersistentNotificationMaxRecipients               *int  `access:"site_posts"`
+	EnableReadReceipts                                *bool `access:"site_posts"`
+	ReadReceiptsMaxChannelMembers                     *int  `access:"site_posts"`
 	EnableAPIChannelDeletion                          *bool
 	EnableLocalMode                                   *bool   `access:"cloud_restrictable"`
 	LocalModeSocketLocation                           *string `access:"cloud_restrictable"` // telemetry: none
@@ -605,6 +642,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnablePostSearch = NewPointer(true)
 	}
 
+	if s.EnableSavedSearchAlerts == nil {
+		s.EnableSavedSearchAlerts = NewPointer(true)
+	}
+
 	if s.EnableFileSearch == nil {
 		s.EnableFileSearch = NewPointer(true)
 	}
@@ -647,6 +688,14 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.TerminateSessionsOnPasswordChange = NewPointer(!isUpdate)
 	}
 
+	if s.EnableNewLoginLocationAlerts == nil {
+		s.EnableNewLoginLocationAlerts = NewPointer(false)
+	}
+
+	if s.RequireNewLoginLocationVerification == nil {
+		s.RequireNewLoginLocationVerification = NewPointer(false)
+	}
+
 	if s.SessionLengthWebInDays == nil {
 		if isUpdate {
 			s.SessionLengthWebInDays = NewPointer(180)
@@ -919,6 +968,14 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.PersistentNotificationMaxRecipients = NewPointer(5)
 	}
 
+	if s.EnableReadReceipts == nil {
+		s.EnableReadReceipts = NewPointer(false)
+	}
+
+	if s.ReadReceiptsMaxChannelMembers == nil {
+		s.ReadReceiptsMaxChannelMembers = NewPointer(0)
+	}
+
 	if s.AllowSyncedDrafts == nil {
 		s.AllowSyncedDrafts = NewPointer(true)
 	}
@@ -1479,6 +1536,11 @@ type ExperimentalAuditSettings struct {
 	FileCompress        *bool           `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	FileMaxQueueSize    *int            `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	AdvancedLoggingJSON json.RawMessage `access:"experimental_features,write_restrictable"`
+
+	// The hash chain settings are only read at startup, so changing them requires a restart.
+	HashChainEnabled            *bool `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	HashChainCheckpointInterval *int  `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	DatabaseEnabled             *bool `access:"experimental_features,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ExperimentalAuditSettings) SetDefaults() {
@@ -1513,6 +1575,18 @@ func (s *ExperimentalAuditSettings) SetDefaults() {
 	if utils.IsEmptyJSON(s.AdvancedLoggingJSON) {
 		s.AdvancedLoggingJSON = []byte("{}")
 	}
+
+	if s.HashChainEnabled == nil {
+		s.HashChainEnabled = NewPointer(false)
+	}
+
+	if s.HashChainCheckpointInterval == nil {
+		s.HashChainCheckpointInterval = NewPointer(1000)
+	}
+
+	if s.DatabaseEnabled == nil {
+		s.DatabaseEnabled = NewPointer(false)
+	}
 }
 
 // GetAdvancedLoggingConfig returns the advanced logging config as a []byte.
@@ -2051,6 +2125,31 @@ func (s *RateLimitSettings) SetDefaults() {
 	}
 }
 
+type IPFilteringSettings struct {
+	Enable          *bool             `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	AllowedIPRanges *string           `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	DeniedIPRanges  *string           `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	RoleIPRanges    map[string]string `access:"environment_web_server,write_restrictable,cloud_restrictable"` // telemetry: none
+}
+
+func (s *IPFilteringSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewPointer(false)
+	}
+
+	if s.AllowedIPRanges == nil {
+		s.AllowedIPRanges = NewPointer("")
+	}
+
+	if s.DeniedIPRanges == nil {
+		s.DeniedIPRanges = NewPointer("")
+	}
+
+	if s.RoleIPRanges == nil {
+		s.RoleIPRanges = map[string]string{}
+	}
+}
+
 type PrivacySettings struct {
 	ShowEmailAddress *bool `access:"site_users_and_teams"`
 	ShowFullName     *bool `access:"site_users_and_teams"`
@@ -2587,6 +2686,41 @@ func (s *LocalizationSettings) SetDefaults() {
 	}
 }
 
+type TranslationSettings struct {
+	Enable                *bool   `access:"site_localization"`
+	Provider              *string `access:"site_localization"`
+	LibreTranslateURL     *string `access:"site_localization"` // telemetry: none
+	LibreTranslateAPIKey  *string `access:"site_localization"` // telemetry: none
+	PluginId              *string `access:"site_localization"` // telemetry: none
+	RequestTimeoutSeconds *int    `access:"site_localization"`
+}
+
+func (s *TranslationSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewPointer(false)
+	}
+
+	if s.Provider == nil {
+		s.Provider = NewPointer(TranslationProviderLibreTranslate)
+	}
+
+	if s.LibreTranslateURL == nil {
+		s.LibreTranslateURL = NewPointer("")
+	}
+
+	if s.LibreTranslateAPIKey == nil {
+		s.LibreTranslateAPIKey = NewPointer("")
+	}
+
+	if s.PluginId == nil {
+		s.PluginId = NewPointer("")
+	}
+
+	if s.RequestTimeoutSeconds == nil {
+		s.RequestTimeoutSeconds = NewPointer(TranslationSettingsDefaultRequestTimeoutSeconds)
+	}
+}
+
 type SamlSettings struct {
 	// Basic
 	Enable                        *bool `access:"authentication_saml"`
@@ -2947,6 +3081,8 @@ type BleveSettings struct {
 	EnableAutocomplete            *bool   `access:"experimental_bleve"`
 	BulkIndexingTimeWindowSeconds *int    `json:",omitempty"` // telemetry: none
 	BatchSize                     *int    `access:"experimental_bleve"`
+	TextAnalyzer                  *string `access:"experimental_bleve"`
+	DetectPostLanguage            *bool   `access:"experimental_bleve"`
 }
 
 func (bs *BleveSettings) SetDefaults() {
@@ -2969,6 +3105,14 @@ func (bs *BleveSettings) SetDefaults() {
 	if bs.BatchSize == nil {
 		bs.BatchSize = NewPointer(BleveSettingsDefaultBatchSize)
 	}
+
+	if bs.TextAnalyzer == nil {
+		bs.TextAnalyzer = NewPointer(BleveSettingsDefaultTextAnalyzer)
+	}
+
+	if bs.DetectPostLanguage == nil {
+		bs.DetectPostLanguage = NewPointer(false)
+	}
 }
 
 type DataRetentionSettings struct {
@@ -3140,6 +3284,17 @@ type PluginSettings struct {
 	MarketplaceURL              *string                   `access:"plugins,write_restrictable,cloud_restrictable"`
 	SignaturePublicKeyFiles     []string                  `access:"plugins,write_restrictable,cloud_restrictable"`
 	ChimeraOAuthProxyURL        *string                   `access:"plugins,write_restrictable,cloud_restrictable"`
+	EnforceCapabilities         *bool                     `access:"plugins,write_restrictable,cloud_restrictable"`
+	GrantedCapabilities         map[string][]string       `access:"plugins,write_restrictable,cloud_restrictable"` // telemetry: none
+	RegisteredPermissions       map[string][]string       `access:"plugins,write_restrictable,cloud_restrictable"` // telemetry: none
+
+	HookTimeoutSeconds                *int `access:"plugins,write_restrictable,cloud_restrictable"`
+	MessageHookTimeoutMilliseconds    *int `access:"plugins,write_restrictable,cloud_restrictable"`
+	HookFailureThreshold              *int `access:"plugins,write_restrictable,cloud_restrictable"`
+	HookCircuitBreakerCooldownSeconds *int `access:"plugins,write_restrictable,cloud_restrictable"`
+
+	WasmMemoryLimitMB       *int `access:"plugins,write_restrictable,cloud_restrictable"`
+	WasmMaxExecutionSeconds *int `access:"plugins,write_restrictable,cloud_restrictable"`
 }
 
 func (s *PluginSettings) SetDefaults(ls LogSettings) {
@@ -3175,6 +3330,18 @@ func (s *PluginSettings) SetDefaults(ls LogSettings) {
 		s.PluginStates = make(map[string]*PluginState)
 	}
 
+	if s.EnforceCapabilities == nil {
+		s.EnforceCapabilities = NewPointer(false)
+	}
+
+	if s.GrantedCapabilities == nil {
+		s.GrantedCapabilities = make(map[string][]string)
+	}
+
+	if s.RegisteredPermissions == nil {
+		s.RegisteredPermissions = make(map[string][]string)
+	}
+
 	if s.PluginStates[PluginIdNPS] == nil {
 		// Enable the NPS plugin by default if diagnostics are enabled
 		s.PluginStates[PluginIdNPS] = &PluginState{Enable: ls.EnableDiagnostics == nil || *ls.EnableDiagnostics}
@@ -3217,6 +3384,59 @@ func (s *PluginSettings) SetDefaults(ls LogSettings) {
 	if s.ChimeraOAuthProxyURL == nil {
 		s.ChimeraOAuthProxyURL = NewPointer("")
 	}
+
+	if s.HookTimeoutSeconds == nil {
+		s.HookTimeoutSeconds = NewPointer(PluginSettingsDefaultHookTimeoutSeconds)
+	}
+
+	if s.MessageHookTimeoutMilliseconds == nil {
+		s.MessageHookTimeoutMilliseconds = NewPointer(PluginSettingsDefaultMessageHookTimeoutMilliseconds)
+	}
+
+	if s.HookFailureThreshold == nil {
+		s.HookFailureThreshold = NewPointer(PluginSettingsDefaultHookFailureThreshold)
+	}
+
+	if s.HookCircuitBreakerCooldownSeconds == nil {
+		s.HookCircuitBreakerCooldownSeconds = NewPointer(PluginSettingsDefaultHookCircuitBreakerCooldownSeconds)
+	}
+
+	if s.WasmMemoryLimitMB == nil {
+		s.WasmMemoryLimitMB = NewPointer(PluginSettingsDefaultWasmMemoryLimitMB)
+	}
+
+	if s.WasmMaxExecutionSeconds == nil {
+		s.WasmMaxExecutionSeconds = NewPointer(PluginSettingsDefaultWasmMaxExecutionSeconds)
+	}
+}
+
+func (s *PluginSettings) isValid() *AppError {
+	if *s.HookTimeoutSeconds < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_hook_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MessageHookTimeoutMilliseconds < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_message_hook_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.HookFailureThreshold < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_hook_failure_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.HookCircuitBreakerCooldownSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_hook_circuit_breaker_cooldown.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	// WebAssembly memory is allocated in 64KiB pages and capped at 4GiB.
+	if *s.WasmMemoryLimitMB <= 0 || *s.WasmMemoryLimitMB > 4096 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_wasm_memory_limit.app_error", map[string]any{"Max": 4096}, "", http.StatusBadRequest)
+	}
+
+	if *s.WasmMaxExecutionSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.plugin_wasm_max_execution.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 // Sanitize cleans up the plugin settings by removing any sensitive information.
@@ -3428,6 +3648,9 @@ type GuestAccountsSettings struct {
 	AllowEmailAccounts               *bool   `access:"authentication_guest_access"`
 	EnforceMultifactorAuthentication *bool   `access:"authentication_guest_access"`
 	RestrictCreationToDomains        *string `access:"authentication_guest_access"`
+	ExpiryDays                       *int    `access:"authentication_guest_access"`
+	ExpiryWarningDays                *int    `access:"authentication_guest_access"`
+	RequireInviteApproval            *bool   `access:"authentication_guest_access"`
 }
 
 func (s *GuestAccountsSettings) SetDefaults() {
@@ -3450,6 +3673,30 @@ func (s *GuestAccountsSettings) SetDefaults() {
 	if s.RestrictCreationToDomains == nil {
 		s.RestrictCreationToDomains = NewPointer("")
 	}
+
+	if s.ExpiryDays == nil {
+		s.ExpiryDays = NewPointer(0)
+	}
+
+	if s.ExpiryWarningDays == nil {
+		s.ExpiryWarningDays = NewPointer(7)
+	}
+
+	if s.RequireInviteApproval == nil {
+		s.RequireInviteApproval = NewPointer(false)
+	}
+}
+
+func (s *GuestAccountsSettings) isValid() *AppError {
+	if *s.ExpiryDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.guest_expiry_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.ExpiryWarningDays < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.guest_expiry_warning_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type ImageProxySettings struct {
@@ -3514,6 +3761,12 @@ type ExportSettings struct {
 	Directory *string // telemetry: none
 	// The number of days to retain the exported files before deleting them.
 	RetentionDays *int
+	// Whether users can request an export of their own data.
+	EnableUserDataExport *bool
+	// The minimum number of hours between two data exports requested by the same user.
+	UserDataExportIntervalHours *int
+	// The number of hours for which a user's data export can be downloaded.
+	UserDataExportLinkExpiryHours *int
 }
 
 func (s *ExportSettings) isValid() *AppError {
@@ -3525,6 +3778,14 @@ func (s *ExportSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.export.retention_days_too_low.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.UserDataExportIntervalHours <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.export.user_data_export_interval_hours.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.UserDataExportLinkExpiryHours <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.export.user_data_export_link_expiry_hours.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3537,6 +3798,18 @@ func (s *ExportSettings) SetDefaults() {
 	if s.RetentionDays == nil {
 		s.RetentionDays = NewPointer(ExportSettingsDefaultRetentionDays)
 	}
+
+	if s.EnableUserDataExport == nil {
+		s.EnableUserDataExport = NewPointer(false)
+	}
+
+	if s.UserDataExportIntervalHours == nil {
+		s.UserDataExportIntervalHours = NewPointer(ExportSettingsDefaultUserDataExportIntervalHours)
+	}
+
+	if s.UserDataExportLinkExpiryHours == nil {
+		s.UserDataExportLinkExpiryHours = NewPointer(ExportSettingsDefaultUserDataExportLinkExpiryHours)
+	}
 }
 
 type ConfigFunc func() *Config
@@ -3595,6 +3868,7 @@ type Config struct {
 	FileSettings                FileSettings
 	EmailSettings               EmailSettings
 	RateLimitSettings           RateLimitSettings
+	IPFilteringSettings         IPFilteringSettings
 	PrivacySettings             PrivacySettings
 	SupportSettings             SupportSettings
 	AnnouncementSettings        AnnouncementSettings
@@ -3606,6 +3880,7 @@ type Config struct {
 	LdapSettings                LdapSettings
 	ComplianceSettings          ComplianceSettings
 	LocalizationSettings        LocalizationSettings
+	TranslationSettings         TranslationSettings
 	SamlSettings                SamlSettings
 	NativeAppSettings           NativeAppSettings
 	CacheSettings               CacheSettings
@@ -3723,11 +3998,13 @@ func (o *Config) SetDefaults() {
 	o.AnalyticsSettings.SetDefaults()
 	o.ComplianceSettings.SetDefaults()
 	o.LocalizationSettings.SetDefaults()
+	o.TranslationSettings.SetDefaults()
 	o.ElasticsearchSettings.SetDefaults()
 	o.BleveSettings.SetDefaults()
 	o.NativeAppSettings.SetDefaults()
 	o.DataRetentionSettings.SetDefaults()
 	o.RateLimitSettings.SetDefaults()
+	o.IPFilteringSettings.SetDefaults()
 	o.LogSettings.SetDefaults()
 	o.ExperimentalAuditSettings.SetDefaults()
 	o.NotificationLogSettings.SetDefaults()
@@ -3800,6 +4077,14 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
+	if appErr := o.IPFilteringSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.GuestAccountsSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
 	if appErr := o.ServiceSettings.isValid(); appErr != nil {
 		return appErr
 	}
@@ -3824,6 +4109,14 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
+	if appErr := o.ExperimentalAuditSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
+	if appErr := o.TranslationSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
 	if appErr := o.MessageExportSettings.isValid(); appErr != nil {
 		return appErr
 	}
@@ -3844,6 +4137,10 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
+	if appErr := o.PluginSettings.isValid(); appErr != nil {
+		return appErr
+	}
+
 	return nil
 }
 
@@ -3987,6 +4284,32 @@ func (s *RateLimitSettings) isValid() *AppError {
 	return nil
 }
 
+func (s *IPFilteringSettings) isValid() *AppError {
+	if _, err := ParseIPRanges(*s.AllowedIPRanges); err != nil {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ip_filtering_allowed_ranges.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if _, err := ParseIPRanges(*s.DeniedIPRanges); err != nil {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ip_filtering_denied_ranges.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	for role, ranges := range s.RoleIPRanges {
+		if !IsValidRoleName(role) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.ip_filtering_role.app_error", map[string]any{"Role": role}, "", http.StatusBadRequest)
+		}
+
+		ipNets, err := ParseIPRanges(ranges)
+		if err != nil {
+			return NewAppError("Config.IsValid", "model.config.is_valid.ip_filtering_role_ranges.app_error", map[string]any{"Role": role}, "", http.StatusBadRequest).Wrap(err)
+		}
+		if len(ipNets) == 0 {
+			return NewAppError("Config.IsValid", "model.config.is_valid.ip_filtering_role_ranges.app_error", map[string]any{"Role": role}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
 func (s *LdapSettings) isValid() *AppError {
 	if !(*s.ConnectionSecurity == ConnSecurityNone || *s.ConnectionSecurity == ConnSecurityTLS || *s.ConnectionSecurity == ConnSecurityStarttls) {
 		return NewAppError("Config.IsValid", "model.config.is_valid.ldap_security.app_error", nil, "", http.StatusBadRequest)
@@ -4231,6 +4554,9 @@ func (s *ServiceSettings) isValid() *AppError {
 	if *s.PersistentNotificationMaxRecipients <= 0 {
 		return NewAppError("Config.IsValid", "model.config.is_valid.persistent_notifications_recipients.app_error", nil, "", http.StatusBadRequest)
 	}
+	if *s.ReadReceiptsMaxChannelMembers < 0 || *s.ReadReceiptsMaxChannelMembers > ServiceSettingsMaxReadReceiptsChannelMembers {
+		return NewAppError("Config.IsValid", "model.config.is_valid.read_receipts_max_channel_members.app_error", map[string]any{"Max": ServiceSettingsMaxReadReceiptsChannelMembers}, "", http.StatusBadRequest)
+	}
 
 	// we check if file has a valid parent, the server will try to create the socket
 	// file if it doesn't exist, but we need to be sure if the directory exist or not
@@ -4314,6 +4640,9 @@ func (bs *BleveSettings) isValid() *AppError {
 	if *bs.BatchSize < minBatchSize {
 		return NewAppError("Config.IsValid", "model.config.is_valid.bleve_search.bulk_indexing_batch_size.app_error", map[string]any{"BatchSize": minBatchSize}, "", http.StatusBadRequest)
 	}
+	if !slices.Contains(BleveTextAnalyzers, *bs.TextAnalyzer) {
+		return NewAppError("Config.IsValid", "model.config.is_valid.bleve_search.text_analyzer.app_error", map[string]any{"TextAnalyzer": *bs.TextAnalyzer}, "", http.StatusBadRequest)
+	}
 
 	return nil
 }
@@ -4358,6 +4687,14 @@ func (s *DataRetentionSettings) isValid() *AppError {
 	return nil
 }
 
+func (s *ExperimentalAuditSettings) isValid() *AppError {
+	if *s.HashChainCheckpointInterval <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.audit_hash_chain_checkpoint_interval.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func (s *LocalizationSettings) isValid() *AppError {
 	if *s.AvailableLocales != "" {
 		if !strings.Contains(*s.AvailableLocales, *s.DefaultClientLocale) {
@@ -4368,6 +4705,27 @@ func (s *LocalizationSettings) isValid() *AppError {
 	return nil
 }
 
+func (s *TranslationSettings) isValid() *AppError {
+	switch *s.Provider {
+	case TranslationProviderLibreTranslate:
+		if *s.Enable && !IsValidHTTPURL(*s.LibreTranslateURL) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.translation_libretranslate_url.app_error", nil, "", http.StatusBadRequest)
+		}
+	case TranslationProviderPlugin:
+		if *s.Enable && *s.PluginId == "" {
+			return NewAppError("Config.IsValid", "model.config.is_valid.translation_plugin_id.app_error", nil, "", http.StatusBadRequest)
+		}
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.translation_provider.app_error", map[string]any{"Provider": *s.Provider}, "", http.StatusBadRequest)
+	}
+
+	if *s.RequestTimeoutSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.translation_request_timeout.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func (s *MessageExportSettings) isValid() *AppError {
 	if s.EnableExport == nil {
 		return NewAppError("Config.IsValid", "model.config.is_valid.message_export.enable.app_error", nil, "", http.StatusBadRequest)
@@ -4500,6 +4858,10 @@ func (o *Config) Sanitize(pluginManifests []*Manifest) {
 		*o.ElasticsearchSettings.Password = FakeSetting
 	}
 
+	if o.TranslationSettings.LibreTranslateAPIKey != nil && *o.TranslationSettings.LibreTranslateAPIKey != "" {
+		*o.TranslationSettings.LibreTranslateAPIKey = FakeSetting
+	}
+
 	for i := range o.SqlSettings.DataSourceReplicas {
 		o.SqlSettings.DataSourceReplicas[i] = FakeSetting
 	}
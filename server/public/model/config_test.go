@@ -101,6 +101,24 @@ func TestServiceSettingsIsValid(t *testing.T) {
 			},
 			ExpectError: false,
 		},
+		"ReadReceiptsMaxChannelMembers is negative": {
+			ServiceSettings: ServiceSettings{
+				ReadReceiptsMaxChannelMembers: NewPointer(-1),
+			},
+			ExpectError: true,
+		},
+		"ReadReceiptsMaxChannelMembers is the maximum": {
+			ServiceSettings: ServiceSettings{
+				ReadReceiptsMaxChannelMembers: NewPointer(ServiceSettingsMaxReadReceiptsChannelMembers),
+			},
+			ExpectError: false,
+		},
+		"ReadReceiptsMaxChannelMembers is above the maximum": {
+			ServiceSettings: ServiceSettings{
+				ReadReceiptsMaxChannelMembers: NewPointer(ServiceSettingsMaxReadReceiptsChannelMembers + 1),
+			},
+			ExpectError: true,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			test.ServiceSettings.SetDefaults(false)
@@ -401,6 +419,66 @@ func TestTeamSettingsDefaultJoinLeaveMessage(t *testing.T) {
 	require.Equal(t, NewPointer(true), c1.TeamSettings.EnableJoinLeaveMessageByDefault)
 }
 
+func TestBleveSettingsIsValidTextAnalyzer(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Nil(t, c1.BleveSettings.isValid())
+
+	c1.BleveSettings.TextAnalyzer = NewPointer("cjk")
+	require.Nil(t, c1.BleveSettings.isValid())
+
+	c1.BleveSettings.TextAnalyzer = NewPointer("klingon")
+	appErr := c1.BleveSettings.isValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.bleve_search.text_analyzer.app_error", appErr.Id)
+}
+
+func TestTranslationSettingsIsValid(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Nil(t, c1.TranslationSettings.isValid())
+
+	c1.TranslationSettings.Enable = NewPointer(true)
+	appErr := c1.TranslationSettings.isValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.translation_libretranslate_url.app_error", appErr.Id)
+
+	c1.TranslationSettings.LibreTranslateURL = NewPointer("http://localhost:5000")
+	require.Nil(t, c1.TranslationSettings.isValid())
+
+	c1.TranslationSettings.Provider = NewPointer(TranslationProviderPlugin)
+	appErr = c1.TranslationSettings.isValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.translation_plugin_id.app_error", appErr.Id)
+
+	c1.TranslationSettings.PluginId = NewPointer("com.example.translator")
+	require.Nil(t, c1.TranslationSettings.isValid())
+
+	c1.TranslationSettings.Provider = NewPointer("babelfish")
+	appErr = c1.TranslationSettings.isValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.translation_provider.app_error", appErr.Id)
+
+	c1.TranslationSettings.Provider = NewPointer(TranslationProviderLibreTranslate)
+	c1.TranslationSettings.RequestTimeoutSeconds = NewPointer(0)
+	appErr = c1.TranslationSettings.isValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.config.is_valid.translation_request_timeout.app_error", appErr.Id)
+}
+
+func TestExperimentalAuditSettingsIsValid(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Nil(t, c1.ExperimentalAuditSettings.isValid())
+
+	for _, interval := range []int{0, -1} {
+		c1.ExperimentalAuditSettings.HashChainCheckpointInterval = NewPointer(interval)
+		appErr := c1.ExperimentalAuditSettings.isValid()
+		require.NotNil(t, appErr)
+		require.Equal(t, "model.config.is_valid.audit_hash_chain_checkpoint_interval.app_error", appErr.Id)
+	}
+}
+
 func TestMessageExportSettingsIsValidEnableExportNotSet(t *testing.T) {
 	mes := &MessageExportSettings{}
 
@@ -0,0 +1,162 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+// GuestAccount tracks the lifecycle of a guest user: the member who sponsors
+// them and when their account expires.
+type GuestAccount struct {
+	UserId string `json:"user_id"`
+	// SponsorId is empty for guests who weren't invited by a member, such as
+	// demoted users and guests synchronized from SAML or LDAP.
+	SponsorId string `json:"sponsor_id"`
+	// ExpiresAt is zero for guests that never expire.
+	ExpiresAt int64 `json:"expires_at"`
+	// ExpiryNotifiedAt is set once the guest and their sponsor have been
+	// warned of the upcoming expiry, and reset when the account is extended.
+	ExpiryNotifiedAt int64 `json:"expiry_notified_at"`
+	// ExpiryDeactivatedAt is the DeleteAt of the guest when their account was
+	// deactivated on expiry, so that extending the account only reactivates
+	// guests who weren't deactivated for another reason.
+	ExpiryDeactivatedAt int64 `json:"expiry_deactivated_at"`
+	CreateAt            int64 `json:"create_at"`
+	UpdateAt            int64 `json:"update_at"`
+}
+
+func (g *GuestAccount) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":    g.UserId,
+		"sponsor_id": g.SponsorId,
+		"expires_at": g.ExpiresAt,
+	}
+}
+
+func (g *GuestAccount) PreSave() {
+	if g.CreateAt == 0 {
+		g.CreateAt = GetMillis()
+	}
+
+	g.UpdateAt = g.CreateAt
+}
+
+func (g *GuestAccount) PreUpdate() {
+	g.UpdateAt = GetMillis()
+}
+
+func (g *GuestAccount) IsValid() *AppError {
+	if !IsValidId(g.UserId) {
+		return NewAppError("GuestAccount.IsValid", "model.guest_account.is_valid.user_id.app_error", nil, "user_id="+g.UserId, http.StatusBadRequest)
+	}
+
+	if g.SponsorId != "" && !IsValidId(g.SponsorId) {
+		return NewAppError("GuestAccount.IsValid", "model.guest_account.is_valid.sponsor_id.app_error", nil, "sponsor_id="+g.SponsorId, http.StatusBadRequest)
+	}
+
+	if g.ExpiresAt < 0 {
+		return NewAppError("GuestAccount.IsValid", "model.guest_account.is_valid.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if g.CreateAt == 0 {
+		return NewAppError("GuestAccount.IsValid", "model.guest_account.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if g.UpdateAt == 0 {
+		return NewAppError("GuestAccount.IsValid", "model.guest_account.is_valid.update_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the account has an expiry date at or before the
+// given time, in milliseconds.
+func (g *GuestAccount) IsExpired(now int64) bool {
+	return g.ExpiresAt > 0 && g.ExpiresAt <= now
+}
+
+// GuestAccountExpiry returns the expiry date, in milliseconds, of a guest
+// account created or extended at the given time, or zero if guests do not
+// expire.
+func GuestAccountExpiry(from int64, expiryDays int) int64 {
+	if expiryDays <= 0 {
+		return 0
+	}
+
+	return from + int64(expiryDays)*int64(24*time.Hour/time.Millisecond)
+}
+
+// GuestAccountExtension is the body of a request to extend a guest account.
+// If ExpiresAt is zero, the account is extended by the configured number of
+// expiry days. Only system admins can set ExpiresAt further than that.
+type GuestAccountExtension struct {
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// PendingGuestInvite is a guest invitation waiting for a system admin to
+// approve it before the invite emails are sent.
+type PendingGuestInvite struct {
+	Id        string      `json:"id"`
+	CreateAt  int64       `json:"create_at"`
+	TeamId    string      `json:"team_id"`
+	SenderId  string      `json:"sender_id"`
+	SponsorId string      `json:"sponsor_id"`
+	Emails    StringArray `json:"emails"`
+	Channels  StringArray `json:"channels"`
+	Message   string      `json:"message"`
+}
+
+func (i *PendingGuestInvite) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         i.Id,
+		"team_id":    i.TeamId,
+		"sender_id":  i.SenderId,
+		"sponsor_id": i.SponsorId,
+		"emails":     i.Emails,
+		"channels":   i.Channels,
+	}
+}
+
+func (i *PendingGuestInvite) PreSave() {
+	if i.Id == "" {
+		i.Id = NewId()
+	}
+
+	if i.CreateAt == 0 {
+		i.CreateAt = GetMillis()
+	}
+}
+
+func (i *PendingGuestInvite) IsValid() *AppError {
+	if !IsValidId(i.Id) {
+		return NewAppError("PendingGuestInvite.IsValid", "model.pending_guest_invite.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if i.CreateAt == 0 {
+		return NewAppError("PendingGuestInvite.IsValid", "model.pending_guest_invite.is_valid.create_at.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.TeamId) {
+		return NewAppError("PendingGuestInvite.IsValid", "model.pending_guest_invite.is_valid.team_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(i.SenderId) {
+		return NewAppError("PendingGuestInvite.IsValid", "model.pending_guest_invite.is_valid.sender_id.app_error", nil, "id="+i.Id, http.StatusBadRequest)
+	}
+
+	invite := i.GuestsInvite()
+	return invite.IsValid()
+}
+
+// GuestsInvite returns the invitation to send once the invite is approved.
+func (i *PendingGuestInvite) GuestsInvite() *GuestsInvite {
+	return &GuestsInvite{
+		Emails:    i.Emails,
+		Channels:  i.Channels,
+		Message:   i.Message,
+		SponsorId: i.SponsorId,
+	}
+}
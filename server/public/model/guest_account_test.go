@@ -0,0 +1,80 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestAccountIsValid(t *testing.T) {
+	account := &GuestAccount{
+		UserId:    NewId(),
+		SponsorId: NewId(),
+	}
+	account.PreSave()
+	require.Nil(t, account.IsValid())
+
+	account.ExpiresAt = -1
+	require.NotNil(t, account.IsValid())
+	account.ExpiresAt = 0
+
+	account.SponsorId = "invalid"
+	require.NotNil(t, account.IsValid())
+
+	account.SponsorId = ""
+	require.Nil(t, account.IsValid(), "guests without a sponsor are valid")
+}
+
+func TestGuestAccountIsExpired(t *testing.T) {
+	account := &GuestAccount{}
+	assert.False(t, account.IsExpired(1000), "accounts without an expiry date never expire")
+
+	account.ExpiresAt = 1000
+	assert.False(t, account.IsExpired(999))
+	assert.True(t, account.IsExpired(1000))
+	assert.True(t, account.IsExpired(1001))
+}
+
+func TestGuestAccountExpiry(t *testing.T) {
+	assert.Equal(t, int64(0), GuestAccountExpiry(1000, 0))
+	assert.Equal(t, int64(1000+2*24*60*60*1000), GuestAccountExpiry(1000, 2))
+}
+
+func TestPendingGuestInviteIsValid(t *testing.T) {
+	invite := &PendingGuestInvite{
+		TeamId:    NewId(),
+		SenderId:  NewId(),
+		SponsorId: NewId(),
+		Emails:    StringArray{"guest@example.com"},
+		Channels:  StringArray{NewId()},
+	}
+	invite.PreSave()
+	require.Nil(t, invite.IsValid())
+
+	invite.SponsorId = "invalid"
+	require.NotNil(t, invite.IsValid())
+	invite.SponsorId = NewId()
+
+	invite.Emails = nil
+	require.NotNil(t, invite.IsValid())
+}
+
+func TestGuestsInviteIsValidSponsor(t *testing.T) {
+	invite := &GuestsInvite{
+		Emails:   []string{"guest@example.com"},
+		Channels: []string{NewId()},
+	}
+	require.Nil(t, invite.IsValid(), "the sponsor is optional")
+
+	invite.SponsorId = "invalid"
+	appErr := invite.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, "model.guest.is_valid.sponsor.app_error", appErr.Id)
+
+	invite.SponsorId = NewId()
+	require.Nil(t, invite.IsValid())
+}
@@ -11,12 +11,17 @@ type GuestsInvite struct {
 	Emails   []string `json:"emails"`
 	Channels []string `json:"channels"`
 	Message  string   `json:"message"`
+	// SponsorId is the member responsible for the invited guests, who is
+	// warned before their accounts expire and may extend them. It defaults
+	// to the member sending the invite.
+	SponsorId string `json:"sponsor_id"`
 }
 
 func (i *GuestsInvite) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"emails":   i.Emails,
-		"channels": i.Channels,
+		"emails":     i.Emails,
+		"channels":   i.Channels,
+		"sponsor_id": i.SponsorId,
 	}
 }
 
@@ -42,5 +47,9 @@ func (i *GuestsInvite) IsValid() *AppError {
 			return NewAppError("GuestsInvite.IsValid", "model.guest.is_valid.channel.app_error", nil, "channel="+channel, http.StatusBadRequest)
 		}
 	}
+
+	if i.SponsorId != "" && !IsValidId(i.SponsorId) {
+		return NewAppError("GuestsInvite.IsValid", "model.guest.is_valid.sponsor.app_error", nil, "sponsor_id="+i.SponsorId, http.StatusBadRequest)
+	}
 	return nil
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
 type AllowedIPRanges []AllowedIPRange
 
 type AllowedIPRange struct {
@@ -18,3 +24,31 @@ func (air *AllowedIPRanges) Auditable() map[string]interface{} {
 type GetIPAddressResponse struct {
 	IP string `json:"ip"`
 }
+
+// ParseIPRanges parses a whitespace separated list of CIDR blocks, as used by
+// IPFilteringSettings. A bare IP address is treated as a block containing only
+// that address.
+func ParseIPRanges(ranges string) ([]*net.IPNet, error) {
+	var ipNets []*net.IPNet
+	for _, block := range strings.Fields(ranges) {
+		if !strings.Contains(block, "/") {
+			ip := net.ParseIP(block)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", block)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			block = fmt.Sprintf("%s/%d", block, bits)
+		}
+
+		_, ipNet, err := net.ParseCIDR(block)
+		if err != nil {
+			return nil, err
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+
+	return ipNets, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPRanges(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		ipNets, err := ParseIPRanges("  ")
+		require.NoError(t, err)
+		assert.Empty(t, ipNets)
+	})
+
+	t.Run("CIDR blocks and addresses", func(t *testing.T) {
+		ipNets, err := ParseIPRanges("10.0.0.0/8\n192.168.1.10  2001:db8::/32 ::1")
+		require.NoError(t, err)
+		require.Len(t, ipNets, 4)
+		assert.Equal(t, "10.0.0.0/8", ipNets[0].String())
+		assert.Equal(t, "192.168.1.10/32", ipNets[1].String())
+		assert.Equal(t, "2001:db8::/32", ipNets[2].String())
+		assert.Equal(t, "::1/128", ipNets[3].String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ParseIPRanges("10.0.0.0/8 10.0.0.0/33")
+		assert.Error(t, err)
+
+		_, err = ParseIPRanges("vpn.example.com")
+		assert.Error(t, err)
+	})
+}
+
+func TestIPFilteringSettingsIsValid(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		settings IPFilteringSettings
+		errorID  string
+	}{
+		{
+			name:     "defaults",
+			settings: IPFilteringSettings{},
+		},
+		{
+			name: "valid",
+			settings: IPFilteringSettings{
+				AllowedIPRanges: NewPointer("10.0.0.0/8"),
+				DeniedIPRanges:  NewPointer("10.0.0.1"),
+				RoleIPRanges:    map[string]string{SystemAdminRoleId: "10.8.0.0/16"},
+			},
+		},
+		{
+			name:     "invalid allowed ranges",
+			settings: IPFilteringSettings{AllowedIPRanges: NewPointer("10.0.0.0/8,10.1.0.0/16")},
+			errorID:  "model.config.is_valid.ip_filtering_allowed_ranges.app_error",
+		},
+		{
+			name:     "invalid denied ranges",
+			settings: IPFilteringSettings{DeniedIPRanges: NewPointer("localhost")},
+			errorID:  "model.config.is_valid.ip_filtering_denied_ranges.app_error",
+		},
+		{
+			name:     "invalid role",
+			settings: IPFilteringSettings{RoleIPRanges: map[string]string{"not a role": "10.8.0.0/16"}},
+			errorID:  "model.config.is_valid.ip_filtering_role.app_error",
+		},
+		{
+			name:     "empty role ranges",
+			settings: IPFilteringSettings{RoleIPRanges: map[string]string{SystemAdminRoleId: ""}},
+			errorID:  "model.config.is_valid.ip_filtering_role_ranges.app_error",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.settings.SetDefaults()
+			appErr := tc.settings.isValid()
+			if tc.errorID == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, tc.errorID, appErr.Id)
+			}
+		})
+	}
+}
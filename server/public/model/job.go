@@ -41,6 +41,8 @@ const (
 	JobTypeExportUsersToCSV              = "export_users_to_csv"
 	JobTypeDeleteDmsPreferencesMigration = "delete_dms_preferences_migration"
 	JobTypeMobileSessionMetadata         = "mobile_session_metadata"
+	JobTypeGuestExpiry                   = "guest_expiry"
+	JobTypeUserDataExport                = "user_data_export"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -74,6 +76,8 @@ var AllJobTypes = [...]string{
 	JobTypeCleanupDesktopTokens,
 	JobTypeRefreshPostStats,
 	JobTypeMobileSessionMetadata,
+	JobTypeGuestExpiry,
+	JobTypeUserDataExport,
 }
 
 type Job struct {
@@ -157,7 +161,7 @@ func IsValidJobType(jobType string) bool {
 		}
 	}
 
-	return false
+	return IsPluginJobType(jobType)
 }
 
 func (j *Job) LogClone() any {
@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const (
+	LoginFingerprintTypeIPRange   = "ip_range"
+	LoginFingerprintTypeUserAgent = "user_agent"
+
+	LoginFingerprintValueMaxLength = 256
+
+	// Logins are grouped by network rather than by exact address so that
+	// dynamic address assignment within a provider does not raise alerts.
+	LoginFingerprintIPv4PrefixLength = 24
+	LoginFingerprintIPv6PrefixLength = 48
+)
+
+// LoginFingerprint records a network range or client that a user has
+// successfully logged in from before. It is used to detect logins from
+// never-before-seen locations.
+type LoginFingerprint struct {
+	UserId     string `json:"user_id"`
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	CreateAt   int64  `json:"create_at"`
+	LastSeenAt int64  `json:"last_seen_at"`
+}
+
+func (f *LoginFingerprint) PreSave() {
+	if f.CreateAt == 0 {
+		f.CreateAt = GetMillis()
+	}
+
+	if f.LastSeenAt == 0 {
+		f.LastSeenAt = f.CreateAt
+	}
+}
+
+func (f *LoginFingerprint) IsValid() *AppError {
+	if !IsValidId(f.UserId) {
+		return NewAppError("LoginFingerprint.IsValid", "model.login_fingerprint.is_valid.user_id.app_error", nil, "user_id="+f.UserId, http.StatusBadRequest)
+	}
+
+	if f.Type != LoginFingerprintTypeIPRange && f.Type != LoginFingerprintTypeUserAgent {
+		return NewAppError("LoginFingerprint.IsValid", "model.login_fingerprint.is_valid.type.app_error", nil, "type="+f.Type, http.StatusBadRequest)
+	}
+
+	if f.Value == "" || len(f.Value) > LoginFingerprintValueMaxLength {
+		return NewAppError("LoginFingerprint.IsValid", "model.login_fingerprint.is_valid.value.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if f.CreateAt == 0 {
+		return NewAppError("LoginFingerprint.IsValid", "model.login_fingerprint.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// LoginIPRange returns the network, in CIDR notation, that the given IP
+// address belongs to for the purposes of login fingerprinting. An empty
+// string is returned if the address cannot be parsed.
+func LoginIPRange(ipAddress string) string {
+	ip := net.ParseIP(strings.TrimSpace(ipAddress))
+	if ip == nil {
+		return ""
+	}
+
+	if ipv4 := ip.To4(); ipv4 != nil {
+		mask := net.CIDRMask(LoginFingerprintIPv4PrefixLength, 8*net.IPv4len)
+		return fmt.Sprintf("%s/%d", ipv4.Mask(mask).String(), LoginFingerprintIPv4PrefixLength)
+	}
+
+	mask := net.CIDRMask(LoginFingerprintIPv6PrefixLength, 8*net.IPv6len)
+	return fmt.Sprintf("%s/%d", ip.Mask(mask).String(), LoginFingerprintIPv6PrefixLength)
+}
+
+// LoginUserAgent returns the client fingerprint for the given parsed user
+// agent details. Versions are deliberately left out so that routine
+// browser upgrades are not reported as new clients.
+func LoginUserAgent(platform, os, browserName string) string {
+	value := strings.Join([]string{platform, os, browserName}, "|")
+	if len(value) > LoginFingerprintValueMaxLength {
+		value = value[:LoginFingerprintValueMaxLength]
+	}
+	return value
+}
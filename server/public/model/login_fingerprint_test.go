@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginFingerprintIsValid(t *testing.T) {
+	fingerprint := &LoginFingerprint{
+		UserId: NewId(),
+		Type:   LoginFingerprintTypeIPRange,
+		Value:  "10.0.0.0/24",
+	}
+	require.NotNil(t, fingerprint.IsValid())
+
+	fingerprint.PreSave()
+	require.Nil(t, fingerprint.IsValid())
+	assert.Equal(t, fingerprint.CreateAt, fingerprint.LastSeenAt)
+
+	fingerprint.UserId = "invalid"
+	require.NotNil(t, fingerprint.IsValid())
+	fingerprint.UserId = NewId()
+
+	fingerprint.Type = "unknown"
+	require.NotNil(t, fingerprint.IsValid())
+	fingerprint.Type = LoginFingerprintTypeUserAgent
+
+	fingerprint.Value = ""
+	require.NotNil(t, fingerprint.IsValid())
+
+	fingerprint.Value = strings.Repeat("a", LoginFingerprintValueMaxLength+1)
+	require.NotNil(t, fingerprint.IsValid())
+
+	fingerprint.Value = strings.Repeat("a", LoginFingerprintValueMaxLength)
+	require.Nil(t, fingerprint.IsValid())
+}
+
+func TestLoginIPRange(t *testing.T) {
+	for _, tc := range []struct {
+		ip       string
+		expected string
+	}{
+		{"203.0.113.57", "203.0.113.0/24"},
+		{" 203.0.113.1 ", "203.0.113.0/24"},
+		{"::ffff:203.0.113.57", "203.0.113.0/24"},
+		{"2001:db8:abcd:12::1", "2001:db8:abcd::/48"},
+		{"", ""},
+		{"not-an-ip", ""},
+	} {
+		t.Run(tc.ip, func(t *testing.T) {
+			assert.Equal(t, tc.expected, LoginIPRange(tc.ip))
+		})
+	}
+}
+
+func TestLoginUserAgent(t *testing.T) {
+	assert.Equal(t, "Macintosh|Mac OS|Chrome", LoginUserAgent("Macintosh", "Mac OS", "Chrome"))
+	assert.Len(t, LoginUserAgent(strings.Repeat("a", 300), "", ""), LoginFingerprintValueMaxLength)
+}
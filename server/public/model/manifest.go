@@ -218,8 +218,38 @@ type Manifest struct {
 
 	// Plugins can store any kind of data in Props to allow other plugins to use it.
 	Props map[string]any `json:"props,omitempty" yaml:"props,omitempty"`
+
+	// Capabilities lists the plugin API capabilities the plugin requires, e.g. "posts:read".
+	// Administrators approve them when installing or upgrading the plugin.
+	//
+	// Minimum server version: 10.3
+	Capabilities []string `json:"capabilities,omitempty" yaml:"capabilities,omitempty"`
+
+	// Permissions lists permissions the plugin registers with the server. Administrators can grant
+	// them to roles and schemes, and the plugin checks them through the HasPermissionTo* API methods
+	// using the ID returned by PluginPermissionId.
+	//
+	// Minimum server version: 10.3
+	Permissions []*PluginPermission `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+
+	// Dependencies lists other plugins, and the versions of them, that must be installed and
+	// active for this plugin to be activated. Plugins are activated after their dependencies, and
+	// a plugin can't be disabled while enabled plugins depend on it, unless forced.
+	//
+	// Minimum server version: 10.3
+	Dependencies []*PluginDependency `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
 }
 
+const (
+	// PluginRuntimeProcess runs the server component as a separate process, communicating
+	// with the server over RPC. This is the default.
+	PluginRuntimeProcess = "process"
+
+	// PluginRuntimeWasm runs the server component as a WebAssembly module inside the server
+	// process. The Executable field must then point at a .wasm module.
+	PluginRuntimeWasm = "wasm"
+)
+
 type ManifestServer struct {
 	// Executables are the paths to your executable binaries, specifying multiple entry
 	// points for different platforms when bundled together in a single plugin.
@@ -233,6 +263,9 @@ type ManifestServer struct {
 	// If your plugin is compiled for multiple platforms, consider bundling them together
 	// and using the Executables field instead.
 	Executable string `json:"executable" yaml:"executable"`
+
+	// Runtime selects how the server component is executed: "process" (the default) or "wasm".
+	Runtime string `json:"runtime,omitempty" yaml:"runtime,omitempty"`
 }
 
 type ManifestWebapp struct {
@@ -291,6 +324,15 @@ func (m *Manifest) HasServer() bool {
 	return m.Server != nil
 }
 
+// ServerRuntime returns the runtime used to execute the server component, defaulting to
+// PluginRuntimeProcess.
+func (m *Manifest) ServerRuntime() string {
+	if m.Server == nil || m.Server.Runtime == "" {
+		return PluginRuntimeProcess
+	}
+	return m.Server.Runtime
+}
+
 func (m *Manifest) HasWebapp() bool {
 	return m.Webapp != nil
 }
@@ -342,6 +384,42 @@ func (m *Manifest) IsValid() error {
 		}
 	}
 
+	if m.Server != nil {
+		switch m.ServerRuntime() {
+		case PluginRuntimeProcess:
+		case PluginRuntimeWasm:
+			if m.Server.Executable == "" {
+				return errors.New("a WebAssembly server component needs an executable")
+			}
+		default:
+			return errors.Errorf("invalid server runtime %q", m.Server.Runtime)
+		}
+	}
+
+	for _, capability := range m.Capabilities {
+		if !IsValidPluginCapability(capability) {
+			return errors.Errorf("invalid capability %q", capability)
+		}
+	}
+
+	permissionIDs := make(map[string]bool, len(m.Permissions))
+	for _, permission := range m.Permissions {
+		if permission == nil {
+			return errors.New("invalid empty permission")
+		}
+		if err := permission.IsValid(); err != nil {
+			return err
+		}
+		if permissionIDs[permission.Id] {
+			return errors.Errorf("duplicate permission %q", permission.Id)
+		}
+		permissionIDs[permission.Id] = true
+	}
+
+	if err := m.IsValidDependencies(); err != nil {
+		return err
+	}
+
 	if m.SettingsSchema != nil {
 		err := m.SettingsSchema.isValid()
 		if err != nil {
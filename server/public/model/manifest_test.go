@@ -31,7 +31,20 @@ func TestIsValid(t *testing.T) {
 		{"SettingSchema error", &Manifest{Id: "com.company.test", Name: "some name", HomepageURL: "http://someurl.com", SupportURL: "http://someotherurl.com", Version: "5.10.0", MinServerVersion: "5.10.8", SettingsSchema: &PluginSettingsSchema{
 			Settings: []*PluginSetting{{Type: "Invalid"}},
 		}}, true},
+		{"Invalid capability", &Manifest{Id: "com.company.test", Name: "some name", Capabilities: []string{PluginCapabilityPostsRead, "posts:delete"}}, true},
 		{"Minimal valid manifest", &Manifest{Id: "com.company.test", Name: "some name"}, false},
+		{"Valid capabilities", &Manifest{Id: "com.company.test", Name: "some name", Capabilities: []string{PluginCapabilityPostsRead, PluginCapabilityWebSocketSend}}, false},
+		{"Invalid server runtime", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.exe", Runtime: "jvm"}}, true},
+		{"WebAssembly runtime without executable", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executables: map[string]string{"linux-amd64": "plugin-linux-amd64"}, Runtime: PluginRuntimeWasm}}, true},
+		{"WebAssembly runtime", &Manifest{Id: "com.company.test", Name: "some name", Server: &ManifestServer{Executable: "plugin.wasm", Runtime: PluginRuntimeWasm}}, false},
+		{"Invalid permission", &Manifest{Id: "com.company.test", Name: "some name", Permissions: []*PluginPermission{{Id: "Manage Widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam}}}, true},
+		{"Duplicate permission", &Manifest{Id: "com.company.test", Name: "some name", Permissions: []*PluginPermission{
+			{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam},
+			{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeChannel},
+		}}, true},
+		{"Valid permissions", &Manifest{Id: "com.company.test", Name: "some name", Permissions: []*PluginPermission{
+			{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam, DefaultRoles: []string{TeamAdminRoleId}},
+		}}, false},
 		{"Happy case", &Manifest{
 			Id:               "com.company.test",
 			Name:             "thename",
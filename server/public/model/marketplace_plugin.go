@@ -116,6 +116,8 @@ func (filter *MarketplacePluginFilter) ApplyToURL(u *url.URL) {
 type InstallMarketplacePluginRequest struct {
 	Id      string `json:"id"`
 	Version string `json:"version"`
+	// ApproveCapabilities grants the capabilities requested by the plugin manifest once installed.
+	ApproveCapabilities bool `json:"approve_capabilities,omitempty"`
 }
 
 // PluginRequestFromReader decodes a json-encoded plugin request from the given io.Reader.
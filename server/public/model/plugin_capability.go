@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"slices"
+)
+
+// Plugin capabilities are declared in a plugin manifest and must be granted by an administrator
+// before a plugin may call the corresponding plugin API methods, when
+// PluginSettings.EnforceCapabilities is enabled.
+const (
+	PluginCapabilityPostsRead     = "posts:read"
+	PluginCapabilityPostsWrite    = "posts:write"
+	PluginCapabilityChannelsRead  = "channels:read"
+	PluginCapabilityChannelsWrite = "channels:write"
+	PluginCapabilityTeamsRead     = "teams:read"
+	PluginCapabilityTeamsWrite    = "teams:write"
+	PluginCapabilityUsersRead     = "users:read"
+	PluginCapabilityUsersWrite    = "users:write"
+	PluginCapabilitySessionsRead  = "sessions:read"
+	PluginCapabilitySessionsWrite = "sessions:write"
+	PluginCapabilityFilesRead     = "files:read"
+	PluginCapabilityFilesWrite    = "files:write"
+	PluginCapabilityConfigRead    = "config:read"
+	PluginCapabilityConfigWrite   = "config:write"
+	PluginCapabilityCommandsWrite = "commands:write"
+	PluginCapabilityOAuthWrite    = "oauth:write"
+	PluginCapabilityPluginsManage = "plugins:manage"
+	PluginCapabilityMailSend      = "mail:send"
+	PluginCapabilityPushSend      = "push:send"
+	PluginCapabilityWebSocketSend = "websocket:send"
+	PluginCapabilityStoreMigrate  = "store:migrate"
+)
+
+var PluginCapabilities = []string{
+	PluginCapabilityPostsRead,
+	PluginCapabilityPostsWrite,
+	PluginCapabilityChannelsRead,
+	PluginCapabilityChannelsWrite,
+	PluginCapabilityTeamsRead,
+	PluginCapabilityTeamsWrite,
+	PluginCapabilityUsersRead,
+	PluginCapabilityUsersWrite,
+	PluginCapabilitySessionsRead,
+	PluginCapabilitySessionsWrite,
+	PluginCapabilityFilesRead,
+	PluginCapabilityFilesWrite,
+	PluginCapabilityConfigRead,
+	PluginCapabilityConfigWrite,
+	PluginCapabilityCommandsWrite,
+	PluginCapabilityOAuthWrite,
+	PluginCapabilityPluginsManage,
+	PluginCapabilityMailSend,
+	PluginCapabilityPushSend,
+	PluginCapabilityWebSocketSend,
+	PluginCapabilityStoreMigrate,
+}
+
+func IsValidPluginCapability(capability string) bool {
+	return slices.Contains(PluginCapabilities, capability)
+}
+
+// PluginCapabilitiesPendingApproval returns the capabilities requested by the manifest that
+// have not been granted.
+func PluginCapabilitiesPendingApproval(manifest *Manifest, granted []string) []string {
+	pending := []string{}
+	for _, capability := range manifest.Capabilities {
+		if !slices.Contains(granted, capability) {
+			pending = append(pending, capability)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidPluginCapability(t *testing.T) {
+	for _, capability := range PluginCapabilities {
+		assert.True(t, IsValidPluginCapability(capability), capability)
+	}
+
+	assert.False(t, IsValidPluginCapability(""))
+	assert.False(t, IsValidPluginCapability("posts"))
+	assert.False(t, IsValidPluginCapability("POSTS:READ"))
+}
+
+func TestPluginCapabilitiesPendingApproval(t *testing.T) {
+	manifest := &Manifest{
+		Id:           "com.company.test",
+		Capabilities: []string{PluginCapabilityPostsRead, PluginCapabilityUsersWrite},
+	}
+
+	assert.Equal(t, []string{PluginCapabilityPostsRead, PluginCapabilityUsersWrite}, PluginCapabilitiesPendingApproval(manifest, nil))
+	assert.Equal(t, []string{PluginCapabilityUsersWrite}, PluginCapabilitiesPendingApproval(manifest, []string{PluginCapabilityPostsRead}))
+	assert.Empty(t, PluginCapabilitiesPendingApproval(manifest, []string{PluginCapabilityUsersWrite, PluginCapabilityPostsRead}))
+	assert.Empty(t, PluginCapabilitiesPendingApproval(&Manifest{Id: "com.company.test"}, nil))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+)
+
+// PluginDependency declares that a plugin requires another plugin to be installed and active
+// before it can be activated.
+type PluginDependency struct {
+	// Id is the ID of the required plugin.
+	Id string `json:"id" yaml:"id"`
+
+	// Version is a semantic version range the required plugin must satisfy, such as ">=1.2.0" or
+	// ">=1.2.0 <2.0.0". Ranges can be combined with "||". Any version is accepted if empty.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+}
+
+func (d *PluginDependency) IsValid() error {
+	if !IsValidPluginId(d.Id) {
+		return errors.Errorf("invalid dependency plugin ID %q", d.Id)
+	}
+
+	if d.Version != "" {
+		if _, err := semver.ParseRange(d.Version); err != nil {
+			return errors.Wrapf(err, "failed to parse version range of dependency %q", d.Id)
+		}
+	}
+
+	return nil
+}
+
+// IsSatisfiedBy reports whether the given manifest is the required plugin at an acceptable version.
+func (d *PluginDependency) IsSatisfiedBy(manifest *Manifest) bool {
+	if manifest == nil || manifest.Id != d.Id {
+		return false
+	}
+
+	if d.Version == "" {
+		return true
+	}
+
+	versionRange, err := semver.ParseRange(d.Version)
+	if err != nil {
+		return false
+	}
+
+	version, err := semver.Parse(manifest.Version)
+	if err != nil {
+		return false
+	}
+
+	return versionRange(version)
+}
+
+// IsValidDependencies checks that the dependencies are well-formed, that none is declared twice
+// and that the plugin doesn't depend on itself.
+func (m *Manifest) IsValidDependencies() error {
+	dependencyIDs := make(map[string]bool, len(m.Dependencies))
+	for _, dependency := range m.Dependencies {
+		if dependency == nil {
+			return errors.New("invalid empty dependency")
+		}
+		if err := dependency.IsValid(); err != nil {
+			return err
+		}
+		if dependency.Id == m.Id {
+			return errors.New("a plugin cannot depend on itself")
+		}
+		if dependencyIDs[dependency.Id] {
+			return errors.Errorf("duplicate dependency %q", dependency.Id)
+		}
+		dependencyIDs[dependency.Id] = true
+	}
+
+	return nil
+}
+
+// GetDependency returns the plugin's dependency on the given plugin, if any.
+func (m *Manifest) GetDependency(pluginID string) *PluginDependency {
+	for _, dependency := range m.Dependencies {
+		if dependency != nil && dependency.Id == pluginID {
+			return dependency
+		}
+	}
+
+	return nil
+}
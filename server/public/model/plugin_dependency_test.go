@@ -0,0 +1,57 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginDependencyIsValid(t *testing.T) {
+	assert.NoError(t, (&PluginDependency{Id: "com.mattermost.calendar"}).IsValid())
+	assert.NoError(t, (&PluginDependency{Id: "com.mattermost.calendar", Version: ">=1.2.0 <2.0.0 || >=3.0.0"}).IsValid())
+	assert.Error(t, (&PluginDependency{Id: "x"}).IsValid())
+	assert.Error(t, (&PluginDependency{Id: "com.mattermost.calendar", Version: "latest"}).IsValid())
+}
+
+func TestPluginDependencyIsSatisfiedBy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		dependency *PluginDependency
+		manifest   *Manifest
+		expected   bool
+	}{
+		"any version":        {&PluginDependency{Id: "calendar"}, &Manifest{Id: "calendar", Version: "0.1.0"}, true},
+		"matching version":   {&PluginDependency{Id: "calendar", Version: ">=1.2.0 <2.0.0"}, &Manifest{Id: "calendar", Version: "1.4.2"}, true},
+		"too old":            {&PluginDependency{Id: "calendar", Version: ">=1.2.0"}, &Manifest{Id: "calendar", Version: "1.1.0"}, false},
+		"too new":            {&PluginDependency{Id: "calendar", Version: "<2.0.0"}, &Manifest{Id: "calendar", Version: "2.0.0"}, false},
+		"invalid version":    {&PluginDependency{Id: "calendar", Version: ">=1.0.0"}, &Manifest{Id: "calendar", Version: "latest"}, false},
+		"other plugin":       {&PluginDependency{Id: "calendar"}, &Manifest{Id: "tasks", Version: "1.0.0"}, false},
+		"not installed":      {&PluginDependency{Id: "calendar"}, nil, false},
+		"alternative ranges": {&PluginDependency{Id: "calendar", Version: "<1.0.0 || >=3.0.0"}, &Manifest{Id: "calendar", Version: "3.1.0"}, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.dependency.IsSatisfiedBy(tc.manifest))
+		})
+	}
+}
+
+func TestManifestIsValidDependencies(t *testing.T) {
+	manifest := &Manifest{Id: "com.mattermost.tasks"}
+	assert.NoError(t, manifest.IsValidDependencies())
+
+	manifest.Dependencies = []*PluginDependency{{Id: "com.mattermost.calendar", Version: ">=1.0.0"}}
+	assert.NoError(t, manifest.IsValidDependencies())
+	assert.Equal(t, manifest.Dependencies[0], manifest.GetDependency("com.mattermost.calendar"))
+	assert.Nil(t, manifest.GetDependency("com.mattermost.other"))
+
+	manifest.Dependencies = []*PluginDependency{nil}
+	assert.Error(t, manifest.IsValidDependencies())
+
+	manifest.Dependencies = []*PluginDependency{{Id: "com.mattermost.tasks"}}
+	assert.EqualError(t, manifest.IsValidDependencies(), "a plugin cannot depend on itself")
+
+	manifest.Dependencies = []*PluginDependency{{Id: "com.mattermost.calendar"}, {Id: "com.mattermost.calendar", Version: "1.0.0"}}
+	assert.EqualError(t, manifest.IsValidDependencies(), `duplicate dependency "com.mattermost.calendar"`)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const (
+	// PluginJobTypePrefix prefixes the types of the jobs registered by plugins, which are
+	// otherwise named by the plugin.
+	PluginJobTypePrefix = "plugin_"
+	// PluginJobNameMaxLength keeps plugin job types within the 32 characters allowed for job
+	// types.
+	PluginJobNameMaxLength = 32 - len(PluginJobTypePrefix)
+	// PluginJobMinIntervalSeconds is the shortest interval plugin jobs can be scheduled at, since
+	// the job schedulers only run once a minute.
+	PluginJobMinIntervalSeconds = 60
+)
+
+var validPluginJobName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// PluginJobDefinition describes a job type registered by a plugin.
+type PluginJobDefinition struct {
+	// Name identifies the job type. It must be unique across plugins, and consist of at most
+	// PluginJobNameMaxLength lowercase letters, digits and underscores. The job type is the
+	// name prefixed with PluginJobTypePrefix.
+	Name string `json:"name"`
+	// IntervalSeconds schedules a job of the type every interval, if set. Otherwise, jobs of the
+	// type are only created on demand.
+	IntervalSeconds int64 `json:"interval_seconds"`
+}
+
+func (d *PluginJobDefinition) IsValid() *AppError {
+	if len(d.Name) > PluginJobNameMaxLength || !validPluginJobName.MatchString(d.Name) {
+		return NewAppError("PluginJobDefinition.IsValid", "model.plugin_job.is_valid.name.app_error", map[string]any{"MaxLength": PluginJobNameMaxLength}, "name="+d.Name, http.StatusBadRequest)
+	}
+
+	if d.IntervalSeconds != 0 && d.IntervalSeconds < PluginJobMinIntervalSeconds {
+		return NewAppError("PluginJobDefinition.IsValid", "model.plugin_job.is_valid.interval.app_error", map[string]any{"Min": PluginJobMinIntervalSeconds}, "name="+d.Name, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// JobType returns the type of the jobs of the definition.
+func (d *PluginJobDefinition) JobType() string {
+	return PluginJobType(d.Name)
+}
+
+// PluginJobType returns the type of the jobs registered by a plugin under the given name.
+func PluginJobType(name string) string {
+	return PluginJobTypePrefix + name
+}
+
+// IsPluginJobType reports whether the given job type may have been registered by a plugin.
+func IsPluginJobType(jobType string) bool {
+	name, found := strings.CutPrefix(jobType, PluginJobTypePrefix)
+	return found && len(name) <= PluginJobNameMaxLength && validPluginJobName.MatchString(name)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginJobDefinitionIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Definition PluginJobDefinition
+		ErrorID    string
+	}{
+		"valid":                {Definition: PluginJobDefinition{Name: "sync_users"}},
+		"valid with interval":  {Definition: PluginJobDefinition{Name: "sync", IntervalSeconds: 60}},
+		"longest name":         {Definition: PluginJobDefinition{Name: strings.Repeat("a", PluginJobNameMaxLength)}},
+		"empty name":           {Definition: PluginJobDefinition{}, ErrorID: "model.plugin_job.is_valid.name.app_error"},
+		"name too long":        {Definition: PluginJobDefinition{Name: strings.Repeat("a", PluginJobNameMaxLength+1)}, ErrorID: "model.plugin_job.is_valid.name.app_error"},
+		"invalid characters":   {Definition: PluginJobDefinition{Name: "Sync-Users"}, ErrorID: "model.plugin_job.is_valid.name.app_error"},
+		"interval too short":   {Definition: PluginJobDefinition{Name: "sync", IntervalSeconds: 59}, ErrorID: "model.plugin_job.is_valid.interval.app_error"},
+		"interval is negative": {Definition: PluginJobDefinition{Name: "sync", IntervalSeconds: -1}, ErrorID: "model.plugin_job.is_valid.interval.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			appErr := tc.Definition.IsValid()
+			if tc.ErrorID == "" {
+				require.Nil(t, appErr)
+				return
+			}
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.ErrorID, appErr.Id)
+		})
+	}
+}
+
+func TestPluginJobType(t *testing.T) {
+	jobType := PluginJobType("sync")
+	assert.Equal(t, "plugin_sync", jobType)
+	assert.True(t, IsPluginJobType(jobType))
+	assert.True(t, IsValidJobType(jobType))
+
+	assert.False(t, IsPluginJobType(JobTypePlugins))
+	assert.False(t, IsPluginJobType("plugin_"))
+	assert.False(t, IsPluginJobType("plugin_Sync"))
+	assert.False(t, IsValidJobType("plugin_"))
+}
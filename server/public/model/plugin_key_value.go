@@ -31,3 +31,76 @@ func (kv *PluginKeyValue) IsValid() *AppError {
 
 	return nil
 }
+
+const (
+	PluginKVListMaxPerPage     = 1000
+	PluginKVListDefaultPerPage = 100
+	PluginKVBatchMaxSize       = 1000
+)
+
+// PluginKVMetadata describes a stored plugin key without its value. Keys saved before their
+// timestamps were recorded are dated to the upgrade that added them.
+type PluginKVMetadata struct {
+	Key      string `json:"key" db:"PKey"`
+	CreateAt int64  `json:"create_at"`
+	UpdateAt int64  `json:"update_at"`
+	ExpireAt int64  `json:"expire_at"`
+}
+
+// PluginKVListOptions selects a range of plugin keys, in ascending key order.
+type PluginKVListOptions struct {
+	Prefix  string // Only list keys starting with Prefix
+	Cursor  string // Only list keys sorting after Cursor. Use the NextCursor of a previous page to continue from it
+	EndKey  string // Only list keys sorting before EndKey
+	PerPage int    // Maximum number of keys to return, defaults to PluginKVListDefaultPerPage
+}
+
+// PluginKVListPage is a page of keys returned by a PluginKVListOptions query.
+type PluginKVListPage struct {
+	Keys []string `json:"keys"`
+	// NextCursor is empty once the last page has been returned.
+	NextCursor string `json:"next_cursor"`
+}
+
+// PluginKVBatchOperation is a single write applied as part of a plugin KV batch.
+type PluginKVBatchOperation struct {
+	Key     string
+	Value   []byte // A nil value removes the key
+	Options PluginKVSetOptions
+}
+
+func (o *PluginKVListOptions) SetDefaults() {
+	if o.PerPage <= 0 {
+		o.PerPage = PluginKVListDefaultPerPage
+	}
+}
+
+func (o *PluginKVListOptions) IsValid() *AppError {
+	if o.PerPage < 0 || o.PerPage > PluginKVListMaxPerPage {
+		return NewAppError("PluginKVListOptions.IsValid", "model.plugin_kv_list_options.is_valid.per_page.app_error", map[string]any{"Max": PluginKVListMaxPerPage}, "", http.StatusBadRequest)
+	}
+
+	if o.EndKey != "" && o.Cursor != "" && o.EndKey <= o.Cursor {
+		return NewAppError("PluginKVListOptions.IsValid", "model.plugin_kv_list_options.is_valid.range.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsValidPluginKVBatch checks the size of a batch and the options of each of its operations.
+func IsValidPluginKVBatch(ops []*PluginKVBatchOperation) *AppError {
+	if len(ops) > PluginKVBatchMaxSize {
+		return NewAppError("IsValidPluginKVBatch", "model.plugin_kv_batch.is_valid.size.app_error", map[string]any{"Max": PluginKVBatchMaxSize}, "", http.StatusBadRequest)
+	}
+
+	for _, op := range ops {
+		if op == nil {
+			return NewAppError("IsValidPluginKVBatch", "model.plugin_kv_batch.is_valid.operation.app_error", nil, "", http.StatusBadRequest)
+		}
+		if err := op.Options.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -23,3 +23,43 @@ func TestPluginKeyIsValid(t *testing.T) {
 	kv.Key = "this is an extremely long, long, long, long, long, long, long, long, long, long, long, long, long key and should be invalid and this is being verified in this test"
 	assert.NotNil(t, kv.IsValid())
 }
+
+func TestPluginKVListOptionsIsValid(t *testing.T) {
+	opts := PluginKVListOptions{}
+	assert.Nil(t, opts.IsValid())
+
+	opts.SetDefaults()
+	assert.Equal(t, PluginKVListDefaultPerPage, opts.PerPage)
+
+	opts.PerPage = PluginKVListMaxPerPage + 1
+	assert.NotNil(t, opts.IsValid())
+
+	opts.PerPage = 10
+	opts.Cursor = "b"
+	opts.EndKey = "a"
+	assert.NotNil(t, opts.IsValid())
+
+	opts.EndKey = "c"
+	assert.Nil(t, opts.IsValid())
+}
+
+func TestIsValidPluginKVBatch(t *testing.T) {
+	assert.Nil(t, IsValidPluginKVBatch(nil))
+
+	ops := []*PluginKVBatchOperation{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Options: PluginKVSetOptions{Atomic: true, OldValue: []byte("2")}},
+	}
+	assert.Nil(t, IsValidPluginKVBatch(ops))
+
+	ops = append(ops, &PluginKVBatchOperation{Key: "c", Options: PluginKVSetOptions{OldValue: []byte("3")}})
+	assert.NotNil(t, IsValidPluginKVBatch(ops))
+
+	assert.NotNil(t, IsValidPluginKVBatch([]*PluginKVBatchOperation{nil}))
+
+	ops = make([]*PluginKVBatchOperation, PluginKVBatchMaxSize+1)
+	for i := range ops {
+		ops[i] = &PluginKVBatchOperation{Key: "k"}
+	}
+	assert.NotNil(t, IsValidPluginKVBatch(ops))
+}
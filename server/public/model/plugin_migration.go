@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const PluginMigrationNameMaxRunes = 255
+
+// PluginMigration is a versioned database schema change shipped by a plugin. The server records
+// the migrations a plugin has applied, along with the SQL reverting them, so that they can be
+// rolled back even when the plugin isn't running.
+type PluginMigration struct {
+	PluginId string `json:"plugin_id"`
+	Version  int64  `json:"version"`
+	Name     string `json:"name"`
+	// Up is the SQL applying the migration, for the database driver in use. It is only set when
+	// applying a migration, and isn't stored.
+	Up string `json:"up,omitempty"`
+	// Down is the SQL reverting the migration, for the database driver in use. Migrations without
+	// it can't be rolled back.
+	Down      string `json:"down,omitempty"`
+	AppliedAt int64  `json:"applied_at"`
+}
+
+func (m *PluginMigration) PreSave() {
+	if m.AppliedAt == 0 {
+		m.AppliedAt = GetMillis()
+	}
+}
+
+func (m *PluginMigration) IsValid() *AppError {
+	if !IsValidPluginId(m.PluginId) {
+		return NewAppError("PluginMigration.IsValid", "model.plugin_migration.is_valid.plugin_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if m.Version <= 0 {
+		return NewAppError("PluginMigration.IsValid", "model.plugin_migration.is_valid.version.app_error", nil, "plugin_id="+m.PluginId, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(m.Name) > PluginMigrationNameMaxRunes {
+		return NewAppError("PluginMigration.IsValid", "model.plugin_migration.is_valid.name.app_error", map[string]any{"MaxLength": PluginMigrationNameMaxRunes}, "plugin_id="+m.PluginId, http.StatusBadRequest)
+	}
+
+	if strings.TrimSpace(m.Up) == "" {
+		return NewAppError("PluginMigration.IsValid", "model.plugin_migration.is_valid.up.app_error", nil, "plugin_id="+m.PluginId, http.StatusBadRequest)
+	}
+
+	if m.AppliedAt == 0 {
+		return NewAppError("PluginMigration.IsValid", "model.plugin_migration.is_valid.applied_at.app_error", nil, "plugin_id="+m.PluginId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PluginMigrationRollbackRequest asks to roll back every migration of a plugin applied after the
+// given version, newest first. A version of 0 rolls back all of them.
+type PluginMigrationRollbackRequest struct {
+	ToVersion int64 `json:"to_version"`
+}
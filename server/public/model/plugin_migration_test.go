@@ -0,0 +1,46 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginMigrationIsValid(t *testing.T) {
+	valid := func() *PluginMigration {
+		m := &PluginMigration{
+			PluginId: "com.mattermost.demo",
+			Version:  1,
+			Name:     "create_table",
+			Up:       "CREATE TABLE demo (id varchar(26))",
+		}
+		m.PreSave()
+		return m
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	for name, tc := range map[string]struct {
+		mutate func(m *PluginMigration)
+		id     string
+	}{
+		"invalid plugin id": {func(m *PluginMigration) { m.PluginId = "a" }, "model.plugin_migration.is_valid.plugin_id.app_error"},
+		"zero version":      {func(m *PluginMigration) { m.Version = 0 }, "model.plugin_migration.is_valid.version.app_error"},
+		"long name":         {func(m *PluginMigration) { m.Name = strings.Repeat("a", PluginMigrationNameMaxRunes+1) }, "model.plugin_migration.is_valid.name.app_error"},
+		"empty up":          {func(m *PluginMigration) { m.Up = " " }, "model.plugin_migration.is_valid.up.app_error"},
+		"no applied at":     {func(m *PluginMigration) { m.AppliedAt = 0 }, "model.plugin_migration.is_valid.applied_at.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := valid()
+			tc.mutate(m)
+			appErr := m.IsValid()
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.id, appErr.Id)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Permissions registered by plugins are namespaced by the plugin ID, e.g.
+// "plugin:com.mattermost.demo:manage_widgets", so they can't collide with core permissions or
+// with those of other plugins.
+const (
+	PluginPermissionPrefix = "plugin:"
+
+	PluginPermissionScopeSystem  = "system"
+	PluginPermissionScopeTeam    = "team"
+	PluginPermissionScopeChannel = "channel"
+
+	PluginPermissionNameMaxLength        = 64
+	PluginPermissionDescriptionMaxLength = 256
+)
+
+var validPluginPermissionId = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// pluginPermissionDefaultRoles lists the built-in roles a plugin permission of the given scope
+// may be granted to by default.
+var pluginPermissionDefaultRoles = map[string][]string{
+	PluginPermissionScopeSystem: {
+		SystemUserRoleId,
+		SystemManagerRoleId,
+		SystemUserManagerRoleId,
+		SystemReadOnlyAdminRoleId,
+	},
+	PluginPermissionScopeTeam: {
+		SystemUserRoleId,
+		TeamAdminRoleId,
+		TeamUserRoleId,
+		TeamGuestRoleId,
+	},
+	PluginPermissionScopeChannel: {
+		SystemUserRoleId,
+		TeamAdminRoleId,
+		TeamUserRoleId,
+		TeamGuestRoleId,
+		ChannelAdminRoleId,
+		ChannelUserRoleId,
+		ChannelGuestRoleId,
+	},
+}
+
+// PluginPermission is a permission declared in a plugin manifest. Once the plugin is activated it
+// can be granted to roles like any core permission and checked through the plugin API.
+type PluginPermission struct {
+	// Id identifies the permission within the plugin. It may only contain lowercase letters,
+	// digits and underscores.
+	Id string `json:"id" yaml:"id"`
+
+	// Name is shown to administrators when editing roles and schemes.
+	Name string `json:"name" yaml:"name"`
+
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Scope is one of "system", "team" or "channel", and determines which roles and schemes the
+	// permission applies to.
+	Scope string `json:"scope" yaml:"scope"`
+
+	// DefaultRoles lists the built-in roles the permission is granted to when first registered,
+	// e.g. "team_admin". System admins are always granted every plugin permission.
+	DefaultRoles []string `json:"default_roles,omitempty" yaml:"default_roles,omitempty"`
+}
+
+func (p *PluginPermission) IsValid() error {
+	if !validPluginPermissionId.MatchString(p.Id) {
+		return errors.Errorf("invalid permission id %q", p.Id)
+	}
+
+	if strings.TrimSpace(p.Name) == "" || len(p.Name) > PluginPermissionNameMaxLength {
+		return errors.Errorf("invalid name for permission %q", p.Id)
+	}
+
+	if len(p.Description) > PluginPermissionDescriptionMaxLength {
+		return errors.Errorf("description of permission %q is too long", p.Id)
+	}
+
+	allowedRoles, ok := pluginPermissionDefaultRoles[p.Scope]
+	if !ok {
+		return errors.Errorf("invalid scope %q for permission %q", p.Scope, p.Id)
+	}
+
+	for _, role := range p.DefaultRoles {
+		if !slices.Contains(allowedRoles, role) {
+			return errors.Errorf("role %q can't be granted %s scoped permission %q", role, p.Scope, p.Id)
+		}
+	}
+
+	return nil
+}
+
+// ToPermission converts the plugin permission into a core permission namespaced by the plugin ID.
+func (p *PluginPermission) ToPermission(pluginID string) *Permission {
+	return &Permission{
+		Id:          PluginPermissionId(pluginID, p.Id),
+		Name:        p.Name,
+		Description: p.Description,
+		Scope:       p.PermissionScope(),
+	}
+}
+
+// PermissionScope maps the manifest scope onto the corresponding core permission scope.
+func (p *PluginPermission) PermissionScope() string {
+	switch p.Scope {
+	case PluginPermissionScopeTeam:
+		return PermissionScopeTeam
+	case PluginPermissionScopeChannel:
+		return PermissionScopeChannel
+	default:
+		return PermissionScopeSystem
+	}
+}
+
+// PluginPermissionId returns the ID under which a plugin permission is stored in roles, and which
+// plugins pass to the HasPermissionTo* API methods.
+func PluginPermissionId(pluginID, permissionID string) string {
+	return PluginPermissionPrefix + pluginID + ":" + permissionID
+}
+
+// PluginPermissionPrefixFor returns the prefix shared by all permissions of the given plugin.
+func PluginPermissionPrefixFor(pluginID string) string {
+	return PluginPermissionPrefix + pluginID + ":"
+}
+
+// IsPluginPermissionId reports whether the given permission ID is a well-formed plugin permission.
+// Roles may keep permissions of disabled plugins, so this doesn't require the plugin to be active.
+func IsPluginPermissionId(id string) bool {
+	rest, ok := strings.CutPrefix(id, PluginPermissionPrefix)
+	if !ok {
+		return false
+	}
+
+	pluginID, permissionID, ok := strings.Cut(rest, ":")
+	return ok && IsValidPluginId(pluginID) && validPluginPermissionId.MatchString(permissionID)
+}
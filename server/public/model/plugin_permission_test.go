@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginPermissionIsValid(t *testing.T) {
+	testCases := []struct {
+		Title       string
+		Permission  PluginPermission
+		ExpectError bool
+	}{
+		{"valid", PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam, DefaultRoles: []string{TeamAdminRoleId}}, false},
+		{"valid without default roles", PluginPermission{Id: "view_widgets", Name: "View widgets", Scope: PluginPermissionScopeSystem}, false},
+		{"channel role on channel scope", PluginPermission{Id: "pin_widgets", Name: "Pin widgets", Scope: PluginPermissionScopeChannel, DefaultRoles: []string{ChannelUserRoleId, TeamAdminRoleId}}, false},
+		{"empty id", PluginPermission{Name: "Manage widgets", Scope: PluginPermissionScopeTeam}, true},
+		{"id with colon", PluginPermission{Id: "manage:widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam}, true},
+		{"uppercase id", PluginPermission{Id: "ManageWidgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam}, true},
+		{"empty name", PluginPermission{Id: "manage_widgets", Name: " ", Scope: PluginPermissionScopeTeam}, true},
+		{"long description", PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Description: strings.Repeat("a", PluginPermissionDescriptionMaxLength+1), Scope: PluginPermissionScopeTeam}, true},
+		{"invalid scope", PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Scope: PermissionScopeTeam}, true},
+		{"channel role on team scope", PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeTeam, DefaultRoles: []string{ChannelAdminRoleId}}, true},
+		{"system admin default role", PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Scope: PluginPermissionScopeSystem, DefaultRoles: []string{SystemAdminRoleId}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Title, func(t *testing.T) {
+			err := tc.Permission.IsValid()
+			if tc.ExpectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPluginPermissionToPermission(t *testing.T) {
+	permission := &PluginPermission{Id: "manage_widgets", Name: "Manage widgets", Description: "Create and delete widgets", Scope: PluginPermissionScopeChannel}
+
+	assert.Equal(t, &Permission{
+		Id:          "plugin:com.company.test:manage_widgets",
+		Name:        "Manage widgets",
+		Description: "Create and delete widgets",
+		Scope:       PermissionScopeChannel,
+	}, permission.ToPermission("com.company.test"))
+}
+
+func TestIsPluginPermissionId(t *testing.T) {
+	assert.True(t, IsPluginPermissionId(PluginPermissionId("com.company.test", "manage_widgets")))
+	assert.True(t, strings.HasPrefix(PluginPermissionId("com.company.test", "manage_widgets"), PluginPermissionPrefixFor("com.company.test")))
+
+	assert.False(t, IsPluginPermissionId(PermissionCreatePost.Id))
+	assert.False(t, IsPluginPermissionId("plugin:com.company.test"))
+	assert.False(t, IsPluginPermissionId("plugin::manage_widgets"))
+	assert.False(t, IsPluginPermissionId("plugin:com.company.test:manage widgets"))
+	assert.False(t, IsPluginPermissionId("plugin:com.company.test:manage:widgets"))
+}
+
+func TestRoleIsValidWithPluginPermissions(t *testing.T) {
+	role := &Role{
+		Id:          NewId(),
+		Name:        "custom_role",
+		DisplayName: "Custom role",
+		Permissions: []string{PermissionCreatePost.Id, PluginPermissionId("com.company.test", "manage_widgets")},
+	}
+	assert.True(t, role.IsValid())
+
+	role.Permissions = append(role.Permissions, "plugin:com.company.test")
+	assert.False(t, role.IsValid())
+}
@@ -22,6 +22,13 @@ type PluginStatus struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	Version     string `json:"version"`
+	// HooksBypassedUntil is set while the plugin's hooks are bypassed after repeatedly timing out
+	// or crashing, along with the failure that caused it in HookError.
+	HooksBypassedUntil int64  `json:"hooks_bypassed_until,omitempty"`
+	HookError          string `json:"hook_error,omitempty"`
+	// UnmetDependencies lists the declared dependencies that aren't installed at a matching
+	// version or aren't active.
+	UnmetDependencies []*PluginDependency `json:"unmet_dependencies,omitempty"`
 }
 
 type PluginStatuses []*PluginStatus
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"path/filepath"
+)
+
+const (
+	PluginWatchLogLevelDebug = "debug"
+	PluginWatchLogLevelInfo  = "info"
+	PluginWatchLogLevelWarn  = "warn"
+	PluginWatchLogLevelError = "error"
+)
+
+// PluginWatchRequest asks the server to deploy a plugin from a local directory and to redeploy it
+// whenever its manifest, server executables or webapp bundle change.
+type PluginWatchRequest struct {
+	// Path is the absolute path of the directory containing the plugin's plugin.json, on the
+	// machine running the server.
+	Path string `json:"path"`
+}
+
+func (r *PluginWatchRequest) IsValid() *AppError {
+	if r.Path == "" || !filepath.IsAbs(r.Path) {
+		return NewAppError("PluginWatchRequest.IsValid", "model.plugin_watch_request.path.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// PluginWatchLogEntry is a log line from a watched plugin, or from the server about the plugin's
+// deployment, streamed to the developer as newline-delimited JSON.
+type PluginWatchLogEntry struct {
+	Timestamp int64             `json:"timestamp"`
+	Level     string            `json:"level"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
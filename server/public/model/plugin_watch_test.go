@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginWatchRequestIsValid(t *testing.T) {
+	absolute, err := filepath.Abs("plugin")
+	assert.NoError(t, err)
+
+	assert.NotNil(t, (&PluginWatchRequest{}).IsValid())
+	assert.NotNil(t, (&PluginWatchRequest{Path: "relative/plugin"}).IsValid())
+	assert.Nil(t, (&PluginWatchRequest{Path: absolute}).IsValid())
+}
@@ -77,6 +77,7 @@ const (
 	PostPropsPreviewedPost            = "previewed_post"
 
 	PostPriorityUrgent               = "urgent"
+	PostPriorityImportant            = "important"
 	PostPropsRequestedAck            = "requested_ack"
 	PostPropsPersistentNotifications = "persistent_notifications"
 )
@@ -163,13 +164,6 @@ type PostPatch struct {
 	HasReactions *bool            `json:"has_reactions"`
 }
 
-type PostReminder struct {
-	TargetTime int64 `json:"target_time"`
-	// These fields are only used internally for interacting with DB.
-	PostId string `json:",omitempty"`
-	UserId string `json:",omitempty"`
-}
-
 type PostPriority struct {
 	Priority                *string `json:"priority"`
 	RequestedAck            *bool   `json:"requested_ack"`
@@ -204,6 +198,9 @@ type SearchParameter struct {
 	Page                   *int    `json:"page"`
 	PerPage                *int    `json:"per_page"`
 	IncludeDeletedChannels *bool   `json:"include_deleted_channels"`
+	SortBy                 *string `json:"sort_by"`
+	IncludeSnippets        *bool   `json:"include_snippets"`
+	IncludeFacets          *bool   `json:"include_facets"`
 }
 
 type AnalyticsPostCountsOptions struct {
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// PostReminderMaxDuration is how far in the future a reminder can be set relative to now.
+	PostReminderMaxDuration = 365 * 24 * time.Hour
+
+	postReminderDateTimeLayout = "2006-01-02 15:04"
+	postReminderTimeLayout     = "15:04"
+)
+
+// PostReminder reminds a user of a post at a time. The time is set either as TargetTime, in
+// seconds since the epoch, or relative to when the reminder is set with In or At.
+type PostReminder struct {
+	TargetTime int64 `json:"target_time"`
+	// In is a duration from now, such as "30m", "2h" or "3d".
+	In string `json:"in,omitempty"`
+	// At is a date and time in the timezone of the user, such as "2024-06-03 09:00", or just a
+	// time, such as "09:00", for the next time it comes.
+	At string `json:"at,omitempty"`
+
+	PostId string `json:"post_id,omitempty"`
+	UserId string `json:"user_id,omitempty"`
+}
+
+// ResolveTargetTime sets the target time of the reminder from In or At, if either is set,
+// relative to now and in the location of the user.
+func (r *PostReminder) ResolveTargetTime(now time.Time, location *time.Location) *AppError {
+	switch {
+	case r.In != "" && r.At != "":
+		return NewAppError("PostReminder.ResolveTargetTime", "model.post_reminder.resolve_target_time.in_and_at.app_error", nil, "", http.StatusBadRequest)
+	case r.In != "":
+		duration, err := parsePostReminderDuration(r.In)
+		if err != nil || duration <= 0 || duration > PostReminderMaxDuration {
+			return NewAppError("PostReminder.ResolveTargetTime", "model.post_reminder.resolve_target_time.in.app_error", map[string]any{"In": r.In}, "", http.StatusBadRequest).Wrap(err)
+		}
+		r.TargetTime = now.Add(duration).Unix()
+	case r.At != "":
+		now = now.In(location)
+		target, err := time.ParseInLocation(postReminderDateTimeLayout, r.At, location)
+		if err != nil {
+			clock, clockErr := time.ParseInLocation(postReminderTimeLayout, r.At, location)
+			if clockErr != nil {
+				return NewAppError("PostReminder.ResolveTargetTime", "model.post_reminder.resolve_target_time.at.app_error", map[string]any{"At": r.At}, "", http.StatusBadRequest).Wrap(err)
+			}
+			target = time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+			if !target.After(now) {
+				target = target.AddDate(0, 0, 1)
+			}
+		}
+		if !target.After(now) || target.Sub(now) > PostReminderMaxDuration {
+			return NewAppError("PostReminder.ResolveTargetTime", "model.post_reminder.resolve_target_time.at.app_error", map[string]any{"At": r.At}, "", http.StatusBadRequest)
+		}
+		r.TargetTime = target.Unix()
+	}
+
+	return nil
+}
+
+func (r *PostReminder) IsValid() *AppError {
+	if r.TargetTime <= 0 {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.target_time.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// parsePostReminderDuration parses a duration as time.ParseDuration does, also accepting a
+// number of days such as "3d".
+func parsePostReminderDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostReminderResolveTargetTime(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	now := time.Date(2024, time.June, 3, 14, 30, 0, 0, location)
+
+	for name, tc := range map[string]struct {
+		reminder PostReminder
+		expected time.Time
+		errorId  string
+	}{
+		"target time": {
+			reminder: PostReminder{TargetTime: 1000},
+			expected: time.Unix(1000, 0),
+		},
+		"in minutes": {
+			reminder: PostReminder{In: "30m"},
+			expected: now.Add(30 * time.Minute),
+		},
+		"in days": {
+			reminder: PostReminder{In: "3d"},
+			expected: now.Add(3 * 24 * time.Hour),
+		},
+		"in the past": {
+			reminder: PostReminder{In: "-1h"},
+			errorId:  "model.post_reminder.resolve_target_time.in.app_error",
+		},
+		"in more than a year": {
+			reminder: PostReminder{In: "366d"},
+			errorId:  "model.post_reminder.resolve_target_time.in.app_error",
+		},
+		"invalid in": {
+			reminder: PostReminder{In: "soon"},
+			errorId:  "model.post_reminder.resolve_target_time.in.app_error",
+		},
+		"at a date and time": {
+			reminder: PostReminder{At: "2024-06-04 09:00"},
+			expected: time.Date(2024, time.June, 4, 9, 0, 0, 0, location),
+		},
+		"at a time later today": {
+			reminder: PostReminder{At: "17:00"},
+			expected: time.Date(2024, time.June, 3, 17, 0, 0, 0, location),
+		},
+		"at a time tomorrow": {
+			reminder: PostReminder{At: "09:00"},
+			expected: time.Date(2024, time.June, 4, 9, 0, 0, 0, location),
+		},
+		"at a date in the past": {
+			reminder: PostReminder{At: "2024-06-01 09:00"},
+			errorId:  "model.post_reminder.resolve_target_time.at.app_error",
+		},
+		"invalid at": {
+			reminder: PostReminder{At: "tomorrow"},
+			errorId:  "model.post_reminder.resolve_target_time.at.app_error",
+		},
+		"in and at": {
+			reminder: PostReminder{In: "1h", At: "17:00"},
+			errorId:  "model.post_reminder.resolve_target_time.in_and_at.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			appErr := tc.reminder.ResolveTargetTime(now, location)
+			if tc.errorId != "" {
+				require.NotNil(t, appErr)
+				assert.Equal(t, tc.errorId, appErr.Id)
+				return
+			}
+			require.Nil(t, appErr)
+			assert.Equal(t, tc.expected.Unix(), tc.reminder.TargetTime)
+		})
+	}
+}
+
+func TestPostReminderIsValid(t *testing.T) {
+	assert.NotNil(t, (&PostReminder{}).IsValid())
+	assert.Nil(t, (&PostReminder{TargetTime: 1000}).IsValid())
+}
@@ -6,20 +6,234 @@ package model
 import (
 	"encoding/json"
 	"io"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	PostSearchSnippetMaxLength = 200
+	PostSearchFacetSize        = 10
+
+	PostSearchDateFacetDay   = "day"
+	PostSearchDateFacetWeek  = "week"
+	PostSearchDateFacetMonth = "month"
+	PostSearchDateFacetYear  = "year"
+	PostSearchDateFacetOlder = "older"
 )
 
 type PostSearchMatches map[string][]string
 
+// PostSearchSnippet is an excerpt of the message of a post around the words matching
+// a search. The highlights are the offsets, in characters, of the matches in the text.
+type PostSearchSnippet struct {
+	Text       string                `json:"text"`
+	Highlights []PostSearchHighlight `json:"highlights"`
+}
+
+type PostSearchHighlight struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// PostSearchFacets count the posts matching a search by channel, author, date and type
+// of attached file. Only the most frequent values are included.
+type PostSearchFacets struct {
+	Channels  []*PostSearchFacet `json:"channels"`
+	Users     []*PostSearchFacet `json:"users"`
+	Dates     []*PostSearchFacet `json:"dates"`
+	FileTypes []*PostSearchFacet `json:"file_types"`
+}
+
+type PostSearchFacet struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
 type PostSearchResults struct {
 	*PostList
-	Matches PostSearchMatches `json:"matches"`
+	Matches  PostSearchMatches             `json:"matches"`
+	Snippets map[string]*PostSearchSnippet `json:"snippets,omitempty"`
+	Facets   *PostSearchFacets             `json:"facets,omitempty"`
 }
 
 func MakePostSearchResults(posts *PostList, matches PostSearchMatches) *PostSearchResults {
 	return &PostSearchResults{
-		posts,
-		matches,
+		PostList: posts,
+		Matches:  matches,
+	}
+}
+
+// PostSearchDateFacetRanges returns the ranges of creation times, in milliseconds, counted by
+// each date facet at the given time. The start is inclusive, the end exclusive and zero means
+// the range is unbounded.
+func PostSearchDateFacetRanges(now int64) map[string][2]int64 {
+	const day = int64(24 * 60 * 60 * 1000)
+	return map[string][2]int64{
+		PostSearchDateFacetDay:   {now - day, 0},
+		PostSearchDateFacetWeek:  {now - 7*day, now - day},
+		PostSearchDateFacetMonth: {now - 30*day, now - 7*day},
+		PostSearchDateFacetYear:  {now - 365*day, now - 30*day},
+		PostSearchDateFacetOlder: {0, now - 365*day},
+	}
+}
+
+// PostSearchDateFacets are the date facets, from the most recent.
+var PostSearchDateFacets = []string{
+	PostSearchDateFacetDay,
+	PostSearchDateFacetWeek,
+	PostSearchDateFacetMonth,
+	PostSearchDateFacetYear,
+	PostSearchDateFacetOlder,
+}
+
+// CountPostSearchFacets counts the facets of the given posts, for the search backends returning
+// all the posts matching a search at once. The extensions of the files are given by post id.
+func CountPostSearchFacets(posts *PostList, fileExtensions map[string][]string, now int64) *PostSearchFacets {
+	channelCounts := map[string]int{}
+	userCounts := map[string]int{}
+	dateCounts := map[string]int{}
+	fileTypeCounts := map[string]int{}
+
+	dateRanges := PostSearchDateFacetRanges(now)
+	for _, post := range posts.Posts {
+		channelCounts[post.ChannelId]++
+		userCounts[post.UserId]++
+		for name, dateRange := range dateRanges {
+			if post.CreateAt >= dateRange[0] && (dateRange[1] == 0 || post.CreateAt < dateRange[1]) {
+				dateCounts[name]++
+			}
+		}
+		for _, extension := range fileExtensions[post.Id] {
+			fileTypeCounts[extension]++
+		}
+	}
+
+	facets := &PostSearchFacets{
+		Channels:  topPostSearchFacets(channelCounts),
+		Users:     topPostSearchFacets(userCounts),
+		Dates:     []*PostSearchFacet{},
+		FileTypes: topPostSearchFacets(fileTypeCounts),
+	}
+	for _, name := range PostSearchDateFacets {
+		if dateCounts[name] > 0 {
+			facets.Dates = append(facets.Dates, &PostSearchFacet{Value: name, Count: dateCounts[name]})
+		}
 	}
+
+	return facets
+}
+
+func topPostSearchFacets(counts map[string]int) []*PostSearchFacet {
+	facets := make([]*PostSearchFacet, 0, len(counts))
+	for value, count := range counts {
+		facets = append(facets, &PostSearchFacet{Value: value, Count: count})
+	}
+	slices.SortFunc(facets, func(a, b *PostSearchFacet) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.Value, b.Value)
+	})
+	return facets[:min(len(facets), PostSearchFacetSize)]
+}
+
+// FindSearchTermMatches returns the words of the message matching the terms of the search,
+// for the search backends that don't return which words matched.
+func FindSearchTermMatches(message string, paramsList []*SearchParams) []string {
+	var terms []string
+	for _, params := range paramsList {
+		for _, term := range strings.Fields(params.Terms) {
+			term = strings.ToLower(strings.Trim(term, `"#`))
+			if term != "" {
+				terms = append(terms, term)
+			}
+		}
+	}
+
+	matches := []string{}
+	words := strings.FieldsFunc(message, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-'
+	})
+	for _, word := range words {
+		lowerWord := strings.ToLower(word)
+		for _, term := range terms {
+			prefix, isPrefix := strings.CutSuffix(term, "*")
+			if (lowerWord == term || (isPrefix && strings.HasPrefix(lowerWord, prefix))) && !slices.Contains(matches, word) {
+				matches = append(matches, word)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// MakePostSearchSnippet returns the excerpt of the message around the first of the
+// matched words, highlighting all the matched words in it.
+func MakePostSearchSnippet(message string, matches []string) *PostSearchSnippet {
+	text := []rune(message)
+	lowerText := make([]rune, len(text))
+	for i, r := range text {
+		lowerText[i] = unicode.ToLower(r)
+	}
+
+	var highlights []PostSearchHighlight
+	for _, match := range matches {
+		lowerMatch := []rune(strings.ToLower(match))
+		if len(lowerMatch) == 0 || len(lowerMatch) != len([]rune(match)) {
+			continue
+		}
+		for i := 0; i+len(lowerMatch) <= len(lowerText); i++ {
+			if slices.Equal(lowerText[i:i+len(lowerMatch)], lowerMatch) {
+				highlights = append(highlights, PostSearchHighlight{Start: i, End: i + len(lowerMatch)})
+			}
+		}
+	}
+	slices.SortFunc(highlights, func(a, b PostSearchHighlight) int {
+		return a.Start - b.Start
+	})
+
+	// Start the snippet a few words before the first match.
+	start := 0
+	if len(highlights) > 0 && highlights[0].End > PostSearchSnippetMaxLength {
+		start = highlights[0].Start - PostSearchSnippetMaxLength/4
+		for start < highlights[0].Start && !unicode.IsSpace(text[start]) {
+			start++
+		}
+		for start < highlights[0].Start && unicode.IsSpace(text[start]) {
+			start++
+		}
+	}
+	end := min(start+PostSearchSnippetMaxLength, len(text))
+
+	snippet := &PostSearchSnippet{
+		Text:       string(text[start:end]),
+		Highlights: []PostSearchHighlight{},
+	}
+	prefixLength := 0
+	if start > 0 {
+		snippet.Text = "…" + snippet.Text
+		prefixLength = 1
+	}
+	if end < len(text) {
+		snippet.Text += "…"
+	}
+
+	lastEnd := -1
+	for _, highlight := range highlights {
+		// Overlapping matches, and those not entirely in the snippet, are skipped.
+		if highlight.Start < lastEnd || highlight.Start < start || highlight.End > end {
+			continue
+		}
+		snippet.Highlights = append(snippet.Highlights, PostSearchHighlight{
+			Start: highlight.Start - start + prefixLength,
+			End:   highlight.End - start + prefixLength,
+		})
+		lastEnd = highlight.End
+	}
+
+	return snippet
 }
 
 func (o *PostSearchResults) ToJSON() (string, error) {
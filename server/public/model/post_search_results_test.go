@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSearchTermMatches(t *testing.T) {
+	paramsList := ParseSearchParams(`deploy* "release notes" #incident`, 0)
+
+	matches := FindSearchTermMatches("Deploying the release today, see the notes in #incident and the deploy log", paramsList)
+	assert.Equal(t, []string{"Deploying", "release", "notes", "incident", "deploy"}, matches)
+
+	assert.Empty(t, FindSearchTermMatches("nothing to see here", paramsList))
+}
+
+func TestMakePostSearchSnippet(t *testing.T) {
+	t.Run("short message", func(t *testing.T) {
+		snippet := MakePostSearchSnippet("The Release is out, release notes below", []string{"release"})
+
+		assert.Equal(t, "The Release is out, release notes below", snippet.Text)
+		assert.Equal(t, []PostSearchHighlight{{Start: 4, End: 11}, {Start: 20, End: 27}}, snippet.Highlights)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		message := strings.Repeat("word ", 100)
+		snippet := MakePostSearchSnippet(message, nil)
+
+		assert.Equal(t, message[:PostSearchSnippetMaxLength]+"…", snippet.Text)
+		assert.Empty(t, snippet.Highlights)
+	})
+
+	t.Run("match far in a long message", func(t *testing.T) {
+		message := strings.Repeat("lorem ipsum ", 50) + "the 東京 office " + strings.Repeat("dolor sit ", 50)
+		snippet := MakePostSearchSnippet(message, []string{"東京"})
+
+		require.True(t, strings.HasPrefix(snippet.Text, "…"))
+		require.True(t, strings.HasSuffix(snippet.Text, "…"))
+		require.Len(t, snippet.Highlights, 1)
+
+		text := []rune(snippet.Text)
+		highlight := snippet.Highlights[0]
+		assert.Equal(t, "東京", string(text[highlight.Start:highlight.End]))
+		assert.LessOrEqual(t, len(text), PostSearchSnippetMaxLength+2)
+	})
+
+	t.Run("overlapping matches", func(t *testing.T) {
+		snippet := MakePostSearchSnippet("deployment", []string{"deploy", "deployment"})
+
+		assert.Equal(t, []PostSearchHighlight{{Start: 0, End: 6}}, snippet.Highlights)
+	})
+}
+
+func TestCountPostSearchFacets(t *testing.T) {
+	now := GetMillis()
+	day := int64(24 * 60 * 60 * 1000)
+
+	posts := NewPostList()
+	posts.AddPost(&Post{Id: "post1", ChannelId: "channel1", UserId: "user1", CreateAt: now - 1000})
+	posts.AddPost(&Post{Id: "post2", ChannelId: "channel1", UserId: "user2", CreateAt: now - 3*day})
+	posts.AddPost(&Post{Id: "post3", ChannelId: "channel2", UserId: "user1", CreateAt: now - 400*day})
+
+	facets := CountPostSearchFacets(posts, map[string][]string{
+		"post1": {"pdf", "png"},
+		"post3": {"pdf"},
+	}, now)
+
+	assert.Equal(t, []*PostSearchFacet{{Value: "channel1", Count: 2}, {Value: "channel2", Count: 1}}, facets.Channels)
+	assert.Equal(t, []*PostSearchFacet{{Value: "user1", Count: 2}, {Value: "user2", Count: 1}}, facets.Users)
+	assert.Equal(t, []*PostSearchFacet{
+		{Value: PostSearchDateFacetDay, Count: 1},
+		{Value: PostSearchDateFacetWeek, Count: 1},
+		{Value: PostSearchDateFacetOlder, Count: 1},
+	}, facets.Dates)
+	assert.Equal(t, []*PostSearchFacet{{Value: "pdf", Count: 2}, {Value: "png", Count: 1}}, facets.FileTypes)
+}
+
+func TestPostSearchOptionsIsValid(t *testing.T) {
+	assert.Nil(t, (&PostSearchOptions{}).IsValid())
+	assert.Nil(t, (&PostSearchOptions{SortBy: SearchSortByRecent}).IsValid())
+	assert.Nil(t, (&PostSearchOptions{SortBy: SearchSortByRelevance}).IsValid())
+	assert.NotNil(t, (&PostSearchOptions{SortBy: "popularity"}).IsValid())
+}
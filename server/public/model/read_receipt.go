@@ -0,0 +1,18 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// ReadReceipt records that a member of a channel has seen its posts up to a time. Receipts are
+// only shared by the users who opt in with PreferenceNameShareReadReceipts.
+type ReadReceipt struct {
+	UserId       string `json:"user_id"`
+	ChannelId    string `json:"channel_id"`
+	LastViewedAt int64  `json:"last_viewed_at"`
+}
+
+// PostReadReceipts lists the members of the channel of a post who have seen it.
+type PostReadReceipts struct {
+	PostId string         `json:"post_id"`
+	SeenBy []*ReadReceipt `json:"seen_by"`
+}
@@ -720,7 +720,7 @@ func (r *Role) IsValidWithoutId() bool {
 		return false
 	}
 	for _, permission := range r.Permissions {
-		permissionValidated := check(AllPermissions, permission) || check(DeprecatedPermissions, permission)
+		permissionValidated := check(AllPermissions, permission) || check(DeprecatedPermissions, permission) || IsPluginPermissionId(permission)
 		if !permissionValidated {
 			return false
 		}
@@ -0,0 +1,386 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	SavedSearchNameMaxRunes  = 64
+	SavedSearchTermsMaxRunes = 1024
+	// SavedSearchMaxPerUser is the number of searches each user can save.
+	SavedSearchMaxPerUser = 50
+)
+
+// SavedSearch is a post search saved by a user to be run again later, and
+// optionally to alert the user about new posts matching it.
+type SavedSearch struct {
+	Id     string `json:"id"`
+	UserId string `json:"user_id"`
+	// TeamId restricts the search to a team. It searches all the teams of the
+	// user if empty.
+	TeamId     string `json:"team_id"`
+	Name       string `json:"name"`
+	Terms      string `json:"terms"`
+	IsOrSearch bool   `json:"is_or_search"`
+	// Params are the search parameters parsed from Terms.
+	Params []*SearchParams `json:"params"`
+	// Alert enables direct messages from the system bot about new posts
+	// matching the search.
+	Alert    bool  `json:"alert"`
+	CreateAt int64 `json:"create_at"`
+	UpdateAt int64 `json:"update_at"`
+	// TimeZoneOffset is the offset of the user's time zone, in seconds, used
+	// to parse date filters of Terms.
+	TimeZoneOffset int `json:"timezone_offset" db:"-"`
+}
+
+// SavedSearchPatch holds the fields of a saved search that can be updated.
+type SavedSearchPatch struct {
+	Name           *string `json:"name"`
+	Terms          *string `json:"terms"`
+	IsOrSearch     *bool   `json:"is_or_search"`
+	Alert          *bool   `json:"alert"`
+	TimeZoneOffset *int    `json:"timezone_offset"`
+}
+
+func (s *SavedSearch) Auditable() map[string]any {
+	return map[string]any{
+		"id":      s.Id,
+		"user_id": s.UserId,
+		"team_id": s.TeamId,
+		"alert":   s.Alert,
+	}
+}
+
+// ParseTerms sets the search parameters from the terms of the search.
+func (s *SavedSearch) ParseTerms() {
+	s.Params = ParseSearchParams(strings.TrimSpace(s.Terms), s.TimeZoneOffset)
+	for _, params := range s.Params {
+		params.OrTerms = s.IsOrSearch
+	}
+}
+
+func (s *SavedSearch) PreSave() {
+	if s.Id == "" {
+		s.Id = NewId()
+	}
+
+	s.Name = strings.TrimSpace(s.Name)
+	s.ParseTerms()
+
+	if s.CreateAt == 0 {
+		s.CreateAt = GetMillis()
+	}
+	s.UpdateAt = s.CreateAt
+}
+
+func (s *SavedSearch) PreUpdate() {
+	s.Name = strings.TrimSpace(s.Name)
+	s.ParseTerms()
+	s.UpdateAt = GetMillis()
+}
+
+func (s *SavedSearch) Patch(patch *SavedSearchPatch) {
+	if patch.Name != nil {
+		s.Name = *patch.Name
+	}
+
+	if patch.Terms != nil {
+		s.Terms = *patch.Terms
+	}
+
+	if patch.IsOrSearch != nil {
+		s.IsOrSearch = *patch.IsOrSearch
+	}
+
+	if patch.Alert != nil {
+		s.Alert = *patch.Alert
+	}
+
+	if patch.TimeZoneOffset != nil {
+		s.TimeZoneOffset = *patch.TimeZoneOffset
+	}
+}
+
+func (s *SavedSearch) IsValid() *AppError {
+	if !IsValidId(s.Id) {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(s.UserId) {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.user_id.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if s.TeamId != "" && !IsValidId(s.TeamId) {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.team_id.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if s.Name == "" || utf8.RuneCountInString(s.Name) > SavedSearchNameMaxRunes {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.name.app_error", map[string]any{"MaxLength": SavedSearchNameMaxRunes}, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(s.Terms) > SavedSearchTermsMaxRunes {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.terms_length.app_error", map[string]any{"MaxLength": SavedSearchTermsMaxRunes}, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if len(s.Params) == 0 {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.terms.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if s.CreateAt == 0 {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.create_at.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if s.UpdateAt == 0 {
+		return NewAppError("SavedSearch.IsValid", "model.saved_search.is_valid.update_at.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// MatchesPost reports whether the given post, made by poster in channel,
+// matches the search. It approximates the database search in memory, to
+// evaluate new posts against the searches with alerts.
+func (s *SavedSearch) MatchesPost(post *Post, channel *Channel, poster *User) bool {
+	for _, params := range s.Params {
+		if params.matchesPost(post, channel, poster) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *SearchParams) matchesPost(post *Post, channel *Channel, poster *User) bool {
+	if p.Terms == "*" {
+		return false
+	}
+
+	if len(p.InChannels) > 0 && !containsFold(p.InChannels, channel.Name) {
+		return false
+	}
+	if containsFold(p.ExcludedChannels, channel.Name) {
+		return false
+	}
+
+	if len(p.FromUsers) > 0 && !containsFold(p.FromUsers, poster.Username) {
+		return false
+	}
+	if containsFold(p.ExcludedUsers, poster.Username) {
+		return false
+	}
+
+	if !p.matchesDates(post.CreateAt) {
+		return false
+	}
+
+	if !p.matchesExtensions(post) {
+		return false
+	}
+
+	if !p.matchesModifiers(post) {
+		return false
+	}
+
+	var words []string
+	if p.IsHashtag {
+		words = strings.Fields(strings.ToLower(post.Hashtags))
+	} else {
+		words = searchWordsOf(post.Message)
+	}
+	message := strings.ToLower(post.Message)
+
+	if p.Terms != "" {
+		terms := splitWords(strings.ToLower(p.Terms))
+		matched := 0
+		for _, term := range terms {
+			if searchTermMatches(term, words, message) {
+				matched++
+			}
+		}
+		if matched == 0 || (!p.OrTerms && matched < len(terms)) {
+			return false
+		}
+	}
+
+	for _, term := range splitWords(strings.ToLower(p.ExcludedTerms)) {
+		if searchTermMatches(strings.TrimPrefix(term, "-"), words, message) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *SearchParams) matchesDates(createAt int64) bool {
+	if p.AfterDate != "" && createAt < p.GetAfterDateMillis() {
+		return false
+	}
+	if p.BeforeDate != "" && createAt > p.GetBeforeDateMillis() {
+		return false
+	}
+	if p.OnDate != "" {
+		start, end := p.GetOnDateMillis()
+		if createAt < start || createAt > end {
+			return false
+		}
+	}
+
+	if p.ExcludedAfterDate != "" && createAt >= p.GetExcludedAfterDateMillis() {
+		return false
+	}
+	if p.ExcludedBeforeDate != "" && createAt <= p.GetExcludedBeforeDateMillis() {
+		return false
+	}
+	if p.ExcludedDate != "" {
+		start, end := p.GetExcludedDateMillis()
+		if createAt >= start && createAt <= end {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *SearchParams) matchesExtensions(post *Post) bool {
+	if len(p.Extensions) == 0 && len(p.ExcludedExtensions) == 0 {
+		return true
+	}
+
+	var extensions []string
+	if post.Metadata != nil {
+		for _, file := range post.Metadata.Files {
+			extensions = append(extensions, file.Extension)
+		}
+	}
+
+	found := len(p.Extensions) == 0
+	for _, extension := range extensions {
+		if containsFold(p.ExcludedExtensions, extension) {
+			return false
+		}
+		if containsFold(p.Extensions, extension) {
+			found = true
+		}
+	}
+
+	return found
+}
+
+func (p *SearchParams) matchesModifiers(post *Post) bool {
+	// New posts can't have been flagged yet.
+	if p.IsFlagged {
+		return false
+	}
+	if p.IsPinned && !post.IsPinned {
+		return false
+	}
+	if p.IsRoot && post.RootId != "" {
+		return false
+	}
+	if p.InThread && post.RootId == "" {
+		return false
+	}
+	if p.HasFile && len(post.FileIds) == 0 {
+		return false
+	}
+	if p.HasLink && !MessageHasLink(post.Message) {
+		return false
+	}
+	if p.HasReaction && !post.HasReactions {
+		return false
+	}
+
+	if len(p.ReactedEmojis) > 0 {
+		var emojis []string
+		if post.Metadata != nil {
+			for _, reaction := range post.Metadata.Reactions {
+				emojis = append(emojis, reaction.EmojiName)
+			}
+		}
+		for _, emoji := range p.ReactedEmojis {
+			if !containsFold(emojis, emoji) {
+				return false
+			}
+		}
+	}
+
+	if len(p.Mentions) > 0 {
+		mentions := MessageMentions(post.Message)
+		for _, username := range p.Mentions {
+			if !containsFold(mentions, username) {
+				return false
+			}
+		}
+	}
+
+	if len(p.Priorities) > 0 {
+		priority := post.GetPriority()
+		if priority == nil || priority.Priority == nil || !containsFold(p.Priorities, *priority.Priority) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// searchWordsOf splits a message into lowercase words, trimming their
+// surrounding punctuation the same way search terms are.
+func searchWordsOf(message string) []string {
+	var words []string
+	for _, word := range strings.Fields(strings.ToLower(message)) {
+		word = searchTermPuncStart.ReplaceAllString(word, "")
+		word = searchTermPuncEnd.ReplaceAllString(word, "")
+		word = strings.Trim(word, `"*`)
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// searchTermMatches reports whether a lowercase search term matches the given
+// words of a message. Quoted terms match phrases, and terms ending with an
+// asterisk match word prefixes.
+func searchTermMatches(term string, words []string, message string) bool {
+	if strings.HasPrefix(term, `"`) {
+		phrase := strings.Trim(term, `"`)
+		return phrase != "" && strings.Contains(message, phrase)
+	}
+
+	if prefix, isPrefix := strings.CutSuffix(term, "*"); isPrefix {
+		if prefix == "" {
+			return false
+		}
+		for _, word := range words {
+			if strings.HasPrefix(word, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, word := range words {
+		if word == term {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimLeft(v, "@~"), value) {
+			return true
+		}
+	}
+
+	return false
+}
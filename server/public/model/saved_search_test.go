@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedSearchIsValid(t *testing.T) {
+	newSearch := func() *SavedSearch {
+		search := &SavedSearch{
+			UserId: NewId(),
+			Name:   " Incidents ",
+			Terms:  "INC-* in:town-square",
+		}
+		search.PreSave()
+		return search
+	}
+
+	search := newSearch()
+	require.Nil(t, search.IsValid())
+	assert.Equal(t, "Incidents", search.Name)
+	require.Len(t, search.Params, 1)
+	assert.Equal(t, []string{"town-square"}, search.Params[0].InChannels)
+
+	for name, tc := range map[string]struct {
+		Update  func(*SavedSearch)
+		ErrorID string
+	}{
+		"invalid user id": {func(s *SavedSearch) { s.UserId = "invalid" }, "model.saved_search.is_valid.user_id.app_error"},
+		"invalid team id": {func(s *SavedSearch) { s.TeamId = "invalid" }, "model.saved_search.is_valid.team_id.app_error"},
+		"empty name":      {func(s *SavedSearch) { s.Name = "" }, "model.saved_search.is_valid.name.app_error"},
+		"long name":       {func(s *SavedSearch) { s.Name = strings.Repeat("a", SavedSearchNameMaxRunes+1) }, "model.saved_search.is_valid.name.app_error"},
+		"long terms":      {func(s *SavedSearch) { s.Terms = strings.Repeat("a", SavedSearchTermsMaxRunes+1) }, "model.saved_search.is_valid.terms_length.app_error"},
+		"empty terms":     {func(s *SavedSearch) { s.Terms = " "; s.ParseTerms() }, "model.saved_search.is_valid.terms.app_error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			search := newSearch()
+			tc.Update(search)
+			appErr := search.IsValid()
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.ErrorID, appErr.Id)
+		})
+	}
+}
+
+func TestSavedSearchPatch(t *testing.T) {
+	search := &SavedSearch{Name: "name", Terms: "foo"}
+	search.Patch(&SavedSearchPatch{
+		Terms:      NewPointer("bar baz"),
+		IsOrSearch: NewPointer(true),
+		Alert:      NewPointer(true),
+	})
+	search.PreUpdate()
+
+	assert.Equal(t, "name", search.Name)
+	assert.True(t, search.Alert)
+	require.Len(t, search.Params, 1)
+	assert.Equal(t, "bar baz", search.Params[0].Terms)
+	assert.True(t, search.Params[0].OrTerms)
+	assert.NotZero(t, search.UpdateAt)
+}
+
+func TestSavedSearchMatchesPost(t *testing.T) {
+	channel := &Channel{Name: "incidents"}
+	poster := &User{Username: "alice"}
+	now := GetMillis()
+
+	newPost := func(message string) *Post {
+		post := &Post{Message: message, CreateAt: now}
+		post.Hashtags, _ = ParseHashtags(message)
+		return post
+	}
+
+	for name, tc := range map[string]struct {
+		Terms      string
+		IsOrSearch bool
+		Post       *Post
+		Expected   bool
+	}{
+		"word":                      {"outage", false, newPost("Major outage in progress."), true},
+		"word is case insensitive":  {"OUTAGE", false, newPost("major outage"), true},
+		"partial word":              {"outa", false, newPost("major outage"), false},
+		"prefix":                    {"inc-12*", false, newPost("Tracking INC-1234 now"), true},
+		"all terms":                 {"major outage", false, newPost("major incident"), false},
+		"any term":                  {"major outage", true, newPost("major incident"), true},
+		"phrase":                    {`"major outage"`, false, newPost("a major outage happened"), true},
+		"phrase out of order":       {`"major outage"`, false, newPost("outage major"), false},
+		"excluded term":             {"outage -resolved", false, newPost("outage resolved"), false},
+		"excluded term not present": {"outage -resolved", false, newPost("outage ongoing"), true},
+		"hashtag":                   {"#incident", false, newPost("new #incident reported"), true},
+		"hashtag not in message":    {"#incident", false, newPost("new incident reported"), false},
+		"in channel":                {"outage in:incidents", false, newPost("outage"), true},
+		"in other channel":          {"outage in:town-square", false, newPost("outage"), false},
+		"excluded channel":          {"outage -in:incidents", false, newPost("outage"), false},
+		"from user":                 {"outage from:@alice", false, newPost("outage"), true},
+		"from other user":           {"outage from:bob", false, newPost("outage"), false},
+		"excluded user":             {"outage -from:alice", false, newPost("outage"), false},
+		"filter only":               {"from:alice", false, newPost("anything"), true},
+		"before date":               {"outage before:2000-01-01", false, newPost("outage"), false},
+		"after date":                {"outage after:2000-01-01", false, newPost("outage"), true},
+		"after today":               {"outage after:" + time.Now().UTC().Add(24*time.Hour).Format("2006-01-02"), false, newPost("outage"), false},
+		"wildcard only":             {"*", false, newPost("outage"), false},
+		"has link":                  {"outage has:link", false, newPost("outage https://status.example.com"), true},
+		"has no link":               {"outage has:link", false, newPost("outage"), false},
+		"mentions":                  {"mentions:@bob", false, newPost("@Bob can you look?"), true},
+		"mentions other user":       {"mentions:@bob", false, newPost("@bobby can you look?"), false},
+		"in thread":                 {"outage in:thread", false, &Post{Message: "outage", RootId: NewId(), CreateAt: now}, true},
+		"root only":                 {"outage is:root", false, &Post{Message: "outage", RootId: NewId(), CreateAt: now}, false},
+		"flagged":                   {"outage is:flagged", false, newPost("outage"), false},
+		"priority": {"priority:urgent", false, &Post{Message: "outage", CreateAt: now, Metadata: &PostMetadata{
+			Priority: &PostPriority{Priority: NewPointer(PostPriorityUrgent)},
+		}}, true},
+		"no priority": {"priority:urgent", false, newPost("outage"), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			search := &SavedSearch{Terms: tc.Terms, IsOrSearch: tc.IsOrSearch}
+			search.ParseTerms()
+			assert.Equal(t, tc.Expected, search.MatchesPost(tc.Post, channel, poster))
+		})
+	}
+
+	t.Run("extensions", func(t *testing.T) {
+		post := newPost("report")
+		post.Metadata = &PostMetadata{Files: []*FileInfo{{Name: "report.pdf", Extension: "pdf"}}}
+
+		search := &SavedSearch{Terms: "report ext:pdf"}
+		search.ParseTerms()
+		assert.True(t, search.MatchesPost(post, channel, poster))
+
+		search = &SavedSearch{Terms: "report ext:txt"}
+		search.ParseTerms()
+		assert.False(t, search.MatchesPost(post, channel, poster))
+
+		search = &SavedSearch{Terms: "report -ext:pdf"}
+		search.ParseTerms()
+		assert.False(t, search.MatchesPost(post, channel, poster))
+	})
+}
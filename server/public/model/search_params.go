@@ -10,6 +10,11 @@ import (
 	"time"
 )
 
+const (
+	SearchSortByRecent    = "recent"
+	SearchSortByRelevance = "relevance"
+)
+
 var searchTermPuncStart = regexp.MustCompile(`^[^\pL\d\s#"]+`)
 var searchTermPuncEnd = regexp.MustCompile(`[^\pL\d\s*"]+$`)
 
@@ -29,12 +34,67 @@ type SearchParams struct {
 	ExcludedExtensions     []string `json:"excluded_extensions,omitempty"`
 	OnDate                 string   `json:"on_date,omitempty"`
 	ExcludedDate           string   `json:"excluded_date,omitempty"`
+	IsPinned               bool     `json:"is_pinned,omitempty"`
+	IsFlagged              bool     `json:"is_flagged,omitempty"`
+	IsRoot                 bool     `json:"is_root,omitempty"`
+	InThread               bool     `json:"in_thread,omitempty"`
+	HasFile                bool     `json:"has_file,omitempty"`
+	HasLink                bool     `json:"has_link,omitempty"`
+	HasReaction            bool     `json:"has_reaction,omitempty"`
+	ReactedEmojis          []string `json:"reacted_emojis,omitempty"`
+	Mentions               []string `json:"mentions,omitempty"`
+	Priorities             []string `json:"priorities,omitempty"`
 	OrTerms                bool     `json:"or_terms,omitempty"`
 	IncludeDeletedChannels bool     `json:"include_deleted_channels,omitempty"`
 	TimeZoneOffset         int      `json:"timezone_offset,omitempty"`
 	// True if this search doesn't originate from a "current user".
 	SearchWithoutUserId bool   `json:"search_without_user_id,omitempty"`
 	Modifier            string `json:"modifier"`
+	// FlaggedPostIds are the ids of the posts flagged by the searching user,
+	// set for the search engines that don't index flagged posts.
+	FlaggedPostIds []string `json:"-"`
+	SortBy         string   `json:"sort_by,omitempty"`
+	IncludeFacets  bool     `json:"include_facets,omitempty"`
+	// AffinityChannelIds and AffinityUserIds are the channels and users the searching
+	// user interacts the most with, set for the search engines that rank by relevance.
+	AffinityChannelIds []string `json:"-"`
+	AffinityUserIds    []string `json:"-"`
+}
+
+// PostSearchOptions are the options of a post search that change how its results
+// are returned rather than which posts match.
+type PostSearchOptions struct {
+	SortBy          string
+	IncludeSnippets bool
+	IncludeFacets   bool
+}
+
+func (o *PostSearchOptions) IsValid() *AppError {
+	if o.SortBy != "" && o.SortBy != SearchSortByRecent && o.SortBy != SearchSortByRelevance {
+		return NewAppError("PostSearchOptions.IsValid", "model.search_params.sort_by.app_error", map[string]any{"SortBy": o.SortBy}, "", http.StatusBadRequest)
+	}
+	return nil
+}
+
+// HasModifiers returns true if the search filters posts by their state, such
+// as is:pinned or has:file, rather than by their terms.
+func (p *SearchParams) HasModifiers() bool {
+	return p.IsPinned || p.IsFlagged || p.IsRoot || p.InThread ||
+		p.HasFile || p.HasLink || p.HasReaction ||
+		len(p.ReactedEmojis) > 0 || len(p.Mentions) > 0 || len(p.Priorities) > 0
+}
+
+func (p *SearchParams) copyModifiersTo(dst *SearchParams) {
+	dst.IsPinned = p.IsPinned
+	dst.IsFlagged = p.IsFlagged
+	dst.IsRoot = p.IsRoot
+	dst.InThread = p.InThread
+	dst.HasFile = p.HasFile
+	dst.HasLink = p.HasLink
+	dst.HasReaction = p.HasReaction
+	dst.ReactedEmojis = p.ReactedEmojis
+	dst.Mentions = p.Mentions
+	dst.Priorities = p.Priorities
 }
 
 // Returns the epoch timestamp of the start of the day specified by SearchParams.AfterDate
@@ -109,7 +169,32 @@ func (p *SearchParams) GetExcludedDateMillis() (int64, int64) {
 	return GetStartOfDayMillis(date, p.TimeZoneOffset), GetEndOfDayMillis(date, p.TimeZoneOffset)
 }
 
-var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext"}
+var searchFlags = [...]string{"from", "channel", "in", "before", "after", "on", "ext", "is", "has", "reacted", "mentions", "priority"}
+
+var searchLinkPattern = regexp.MustCompile(`(?i)https?://`)
+var searchMentionPattern = regexp.MustCompile(`\B@([[:alnum:]][[:alnum:]\.\-_:]*)`)
+
+// MessageHasLink returns true if the message contains a link, as matched by
+// the has:link search modifier.
+func MessageHasLink(message string) bool {
+	return searchLinkPattern.MatchString(message)
+}
+
+// MessageMentions returns the lowercase usernames mentioned in the message, as
+// matched by the mentions: search modifier.
+func MessageMentions(message string) []string {
+	var usernames []string
+	seen := map[string]bool{}
+	for _, match := range searchMentionPattern.FindAllStringSubmatch(message, -1) {
+		username := strings.ToLower(strings.TrimRight(match[1], ".-_:"))
+		if username != "" && !seen[username] {
+			seen[username] = true
+			usernames = append(usernames, username)
+		}
+	}
+
+	return usernames
+}
 
 type flag struct {
 	name    string
@@ -271,8 +356,13 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 	excludedExtensions := []string{}
 	extensions := []string{}
 
+	modifiers := &SearchParams{}
+
 	for _, flag := range flags {
-		if flag.name == "in" || flag.name == "channel" {
+		if flag.name == "in" && !flag.exclude && strings.EqualFold(flag.value, "thread") {
+			// Channels named thread can still be searched with in:~thread.
+			modifiers.InThread = true
+		} else if flag.name == "in" || flag.name == "channel" {
 			if flag.exclude {
 				excludedChannels = append(excludedChannels, flag.value)
 			} else {
@@ -308,6 +398,8 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			} else {
 				extensions = append(extensions, flag.value)
 			}
+		} else if !flag.exclude {
+			parseSearchModifier(flag, modifiers)
 		}
 	}
 
@@ -363,7 +455,8 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 			len(extensions) != 0 || len(excludedExtensions) != 0 ||
 			afterDate != "" || excludedAfterDate != "" ||
 			beforeDate != "" || excludedBeforeDate != "" ||
-			onDate != "" || excludedDate != "") {
+			onDate != "" || excludedDate != "" ||
+			modifiers.HasModifiers()) {
 		paramsList = append(paramsList, &SearchParams{
 			Terms:              "",
 			ExcludedTerms:      "",
@@ -384,9 +477,52 @@ func ParseSearchParams(text string, timeZoneOffset int) []*SearchParams {
 		})
 	}
 
+	for _, params := range paramsList {
+		modifiers.copyModifiersTo(params)
+	}
+
 	return paramsList
 }
 
+// parseSearchModifier sets the modifier of the given flag, such as is:pinned
+// or has:file. Unknown modifiers are ignored.
+func parseSearchModifier(flag flag, modifiers *SearchParams) {
+	value := strings.ToLower(flag.value)
+
+	switch flag.name {
+	case "is":
+		switch value {
+		case "pinned":
+			modifiers.IsPinned = true
+		case "flagged", "saved":
+			modifiers.IsFlagged = true
+		case "root":
+			modifiers.IsRoot = true
+		}
+	case "has":
+		switch value {
+		case "file", "files":
+			modifiers.HasFile = true
+		case "link", "links":
+			modifiers.HasLink = true
+		case "reaction", "reactions":
+			modifiers.HasReaction = true
+		}
+	case "reacted":
+		if emoji := strings.Trim(value, ":"); emoji != "" {
+			modifiers.ReactedEmojis = append(modifiers.ReactedEmojis, emoji)
+		}
+	case "mentions":
+		if username := strings.TrimLeft(value, "@"); username != "" {
+			modifiers.Mentions = append(modifiers.Mentions, username)
+		}
+	case "priority":
+		if value == PostPriorityUrgent || value == PostPriorityImportant {
+			modifiers.Priorities = append(modifiers.Priorities, value)
+		}
+	}
+}
+
 func IsSearchParamsListValid(paramsList []*SearchParams) *AppError {
 	// All SearchParams should have same IncludeDeletedChannels value.
 	for _, params := range paramsList {
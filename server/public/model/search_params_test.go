@@ -1797,3 +1797,66 @@ func TestIsSearchParamsListValid(t *testing.T) {
 	appErr = IsSearchParamsListValid([]*SearchParams{})
 	assert.Nil(t, appErr)
 }
+
+func TestParseSearchParamsModifiers(t *testing.T) {
+	t.Run("modifiers apply to all the params", func(t *testing.T) {
+		params := ParseSearchParams("outage #incident is:pinned has:file", 0)
+		require.Len(t, params, 2)
+		for _, p := range params {
+			assert.True(t, p.IsPinned)
+			assert.True(t, p.HasFile)
+			assert.False(t, p.HasLink)
+		}
+	})
+
+	t.Run("modifiers without terms", func(t *testing.T) {
+		params := ParseSearchParams("is:flagged has:link has:reaction is:root", 0)
+		require.Len(t, params, 1)
+		assert.Empty(t, params[0].Terms)
+		assert.True(t, params[0].IsFlagged)
+		assert.True(t, params[0].HasLink)
+		assert.True(t, params[0].HasReaction)
+		assert.True(t, params[0].IsRoot)
+		assert.True(t, params[0].HasModifiers())
+	})
+
+	t.Run("values", func(t *testing.T) {
+		params := ParseSearchParams("reacted::+1: reacted:tada mentions:@Alice mentions:bob priority:URGENT priority:low", 0)
+		require.Len(t, params, 1)
+		assert.Equal(t, []string{"+1", "tada"}, params[0].ReactedEmojis)
+		assert.Equal(t, []string{"alice", "bob"}, params[0].Mentions)
+		assert.Equal(t, []string{PostPriorityUrgent}, params[0].Priorities)
+	})
+
+	t.Run("in:thread", func(t *testing.T) {
+		params := ParseSearchParams("outage in:thread", 0)
+		require.Len(t, params, 1)
+		assert.True(t, params[0].InThread)
+		assert.Empty(t, params[0].InChannels)
+
+		params = ParseSearchParams("outage in:~thread", 0)
+		require.Len(t, params, 1)
+		assert.False(t, params[0].InThread)
+		assert.Equal(t, []string{"~thread"}, params[0].InChannels)
+	})
+
+	t.Run("unknown and excluded modifiers are ignored", func(t *testing.T) {
+		params := ParseSearchParams("outage is:unknown -is:pinned", 0)
+		require.Len(t, params, 1)
+		assert.Equal(t, "outage", params[0].Terms)
+		assert.False(t, params[0].HasModifiers())
+
+		assert.Empty(t, ParseSearchParams("is:unknown", 0))
+	})
+}
+
+func TestMessageHasLink(t *testing.T) {
+	assert.True(t, MessageHasLink("see https://example.com"))
+	assert.True(t, MessageHasLink("see [docs](HTTP://example.com)"))
+	assert.False(t, MessageHasLink("see example.com"))
+}
+
+func TestMessageMentions(t *testing.T) {
+	assert.Equal(t, []string{"alice", "bob.smith"}, MessageMentions("@Alice, can you ask @bob.smith. Thanks @alice"))
+	assert.Empty(t, MessageMentions("email me at alice@example.com"))
+}
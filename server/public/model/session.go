@@ -29,12 +29,17 @@ const (
 	SessionPropLastRemovedDeviceId        = "last_removed_device_id"
 	SessionPropDeviceNotificationDisabled = "device_notification_disabled"
 	SessionPropMobileVersion              = "mobile_version"
+	SessionPropIpAddress                  = "ip_address"
+	SessionPropLastIpAddress              = "last_ip_address"
+	SessionPropUserAgent                  = "user_agent"
+	SessionPropDeviceName                 = "device_name"
 	SessionTypeUserAccessToken            = "UserAccessToken"
 	SessionTypeCloudKey                   = "CloudKey"
 	SessionTypeRemoteclusterToken         = "RemoteClusterToken"
 	SessionPropIsGuest                    = "is_guest"
 	SessionActivityTimeout                = 1000 * 60 * 5  // 5 minutes
 	SessionUserAccessTokenExpiryHours     = 100 * 365 * 24 // 100 years
+	SessionUserAgentMaxLength             = 256
 )
 
 //msgp:tuple StringMap
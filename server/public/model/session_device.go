@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const SessionDeviceNameMaxRunes = 64
+
+// SessionDevice is a user facing view of a session, describing the device
+// and network the session was created from. It never contains the session token.
+type SessionDevice struct {
+	SessionId      string `json:"session_id"`
+	DeviceId       string `json:"device_id,omitempty"`
+	Name           string `json:"name"`
+	Platform       string `json:"platform"`
+	Os             string `json:"os"`
+	Browser        string `json:"browser"`
+	UserAgent      string `json:"user_agent"`
+	FirstIpAddress string `json:"first_ip_address"`
+	LastIpAddress  string `json:"last_ip_address"`
+	CreateAt       int64  `json:"create_at"`
+	LastActivityAt int64  `json:"last_activity_at"`
+	ExpiresAt      int64  `json:"expires_at"`
+	IsMobile       bool   `json:"is_mobile"`
+	IsCurrent      bool   `json:"is_current"`
+}
+
+// NewSessionDevice builds the device inventory entry for the given session.
+func NewSessionDevice(session *Session) *SessionDevice {
+	device := &SessionDevice{
+		SessionId:      session.Id,
+		DeviceId:       session.DeviceId,
+		Name:           session.Props[SessionPropDeviceName],
+		Platform:       session.Props[SessionPropPlatform],
+		Os:             session.Props[SessionPropOs],
+		Browser:        session.Props[SessionPropBrowser],
+		UserAgent:      session.Props[SessionPropUserAgent],
+		FirstIpAddress: session.Props[SessionPropIpAddress],
+		LastIpAddress:  session.Props[SessionPropLastIpAddress],
+		CreateAt:       session.CreateAt,
+		LastActivityAt: session.LastActivityAt,
+		ExpiresAt:      session.ExpiresAt,
+		IsMobile:       session.IsMobileApp(),
+	}
+
+	if device.LastIpAddress == "" {
+		device.LastIpAddress = device.FirstIpAddress
+	}
+
+	return device
+}
+
+// IsValidSessionDeviceName checks that a user supplied device name is
+// not blank and fits within SessionDeviceNameMaxRunes.
+func IsValidSessionDeviceName(name string) bool {
+	if strings.TrimSpace(name) == "" {
+		return false
+	}
+
+	return utf8.RuneCountInString(name) <= SessionDeviceNameMaxRunes
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSessionDevice(t *testing.T) {
+	t.Run("maps session props", func(t *testing.T) {
+		session := &Session{
+			Id:             NewId(),
+			Token:          NewId(),
+			CreateAt:       100,
+			LastActivityAt: 200,
+			ExpiresAt:      300,
+			Props: StringMap{
+				SessionPropDeviceName:    "Work laptop",
+				SessionPropPlatform:      "Macintosh",
+				SessionPropOs:            "Mac OS",
+				SessionPropBrowser:       "Chrome/120.0",
+				SessionPropUserAgent:     "Mozilla/5.0",
+				SessionPropIpAddress:     "10.0.0.1",
+				SessionPropLastIpAddress: "10.0.0.2",
+			},
+		}
+
+		device := NewSessionDevice(session)
+		assert.Equal(t, session.Id, device.SessionId)
+		assert.Equal(t, "Work laptop", device.Name)
+		assert.Equal(t, "Macintosh", device.Platform)
+		assert.Equal(t, "Mac OS", device.Os)
+		assert.Equal(t, "Chrome/120.0", device.Browser)
+		assert.Equal(t, "Mozilla/5.0", device.UserAgent)
+		assert.Equal(t, "10.0.0.1", device.FirstIpAddress)
+		assert.Equal(t, "10.0.0.2", device.LastIpAddress)
+		assert.Equal(t, int64(100), device.CreateAt)
+		assert.Equal(t, int64(200), device.LastActivityAt)
+		assert.Equal(t, int64(300), device.ExpiresAt)
+		assert.False(t, device.IsMobile)
+		assert.False(t, device.IsCurrent)
+	})
+
+	t.Run("last ip defaults to first ip", func(t *testing.T) {
+		session := &Session{
+			Id:       NewId(),
+			DeviceId: "apple:1234",
+			Props:    StringMap{SessionPropIpAddress: "10.0.0.1"},
+		}
+
+		device := NewSessionDevice(session)
+		assert.Equal(t, "10.0.0.1", device.LastIpAddress)
+		assert.Equal(t, "apple:1234", device.DeviceId)
+		assert.True(t, device.IsMobile)
+	})
+}
+
+func TestIsValidSessionDeviceName(t *testing.T) {
+	assert.True(t, IsValidSessionDeviceName("My phone"))
+	assert.True(t, IsValidSessionDeviceName(strings.Repeat("a", SessionDeviceNameMaxRunes)))
+	assert.True(t, IsValidSessionDeviceName(strings.Repeat("ñ", SessionDeviceNameMaxRunes)))
+	assert.False(t, IsValidSessionDeviceName(""))
+	assert.False(t, IsValidSessionDeviceName("   "))
+	assert.False(t, IsValidSessionDeviceName(strings.Repeat("a", SessionDeviceNameMaxRunes+1)))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	TranslationProviderLibreTranslate = "libretranslate"
+	TranslationProviderPlugin         = "plugin"
+
+	TranslationSettingsDefaultRequestTimeoutSeconds = 30
+
+	// TranslationThreadMaxPosts is the number of posts of a thread translated at once.
+	TranslationThreadMaxPosts = 200
+	// TranslationAutoMaxLocales is the number of locales the posts of an auto-translated channel
+	// are translated into.
+	TranslationAutoMaxLocales = 10
+)
+
+// TextTranslation is a text translated by a translation provider.
+type TextTranslation struct {
+	Text string `json:"text"`
+	// SourceLanguage is the language the text was translated from, as detected by the provider
+	// if it wasn't given.
+	SourceLanguage string `json:"source_language"`
+}
+
+// PostTranslation is the message of a post translated into a locale. It is cached until the
+// post is edited.
+type PostTranslation struct {
+	PostId string `json:"post_id"`
+	Locale string `json:"locale"`
+	// PostEditAt is the edit time of the post when it was translated. The translation is stale
+	// once the post is edited again.
+	PostEditAt     int64  `json:"post_edit_at"`
+	Message        string `json:"message"`
+	SourceLanguage string `json:"source_language"`
+	Provider       string `json:"provider"`
+	CreateAt       int64  `json:"create_at"`
+}
+
+func (t *PostTranslation) PreSave() {
+	if t.CreateAt == 0 {
+		t.CreateAt = GetMillis()
+	}
+}
+
+func (t *PostTranslation) IsValid() *AppError {
+	if !IsValidId(t.PostId) {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if t.Locale == "" || !IsValidLocale(t.Locale) {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.locale.app_error", nil, "post_id="+t.PostId, http.StatusBadRequest)
+	}
+
+	if t.CreateAt == 0 {
+		return NewAppError("PostTranslation.IsValid", "model.post_translation.is_valid.create_at.app_error", nil, "post_id="+t.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsStale returns true if the post was edited since it was translated.
+func (t *PostTranslation) IsStale(post *Post) bool {
+	return t.PostEditAt != post.EditAt
+}
+
+// ChannelTranslationSettings are the translation settings of a channel.
+type ChannelTranslationSettings struct {
+	ChannelId string `json:"channel_id"`
+	// AutoTranslate translates the new posts of the channel into the locales of its members.
+	AutoTranslate bool  `json:"auto_translate"`
+	UpdateAt      int64 `json:"update_at"`
+}
+
+func (s *ChannelTranslationSettings) Auditable() map[string]any {
+	return map[string]any{
+		"channel_id":     s.ChannelId,
+		"auto_translate": s.AutoTranslate,
+	}
+}
+
+func (s *ChannelTranslationSettings) PreSave() {
+	s.UpdateAt = GetMillis()
+}
+
+func (s *ChannelTranslationSettings) IsValid() *AppError {
+	if !IsValidId(s.ChannelId) {
+		return NewAppError("ChannelTranslationSettings.IsValid", "model.channel_translation_settings.is_valid.channel_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
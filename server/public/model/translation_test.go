@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTranslationIsValid(t *testing.T) {
+	translation := &PostTranslation{PostId: NewId(), Locale: "pt-BR", Message: "olá"}
+	require.NotNil(t, translation.IsValid())
+
+	translation.PreSave()
+	require.Nil(t, translation.IsValid())
+
+	translation.Locale = ""
+	require.NotNil(t, translation.IsValid())
+
+	translation.Locale = "not a locale"
+	require.NotNil(t, translation.IsValid())
+
+	translation.Locale = "de"
+	translation.PostId = "invalid"
+	require.NotNil(t, translation.IsValid())
+}
+
+func TestPostTranslationIsStale(t *testing.T) {
+	post := &Post{Id: NewId()}
+	translation := &PostTranslation{PostId: post.Id, PostEditAt: post.EditAt}
+	assert.False(t, translation.IsStale(post))
+
+	post.EditAt = GetMillis()
+	assert.True(t, translation.IsStale(post))
+}
+
+func TestChannelTranslationSettingsIsValid(t *testing.T) {
+	settings := &ChannelTranslationSettings{ChannelId: NewId(), AutoTranslate: true}
+	settings.PreSave()
+	require.Nil(t, settings.IsValid())
+	assert.NotZero(t, settings.UpdateAt)
+
+	settings.ChannelId = ""
+	require.NotNil(t, settings.IsValid())
+}
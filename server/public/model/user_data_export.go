@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"path/filepath"
+)
+
+// UserDataExportDir is the directory, relative to ExportSettings.Directory,
+// where the archives of users' own data exports are written.
+const UserDataExportDir = "user_data"
+
+// UserDataExport is a user's request for a copy of their own data, produced
+// by a user_data_export job.
+type UserDataExport struct {
+	JobId    string `json:"job_id"`
+	UserId   string `json:"user_id"`
+	CreateAt int64  `json:"create_at"`
+	// ExpiresAt is set once the archive is ready, and is the time after which
+	// it can no longer be downloaded.
+	ExpiresAt int64 `json:"expires_at"`
+	// Status is the status of the job producing the archive.
+	Status string `json:"status" db:"-"`
+}
+
+func (e *UserDataExport) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"job_id":     e.JobId,
+		"user_id":    e.UserId,
+		"create_at":  e.CreateAt,
+		"expires_at": e.ExpiresAt,
+	}
+}
+
+func (e *UserDataExport) PreSave() {
+	if e.CreateAt == 0 {
+		e.CreateAt = GetMillis()
+	}
+}
+
+func (e *UserDataExport) IsValid() *AppError {
+	if !IsValidId(e.JobId) {
+		return NewAppError("UserDataExport.IsValid", "model.user_data_export.is_valid.job_id.app_error", nil, "job_id="+e.JobId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(e.UserId) {
+		return NewAppError("UserDataExport.IsValid", "model.user_data_export.is_valid.user_id.app_error", nil, "user_id="+e.UserId, http.StatusBadRequest)
+	}
+
+	if e.CreateAt == 0 {
+		return NewAppError("UserDataExport.IsValid", "model.user_data_export.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if e.ExpiresAt < 0 {
+		return NewAppError("UserDataExport.IsValid", "model.user_data_export.is_valid.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsDownloadable returns true if the archive is ready and has not expired at
+// the given time, in milliseconds.
+func (e *UserDataExport) IsDownloadable(now int64) bool {
+	return e.ExpiresAt > now
+}
+
+// FileName returns the name of the archive in the export directory. The
+// suffix matches bulk exports so that expired archives are cleaned up by the
+// export_delete job.
+func (e *UserDataExport) FileName() string {
+	return filepath.Join(UserDataExportDir, e.JobId+"_export.zip")
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDataExportIsValid(t *testing.T) {
+	export := &UserDataExport{
+		JobId:  NewId(),
+		UserId: NewId(),
+	}
+	require.NotNil(t, export.IsValid())
+
+	export.PreSave()
+	require.Nil(t, export.IsValid())
+
+	export.ExpiresAt = -1
+	require.NotNil(t, export.IsValid())
+	export.ExpiresAt = 0
+
+	export.UserId = ""
+	require.NotNil(t, export.IsValid())
+}
+
+func TestUserDataExportIsDownloadable(t *testing.T) {
+	export := &UserDataExport{}
+	assert.False(t, export.IsDownloadable(1000), "exports that are not ready can't be downloaded")
+
+	export.ExpiresAt = 1000
+	assert.True(t, export.IsDownloadable(999))
+	assert.False(t, export.IsDownloadable(1000))
+}
+
+func TestUserDataExportFileName(t *testing.T) {
+	export := &UserDataExport{JobId: "jobid"}
+	assert.Equal(t, "user_data/jobid_export.zip", export.FileName())
+}
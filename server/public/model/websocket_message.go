@@ -18,6 +18,8 @@ const (
 	WebsocketEventPostEdited                          WebsocketEventType = "post_edited"
 	WebsocketEventPostDeleted                         WebsocketEventType = "post_deleted"
 	WebsocketEventPostUnread                          WebsocketEventType = "post_unread"
+	WebsocketEventPostTranslated                      WebsocketEventType = "post_translated"
+	WebsocketEventReadReceipt                         WebsocketEventType = "read_receipt"
 	WebsocketEventChannelConverted                    WebsocketEventType = "channel_converted"
 	WebsocketEventChannelCreated                      WebsocketEventType = "channel_created"
 	WebsocketEventChannelDeleted                      WebsocketEventType = "channel_deleted"
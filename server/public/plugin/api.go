@@ -871,7 +871,8 @@ type API interface {
 	// Minimum server version: 5.6
 	EnablePlugin(id string) *model.AppError
 
-	// DisablePlugin will disable an enabled plugin.
+	// DisablePlugin will disable an enabled plugin. Plugins that other enabled plugins depend on
+	// can't be disabled.
 	//
 	// @tag Plugin
 	// Minimum server version: 5.6
@@ -962,6 +963,39 @@ type API interface {
 	// Minimum server version: 5.6
 	KVList(page, perPage int) ([]string, *model.AppError)
 
+	// KVListWithOptions lists the keys for a plugin in ascending order, filtered by prefix or
+	// key range. Pass the NextCursor of the returned page as the Cursor option to fetch the
+	// next page; an empty NextCursor means there are no more keys.
+	//
+	// @tag KeyValueStore
+	// Minimum server version: 10.3
+	KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError)
+
+	// KVGetMulti retrieves the values of several keys, unique per plugin. Non-existent keys are
+	// omitted from the returned map.
+	//
+	// @tag KeyValueStore
+	// Minimum server version: 10.3
+	KVGetMulti(keys []string) (map[string][]byte, *model.AppError)
+
+	// KVGetMetadata retrieves the creation, update and expiry times of a key, unique per plugin.
+	// Returns nil for non-existent keys.
+	//
+	// @tag KeyValueStore
+	// Minimum server version: 10.3
+	KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError)
+
+	// KVBatch applies several writes in a single transaction. An operation with a nil value
+	// deletes its key, and atomic operations use the same compare and set semantics as
+	// KVSetWithOptions.
+	// Returns (false, err) if DB error occurred
+	// Returns (false, nil) if an atomic operation failed, in which case nothing was written
+	// Returns (true, nil) if all operations were applied
+	//
+	// @tag KeyValueStore
+	// Minimum server version: 10.3
+	KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError)
+
 	// PublishWebSocketEvent sends an event to WebSocket connections.
 	// event is the type and will be prepended with "custom_<pluginid>_".
 	// payload is the data sent with the event. Interface values must be primitive Go types or mattermost-server/model types.
@@ -972,6 +1006,9 @@ type API interface {
 
 	// HasPermissionTo check if the user has the permission at system scope.
 	//
+	// Permissions declared in the plugin manifest are checked by passing a permission whose Id
+	// is model.PluginPermissionId(manifest.Id, permissionID).
+	//
 	// @tag User
 	// Minimum server version: 5.3
 	HasPermissionTo(userID string, permission *model.Permission) bool
@@ -1312,6 +1349,62 @@ type API interface {
 	// @tag Plugin
 	// Minimum server version: 10.1
 	GetPluginID() string
+
+	// GetPluginMigrations returns the database migrations applied by this plugin, ordered by
+	// version.
+	//
+	// @tag Plugin
+	// Minimum server version: 10.3
+	GetPluginMigrations() ([]*model.PluginMigration, *model.AppError)
+
+	// ApplyPluginMigration runs a database migration for this plugin and records it as applied,
+	// in a single transaction where the database allows it. The Up and Down SQL must be written
+	// for the database driver in use. Returns a 409 error if the version was already applied.
+	//
+	// The SQL runs with the privileges of the server's database user and is not limited to the
+	// plugin's own tables, so it requires the store:migrate capability and every statement is
+	// recorded in the audit log.
+	//
+	// @tag Plugin
+	// Minimum server version: 10.3
+	ApplyPluginMigration(migration *model.PluginMigration) *model.AppError
+
+	// RegisterPluginJob registers a job type with the server's job server, on this server. Jobs
+	// of the type are listed and can be cancelled alongside other jobs, and are run through the
+	// RunPluginJob hook by the server claiming them. If the definition has an interval, a job is
+	// scheduled periodically by the cluster leader. Registering a job again replaces its
+	// definition. Job types are unregistered when the plugin is disabled.
+	//
+	// @tag Job
+	// Minimum server version: 10.3
+	RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError
+
+	// UnregisterPluginJob unregisters a job type registered by the plugin, on this server.
+	// Existing jobs of the type are kept, but no longer run.
+	//
+	// @tag Job
+	// Minimum server version: 10.3
+	UnregisterPluginJob(name string) *model.AppError
+
+	// CreatePluginJob schedules a job of a type registered by the plugin, to be run once by any
+	// server of the cluster the plugin is active on.
+	//
+	// @tag Job
+	// Minimum server version: 10.3
+	CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError)
+
+	// GetPluginJob gets a job of a type registered by the plugin.
+	//
+	// @tag Job
+	// Minimum server version: 10.3
+	GetPluginJob(jobID string) (*model.Job, *model.AppError)
+
+	// SetPluginJobProgress updates the progress, in percent, of a running job of a type registered
+	// by the plugin.
+	//
+	// @tag Job
+	// Minimum server version: 10.3
+	SetPluginJobProgress(jobID string, progress int64) *model.AppError
 }
 
 var handshake = plugin.HandshakeConfig{
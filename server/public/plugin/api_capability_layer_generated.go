@@ -0,0 +1,1467 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+package plugin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+type apiCapabilityLayer struct {
+	apiImpl API
+	check   func(method string) *model.AppError
+}
+
+// NewAPICapabilityLayer wraps the given API so that every call is first authorized by check.
+// A rejected call returns the error from check, or zero values if the method returns no error.
+func NewAPICapabilityLayer(apiImpl API, check func(method string) *model.AppError) API {
+	return &apiCapabilityLayer{apiImpl: apiImpl, check: check}
+}
+
+func (api *apiCapabilityLayer) LoadPluginConfiguration(dest any) error {
+	if _appErr := api.check("LoadPluginConfiguration"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.LoadPluginConfiguration(dest)
+}
+
+func (api *apiCapabilityLayer) RegisterCommand(command *model.Command) error {
+	if _appErr := api.check("RegisterCommand"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RegisterCommand(command)
+}
+
+func (api *apiCapabilityLayer) UnregisterCommand(teamID, trigger string) error {
+	if _appErr := api.check("UnregisterCommand"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UnregisterCommand(teamID, trigger)
+}
+
+func (api *apiCapabilityLayer) ExecuteSlashCommand(commandArgs *model.CommandArgs) (*model.CommandResponse, error) {
+	if _appErr := api.check("ExecuteSlashCommand"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ExecuteSlashCommand(commandArgs)
+}
+
+func (api *apiCapabilityLayer) GetConfig() *model.Config {
+	if _appErr := api.check("GetConfig"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.GetConfig()
+}
+
+func (api *apiCapabilityLayer) GetUnsanitizedConfig() *model.Config {
+	if _appErr := api.check("GetUnsanitizedConfig"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.GetUnsanitizedConfig()
+}
+
+func (api *apiCapabilityLayer) SaveConfig(config *model.Config) *model.AppError {
+	if _appErr := api.check("SaveConfig"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SaveConfig(config)
+}
+
+func (api *apiCapabilityLayer) GetPluginConfig() map[string]any {
+	if _appErr := api.check("GetPluginConfig"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.GetPluginConfig()
+}
+
+func (api *apiCapabilityLayer) SavePluginConfig(config map[string]any) *model.AppError {
+	if _appErr := api.check("SavePluginConfig"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SavePluginConfig(config)
+}
+
+func (api *apiCapabilityLayer) GetBundlePath() (string, error) {
+	if _appErr := api.check("GetBundlePath"); _appErr != nil {
+		return "", _appErr
+	}
+	return api.apiImpl.GetBundlePath()
+}
+
+func (api *apiCapabilityLayer) GetLicense() *model.License {
+	if _appErr := api.check("GetLicense"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.GetLicense()
+}
+
+func (api *apiCapabilityLayer) IsEnterpriseReady() bool {
+	if _appErr := api.check("IsEnterpriseReady"); _appErr != nil {
+		return false
+	}
+	return api.apiImpl.IsEnterpriseReady()
+}
+
+func (api *apiCapabilityLayer) GetServerVersion() string {
+	if _appErr := api.check("GetServerVersion"); _appErr != nil {
+		return ""
+	}
+	return api.apiImpl.GetServerVersion()
+}
+
+func (api *apiCapabilityLayer) GetSystemInstallDate() (int64, *model.AppError) {
+	if _appErr := api.check("GetSystemInstallDate"); _appErr != nil {
+		return *new(int64), _appErr
+	}
+	return api.apiImpl.GetSystemInstallDate()
+}
+
+func (api *apiCapabilityLayer) GetDiagnosticId() string {
+	if _appErr := api.check("GetDiagnosticId"); _appErr != nil {
+		return ""
+	}
+	return api.apiImpl.GetDiagnosticId()
+}
+
+func (api *apiCapabilityLayer) GetTelemetryId() string {
+	if _appErr := api.check("GetTelemetryId"); _appErr != nil {
+		return ""
+	}
+	return api.apiImpl.GetTelemetryId()
+}
+
+func (api *apiCapabilityLayer) CreateUser(user *model.User) (*model.User, *model.AppError) {
+	if _appErr := api.check("CreateUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateUser(user)
+}
+
+func (api *apiCapabilityLayer) DeleteUser(userID string) *model.AppError {
+	if _appErr := api.check("DeleteUser"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteUser(userID)
+}
+
+func (api *apiCapabilityLayer) GetUsers(options *model.UserGetOptions) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetUsers"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUsers(options)
+}
+
+func (api *apiCapabilityLayer) GetUsersByIds(userIDs []string) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetUsersByIds"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUsersByIds(userIDs)
+}
+
+func (api *apiCapabilityLayer) GetUser(userID string) (*model.User, *model.AppError) {
+	if _appErr := api.check("GetUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUser(userID)
+}
+
+func (api *apiCapabilityLayer) GetUserByEmail(email string) (*model.User, *model.AppError) {
+	if _appErr := api.check("GetUserByEmail"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUserByEmail(email)
+}
+
+func (api *apiCapabilityLayer) GetUserByUsername(name string) (*model.User, *model.AppError) {
+	if _appErr := api.check("GetUserByUsername"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUserByUsername(name)
+}
+
+func (api *apiCapabilityLayer) GetUsersByUsernames(usernames []string) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetUsersByUsernames"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUsersByUsernames(usernames)
+}
+
+func (api *apiCapabilityLayer) GetUsersInTeam(teamID string, page int, perPage int) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetUsersInTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUsersInTeam(teamID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetPreferenceForUser(userID, category, name string) (model.Preference, *model.AppError) {
+	if _appErr := api.check("GetPreferenceForUser"); _appErr != nil {
+		return *new(model.Preference), _appErr
+	}
+	return api.apiImpl.GetPreferenceForUser(userID, category, name)
+}
+
+func (api *apiCapabilityLayer) GetPreferencesForUser(userID string) ([]model.Preference, *model.AppError) {
+	if _appErr := api.check("GetPreferencesForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPreferencesForUser(userID)
+}
+
+func (api *apiCapabilityLayer) UpdatePreferencesForUser(userID string, preferences []model.Preference) *model.AppError {
+	if _appErr := api.check("UpdatePreferencesForUser"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UpdatePreferencesForUser(userID, preferences)
+}
+
+func (api *apiCapabilityLayer) DeletePreferencesForUser(userID string, preferences []model.Preference) *model.AppError {
+	if _appErr := api.check("DeletePreferencesForUser"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeletePreferencesForUser(userID, preferences)
+}
+
+func (api *apiCapabilityLayer) GetSession(sessionID string) (*model.Session, *model.AppError) {
+	if _appErr := api.check("GetSession"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetSession(sessionID)
+}
+
+func (api *apiCapabilityLayer) CreateSession(session *model.Session) (*model.Session, *model.AppError) {
+	if _appErr := api.check("CreateSession"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateSession(session)
+}
+
+func (api *apiCapabilityLayer) ExtendSessionExpiry(sessionID string, newExpiry int64) *model.AppError {
+	if _appErr := api.check("ExtendSessionExpiry"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.ExtendSessionExpiry(sessionID, newExpiry)
+}
+
+func (api *apiCapabilityLayer) RevokeSession(sessionID string) *model.AppError {
+	if _appErr := api.check("RevokeSession"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RevokeSession(sessionID)
+}
+
+func (api *apiCapabilityLayer) CreateUserAccessToken(token *model.UserAccessToken) (*model.UserAccessToken, *model.AppError) {
+	if _appErr := api.check("CreateUserAccessToken"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateUserAccessToken(token)
+}
+
+func (api *apiCapabilityLayer) RevokeUserAccessToken(tokenID string) *model.AppError {
+	if _appErr := api.check("RevokeUserAccessToken"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RevokeUserAccessToken(tokenID)
+}
+
+func (api *apiCapabilityLayer) GetTeamIcon(teamID string) ([]byte, *model.AppError) {
+	if _appErr := api.check("GetTeamIcon"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamIcon(teamID)
+}
+
+func (api *apiCapabilityLayer) SetTeamIcon(teamID string, data []byte) *model.AppError {
+	if _appErr := api.check("SetTeamIcon"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SetTeamIcon(teamID, data)
+}
+
+func (api *apiCapabilityLayer) RemoveTeamIcon(teamID string) *model.AppError {
+	if _appErr := api.check("RemoveTeamIcon"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RemoveTeamIcon(teamID)
+}
+
+func (api *apiCapabilityLayer) UpdateUser(user *model.User) (*model.User, *model.AppError) {
+	if _appErr := api.check("UpdateUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateUser(user)
+}
+
+func (api *apiCapabilityLayer) GetUserStatus(userID string) (*model.Status, *model.AppError) {
+	if _appErr := api.check("GetUserStatus"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUserStatus(userID)
+}
+
+func (api *apiCapabilityLayer) GetUserStatusesByIds(userIds []string) ([]*model.Status, *model.AppError) {
+	if _appErr := api.check("GetUserStatusesByIds"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUserStatusesByIds(userIds)
+}
+
+func (api *apiCapabilityLayer) UpdateUserStatus(userID, status string) (*model.Status, *model.AppError) {
+	if _appErr := api.check("UpdateUserStatus"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateUserStatus(userID, status)
+}
+
+func (api *apiCapabilityLayer) SetUserStatusTimedDND(userId string, endtime int64) (*model.Status, *model.AppError) {
+	if _appErr := api.check("SetUserStatusTimedDND"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SetUserStatusTimedDND(userId, endtime)
+}
+
+func (api *apiCapabilityLayer) UpdateUserActive(userID string, active bool) *model.AppError {
+	if _appErr := api.check("UpdateUserActive"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UpdateUserActive(userID, active)
+}
+
+func (api *apiCapabilityLayer) UpdateUserCustomStatus(userID string, customStatus *model.CustomStatus) *model.AppError {
+	if _appErr := api.check("UpdateUserCustomStatus"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UpdateUserCustomStatus(userID, customStatus)
+}
+
+func (api *apiCapabilityLayer) RemoveUserCustomStatus(userID string) *model.AppError {
+	if _appErr := api.check("RemoveUserCustomStatus"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RemoveUserCustomStatus(userID)
+}
+
+func (api *apiCapabilityLayer) GetUsersInChannel(channelID, sortBy string, page, perPage int) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetUsersInChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUsersInChannel(channelID, sortBy, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetLDAPUserAttributes(userID string, attributes []string) (map[string]string, *model.AppError) {
+	if _appErr := api.check("GetLDAPUserAttributes"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetLDAPUserAttributes(userID, attributes)
+}
+
+func (api *apiCapabilityLayer) CreateTeam(team *model.Team) (*model.Team, *model.AppError) {
+	if _appErr := api.check("CreateTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateTeam(team)
+}
+
+func (api *apiCapabilityLayer) DeleteTeam(teamID string) *model.AppError {
+	if _appErr := api.check("DeleteTeam"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteTeam(teamID)
+}
+
+func (api *apiCapabilityLayer) GetTeams() ([]*model.Team, *model.AppError) {
+	if _appErr := api.check("GetTeams"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeams()
+}
+
+func (api *apiCapabilityLayer) GetTeam(teamID string) (*model.Team, *model.AppError) {
+	if _appErr := api.check("GetTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeam(teamID)
+}
+
+func (api *apiCapabilityLayer) GetTeamByName(name string) (*model.Team, *model.AppError) {
+	if _appErr := api.check("GetTeamByName"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamByName(name)
+}
+
+func (api *apiCapabilityLayer) GetTeamsUnreadForUser(userID string) ([]*model.TeamUnread, *model.AppError) {
+	if _appErr := api.check("GetTeamsUnreadForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamsUnreadForUser(userID)
+}
+
+func (api *apiCapabilityLayer) UpdateTeam(team *model.Team) (*model.Team, *model.AppError) {
+	if _appErr := api.check("UpdateTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateTeam(team)
+}
+
+func (api *apiCapabilityLayer) SearchTeams(term string) ([]*model.Team, *model.AppError) {
+	if _appErr := api.check("SearchTeams"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SearchTeams(term)
+}
+
+func (api *apiCapabilityLayer) GetTeamsForUser(userID string) ([]*model.Team, *model.AppError) {
+	if _appErr := api.check("GetTeamsForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamsForUser(userID)
+}
+
+func (api *apiCapabilityLayer) CreateTeamMember(teamID, userID string) (*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("CreateTeamMember"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateTeamMember(teamID, userID)
+}
+
+func (api *apiCapabilityLayer) CreateTeamMembers(teamID string, userIds []string, requestorId string) ([]*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("CreateTeamMembers"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateTeamMembers(teamID, userIds, requestorId)
+}
+
+func (api *apiCapabilityLayer) CreateTeamMembersGracefully(teamID string, userIds []string, requestorId string) ([]*model.TeamMemberWithError, *model.AppError) {
+	if _appErr := api.check("CreateTeamMembersGracefully"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateTeamMembersGracefully(teamID, userIds, requestorId)
+}
+
+func (api *apiCapabilityLayer) DeleteTeamMember(teamID, userID, requestorId string) *model.AppError {
+	if _appErr := api.check("DeleteTeamMember"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteTeamMember(teamID, userID, requestorId)
+}
+
+func (api *apiCapabilityLayer) GetTeamMembers(teamID string, page, perPage int) ([]*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("GetTeamMembers"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamMembers(teamID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetTeamMember(teamID, userID string) (*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("GetTeamMember"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamMember(teamID, userID)
+}
+
+func (api *apiCapabilityLayer) GetTeamMembersForUser(userID string, page int, perPage int) ([]*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("GetTeamMembersForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamMembersForUser(userID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) UpdateTeamMemberRoles(teamID, userID, newRoles string) (*model.TeamMember, *model.AppError) {
+	if _appErr := api.check("UpdateTeamMemberRoles"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateTeamMemberRoles(teamID, userID, newRoles)
+}
+
+func (api *apiCapabilityLayer) CreateChannel(channel *model.Channel) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("CreateChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateChannel(channel)
+}
+
+func (api *apiCapabilityLayer) DeleteChannel(channelId string) *model.AppError {
+	if _appErr := api.check("DeleteChannel"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteChannel(channelId)
+}
+
+func (api *apiCapabilityLayer) GetPublicChannelsForTeam(teamID string, page, perPage int) ([]*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetPublicChannelsForTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPublicChannelsForTeam(teamID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetChannel(channelId string) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannel(channelId)
+}
+
+func (api *apiCapabilityLayer) GetChannelByName(teamID, name string, includeDeleted bool) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetChannelByName"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelByName(teamID, name, includeDeleted)
+}
+
+func (api *apiCapabilityLayer) GetChannelByNameForTeamName(teamName, channelName string, includeDeleted bool) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetChannelByNameForTeamName"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelByNameForTeamName(teamName, channelName, includeDeleted)
+}
+
+func (api *apiCapabilityLayer) GetChannelsForTeamForUser(teamID, userID string, includeDeleted bool) ([]*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetChannelsForTeamForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelsForTeamForUser(teamID, userID, includeDeleted)
+}
+
+func (api *apiCapabilityLayer) GetChannelStats(channelId string) (*model.ChannelStats, *model.AppError) {
+	if _appErr := api.check("GetChannelStats"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelStats(channelId)
+}
+
+func (api *apiCapabilityLayer) GetDirectChannel(userId1, userId2 string) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetDirectChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetDirectChannel(userId1, userId2)
+}
+
+func (api *apiCapabilityLayer) GetGroupChannel(userIds []string) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("GetGroupChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroupChannel(userIds)
+}
+
+func (api *apiCapabilityLayer) UpdateChannel(channel *model.Channel) (*model.Channel, *model.AppError) {
+	if _appErr := api.check("UpdateChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateChannel(channel)
+}
+
+func (api *apiCapabilityLayer) SearchChannels(teamID string, term string) ([]*model.Channel, *model.AppError) {
+	if _appErr := api.check("SearchChannels"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SearchChannels(teamID, term)
+}
+
+func (api *apiCapabilityLayer) CreateChannelSidebarCategory(userID, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError) {
+	if _appErr := api.check("CreateChannelSidebarCategory"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateChannelSidebarCategory(userID, teamID, newCategory)
+}
+
+func (api *apiCapabilityLayer) GetChannelSidebarCategories(userID, teamID string) (*model.OrderedSidebarCategories, *model.AppError) {
+	if _appErr := api.check("GetChannelSidebarCategories"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelSidebarCategories(userID, teamID)
+}
+
+func (api *apiCapabilityLayer) UpdateChannelSidebarCategories(userID, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, *model.AppError) {
+	if _appErr := api.check("UpdateChannelSidebarCategories"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateChannelSidebarCategories(userID, teamID, categories)
+}
+
+func (api *apiCapabilityLayer) SearchUsers(search *model.UserSearch) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("SearchUsers"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SearchUsers(search)
+}
+
+func (api *apiCapabilityLayer) SearchPostsInTeam(teamID string, paramsList []*model.SearchParams) ([]*model.Post, *model.AppError) {
+	if _appErr := api.check("SearchPostsInTeam"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SearchPostsInTeam(teamID, paramsList)
+}
+
+func (api *apiCapabilityLayer) SearchPostsInTeamForUser(teamID string, userID string, searchParams model.SearchParameter) (*model.PostSearchResults, *model.AppError) {
+	if _appErr := api.check("SearchPostsInTeamForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.SearchPostsInTeamForUser(teamID, userID, searchParams)
+}
+
+func (api *apiCapabilityLayer) AddChannelMember(channelId, userID string) (*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("AddChannelMember"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.AddChannelMember(channelId, userID)
+}
+
+func (api *apiCapabilityLayer) AddUserToChannel(channelId, userID, asUserId string) (*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("AddUserToChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.AddUserToChannel(channelId, userID, asUserId)
+}
+
+func (api *apiCapabilityLayer) GetChannelMember(channelId, userID string) (*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("GetChannelMember"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelMember(channelId, userID)
+}
+
+func (api *apiCapabilityLayer) GetChannelMembers(channelId string, page, perPage int) (model.ChannelMembers, *model.AppError) {
+	if _appErr := api.check("GetChannelMembers"); _appErr != nil {
+		return *new(model.ChannelMembers), _appErr
+	}
+	return api.apiImpl.GetChannelMembers(channelId, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetChannelMembersByIds(channelId string, userIds []string) (model.ChannelMembers, *model.AppError) {
+	if _appErr := api.check("GetChannelMembersByIds"); _appErr != nil {
+		return *new(model.ChannelMembers), _appErr
+	}
+	return api.apiImpl.GetChannelMembersByIds(channelId, userIds)
+}
+
+func (api *apiCapabilityLayer) GetChannelMembersForUser(teamID, userID string, page, perPage int) ([]*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("GetChannelMembersForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetChannelMembersForUser(teamID, userID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) UpdateChannelMemberRoles(channelId, userID, newRoles string) (*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("UpdateChannelMemberRoles"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateChannelMemberRoles(channelId, userID, newRoles)
+}
+
+func (api *apiCapabilityLayer) UpdateChannelMemberNotifications(channelId, userID string, notifications map[string]string) (*model.ChannelMember, *model.AppError) {
+	if _appErr := api.check("UpdateChannelMemberNotifications"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateChannelMemberNotifications(channelId, userID, notifications)
+}
+
+func (api *apiCapabilityLayer) PatchChannelMembersNotifications(members []*model.ChannelMemberIdentifier, notifyProps map[string]string) *model.AppError {
+	if _appErr := api.check("PatchChannelMembersNotifications"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.PatchChannelMembersNotifications(members, notifyProps)
+}
+
+func (api *apiCapabilityLayer) GetGroup(groupId string) (*model.Group, *model.AppError) {
+	if _appErr := api.check("GetGroup"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroup(groupId)
+}
+
+func (api *apiCapabilityLayer) GetGroupByName(name string) (*model.Group, *model.AppError) {
+	if _appErr := api.check("GetGroupByName"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroupByName(name)
+}
+
+func (api *apiCapabilityLayer) GetGroupMemberUsers(groupID string, page, perPage int) ([]*model.User, *model.AppError) {
+	if _appErr := api.check("GetGroupMemberUsers"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroupMemberUsers(groupID, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetGroupsBySource(groupSource model.GroupSource) ([]*model.Group, *model.AppError) {
+	if _appErr := api.check("GetGroupsBySource"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroupsBySource(groupSource)
+}
+
+func (api *apiCapabilityLayer) GetGroupsForUser(userID string) ([]*model.Group, *model.AppError) {
+	if _appErr := api.check("GetGroupsForUser"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetGroupsForUser(userID)
+}
+
+func (api *apiCapabilityLayer) DeleteChannelMember(channelId, userID string) *model.AppError {
+	if _appErr := api.check("DeleteChannelMember"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteChannelMember(channelId, userID)
+}
+
+func (api *apiCapabilityLayer) CreatePost(post *model.Post) (*model.Post, *model.AppError) {
+	if _appErr := api.check("CreatePost"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreatePost(post)
+}
+
+func (api *apiCapabilityLayer) AddReaction(reaction *model.Reaction) (*model.Reaction, *model.AppError) {
+	if _appErr := api.check("AddReaction"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.AddReaction(reaction)
+}
+
+func (api *apiCapabilityLayer) RemoveReaction(reaction *model.Reaction) *model.AppError {
+	if _appErr := api.check("RemoveReaction"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RemoveReaction(reaction)
+}
+
+func (api *apiCapabilityLayer) GetReactions(postId string) ([]*model.Reaction, *model.AppError) {
+	if _appErr := api.check("GetReactions"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetReactions(postId)
+}
+
+func (api *apiCapabilityLayer) SendEphemeralPost(userID string, post *model.Post) *model.Post {
+	if _appErr := api.check("SendEphemeralPost"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.SendEphemeralPost(userID, post)
+}
+
+func (api *apiCapabilityLayer) UpdateEphemeralPost(userID string, post *model.Post) *model.Post {
+	if _appErr := api.check("UpdateEphemeralPost"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.UpdateEphemeralPost(userID, post)
+}
+
+func (api *apiCapabilityLayer) DeleteEphemeralPost(userID, postId string) {
+	if _appErr := api.check("DeleteEphemeralPost"); _appErr != nil {
+		return
+	}
+	api.apiImpl.DeleteEphemeralPost(userID, postId)
+}
+
+func (api *apiCapabilityLayer) DeletePost(postId string) *model.AppError {
+	if _appErr := api.check("DeletePost"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeletePost(postId)
+}
+
+func (api *apiCapabilityLayer) GetPostThread(postId string) (*model.PostList, *model.AppError) {
+	if _appErr := api.check("GetPostThread"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPostThread(postId)
+}
+
+func (api *apiCapabilityLayer) GetPost(postId string) (*model.Post, *model.AppError) {
+	if _appErr := api.check("GetPost"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPost(postId)
+}
+
+func (api *apiCapabilityLayer) GetPostsSince(channelId string, time int64) (*model.PostList, *model.AppError) {
+	if _appErr := api.check("GetPostsSince"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPostsSince(channelId, time)
+}
+
+func (api *apiCapabilityLayer) GetPostsAfter(channelId, postId string, page, perPage int) (*model.PostList, *model.AppError) {
+	if _appErr := api.check("GetPostsAfter"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPostsAfter(channelId, postId, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetPostsBefore(channelId, postId string, page, perPage int) (*model.PostList, *model.AppError) {
+	if _appErr := api.check("GetPostsBefore"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPostsBefore(channelId, postId, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetPostsForChannel(channelId string, page, perPage int) (*model.PostList, *model.AppError) {
+	if _appErr := api.check("GetPostsForChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPostsForChannel(channelId, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetTeamStats(teamID string) (*model.TeamStats, *model.AppError) {
+	if _appErr := api.check("GetTeamStats"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetTeamStats(teamID)
+}
+
+func (api *apiCapabilityLayer) UpdatePost(post *model.Post) (*model.Post, *model.AppError) {
+	if _appErr := api.check("UpdatePost"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdatePost(post)
+}
+
+func (api *apiCapabilityLayer) GetProfileImage(userID string) ([]byte, *model.AppError) {
+	if _appErr := api.check("GetProfileImage"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetProfileImage(userID)
+}
+
+func (api *apiCapabilityLayer) SetProfileImage(userID string, data []byte) *model.AppError {
+	if _appErr := api.check("SetProfileImage"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SetProfileImage(userID, data)
+}
+
+func (api *apiCapabilityLayer) GetEmojiList(sortBy string, page, perPage int) ([]*model.Emoji, *model.AppError) {
+	if _appErr := api.check("GetEmojiList"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetEmojiList(sortBy, page, perPage)
+}
+
+func (api *apiCapabilityLayer) GetEmojiByName(name string) (*model.Emoji, *model.AppError) {
+	if _appErr := api.check("GetEmojiByName"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetEmojiByName(name)
+}
+
+func (api *apiCapabilityLayer) GetEmoji(emojiId string) (*model.Emoji, *model.AppError) {
+	if _appErr := api.check("GetEmoji"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetEmoji(emojiId)
+}
+
+func (api *apiCapabilityLayer) CopyFileInfos(userID string, fileIds []string) ([]string, *model.AppError) {
+	if _appErr := api.check("CopyFileInfos"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CopyFileInfos(userID, fileIds)
+}
+
+func (api *apiCapabilityLayer) GetFileInfo(fileId string) (*model.FileInfo, *model.AppError) {
+	if _appErr := api.check("GetFileInfo"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetFileInfo(fileId)
+}
+
+func (api *apiCapabilityLayer) SetFileSearchableContent(fileID string, content string) *model.AppError {
+	if _appErr := api.check("SetFileSearchableContent"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SetFileSearchableContent(fileID, content)
+}
+
+func (api *apiCapabilityLayer) GetFileInfos(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError) {
+	if _appErr := api.check("GetFileInfos"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetFileInfos(page, perPage, opt)
+}
+
+func (api *apiCapabilityLayer) GetFile(fileId string) ([]byte, *model.AppError) {
+	if _appErr := api.check("GetFile"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetFile(fileId)
+}
+
+func (api *apiCapabilityLayer) GetFileLink(fileId string) (string, *model.AppError) {
+	if _appErr := api.check("GetFileLink"); _appErr != nil {
+		return "", _appErr
+	}
+	return api.apiImpl.GetFileLink(fileId)
+}
+
+func (api *apiCapabilityLayer) ReadFile(path string) ([]byte, *model.AppError) {
+	if _appErr := api.check("ReadFile"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ReadFile(path)
+}
+
+func (api *apiCapabilityLayer) GetEmojiImage(emojiId string) ([]byte, string, *model.AppError) {
+	if _appErr := api.check("GetEmojiImage"); _appErr != nil {
+		return nil, "", _appErr
+	}
+	return api.apiImpl.GetEmojiImage(emojiId)
+}
+
+func (api *apiCapabilityLayer) UploadFile(data []byte, channelId string, filename string) (*model.FileInfo, *model.AppError) {
+	if _appErr := api.check("UploadFile"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UploadFile(data, channelId, filename)
+}
+
+func (api *apiCapabilityLayer) OpenInteractiveDialog(dialog model.OpenDialogRequest) *model.AppError {
+	if _appErr := api.check("OpenInteractiveDialog"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.OpenInteractiveDialog(dialog)
+}
+
+func (api *apiCapabilityLayer) GetPlugins() ([]*model.Manifest, *model.AppError) {
+	if _appErr := api.check("GetPlugins"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPlugins()
+}
+
+func (api *apiCapabilityLayer) EnablePlugin(id string) *model.AppError {
+	if _appErr := api.check("EnablePlugin"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.EnablePlugin(id)
+}
+
+func (api *apiCapabilityLayer) DisablePlugin(id string) *model.AppError {
+	if _appErr := api.check("DisablePlugin"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DisablePlugin(id)
+}
+
+func (api *apiCapabilityLayer) RemovePlugin(id string) *model.AppError {
+	if _appErr := api.check("RemovePlugin"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RemovePlugin(id)
+}
+
+func (api *apiCapabilityLayer) GetPluginStatus(id string) (*model.PluginStatus, *model.AppError) {
+	if _appErr := api.check("GetPluginStatus"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPluginStatus(id)
+}
+
+func (api *apiCapabilityLayer) InstallPlugin(file io.Reader, replace bool) (*model.Manifest, *model.AppError) {
+	if _appErr := api.check("InstallPlugin"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.InstallPlugin(file, replace)
+}
+
+func (api *apiCapabilityLayer) KVSet(key string, value []byte) *model.AppError {
+	if _appErr := api.check("KVSet"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.KVSet(key, value)
+}
+
+func (api *apiCapabilityLayer) KVCompareAndSet(key string, oldValue, newValue []byte) (bool, *model.AppError) {
+	if _appErr := api.check("KVCompareAndSet"); _appErr != nil {
+		return false, _appErr
+	}
+	return api.apiImpl.KVCompareAndSet(key, oldValue, newValue)
+}
+
+func (api *apiCapabilityLayer) KVCompareAndDelete(key string, oldValue []byte) (bool, *model.AppError) {
+	if _appErr := api.check("KVCompareAndDelete"); _appErr != nil {
+		return false, _appErr
+	}
+	return api.apiImpl.KVCompareAndDelete(key, oldValue)
+}
+
+func (api *apiCapabilityLayer) KVSetWithOptions(key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError) {
+	if _appErr := api.check("KVSetWithOptions"); _appErr != nil {
+		return false, _appErr
+	}
+	return api.apiImpl.KVSetWithOptions(key, value, options)
+}
+
+func (api *apiCapabilityLayer) KVSetWithExpiry(key string, value []byte, expireInSeconds int64) *model.AppError {
+	if _appErr := api.check("KVSetWithExpiry"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.KVSetWithExpiry(key, value, expireInSeconds)
+}
+
+func (api *apiCapabilityLayer) KVGet(key string) ([]byte, *model.AppError) {
+	if _appErr := api.check("KVGet"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.KVGet(key)
+}
+
+func (api *apiCapabilityLayer) KVDelete(key string) *model.AppError {
+	if _appErr := api.check("KVDelete"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.KVDelete(key)
+}
+
+func (api *apiCapabilityLayer) KVDeleteAll() *model.AppError {
+	if _appErr := api.check("KVDeleteAll"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.KVDeleteAll()
+}
+
+func (api *apiCapabilityLayer) KVList(page, perPage int) ([]string, *model.AppError) {
+	if _appErr := api.check("KVList"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.KVList(page, perPage)
+}
+
+func (api *apiCapabilityLayer) KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	if _appErr := api.check("KVListWithOptions"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.KVListWithOptions(options)
+}
+
+func (api *apiCapabilityLayer) KVGetMulti(keys []string) (map[string][]byte, *model.AppError) {
+	if _appErr := api.check("KVGetMulti"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.KVGetMulti(keys)
+}
+
+func (api *apiCapabilityLayer) KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError) {
+	if _appErr := api.check("KVGetMetadata"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.KVGetMetadata(key)
+}
+
+func (api *apiCapabilityLayer) KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	if _appErr := api.check("KVBatch"); _appErr != nil {
+		return false, _appErr
+	}
+	return api.apiImpl.KVBatch(ops)
+}
+
+func (api *apiCapabilityLayer) PublishWebSocketEvent(event string, payload map[string]any, broadcast *model.WebsocketBroadcast) {
+	if _appErr := api.check("PublishWebSocketEvent"); _appErr != nil {
+		return
+	}
+	api.apiImpl.PublishWebSocketEvent(event, payload, broadcast)
+}
+
+func (api *apiCapabilityLayer) HasPermissionTo(userID string, permission *model.Permission) bool {
+	if _appErr := api.check("HasPermissionTo"); _appErr != nil {
+		return false
+	}
+	return api.apiImpl.HasPermissionTo(userID, permission)
+}
+
+func (api *apiCapabilityLayer) HasPermissionToTeam(userID, teamID string, permission *model.Permission) bool {
+	if _appErr := api.check("HasPermissionToTeam"); _appErr != nil {
+		return false
+	}
+	return api.apiImpl.HasPermissionToTeam(userID, teamID, permission)
+}
+
+func (api *apiCapabilityLayer) HasPermissionToChannel(userID, channelId string, permission *model.Permission) bool {
+	if _appErr := api.check("HasPermissionToChannel"); _appErr != nil {
+		return false
+	}
+	return api.apiImpl.HasPermissionToChannel(userID, channelId, permission)
+}
+
+func (api *apiCapabilityLayer) RolesGrantPermission(roleNames []string, permissionId string) bool {
+	if _appErr := api.check("RolesGrantPermission"); _appErr != nil {
+		return false
+	}
+	return api.apiImpl.RolesGrantPermission(roleNames, permissionId)
+}
+
+func (api *apiCapabilityLayer) LogDebug(msg string, keyValuePairs ...any) {
+	if _appErr := api.check("LogDebug"); _appErr != nil {
+		return
+	}
+	api.apiImpl.LogDebug(msg, keyValuePairs...)
+}
+
+func (api *apiCapabilityLayer) LogInfo(msg string, keyValuePairs ...any) {
+	if _appErr := api.check("LogInfo"); _appErr != nil {
+		return
+	}
+	api.apiImpl.LogInfo(msg, keyValuePairs...)
+}
+
+func (api *apiCapabilityLayer) LogError(msg string, keyValuePairs ...any) {
+	if _appErr := api.check("LogError"); _appErr != nil {
+		return
+	}
+	api.apiImpl.LogError(msg, keyValuePairs...)
+}
+
+func (api *apiCapabilityLayer) LogWarn(msg string, keyValuePairs ...any) {
+	if _appErr := api.check("LogWarn"); _appErr != nil {
+		return
+	}
+	api.apiImpl.LogWarn(msg, keyValuePairs...)
+}
+
+func (api *apiCapabilityLayer) SendMail(to, subject, htmlBody string) *model.AppError {
+	if _appErr := api.check("SendMail"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SendMail(to, subject, htmlBody)
+}
+
+func (api *apiCapabilityLayer) CreateBot(bot *model.Bot) (*model.Bot, *model.AppError) {
+	if _appErr := api.check("CreateBot"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateBot(bot)
+}
+
+func (api *apiCapabilityLayer) PatchBot(botUserId string, botPatch *model.BotPatch) (*model.Bot, *model.AppError) {
+	if _appErr := api.check("PatchBot"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.PatchBot(botUserId, botPatch)
+}
+
+func (api *apiCapabilityLayer) GetBot(botUserId string, includeDeleted bool) (*model.Bot, *model.AppError) {
+	if _appErr := api.check("GetBot"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetBot(botUserId, includeDeleted)
+}
+
+func (api *apiCapabilityLayer) GetBots(options *model.BotGetOptions) ([]*model.Bot, *model.AppError) {
+	if _appErr := api.check("GetBots"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetBots(options)
+}
+
+func (api *apiCapabilityLayer) UpdateBotActive(botUserId string, active bool) (*model.Bot, *model.AppError) {
+	if _appErr := api.check("UpdateBotActive"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateBotActive(botUserId, active)
+}
+
+func (api *apiCapabilityLayer) PermanentDeleteBot(botUserId string) *model.AppError {
+	if _appErr := api.check("PermanentDeleteBot"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.PermanentDeleteBot(botUserId)
+}
+
+func (api *apiCapabilityLayer) PluginHTTP(request *http.Request) *http.Response {
+	if _appErr := api.check("PluginHTTP"); _appErr != nil {
+		return nil
+	}
+	return api.apiImpl.PluginHTTP(request)
+}
+
+func (api *apiCapabilityLayer) PublishUserTyping(userID, channelId, parentId string) *model.AppError {
+	if _appErr := api.check("PublishUserTyping"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.PublishUserTyping(userID, channelId, parentId)
+}
+
+func (api *apiCapabilityLayer) CreateCommand(cmd *model.Command) (*model.Command, error) {
+	if _appErr := api.check("CreateCommand"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateCommand(cmd)
+}
+
+func (api *apiCapabilityLayer) ListCommands(teamID string) ([]*model.Command, error) {
+	if _appErr := api.check("ListCommands"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ListCommands(teamID)
+}
+
+func (api *apiCapabilityLayer) ListCustomCommands(teamID string) ([]*model.Command, error) {
+	if _appErr := api.check("ListCustomCommands"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ListCustomCommands(teamID)
+}
+
+func (api *apiCapabilityLayer) ListPluginCommands(teamID string) ([]*model.Command, error) {
+	if _appErr := api.check("ListPluginCommands"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ListPluginCommands(teamID)
+}
+
+func (api *apiCapabilityLayer) ListBuiltInCommands() ([]*model.Command, error) {
+	if _appErr := api.check("ListBuiltInCommands"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ListBuiltInCommands()
+}
+
+func (api *apiCapabilityLayer) GetCommand(commandID string) (*model.Command, error) {
+	if _appErr := api.check("GetCommand"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetCommand(commandID)
+}
+
+func (api *apiCapabilityLayer) UpdateCommand(commandID string, updatedCmd *model.Command) (*model.Command, error) {
+	if _appErr := api.check("UpdateCommand"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateCommand(commandID, updatedCmd)
+}
+
+func (api *apiCapabilityLayer) DeleteCommand(commandID string) error {
+	if _appErr := api.check("DeleteCommand"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteCommand(commandID)
+}
+
+func (api *apiCapabilityLayer) CreateOAuthApp(app *model.OAuthApp) (*model.OAuthApp, *model.AppError) {
+	if _appErr := api.check("CreateOAuthApp"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateOAuthApp(app)
+}
+
+func (api *apiCapabilityLayer) GetOAuthApp(appID string) (*model.OAuthApp, *model.AppError) {
+	if _appErr := api.check("GetOAuthApp"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetOAuthApp(appID)
+}
+
+func (api *apiCapabilityLayer) UpdateOAuthApp(app *model.OAuthApp) (*model.OAuthApp, *model.AppError) {
+	if _appErr := api.check("UpdateOAuthApp"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateOAuthApp(app)
+}
+
+func (api *apiCapabilityLayer) DeleteOAuthApp(appID string) *model.AppError {
+	if _appErr := api.check("DeleteOAuthApp"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.DeleteOAuthApp(appID)
+}
+
+func (api *apiCapabilityLayer) PublishPluginClusterEvent(ev model.PluginClusterEvent, opts model.PluginClusterEventSendOptions) error {
+	if _appErr := api.check("PublishPluginClusterEvent"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.PublishPluginClusterEvent(ev, opts)
+}
+
+func (api *apiCapabilityLayer) RequestTrialLicense(requesterID string, users int, termsAccepted bool, receiveEmailsAccepted bool) *model.AppError {
+	if _appErr := api.check("RequestTrialLicense"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RequestTrialLicense(requesterID, users, termsAccepted, receiveEmailsAccepted)
+}
+
+func (api *apiCapabilityLayer) GetCloudLimits() (*model.ProductLimits, error) {
+	if _appErr := api.check("GetCloudLimits"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetCloudLimits()
+}
+
+func (api *apiCapabilityLayer) EnsureBotUser(bot *model.Bot) (string, error) {
+	if _appErr := api.check("EnsureBotUser"); _appErr != nil {
+		return "", _appErr
+	}
+	return api.apiImpl.EnsureBotUser(bot)
+}
+
+func (api *apiCapabilityLayer) RegisterCollectionAndTopic(collectionType, topicType string) error {
+	if _appErr := api.check("RegisterCollectionAndTopic"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RegisterCollectionAndTopic(collectionType, topicType)
+}
+
+func (api *apiCapabilityLayer) CreateUploadSession(us *model.UploadSession) (*model.UploadSession, error) {
+	if _appErr := api.check("CreateUploadSession"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreateUploadSession(us)
+}
+
+func (api *apiCapabilityLayer) UploadData(us *model.UploadSession, rd io.Reader) (*model.FileInfo, error) {
+	if _appErr := api.check("UploadData"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UploadData(us, rd)
+}
+
+func (api *apiCapabilityLayer) GetUploadSession(uploadID string) (*model.UploadSession, error) {
+	if _appErr := api.check("GetUploadSession"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetUploadSession(uploadID)
+}
+
+func (api *apiCapabilityLayer) SendPushNotification(notification *model.PushNotification, userID string) *model.AppError {
+	if _appErr := api.check("SendPushNotification"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SendPushNotification(notification, userID)
+}
+
+func (api *apiCapabilityLayer) UpdateUserAuth(userID string, userAuth *model.UserAuth) (*model.UserAuth, *model.AppError) {
+	if _appErr := api.check("UpdateUserAuth"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateUserAuth(userID, userAuth)
+}
+
+func (api *apiCapabilityLayer) RegisterPluginForSharedChannels(opts model.RegisterPluginOpts) (remoteID string, err error) {
+	if _appErr := api.check("RegisterPluginForSharedChannels"); _appErr != nil {
+		return "", _appErr
+	}
+	return api.apiImpl.RegisterPluginForSharedChannels(opts)
+}
+
+func (api *apiCapabilityLayer) UnregisterPluginForSharedChannels(pluginID string) error {
+	if _appErr := api.check("UnregisterPluginForSharedChannels"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UnregisterPluginForSharedChannels(pluginID)
+}
+
+func (api *apiCapabilityLayer) ShareChannel(sc *model.SharedChannel) (*model.SharedChannel, error) {
+	if _appErr := api.check("ShareChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.ShareChannel(sc)
+}
+
+func (api *apiCapabilityLayer) UpdateSharedChannel(sc *model.SharedChannel) (*model.SharedChannel, error) {
+	if _appErr := api.check("UpdateSharedChannel"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateSharedChannel(sc)
+}
+
+func (api *apiCapabilityLayer) UnshareChannel(channelID string) (unshared bool, err error) {
+	if _appErr := api.check("UnshareChannel"); _appErr != nil {
+		return false, _appErr
+	}
+	return api.apiImpl.UnshareChannel(channelID)
+}
+
+func (api *apiCapabilityLayer) UpdateSharedChannelCursor(channelID, remoteID string, cusror model.GetPostsSinceForSyncCursor) error {
+	if _appErr := api.check("UpdateSharedChannelCursor"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UpdateSharedChannelCursor(channelID, remoteID, cusror)
+}
+
+func (api *apiCapabilityLayer) SyncSharedChannel(channelID string) error {
+	if _appErr := api.check("SyncSharedChannel"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SyncSharedChannel(channelID)
+}
+
+func (api *apiCapabilityLayer) InviteRemoteToChannel(channelID string, remoteID string, userID string, shareIfNotShared bool) error {
+	if _appErr := api.check("InviteRemoteToChannel"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.InviteRemoteToChannel(channelID, remoteID, userID, shareIfNotShared)
+}
+
+func (api *apiCapabilityLayer) UninviteRemoteFromChannel(channelID string, remoteID string) error {
+	if _appErr := api.check("UninviteRemoteFromChannel"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UninviteRemoteFromChannel(channelID, remoteID)
+}
+
+func (api *apiCapabilityLayer) UpdateUserRoles(userID, newRoles string) (*model.User, *model.AppError) {
+	if _appErr := api.check("UpdateUserRoles"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.UpdateUserRoles(userID, newRoles)
+}
+
+func (api *apiCapabilityLayer) GetPluginID() string {
+	if _appErr := api.check("GetPluginID"); _appErr != nil {
+		return ""
+	}
+	return api.apiImpl.GetPluginID()
+}
+
+func (api *apiCapabilityLayer) GetPluginMigrations() ([]*model.PluginMigration, *model.AppError) {
+	if _appErr := api.check("GetPluginMigrations"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPluginMigrations()
+}
+
+func (api *apiCapabilityLayer) ApplyPluginMigration(migration *model.PluginMigration) *model.AppError {
+	if _appErr := api.check("ApplyPluginMigration"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.ApplyPluginMigration(migration)
+}
+
+func (api *apiCapabilityLayer) RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError {
+	if _appErr := api.check("RegisterPluginJob"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.RegisterPluginJob(definition)
+}
+
+func (api *apiCapabilityLayer) UnregisterPluginJob(name string) *model.AppError {
+	if _appErr := api.check("UnregisterPluginJob"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.UnregisterPluginJob(name)
+}
+
+func (api *apiCapabilityLayer) CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError) {
+	if _appErr := api.check("CreatePluginJob"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.CreatePluginJob(name, data)
+}
+
+func (api *apiCapabilityLayer) GetPluginJob(jobID string) (*model.Job, *model.AppError) {
+	if _appErr := api.check("GetPluginJob"); _appErr != nil {
+		return nil, _appErr
+	}
+	return api.apiImpl.GetPluginJob(jobID)
+}
+
+func (api *apiCapabilityLayer) SetPluginJobProgress(jobID string, progress int64) *model.AppError {
+	if _appErr := api.check("SetPluginJobProgress"); _appErr != nil {
+		return _appErr
+	}
+	return api.apiImpl.SetPluginJobProgress(jobID, progress)
+}
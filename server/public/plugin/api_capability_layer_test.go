@@ -0,0 +1,50 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+)
+
+func TestAPICapabilityLayer(t *testing.T) {
+	deniedErr := model.NewAppError("check", "denied", nil, "", http.StatusForbidden)
+	var checked []string
+	check := func(method string) *model.AppError {
+		checked = append(checked, method)
+		if method == "GetUser" || method == "DeletePost" {
+			return deniedErr
+		}
+		return nil
+	}
+
+	api := &plugintest.API{}
+	defer api.AssertExpectations(t)
+	api.On("GetChannel", "channel_id").Return(&model.Channel{Id: "channel_id"}, nil)
+
+	layer := plugin.NewAPICapabilityLayer(api, check)
+
+	t.Run("allowed calls reach the wrapped API", func(t *testing.T) {
+		channel, appErr := layer.GetChannel("channel_id")
+		require.Nil(t, appErr)
+		assert.Equal(t, "channel_id", channel.Id)
+	})
+
+	t.Run("denied calls return the check error", func(t *testing.T) {
+		user, appErr := layer.GetUser("user_id")
+		assert.Nil(t, user)
+		assert.Equal(t, deniedErr, appErr)
+
+		assert.Equal(t, deniedErr, layer.DeletePost("post_id"))
+	})
+
+	assert.Equal(t, []string{"GetChannel", "GetUser", "DeletePost"}, checked)
+}
@@ -1034,6 +1034,34 @@ func (api *apiTimerLayer) KVList(page, perPage int) ([]string, *model.AppError)
 	return _returnsA, _returnsB
 }
 
+func (api *apiTimerLayer) KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.KVListWithOptions(options)
+	api.recordTime(startTime, "KVListWithOptions", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) KVGetMulti(keys []string) (map[string][]byte, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.KVGetMulti(keys)
+	api.recordTime(startTime, "KVGetMulti", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.KVGetMetadata(key)
+	api.recordTime(startTime, "KVGetMetadata", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.KVBatch(ops)
+	api.recordTime(startTime, "KVBatch", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
 func (api *apiTimerLayer) PublishWebSocketEvent(event string, payload map[string]any, broadcast *model.WebsocketBroadcast) {
 	startTime := timePkg.Now()
 	api.apiImpl.PublishWebSocketEvent(event, payload, broadcast)
@@ -1385,3 +1413,52 @@ func (api *apiTimerLayer) GetPluginID() string {
 	api.recordTime(startTime, "GetPluginID", true)
 	return _returnsA
 }
+
+func (api *apiTimerLayer) GetPluginMigrations() ([]*model.PluginMigration, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.GetPluginMigrations()
+	api.recordTime(startTime, "GetPluginMigrations", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) ApplyPluginMigration(migration *model.PluginMigration) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.ApplyPluginMigration(migration)
+	api.recordTime(startTime, "ApplyPluginMigration", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.RegisterPluginJob(definition)
+	api.recordTime(startTime, "RegisterPluginJob", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) UnregisterPluginJob(name string) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.UnregisterPluginJob(name)
+	api.recordTime(startTime, "UnregisterPluginJob", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.CreatePluginJob(name, data)
+	api.recordTime(startTime, "CreatePluginJob", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) GetPluginJob(jobID string) (*model.Job, *model.AppError) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := api.apiImpl.GetPluginJob(jobID)
+	api.recordTime(startTime, "GetPluginJob", _returnsB == nil)
+	return _returnsA, _returnsB
+}
+
+func (api *apiTimerLayer) SetPluginJobProgress(jobID string, progress int64) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.SetPluginJobProgress(jobID, progress)
+	api.recordTime(startTime, "SetPluginJobProgress", _returnsA == nil)
+	return _returnsA
+}
@@ -11,6 +11,7 @@ import (
 	"database/sql/driver"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -19,6 +20,7 @@ import (
 	"os"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/hashicorp/go-plugin"
@@ -30,14 +32,22 @@ import (
 
 var hookNameToId = make(map[string]int)
 
+// hooksCaller is the transport used to invoke a plugin's hooks: an *rpc.Client for plugins
+// running as a separate process, or a *wasmModule for plugins running in-process.
+type hooksCaller interface {
+	Call(serviceMethod string, args any, reply any) error
+	Go(serviceMethod string, args any, reply any, done chan *rpc.Call) *rpc.Call
+}
+
 type hooksRPCClient struct {
-	client      *rpc.Client
+	client      hooksCaller
 	log         *mlog.Logger
 	muxBroker   *plugin.MuxBroker
 	apiImpl     API
 	driver      Driver
 	implemented [TotalHooksID]bool
 	doneWg      sync.WaitGroup
+	guard       *hookGuard
 }
 
 type hooksRPCServer struct {
@@ -52,6 +62,7 @@ type hooksPlugin struct {
 	apiImpl    API
 	driverImpl Driver
 	log        *mlog.Logger
+	guard      *hookGuard
 }
 
 func (p *hooksPlugin) Server(b *plugin.MuxBroker) (any, error) {
@@ -64,9 +75,45 @@ func (p *hooksPlugin) Client(b *plugin.MuxBroker, client *rpc.Client) (any, erro
 		muxBroker: b,
 		apiImpl:   p.apiImpl,
 		driver:    p.driverImpl,
+		guard:     p.guard,
 	}, nil
 }
 
+// call invokes the given hook, abandoning it if it runs past its execution budget. The outcome
+// is recorded against the plugin's circuit breaker.
+//
+// The response is decoded into a private copy of reply, which is only copied back once the call
+// completes in time, so that a late response cannot race with the caller.
+func (g *hooksRPCClient) call(hookName string, args any, reply any) error {
+	timeout := g.guard.timeoutFor(hookName)
+	if timeout <= 0 {
+		err := g.client.Call("Plugin."+hookName, args, reply)
+		g.guard.recordResult(hookName, err)
+		return err
+	}
+
+	replyValue := reflect.ValueOf(reply).Elem()
+	privateReply := reflect.New(replyValue.Type())
+	privateReply.Elem().Set(replyValue)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	call := g.client.Go("Plugin."+hookName, args, privateReply.Interface(), make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		if call.Error == nil {
+			replyValue.Set(privateReply.Elem())
+		}
+		g.guard.recordResult(hookName, call.Error)
+		return call.Error
+	case <-timer.C:
+		err := fmt.Errorf("%w after %v", errHookTimeout, timeout)
+		g.guard.recordResult(hookName, err)
+		return err
+	}
+}
+
 type apiRPCClient struct {
 	client    *rpc.Client
 	muxBroker *plugin.MuxBroker
@@ -638,13 +685,21 @@ type Z_MessageWillBePostedReturns struct {
 
 func (g *hooksRPCClient) MessageWillBePosted(c *Context, post *model.Post) (*model.Post, string) {
 	_args := &Z_MessageWillBePostedArgs{c, post}
-	_returns := &Z_MessageWillBePostedReturns{A: _args.B}
 	if g.implemented[MessageWillBePostedID] {
-		if err := g.client.Call("Plugin.MessageWillBePosted", _args, _returns); err != nil {
+		// Decode into a copy of the post, since a response arriving after the hook timed out
+		// would otherwise modify the post while the server is still using it.
+		_returns := &Z_MessageWillBePostedReturns{A: post.Clone()}
+		if err := g.call("MessageWillBePosted", _args, _returns); err != nil {
 			g.log.Error("RPC call MessageWillBePosted to plugin failed.", mlog.Err(err))
+			// A post the plugin didn't get to filter in time is rejected rather than let through.
+			if errors.Is(err, errHookTimeout) {
+				return nil, errHookTimeout.Error()
+			}
+			return post, ""
 		}
+		return _returns.A, _returns.B
 	}
-	return _returns.A, _returns.B
+	return post, ""
 }
 
 func (s *hooksRPCServer) MessageWillBePosted(args *Z_MessageWillBePostedArgs, returns *Z_MessageWillBePostedReturns) error {
@@ -681,8 +736,11 @@ func (g *hooksRPCClient) MessageWillBeUpdated(c *Context, newPost, oldPost *mode
 	_default_returns := &Z_MessageWillBeUpdatedReturns{A: _args.B}
 	if g.implemented[MessageWillBeUpdatedID] {
 		_returns := &Z_MessageWillBeUpdatedReturns{}
-		if err := g.client.Call("Plugin.MessageWillBeUpdated", _args, _returns); err != nil {
+		if err := g.call("MessageWillBeUpdated", _args, _returns); err != nil {
 			g.log.Error("RPC call MessageWillBeUpdated to plugin failed.", mlog.Err(err))
+			if errors.Is(err, errHookTimeout) {
+				return nil, errHookTimeout.Error()
+			}
 			return _default_returns.A, _default_returns.B
 		}
 		return _returns.A, _returns.B
@@ -720,8 +778,9 @@ func (g *hooksRPCClient) MessagesWillBeConsumed(posts []*model.Post) []*model.Po
 	_args := &Z_MessagesWillBeConsumedArgs{posts}
 	_returns := &Z_MessagesWillBeConsumedReturns{}
 	if g.implemented[MessagesWillBeConsumedID] {
-		if err := g.client.Call("Plugin.MessagesWillBeConsumed", _args, _returns); err != nil {
+		if err := g.call("MessagesWillBeConsumed", _args, _returns); err != nil {
 			g.log.Error("RPC call MessagesWillBeConsumed to plugin failed.", mlog.Err(err))
+			return posts
 		}
 	}
 	return _returns.A
@@ -29,7 +29,7 @@ func (g *hooksRPCClient) OnDeactivate() error {
 	_args := &Z_OnDeactivateArgs{}
 	_returns := &Z_OnDeactivateReturns{}
 	if g.implemented[OnDeactivateID] {
-		if err := g.client.Call("Plugin.OnDeactivate", _args, _returns); err != nil {
+		if err := g.call("OnDeactivate", _args, _returns); err != nil {
 			g.log.Error("RPC call OnDeactivate to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -63,7 +63,7 @@ func (g *hooksRPCClient) OnConfigurationChange() error {
 	_args := &Z_OnConfigurationChangeArgs{}
 	_returns := &Z_OnConfigurationChangeReturns{}
 	if g.implemented[OnConfigurationChangeID] {
-		if err := g.client.Call("Plugin.OnConfigurationChange", _args, _returns); err != nil {
+		if err := g.call("OnConfigurationChange", _args, _returns); err != nil {
 			g.log.Error("RPC call OnConfigurationChange to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -100,7 +100,7 @@ func (g *hooksRPCClient) ExecuteCommand(c *Context, args *model.CommandArgs) (*m
 	_args := &Z_ExecuteCommandArgs{c, args}
 	_returns := &Z_ExecuteCommandReturns{}
 	if g.implemented[ExecuteCommandID] {
-		if err := g.client.Call("Plugin.ExecuteCommand", _args, _returns); err != nil {
+		if err := g.call("ExecuteCommand", _args, _returns); err != nil {
 			g.log.Error("RPC call ExecuteCommand to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -134,7 +134,7 @@ func (g *hooksRPCClient) UserHasBeenCreated(c *Context, user *model.User) {
 	_args := &Z_UserHasBeenCreatedArgs{c, user}
 	_returns := &Z_UserHasBeenCreatedReturns{}
 	if g.implemented[UserHasBeenCreatedID] {
-		if err := g.client.Call("Plugin.UserHasBeenCreated", _args, _returns); err != nil {
+		if err := g.call("UserHasBeenCreated", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasBeenCreated to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -169,7 +169,7 @@ func (g *hooksRPCClient) UserWillLogIn(c *Context, user *model.User) string {
 	_args := &Z_UserWillLogInArgs{c, user}
 	_returns := &Z_UserWillLogInReturns{}
 	if g.implemented[UserWillLogInID] {
-		if err := g.client.Call("Plugin.UserWillLogIn", _args, _returns); err != nil {
+		if err := g.call("UserWillLogIn", _args, _returns); err != nil {
 			g.log.Error("RPC call UserWillLogIn to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -203,7 +203,7 @@ func (g *hooksRPCClient) UserHasLoggedIn(c *Context, user *model.User) {
 	_args := &Z_UserHasLoggedInArgs{c, user}
 	_returns := &Z_UserHasLoggedInReturns{}
 	if g.implemented[UserHasLoggedInID] {
-		if err := g.client.Call("Plugin.UserHasLoggedIn", _args, _returns); err != nil {
+		if err := g.call("UserHasLoggedIn", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasLoggedIn to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -237,7 +237,7 @@ func (g *hooksRPCClient) MessageHasBeenPosted(c *Context, post *model.Post) {
 	_args := &Z_MessageHasBeenPostedArgs{c, post}
 	_returns := &Z_MessageHasBeenPostedReturns{}
 	if g.implemented[MessageHasBeenPostedID] {
-		if err := g.client.Call("Plugin.MessageHasBeenPosted", _args, _returns); err != nil {
+		if err := g.call("MessageHasBeenPosted", _args, _returns); err != nil {
 			g.log.Error("RPC call MessageHasBeenPosted to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -272,7 +272,7 @@ func (g *hooksRPCClient) MessageHasBeenUpdated(c *Context, newPost, oldPost *mod
 	_args := &Z_MessageHasBeenUpdatedArgs{c, newPost, oldPost}
 	_returns := &Z_MessageHasBeenUpdatedReturns{}
 	if g.implemented[MessageHasBeenUpdatedID] {
-		if err := g.client.Call("Plugin.MessageHasBeenUpdated", _args, _returns); err != nil {
+		if err := g.call("MessageHasBeenUpdated", _args, _returns); err != nil {
 			g.log.Error("RPC call MessageHasBeenUpdated to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -306,7 +306,7 @@ func (g *hooksRPCClient) MessageHasBeenDeleted(c *Context, post *model.Post) {
 	_args := &Z_MessageHasBeenDeletedArgs{c, post}
 	_returns := &Z_MessageHasBeenDeletedReturns{}
 	if g.implemented[MessageHasBeenDeletedID] {
-		if err := g.client.Call("Plugin.MessageHasBeenDeleted", _args, _returns); err != nil {
+		if err := g.call("MessageHasBeenDeleted", _args, _returns); err != nil {
 			g.log.Error("RPC call MessageHasBeenDeleted to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -340,7 +340,7 @@ func (g *hooksRPCClient) ChannelHasBeenCreated(c *Context, channel *model.Channe
 	_args := &Z_ChannelHasBeenCreatedArgs{c, channel}
 	_returns := &Z_ChannelHasBeenCreatedReturns{}
 	if g.implemented[ChannelHasBeenCreatedID] {
-		if err := g.client.Call("Plugin.ChannelHasBeenCreated", _args, _returns); err != nil {
+		if err := g.call("ChannelHasBeenCreated", _args, _returns); err != nil {
 			g.log.Error("RPC call ChannelHasBeenCreated to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -375,7 +375,7 @@ func (g *hooksRPCClient) UserHasJoinedChannel(c *Context, channelMember *model.C
 	_args := &Z_UserHasJoinedChannelArgs{c, channelMember, actor}
 	_returns := &Z_UserHasJoinedChannelReturns{}
 	if g.implemented[UserHasJoinedChannelID] {
-		if err := g.client.Call("Plugin.UserHasJoinedChannel", _args, _returns); err != nil {
+		if err := g.call("UserHasJoinedChannel", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasJoinedChannel to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -410,7 +410,7 @@ func (g *hooksRPCClient) UserHasLeftChannel(c *Context, channelMember *model.Cha
 	_args := &Z_UserHasLeftChannelArgs{c, channelMember, actor}
 	_returns := &Z_UserHasLeftChannelReturns{}
 	if g.implemented[UserHasLeftChannelID] {
-		if err := g.client.Call("Plugin.UserHasLeftChannel", _args, _returns); err != nil {
+		if err := g.call("UserHasLeftChannel", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasLeftChannel to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -445,7 +445,7 @@ func (g *hooksRPCClient) UserHasJoinedTeam(c *Context, teamMember *model.TeamMem
 	_args := &Z_UserHasJoinedTeamArgs{c, teamMember, actor}
 	_returns := &Z_UserHasJoinedTeamReturns{}
 	if g.implemented[UserHasJoinedTeamID] {
-		if err := g.client.Call("Plugin.UserHasJoinedTeam", _args, _returns); err != nil {
+		if err := g.call("UserHasJoinedTeam", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasJoinedTeam to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -480,7 +480,7 @@ func (g *hooksRPCClient) UserHasLeftTeam(c *Context, teamMember *model.TeamMembe
 	_args := &Z_UserHasLeftTeamArgs{c, teamMember, actor}
 	_returns := &Z_UserHasLeftTeamReturns{}
 	if g.implemented[UserHasLeftTeamID] {
-		if err := g.client.Call("Plugin.UserHasLeftTeam", _args, _returns); err != nil {
+		if err := g.call("UserHasLeftTeam", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasLeftTeam to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -514,7 +514,7 @@ func (g *hooksRPCClient) ReactionHasBeenAdded(c *Context, reaction *model.Reacti
 	_args := &Z_ReactionHasBeenAddedArgs{c, reaction}
 	_returns := &Z_ReactionHasBeenAddedReturns{}
 	if g.implemented[ReactionHasBeenAddedID] {
-		if err := g.client.Call("Plugin.ReactionHasBeenAdded", _args, _returns); err != nil {
+		if err := g.call("ReactionHasBeenAdded", _args, _returns); err != nil {
 			g.log.Error("RPC call ReactionHasBeenAdded to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -548,7 +548,7 @@ func (g *hooksRPCClient) ReactionHasBeenRemoved(c *Context, reaction *model.Reac
 	_args := &Z_ReactionHasBeenRemovedArgs{c, reaction}
 	_returns := &Z_ReactionHasBeenRemovedReturns{}
 	if g.implemented[ReactionHasBeenRemovedID] {
-		if err := g.client.Call("Plugin.ReactionHasBeenRemoved", _args, _returns); err != nil {
+		if err := g.call("ReactionHasBeenRemoved", _args, _returns); err != nil {
 			g.log.Error("RPC call ReactionHasBeenRemoved to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -582,7 +582,7 @@ func (g *hooksRPCClient) OnPluginClusterEvent(c *Context, ev model.PluginCluster
 	_args := &Z_OnPluginClusterEventArgs{c, ev}
 	_returns := &Z_OnPluginClusterEventReturns{}
 	if g.implemented[OnPluginClusterEventID] {
-		if err := g.client.Call("Plugin.OnPluginClusterEvent", _args, _returns); err != nil {
+		if err := g.call("OnPluginClusterEvent", _args, _returns); err != nil {
 			g.log.Error("RPC call OnPluginClusterEvent to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -616,7 +616,7 @@ func (g *hooksRPCClient) OnWebSocketConnect(webConnID, userID string) {
 	_args := &Z_OnWebSocketConnectArgs{webConnID, userID}
 	_returns := &Z_OnWebSocketConnectReturns{}
 	if g.implemented[OnWebSocketConnectID] {
-		if err := g.client.Call("Plugin.OnWebSocketConnect", _args, _returns); err != nil {
+		if err := g.call("OnWebSocketConnect", _args, _returns); err != nil {
 			g.log.Error("RPC call OnWebSocketConnect to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -650,7 +650,7 @@ func (g *hooksRPCClient) OnWebSocketDisconnect(webConnID, userID string) {
 	_args := &Z_OnWebSocketDisconnectArgs{webConnID, userID}
 	_returns := &Z_OnWebSocketDisconnectReturns{}
 	if g.implemented[OnWebSocketDisconnectID] {
-		if err := g.client.Call("Plugin.OnWebSocketDisconnect", _args, _returns); err != nil {
+		if err := g.call("OnWebSocketDisconnect", _args, _returns); err != nil {
 			g.log.Error("RPC call OnWebSocketDisconnect to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -685,7 +685,7 @@ func (g *hooksRPCClient) WebSocketMessageHasBeenPosted(webConnID, userID string,
 	_args := &Z_WebSocketMessageHasBeenPostedArgs{webConnID, userID, req}
 	_returns := &Z_WebSocketMessageHasBeenPostedReturns{}
 	if g.implemented[WebSocketMessageHasBeenPostedID] {
-		if err := g.client.Call("Plugin.WebSocketMessageHasBeenPosted", _args, _returns); err != nil {
+		if err := g.call("WebSocketMessageHasBeenPosted", _args, _returns); err != nil {
 			g.log.Error("RPC call WebSocketMessageHasBeenPosted to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -721,7 +721,7 @@ func (g *hooksRPCClient) RunDataRetention(nowTime, batchSize int64) (int64, erro
 	_args := &Z_RunDataRetentionArgs{nowTime, batchSize}
 	_returns := &Z_RunDataRetentionReturns{}
 	if g.implemented[RunDataRetentionID] {
-		if err := g.client.Call("Plugin.RunDataRetention", _args, _returns); err != nil {
+		if err := g.call("RunDataRetention", _args, _returns); err != nil {
 			g.log.Error("RPC call RunDataRetention to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -757,7 +757,7 @@ func (g *hooksRPCClient) OnInstall(c *Context, event model.OnInstallEvent) error
 	_args := &Z_OnInstallArgs{c, event}
 	_returns := &Z_OnInstallReturns{}
 	if g.implemented[OnInstallID] {
-		if err := g.client.Call("Plugin.OnInstall", _args, _returns); err != nil {
+		if err := g.call("OnInstall", _args, _returns); err != nil {
 			g.log.Error("RPC call OnInstall to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -790,7 +790,7 @@ func (g *hooksRPCClient) OnSendDailyTelemetry() {
 	_args := &Z_OnSendDailyTelemetryArgs{}
 	_returns := &Z_OnSendDailyTelemetryReturns{}
 	if g.implemented[OnSendDailyTelemetryID] {
-		if err := g.client.Call("Plugin.OnSendDailyTelemetry", _args, _returns); err != nil {
+		if err := g.call("OnSendDailyTelemetry", _args, _returns); err != nil {
 			g.log.Error("RPC call OnSendDailyTelemetry to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -823,7 +823,7 @@ func (g *hooksRPCClient) OnCloudLimitsUpdated(limits *model.ProductLimits) {
 	_args := &Z_OnCloudLimitsUpdatedArgs{limits}
 	_returns := &Z_OnCloudLimitsUpdatedReturns{}
 	if g.implemented[OnCloudLimitsUpdatedID] {
-		if err := g.client.Call("Plugin.OnCloudLimitsUpdated", _args, _returns); err != nil {
+		if err := g.call("OnCloudLimitsUpdated", _args, _returns); err != nil {
 			g.log.Error("RPC call OnCloudLimitsUpdated to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -858,7 +858,7 @@ func (g *hooksRPCClient) ConfigurationWillBeSaved(newCfg *model.Config) (*model.
 	_args := &Z_ConfigurationWillBeSavedArgs{newCfg}
 	_returns := &Z_ConfigurationWillBeSavedReturns{}
 	if g.implemented[ConfigurationWillBeSavedID] {
-		if err := g.client.Call("Plugin.ConfigurationWillBeSaved", _args, _returns); err != nil {
+		if err := g.call("ConfigurationWillBeSaved", _args, _returns); err != nil {
 			g.log.Error("RPC call ConfigurationWillBeSaved to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -895,7 +895,7 @@ func (g *hooksRPCClient) NotificationWillBePushed(pushNotification *model.PushNo
 	_args := &Z_NotificationWillBePushedArgs{pushNotification, userID}
 	_returns := &Z_NotificationWillBePushedReturns{}
 	if g.implemented[NotificationWillBePushedID] {
-		if err := g.client.Call("Plugin.NotificationWillBePushed", _args, _returns); err != nil {
+		if err := g.call("NotificationWillBePushed", _args, _returns); err != nil {
 			g.log.Error("RPC call NotificationWillBePushed to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -929,7 +929,7 @@ func (g *hooksRPCClient) UserHasBeenDeactivated(c *Context, user *model.User) {
 	_args := &Z_UserHasBeenDeactivatedArgs{c, user}
 	_returns := &Z_UserHasBeenDeactivatedReturns{}
 	if g.implemented[UserHasBeenDeactivatedID] {
-		if err := g.client.Call("Plugin.UserHasBeenDeactivated", _args, _returns); err != nil {
+		if err := g.call("UserHasBeenDeactivated", _args, _returns); err != nil {
 			g.log.Error("RPC call UserHasBeenDeactivated to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -965,7 +965,7 @@ func (g *hooksRPCClient) OnSharedChannelsSyncMsg(msg *model.SyncMsg, rc *model.R
 	_args := &Z_OnSharedChannelsSyncMsgArgs{msg, rc}
 	_returns := &Z_OnSharedChannelsSyncMsgReturns{}
 	if g.implemented[OnSharedChannelsSyncMsgID] {
-		if err := g.client.Call("Plugin.OnSharedChannelsSyncMsg", _args, _returns); err != nil {
+		if err := g.call("OnSharedChannelsSyncMsg", _args, _returns); err != nil {
 			g.log.Error("RPC call OnSharedChannelsSyncMsg to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1000,7 +1000,7 @@ func (g *hooksRPCClient) OnSharedChannelsPing(rc *model.RemoteCluster) bool {
 	_args := &Z_OnSharedChannelsPingArgs{rc}
 	_returns := &Z_OnSharedChannelsPingReturns{}
 	if g.implemented[OnSharedChannelsPingID] {
-		if err := g.client.Call("Plugin.OnSharedChannelsPing", _args, _returns); err != nil {
+		if err := g.call("OnSharedChannelsPing", _args, _returns); err != nil {
 			g.log.Error("RPC call OnSharedChannelsPing to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1034,7 +1034,7 @@ func (g *hooksRPCClient) PreferencesHaveChanged(c *Context, preferences []model.
 	_args := &Z_PreferencesHaveChangedArgs{c, preferences}
 	_returns := &Z_PreferencesHaveChangedReturns{}
 	if g.implemented[PreferencesHaveChangedID] {
-		if err := g.client.Call("Plugin.PreferencesHaveChanged", _args, _returns); err != nil {
+		if err := g.call("PreferencesHaveChanged", _args, _returns); err != nil {
 			g.log.Error("RPC call PreferencesHaveChanged to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1070,7 +1070,7 @@ func (g *hooksRPCClient) OnSharedChannelsAttachmentSyncMsg(fi *model.FileInfo, p
 	_args := &Z_OnSharedChannelsAttachmentSyncMsgArgs{fi, post, rc}
 	_returns := &Z_OnSharedChannelsAttachmentSyncMsgReturns{}
 	if g.implemented[OnSharedChannelsAttachmentSyncMsgID] {
-		if err := g.client.Call("Plugin.OnSharedChannelsAttachmentSyncMsg", _args, _returns); err != nil {
+		if err := g.call("OnSharedChannelsAttachmentSyncMsg", _args, _returns); err != nil {
 			g.log.Error("RPC call OnSharedChannelsAttachmentSyncMsg to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1106,7 +1106,7 @@ func (g *hooksRPCClient) OnSharedChannelsProfileImageSyncMsg(user *model.User, r
 	_args := &Z_OnSharedChannelsProfileImageSyncMsgArgs{user, rc}
 	_returns := &Z_OnSharedChannelsProfileImageSyncMsgReturns{}
 	if g.implemented[OnSharedChannelsProfileImageSyncMsgID] {
-		if err := g.client.Call("Plugin.OnSharedChannelsProfileImageSyncMsg", _args, _returns); err != nil {
+		if err := g.call("OnSharedChannelsProfileImageSyncMsg", _args, _returns); err != nil {
 			g.log.Error("RPC call OnSharedChannelsProfileImageSyncMsg to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1142,7 +1142,7 @@ func (g *hooksRPCClient) GenerateSupportData(c *Context) ([]*model.FileData, err
 	_args := &Z_GenerateSupportDataArgs{c}
 	_returns := &Z_GenerateSupportDataReturns{}
 	if g.implemented[GenerateSupportDataID] {
-		if err := g.client.Call("Plugin.GenerateSupportData", _args, _returns); err != nil {
+		if err := g.call("GenerateSupportData", _args, _returns); err != nil {
 			g.log.Error("RPC call GenerateSupportData to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -1161,6 +1161,634 @@ func (s *hooksRPCServer) GenerateSupportData(args *Z_GenerateSupportDataArgs, re
 	return nil
 }
 
+func init() {
+	hookNameToId["ChannelHasBeenArchived"] = ChannelHasBeenArchivedID
+}
+
+type Z_ChannelHasBeenArchivedArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.User
+}
+
+type Z_ChannelHasBeenArchivedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User) {
+	_args := &Z_ChannelHasBeenArchivedArgs{c, channel, actor}
+	_returns := &Z_ChannelHasBeenArchivedReturns{}
+	if g.implemented[ChannelHasBeenArchivedID] {
+		if err := g.call("ChannelHasBeenArchived", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenArchived to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenArchived(args *Z_ChannelHasBeenArchivedArgs, returns *Z_ChannelHasBeenArchivedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User)
+	}); ok {
+		hook.ChannelHasBeenArchived(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenArchived called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenRestored"] = ChannelHasBeenRestoredID
+}
+
+type Z_ChannelHasBeenRestoredArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.User
+}
+
+type Z_ChannelHasBeenRestoredReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User) {
+	_args := &Z_ChannelHasBeenRestoredArgs{c, channel, actor}
+	_returns := &Z_ChannelHasBeenRestoredReturns{}
+	if g.implemented[ChannelHasBeenRestoredID] {
+		if err := g.call("ChannelHasBeenRestored", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenRestored to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenRestored(args *Z_ChannelHasBeenRestoredArgs, returns *Z_ChannelHasBeenRestoredReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User)
+	}); ok {
+		hook.ChannelHasBeenRestored(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenRestored called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenRenamed"] = ChannelHasBeenRenamedID
+}
+
+type Z_ChannelHasBeenRenamedArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.Channel
+}
+
+type Z_ChannelHasBeenRenamedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenRenamed(c *Context, newChannel, oldChannel *model.Channel) {
+	_args := &Z_ChannelHasBeenRenamedArgs{c, newChannel, oldChannel}
+	_returns := &Z_ChannelHasBeenRenamedReturns{}
+	if g.implemented[ChannelHasBeenRenamedID] {
+		if err := g.call("ChannelHasBeenRenamed", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenRenamed to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenRenamed(args *Z_ChannelHasBeenRenamedArgs, returns *Z_ChannelHasBeenRenamedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenRenamed(c *Context, newChannel, oldChannel *model.Channel)
+	}); ok {
+		hook.ChannelHasBeenRenamed(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenRenamed called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelHasBeenConverted"] = ChannelHasBeenConvertedID
+}
+
+type Z_ChannelHasBeenConvertedArgs struct {
+	A *Context
+	B *model.Channel
+	C *model.Channel
+}
+
+type Z_ChannelHasBeenConvertedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelHasBeenConverted(c *Context, newChannel, oldChannel *model.Channel) {
+	_args := &Z_ChannelHasBeenConvertedArgs{c, newChannel, oldChannel}
+	_returns := &Z_ChannelHasBeenConvertedReturns{}
+	if g.implemented[ChannelHasBeenConvertedID] {
+		if err := g.call("ChannelHasBeenConverted", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelHasBeenConverted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelHasBeenConverted(args *Z_ChannelHasBeenConvertedArgs, returns *Z_ChannelHasBeenConvertedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelHasBeenConverted(c *Context, newChannel, oldChannel *model.Channel)
+	}); ok {
+		hook.ChannelHasBeenConverted(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelHasBeenConverted called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelMemberRolesHaveChanged"] = ChannelMemberRolesHaveChangedID
+}
+
+type Z_ChannelMemberRolesHaveChangedArgs struct {
+	A *Context
+	B *model.ChannelMember
+	C *model.ChannelMember
+}
+
+type Z_ChannelMemberRolesHaveChangedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelMemberRolesHaveChanged(c *Context, newMember, oldMember *model.ChannelMember) {
+	_args := &Z_ChannelMemberRolesHaveChangedArgs{c, newMember, oldMember}
+	_returns := &Z_ChannelMemberRolesHaveChangedReturns{}
+	if g.implemented[ChannelMemberRolesHaveChangedID] {
+		if err := g.call("ChannelMemberRolesHaveChanged", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelMemberRolesHaveChanged to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelMemberRolesHaveChanged(args *Z_ChannelMemberRolesHaveChangedArgs, returns *Z_ChannelMemberRolesHaveChangedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelMemberRolesHaveChanged(c *Context, newMember, oldMember *model.ChannelMember)
+	}); ok {
+		hook.ChannelMemberRolesHaveChanged(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelMemberRolesHaveChanged called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["TeamMemberRolesHaveChanged"] = TeamMemberRolesHaveChangedID
+}
+
+type Z_TeamMemberRolesHaveChangedArgs struct {
+	A *Context
+	B *model.TeamMember
+	C *model.TeamMember
+}
+
+type Z_TeamMemberRolesHaveChangedReturns struct {
+}
+
+func (g *hooksRPCClient) TeamMemberRolesHaveChanged(c *Context, newMember, oldMember *model.TeamMember) {
+	_args := &Z_TeamMemberRolesHaveChangedArgs{c, newMember, oldMember}
+	_returns := &Z_TeamMemberRolesHaveChangedReturns{}
+	if g.implemented[TeamMemberRolesHaveChangedID] {
+		if err := g.call("TeamMemberRolesHaveChanged", _args, _returns); err != nil {
+			g.log.Error("RPC call TeamMemberRolesHaveChanged to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) TeamMemberRolesHaveChanged(args *Z_TeamMemberRolesHaveChangedArgs, returns *Z_TeamMemberRolesHaveChangedReturns) error {
+	if hook, ok := s.impl.(interface {
+		TeamMemberRolesHaveChanged(c *Context, newMember, oldMember *model.TeamMember)
+	}); ok {
+		hook.TeamMemberRolesHaveChanged(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook TeamMemberRolesHaveChanged called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["PostHasBeenPinned"] = PostHasBeenPinnedID
+}
+
+type Z_PostHasBeenPinnedArgs struct {
+	A *Context
+	B *model.Post
+}
+
+type Z_PostHasBeenPinnedReturns struct {
+}
+
+func (g *hooksRPCClient) PostHasBeenPinned(c *Context, post *model.Post) {
+	_args := &Z_PostHasBeenPinnedArgs{c, post}
+	_returns := &Z_PostHasBeenPinnedReturns{}
+	if g.implemented[PostHasBeenPinnedID] {
+		if err := g.call("PostHasBeenPinned", _args, _returns); err != nil {
+			g.log.Error("RPC call PostHasBeenPinned to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) PostHasBeenPinned(args *Z_PostHasBeenPinnedArgs, returns *Z_PostHasBeenPinnedReturns) error {
+	if hook, ok := s.impl.(interface {
+		PostHasBeenPinned(c *Context, post *model.Post)
+	}); ok {
+		hook.PostHasBeenPinned(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook PostHasBeenPinned called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["PostHasBeenUnpinned"] = PostHasBeenUnpinnedID
+}
+
+type Z_PostHasBeenUnpinnedArgs struct {
+	A *Context
+	B *model.Post
+}
+
+type Z_PostHasBeenUnpinnedReturns struct {
+}
+
+func (g *hooksRPCClient) PostHasBeenUnpinned(c *Context, post *model.Post) {
+	_args := &Z_PostHasBeenUnpinnedArgs{c, post}
+	_returns := &Z_PostHasBeenUnpinnedReturns{}
+	if g.implemented[PostHasBeenUnpinnedID] {
+		if err := g.call("PostHasBeenUnpinned", _args, _returns); err != nil {
+			g.log.Error("RPC call PostHasBeenUnpinned to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) PostHasBeenUnpinned(args *Z_PostHasBeenUnpinnedArgs, returns *Z_PostHasBeenUnpinnedReturns) error {
+	if hook, ok := s.impl.(interface {
+		PostHasBeenUnpinned(c *Context, post *model.Post)
+	}); ok {
+		hook.PostHasBeenUnpinned(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook PostHasBeenUnpinned called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["PostHasBeenAcknowledged"] = PostHasBeenAcknowledgedID
+}
+
+type Z_PostHasBeenAcknowledgedArgs struct {
+	A *Context
+	B *model.PostAcknowledgement
+}
+
+type Z_PostHasBeenAcknowledgedReturns struct {
+}
+
+func (g *hooksRPCClient) PostHasBeenAcknowledged(c *Context, acknowledgement *model.PostAcknowledgement) {
+	_args := &Z_PostHasBeenAcknowledgedArgs{c, acknowledgement}
+	_returns := &Z_PostHasBeenAcknowledgedReturns{}
+	if g.implemented[PostHasBeenAcknowledgedID] {
+		if err := g.call("PostHasBeenAcknowledged", _args, _returns); err != nil {
+			g.log.Error("RPC call PostHasBeenAcknowledged to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) PostHasBeenAcknowledged(args *Z_PostHasBeenAcknowledgedArgs, returns *Z_PostHasBeenAcknowledgedReturns) error {
+	if hook, ok := s.impl.(interface {
+		PostHasBeenAcknowledged(c *Context, acknowledgement *model.PostAcknowledgement)
+	}); ok {
+		hook.PostHasBeenAcknowledged(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook PostHasBeenAcknowledged called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelBookmarkHasBeenCreated"] = ChannelBookmarkHasBeenCreatedID
+}
+
+type Z_ChannelBookmarkHasBeenCreatedArgs struct {
+	A *Context
+	B *model.ChannelBookmarkWithFileInfo
+}
+
+type Z_ChannelBookmarkHasBeenCreatedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelBookmarkHasBeenCreated(c *Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	_args := &Z_ChannelBookmarkHasBeenCreatedArgs{c, bookmark}
+	_returns := &Z_ChannelBookmarkHasBeenCreatedReturns{}
+	if g.implemented[ChannelBookmarkHasBeenCreatedID] {
+		if err := g.call("ChannelBookmarkHasBeenCreated", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelBookmarkHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelBookmarkHasBeenCreated(args *Z_ChannelBookmarkHasBeenCreatedArgs, returns *Z_ChannelBookmarkHasBeenCreatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelBookmarkHasBeenCreated(c *Context, bookmark *model.ChannelBookmarkWithFileInfo)
+	}); ok {
+		hook.ChannelBookmarkHasBeenCreated(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelBookmarkHasBeenCreated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelBookmarkHasBeenUpdated"] = ChannelBookmarkHasBeenUpdatedID
+}
+
+type Z_ChannelBookmarkHasBeenUpdatedArgs struct {
+	A *Context
+	B *model.ChannelBookmarkWithFileInfo
+	C *model.ChannelBookmarkWithFileInfo
+}
+
+type Z_ChannelBookmarkHasBeenUpdatedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelBookmarkHasBeenUpdated(c *Context, newBookmark, oldBookmark *model.ChannelBookmarkWithFileInfo) {
+	_args := &Z_ChannelBookmarkHasBeenUpdatedArgs{c, newBookmark, oldBookmark}
+	_returns := &Z_ChannelBookmarkHasBeenUpdatedReturns{}
+	if g.implemented[ChannelBookmarkHasBeenUpdatedID] {
+		if err := g.call("ChannelBookmarkHasBeenUpdated", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelBookmarkHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelBookmarkHasBeenUpdated(args *Z_ChannelBookmarkHasBeenUpdatedArgs, returns *Z_ChannelBookmarkHasBeenUpdatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelBookmarkHasBeenUpdated(c *Context, newBookmark, oldBookmark *model.ChannelBookmarkWithFileInfo)
+	}); ok {
+		hook.ChannelBookmarkHasBeenUpdated(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelBookmarkHasBeenUpdated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["ChannelBookmarkHasBeenDeleted"] = ChannelBookmarkHasBeenDeletedID
+}
+
+type Z_ChannelBookmarkHasBeenDeletedArgs struct {
+	A *Context
+	B *model.ChannelBookmarkWithFileInfo
+}
+
+type Z_ChannelBookmarkHasBeenDeletedReturns struct {
+}
+
+func (g *hooksRPCClient) ChannelBookmarkHasBeenDeleted(c *Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	_args := &Z_ChannelBookmarkHasBeenDeletedArgs{c, bookmark}
+	_returns := &Z_ChannelBookmarkHasBeenDeletedReturns{}
+	if g.implemented[ChannelBookmarkHasBeenDeletedID] {
+		if err := g.call("ChannelBookmarkHasBeenDeleted", _args, _returns); err != nil {
+			g.log.Error("RPC call ChannelBookmarkHasBeenDeleted to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) ChannelBookmarkHasBeenDeleted(args *Z_ChannelBookmarkHasBeenDeletedArgs, returns *Z_ChannelBookmarkHasBeenDeletedReturns) error {
+	if hook, ok := s.impl.(interface {
+		ChannelBookmarkHasBeenDeleted(c *Context, bookmark *model.ChannelBookmarkWithFileInfo)
+	}); ok {
+		hook.ChannelBookmarkHasBeenDeleted(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook ChannelBookmarkHasBeenDeleted called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["TeamHasBeenCreated"] = TeamHasBeenCreatedID
+}
+
+type Z_TeamHasBeenCreatedArgs struct {
+	A *Context
+	B *model.Team
+}
+
+type Z_TeamHasBeenCreatedReturns struct {
+}
+
+func (g *hooksRPCClient) TeamHasBeenCreated(c *Context, team *model.Team) {
+	_args := &Z_TeamHasBeenCreatedArgs{c, team}
+	_returns := &Z_TeamHasBeenCreatedReturns{}
+	if g.implemented[TeamHasBeenCreatedID] {
+		if err := g.call("TeamHasBeenCreated", _args, _returns); err != nil {
+			g.log.Error("RPC call TeamHasBeenCreated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) TeamHasBeenCreated(args *Z_TeamHasBeenCreatedArgs, returns *Z_TeamHasBeenCreatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		TeamHasBeenCreated(c *Context, team *model.Team)
+	}); ok {
+		hook.TeamHasBeenCreated(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook TeamHasBeenCreated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["TeamHasBeenUpdated"] = TeamHasBeenUpdatedID
+}
+
+type Z_TeamHasBeenUpdatedArgs struct {
+	A *Context
+	B *model.Team
+	C *model.Team
+}
+
+type Z_TeamHasBeenUpdatedReturns struct {
+}
+
+func (g *hooksRPCClient) TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team) {
+	_args := &Z_TeamHasBeenUpdatedArgs{c, newTeam, oldTeam}
+	_returns := &Z_TeamHasBeenUpdatedReturns{}
+	if g.implemented[TeamHasBeenUpdatedID] {
+		if err := g.call("TeamHasBeenUpdated", _args, _returns); err != nil {
+			g.log.Error("RPC call TeamHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) TeamHasBeenUpdated(args *Z_TeamHasBeenUpdatedArgs, returns *Z_TeamHasBeenUpdatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team)
+	}); ok {
+		hook.TeamHasBeenUpdated(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook TeamHasBeenUpdated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["UserHasBeenUpdated"] = UserHasBeenUpdatedID
+}
+
+type Z_UserHasBeenUpdatedArgs struct {
+	A *Context
+	B *model.User
+	C *model.User
+}
+
+type Z_UserHasBeenUpdatedReturns struct {
+}
+
+func (g *hooksRPCClient) UserHasBeenUpdated(c *Context, newUser, oldUser *model.User) {
+	_args := &Z_UserHasBeenUpdatedArgs{c, newUser, oldUser}
+	_returns := &Z_UserHasBeenUpdatedReturns{}
+	if g.implemented[UserHasBeenUpdatedID] {
+		if err := g.call("UserHasBeenUpdated", _args, _returns); err != nil {
+			g.log.Error("RPC call UserHasBeenUpdated to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) UserHasBeenUpdated(args *Z_UserHasBeenUpdatedArgs, returns *Z_UserHasBeenUpdatedReturns) error {
+	if hook, ok := s.impl.(interface {
+		UserHasBeenUpdated(c *Context, newUser, oldUser *model.User)
+	}); ok {
+		hook.UserHasBeenUpdated(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook UserHasBeenUpdated called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["UserCustomStatusHasChanged"] = UserCustomStatusHasChangedID
+}
+
+type Z_UserCustomStatusHasChangedArgs struct {
+	A *Context
+	B string
+	C *model.CustomStatus
+}
+
+type Z_UserCustomStatusHasChangedReturns struct {
+}
+
+func (g *hooksRPCClient) UserCustomStatusHasChanged(c *Context, userID string, customStatus *model.CustomStatus) {
+	_args := &Z_UserCustomStatusHasChangedArgs{c, userID, customStatus}
+	_returns := &Z_UserCustomStatusHasChangedReturns{}
+	if g.implemented[UserCustomStatusHasChangedID] {
+		if err := g.call("UserCustomStatusHasChanged", _args, _returns); err != nil {
+			g.log.Error("RPC call UserCustomStatusHasChanged to plugin failed.", mlog.Err(err))
+		}
+	}
+
+}
+
+func (s *hooksRPCServer) UserCustomStatusHasChanged(args *Z_UserCustomStatusHasChangedArgs, returns *Z_UserCustomStatusHasChangedReturns) error {
+	if hook, ok := s.impl.(interface {
+		UserCustomStatusHasChanged(c *Context, userID string, customStatus *model.CustomStatus)
+	}); ok {
+		hook.UserCustomStatusHasChanged(args.A, args.B, args.C)
+	} else {
+		return encodableError(fmt.Errorf("Hook UserCustomStatusHasChanged called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["RunPluginJob"] = RunPluginJobID
+}
+
+type Z_RunPluginJobArgs struct {
+	A *model.Job
+}
+
+type Z_RunPluginJobReturns struct {
+	A error
+}
+
+func (g *hooksRPCClient) RunPluginJob(job *model.Job) error {
+	_args := &Z_RunPluginJobArgs{job}
+	_returns := &Z_RunPluginJobReturns{}
+	if g.implemented[RunPluginJobID] {
+		if err := g.call("RunPluginJob", _args, _returns); err != nil {
+			g.log.Error("RPC call RunPluginJob to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A
+}
+
+func (s *hooksRPCServer) RunPluginJob(args *Z_RunPluginJobArgs, returns *Z_RunPluginJobReturns) error {
+	if hook, ok := s.impl.(interface {
+		RunPluginJob(job *model.Job) error
+	}); ok {
+		returns.A = hook.RunPluginJob(args.A)
+		returns.A = encodableError(returns.A)
+	} else {
+		return encodableError(fmt.Errorf("Hook RunPluginJob called but not implemented."))
+	}
+	return nil
+}
+
+func init() {
+	hookNameToId["TranslateText"] = TranslateTextID
+}
+
+type Z_TranslateTextArgs struct {
+	A *Context
+	B []string
+	C string
+	D string
+}
+
+type Z_TranslateTextReturns struct {
+	A []*model.TextTranslation
+	B error
+}
+
+func (g *hooksRPCClient) TranslateText(c *Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error) {
+	_args := &Z_TranslateTextArgs{c, texts, sourceLanguage, targetLocale}
+	_returns := &Z_TranslateTextReturns{}
+	if g.implemented[TranslateTextID] {
+		if err := g.call("TranslateText", _args, _returns); err != nil {
+			g.log.Error("RPC call TranslateText to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *hooksRPCServer) TranslateText(args *Z_TranslateTextArgs, returns *Z_TranslateTextReturns) error {
+	if hook, ok := s.impl.(interface {
+		TranslateText(c *Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error)
+	}); ok {
+		returns.A, returns.B = hook.TranslateText(args.A, args.B, args.C, args.D)
+		returns.B = encodableError(returns.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook TranslateText called but not implemented."))
+	}
+	return nil
+}
+
 type Z_RegisterCommandArgs struct {
 	A *model.Command
 }
@@ -5324,6 +5952,122 @@ func (s *apiRPCServer) KVList(args *Z_KVListArgs, returns *Z_KVListReturns) erro
 	return nil
 }
 
+type Z_KVListWithOptionsArgs struct {
+	A model.PluginKVListOptions
+}
+
+type Z_KVListWithOptionsReturns struct {
+	A *model.PluginKVListPage
+	B *model.AppError
+}
+
+func (g *apiRPCClient) KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	_args := &Z_KVListWithOptionsArgs{options}
+	_returns := &Z_KVListWithOptionsReturns{}
+	if err := g.client.Call("Plugin.KVListWithOptions", _args, _returns); err != nil {
+		log.Printf("RPC call to KVListWithOptions API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) KVListWithOptions(args *Z_KVListWithOptionsArgs, returns *Z_KVListWithOptionsReturns) error {
+	if hook, ok := s.impl.(interface {
+		KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.KVListWithOptions(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API KVListWithOptions called but not implemented."))
+	}
+	return nil
+}
+
+type Z_KVGetMultiArgs struct {
+	A []string
+}
+
+type Z_KVGetMultiReturns struct {
+	A map[string][]byte
+	B *model.AppError
+}
+
+func (g *apiRPCClient) KVGetMulti(keys []string) (map[string][]byte, *model.AppError) {
+	_args := &Z_KVGetMultiArgs{keys}
+	_returns := &Z_KVGetMultiReturns{}
+	if err := g.client.Call("Plugin.KVGetMulti", _args, _returns); err != nil {
+		log.Printf("RPC call to KVGetMulti API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) KVGetMulti(args *Z_KVGetMultiArgs, returns *Z_KVGetMultiReturns) error {
+	if hook, ok := s.impl.(interface {
+		KVGetMulti(keys []string) (map[string][]byte, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.KVGetMulti(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API KVGetMulti called but not implemented."))
+	}
+	return nil
+}
+
+type Z_KVGetMetadataArgs struct {
+	A string
+}
+
+type Z_KVGetMetadataReturns struct {
+	A *model.PluginKVMetadata
+	B *model.AppError
+}
+
+func (g *apiRPCClient) KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError) {
+	_args := &Z_KVGetMetadataArgs{key}
+	_returns := &Z_KVGetMetadataReturns{}
+	if err := g.client.Call("Plugin.KVGetMetadata", _args, _returns); err != nil {
+		log.Printf("RPC call to KVGetMetadata API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) KVGetMetadata(args *Z_KVGetMetadataArgs, returns *Z_KVGetMetadataReturns) error {
+	if hook, ok := s.impl.(interface {
+		KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.KVGetMetadata(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API KVGetMetadata called but not implemented."))
+	}
+	return nil
+}
+
+type Z_KVBatchArgs struct {
+	A []*model.PluginKVBatchOperation
+}
+
+type Z_KVBatchReturns struct {
+	A bool
+	B *model.AppError
+}
+
+func (g *apiRPCClient) KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	_args := &Z_KVBatchArgs{ops}
+	_returns := &Z_KVBatchReturns{}
+	if err := g.client.Call("Plugin.KVBatch", _args, _returns); err != nil {
+		log.Printf("RPC call to KVBatch API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) KVBatch(args *Z_KVBatchArgs, returns *Z_KVBatchReturns) error {
+	if hook, ok := s.impl.(interface {
+		KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.KVBatch(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API KVBatch called but not implemented."))
+	}
+	return nil
+}
+
 type Z_PublishWebSocketEventArgs struct {
 	A string
 	B map[string]any
@@ -6657,3 +7401,203 @@ func (s *apiRPCServer) GetPluginID(args *Z_GetPluginIDArgs, returns *Z_GetPlugin
 	}
 	return nil
 }
+
+type Z_GetPluginMigrationsArgs struct {
+}
+
+type Z_GetPluginMigrationsReturns struct {
+	A []*model.PluginMigration
+	B *model.AppError
+}
+
+func (g *apiRPCClient) GetPluginMigrations() ([]*model.PluginMigration, *model.AppError) {
+	_args := &Z_GetPluginMigrationsArgs{}
+	_returns := &Z_GetPluginMigrationsReturns{}
+	if err := g.client.Call("Plugin.GetPluginMigrations", _args, _returns); err != nil {
+		log.Printf("RPC call to GetPluginMigrations API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) GetPluginMigrations(args *Z_GetPluginMigrationsArgs, returns *Z_GetPluginMigrationsReturns) error {
+	if hook, ok := s.impl.(interface {
+		GetPluginMigrations() ([]*model.PluginMigration, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.GetPluginMigrations()
+	} else {
+		return encodableError(fmt.Errorf("API GetPluginMigrations called but not implemented."))
+	}
+	return nil
+}
+
+type Z_ApplyPluginMigrationArgs struct {
+	A *model.PluginMigration
+}
+
+type Z_ApplyPluginMigrationReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) ApplyPluginMigration(migration *model.PluginMigration) *model.AppError {
+	_args := &Z_ApplyPluginMigrationArgs{migration}
+	_returns := &Z_ApplyPluginMigrationReturns{}
+	if err := g.client.Call("Plugin.ApplyPluginMigration", _args, _returns); err != nil {
+		log.Printf("RPC call to ApplyPluginMigration API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) ApplyPluginMigration(args *Z_ApplyPluginMigrationArgs, returns *Z_ApplyPluginMigrationReturns) error {
+	if hook, ok := s.impl.(interface {
+		ApplyPluginMigration(migration *model.PluginMigration) *model.AppError
+	}); ok {
+		returns.A = hook.ApplyPluginMigration(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API ApplyPluginMigration called but not implemented."))
+	}
+	return nil
+}
+
+type Z_RegisterPluginJobArgs struct {
+	A *model.PluginJobDefinition
+}
+
+type Z_RegisterPluginJobReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError {
+	_args := &Z_RegisterPluginJobArgs{definition}
+	_returns := &Z_RegisterPluginJobReturns{}
+	if err := g.client.Call("Plugin.RegisterPluginJob", _args, _returns); err != nil {
+		log.Printf("RPC call to RegisterPluginJob API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) RegisterPluginJob(args *Z_RegisterPluginJobArgs, returns *Z_RegisterPluginJobReturns) error {
+	if hook, ok := s.impl.(interface {
+		RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError
+	}); ok {
+		returns.A = hook.RegisterPluginJob(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API RegisterPluginJob called but not implemented."))
+	}
+	return nil
+}
+
+type Z_UnregisterPluginJobArgs struct {
+	A string
+}
+
+type Z_UnregisterPluginJobReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) UnregisterPluginJob(name string) *model.AppError {
+	_args := &Z_UnregisterPluginJobArgs{name}
+	_returns := &Z_UnregisterPluginJobReturns{}
+	if err := g.client.Call("Plugin.UnregisterPluginJob", _args, _returns); err != nil {
+		log.Printf("RPC call to UnregisterPluginJob API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) UnregisterPluginJob(args *Z_UnregisterPluginJobArgs, returns *Z_UnregisterPluginJobReturns) error {
+	if hook, ok := s.impl.(interface {
+		UnregisterPluginJob(name string) *model.AppError
+	}); ok {
+		returns.A = hook.UnregisterPluginJob(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API UnregisterPluginJob called but not implemented."))
+	}
+	return nil
+}
+
+type Z_CreatePluginJobArgs struct {
+	A string
+	B map[string]string
+}
+
+type Z_CreatePluginJobReturns struct {
+	A *model.Job
+	B *model.AppError
+}
+
+func (g *apiRPCClient) CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError) {
+	_args := &Z_CreatePluginJobArgs{name, data}
+	_returns := &Z_CreatePluginJobReturns{}
+	if err := g.client.Call("Plugin.CreatePluginJob", _args, _returns); err != nil {
+		log.Printf("RPC call to CreatePluginJob API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) CreatePluginJob(args *Z_CreatePluginJobArgs, returns *Z_CreatePluginJobReturns) error {
+	if hook, ok := s.impl.(interface {
+		CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.CreatePluginJob(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("API CreatePluginJob called but not implemented."))
+	}
+	return nil
+}
+
+type Z_GetPluginJobArgs struct {
+	A string
+}
+
+type Z_GetPluginJobReturns struct {
+	A *model.Job
+	B *model.AppError
+}
+
+func (g *apiRPCClient) GetPluginJob(jobID string) (*model.Job, *model.AppError) {
+	_args := &Z_GetPluginJobArgs{jobID}
+	_returns := &Z_GetPluginJobReturns{}
+	if err := g.client.Call("Plugin.GetPluginJob", _args, _returns); err != nil {
+		log.Printf("RPC call to GetPluginJob API failed: %s", err.Error())
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *apiRPCServer) GetPluginJob(args *Z_GetPluginJobArgs, returns *Z_GetPluginJobReturns) error {
+	if hook, ok := s.impl.(interface {
+		GetPluginJob(jobID string) (*model.Job, *model.AppError)
+	}); ok {
+		returns.A, returns.B = hook.GetPluginJob(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API GetPluginJob called but not implemented."))
+	}
+	return nil
+}
+
+type Z_SetPluginJobProgressArgs struct {
+	A string
+	B int64
+}
+
+type Z_SetPluginJobProgressReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) SetPluginJobProgress(jobID string, progress int64) *model.AppError {
+	_args := &Z_SetPluginJobProgressArgs{jobID, progress}
+	_returns := &Z_SetPluginJobProgressReturns{}
+	if err := g.client.Call("Plugin.SetPluginJobProgress", _args, _returns); err != nil {
+		log.Printf("RPC call to SetPluginJobProgress API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) SetPluginJobProgress(args *Z_SetPluginJobProgressArgs, returns *Z_SetPluginJobProgressReturns) error {
+	if hook, ok := s.impl.(interface {
+		SetPluginJobProgress(jobID string, progress int64) *model.AppError
+	}); ok {
+		returns.A = hook.SetPluginJobProgress(args.A, args.B)
+	} else {
+		return encodableError(fmt.Errorf("API SetPluginJobProgress called but not implemented."))
+	}
+	return nil
+}
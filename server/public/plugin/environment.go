@@ -33,7 +33,16 @@ type registeredPlugin struct {
 	State      int
 	Error      string
 
-	supervisor *supervisor
+	supervisor pluginSupervisor
+}
+
+// pluginSupervisor runs the server component of a plugin, either as a separate process or as an
+// in-process WebAssembly module.
+type pluginSupervisor interface {
+	Hooks() Hooks
+	Implements(hookId int) bool
+	PerformHealthCheck() error
+	Shutdown()
 }
 
 // PrepackagedPlugin is a plugin prepackaged with the server and found on startup.
@@ -60,6 +69,13 @@ type Environment struct {
 	prepackagedPlugins               []*PrepackagedPlugin
 	transitionallyPrepackagedPlugins []*PrepackagedPlugin
 	prepackagedPluginsLock           sync.RWMutex
+	hookBudget                       HookBudget
+	hookBudgetLock                   sync.RWMutex
+	wasmLimits                       WasmLimits
+	wasmLimitsLock                   sync.RWMutex
+	logObserver                      LogObserver
+	logObserverLock                  sync.RWMutex
+	hookGuards                       sync.Map
 }
 
 func NewEnvironment(
@@ -198,7 +214,7 @@ func (env *Environment) setPluginState(id string, state int) {
 }
 
 // setPluginSupervisor records the supervisor for a registered plugin.
-func (env *Environment) setPluginSupervisor(id string, supervisor *supervisor) {
+func (env *Environment) setPluginSupervisor(id string, supervisor pluginSupervisor) {
 	if rp, ok := env.registeredPlugins.Load(id); ok {
 		p := rp.(registeredPlugin)
 		p.supervisor = supervisor
@@ -241,6 +257,17 @@ func (env *Environment) Statuses() (model.PluginStatuses, error) {
 			Version:     plugin.Manifest.Version,
 		}
 
+		if bypassed, until, hookErr := env.getHookGuard(plugin.Manifest.Id).bypassed(); bypassed {
+			status.HooksBypassedUntil = until.UnixMilli()
+			status.HookError = hookErr
+		}
+
+		for _, dependency := range plugin.Manifest.Dependencies {
+			if env.checkDependency(dependency, plugins) != nil {
+				status.UnmetDependencies = append(status.UnmetDependencies, dependency)
+			}
+		}
+
 		pluginStatuses = append(pluginStatuses, status)
 	}
 
@@ -280,9 +307,56 @@ func checkMinServerVersion(pluginInfo *model.BundleInfo) error {
 	return nil
 }
 
+// checkDependencies returns an error describing the first dependency of the plugin that isn't
+// installed at a matching version and active.
+func (env *Environment) checkDependencies(pluginInfo *model.BundleInfo, plugins []*model.BundleInfo) error {
+	for _, dependency := range pluginInfo.Manifest.Dependencies {
+		if err := env.checkDependency(dependency, plugins); err != nil {
+			return errors.Wrapf(err, "unmet dependency: %v", pluginInfo.Manifest.Id)
+		}
+	}
+
+	return nil
+}
+
+func (env *Environment) checkDependency(dependency *model.PluginDependency, plugins []*model.BundleInfo) error {
+	for _, p := range plugins {
+		if p.Manifest == nil || p.Manifest.Id != dependency.Id {
+			continue
+		}
+
+		if !dependency.IsSatisfiedBy(p.Manifest) {
+			return fmt.Errorf("plugin requires %v version %v, found %v", dependency.Id, dependency.Version, p.Manifest.Version)
+		}
+		if !env.IsActive(dependency.Id) {
+			return fmt.Errorf("plugin requires %v to be active", dependency.Id)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("plugin requires %v to be installed", dependency.Id)
+}
+
 func (env *Environment) startPluginServer(pluginInfo *model.BundleInfo, opts ...func(*supervisor, *plugin.ClientConfig) error) error {
-	sup, err := newSupervisor(pluginInfo, env.newAPIImpl(pluginInfo.Manifest), env.dbDriver, env.logger, env.metrics, opts...)
+	guard := env.hookGuardFor(pluginInfo.Manifest.Id)
+
+	observe := env.getLogObserver()
+
+	var sup pluginSupervisor
+	var err error
+	if pluginInfo.Manifest.ServerRuntime() == model.PluginRuntimeWasm {
+		sup, err = newWasmSupervisor(pluginInfo, env.newAPIImpl(pluginInfo.Manifest), env.logger, env.metrics, guard, env.getWasmLimits(), observe)
+	} else {
+		opts = append(opts, withHookGuard(guard))
+		if observe != nil {
+			opts = append(opts, withLogObserver(observe))
+		}
+		sup, err = newSupervisor(pluginInfo, env.newAPIImpl(pluginInfo.Manifest), env.dbDriver, env.logger, env.metrics, opts...)
+	}
 	if err != nil {
+		if observe != nil {
+			observe(pluginInfo.Manifest.Id, model.PluginWatchLogLevelError, "Unable to start plugin", "error", err.Error())
+		}
 		return errors.Wrapf(err, "unable to start plugin: %v", pluginInfo.Manifest.Id)
 	}
 
@@ -351,6 +425,11 @@ func (env *Environment) Activate(id string) (manifest *model.Manifest, activated
 		return nil, false, err
 	}
 
+	err = env.checkDependencies(pluginInfo, plugins)
+	if err != nil {
+		return nil, false, err
+	}
+
 	componentActivated := false
 
 	if pluginInfo.Manifest.HasWebapp() {
@@ -425,6 +504,10 @@ func (env *Environment) Reattach(manifest *model.Manifest, pluginReattachConfig
 		return errors.New("cannot reattach plugin without server component")
 	}
 
+	if pluginInfo.Manifest.ServerRuntime() != model.PluginRuntimeProcess {
+		return errors.New("cannot reattach a WebAssembly plugin")
+	}
+
 	if pluginInfo.Manifest.HasWebapp() {
 		env.logger.Warn("Ignoring webapp for reattached plugin", mlog.String("plugin_id", id))
 	}
@@ -443,6 +526,7 @@ func (env *Environment) RemovePlugin(id string) {
 	if _, ok := env.registeredPlugins.Load(id); ok {
 		env.registeredPlugins.Delete(id)
 	}
+	env.hookGuards.Delete(id)
 }
 
 // Deactivates the plugin with the given id.
@@ -520,6 +604,11 @@ func (env *Environment) Shutdown() {
 	env.registeredPlugins.Range(func(key, value any) bool {
 		env.registeredPlugins.Delete(key)
 
+		return true
+	})
+	env.hookGuards.Range(func(key, value any) bool {
+		env.hookGuards.Delete(key)
+
 		return true
 	})
 }
@@ -581,13 +670,18 @@ func (env *Environment) UnpackWebappBundle(id string) (*model.Manifest, error) {
 	return manifest, nil
 }
 
-// HooksForPlugin returns the hooks API for the plugin with the given id.
+// HooksForPlugin returns the hooks API for the plugin with the given id. It returns an error
+// while the plugin's hooks are bypassed by the circuit breaker, failing the caller's request
+// rather than skipping the plugin.
 //
 // Consider using RunMultiPluginHook instead.
 func (env *Environment) HooksForPlugin(id string) (Hooks, error) {
 	if p, ok := env.registeredPlugins.Load(id); ok {
 		rp := p.(registeredPlugin)
 		if rp.supervisor != nil && env.IsActive(id) {
+			if bypassed, _, _ := env.getHookGuard(id).bypassed(); bypassed {
+				return nil, fmt.Errorf("%w: %v", errHooksBypassed, id)
+			}
 			return rp.supervisor.Hooks(), nil
 		}
 	}
@@ -596,6 +690,8 @@ func (env *Environment) HooksForPlugin(id string) (Hooks, error) {
 }
 
 // RunMultiPluginHook invokes hookRunnerFunc for each active plugin that implements the given hookId.
+// Plugins whose hooks are temporarily bypassed by the circuit breaker are skipped, except for
+// filtering hooks, which reject the post or file without calling the plugin.
 //
 // If hookRunnerFunc returns false, iteration will not continue. The iteration order among active
 // plugins is not specified.
@@ -609,8 +705,16 @@ func (env *Environment) RunMultiPluginHook(hookRunnerFunc func(hooks Hooks) bool
 			return true
 		}
 
+		hooks := rp.supervisor.Hooks()
+		if bypassed, _, _ := env.getHookGuard(rp.BundleInfo.Manifest.Id).bypassed(); bypassed {
+			if !filteringHooks[hookId] {
+				return true
+			}
+			hooks = bypassedHooks{Hooks: hooks}
+		}
+
 		hookStartTime := time.Now()
-		result := hookRunnerFunc(rp.supervisor.Hooks())
+		result := hookRunnerFunc(hooks)
 
 		if env.metrics != nil {
 			elapsedTime := float64(time.Since(hookStartTime)) / float64(time.Second)
@@ -654,6 +758,68 @@ func newRegisteredPlugin(bundle *model.BundleInfo) registeredPlugin {
 	return registeredPlugin{State: state, BundleInfo: bundle}
 }
 
+// SetHookBudget configures the hook execution timeouts and circuit breaker for all plugins. It
+// takes effect immediately, including for plugins that are already running.
+func (env *Environment) SetHookBudget(budget HookBudget) {
+	env.hookBudgetLock.Lock()
+	defer env.hookBudgetLock.Unlock()
+	env.hookBudget = budget
+}
+
+func (env *Environment) getHookBudget() HookBudget {
+	env.hookBudgetLock.RLock()
+	defer env.hookBudgetLock.RUnlock()
+	return env.hookBudget
+}
+
+// SetWasmLimits configures the resources available to WebAssembly plugins. It takes effect the
+// next time each plugin is activated.
+func (env *Environment) SetWasmLimits(limits WasmLimits) {
+	env.wasmLimitsLock.Lock()
+	defer env.wasmLimitsLock.Unlock()
+	env.wasmLimits = limits
+}
+
+func (env *Environment) getWasmLimits() WasmLimits {
+	env.wasmLimitsLock.RLock()
+	defer env.wasmLimitsLock.RUnlock()
+	return env.wasmLimits
+}
+
+// SetLogObserver sets an observer for the output of every plugin's server component, in addition
+// to the server log. It takes effect the next time each plugin is activated.
+func (env *Environment) SetLogObserver(observe LogObserver) {
+	env.logObserverLock.Lock()
+	defer env.logObserverLock.Unlock()
+	env.logObserver = observe
+}
+
+func (env *Environment) getLogObserver() LogObserver {
+	env.logObserverLock.RLock()
+	defer env.logObserverLock.RUnlock()
+	return env.logObserver
+}
+
+// ResetHookCircuitBreaker stops bypassing the hooks of the plugin with the given id and clears
+// its recorded failures.
+func (env *Environment) ResetHookCircuitBreaker(id string) {
+	if guard := env.getHookGuard(id); guard != nil {
+		guard.reset()
+	}
+}
+
+func (env *Environment) getHookGuard(id string) *hookGuard {
+	if guard, ok := env.hookGuards.Load(id); ok {
+		return guard.(*hookGuard)
+	}
+	return nil
+}
+
+func (env *Environment) hookGuardFor(id string) *hookGuard {
+	guard, _ := env.hookGuards.LoadOrStore(id, newHookGuard(id, env.getHookBudget, env.metrics, env.logger))
+	return guard.(*hookGuard)
+}
+
 // TogglePluginHealthCheckJob starts a new job if one is not running and is set to enabled, or kills an existing one if set to disabled.
 func (env *Environment) TogglePluginHealthCheckJob(enable bool) {
 	// Config is set to enable. No job exists, start a new job.
@@ -74,3 +74,64 @@ func TestAvailablePlugins(t *testing.T) {
 		require.Len(t, bundles, 0)
 	})
 }
+
+func TestPluginDependencies(t *testing.T) {
+	dir := t.TempDir()
+	env := Environment{
+		pluginDir: dir,
+		logger:    mlog.CreateConsoleTestLogger(t),
+	}
+
+	writeManifest := func(t *testing.T, manifest *model.Manifest) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, manifest.Id), 0700))
+		manifestJSON, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, manifest.Id, "plugin.json"), manifestJSON, 0644))
+	}
+
+	writeManifest(t, &model.Manifest{
+		Id:      "calendar",
+		Version: "1.0.0",
+		Dependencies: []*model.PluginDependency{
+			{Id: "botframework", Version: ">=2.0.0"},
+			{Id: "missing"},
+		},
+	})
+	writeManifest(t, &model.Manifest{Id: "botframework", Version: "1.5.0"})
+
+	t.Run("statuses report unmet dependencies", func(t *testing.T) {
+		statuses, err := env.Statuses()
+		require.NoError(t, err)
+
+		for _, status := range statuses {
+			switch status.PluginId {
+			case "calendar":
+				require.Len(t, status.UnmetDependencies, 2)
+				require.Equal(t, "botframework", status.UnmetDependencies[0].Id)
+				require.Equal(t, "missing", status.UnmetDependencies[1].Id)
+			case "botframework":
+				require.Empty(t, status.UnmetDependencies)
+			}
+		}
+	})
+
+	t.Run("plugins with unmet dependencies fail to activate", func(t *testing.T) {
+		_, activated, err := env.Activate("calendar")
+		require.ErrorContains(t, err, "unmet dependency")
+		require.ErrorContains(t, err, "plugin requires botframework version >=2.0.0, found 1.5.0")
+		require.False(t, activated)
+		require.Equal(t, model.PluginStateFailedToStart, env.GetPluginState("calendar"))
+	})
+
+	t.Run("inactive dependencies are unmet", func(t *testing.T) {
+		plugins, err := env.Available()
+		require.NoError(t, err)
+
+		err = env.checkDependency(&model.PluginDependency{Id: "botframework", Version: "1.x"}, plugins)
+		require.EqualError(t, err, "plugin requires botframework to be active")
+
+		err = env.checkDependency(&model.PluginDependency{Id: "missing"}, plugins)
+		require.EqualError(t, err, "plugin requires missing to be installed")
+	})
+}
@@ -11,12 +11,17 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 type hclogAdapter struct {
 	wrappedLogger *mlog.Logger
 	extrasKey     string
+
+	// observe, if set, also receives every message, along with the id of the plugin.
+	observe  LogObserver
+	pluginID string
 }
 
 func (h *hclogAdapter) Log(level hclog.Level, msg string, args ...any) {
@@ -39,6 +44,7 @@ func (h *hclogAdapter) Log(level hclog.Level, msg string, args ...any) {
 
 func (h *hclogAdapter) Trace(msg string, args ...any) {
 	extras := strings.TrimSpace(fmt.Sprint(args...))
+	h.notify(model.PluginWatchLogLevelDebug, msg, extras)
 	if extras != "" {
 		h.wrappedLogger.Debug(msg, mlog.String(h.extrasKey, extras))
 	} else {
@@ -48,6 +54,7 @@ func (h *hclogAdapter) Trace(msg string, args ...any) {
 
 func (h *hclogAdapter) Debug(msg string, args ...any) {
 	extras := strings.TrimSpace(fmt.Sprint(args...))
+	h.notify(model.PluginWatchLogLevelDebug, msg, extras)
 	if extras != "" {
 		h.wrappedLogger.Debug(msg, mlog.String(h.extrasKey, extras))
 	} else {
@@ -57,6 +64,7 @@ func (h *hclogAdapter) Debug(msg string, args ...any) {
 
 func (h *hclogAdapter) Info(msg string, args ...any) {
 	extras := strings.TrimSpace(fmt.Sprint(args...))
+	h.notify(model.PluginWatchLogLevelInfo, msg, extras)
 	if extras != "" {
 		h.wrappedLogger.Info(msg, mlog.String(h.extrasKey, extras))
 	} else {
@@ -66,6 +74,7 @@ func (h *hclogAdapter) Info(msg string, args ...any) {
 
 func (h *hclogAdapter) Warn(msg string, args ...any) {
 	extras := strings.TrimSpace(fmt.Sprint(args...))
+	h.notify(model.PluginWatchLogLevelWarn, msg, extras)
 	if extras != "" {
 		h.wrappedLogger.Warn(msg, mlog.String(h.extrasKey, extras))
 	} else {
@@ -75,6 +84,7 @@ func (h *hclogAdapter) Warn(msg string, args ...any) {
 
 func (h *hclogAdapter) Error(msg string, args ...any) {
 	extras := strings.TrimSpace(fmt.Sprint(args...))
+	h.notify(model.PluginWatchLogLevelError, msg, extras)
 	if extras != "" {
 		h.wrappedLogger.Error(msg, mlog.String(h.extrasKey, extras))
 	} else {
@@ -82,6 +92,18 @@ func (h *hclogAdapter) Error(msg string, args ...any) {
 	}
 }
 
+func (h *hclogAdapter) notify(level, msg, extras string) {
+	if h.observe == nil {
+		return
+	}
+
+	if extras != "" {
+		h.observe(h.pluginID, level, msg, h.extrasKey, extras)
+	} else {
+		h.observe(h.pluginID, level, msg)
+	}
+}
+
 func (h *hclogAdapter) IsTrace() bool {
 	return false
 }
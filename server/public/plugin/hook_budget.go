@@ -0,0 +1,203 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// errHookTimeout is returned by the hooks RPC client when a hook exceeds its execution budget.
+var errHookTimeout = errors.New("plugin hook timed out")
+
+// errHooksBypassed is the reason content is rejected by a filtering hook of a plugin whose hooks
+// are bypassed by the circuit breaker.
+var errHooksBypassed = errors.New("plugin hooks temporarily bypassed after repeated failures")
+
+// messageHooks intercept posts inline with the request that created, updated or fetched them,
+// so they are held to the tighter HookBudget.MessageTimeout. Posts created or updated while
+// MessageWillBePosted or MessageWillBeUpdated times out are rejected.
+var messageHooks = map[string]bool{
+	"MessageWillBePosted":    true,
+	"MessageWillBeUpdated":   true,
+	"MessagesWillBeConsumed": true,
+}
+
+// filteringHooks let plugins reject posts and files. While a plugin's hooks are bypassed, these
+// reject the content instead of skipping the plugin, so that a plugin enforcing a policy can't
+// be bypassed by making it time out.
+var filteringHooks = map[int]bool{
+	MessageWillBePostedID:  true,
+	MessageWillBeUpdatedID: true,
+	FileWillBeUploadedID:   true,
+}
+
+// unboundedHooks are expected to run for a long time, or already have a deadline enforced by
+// the environment, and are never subject to a timeout.
+var unboundedHooks = map[string]bool{
+	"OnDeactivate":        true,
+	"OnInstall":           true,
+	"RunDataRetention":    true,
+	"GenerateSupportData": true,
+	"RunPluginJob":        true,
+}
+
+// HookBudget bounds how long the server waits on plugin hooks, and how many consecutive
+// failures it tolerates before temporarily bypassing a plugin.
+type HookBudget struct {
+	// DefaultTimeout applies to every hook without a more specific timeout. Zero disables it.
+	DefaultTimeout time.Duration
+	// MessageTimeout applies to MessageWillBePosted, MessageWillBeUpdated and
+	// MessagesWillBeConsumed. Zero falls back to DefaultTimeout.
+	MessageTimeout time.Duration
+	// FailureThreshold is the number of consecutive timed out or crashed hook calls after which
+	// the plugin's hooks are bypassed. Zero disables the circuit breaker.
+	FailureThreshold int
+	// Cooldown is how long a plugin's hooks are bypassed once the circuit breaker has tripped.
+	Cooldown time.Duration
+}
+
+// TimeoutFor returns the execution budget for the given hook, or zero if it is unbounded.
+func (b HookBudget) TimeoutFor(hookName string) time.Duration {
+	if unboundedHooks[hookName] {
+		return 0
+	}
+	if messageHooks[hookName] && b.MessageTimeout > 0 {
+		return b.MessageTimeout
+	}
+	return b.DefaultTimeout
+}
+
+// hookGuard enforces the hook budget for a single plugin and tracks its circuit breaker.
+//
+// A guard outlives the plugin's supervisor so that a plugin which keeps crashing and being
+// restarted by the health check job is still bypassed.
+type hookGuard struct {
+	pluginID string
+	budget   func() HookBudget
+	metrics  metricsInterface
+	logger   *mlog.Logger
+
+	mut       sync.Mutex
+	failures  int
+	openUntil time.Time
+	lastError string
+}
+
+func newHookGuard(pluginID string, budget func() HookBudget, metrics metricsInterface, logger *mlog.Logger) *hookGuard {
+	return &hookGuard{
+		pluginID: pluginID,
+		budget:   budget,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+func (g *hookGuard) timeoutFor(hookName string) time.Duration {
+	if g == nil {
+		return 0
+	}
+	return g.budget().TimeoutFor(hookName)
+}
+
+// bypassed reports whether the circuit breaker is open, returning when it closes again and the
+// failure that tripped it.
+func (g *hookGuard) bypassed() (bool, time.Time, string) {
+	if g == nil {
+		return false, time.Time{}, ""
+	}
+
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if g.openUntil.IsZero() || !time.Now().Before(g.openUntil) {
+		return false, time.Time{}, ""
+	}
+	return true, g.openUntil, g.lastError
+}
+
+// recordResult updates the circuit breaker with the outcome of a hook call. Errors returned by
+// the plugin itself don't count against it; only timeouts and broken connections, which is how
+// a panicking plugin surfaces, do.
+func (g *hookGuard) recordResult(hookName string, err error) {
+	if g == nil {
+		return
+	}
+
+	var serverErr rpc.ServerError
+	if err == nil || errors.As(err, &serverErr) {
+		g.mut.Lock()
+		g.failures = 0
+		g.mut.Unlock()
+		return
+	}
+
+	if errors.Is(err, errHookTimeout) && g.metrics != nil {
+		g.metrics.IncrementPluginHookTimeoutCounter(g.pluginID, hookName)
+	}
+
+	budget := g.budget()
+	if budget.FailureThreshold <= 0 {
+		return
+	}
+
+	g.mut.Lock()
+	g.failures++
+	tripped := g.failures >= budget.FailureThreshold
+	if tripped {
+		g.openUntil = time.Now().Add(budget.Cooldown)
+		g.lastError = fmt.Sprintf("%s: %s", hookName, err.Error())
+		// Leave the breaker half-open once the cooldown ends so a single further failure trips it again.
+		g.failures = budget.FailureThreshold - 1
+	}
+	g.mut.Unlock()
+
+	if !tripped {
+		return
+	}
+
+	g.logger.Error("Plugin hooks temporarily bypassed after repeated failures",
+		mlog.String("plugin_id", g.pluginID),
+		mlog.String("hook_name", hookName),
+		mlog.Int("failure_threshold", budget.FailureThreshold),
+		mlog.Duration("cooldown", budget.Cooldown),
+		mlog.Err(err),
+	)
+	if g.metrics != nil {
+		g.metrics.IncrementPluginCircuitBreakerTripCounter(g.pluginID)
+	}
+}
+
+// reset closes the circuit breaker and forgets any recorded failures.
+func (g *hookGuard) reset() {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	g.failures = 0
+	g.openUntil = time.Time{}
+	g.lastError = ""
+}
+
+// bypassedHooks stands in for the hooks of a plugin bypassed by the circuit breaker when running
+// its filtering hooks, rejecting the content without calling the plugin.
+type bypassedHooks struct {
+	Hooks
+}
+
+func (bypassedHooks) MessageWillBePosted(c *Context, post *model.Post) (*model.Post, string) {
+	return nil, errHooksBypassed.Error()
+}
+
+func (bypassedHooks) MessageWillBeUpdated(c *Context, newPost, oldPost *model.Post) (*model.Post, string) {
+	return nil, errHooksBypassed.Error()
+}
+
+func (bypassedHooks) FileWillBeUploaded(c *Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
+	return nil, errHooksBypassed.Error()
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"errors"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/utils"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func TestHookBudgetTimeoutFor(t *testing.T) {
+	budget := HookBudget{
+		DefaultTimeout: 30 * time.Second,
+		MessageTimeout: 5 * time.Second,
+	}
+
+	assert.Equal(t, 30*time.Second, budget.TimeoutFor("ChannelHasBeenCreated"))
+	assert.Equal(t, 5*time.Second, budget.TimeoutFor("MessageWillBePosted"))
+	assert.Equal(t, 5*time.Second, budget.TimeoutFor("MessagesWillBeConsumed"))
+	assert.Zero(t, budget.TimeoutFor("OnDeactivate"))
+	assert.Zero(t, budget.TimeoutFor("RunDataRetention"))
+
+	budget.MessageTimeout = 0
+	assert.Equal(t, 30*time.Second, budget.TimeoutFor("MessageWillBePosted"))
+}
+
+func TestHookGuard(t *testing.T) {
+	logger := mlog.CreateConsoleTestLogger(t)
+	budget := HookBudget{FailureThreshold: 3, Cooldown: time.Minute}
+	newGuard := func() *hookGuard {
+		return newHookGuard("someid", func() HookBudget { return budget }, nil, logger)
+	}
+
+	t.Run("trips after consecutive failures", func(t *testing.T) {
+		guard := newGuard()
+		for i := 0; i < 2; i++ {
+			guard.recordResult("MessageWillBePosted", errHookTimeout)
+		}
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+
+		guard.recordResult("MessageWillBePosted", errHookTimeout)
+		bypassed, until, hookErr := guard.bypassed()
+		assert.True(t, bypassed)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), until, 5*time.Second)
+		assert.Contains(t, hookErr, "MessageWillBePosted")
+	})
+
+	t.Run("success resets the failure count", func(t *testing.T) {
+		guard := newGuard()
+		guard.recordResult("MessageWillBePosted", rpc.ErrShutdown)
+		guard.recordResult("MessageWillBePosted", rpc.ErrShutdown)
+		guard.recordResult("MessageWillBePosted", nil)
+		guard.recordResult("MessageWillBePosted", rpc.ErrShutdown)
+		guard.recordResult("MessageWillBePosted", rpc.ErrShutdown)
+
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+	})
+
+	t.Run("errors returned by the plugin don't count", func(t *testing.T) {
+		guard := newGuard()
+		for i := 0; i < 5; i++ {
+			guard.recordResult("ExecuteCommand", rpc.ServerError("hook ExecuteCommand called but not implemented"))
+		}
+
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+	})
+
+	t.Run("half-open after cooldown", func(t *testing.T) {
+		guard := newGuard()
+		for i := 0; i < 3; i++ {
+			guard.recordResult("MessageWillBePosted", errHookTimeout)
+		}
+
+		guard.mut.Lock()
+		guard.openUntil = time.Now().Add(-time.Second)
+		guard.mut.Unlock()
+
+		bypassed, _, _ := guard.bypassed()
+		require.False(t, bypassed)
+
+		guard.recordResult("MessageWillBePosted", errHookTimeout)
+		bypassed, _, _ = guard.bypassed()
+		assert.True(t, bypassed)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		guard := newGuard()
+		for i := 0; i < 3; i++ {
+			guard.recordResult("MessageWillBePosted", errHookTimeout)
+		}
+		guard.reset()
+
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+	})
+
+	t.Run("disabled circuit breaker", func(t *testing.T) {
+		guard := newHookGuard("someid", func() HookBudget { return HookBudget{} }, nil, logger)
+		for i := 0; i < 10; i++ {
+			guard.recordResult("MessageWillBePosted", errors.New("connection reset"))
+		}
+
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+	})
+
+	t.Run("nil guard", func(t *testing.T) {
+		var guard *hookGuard
+		assert.Zero(t, guard.timeoutFor("MessageWillBePosted"))
+		guard.recordResult("MessageWillBePosted", errHookTimeout)
+		bypassed, _, _ := guard.bypassed()
+		assert.False(t, bypassed)
+	})
+}
+
+func TestEnvironmentHookTimeout(t *testing.T) {
+	pluginDir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(pluginDir)
+	webappPluginDir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(webappPluginDir)
+
+	pluginID := "slowplugin"
+	utils.CompileGo(t, `
+		package main
+
+		import (
+			"time"
+
+			"github.com/mattermost/mattermost/server/public/model"
+			"github.com/mattermost/mattermost/server/public/plugin"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) MessageWillBePosted(c *plugin.Context, post *model.Post) (*model.Post, string) {
+			time.Sleep(10 * time.Second)
+			post.Message = "too late"
+			return post, ""
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+	`, filepath.Join(pluginDir, pluginID, "backend.exe"))
+	err = os.WriteFile(filepath.Join(pluginDir, pluginID, "plugin.json"), []byte(`{"id": "`+pluginID+`", "server": {"executable": "backend.exe"}}`), 0600)
+	require.NoError(t, err)
+
+	logger := mlog.CreateConsoleTestLogger(t)
+	env, err := NewEnvironment(func(*model.Manifest) API { return nil }, nil, pluginDir, webappPluginDir, logger, nil)
+	require.NoError(t, err)
+	defer env.Shutdown()
+
+	env.SetHookBudget(HookBudget{
+		DefaultTimeout:   time.Second,
+		MessageTimeout:   100 * time.Millisecond,
+		FailureThreshold: 2,
+		Cooldown:         time.Minute,
+	})
+
+	_, activated, err := env.Activate(pluginID)
+	require.NoError(t, err)
+	require.True(t, activated)
+
+	runHook := func() (bool, *model.Post, string) {
+		post := &model.Post{Message: "message"}
+		var rejectionReason string
+		called := false
+		env.RunMultiPluginHook(func(hooks Hooks) bool {
+			called = true
+			post, rejectionReason = hooks.MessageWillBePosted(&Context{}, post)
+			return true
+		}, MessageWillBePostedID)
+		return called, post, rejectionReason
+	}
+
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		called, post, rejectionReason := runHook()
+		require.True(t, called)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Nil(t, post, "posts must be rejected when the hook times out")
+		assert.NotEmpty(t, rejectionReason)
+	}
+
+	start := time.Now()
+	called, post, rejectionReason := runHook()
+	require.True(t, called)
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "bypassed plugins must not be called")
+	assert.Nil(t, post, "posts must be rejected while the plugin is bypassed")
+	assert.Equal(t, errHooksBypassed.Error(), rejectionReason)
+
+	_, err = env.HooksForPlugin(pluginID)
+	assert.Error(t, err)
+
+	statuses, err := env.Statuses()
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.NotZero(t, statuses[0].HooksBypassedUntil)
+	assert.Contains(t, statuses[0].HookError, "MessageWillBePosted")
+
+	env.ResetHookCircuitBreaker(pluginID)
+	_, err = env.HooksForPlugin(pluginID)
+	assert.NoError(t, err)
+}
@@ -61,6 +61,24 @@ const (
 	OnSharedChannelsAttachmentSyncMsgID       = 43
 	OnSharedChannelsProfileImageSyncMsgID     = 44
 	GenerateSupportDataID                     = 45
+	ChannelHasBeenArchivedID                  = 46
+	ChannelHasBeenRestoredID                  = 47
+	ChannelHasBeenRenamedID                   = 48
+	ChannelHasBeenConvertedID                 = 49
+	ChannelMemberRolesHaveChangedID           = 50
+	TeamMemberRolesHaveChangedID              = 51
+	PostHasBeenPinnedID                       = 52
+	PostHasBeenUnpinnedID                     = 53
+	PostHasBeenAcknowledgedID                 = 54
+	ChannelBookmarkHasBeenCreatedID           = 55
+	ChannelBookmarkHasBeenUpdatedID           = 56
+	ChannelBookmarkHasBeenDeletedID           = 57
+	TeamHasBeenCreatedID                      = 58
+	TeamHasBeenUpdatedID                      = 59
+	UserHasBeenUpdatedID                      = 60
+	UserCustomStatusHasChangedID              = 61
+	RunPluginJobID                            = 62
+	TranslateTextID                           = 63
 	TotalHooksID                              = iota
 )
 
@@ -389,4 +407,106 @@ type Hooks interface {
 	//
 	// Minimum server version: 9.8
 	GenerateSupportData(c *Context) ([]*model.FileData, error)
+
+	// ChannelHasBeenArchived is invoked after the channel has been archived.
+	// If actor is not nil, the channel was archived by the actor.
+	//
+	// Minimum server version: 10.3
+	ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User)
+
+	// ChannelHasBeenRestored is invoked after an archived channel has been restored.
+	// If actor is not nil, the channel was restored by the actor.
+	//
+	// Minimum server version: 10.3
+	ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User)
+
+	// ChannelHasBeenRenamed is invoked after the name or display name of a channel has changed.
+	//
+	// Minimum server version: 10.3
+	ChannelHasBeenRenamed(c *Context, newChannel, oldChannel *model.Channel)
+
+	// ChannelHasBeenConverted is invoked after the type of a channel has changed, e.g. when a public
+	// channel is converted to a private channel or a group message is converted to a private channel.
+	//
+	// Minimum server version: 10.3
+	ChannelHasBeenConverted(c *Context, newChannel, oldChannel *model.Channel)
+
+	// ChannelMemberRolesHaveChanged is invoked after the roles of a channel member have changed.
+	//
+	// Minimum server version: 10.3
+	ChannelMemberRolesHaveChanged(c *Context, newMember, oldMember *model.ChannelMember)
+
+	// TeamMemberRolesHaveChanged is invoked after the roles of a team member have changed.
+	//
+	// Minimum server version: 10.3
+	TeamMemberRolesHaveChanged(c *Context, newMember, oldMember *model.TeamMember)
+
+	// PostHasBeenPinned is invoked after a post has been pinned to its channel.
+	//
+	// Minimum server version: 10.3
+	PostHasBeenPinned(c *Context, post *model.Post)
+
+	// PostHasBeenUnpinned is invoked after a post has been unpinned from its channel.
+	//
+	// Minimum server version: 10.3
+	PostHasBeenUnpinned(c *Context, post *model.Post)
+
+	// PostHasBeenAcknowledged is invoked after a user has acknowledged a post.
+	//
+	// Minimum server version: 10.3
+	PostHasBeenAcknowledged(c *Context, acknowledgement *model.PostAcknowledgement)
+
+	// ChannelBookmarkHasBeenCreated is invoked after a channel bookmark has been committed to the database.
+	//
+	// Minimum server version: 10.3
+	ChannelBookmarkHasBeenCreated(c *Context, bookmark *model.ChannelBookmarkWithFileInfo)
+
+	// ChannelBookmarkHasBeenUpdated is invoked after a channel bookmark has been updated. Updating a
+	// bookmark may replace it with a new bookmark, in which case the ids of newBookmark and oldBookmark
+	// differ.
+	//
+	// Minimum server version: 10.3
+	ChannelBookmarkHasBeenUpdated(c *Context, newBookmark, oldBookmark *model.ChannelBookmarkWithFileInfo)
+
+	// ChannelBookmarkHasBeenDeleted is invoked after a channel bookmark has been deleted.
+	//
+	// Minimum server version: 10.3
+	ChannelBookmarkHasBeenDeleted(c *Context, bookmark *model.ChannelBookmarkWithFileInfo)
+
+	// TeamHasBeenCreated is invoked after the team has been committed to the database.
+	//
+	// Minimum server version: 10.3
+	TeamHasBeenCreated(c *Context, team *model.Team)
+
+	// TeamHasBeenUpdated is invoked after the team has been updated in the database.
+	//
+	// Minimum server version: 10.3
+	TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team)
+
+	// UserHasBeenUpdated is invoked after a user has been updated in the database, including changes
+	// to the user's profile and roles. Sensitive fields are sanitized on both users.
+	//
+	// Minimum server version: 10.3
+	UserHasBeenUpdated(c *Context, newUser, oldUser *model.User)
+
+	// UserCustomStatusHasChanged is invoked after a user has set or cleared their custom status.
+	// customStatus is nil if the custom status was cleared.
+	//
+	// Minimum server version: 10.3
+	UserCustomStatusHasChanged(c *Context, userID string, customStatus *model.CustomStatus)
+
+	// RunPluginJob is invoked to run a job of a type registered by the plugin with
+	// RegisterPluginJob, on the server that claimed the job. Returning an error marks the job as
+	// failed. Long running jobs should periodically check through GetPluginJob whether
+	// cancellation was requested, and return early if so. The hook isn't subject to a timeout.
+	//
+	// Minimum server version: 10.3
+	RunPluginJob(job *model.Job) error
+
+	// TranslateText is invoked to translate texts into the target locale when the plugin is the
+	// translation provider configured in TranslationSettings. The source language is empty if it
+	// is to be detected. The translations must be returned in the order of the texts.
+	//
+	// Minimum server version: 10.3
+	TranslateText(c *Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error)
 }
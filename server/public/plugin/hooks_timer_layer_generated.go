@@ -291,3 +291,113 @@ func (hooks *hooksTimerLayer) GenerateSupportData(c *Context) ([]*model.FileData
 	hooks.recordTime(startTime, "GenerateSupportData", _returnsB == nil)
 	return _returnsA, _returnsB
 }
+
+func (hooks *hooksTimerLayer) ChannelHasBeenArchived(c *Context, channel *model.Channel, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenArchived(c, channel, actor)
+	hooks.recordTime(startTime, "ChannelHasBeenArchived", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenRestored(c *Context, channel *model.Channel, actor *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenRestored(c, channel, actor)
+	hooks.recordTime(startTime, "ChannelHasBeenRestored", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenRenamed(c *Context, newChannel, oldChannel *model.Channel) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenRenamed(c, newChannel, oldChannel)
+	hooks.recordTime(startTime, "ChannelHasBeenRenamed", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelHasBeenConverted(c *Context, newChannel, oldChannel *model.Channel) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelHasBeenConverted(c, newChannel, oldChannel)
+	hooks.recordTime(startTime, "ChannelHasBeenConverted", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelMemberRolesHaveChanged(c *Context, newMember, oldMember *model.ChannelMember) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelMemberRolesHaveChanged(c, newMember, oldMember)
+	hooks.recordTime(startTime, "ChannelMemberRolesHaveChanged", true)
+}
+
+func (hooks *hooksTimerLayer) TeamMemberRolesHaveChanged(c *Context, newMember, oldMember *model.TeamMember) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.TeamMemberRolesHaveChanged(c, newMember, oldMember)
+	hooks.recordTime(startTime, "TeamMemberRolesHaveChanged", true)
+}
+
+func (hooks *hooksTimerLayer) PostHasBeenPinned(c *Context, post *model.Post) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.PostHasBeenPinned(c, post)
+	hooks.recordTime(startTime, "PostHasBeenPinned", true)
+}
+
+func (hooks *hooksTimerLayer) PostHasBeenUnpinned(c *Context, post *model.Post) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.PostHasBeenUnpinned(c, post)
+	hooks.recordTime(startTime, "PostHasBeenUnpinned", true)
+}
+
+func (hooks *hooksTimerLayer) PostHasBeenAcknowledged(c *Context, acknowledgement *model.PostAcknowledgement) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.PostHasBeenAcknowledged(c, acknowledgement)
+	hooks.recordTime(startTime, "PostHasBeenAcknowledged", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelBookmarkHasBeenCreated(c *Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelBookmarkHasBeenCreated(c, bookmark)
+	hooks.recordTime(startTime, "ChannelBookmarkHasBeenCreated", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelBookmarkHasBeenUpdated(c *Context, newBookmark, oldBookmark *model.ChannelBookmarkWithFileInfo) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelBookmarkHasBeenUpdated(c, newBookmark, oldBookmark)
+	hooks.recordTime(startTime, "ChannelBookmarkHasBeenUpdated", true)
+}
+
+func (hooks *hooksTimerLayer) ChannelBookmarkHasBeenDeleted(c *Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.ChannelBookmarkHasBeenDeleted(c, bookmark)
+	hooks.recordTime(startTime, "ChannelBookmarkHasBeenDeleted", true)
+}
+
+func (hooks *hooksTimerLayer) TeamHasBeenCreated(c *Context, team *model.Team) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.TeamHasBeenCreated(c, team)
+	hooks.recordTime(startTime, "TeamHasBeenCreated", true)
+}
+
+func (hooks *hooksTimerLayer) TeamHasBeenUpdated(c *Context, newTeam, oldTeam *model.Team) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.TeamHasBeenUpdated(c, newTeam, oldTeam)
+	hooks.recordTime(startTime, "TeamHasBeenUpdated", true)
+}
+
+func (hooks *hooksTimerLayer) UserHasBeenUpdated(c *Context, newUser, oldUser *model.User) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.UserHasBeenUpdated(c, newUser, oldUser)
+	hooks.recordTime(startTime, "UserHasBeenUpdated", true)
+}
+
+func (hooks *hooksTimerLayer) UserCustomStatusHasChanged(c *Context, userID string, customStatus *model.CustomStatus) {
+	startTime := timePkg.Now()
+	hooks.hooksImpl.UserCustomStatusHasChanged(c, userID, customStatus)
+	hooks.recordTime(startTime, "UserCustomStatusHasChanged", true)
+}
+
+func (hooks *hooksTimerLayer) RunPluginJob(job *model.Job) error {
+	startTime := timePkg.Now()
+	_returnsA := hooks.hooksImpl.RunPluginJob(job)
+	hooks.recordTime(startTime, "RunPluginJob", _returnsA == nil)
+	return _returnsA
+}
+
+func (hooks *hooksTimerLayer) TranslateText(c *Context, texts []string, sourceLanguage, targetLocale string) ([]*model.TextTranslation, error) {
+	startTime := timePkg.Now()
+	_returnsA, _returnsB := hooks.hooksImpl.TranslateText(c, texts, sourceLanguage, targetLocale)
+	hooks.recordTime(startTime, "TranslateText", _returnsB == nil)
+	return _returnsA, _returnsB
+}
@@ -181,6 +181,48 @@ func FieldListToRecordSuccess(structPrefix string, fieldList *ast.FieldList) str
 	return fmt.Sprintf("%s == nil", result)
 }
 
+// FieldListToDeniedReturns returns a return statement yielding errName for every error result
+// and the zero value for every other result.
+func FieldListToDeniedReturns(errName string, fieldList *ast.FieldList, fileset *token.FileSet) string {
+	if fieldList == nil || len(fieldList.List) == 0 {
+		return "return"
+	}
+
+	result := []string{}
+	for _, field := range fieldList.List {
+		typeNameBuffer := &bytes.Buffer{}
+		err := printer.Fprint(typeNameBuffer, fileset, field.Type)
+		if err != nil {
+			panic(err)
+		}
+		typeName := typeNameBuffer.String()
+
+		var value string
+		switch {
+		case typeName == "error" || typeName == "*model.AppError":
+			value = errName
+		case strings.HasPrefix(typeName, "*") || strings.HasPrefix(typeName, "[]") || strings.HasPrefix(typeName, "map["):
+			value = "nil"
+		case typeName == "bool":
+			value = "false"
+		case typeName == "string":
+			value = `""`
+		default:
+			value = "*new(" + typeName + ")"
+		}
+
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			result = append(result, value)
+		}
+	}
+
+	return "return " + strings.Join(result, ", ")
+}
+
 func FieldListToStructList(fieldList *ast.FieldList, fileset *token.FileSet) string {
 	result := []string{}
 	if fieldList == nil || len(fieldList.List) == 0 {
@@ -325,7 +367,7 @@ func (g *hooksRPCClient) {{.Name}}{{funcStyle .Params}} {{funcStyle .Return}} {
 	_args := &{{.Name | obscure}}Args{ {{valuesOnly .Params}} }
 	_returns := &{{.Name | obscure}}Returns{}
 	if g.implemented[{{.Name}}ID] {
-		if err := g.client.Call("Plugin.{{.Name}}", _args, _returns); err != nil {
+		if err := g.call("{{.Name}}", _args, _returns); err != nil {
 			g.log.Error("RPC call {{.Name}} to plugin failed.", mlog.Err(err))
 		}
 	}
@@ -460,6 +502,44 @@ func (hooks *hooksTimerLayer) {{.Name}}{{funcStyle .Params}} {{funcStyle .Return
 {{end}}
 `
 
+var apiCapabilityLayerTemplate = `// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Code generated by "make pluginapi"
+// DO NOT EDIT
+
+package plugin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+type apiCapabilityLayer struct {
+	apiImpl API
+	check   func(method string) *model.AppError
+}
+
+// NewAPICapabilityLayer wraps the given API so that every call is first authorized by check.
+// A rejected call returns the error from check, or zero values if the method returns no error.
+func NewAPICapabilityLayer(apiImpl API, check func(method string) *model.AppError) API {
+	return &apiCapabilityLayer{apiImpl: apiImpl, check: check}
+}
+
+{{range .APIMethods}}
+
+func (api *apiCapabilityLayer) {{.Name}}{{funcStyle .Params}} {{funcStyle .Return}} {
+	if _appErr := api.check("{{.Name}}"); _appErr != nil {
+		{{ denied "_appErr" .Return }}
+	}
+	{{ if .Return }}return {{ end }}api.apiImpl.{{.Name}}({{valuesOnly .Params}})
+}
+
+{{end}}
+`
+
 type MethodParams struct {
 	Name   string
 	Params *ast.FieldList
@@ -537,6 +617,9 @@ func generatePluginTimerLayer(info *PluginInterfaceInfo) {
 		"shouldRecordSuccess": func(structPrefix string, fields *ast.FieldList) string {
 			return FieldListToRecordSuccess(structPrefix, fields)
 		},
+		"denied": func(errName string, fields *ast.FieldList) string {
+			return FieldListToDeniedReturns(errName, fields, info.FileSet)
+		},
 	}
 
 	// Prepare template params
@@ -557,8 +640,9 @@ func generatePluginTimerLayer(info *PluginInterfaceInfo) {
 	}
 
 	pluginTemplates := map[string]string{
-		"api_timer_layer_generated.go":   apiTimerLayerTemplate,
-		"hooks_timer_layer_generated.go": hooksTimerLayerTemplate,
+		"api_timer_layer_generated.go":      apiTimerLayerTemplate,
+		"hooks_timer_layer_generated.go":    hooksTimerLayerTemplate,
+		"api_capability_layer_generated.go": apiCapabilityLayerTemplate,
 	}
 
 	for fileName, presetTemplate := range pluginTemplates {
@@ -637,7 +721,7 @@ func main() {
 	log.Println("Generating plugin hooks glue")
 	generateHooksGlue(removeExcluded(forRPC, excludedPluginHooks))
 
-	// Generate plugin timer layers
+	// Generate plugin timer and capability layers
 	log.Println("Generating plugin timer glue")
 	forPlugins, err := getPluginInfo(pluginPackageDir)
 	if err != nil {
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"io"
+	"strings"
+
+	plugin "github.com/hashicorp/go-plugin"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// LogObserver receives the output of a plugin's server component in addition to the server log:
+// whatever the plugin writes to stdout and stderr, and the messages logged while supervising it,
+// such as panics and startup failures. The level is one of the model.PluginWatchLogLevel values.
+type LogObserver func(pluginID, level, message string, keyValuePairs ...any)
+
+// observedWriter forwards each write to a LogObserver as a single message, the same way
+// mlog.Logger.StdLogWriter does for the server log.
+type observedWriter struct {
+	pluginID string
+	source   string
+	observe  LogObserver
+}
+
+func (w *observedWriter) Write(p []byte) (int, error) {
+	if message := strings.TrimSpace(string(p)); message != "" {
+		w.observe(w.pluginID, model.PluginWatchLogLevelInfo, message, "source", w.source)
+	}
+	return len(p), nil
+}
+
+// observeOutput returns writers for the plugin's stdout and stderr that also forward to the given
+// observer, if any.
+func observeOutput(pluginID string, stdout, stderr io.Writer, observe LogObserver) (io.Writer, io.Writer) {
+	if observe == nil {
+		return stdout, stderr
+	}
+
+	return io.MultiWriter(stdout, &observedWriter{pluginID: pluginID, source: "plugin_stdout", observe: observe}),
+		io.MultiWriter(stderr, &observedWriter{pluginID: pluginID, source: "plugin_stderr", observe: observe})
+}
+
+// withLogObserver forwards the plugin's output and the supervisor's log messages to the given
+// observer.
+func withLogObserver(observe LogObserver) func(*supervisor, *plugin.ClientConfig) error {
+	return func(sup *supervisor, clientConfig *plugin.ClientConfig) error {
+		clientConfig.SyncStdout, clientConfig.SyncStderr = observeOutput(sup.pluginID, clientConfig.SyncStdout, clientConfig.SyncStderr, observe)
+
+		if logger, ok := clientConfig.Logger.(*hclogAdapter); ok {
+			logger.observe = observe
+			logger.pluginID = sup.pluginID
+		}
+
+		return nil
+	}
+}
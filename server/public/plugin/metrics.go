@@ -8,4 +8,6 @@ type metricsInterface interface {
 	ObservePluginMultiHookIterationDuration(pluginID string, elapsed float64)
 	ObservePluginMultiHookDuration(elapsed float64)
 	ObservePluginAPIDuration(pluginID, apiName string, success bool, elapsed float64)
+	IncrementPluginHookTimeoutCounter(pluginID, hookName string)
+	IncrementPluginCircuitBreakerTripCounter(pluginID string)
 }
@@ -114,6 +114,26 @@ func (_m *API) AddUserToChannel(channelId string, userID string, asUserId string
 	return r0, r1
 }
 
+// ApplyPluginMigration provides a mock function with given fields: migration
+func (_m *API) ApplyPluginMigration(migration *model.PluginMigration) *model.AppError {
+	ret := _m.Called(migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPluginMigration")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(*model.PluginMigration) *model.AppError); ok {
+		r0 = rf(migration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // CopyFileInfos provides a mock function with given fields: userID, fileIds
 func (_m *API) CopyFileInfos(userID string, fileIds []string) ([]string, *model.AppError) {
 	ret := _m.Called(userID, fileIds)
@@ -304,6 +324,38 @@ func (_m *API) CreateOAuthApp(app *model.OAuthApp) (*model.OAuthApp, *model.AppE
 	return r0, r1
 }
 
+// CreatePluginJob provides a mock function with given fields: name, data
+func (_m *API) CreatePluginJob(name string, data map[string]string) (*model.Job, *model.AppError) {
+	ret := _m.Called(name, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePluginJob")
+	}
+
+	var r0 *model.Job
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func(string, map[string]string) (*model.Job, *model.AppError)); ok {
+		return rf(name, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[string]string) *model.Job); ok {
+		r0 = rf(name, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[string]string) *model.AppError); ok {
+		r1 = rf(name, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // CreatePost provides a mock function with given fields: post
 func (_m *API) CreatePost(post *model.Post) (*model.Post, *model.AppError) {
 	ret := _m.Called(post)
@@ -2008,6 +2060,70 @@ func (_m *API) GetPluginID() string {
 	return r0
 }
 
+// GetPluginJob provides a mock function with given fields: jobID
+func (_m *API) GetPluginJob(jobID string) (*model.Job, *model.AppError) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPluginJob")
+	}
+
+	var r0 *model.Job
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func(string) (*model.Job, *model.AppError)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Job); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
+		r1 = rf(jobID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPluginMigrations provides a mock function with given fields:
+func (_m *API) GetPluginMigrations() ([]*model.PluginMigration, *model.AppError) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPluginMigrations")
+	}
+
+	var r0 []*model.PluginMigration
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func() ([]*model.PluginMigration, *model.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.PluginMigration); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PluginMigration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() *model.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetPluginStatus provides a mock function with given fields: id
 func (_m *API) GetPluginStatus(id string) (*model.PluginStatus, *model.AppError) {
 	ret := _m.Called(id)
@@ -3332,6 +3448,36 @@ func (_m *API) IsEnterpriseReady() bool {
 	return r0
 }
 
+// KVBatch provides a mock function with given fields: ops
+func (_m *API) KVBatch(ops []*model.PluginKVBatchOperation) (bool, *model.AppError) {
+	ret := _m.Called(ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVBatch")
+	}
+
+	var r0 bool
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func([]*model.PluginKVBatchOperation) (bool, *model.AppError)); ok {
+		return rf(ops)
+	}
+	if rf, ok := ret.Get(0).(func([]*model.PluginKVBatchOperation) bool); ok {
+		r0 = rf(ops)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func([]*model.PluginKVBatchOperation) *model.AppError); ok {
+		r1 = rf(ops)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // KVCompareAndDelete provides a mock function with given fields: key, oldValue
 func (_m *API) KVCompareAndDelete(key string, oldValue []byte) (bool, *model.AppError) {
 	ret := _m.Called(key, oldValue)
@@ -3464,6 +3610,70 @@ func (_m *API) KVGet(key string) ([]byte, *model.AppError) {
 	return r0, r1
 }
 
+// KVGetMetadata provides a mock function with given fields: key
+func (_m *API) KVGetMetadata(key string) (*model.PluginKVMetadata, *model.AppError) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVGetMetadata")
+	}
+
+	var r0 *model.PluginKVMetadata
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func(string) (*model.PluginKVMetadata, *model.AppError)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PluginKVMetadata); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PluginKVMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *model.AppError); ok {
+		r1 = rf(key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// KVGetMulti provides a mock function with given fields: keys
+func (_m *API) KVGetMulti(keys []string) (map[string][]byte, *model.AppError) {
+	ret := _m.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVGetMulti")
+	}
+
+	var r0 map[string][]byte
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func([]string) (map[string][]byte, *model.AppError)); ok {
+		return rf(keys)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string][]byte); ok {
+		r0 = rf(keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) *model.AppError); ok {
+		r1 = rf(keys)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // KVList provides a mock function with given fields: page, perPage
 func (_m *API) KVList(page int, perPage int) ([]string, *model.AppError) {
 	ret := _m.Called(page, perPage)
@@ -3496,6 +3706,38 @@ func (_m *API) KVList(page int, perPage int) ([]string, *model.AppError) {
 	return r0, r1
 }
 
+// KVListWithOptions provides a mock function with given fields: options
+func (_m *API) KVListWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError) {
+	ret := _m.Called(options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KVListWithOptions")
+	}
+
+	var r0 *model.PluginKVListPage
+	var r1 *model.AppError
+	if rf, ok := ret.Get(0).(func(model.PluginKVListOptions) (*model.PluginKVListPage, *model.AppError)); ok {
+		return rf(options)
+	}
+	if rf, ok := ret.Get(0).(func(model.PluginKVListOptions) *model.PluginKVListPage); ok {
+		r0 = rf(options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PluginKVListPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.PluginKVListOptions) *model.AppError); ok {
+		r1 = rf(options)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // KVSet provides a mock function with given fields: key, value
 func (_m *API) KVSet(key string, value []byte) *model.AppError {
 	ret := _m.Called(key, value)
@@ -3987,6 +4229,26 @@ func (_m *API) RegisterPluginForSharedChannels(opts model.RegisterPluginOpts) (s
 	return r0, r1
 }
 
+// RegisterPluginJob provides a mock function with given fields: definition
+func (_m *API) RegisterPluginJob(definition *model.PluginJobDefinition) *model.AppError {
+	ret := _m.Called(definition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterPluginJob")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(*model.PluginJobDefinition) *model.AppError); ok {
+		r0 = rf(definition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // RemovePlugin provides a mock function with given fields: id
 func (_m *API) RemovePlugin(id string) *model.AppError {
 	ret := _m.Called(id)
@@ -4425,6 +4687,26 @@ func (_m *API) SetFileSearchableContent(fileID string, content string) *model.Ap
 	return r0
 }
 
+// SetPluginJobProgress provides a mock function with given fields: jobID, progress
+func (_m *API) SetPluginJobProgress(jobID string, progress int64) *model.AppError {
+	ret := _m.Called(jobID, progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPluginJobProgress")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string, int64) *model.AppError); ok {
+		r0 = rf(jobID, progress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // SetProfileImage provides a mock function with given fields: userID, data
 func (_m *API) SetProfileImage(userID string, data []byte) *model.AppError {
 	ret := _m.Called(userID, data)
@@ -4599,6 +4881,26 @@ func (_m *API) UnregisterPluginForSharedChannels(pluginID string) error {
 	return r0
 }
 
+// UnregisterPluginJob provides a mock function with given fields: name
+func (_m *API) UnregisterPluginJob(name string) *model.AppError {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnregisterPluginJob")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(string) *model.AppError); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UnshareChannel provides a mock function with given fields: channelID
 func (_m *API) UnshareChannel(channelID string) (bool, error) {
 	ret := _m.Called(channelID)
@@ -20,11 +20,51 @@ type Hooks struct {
 	mock.Mock
 }
 
+// ChannelBookmarkHasBeenCreated provides a mock function with given fields: c, bookmark
+func (_m *Hooks) ChannelBookmarkHasBeenCreated(c *plugin.Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	_m.Called(c, bookmark)
+}
+
+// ChannelBookmarkHasBeenDeleted provides a mock function with given fields: c, bookmark
+func (_m *Hooks) ChannelBookmarkHasBeenDeleted(c *plugin.Context, bookmark *model.ChannelBookmarkWithFileInfo) {
+	_m.Called(c, bookmark)
+}
+
+// ChannelBookmarkHasBeenUpdated provides a mock function with given fields: c, newBookmark, oldBookmark
+func (_m *Hooks) ChannelBookmarkHasBeenUpdated(c *plugin.Context, newBookmark *model.ChannelBookmarkWithFileInfo, oldBookmark *model.ChannelBookmarkWithFileInfo) {
+	_m.Called(c, newBookmark, oldBookmark)
+}
+
+// ChannelHasBeenArchived provides a mock function with given fields: c, channel, actor
+func (_m *Hooks) ChannelHasBeenArchived(c *plugin.Context, channel *model.Channel, actor *model.User) {
+	_m.Called(c, channel, actor)
+}
+
+// ChannelHasBeenConverted provides a mock function with given fields: c, newChannel, oldChannel
+func (_m *Hooks) ChannelHasBeenConverted(c *plugin.Context, newChannel *model.Channel, oldChannel *model.Channel) {
+	_m.Called(c, newChannel, oldChannel)
+}
+
 // ChannelHasBeenCreated provides a mock function with given fields: c, channel
 func (_m *Hooks) ChannelHasBeenCreated(c *plugin.Context, channel *model.Channel) {
 	_m.Called(c, channel)
 }
 
+// ChannelHasBeenRenamed provides a mock function with given fields: c, newChannel, oldChannel
+func (_m *Hooks) ChannelHasBeenRenamed(c *plugin.Context, newChannel *model.Channel, oldChannel *model.Channel) {
+	_m.Called(c, newChannel, oldChannel)
+}
+
+// ChannelHasBeenRestored provides a mock function with given fields: c, channel, actor
+func (_m *Hooks) ChannelHasBeenRestored(c *plugin.Context, channel *model.Channel, actor *model.User) {
+	_m.Called(c, channel, actor)
+}
+
+// ChannelMemberRolesHaveChanged provides a mock function with given fields: c, newMember, oldMember
+func (_m *Hooks) ChannelMemberRolesHaveChanged(c *plugin.Context, newMember *model.ChannelMember, oldMember *model.ChannelMember) {
+	_m.Called(c, newMember, oldMember)
+}
+
 // ConfigurationWillBeSaved provides a mock function with given fields: newCfg
 func (_m *Hooks) ConfigurationWillBeSaved(newCfg *model.Config) (*model.Config, error) {
 	ret := _m.Called(newCfg)
@@ -481,6 +521,21 @@ func (_m *Hooks) OnWebSocketDisconnect(webConnID string, userID string) {
 	_m.Called(webConnID, userID)
 }
 
+// PostHasBeenAcknowledged provides a mock function with given fields: c, acknowledgement
+func (_m *Hooks) PostHasBeenAcknowledged(c *plugin.Context, acknowledgement *model.PostAcknowledgement) {
+	_m.Called(c, acknowledgement)
+}
+
+// PostHasBeenPinned provides a mock function with given fields: c, post
+func (_m *Hooks) PostHasBeenPinned(c *plugin.Context, post *model.Post) {
+	_m.Called(c, post)
+}
+
+// PostHasBeenUnpinned provides a mock function with given fields: c, post
+func (_m *Hooks) PostHasBeenUnpinned(c *plugin.Context, post *model.Post) {
+	_m.Called(c, post)
+}
+
 // PreferencesHaveChanged provides a mock function with given fields: c, preferences
 func (_m *Hooks) PreferencesHaveChanged(c *plugin.Context, preferences []model.Preference) {
 	_m.Called(c, preferences)
@@ -524,6 +579,24 @@ func (_m *Hooks) RunDataRetention(nowTime int64, batchSize int64) (int64, error)
 	return r0, r1
 }
 
+// RunPluginJob provides a mock function with given fields: job
+func (_m *Hooks) RunPluginJob(job *model.Job) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunPluginJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Job) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ServeHTTP provides a mock function with given fields: c, w, r
 func (_m *Hooks) ServeHTTP(c *plugin.Context, w http.ResponseWriter, r *http.Request) {
 	_m.Called(c, w, r)
@@ -534,6 +607,56 @@ func (_m *Hooks) ServeMetrics(c *plugin.Context, w http.ResponseWriter, r *http.
 	_m.Called(c, w, r)
 }
 
+// TeamHasBeenCreated provides a mock function with given fields: c, team
+func (_m *Hooks) TeamHasBeenCreated(c *plugin.Context, team *model.Team) {
+	_m.Called(c, team)
+}
+
+// TeamHasBeenUpdated provides a mock function with given fields: c, newTeam, oldTeam
+func (_m *Hooks) TeamHasBeenUpdated(c *plugin.Context, newTeam *model.Team, oldTeam *model.Team) {
+	_m.Called(c, newTeam, oldTeam)
+}
+
+// TeamMemberRolesHaveChanged provides a mock function with given fields: c, newMember, oldMember
+func (_m *Hooks) TeamMemberRolesHaveChanged(c *plugin.Context, newMember *model.TeamMember, oldMember *model.TeamMember) {
+	_m.Called(c, newMember, oldMember)
+}
+
+// TranslateText provides a mock function with given fields: c, texts, sourceLanguage, targetLocale
+func (_m *Hooks) TranslateText(c *plugin.Context, texts []string, sourceLanguage string, targetLocale string) ([]*model.TextTranslation, error) {
+	ret := _m.Called(c, texts, sourceLanguage, targetLocale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TranslateText")
+	}
+
+	var r0 []*model.TextTranslation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*plugin.Context, []string, string, string) ([]*model.TextTranslation, error)); ok {
+		return rf(c, texts, sourceLanguage, targetLocale)
+	}
+	if rf, ok := ret.Get(0).(func(*plugin.Context, []string, string, string) []*model.TextTranslation); ok {
+		r0 = rf(c, texts, sourceLanguage, targetLocale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TextTranslation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*plugin.Context, []string, string, string) error); ok {
+		r1 = rf(c, texts, sourceLanguage, targetLocale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserCustomStatusHasChanged provides a mock function with given fields: c, userID, customStatus
+func (_m *Hooks) UserCustomStatusHasChanged(c *plugin.Context, userID string, customStatus *model.CustomStatus) {
+	_m.Called(c, userID, customStatus)
+}
+
 // UserHasBeenCreated provides a mock function with given fields: c, user
 func (_m *Hooks) UserHasBeenCreated(c *plugin.Context, user *model.User) {
 	_m.Called(c, user)
@@ -544,6 +667,11 @@ func (_m *Hooks) UserHasBeenDeactivated(c *plugin.Context, user *model.User) {
 	_m.Called(c, user)
 }
 
+// UserHasBeenUpdated provides a mock function with given fields: c, newUser, oldUser
+func (_m *Hooks) UserHasBeenUpdated(c *plugin.Context, newUser *model.User, oldUser *model.User) {
+	_m.Called(c, newUser, oldUser)
+}
+
 // UserHasJoinedChannel provides a mock function with given fields: c, channelMember, actor
 func (_m *Hooks) UserHasJoinedChannel(c *plugin.Context, channelMember *model.ChannelMember, actor *model.User) {
 	_m.Called(c, channelMember, actor)
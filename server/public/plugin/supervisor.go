@@ -30,6 +30,7 @@ type supervisor struct {
 	hooks        Hooks
 	implemented  [TotalHooksID]bool
 	hooksClient  *hooksRPCClient
+	hookGuard    *hookGuard
 	isReattached bool
 }
 
@@ -85,6 +86,14 @@ func WithReattachConfig(pluginReattachConfig *model.PluginReattachConfig) func(*
 	}
 }
 
+// withHookGuard enforces the hook execution budget and circuit breaker tracked by the given guard.
+func withHookGuard(guard *hookGuard) func(*supervisor, *plugin.ClientConfig) error {
+	return func(sup *supervisor, clientConfig *plugin.ClientConfig) error {
+		sup.hookGuard = guard
+		return nil
+	}
+}
+
 func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver, parentLogger *mlog.Logger, metrics metricsInterface, opts ...func(*supervisor, *plugin.ClientConfig) error) (retSupervisor *supervisor, retErr error) {
 	sup := supervisor{
 		pluginID: pluginInfo.Manifest.Id,
@@ -106,12 +115,13 @@ func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver,
 		extrasKey:     "wrapped_extras",
 	}
 
+	hooksImpl := &hooksPlugin{
+		log:        wrappedLogger,
+		driverImpl: sup.appDriver,
+		apiImpl:    &apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics},
+	}
 	pluginMap := map[string]plugin.Plugin{
-		"hooks": &hooksPlugin{
-			log:        wrappedLogger,
-			driverImpl: sup.appDriver,
-			apiImpl:    &apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics},
-		},
+		"hooks": hooksImpl,
 	}
 
 	clientConfig := &plugin.ClientConfig{
@@ -128,6 +138,7 @@ func newSupervisor(pluginInfo *model.BundleInfo, apiImpl API, driver AppDriver,
 			return nil, errors.Wrap(err, "failed to apply option")
 		}
 	}
+	hooksImpl.guard = sup.hookGuard
 
 	sup.client = plugin.NewClient(clientConfig)
 
@@ -4,8 +4,11 @@
 package plugin
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +24,7 @@ func TestSupervisor(t *testing.T) {
 		"Supervisor_InvalidExecutablePath":     testSupervisorInvalidExecutablePath,
 		"Supervisor_NonExistentExecutablePath": testSupervisorNonExistentExecutablePath,
 		"Supervisor_StartTimeout":              testSupervisorStartTimeout,
+		"Supervisor_LogObserver":               testSupervisorLogObserver,
 	} {
 		t.Run(name, f)
 	}
@@ -78,3 +82,39 @@ func testSupervisorStartTimeout(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, supervisor)
 }
+
+func testSupervisorLogObserver(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	backend := filepath.Join(dir, "backend.exe")
+	utils.CompileGo(t, `
+		package main
+
+		func main() {
+			panic("plugin failed to initialize")
+		}
+	`, backend)
+
+	os.WriteFile(filepath.Join(dir, "plugin.json"), []byte(`{"id": "foo", "server": {"executable": "backend.exe"}}`), 0600)
+
+	var lock sync.Mutex
+	var messages []string
+	observe := func(pluginID, level, message string, keyValuePairs ...any) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, "foo", pluginID)
+		messages = append(messages, fmt.Sprint(message, keyValuePairs))
+	}
+
+	bundle := model.BundleInfoForPath(dir)
+	logger := mlog.CreateConsoleTestLogger(t)
+	supervisor, err := newSupervisor(bundle, nil, nil, logger, nil, WithExecutableFromManifest(bundle), withLogObserver(observe))
+	require.Error(t, err)
+	require.Nil(t, supervisor)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Contains(t, strings.Join(messages, "\n"), "plugin failed to initialize")
+}
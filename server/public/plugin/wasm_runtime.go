@@ -0,0 +1,807 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+// WebAssembly plugins run in-process, inside a sandbox with no filesystem, network or
+// environment access. Their server component is a single WebAssembly module, compiled for
+// wasip1 as a reactor, that talks to the server through the following ABI. All payloads are
+// JSON and are passed as (pointer, length) pairs into the module's linear memory. Functions
+// returning a payload pack it into a single i64 as pointer<<32 | length.
+//
+// The module must export:
+//
+//	memory                                                     its linear memory
+//	mm_alloc(size i32) i32                                     allocates size bytes for the server to write into
+//	mm_implemented() i64                                       the names of the implemented hooks, as a JSON array
+//	mm_hook(name_ptr, name_len, args_ptr, args_len i32) i64    invokes the named hook
+//
+// and may export mm_free(ptr, size i32) to release buffers once the server is done with them,
+// and _initialize to set up the module after instantiation.
+//
+// The server provides a single host function:
+//
+//	mattermost.api_call(name_ptr, name_len, args_ptr, args_len i32) i64    invokes the named API method
+//
+// Hook and API arguments are encoded as an object keyed by parameter position ("A", "B", ...),
+// and both return {"error": "...", "returns": {"A": ..., "B": ...}}, where error reports that
+// the call itself failed and error-typed return values are encoded as their message. API
+// methods that stream data or need a database connection are not available.
+//
+// Each call runs on an instance of the module taken from a small pool, so modules must not rely
+// on state kept in memory between calls; use the KV store instead. Instances are discarded when
+// a call fails or runs past its deadline. A plugin runs at most wasmMaxInstances instances at a
+// time, further calls waiting for one to be released, and its memory limit is shared between
+// them.
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	wasmapi "github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	wasmDefaultMemoryLimitBytes = 128 * 1024 * 1024
+	wasmDefaultMaxCallDuration  = time.Minute
+	wasmMaxInstances            = 4
+	wasmMaxHTTPBodyBytes        = 10 * 1024 * 1024
+	wasmPageSize                = 64 * 1024
+)
+
+// wasmUnsupportedAPIMethods rely on streams or connections that aren't available in-process.
+var wasmUnsupportedAPIMethods = map[string]bool{
+	"InstallPlugin":           true,
+	"LoadPluginConfiguration": true,
+	"PluginHTTP":              true,
+	"UploadData":              true,
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// WasmLimits bounds the resources available to WebAssembly plugins.
+type WasmLimits struct {
+	// MemoryLimitBytes caps the linear memory of each plugin, split evenly between the instances
+	// it may run at a time.
+	MemoryLimitBytes uint64
+	// MaxCallDuration caps the execution of a single hook, including hooks that have no
+	// execution budget of their own.
+	MaxCallDuration time.Duration
+}
+
+type wasmEnvelope struct {
+	Error   string          `json:"error,omitempty"`
+	Returns json.RawMessage `json:"returns,omitempty"`
+}
+
+// wasmModule runs calls against instances of a compiled WebAssembly plugin. It implements
+// hooksCaller so that the RPC hooks client, along with its timeouts and circuit breaker, can
+// drive it exactly like a plugin process.
+type wasmModule struct {
+	pluginID        string
+	logger          *mlog.Logger
+	runtime         wazero.Runtime
+	compiled        wazero.CompiledModule
+	config          wazero.ModuleConfig
+	apiServer       *rpc.Server
+	guard           *hookGuard
+	maxCallDuration time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	calls  sync.WaitGroup
+
+	// slots holds a token for each instance in use, bounding them to wasmMaxInstances.
+	slots chan struct{}
+
+	mut    sync.Mutex
+	idle   []wasmapi.Module
+	closed bool
+}
+
+func newWasmModule(pluginInfo *model.BundleInfo, apiImpl API, logger *mlog.Logger, guard *hookGuard, limits WasmLimits, observe LogObserver) (*wasmModule, error) {
+	executable := filepath.Clean(filepath.Join(".", pluginInfo.Manifest.Server.Executable))
+	if strings.HasPrefix(executable, "..") {
+		return nil, fmt.Errorf("invalid backend executable: %s", executable)
+	}
+
+	code, err := os.ReadFile(filepath.Join(pluginInfo.Path, executable))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read WebAssembly module")
+	}
+
+	if limits.MemoryLimitBytes == 0 {
+		limits.MemoryLimitBytes = wasmDefaultMemoryLimitBytes
+	}
+	if limits.MaxCallDuration <= 0 {
+		limits.MaxCallDuration = wasmDefaultMaxCallDuration
+	}
+	memoryLimitPages := limits.MemoryLimitBytes / wasmMaxInstances / wasmPageSize
+	if memoryLimitPages == 0 {
+		memoryLimitPages = 1
+	} else if memoryLimitPages > 65536 {
+		memoryLimitPages = 65536
+	}
+
+	m := &wasmModule{
+		pluginID:        pluginInfo.Manifest.Id,
+		logger:          logger,
+		apiServer:       rpc.NewServer(),
+		guard:           guard,
+		maxCallDuration: limits.MaxCallDuration,
+		slots:           make(chan struct{}, wasmMaxInstances),
+	}
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+
+	if err = m.apiServer.RegisterName("Plugin", &apiRPCServer{impl: apiImpl}); err != nil {
+		m.cancel()
+		return nil, errors.Wrap(err, "unable to register plugin API")
+	}
+
+	m.runtime = wazero.NewRuntimeWithConfig(m.ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(uint32(memoryLimitPages)).
+		WithCloseOnContextDone(true))
+
+	defer func() {
+		if err != nil {
+			m.close()
+		}
+	}()
+
+	if _, err = wasi_snapshot_preview1.Instantiate(m.ctx, m.runtime); err != nil {
+		return nil, errors.Wrap(err, "unable to instantiate WASI")
+	}
+
+	_, err = m.runtime.NewHostModuleBuilder("mattermost").
+		NewFunctionBuilder().
+		WithGoModuleFunction(wasmapi.GoModuleFunc(m.apiCall),
+			[]wasmapi.ValueType{wasmapi.ValueTypeI32, wasmapi.ValueTypeI32, wasmapi.ValueTypeI32, wasmapi.ValueTypeI32},
+			[]wasmapi.ValueType{wasmapi.ValueTypeI64}).
+		Export("api_call").
+		Instantiate(m.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to instantiate host functions")
+	}
+
+	m.compiled, err = m.runtime.CompileModule(m.ctx, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to compile WebAssembly module")
+	}
+
+	for _, export := range []string{"mm_alloc", "mm_implemented", "mm_hook"} {
+		if _, ok := m.compiled.ExportedFunctions()[export]; !ok {
+			err = fmt.Errorf("WebAssembly module does not export %s", export)
+			return nil, err
+		}
+	}
+
+	stdout, stderr := observeOutput(pluginInfo.Manifest.Id,
+		logger.With(mlog.String("source", "plugin_stdout")).StdLogWriter(),
+		logger.With(mlog.String("source", "plugin_stderr")).StdLogWriter(),
+		observe)
+
+	// Instances are anonymous so that more than one can exist at a time.
+	m.config = wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize").
+		WithStdout(stdout).
+		WithStderr(stderr).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithRandSource(rand.Reader)
+
+	// Instantiate once up front, so that a broken module fails activation.
+	var instance wasmapi.Module
+	instance, err = m.runtime.InstantiateModule(m.ctx, m.compiled, m.config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to instantiate WebAssembly module")
+	}
+	m.idle = append(m.idle, instance)
+
+	return m, nil
+}
+
+// Call implements hooksCaller.
+func (m *wasmModule) Call(serviceMethod string, args any, reply any) error {
+	call := <-m.Go(serviceMethod, args, reply, make(chan *rpc.Call, 1)).Done
+	return call.Error
+}
+
+// Go implements hooksCaller. The arguments are encoded before returning, so the caller is free
+// to reuse them, but the reply may be written to at any point until the call is done.
+func (m *wasmModule) Go(serviceMethod string, args any, reply any, done chan *rpc.Call) *rpc.Call {
+	call := &rpc.Call{
+		ServiceMethod: serviceMethod,
+		Args:          args,
+		Reply:         reply,
+		Done:          done,
+	}
+
+	payload, err := encodeWasmStruct(args)
+	if err != nil {
+		call.Error = err
+		call.Done <- call
+		return call
+	}
+
+	go func() {
+		call.Error = m.callHook(strings.TrimPrefix(serviceMethod, "Plugin."), payload, reply)
+		call.Done <- call
+	}()
+
+	return call
+}
+
+// implemented returns the names of the hooks the module implements.
+func (m *wasmModule) implemented() ([]string, error) {
+	out, err := m.invoke(m.maxCallDuration, "mm_implemented")
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []string
+	if err := json.Unmarshal(out, &hooks); err != nil {
+		return nil, errors.Wrap(err, "unable to decode implemented hooks")
+	}
+	return hooks, nil
+}
+
+func (m *wasmModule) callHook(hookName string, payload []byte, reply any) error {
+	timeout := m.maxCallDuration
+	if budget := m.guard.timeoutFor(hookName); budget > 0 && budget < timeout {
+		timeout = budget
+	}
+
+	out, err := m.invoke(timeout, "mm_hook", []byte(hookName), payload)
+	if err != nil {
+		return errors.Wrapf(err, "unable to run hook %s", hookName)
+	}
+
+	var envelope wasmEnvelope
+	if err := json.Unmarshal(out, &envelope); err != nil {
+		return errors.Wrapf(err, "unable to decode result of hook %s", hookName)
+	}
+	if envelope.Error != "" {
+		// Reported by the module itself, just like a plugin process would.
+		return rpc.ServerError(envelope.Error)
+	}
+
+	return decodeWasmStruct(envelope.Returns, reply)
+}
+
+// invoke calls the given export on an idle instance, passing each input as a (pointer, length)
+// pair, and returns the payload it produces.
+func (m *wasmModule) invoke(timeout time.Duration, export string, inputs ...[]byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(m.ctx, timeout)
+	defer cancel()
+
+	instance, err := m.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer m.calls.Done()
+
+	params := make([]uint64, 0, 2*len(inputs))
+	for _, input := range inputs {
+		ptr, writeErr := writeToWasm(ctx, instance, input)
+		if writeErr != nil {
+			m.discard(instance)
+			return nil, writeErr
+		}
+		params = append(params, uint64(ptr), uint64(len(input)))
+	}
+
+	results, err := instance.ExportedFunction(export).Call(ctx, params...)
+	if err != nil {
+		// The instance may have trapped or been closed when the deadline passed, so never reuse it.
+		m.discard(instance)
+		return nil, err
+	}
+
+	for i := 0; i < len(params); i += 2 {
+		freeWasm(ctx, instance, uint32(params[i]), uint32(params[i+1]))
+	}
+
+	out, err := readFromWasm(ctx, instance, results[0])
+	if err != nil {
+		m.discard(instance)
+		return nil, err
+	}
+
+	m.release(instance)
+	return out, nil
+}
+
+// acquire takes an idle instance, or creates one, waiting until fewer than wasmMaxInstances are
+// in use or the context is done.
+func (m *wasmModule) acquire(ctx context.Context) (wasmapi.Module, error) {
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		if m.ctx.Err() != nil {
+			return nil, rpc.ErrShutdown
+		}
+		return nil, errors.Wrap(ctx.Err(), "no WebAssembly module instance available")
+	}
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	if m.closed {
+		<-m.slots
+		return nil, rpc.ErrShutdown
+	}
+
+	m.calls.Add(1)
+	if n := len(m.idle); n > 0 {
+		instance := m.idle[n-1]
+		m.idle = m.idle[:n-1]
+		return instance, nil
+	}
+
+	instance, err := m.runtime.InstantiateModule(m.ctx, m.compiled, m.config)
+	if err != nil {
+		m.calls.Done()
+		<-m.slots
+		return nil, errors.Wrap(err, "unable to instantiate WebAssembly module")
+	}
+	return instance, nil
+}
+
+// release returns an instance to the pool once a call has completed.
+func (m *wasmModule) release(instance wasmapi.Module) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	defer func() { <-m.slots }()
+
+	if m.closed {
+		instance.Close(context.Background())
+		return
+	}
+	m.idle = append(m.idle, instance)
+}
+
+// discard closes an instance that must not be reused.
+func (m *wasmModule) discard(instance wasmapi.Module) {
+	instance.Close(context.Background())
+	<-m.slots
+}
+
+// close aborts any running calls, waits for them to return and releases the runtime.
+func (m *wasmModule) close() {
+	m.mut.Lock()
+	if m.closed {
+		m.mut.Unlock()
+		return
+	}
+	m.closed = true
+	m.idle = nil
+	m.mut.Unlock()
+
+	m.cancel()
+	m.calls.Wait()
+
+	if m.runtime != nil {
+		if err := m.runtime.Close(context.Background()); err != nil {
+			m.logger.Warn("Failed to close WebAssembly runtime", mlog.Err(err))
+		}
+	}
+}
+
+// apiCall is the mattermost.api_call host function.
+func (m *wasmModule) apiCall(ctx context.Context, instance wasmapi.Module, stack []uint64) {
+	namePtr, nameLen, argsPtr, argsLen := uint32(stack[0]), uint32(stack[1]), uint32(stack[2]), uint32(stack[3])
+	stack[0] = 0
+
+	name, ok := instance.Memory().Read(namePtr, nameLen)
+	if !ok {
+		m.logger.Error("WebAssembly plugin passed an invalid API method name")
+		return
+	}
+	args, ok := instance.Memory().Read(argsPtr, argsLen)
+	if !ok {
+		m.logger.Error("WebAssembly plugin passed invalid API arguments", mlog.String("api_name", string(name)))
+		return
+	}
+
+	codec := &wasmAPICodec{
+		method: string(name),
+		args:   bytes.Clone(args),
+	}
+	if wasmUnsupportedAPIMethods[codec.method] {
+		codec.response = wasmEnvelope{Error: fmt.Sprintf("API method %s is not available to WebAssembly plugins", codec.method)}
+	} else if err := m.apiServer.ServeRequest(codec); err != nil && codec.response.Error == "" {
+		codec.response.Error = err.Error()
+	}
+
+	out, err := json.Marshal(codec.response)
+	if err != nil {
+		m.logger.Error("Failed to encode API response for WebAssembly plugin", mlog.String("api_name", codec.method), mlog.Err(err))
+		return
+	}
+
+	ptr, err := writeToWasm(ctx, instance, out)
+	if err != nil {
+		m.logger.Error("Failed to write API response for WebAssembly plugin", mlog.String("api_name", codec.method), mlog.Err(err))
+		return
+	}
+	stack[0] = uint64(ptr)<<32 | uint64(len(out))
+}
+
+// wasmAPICodec is a single-request rpc.ServerCodec used to dispatch an api_call to apiRPCServer.
+type wasmAPICodec struct {
+	method   string
+	args     []byte
+	read     bool
+	response wasmEnvelope
+}
+
+func (c *wasmAPICodec) ReadRequestHeader(r *rpc.Request) error {
+	if c.read {
+		return io.EOF
+	}
+	c.read = true
+	r.ServiceMethod = "Plugin." + c.method
+	return nil
+}
+
+func (c *wasmAPICodec) ReadRequestBody(body any) error {
+	if body == nil {
+		return nil
+	}
+	return decodeWasmStruct(c.args, body)
+}
+
+func (c *wasmAPICodec) WriteResponse(r *rpc.Response, body any) error {
+	if r.Error != "" {
+		c.response.Error = r.Error
+		return nil
+	}
+
+	returns, err := encodeWasmStruct(body)
+	if err != nil {
+		c.response.Error = err.Error()
+		return nil
+	}
+	c.response.Returns = returns
+	return nil
+}
+
+func (c *wasmAPICodec) Close() error {
+	return nil
+}
+
+// writeToWasm copies data into memory allocated by the module, returning its address.
+func writeToWasm(ctx context.Context, instance wasmapi.Module, data []byte) (uint32, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+
+	results, err := instance.ExportedFunction("mm_alloc").Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to allocate WebAssembly memory")
+	}
+	ptr := uint32(results[0])
+	if !instance.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("WebAssembly allocation out of range: %d+%d", ptr, len(data))
+	}
+	return ptr, nil
+}
+
+// readFromWasm copies out the payload referenced by a packed pointer and length, then frees it.
+func readFromWasm(ctx context.Context, instance wasmapi.Module, packed uint64) ([]byte, error) {
+	ptr, size := uint32(packed>>32), uint32(packed)
+	if size == 0 {
+		return nil, nil
+	}
+
+	data, ok := instance.Memory().Read(ptr, size)
+	if !ok {
+		return nil, fmt.Errorf("WebAssembly result out of range: %d+%d", ptr, size)
+	}
+	out := bytes.Clone(data)
+	freeWasm(ctx, instance, ptr, size)
+	return out, nil
+}
+
+func freeWasm(ctx context.Context, instance wasmapi.Module, ptr, size uint32) {
+	if size == 0 {
+		return
+	}
+	if free := instance.ExportedFunction("mm_free"); free != nil {
+		_, _ = free.Call(ctx, uint64(ptr), uint64(size))
+	}
+}
+
+// encodeWasmStruct encodes one of the argument or return structs shared with the RPC glue,
+// encoding error values as their message since they can't otherwise be represented in JSON.
+func encodeWasmStruct(v any) ([]byte, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(v)
+	}
+
+	fields := make(map[string]any, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		value := rv.Field(i)
+		if field.Type == errorType {
+			if !value.IsNil() {
+				fields[field.Name] = value.Interface().(error).Error()
+			}
+			continue
+		}
+		fields[field.Name] = value.Interface()
+	}
+
+	return json.Marshal(fields)
+}
+
+// decodeWasmStruct is the inverse of encodeWasmStruct.
+func decodeWasmStruct(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return json.Unmarshal(data, v)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		raw, ok := fields[field.Name]
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		if field.Type == errorType {
+			var message string
+			if err := json.Unmarshal(raw, &message); err != nil {
+				return errors.Wrapf(err, "unable to decode %s", field.Name)
+			}
+			if message != "" {
+				rv.Field(i).Set(reflect.ValueOf(errors.New(message)))
+			}
+			continue
+		}
+
+		if err := json.Unmarshal(raw, rv.Field(i).Addr().Interface()); err != nil {
+			return errors.Wrapf(err, "unable to decode %s", field.Name)
+		}
+	}
+
+	return nil
+}
+
+// wasmHooks adapts the RPC hooks client to a WebAssembly module, replacing the hooks that rely
+// on streams between processes.
+type wasmHooks struct {
+	*hooksRPCClient
+	module      *wasmModule
+	hasActivate bool
+}
+
+type wasmHTTPRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+type wasmHTTPResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+type wasmServeHTTPArgs struct {
+	A *Context
+	B *wasmHTTPRequest
+}
+
+type wasmServeHTTPReturns struct {
+	A *wasmHTTPResponse
+}
+
+type wasmFileWillBeUploadedArgs struct {
+	A *Context
+	B *model.FileInfo
+	C []byte
+}
+
+type wasmFileWillBeUploadedReturns struct {
+	A *model.FileInfo
+	B string
+	C []byte
+}
+
+func (h *wasmHooks) Implemented() ([]string, error) {
+	impl, err := h.module.implemented()
+	for _, hookName := range impl {
+		if hookId, ok := hookNameToId[hookName]; ok {
+			h.implemented[hookId] = true
+		} else if hookName == "OnActivate" {
+			h.hasActivate = true
+		}
+	}
+	return impl, err
+}
+
+func (h *wasmHooks) OnActivate() error {
+	if !h.hasActivate {
+		return nil
+	}
+
+	_returns := &Z_OnActivateReturns{}
+	if err := h.call("OnActivate", struct{}{}, _returns); err != nil {
+		return err
+	}
+	return _returns.A
+}
+
+func (h *wasmHooks) ServeHTTP(c *Context, w http.ResponseWriter, r *http.Request) {
+	h.serveHTTP("ServeHTTP", h.implemented[ServeHTTPID], c, w, r)
+}
+
+func (h *wasmHooks) ServeMetrics(c *Context, w http.ResponseWriter, r *http.Request) {
+	h.serveHTTP("ServeMetrics", h.implemented[ServeMetricsID], c, w, r)
+}
+
+func (h *wasmHooks) serveHTTP(hookName string, implemented bool, c *Context, w http.ResponseWriter, r *http.Request) {
+	if !implemented {
+		http.NotFound(w, r)
+		return
+	}
+
+	request := &wasmHTTPRequest{
+		Method: r.Method,
+		URL:    r.URL.String(),
+		Header: r.Header,
+	}
+	if r.Body != nil {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, wasmMaxHTTPBodyBytes))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		request.Body = body
+	}
+
+	_returns := &wasmServeHTTPReturns{}
+	if err := h.call(hookName, &wasmServeHTTPArgs{c, request}, _returns); err != nil || _returns.A == nil {
+		h.log.Error("Call "+hookName+" to WebAssembly plugin failed.", mlog.Err(err))
+		http.Error(w, "500 internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	for key, values := range _returns.A.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	if _returns.A.StatusCode != 0 {
+		w.WriteHeader(_returns.A.StatusCode)
+	}
+	if _, err := w.Write(_returns.A.Body); err != nil {
+		h.log.Warn("Failed to write response from WebAssembly plugin", mlog.Err(err))
+	}
+}
+
+func (h *wasmHooks) FileWillBeUploaded(c *Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
+	if !h.implemented[FileWillBeUploadedID] {
+		return info, ""
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.log.Error("Failed to read uploaded file for WebAssembly plugin.", mlog.Err(err))
+		return info, ""
+	}
+
+	_returns := &wasmFileWillBeUploadedReturns{}
+	if err := h.call("FileWillBeUploaded", &wasmFileWillBeUploadedArgs{c, info, data}, _returns); err != nil {
+		h.log.Error("Call FileWillBeUploaded to WebAssembly plugin failed.", mlog.Err(err))
+		return info, ""
+	}
+
+	if len(_returns.C) > 0 {
+		if _, err := output.Write(_returns.C); err != nil {
+			h.log.Error("Error writing replacement file.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+// wasmSupervisor runs a plugin's server component as an in-process WebAssembly module.
+type wasmSupervisor struct {
+	pluginID    string
+	module      *wasmModule
+	hooks       Hooks
+	implemented [TotalHooksID]bool
+}
+
+func newWasmSupervisor(pluginInfo *model.BundleInfo, apiImpl API, parentLogger *mlog.Logger, metrics metricsInterface, guard *hookGuard, limits WasmLimits, observe LogObserver) (*wasmSupervisor, error) {
+	wrappedLogger := pluginInfo.WrapLogger(parentLogger)
+
+	api := API(&apiTimerLayer{pluginInfo.Manifest.Id, apiImpl, metrics})
+	module, err := newWasmModule(pluginInfo, api, wrappedLogger, guard, limits, observe)
+	if err != nil {
+		return nil, err
+	}
+
+	sup := &wasmSupervisor{
+		pluginID: pluginInfo.Manifest.Id,
+		module:   module,
+	}
+
+	client := &wasmHooks{
+		hooksRPCClient: &hooksRPCClient{
+			client:  module,
+			log:     wrappedLogger,
+			apiImpl: api,
+			guard:   guard,
+		},
+		module: module,
+	}
+	sup.hooks = &hooksTimerLayer{pluginInfo.Manifest.Id, client, metrics}
+
+	impl, err := sup.hooks.Implemented()
+	if err != nil {
+		module.close()
+		return nil, err
+	}
+	for _, hookName := range impl {
+		if hookId, ok := hookNameToId[hookName]; ok {
+			sup.implemented[hookId] = true
+		}
+	}
+
+	return sup, nil
+}
+
+func (sup *wasmSupervisor) Hooks() Hooks {
+	return sup.hooks
+}
+
+func (sup *wasmSupervisor) Implements(hookId int) bool {
+	return sup.implemented[hookId]
+}
+
+// PerformHealthCheck always succeeds: a module that traps only loses the instance it ran on.
+func (sup *wasmSupervisor) PerformHealthCheck() error {
+	return nil
+}
+
+// Shutdown aborts any running hooks and releases the module. It returns once no more plugin
+// code is running.
+func (sup *wasmSupervisor) Shutdown() {
+	sup.module.close()
+}
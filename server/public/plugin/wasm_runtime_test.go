@@ -0,0 +1,348 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	wasmapi "github.com/tetratelabs/wazero/api"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// Offsets of the static data in the test modules.
+const (
+	testWasmImplementedOffset = 0
+	testWasmAPINameOffset     = 128
+	testWasmAPIArgsOffset     = 160
+	testWasmResultOffset      = 256
+)
+
+func wasmULEB(v uint64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			out = append(out, b|0x80)
+			continue
+		}
+		return append(out, b)
+	}
+}
+
+func wasmSLEB(v int64) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func wasmVec(items ...[]byte) []byte {
+	out := wasmULEB(uint64(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func wasmBytes(b []byte) []byte {
+	return append(wasmULEB(uint64(len(b))), b...)
+}
+
+func wasmSection(id byte, content []byte) []byte {
+	return append([]byte{id}, wasmBytes(content)...)
+}
+
+func wasmConcat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func wasmI32Const(v int32) []byte {
+	return append([]byte{0x41}, wasmSLEB(int64(v))...)
+}
+
+func wasmI64Const(v int64) []byte {
+	return append([]byte{0x42}, wasmSLEB(v)...)
+}
+
+func wasmPacked(offset, length int) int64 {
+	return int64(offset)<<32 | int64(length)
+}
+
+// buildTestWasmModule assembles a minimal plugin module implementing the ABI. Its mm_hook logs
+// through the API and answers every hook with result, or never returns if loop is set.
+func buildTestWasmModule(implemented, apiArgs, result string, loop bool) []byte {
+	const (
+		i32 = 0x7f
+		i64 = 0x7e
+	)
+	funcType := func(params, results []byte) []byte {
+		return wasmConcat([]byte{0x60}, wasmBytes(params), wasmBytes(results))
+	}
+
+	types := wasmVec(
+		funcType([]byte{i32}, []byte{i32}),                // 0: mm_alloc
+		funcType(nil, []byte{i64}),                        // 1: mm_implemented
+		funcType([]byte{i32, i32, i32, i32}, []byte{i64}), // 2: mm_hook, api_call
+		funcType([]byte{i32, i32}, nil),                   // 3: mm_free
+	)
+	imports := wasmVec(wasmConcat(wasmBytes([]byte("mattermost")), wasmBytes([]byte("api_call")), []byte{0x00, 0x02}))
+	functions := wasmVec([]byte{0x00}, []byte{0x01}, []byte{0x02}, []byte{0x03})
+	memory := wasmVec([]byte{0x00, 0x02})
+	globals := wasmVec(wasmConcat([]byte{i32, 0x01}, wasmI32Const(1024), []byte{0x0b}))
+
+	export := func(name string, kind byte, index byte) []byte {
+		return wasmConcat(wasmBytes([]byte(name)), []byte{kind, index})
+	}
+	exports := wasmVec(
+		export("memory", 0x02, 0),
+		export("mm_alloc", 0x00, 1),
+		export("mm_implemented", 0x00, 2),
+		export("mm_hook", 0x00, 3),
+		export("mm_free", 0x00, 4),
+	)
+
+	body := func(instructions ...[]byte) []byte {
+		return wasmBytes(wasmConcat(append([][]byte{{0x00}}, append(instructions, []byte{0x0b})...)...))
+	}
+	alloc := body(
+		[]byte{0x23, 0x00}, // global.get $heap
+		[]byte{0x23, 0x00}, // global.get $heap
+		[]byte{0x20, 0x00}, // local.get $size
+		[]byte{0x6a},       // i32.add
+		[]byte{0x24, 0x00}, // global.set $heap
+	)
+	implementedFn := body(wasmI64Const(wasmPacked(testWasmImplementedOffset, len(implemented))))
+	hook := body(
+		wasmI32Const(testWasmAPINameOffset),
+		wasmI32Const(int32(len("LogInfo"))),
+		wasmI32Const(testWasmAPIArgsOffset),
+		wasmI32Const(int32(len(apiArgs))),
+		[]byte{0x10, 0x00}, // call $api_call
+		[]byte{0x1a},       // drop
+		wasmI64Const(wasmPacked(testWasmResultOffset, len(result))),
+	)
+	if loop {
+		hook = body(
+			[]byte{0x03, 0x40, 0x0c, 0x00, 0x0b}, // loop br 0 end
+			wasmI64Const(0),
+		)
+	}
+	free := body()
+	code := wasmVec(alloc, implementedFn, hook, free)
+
+	segment := func(offset int32, data string) []byte {
+		return wasmConcat([]byte{0x00}, wasmI32Const(offset), []byte{0x0b}, wasmBytes([]byte(data)))
+	}
+	data := wasmVec(
+		segment(testWasmImplementedOffset, implemented),
+		segment(testWasmAPINameOffset, "LogInfo"),
+		segment(testWasmAPIArgsOffset, apiArgs),
+		segment(testWasmResultOffset, result),
+	)
+
+	return wasmConcat(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00},
+		wasmSection(1, types),
+		wasmSection(2, imports),
+		wasmSection(3, functions),
+		wasmSection(5, memory),
+		wasmSection(6, globals),
+		wasmSection(7, exports),
+		wasmSection(10, code),
+		wasmSection(11, data),
+	)
+}
+
+type wasmTestAPI struct {
+	API
+	logs chan string
+}
+
+func (api *wasmTestAPI) LogInfo(msg string, keyValuePairs ...any) {
+	api.logs <- msg
+}
+
+func setupWasmTestEnvironment(t *testing.T, module []byte) (*Environment, *wasmTestAPI, string) {
+	pluginDir := t.TempDir()
+	webappPluginDir := t.TempDir()
+
+	pluginID := "wasmplugin"
+	require.NoError(t, os.Mkdir(filepath.Join(pluginDir, pluginID), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, pluginID, "plugin.wasm"), module, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, pluginID, "plugin.json"), []byte(`{"id": "`+pluginID+`", "server": {"executable": "plugin.wasm", "runtime": "wasm"}}`), 0600))
+
+	api := &wasmTestAPI{logs: make(chan string, 10)}
+	env, err := NewEnvironment(func(*model.Manifest) API { return api }, nil, pluginDir, webappPluginDir, mlog.CreateConsoleTestLogger(t), nil)
+	require.NoError(t, err)
+	t.Cleanup(env.Shutdown)
+
+	return env, api, pluginID
+}
+
+func TestWasmRuntime(t *testing.T) {
+	t.Run("hooks and API", func(t *testing.T) {
+		module := buildTestWasmModule(
+			`["MessageWillBePosted"]`,
+			`{"A":"hello from wasm","B":null}`,
+			`{"returns":{"A":null,"B":"rejected by wasm"}}`,
+			false,
+		)
+		env, api, pluginID := setupWasmTestEnvironment(t, module)
+
+		_, activated, err := env.Activate(pluginID)
+		require.NoError(t, err)
+		require.True(t, activated)
+		assert.True(t, env.IsActive(pluginID))
+
+		called := false
+		env.RunMultiPluginHook(func(hooks Hooks) bool {
+			called = true
+			post, reason := hooks.MessageWillBePosted(&Context{}, &model.Post{Message: "message"})
+			assert.Nil(t, post)
+			assert.Equal(t, "rejected by wasm", reason)
+			return true
+		}, MessageWillBePostedID)
+		assert.True(t, called)
+
+		select {
+		case msg := <-api.logs:
+			assert.Equal(t, "hello from wasm", msg)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "expected the module to log through the API")
+		}
+
+		hooks, err := env.HooksForPlugin(pluginID)
+		require.NoError(t, err)
+		assert.Nil(t, hooks.MessagesWillBeConsumed([]*model.Post{{Message: "message"}}), "unimplemented hooks should not reach the module")
+
+		assert.True(t, env.Deactivate(pluginID))
+		assert.False(t, env.IsActive(pluginID))
+	})
+
+	t.Run("hook exceeding its budget is aborted", func(t *testing.T) {
+		module := buildTestWasmModule(`["MessageWillBePosted"]`, `{}`, `{}`, true)
+		env, _, pluginID := setupWasmTestEnvironment(t, module)
+		env.SetHookBudget(HookBudget{
+			DefaultTimeout:   time.Second,
+			MessageTimeout:   100 * time.Millisecond,
+			FailureThreshold: 5,
+			Cooldown:         time.Minute,
+		})
+
+		_, _, err := env.Activate(pluginID)
+		require.NoError(t, err)
+
+		hooks, err := env.HooksForPlugin(pluginID)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			start := time.Now()
+			post, reason := hooks.MessageWillBePosted(&Context{}, &model.Post{Message: "message"})
+			assert.Less(t, time.Since(start), 5*time.Second)
+			assert.Nil(t, post)
+			assert.Equal(t, errHookTimeout.Error(), reason)
+		}
+
+		done := make(chan struct{})
+		go func() {
+			env.Deactivate(pluginID)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "shutdown should abort running hooks")
+		}
+	})
+
+	t.Run("invalid module", func(t *testing.T) {
+		env, _, pluginID := setupWasmTestEnvironment(t, []byte("not a wasm module"))
+
+		_, activated, err := env.Activate(pluginID)
+		require.Error(t, err)
+		assert.False(t, activated)
+		assert.Equal(t, model.PluginStateFailedToStart, env.GetPluginState(pluginID))
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		module := buildTestWasmModule(`[]`, `{}`, `{}`, false)
+		env, _, pluginID := setupWasmTestEnvironment(t, module)
+		// The test module needs two pages of memory.
+		env.SetWasmLimits(WasmLimits{MemoryLimitBytes: wasmPageSize})
+
+		_, _, err := env.Activate(pluginID)
+		require.Error(t, err)
+	})
+
+	t.Run("instances are capped", func(t *testing.T) {
+		pluginDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "plugin.wasm"), buildTestWasmModule(`[]`, `{}`, `{}`, false), 0600))
+		pluginInfo := &model.BundleInfo{
+			Path:     pluginDir,
+			Manifest: &model.Manifest{Id: "wasmplugin", Server: &model.ManifestServer{Executable: "plugin.wasm"}},
+		}
+		logger := mlog.CreateConsoleTestLogger(t)
+		guard := newHookGuard("wasmplugin", func() HookBudget { return HookBudget{} }, nil, logger)
+
+		m, err := newWasmModule(pluginInfo, &wasmTestAPI{logs: make(chan string, 10)}, logger, guard, WasmLimits{}, nil)
+		require.NoError(t, err)
+		defer m.close()
+
+		var instances []wasmapi.Module
+		for i := 0; i < wasmMaxInstances; i++ {
+			instance, err := m.acquire(context.Background())
+			require.NoError(t, err)
+			instances = append(instances, instance)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err = m.acquire(ctx)
+		require.Error(t, err, "no instance should be available past the cap")
+
+		m.release(instances[0])
+		m.calls.Done()
+		instance, err := m.acquire(context.Background())
+		require.NoError(t, err, "a released instance should be reused")
+		m.discard(instance)
+		m.calls.Done()
+
+		for _, instance := range instances[1:] {
+			m.release(instance)
+			m.calls.Done()
+		}
+	})
+}
+
+func TestWasmStructEncoding(t *testing.T) {
+	returns := &Z_OnActivateReturns{A: model.NewAppError("where", "id", nil, "", 500)}
+	data, err := encodeWasmStruct(returns)
+	require.NoError(t, err)
+
+	var decoded Z_OnActivateReturns
+	require.NoError(t, decodeWasmStruct(data, &decoded))
+	require.Error(t, decoded.A)
+	assert.Equal(t, returns.A.Error(), decoded.A.Error())
+
+	data, err = encodeWasmStruct(&Z_OnActivateReturns{})
+	require.NoError(t, err)
+	decoded = Z_OnActivateReturns{}
+	require.NoError(t, decodeWasmStruct(data, &decoded))
+	assert.NoError(t, decoded.A)
+}
@@ -20,6 +20,7 @@ type Client struct {
 	File          FileService
 	Frontend      FrontendService
 	Group         GroupService
+	Job           JobService
 	KV            KVService
 	Log           LogService
 	Mail          MailService
@@ -50,6 +51,7 @@ func NewClient(api plugin.API, driver plugin.Driver) *Client {
 		File:          FileService{api: api},
 		Frontend:      FrontendService{api: api},
 		Group:         GroupService{api: api},
+		Job:           JobService{api: api},
 		KV:            KVService{api: api},
 		Log:           LogService{api: api},
 		Mail:          MailService{api: api},
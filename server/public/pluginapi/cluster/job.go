@@ -112,6 +112,10 @@ type JobMetadata struct {
 }
 
 // Schedule creates a scheduled job.
+//
+// Scheduled jobs aren't visible to system admins. Plugins requiring this, or cancellation and
+// JobSettings support, should register their jobs with the server's job server instead, see
+// pluginapi.JobService.
 func Schedule(pluginAPI JobPluginAPI, key string, nextWaitInterval NextWaitInterval, callback func()) (*Job, error) {
 	key = cronPrefix + key
 
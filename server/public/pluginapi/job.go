@@ -0,0 +1,75 @@
+package pluginapi
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+)
+
+// JobService exposes methods to run plugin jobs through the server's job server.
+//
+// Unlike the cluster package's Schedule and JobOnce, plugin jobs are stored alongside the
+// server's own jobs: they are listed and can be canceled by system admins, and honour the
+// JobSettings of the server. Jobs are run through the plugin's RunPluginJob hook, on whichever
+// server running the plugin claims them first.
+type JobService struct {
+	api plugin.API
+}
+
+// Register registers a job of the plugin, typically from OnActivate. Jobs are scheduled every
+// interval when it is positive, and only run when created explicitly otherwise. The job name
+// must be unique across all plugins, and its job type is model.PluginJobType(name).
+//
+// Minimum server version: 10.3
+func (j *JobService) Register(name string, interval time.Duration) error {
+	return normalizeAppErr(j.api.RegisterPluginJob(&model.PluginJobDefinition{
+		Name:            name,
+		IntervalSeconds: int64(interval / time.Second),
+	}))
+}
+
+// Unregister stops running and scheduling a job of the plugin. Existing jobs are kept.
+//
+// Minimum server version: 10.3
+func (j *JobService) Unregister(name string) error {
+	return normalizeAppErr(j.api.UnregisterPluginJob(name))
+}
+
+// Create creates a pending job of a registered job of the plugin, with the given data.
+//
+// Minimum server version: 10.3
+func (j *JobService) Create(name string, data map[string]string) (*model.Job, error) {
+	job, appErr := j.api.CreatePluginJob(name, data)
+
+	return job, normalizeAppErr(appErr)
+}
+
+// Get gets a job of the plugin by id.
+//
+// Minimum server version: 10.3
+func (j *JobService) Get(jobID string) (*model.Job, error) {
+	job, appErr := j.api.GetPluginJob(jobID)
+
+	return job, normalizeAppErr(appErr)
+}
+
+// IsCancelRequested reports whether cancellation of a running job of the plugin was requested.
+// Long running jobs should check it periodically and return early from RunPluginJob when so.
+//
+// Minimum server version: 10.3
+func (j *JobService) IsCancelRequested(jobID string) (bool, error) {
+	job, err := j.Get(jobID)
+	if err != nil {
+		return false, err
+	}
+
+	return job.Status == model.JobStatusCancelRequested, nil
+}
+
+// SetProgress sets the progress, from 0 to 100, of a running job of the plugin.
+//
+// Minimum server version: 10.3
+func (j *JobService) SetProgress(jobID string, progress int64) error {
+	return normalizeAppErr(j.api.SetPluginJobProgress(jobID, progress))
+}
@@ -0,0 +1,107 @@
+package pluginapi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+)
+
+func TestJobRegister(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		api.On("RegisterPluginJob", &model.PluginJobDefinition{Name: "sync", IntervalSeconds: 300}).Return(nil)
+
+		err := client.Job.Register("sync", 5*time.Minute)
+		require.NoError(t, err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		appErr := newAppError()
+
+		api.On("RegisterPluginJob", &model.PluginJobDefinition{Name: "sync"}).Return(appErr)
+
+		err := client.Job.Register("sync", 0)
+		require.Equal(t, appErr, err)
+	})
+}
+
+func TestJobCreate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		data := map[string]string{"key": "value"}
+		api.On("CreatePluginJob", "sync", data).Return(&model.Job{Id: "1", Type: "plugin_sync"}, nil)
+
+		job, err := client.Job.Create("sync", data)
+		require.NoError(t, err)
+		require.Equal(t, "1", job.Id)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		appErr := newAppError()
+
+		api.On("CreatePluginJob", "sync", map[string]string(nil)).Return(nil, appErr)
+
+		job, err := client.Job.Create("sync", nil)
+		require.Equal(t, appErr, err)
+		require.Nil(t, job)
+	})
+}
+
+func TestJobIsCancelRequested(t *testing.T) {
+	t.Run("cancel requested", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		api.On("GetPluginJob", "1").Return(&model.Job{Id: "1", Status: model.JobStatusCancelRequested}, nil)
+
+		canceled, err := client.Job.IsCancelRequested("1")
+		require.NoError(t, err)
+		require.True(t, canceled)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		api.On("GetPluginJob", "1").Return(&model.Job{Id: "1", Status: model.JobStatusInProgress}, nil)
+
+		canceled, err := client.Job.IsCancelRequested("1")
+		require.NoError(t, err)
+		require.False(t, canceled)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		appErr := newAppError()
+
+		api.On("GetPluginJob", "1").Return(nil, appErr)
+
+		canceled, err := client.Job.IsCancelRequested("1")
+		require.Equal(t, appErr, err)
+		require.False(t, canceled)
+	})
+}
@@ -3,6 +3,7 @@ package pluginapi
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -65,18 +66,9 @@ func (k *KVService) Set(key string, value interface{}, options ...KVSetOption) (
 		o(&opts)
 	}
 
-	var valueBytes []byte
-	if value != nil {
-		// Assume JSON encoding, unless explicitly given a byte slice.
-		var isValueInBytes bool
-		valueBytes, isValueInBytes = value.([]byte)
-		if !isValueInBytes {
-			var err error
-			valueBytes, err = json.Marshal(value)
-			if err != nil {
-				return false, errors.Wrapf(err, "failed to marshal value %v", value)
-			}
-		}
+	valueBytes, err := marshalValue(value)
+	if err != nil {
+		return false, err
 	}
 
 	downstreamOpts := model.PluginKVSetOptions{
@@ -84,24 +76,34 @@ func (k *KVService) Set(key string, value interface{}, options ...KVSetOption) (
 		ExpireInSeconds: opts.ExpireInSeconds,
 	}
 
-	if opts.oldValue != nil {
-		oldValueBytes, isOldValueInBytes := opts.oldValue.([]byte)
-		if isOldValueInBytes {
-			downstreamOpts.OldValue = oldValueBytes
-		} else {
-			data, err := json.Marshal(opts.oldValue)
-			if err != nil {
-				return false, errors.Wrapf(err, "failed to marshal value %v", opts.oldValue)
-			}
-
-			downstreamOpts.OldValue = data
-		}
+	downstreamOpts.OldValue, err = marshalValue(opts.oldValue)
+	if err != nil {
+		return false, err
 	}
 
 	written, appErr := k.api.KVSetWithOptions(key, valueBytes, downstreamOpts)
 	return written, normalizeAppErr(appErr)
 }
 
+// marshalValue assumes JSON encoding, unless explicitly given a byte slice. A nil value
+// is returned as is.
+func marshalValue(value interface{}) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if valueBytes, isValueInBytes := value.([]byte); isValueInBytes {
+		return valueBytes, nil
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal value %v", value)
+	}
+
+	return valueBytes, nil
+}
+
 // SetAtomicWithRetries will set a key-value pair atomically using compare and set semantics:
 // it will read key's value (to get oldValue), perform valueFunc (to get newValue),
 // and compare and set (comparing oldValue and setting newValue).
@@ -276,3 +278,103 @@ func (k *KVService) ListKeys(page, count int, options ...ListKeysOption) ([]stri
 
 	return ret, nil
 }
+
+// ListKeysWithOptions lists keys in ascending order, filtered by prefix or key range. Unlike
+// ListKeys, the filtering happens on the server, so only matching keys are transferred.
+//
+// Pass the NextCursor of the returned page as the Cursor option to fetch the next page. An empty
+// NextCursor means there are no more keys.
+//
+// Minimum server version: 10.3
+func (k *KVService) ListKeysWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, error) {
+	page, appErr := k.api.KVListWithOptions(options)
+	if appErr != nil {
+		return nil, normalizeAppErr(appErr)
+	}
+
+	return page, nil
+}
+
+// GetMulti gets the raw values of the given keys. Non-existent keys are omitted from the
+// returned map.
+//
+// Minimum server version: 10.3
+func (k *KVService) GetMulti(keys []string) (map[string][]byte, error) {
+	values, appErr := k.api.KVGetMulti(keys)
+	if appErr != nil {
+		return nil, normalizeAppErr(appErr)
+	}
+
+	return values, nil
+}
+
+// GetMetadata gets the creation, update and expiry times of the given key. A non-existent key
+// returns nil metadata and no error.
+//
+// Minimum server version: 10.3
+func (k *KVService) GetMetadata(key string) (*model.PluginKVMetadata, error) {
+	metadata, appErr := k.api.KVGetMetadata(key)
+	if appErr != nil {
+		return nil, normalizeAppErr(appErr)
+	}
+
+	return metadata, nil
+}
+
+// SetMulti stores several key-value pairs in a single transaction: either all of them are
+// written, or none are. Values are JSON encoded unless given as a byte slice, and nil values
+// delete their keys. SetAtomic is not supported; use Batch for compare and set semantics.
+//
+// Minimum server version: 10.3
+func (k *KVService) SetMulti(kvs map[string]interface{}, options ...KVSetOption) error {
+	opts := KVSetOptions{}
+	for _, o := range options {
+		o(&opts)
+	}
+	if opts.Atomic {
+		return errors.New("atomic writes are not supported by SetMulti")
+	}
+
+	// Write in key order, so that concurrent batches lock rows in a consistent order.
+	keys := make([]string, 0, len(kvs))
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ops := make([]*model.PluginKVBatchOperation, 0, len(kvs))
+	for _, key := range keys {
+		valueBytes, err := marshalValue(kvs[key])
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, &model.PluginKVBatchOperation{
+			Key:     key,
+			Value:   valueBytes,
+			Options: model.PluginKVSetOptions{ExpireInSeconds: opts.ExpireInSeconds},
+		})
+	}
+
+	_, err := k.Batch(ops)
+	return err
+}
+
+// Batch applies several writes in a single transaction. Keys prefixed with `mmi_` are reserved
+// for internal use and will fail to be set.
+//
+// Returns (false, err) if DB error occurred
+// Returns (false, nil) if an atomic operation failed, in which case nothing was written
+// Returns (true, nil) if all operations were applied
+//
+// Minimum server version: 10.3
+func (k *KVService) Batch(ops []*model.PluginKVBatchOperation) (bool, error) {
+	for _, op := range ops {
+		if op != nil && strings.HasPrefix(op.Key, internalKeyPrefix) {
+			return false, errors.Errorf("'%s' prefix is not allowed for keys", internalKeyPrefix)
+		}
+	}
+
+	applied, appErr := k.api.KVBatch(ops)
+	return applied, normalizeAppErr(appErr)
+}
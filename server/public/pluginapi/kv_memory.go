@@ -3,6 +3,7 @@ package pluginapi
 import (
 	"bytes"
 	"encoding/json"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -25,6 +26,8 @@ type MemoryStore struct {
 type kvElem struct {
 	value     []byte
 	expiresAt *time.Time
+	createAt  time.Time
+	updateAt  time.Time
 }
 
 func (e kvElem) isExpired() bool {
@@ -101,34 +104,34 @@ func (s *MemoryStore) Set(key string, value any, options ...KVSetOption) (bool,
 		s.elems = make(map[string]kvElem)
 	}
 
-	if !opts.Atomic {
-		if value == nil {
-			delete(s.elems, key)
-		} else {
-			s.elems[key] = kvElem{
-				value:     valueBytes,
-				expiresAt: expireTime(downstreamOpts.ExpireInSeconds),
-			}
-		}
+	return setElem(s.elems, key, valueBytes, downstreamOpts), nil
+}
 
-		return true, nil
+// setElem writes a value to elems following the semantics of Set. The caller must hold the lock.
+func setElem(elems map[string]kvElem, key string, value []byte, opts model.PluginKVSetOptions) bool {
+	oldElem, exists := elems[key]
+	if opts.Atomic && !oldElem.isExpired() && !bytes.Equal(oldElem.value, opts.OldValue) {
+		return false
 	}
 
-	oldElem := s.elems[key]
-	if !oldElem.isExpired() && !bytes.Equal(oldElem.value, downstreamOpts.OldValue) {
-		return false, nil
+	if value == nil {
+		delete(elems, key)
+		return true
 	}
 
-	if value == nil {
-		delete(s.elems, key)
-	} else {
-		s.elems[key] = kvElem{
-			value:     valueBytes,
-			expiresAt: expireTime(downstreamOpts.ExpireInSeconds),
-		}
+	now := time.Now()
+	createAt := now
+	if exists && !oldElem.isExpired() {
+		createAt = oldElem.createAt
+	}
+	elems[key] = kvElem{
+		value:     value,
+		expiresAt: expireTime(opts.ExpireInSeconds),
+		createAt:  createAt,
+		updateAt:  now,
 	}
 
-	return true, nil
+	return true
 }
 
 func (s *MemoryStore) SetAtomicWithRetries(key string, valueFunc func(oldValue []byte) (newValue any, err error)) error {
@@ -261,6 +264,150 @@ func (s *MemoryStore) DeleteAll() error {
 	return nil
 }
 
+// ListKeysWithOptions lists keys in ascending order, filtered by prefix or key range.
+func (s *MemoryStore) ListKeysWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, error) {
+	if err := options.IsValid(); err != nil {
+		return nil, err
+	}
+	options.SetDefaults()
+
+	keys := make([]string, 0)
+	s.mux.RLock()
+	for k, e := range s.elems {
+		if e.isExpired() ||
+			!strings.HasPrefix(k, options.Prefix) ||
+			(options.Cursor != "" && k <= options.Cursor) ||
+			(options.EndKey != "" && k >= options.EndKey) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	s.mux.RUnlock()
+
+	slices.Sort(keys)
+
+	page := &model.PluginKVListPage{Keys: keys}
+	if len(keys) >= options.PerPage {
+		page.Keys = keys[:options.PerPage]
+		page.NextCursor = page.Keys[len(page.Keys)-1]
+	}
+
+	return page, nil
+}
+
+// GetMulti gets the raw values of the given keys. Non-existent keys are omitted from the
+// returned map.
+func (s *MemoryStore) GetMulti(keys []string) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	for _, key := range keys {
+		if e, ok := s.elems[key]; ok && !e.isExpired() {
+			values[key] = e.value
+		}
+	}
+
+	return values, nil
+}
+
+// GetMetadata gets the creation, update and expiry times of the given key. A non-existent key
+// returns nil metadata and no error.
+func (s *MemoryStore) GetMetadata(key string) (*model.PluginKVMetadata, error) {
+	s.mux.RLock()
+	e, ok := s.elems[key]
+	s.mux.RUnlock()
+	if !ok || e.isExpired() {
+		return nil, nil
+	}
+
+	metadata := &model.PluginKVMetadata{
+		Key:      key,
+		CreateAt: model.GetMillisForTime(e.createAt),
+		UpdateAt: model.GetMillisForTime(e.updateAt),
+	}
+	if e.expiresAt != nil {
+		metadata.ExpireAt = model.GetMillisForTime(*e.expiresAt)
+	}
+
+	return metadata, nil
+}
+
+// SetMulti stores several key-value pairs, either all of them or none.
+func (s *MemoryStore) SetMulti(kvs map[string]any, options ...KVSetOption) error {
+	opts := KVSetOptions{}
+	for _, o := range options {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	if opts.Atomic {
+		return errors.New("atomic writes are not supported by SetMulti")
+	}
+
+	ops := make([]*model.PluginKVBatchOperation, 0, len(kvs))
+	for key, value := range kvs {
+		valueBytes, err := marshalValue(value)
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, &model.PluginKVBatchOperation{
+			Key:     key,
+			Value:   valueBytes,
+			Options: model.PluginKVSetOptions{ExpireInSeconds: opts.ExpireInSeconds},
+		})
+	}
+
+	_, err := s.Batch(ops)
+	return err
+}
+
+// Batch applies several writes at once. If an atomic operation fails, nothing is written.
+func (s *MemoryStore) Batch(ops []*model.PluginKVBatchOperation) (bool, error) {
+	if err := model.IsValidPluginKVBatch(ops); err != nil {
+		return false, err
+	}
+
+	for _, op := range ops {
+		if op.Key == "" {
+			return false, errors.New("key must not be empty")
+		}
+
+		if strings.HasPrefix(op.Key, internalKeyPrefix) {
+			return false, errors.Errorf("'%s' prefix is not allowed for keys", internalKeyPrefix)
+		}
+
+		if utf8.RuneCountInString(op.Key) > model.KeyValueKeyMaxRunes {
+			return false, errors.Errorf("key must not be longer then %d", model.KeyValueKeyMaxRunes)
+		}
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	elems := maps.Clone(s.elems)
+	if elems == nil {
+		elems = make(map[string]kvElem)
+	}
+
+	for _, op := range ops {
+		if op.Options.Atomic && op.Options.OldValue == nil && op.Value == nil {
+			// nil can't be stored, so there is nothing to compare with.
+			return false, nil
+		}
+
+		if !setElem(elems, op.Key, op.Value, op.Options) {
+			return false, nil
+		}
+	}
+
+	s.elems = elems
+
+	return true, nil
+}
+
 func expireTime(expireInSeconds int64) *time.Time {
 	if expireInSeconds == 0 {
 		return nil
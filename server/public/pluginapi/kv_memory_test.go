@@ -12,18 +12,24 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/pluginapi"
 )
 
 // kvStore is used to check that KVService and MemoryStore implement the same interface.
 // Methods names are sorted alphabetically for easier comparison.
 type kvStore interface {
+	Batch(ops []*model.PluginKVBatchOperation) (bool, error)
 	Delete(key string) error
 	DeleteAll() error
 	Get(key string, o any) error
+	GetMetadata(key string) (*model.PluginKVMetadata, error)
+	GetMulti(keys []string) (map[string][]byte, error)
 	ListKeys(page, count int, options ...pluginapi.ListKeysOption) ([]string, error)
+	ListKeysWithOptions(options model.PluginKVListOptions) (*model.PluginKVListPage, error)
 	Set(key string, value any, options ...pluginapi.KVSetOption) (bool, error)
 	SetAtomicWithRetries(key string, valueFunc func(oldValue []byte) (newValue any, err error)) error
+	SetMulti(kvs map[string]any, options ...pluginapi.KVSetOption) error
 }
 
 var _ kvStore = (*pluginapi.MemoryStore)(nil)
@@ -467,3 +473,114 @@ func isNil(t require.TestingT, store *pluginapi.MemoryStore, key string) {
 	require.NoError(t, err)
 	assert.Nil(t, out)
 }
+
+func TestMemoryStoreListKeysWithOptions(t *testing.T) {
+	store := pluginapi.MemoryStore{}
+	err := store.SetMulti(map[string]any{"user_a": 1, "user_b": 2, "user_c": 3, "team_a": 4})
+	require.NoError(t, err)
+
+	t.Run("prefix and cursor", func(t *testing.T) {
+		page, err := store.ListKeysWithOptions(model.PluginKVListOptions{Prefix: "user_", PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_a", "user_b"}, page.Keys)
+		assert.Equal(t, "user_b", page.NextCursor)
+
+		page, err = store.ListKeysWithOptions(model.PluginKVListOptions{Prefix: "user_", PerPage: 2, Cursor: page.NextCursor})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_c"}, page.Keys)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		page, err := store.ListKeysWithOptions(model.PluginKVListOptions{Cursor: "team_a", EndKey: "user_c"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user_a", "user_b"}, page.Keys)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := store.ListKeysWithOptions(model.PluginKVListOptions{PerPage: -1})
+		assert.Error(t, err)
+	})
+}
+
+func TestMemoryStoreGetMulti(t *testing.T) {
+	store := pluginapi.MemoryStore{}
+	_, err := store.Set("a", []byte("1"))
+	require.NoError(t, err)
+
+	values, err := store.GetMulti([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1")}, values)
+}
+
+func TestMemoryStoreGetMetadata(t *testing.T) {
+	store := pluginapi.MemoryStore{}
+
+	metadata, err := store.GetMetadata("a")
+	require.NoError(t, err)
+	assert.Nil(t, metadata)
+
+	_, err = store.Set("a", []byte("1"), pluginapi.SetExpiry(time.Hour))
+	require.NoError(t, err)
+
+	metadata, err = store.GetMetadata("a")
+	require.NoError(t, err)
+	require.NotNil(t, metadata)
+	assert.Equal(t, "a", metadata.Key)
+	assert.NotZero(t, metadata.CreateAt)
+	assert.Equal(t, metadata.CreateAt, metadata.UpdateAt)
+	assert.Greater(t, metadata.ExpireAt, metadata.CreateAt)
+
+	time.Sleep(5 * time.Millisecond)
+	_, err = store.Set("a", []byte("2"))
+	require.NoError(t, err)
+
+	updated, err := store.GetMetadata("a")
+	require.NoError(t, err)
+	assert.Equal(t, metadata.CreateAt, updated.CreateAt)
+	assert.Greater(t, updated.UpdateAt, metadata.UpdateAt)
+	assert.Zero(t, updated.ExpireAt)
+}
+
+func TestMemoryStoreBatch(t *testing.T) {
+	t.Run("applies all operations", func(t *testing.T) {
+		store := pluginapi.MemoryStore{}
+		_, err := store.Set("b", []byte("2"))
+		require.NoError(t, err)
+
+		applied, err := store.Batch([]*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1"), Options: model.PluginKVSetOptions{Atomic: true}},
+			{Key: "b", Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("2")}},
+		})
+		require.NoError(t, err)
+		assert.True(t, applied)
+
+		values, err := store.GetMulti([]string{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"a": []byte("1")}, values)
+	})
+
+	t.Run("failed atomic operation writes nothing", func(t *testing.T) {
+		store := pluginapi.MemoryStore{}
+		_, err := store.Set("b", []byte("2"))
+		require.NoError(t, err)
+
+		applied, err := store.Batch([]*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1")},
+			{Key: "b", Value: []byte("3"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("other")}},
+		})
+		require.NoError(t, err)
+		assert.False(t, applied)
+
+		values, err := store.GetMulti([]string{"a", "b"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"b": []byte("2")}, values)
+	})
+
+	t.Run("key has mmi_ prefix", func(t *testing.T) {
+		store := pluginapi.MemoryStore{}
+		applied, err := store.Batch([]*model.PluginKVBatchOperation{{Key: "mmi_a", Value: []byte("1")}})
+		assert.Error(t, err)
+		assert.False(t, applied)
+	})
+}
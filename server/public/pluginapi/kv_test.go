@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -635,3 +636,117 @@ func getKeys(count int) []string {
 	}
 	return ret
 }
+
+func TestListKeysWithOptions(t *testing.T) {
+	api := &plugintest.API{}
+	defer api.AssertExpectations(t)
+	client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+	options := model.PluginKVListOptions{Prefix: "key", Cursor: "key1", PerPage: 2}
+	page := &model.PluginKVListPage{Keys: []string{"key2", "key3"}, NextCursor: "key3"}
+	api.On("KVListWithOptions", options).Return(page, nil)
+
+	actual, err := client.KV.ListKeysWithOptions(options)
+	require.NoError(t, err)
+	assert.Equal(t, page, actual)
+
+	t.Run("error", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		api.On("KVListWithOptions", model.PluginKVListOptions{}).Return(nil, newAppError())
+
+		actual, err := client.KV.ListKeysWithOptions(model.PluginKVListOptions{})
+		require.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestGetMulti(t *testing.T) {
+	api := &plugintest.API{}
+	defer api.AssertExpectations(t)
+	client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+	values := map[string][]byte{"1": []byte("a")}
+	api.On("KVGetMulti", []string{"1", "2"}).Return(values, nil)
+
+	actual, err := client.KV.GetMulti([]string{"1", "2"})
+	require.NoError(t, err)
+	assert.Equal(t, values, actual)
+}
+
+func TestGetMetadata(t *testing.T) {
+	api := &plugintest.API{}
+	defer api.AssertExpectations(t)
+	client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+	metadata := &model.PluginKVMetadata{Key: "1", CreateAt: 1, UpdateAt: 2}
+	api.On("KVGetMetadata", "1").Return(metadata, nil)
+	api.On("KVGetMetadata", "2").Return(nil, nil)
+
+	actual, err := client.KV.GetMetadata("1")
+	require.NoError(t, err)
+	assert.Equal(t, metadata, actual)
+
+	actual, err = client.KV.GetMetadata("2")
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+}
+
+func TestSetMulti(t *testing.T) {
+	t.Run("marshals values in key order", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		api.On("KVBatch", []*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte(`"1"`), Options: model.PluginKVSetOptions{ExpireInSeconds: 60}},
+			{Key: "b", Value: []byte{2}, Options: model.PluginKVSetOptions{ExpireInSeconds: 60}},
+			{Key: "c", Value: nil, Options: model.PluginKVSetOptions{ExpireInSeconds: 60}},
+		}).Return(true, nil)
+
+		err := client.KV.SetMulti(map[string]interface{}{
+			"c": nil,
+			"a": "1",
+			"b": []byte{2},
+		}, pluginapi.SetExpiry(time.Minute))
+		require.NoError(t, err)
+	})
+
+	t.Run("atomic option is rejected", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		err := client.KV.SetMulti(map[string]interface{}{"a": "1"}, pluginapi.SetAtomic(nil))
+		require.Error(t, err)
+	})
+}
+
+func TestBatch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		ops := []*model.PluginKVBatchOperation{
+			{Key: "a", Value: []byte("1"), Options: model.PluginKVSetOptions{Atomic: true, OldValue: []byte("0")}},
+		}
+		api.On("KVBatch", ops).Return(false, nil)
+
+		applied, err := client.KV.Batch(ops)
+		require.NoError(t, err)
+		assert.False(t, applied)
+	})
+
+	t.Run("internal prefix is rejected", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+
+		applied, err := client.KV.Batch([]*model.PluginKVBatchOperation{{Key: "mmi_a", Value: []byte("1")}})
+		require.Error(t, err)
+		assert.False(t, applied)
+	})
+}
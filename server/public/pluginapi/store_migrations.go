@@ -0,0 +1,196 @@
+package pluginapi
+
+import (
+	"io/fs"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/pluginapi/cluster"
+)
+
+// migrationsMutexKey is the name of the cluster mutex serializing the migrations of a plugin
+// across its instances.
+const migrationsMutexKey = "pluginapi_store_migrations"
+
+// migrationFileRegexp matches migration file names, e.g. 000001_create_table.up.sql.
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is a versioned database schema change of a plugin.
+type Migration struct {
+	// Version orders the migrations of a plugin. It must be unique and positive.
+	Version int64
+	// Name describes the migration.
+	Name string
+	// Up is the SQL applying the migration, keyed by database driver name, e.g.
+	// model.DatabaseDriverPostgres.
+	Up map[string]string
+	// Down is the SQL reverting the migration, keyed by database driver name. Migrations
+	// without it for the driver in use can't be rolled back.
+	Down map[string]string
+}
+
+// Migrate applies the given migrations not yet applied, in version order. The server records
+// the applied versions, so it is safe to call Migrate every time the plugin is activated,
+// typically from OnActivate. Migrations are serialized across the cluster, so only one
+// plugin instance applies them.
+//
+// Each migration must provide SQL for the database driver in use. Applied migrations can be
+// rolled back by a system admin with `mmctl plugin migrations rollback`.
+//
+// Minimum server version: 10.3
+func (s *StoreService) Migrate(migrations []Migration) error {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	driverName := s.DriverName()
+	for i, migration := range sorted {
+		if migration.Version <= 0 {
+			return errors.Errorf("invalid migration version %d", migration.Version)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return errors.Errorf("duplicate migration version %d", migration.Version)
+		}
+		if migration.Up[driverName] == "" {
+			return errors.Errorf("migration %d has no SQL for database driver %s", migration.Version, driverName)
+		}
+	}
+
+	mutex, err := cluster.NewMutex(s.api, migrationsMutexKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to create migrations mutex")
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	applied, err := s.GetAppliedMigrations()
+	if err != nil {
+		return err
+	}
+
+	appliedVersions := make(map[int64]bool, len(applied))
+	for _, migration := range applied {
+		appliedVersions[migration.Version] = true
+	}
+
+	for _, migration := range sorted {
+		if appliedVersions[migration.Version] {
+			continue
+		}
+
+		appErr := s.api.ApplyPluginMigration(&model.PluginMigration{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Up:      migration.Up[driverName],
+			Down:    migration.Down[driverName],
+		})
+		if appErr != nil && appErr.StatusCode != http.StatusConflict {
+			return errors.Wrapf(appErr, "failed to apply migration %d", migration.Version)
+		}
+	}
+
+	return nil
+}
+
+// MigrateFS reads migrations from the given file system, typically an embed.FS, and applies
+// them as Migrate does. Migrations are laid out in a directory per database driver, with a
+// file per version and direction:
+//
+//	postgres/000001_create_table.up.sql
+//	postgres/000001_create_table.down.sql
+//	mysql/000001_create_table.up.sql
+//	mysql/000001_create_table.down.sql
+//
+// Minimum server version: 10.3
+func (s *StoreService) MigrateFS(fsys fs.FS) error {
+	migrations, err := ReadMigrationsFS(fsys)
+	if err != nil {
+		return err
+	}
+
+	return s.Migrate(migrations)
+}
+
+// GetAppliedMigrations returns the migrations applied by the plugin, ordered by version.
+//
+// Minimum server version: 10.3
+func (s *StoreService) GetAppliedMigrations() ([]*model.PluginMigration, error) {
+	migrations, appErr := s.api.GetPluginMigrations()
+	if appErr != nil {
+		return nil, errors.Wrap(appErr, "failed to get applied migrations")
+	}
+
+	return migrations, nil
+}
+
+// ReadMigrationsFS reads migrations from the given file system, laid out as described by
+// MigrateFS.
+func ReadMigrationsFS(fsys fs.FS) ([]Migration, error) {
+	drivers, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read migrations")
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, driver := range drivers {
+		if !driver.IsDir() {
+			continue
+		}
+		driverName := driver.Name()
+
+		files, err := fs.ReadDir(fsys, driverName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s migrations", driverName)
+		}
+
+		for _, file := range files {
+			matches := migrationFileRegexp.FindStringSubmatch(file.Name())
+			if file.IsDir() || matches == nil {
+				continue
+			}
+
+			version, err := strconv.ParseInt(matches[1], 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid migration version in %s", file.Name())
+			}
+
+			sql, err := fs.ReadFile(fsys, path.Join(driverName, file.Name()))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read migration %s", file.Name())
+			}
+
+			migration, ok := byVersion[version]
+			if !ok {
+				migration = &Migration{
+					Version: version,
+					Name:    matches[2],
+					Up:      map[string]string{},
+					Down:    map[string]string{},
+				}
+				byVersion[version] = migration
+			} else if migration.Name != matches[2] {
+				return nil, errors.Errorf("migration %d is named both %s and %s", version, migration.Name, matches[2])
+			}
+
+			if matches[3] == "up" {
+				migration.Up[driverName] = string(sql)
+			} else {
+				migration.Down[driverName] = string(sql)
+			}
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
@@ -0,0 +1,146 @@
+package pluginapi_test
+
+import (
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+)
+
+func TestReadMigrationsFS(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"postgres/000002_add_column.up.sql":     {Data: []byte("ALTER TABLE t ADD COLUMN c text")},
+			"postgres/000001_create_table.up.sql":   {Data: []byte("CREATE TABLE t (id text)")},
+			"postgres/000001_create_table.down.sql": {Data: []byte("DROP TABLE t")},
+			"mysql/000001_create_table.up.sql":      {Data: []byte("CREATE TABLE t (id varchar(26))")},
+			"mysql/README.md":                       {Data: []byte("ignored")},
+			"README.md":                             {Data: []byte("ignored")},
+		}
+
+		migrations, err := pluginapi.ReadMigrationsFS(fsys)
+		require.NoError(t, err)
+		require.Equal(t, []pluginapi.Migration{
+			{
+				Version: 1,
+				Name:    "create_table",
+				Up: map[string]string{
+					model.DatabaseDriverPostgres: "CREATE TABLE t (id text)",
+					model.DatabaseDriverMysql:    "CREATE TABLE t (id varchar(26))",
+				},
+				Down: map[string]string{
+					model.DatabaseDriverPostgres: "DROP TABLE t",
+				},
+			},
+			{
+				Version: 2,
+				Name:    "add_column",
+				Up:      map[string]string{model.DatabaseDriverPostgres: "ALTER TABLE t ADD COLUMN c text"},
+				Down:    map[string]string{},
+			},
+		}, migrations)
+	})
+
+	t.Run("mismatched names", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"postgres/000001_create_table.up.sql": {Data: []byte("CREATE TABLE t (id text)")},
+			"mysql/000001_create_tables.up.sql":   {Data: []byte("CREATE TABLE t (id text)")},
+		}
+
+		_, err := pluginapi.ReadMigrationsFS(fsys)
+		require.Error(t, err)
+	})
+}
+
+func TestStoreMigrate(t *testing.T) {
+	config := &model.Config{
+		SqlSettings: model.SqlSettings{
+			DriverName: model.NewPointer(model.DatabaseDriverPostgres),
+		},
+	}
+
+	migrations := []pluginapi.Migration{
+		{
+			Version: 2,
+			Name:    "add_column",
+			Up:      map[string]string{model.DatabaseDriverPostgres: "ALTER TABLE t ADD COLUMN c text"},
+			Down:    map[string]string{model.DatabaseDriverPostgres: "ALTER TABLE t DROP COLUMN c"},
+		},
+		{
+			Version: 1,
+			Name:    "create_table",
+			Up:      map[string]string{model.DatabaseDriverPostgres: "CREATE TABLE t (id text)"},
+		},
+	}
+
+	setupAPI := func(t *testing.T) *plugintest.API {
+		api := &plugintest.API{}
+		t.Cleanup(func() { api.AssertExpectations(t) })
+		api.On("GetConfig").Return(config)
+		api.On("KVSetWithOptions", mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(true, nil)
+		return api
+	}
+
+	t.Run("applies pending migrations in order", func(t *testing.T) {
+		api := setupAPI(t)
+		api.On("GetPluginMigrations").Return([]*model.PluginMigration{{Version: 1}}, nil)
+		api.On("ApplyPluginMigration", &model.PluginMigration{
+			Version: 2,
+			Name:    "add_column",
+			Up:      "ALTER TABLE t ADD COLUMN c text",
+			Down:    "ALTER TABLE t DROP COLUMN c",
+		}).Return(nil).Once()
+
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+		require.NoError(t, client.Store.Migrate(migrations))
+	})
+
+	t.Run("ignores migrations applied concurrently", func(t *testing.T) {
+		api := setupAPI(t)
+		api.On("GetPluginMigrations").Return([]*model.PluginMigration{}, nil)
+		api.On("ApplyPluginMigration", mock.Anything).Return(model.NewAppError("", "", nil, "", http.StatusConflict)).Twice()
+
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+		require.NoError(t, client.Store.Migrate(migrations))
+	})
+
+	t.Run("stops on failure", func(t *testing.T) {
+		api := setupAPI(t)
+		api.On("GetPluginMigrations").Return([]*model.PluginMigration{}, nil)
+		api.On("ApplyPluginMigration", mock.Anything).Return(model.NewAppError("", "", nil, "", http.StatusInternalServerError)).Once()
+
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+		err := client.Store.Migrate(migrations)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to apply migration 1")
+	})
+
+	t.Run("missing driver", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		api.On("GetConfig").Return(config)
+
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+		err := client.Store.Migrate([]pluginapi.Migration{
+			{Version: 1, Up: map[string]string{model.DatabaseDriverMysql: "SELECT 1"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("duplicate version", func(t *testing.T) {
+		api := &plugintest.API{}
+		defer api.AssertExpectations(t)
+		api.On("GetConfig").Return(config)
+
+		client := pluginapi.NewClient(api, &plugintest.Driver{})
+		err := client.Store.Migrate([]pluginapi.Migration{migrations[1], migrations[1]})
+		require.Error(t, err)
+	})
+}
@@ -103,7 +103,8 @@ export function installPlugin(id: string): ThunkActionFunc<void, GlobalState> {
         }
 
         try {
-            await Client4.installMarketplacePlugin(id);
+            // The requested capabilities are listed next to the install button, so installing approves them.
+            await Client4.installMarketplacePlugin(id, true);
         } catch (error: any) {
             dispatch({
                 type: ActionTypes.INSTALLING_MARKETPLACE_ITEM_FAILED,
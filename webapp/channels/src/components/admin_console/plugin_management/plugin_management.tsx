@@ -8,6 +8,7 @@ import {FormattedMessage, defineMessages, injectIntl} from 'react-intl';
 import {Link} from 'react-router-dom';
 
 import type {AdminConfig} from '@mattermost/types/config';
+import type {PluginDependency} from '@mattermost/types/plugins';
 import type {DeepPartial} from '@mattermost/types/utilities';
 
 import PluginState from 'mattermost-redux/constants/plugins';
@@ -163,6 +164,9 @@ const PluginItemStateDescription = ({state, error}: {state: number; error?: stri
 type PluginStatus = {
     state: number;
     error?: string;
+    hooks_bypassed_until?: number;
+    hook_error?: string;
+    unmet_dependencies?: PluginDependency[];
     active: boolean;
     id: string;
     description: string;
@@ -354,6 +358,43 @@ const PluginItem = ({
         );
     }
 
+    if (pluginStatus.hooks_bypassed_until) {
+        notices.push(
+            <div
+                key='hooks-bypassed'
+                className='alert alert-warning'
+            >
+                <i className='fa fa-warning'/>
+                <FormattedMessage
+                    id='admin.plugin.hooks_bypassed_warning'
+                    defaultMessage='This plugin is temporarily bypassed after its hooks repeatedly timed out or crashed, and will be retried at {time}. Last failure: {error}'
+                    values={{
+                        time: new Date(pluginStatus.hooks_bypassed_until).toLocaleTimeString(),
+                        error: pluginStatus.hook_error,
+                    }}
+                />
+            </div>,
+        );
+    }
+
+    if (pluginStatus.unmet_dependencies?.length) {
+        notices.push(
+            <div
+                key='unmet-dependencies'
+                className='alert alert-warning'
+            >
+                <i className='fa fa-warning'/>
+                <FormattedMessage
+                    id='admin.plugin.unmet_dependencies_warning'
+                    defaultMessage='This plugin requires the following plugins to be installed and enabled: {dependencies}'
+                    values={{
+                        dependencies: pluginStatus.unmet_dependencies.map((dependency) => (dependency.version ? `${dependency.id} (${dependency.version})` : dependency.id)).join(', '),
+                    }}
+                />
+            </div>,
+        );
+    }
+
     notices.push(
         <PluginItemStateDescription
             key='state-description'
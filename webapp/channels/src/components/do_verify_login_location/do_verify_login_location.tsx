@@ -0,0 +1,71 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import React, {useState, useEffect} from 'react';
+import {useIntl} from 'react-intl';
+import {useLocation, useHistory} from 'react-router-dom';
+
+import {Client4} from 'mattermost-redux/client';
+
+import LaptopAlertSVG from 'components/common/svg_images_components/laptop_alert_svg';
+import ColumnLayout from 'components/header_footer_route/content_layouts/column';
+import LoadingScreen from 'components/loading_screen';
+
+import {Constants} from 'utils/constants';
+
+import 'components/do_verify_email/do_verify_email.scss';
+
+const DoVerifyLoginLocation = () => {
+    const {formatMessage} = useIntl();
+    const history = useHistory();
+    const {search} = useLocation();
+
+    const token = new URLSearchParams(search).get('token') ?? '';
+
+    const [failed, setFailed] = useState(false);
+
+    useEffect(() => {
+        verifyLoginLocation();
+    }, []);
+
+    const verifyLoginLocation = async () => {
+        try {
+            await Client4.verifyLoginLocation(token);
+        } catch {
+            setFailed(true);
+            return;
+        }
+
+        history.push(`/login?extra=${Constants.LOGIN_LOCATION_VERIFIED}`);
+    };
+
+    const handleReturnButtonOnClick = () => history.replace('/');
+
+    return (
+        failed ? (
+            <div className='do-verify-body'>
+                <div className='do-verify-body-content'>
+                    <ColumnLayout
+                        title={formatMessage({id: 'do_verify_login_location.invalid_link.title', defaultMessage: 'This confirmation link is invalid'})}
+                        message={formatMessage({id: 'do_verify_login_location.invalid_link.message', defaultMessage: 'The link may have expired or already been used. Sign in again to receive a new one.'})}
+                        SVGElement={<LaptopAlertSVG/>}
+                        extraContent={(
+                            <div className='do-verify-body-content-button-container'>
+                                <button
+                                    className='do-verify-body-content-button-return'
+                                    onClick={handleReturnButtonOnClick}
+                                >
+                                    {formatMessage({id: 'signup_user_completed.return', defaultMessage: 'Return to log in'})}
+                                </button>
+                            </div>
+                        )}
+                    />
+                </div>
+            </div>
+        ) : (
+            <LoadingScreen/>
+        )
+    );
+};
+
+export default DoVerifyLoginLocation;
@@ -215,6 +215,14 @@ const Login = ({onCustomizeHeader}: LoginProps) => {
                 });
                 break;
 
+            case Constants.LOGIN_LOCATION_VERIFIED:
+                mode = 'success';
+                title = formatMessage({
+                    id: 'login.locationVerified',
+                    defaultMessage: 'Sign-in confirmed. You can now log in from this location.',
+                });
+                break;
+
             case Constants.PASSWORD_CHANGE:
                 mode = 'success';
                 title = formatMessage({
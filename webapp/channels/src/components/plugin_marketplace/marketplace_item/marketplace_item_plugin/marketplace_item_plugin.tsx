@@ -93,6 +93,27 @@ export const UpdateDetails = ({version, releaseNotesUrl, installedVersion, isIns
     );
 };
 
+export type RequestedCapabilitiesProps = {
+    capabilities?: string[];
+};
+
+// RequestedCapabilities lists the plugin API capabilities an administrator is asked to approve on install.
+export const RequestedCapabilities = ({capabilities}: RequestedCapabilitiesProps): JSX.Element | null => {
+    if (!capabilities?.length) {
+        return null;
+    }
+
+    return (
+        <div className='capabilities light'>
+            <FormattedMessage
+                id='marketplace_modal.list.capabilities'
+                defaultMessage='Requested capabilities: {capabilities}'
+                values={{capabilities: capabilities.join(', ')}}
+            />
+        </div>
+    );
+};
+
 export type UpdateConfirmationModalProps = {
     show: boolean;
     name: string;
@@ -219,6 +240,7 @@ export type MarketplaceItemPluginProps = {
     homepageUrl?: string;
     releaseNotesUrl?: string;
     labels?: MarketplaceLabel[];
+    capabilities?: string[];
     iconData?: string;
     installedVersion?: string;
     installing: boolean;
@@ -345,7 +367,7 @@ export default class MarketplaceItemPlugin extends React.PureComponent <Marketpl
 
         const versionLabel = <span className='light subtitle'>{version}</span>;
 
-        const updateDetails = (
+        let updateDetails = (
             <UpdateDetails
                 version={this.props.version}
                 installedVersion={this.props.installedVersion}
@@ -355,6 +377,15 @@ export default class MarketplaceItemPlugin extends React.PureComponent <Marketpl
             />
         );
 
+        if (this.props.capabilities?.length) {
+            updateDetails = (
+                <>
+                    {updateDetails}
+                    <RequestedCapabilities capabilities={this.props.capabilities}/>
+                </>
+            );
+        }
+
         return (
             <>
                 <MarketplaceItem
@@ -59,6 +59,7 @@ const MarketplaceList = ({
                         homepageUrl={i.homepage_url}
                         releaseNotesUrl={i.release_notes_url}
                         labels={i.labels}
+                        capabilities={i.manifest.capabilities}
                         iconData={i.icon_data}
                         installedVersion={i.installed_version}
                     />
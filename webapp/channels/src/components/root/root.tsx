@@ -57,6 +57,7 @@ const PasswordResetForm = makeAsyncComponent('PasswordResetForm', lazy(() => imp
 const Signup = makeAsyncComponent('SignupController', lazy(() => import('components/signup/signup')));
 const ShouldVerifyEmail = makeAsyncComponent('ShouldVerifyEmail', lazy(() => import('components/should_verify_email/should_verify_email')));
 const DoVerifyEmail = makeAsyncComponent('DoVerifyEmail', lazy(() => import('components/do_verify_email/do_verify_email')));
+const DoVerifyLoginLocation = makeAsyncComponent('DoVerifyLoginLocation', lazy(() => import('components/do_verify_login_location/do_verify_login_location')));
 const ClaimController = makeAsyncComponent('ClaimController', lazy(() => import('components/claim')));
 const TermsOfService = makeAsyncComponent('TermsOfService', lazy(() => import('components/terms_of_service')));
 const LinkingLandingPage = makeAsyncComponent('LinkingLandingPage', lazy(() => import('components/linking_landing_page')));
@@ -422,6 +423,10 @@ export default class Root extends React.PureComponent<Props, State> {
                         path={'/do_verify_email'}
                         component={DoVerifyEmail}
                     />
+                    <HFRoute
+                        path={'/do_verify_login_location'}
+                        component={DoVerifyLoginLocation}
+                    />
                     <HFTRoute
                         path={'/claim'}
                         component={ClaimController}
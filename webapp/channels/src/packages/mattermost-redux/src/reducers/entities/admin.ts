@@ -455,6 +455,9 @@ function pluginStatuses(state: Record<string, PluginStatusRedux> = {}, action: A
                 active: pluginState > 0,
                 state: pluginState,
                 error: plugin.error,
+                hooks_bypassed_until: Math.max((nextState[id] && nextState[id].hooks_bypassed_until) || 0, plugin.hooks_bypassed_until || 0) || undefined,
+                hook_error: (nextState[id] && nextState[id].hook_error) || plugin.hook_error,
+                unmet_dependencies: (nextState[id] && nextState[id].unmet_dependencies) || plugin.unmet_dependencies,
                 instances,
             };
         }
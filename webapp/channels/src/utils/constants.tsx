@@ -1590,6 +1590,7 @@ export const Constants = {
     GET_TERMS_ERROR: 'get_terms_error',
     TERMS_REJECTED: 'terms_rejected',
     SIGNIN_VERIFIED: 'verified',
+    LOGIN_LOCATION_VERIFIED: 'login_location_verified',
     CREATE_LDAP: 'create_ldap',
     SESSION_EXPIRED: 'expired',
     POST_AREA_HEIGHT: 80,
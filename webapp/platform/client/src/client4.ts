@@ -103,8 +103,10 @@ import type {MfaSecret} from '@mattermost/types/mfa';
 import type {
     ClientPluginManifest,
     PluginManifest,
+    PluginMigration,
     PluginsResponse,
     PluginStatus,
+    RegisteredPluginPermission,
 } from '@mattermost/types/plugins';
 import type {Post, PostList, PostSearchResults, PostsUsageResponse, TeamsUsageResponse, PaginatedPostList, FilesUsageResponse, PostAcknowledgement, PostAnalytics, PostInfo} from '@mattermost/types/posts';
 import type {PreferenceType} from '@mattermost/types/preferences';
@@ -735,6 +737,13 @@ export default class Client4 {
         );
     };
 
+    verifyLoginLocation = (token: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getUsersRoute()}/login/location/verify`,
+            {method: 'post', body: JSON.stringify({token})},
+        );
+    };
+
     updateMyTermsOfServiceStatus = (termsOfServiceId: string, accepted: boolean) => {
         return this.doFetch<StatusOK>(
             `${this.getUserRoute('me')}/terms_of_service`,
@@ -3724,12 +3733,12 @@ export default class Client4 {
         );
     };
 
-    installMarketplacePlugin = (id: string) => {
+    installMarketplacePlugin = (id: string, approveCapabilities = false) => {
         this.trackEvent('api', 'api_install_marketplace_plugin');
 
         return this.doFetch<MarketplacePlugin>(
             `${this.getPluginsMarketplaceRoute()}`,
-            {method: 'post', body: JSON.stringify({id})},
+            {method: 'post', body: JSON.stringify({id, approve_capabilities: approveCapabilities})},
         );
     };
 
@@ -3747,6 +3756,13 @@ export default class Client4 {
         );
     };
 
+    getPluginPermissions = () => {
+        return this.doFetch<RegisteredPluginPermission[]>(
+            `${this.getPluginsRoute()}/permissions`,
+            {method: 'get'},
+        );
+    };
+
     removePlugin = (pluginId: string) => {
         return this.doFetch<StatusOK>(
             this.getPluginRoute(pluginId),
@@ -3768,13 +3784,27 @@ export default class Client4 {
         );
     };
 
-    disablePlugin = (pluginId: string) => {
+    disablePlugin = (pluginId: string, force = false) => {
         return this.doFetch<StatusOK>(
-            `${this.getPluginRoute(pluginId)}/disable`,
+            `${this.getPluginRoute(pluginId)}/disable${buildQueryString({force})}`,
             {method: 'post'},
         );
     };
 
+    getPluginMigrations = (pluginId: string) => {
+        return this.doFetch<PluginMigration[]>(
+            `${this.getPluginRoute(pluginId)}/migrations`,
+            {method: 'get'},
+        );
+    };
+
+    rollbackPluginMigrations = (pluginId: string, toVersion: number) => {
+        return this.doFetch<PluginMigration[]>(
+            `${this.getPluginRoute(pluginId)}/migrations/rollback`,
+            {method: 'post', body: JSON.stringify({to_version: toVersion})},
+        );
+    };
+
     // Groups
     linkGroupSyncable = (groupID: string, syncableID: string, syncableType: string, patch: Partial<SyncablePatch>) => {
         return this.doFetch<GroupSyncable>(
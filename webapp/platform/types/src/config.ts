@@ -102,6 +102,7 @@ export type ClientConfig = {
     EnableSignUpWithOpenId: string;
     EnableSVGs: string;
     EnableTesting: string;
+    EnableTranslation: string;
     EnableThemeSelection: string;
     EnableTutorial: string;
     EnableOnboardingFlow: string;
@@ -200,6 +201,8 @@ export type ClientConfig = {
     PostAcknowledgements: string;
     AllowPersistentNotifications: string;
     PersistentNotificationMaxRecipients: string;
+    EnableReadReceipts: string;
+    ReadReceiptsMaxChannelMembers: string;
     PersistentNotificationIntervalMinutes: string;
     AllowPersistentNotificationsForGuests: string;
     DelayChannelAutocomplete: 'true' | 'false';
@@ -334,6 +337,8 @@ export type ServiceSettings = {
     AllowCookiesForSubdomains: boolean;
     ExtendSessionLengthWithActivity: boolean;
     TerminateSessionsOnPasswordChange: boolean;
+    EnableNewLoginLocationAlerts: boolean;
+    RequireNewLoginLocationVerification: boolean;
     SessionLengthWebInDays: number;
     SessionLengthWebInHours: number;
     SessionLengthMobileInDays: number;
@@ -352,6 +357,7 @@ export type ServiceSettings = {
     PostEditTimeLimit: number;
     TimeBetweenUserTypingUpdatesMilliseconds: number;
     EnablePostSearch: boolean;
+    EnableSavedSearchAlerts: boolean;
     EnableFileSearch: boolean;
     MinimumHashtagLength: number;
     EnableUserTypingMessages: boolean;
@@ -392,6 +398,8 @@ export type ServiceSettings = {
     PersistentNotificationIntervalMinutes: number;
     PersistentNotificationMaxCount: number;
     PersistentNotificationMaxRecipients: number;
+    EnableReadReceipts: boolean;
+    ReadReceiptsMaxChannelMembers: number;
     UniqueEmojiReactionLimitPerPost: number;
     RefreshPostStatsRunTime: string;
     MaximumPayloadSizeBytes: number;
@@ -476,6 +484,9 @@ export type ExperimentalAuditSettings = {
     FileCompress: boolean;
     FileMaxQueueSize: number;
     AdvancedLoggingJSON: Record<string, any>;
+    HashChainEnabled: boolean;
+    HashChainCheckpointInterval: number;
+    DatabaseEnabled: boolean;
 };
 
 export type NotificationLogSettings = {
@@ -605,6 +616,13 @@ export type RateLimitSettings = {
     VaryByHeader: string;
 };
 
+export type IPFilteringSettings = {
+    Enable: boolean;
+    AllowedIPRanges: string;
+    DeniedIPRanges: string;
+    RoleIPRanges: Record<string, string>;
+};
+
 export type PrivacySettings = {
     ShowEmailAddress: boolean;
     ShowFullName: boolean;
@@ -718,6 +736,15 @@ export type LocalizationSettings = {
     AvailableLocales: string;
 };
 
+export type TranslationSettings = {
+    Enable: boolean;
+    Provider: string;
+    LibreTranslateURL: string;
+    LibreTranslateAPIKey: string;
+    PluginId: string;
+    RequestTimeoutSeconds: number;
+};
+
 export type SamlSettings = {
     Enable: boolean;
     EnableSyncWithLdap: boolean;
@@ -845,6 +872,8 @@ export type BleveSettings = {
     EnableSearching: boolean;
     EnableAutocomplete: boolean;
     BatchSize: number;
+    TextAnalyzer: string;
+    DetectPostLanguage: boolean;
 };
 
 export type DataRetentionSettings = {
@@ -903,6 +932,15 @@ export type PluginSettings = {
     MarketplaceURL: string;
     SignaturePublicKeyFiles: string[];
     ChimeraOAuthProxyURL: string;
+    EnforceCapabilities: boolean;
+    GrantedCapabilities: Record<string, string[]>;
+    RegisteredPermissions: Record<string, string[]>;
+    HookTimeoutSeconds: number;
+    MessageHookTimeoutMilliseconds: number;
+    HookFailureThreshold: number;
+    HookCircuitBreakerCooldownSeconds: number;
+    WasmMemoryLimitMB: number;
+    WasmMaxExecutionSeconds: number;
 };
 
 export type DisplaySettings = {
@@ -916,6 +954,9 @@ export type GuestAccountsSettings = {
     AllowEmailAccounts: boolean;
     EnforceMultifactorAuthentication: boolean;
     RestrictCreationToDomains: string;
+    ExpiryDays: number;
+    ExpiryWarningDays: number;
+    RequireInviteApproval: boolean;
 };
 
 export type ImageProxySettings = {
@@ -937,6 +978,9 @@ export type FeatureFlags = Record<string, string | boolean>;
 export type ImportSettings = {
     Directory: string;
     RetentionDays: number;
+    EnableUserDataExport: boolean;
+    UserDataExportIntervalHours: number;
+    UserDataExportLinkExpiryHours: number;
 };
 
 export type ExportSettings = {
@@ -956,6 +1000,7 @@ export type AdminConfig = {
     FileSettings: FileSettings;
     EmailSettings: EmailSettings;
     RateLimitSettings: RateLimitSettings;
+    IPFilteringSettings: IPFilteringSettings;
     PrivacySettings: PrivacySettings;
     SupportSettings: SupportSettings;
     AnnouncementSettings: AnnouncementSettings;
@@ -967,6 +1012,7 @@ export type AdminConfig = {
     LdapSettings: LdapSettings;
     ComplianceSettings: ComplianceSettings;
     LocalizationSettings: LocalizationSettings;
+    TranslationSettings: TranslationSettings;
     SamlSettings: SamlSettings;
     NativeAppSettings: NativeAppSettings;
     ClusterSettings: ClusterSettings;
@@ -23,6 +23,37 @@ export type PluginManifest = {
     webapp?: PluginManifestWebapp;
     settings_schema?: PluginSettingsSchema;
     props?: Record<string, any>;
+    capabilities?: string[];
+    permissions?: PluginPermission[];
+    dependencies?: PluginDependency[];
+};
+
+export type PluginDependency = {
+    id: string;
+    version?: string;
+};
+
+export type PluginMigration = {
+    plugin_id: string;
+    version: number;
+    name: string;
+    down?: string;
+    applied_at: number;
+};
+
+export type PluginPermission = {
+    id: string;
+    name: string;
+    description?: string;
+    scope: 'system' | 'team' | 'channel';
+    default_roles?: string[];
+};
+
+export type RegisteredPluginPermission = {
+    id: string;
+    name: string;
+    description: string;
+    scope: 'system_scope' | 'team_scope' | 'channel_scope';
 };
 
 export type PluginRedux = PluginManifest & {active: boolean};
@@ -87,6 +118,9 @@ export type PluginStatus = {
     name: string;
     description: string;
     version: string;
+    hooks_bypassed_until?: number;
+    hook_error?: string;
+    unmet_dependencies?: PluginDependency[];
 };
 
 type PluginInstance = {
@@ -103,6 +137,9 @@ export type PluginStatusRedux = {
     active: boolean;
     state: number;
     error?: string;
+    hooks_bypassed_until?: number;
+    hook_error?: string;
+    unmet_dependencies?: PluginDependency[];
     instances: PluginInstance[];
 }
 